@@ -13,6 +13,7 @@ import (
 type HandlerOptions struct {
 	ante.HandlerOptions
 	MychainKeeper *mychainkeeper.Keeper
+	GroupKeeper   decorators.GroupKeeper
 }
 
 // NewAnteHandler creates a new ante handler with transaction recording
@@ -36,24 +37,20 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, err
 	}
 	
-	// Wrap with transaction recorder decorator
-	// The transaction recorder should run AFTER the transaction is processed
-	// so we add it as the last decorator
+	// The transaction recorder runs after the default ante handler so it can
+	// read the fee that was deducted. It only captures state the post handler
+	// needs; recording itself happens in the post handler once the messages
+	// have executed.
+	txRecorder := decorators.NewTransactionRecorderDecorator(options.MychainKeeper, options.GroupKeeper)
 	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
 		// First run the default ante handler
 		newCtx, err = defaultAnteHandler(ctx, tx, simulate)
 		if err != nil {
 			return newCtx, err
 		}
-		
-		// Then run the transaction recorder (only if not simulating)
-		if !simulate {
-			txRecorder := decorators.NewTransactionRecorderDecorator(options.MychainKeeper)
-			return txRecorder.AnteHandle(newCtx, tx, simulate, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
-				return ctx, nil
-			})
-		}
-		
-		return newCtx, nil
+
+		return txRecorder.AnteHandle(newCtx, tx, simulate, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return ctx, nil
+		})
 	}, nil
-}
\ No newline at end of file
+}
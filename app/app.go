@@ -35,6 +35,7 @@ import (
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	consensuskeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	groupkeeper "github.com/cosmos/cosmos-sdk/x/group/keeper"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
@@ -48,6 +49,7 @@ import (
 	ibctransferkeeper "github.com/cosmos/ibc-go/v10/modules/apps/transfer/keeper"
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 
+	"mychain/app/decorators"
 	"mychain/docs"
 	dexmodulekeeper "mychain/x/dex/keeper"
 	maincoinmodulekeeper "mychain/x/maincoin/keeper"
@@ -94,6 +96,7 @@ type App struct {
 	GovKeeper             *govkeeper.Keeper
 	UpgradeKeeper         *upgradekeeper.Keeper
 	AuthzKeeper           authzkeeper.Keeper
+	GroupKeeper           groupkeeper.Keeper
 	ConsensusParamsKeeper consensuskeeper.Keeper
 	CircuitBreakerKeeper  circuitkeeper.Keeper
 	ParamsKeeper          paramskeeper.Keeper
@@ -105,7 +108,7 @@ type App struct {
 	TransferKeeper      ibctransferkeeper.Keeper
 
 	MychainKeeper  mychainmodulekeeper.Keeper
-	TestusdKeeper  testusdmodulekeeper.Keeper
+	TestusdKeeper  *testusdmodulekeeper.Keeper
 	MaincoinKeeper *maincoinmodulekeeper.Keeper
 	DexKeeper      dexmodulekeeper.Keeper
 	// this line is used by starport scaffolding # stargate/app/keeperDeclaration
@@ -187,6 +190,7 @@ func New(
 		&app.GovKeeper,
 		&app.UpgradeKeeper,
 		&app.AuthzKeeper,
+		&app.GroupKeeper,
 		&app.ConsensusParamsKeeper,
 		&app.CircuitBreakerKeeper,
 		&app.ParamsKeeper,
@@ -209,11 +213,18 @@ func New(
 	app.MaincoinKeeper.SetTransactionKeeper(&app.MychainKeeper)
 	app.DexKeeper.SetTransactionKeeper(&app.MychainKeeper)
 	app.TestusdKeeper.SetTransactionKeeper(&app.MychainKeeper)
+
+	// Price non-TestUSD maincoin purchases from dex trades
+	app.MaincoinKeeper.SetPriceAdapter(&app.DexKeeper)
 	
 	// Set staking keeper for mychain module
 	logger.Info("Setting staking keeper for mychain module", "stakingKeeper", fmt.Sprintf("%p", app.StakingKeeper))
 	app.MychainKeeper.SetStakingKeeper(app.StakingKeeper)
 	logger.Info("Staking keeper set", "isNil", app.StakingKeeper == nil)
+
+	// Inflation sources reported by the staking metrics
+	app.MychainKeeper.SetMintKeeper(mintkeeper.NewQueryServerImpl(app.MintKeeper))
+	app.MychainKeeper.SetDexKeeper(app.DexKeeper)
 	
 	logger.Info("Transaction keeper wiring completed")
 	logger.Info("Verification", "maincoin", app.MaincoinKeeper.GetTransactionKeeper() != nil)
@@ -227,30 +238,40 @@ func New(
 	// build app
 	app.App = appBuilder.Build(db, traceStore, baseAppOptions...)
 
+	// Let the transaction recorder read the withdraw events of the messages
+	// it records; the circuit breaker still decides what may run
+	app.MsgServiceRouter().SetCircuit(decorators.NewMsgEventTap(&app.CircuitBreakerKeeper))
+
 	// register legacy modules
 	if err := app.registerIBCModules(appOpts); err != nil {
 		panic(err)
 	}
 
-	// Set up custom ante handler with transaction recording
-	anteHandler, err := NewAnteHandler(
-		HandlerOptions{
-			HandlerOptions: ante.HandlerOptions{
-				AccountKeeper:          app.AuthKeeper,
-				BankKeeper:             app.BankKeeper,
-				SignModeHandler:        app.txConfig.SignModeHandler(),
-				FeegrantKeeper:         nil, // not using feegrant
-				SigGasConsumer:         ante.DefaultSigVerificationGasConsumer,
-				ExtensionOptionChecker: nil,
-			},
-			MychainKeeper: &app.MychainKeeper,
+	// Set up custom ante and post handlers with transaction recording
+	handlerOptions := HandlerOptions{
+		HandlerOptions: ante.HandlerOptions{
+			AccountKeeper:          app.AuthKeeper,
+			BankKeeper:             app.BankKeeper,
+			SignModeHandler:        app.txConfig.SignModeHandler(),
+			FeegrantKeeper:         nil, // not using feegrant
+			SigGasConsumer:         ante.DefaultSigVerificationGasConsumer,
+			ExtensionOptionChecker: nil,
 		},
-	)
+		MychainKeeper: &app.MychainKeeper,
+		GroupKeeper:   app.GroupKeeper,
+	}
+	anteHandler, err := NewAnteHandler(handlerOptions)
 	if err != nil {
 		panic(err)
 	}
 	app.SetAnteHandler(anteHandler)
 
+	postHandler, err := NewPostHandler(handlerOptions)
+	if err != nil {
+		panic(err)
+	}
+	app.SetPostHandler(postHandler)
+
 	/****  Module Options ****/
 
 	// create the simulation manager and define the order of the modules for deterministic simulations
@@ -274,6 +295,8 @@ func New(
 		return app.App.InitChainer(ctx, req)
 	})
 
+	app.setupUpgradeHandlers()
+
 	if err := app.Load(loadLatest); err != nil {
 		panic(err)
 	}
@@ -307,6 +330,24 @@ func (app *App) TxConfig() client.TxConfig {
 	return app.txConfig
 }
 
+// GetBaseApp returns the App's BaseApp. It implements the ibc-go testing
+// app interface.
+func (app *App) GetBaseApp() *baseapp.BaseApp {
+	return app.App.BaseApp
+}
+
+// GetIBCKeeper returns the IBC keeper. It implements the ibc-go testing app
+// interface.
+func (app *App) GetIBCKeeper() *ibckeeper.Keeper {
+	return app.IBCKeeper
+}
+
+// GetTxConfig returns App's TxConfig. It implements the ibc-go testing app
+// interface.
+func (app *App) GetTxConfig() client.TxConfig {
+	return app.txConfig
+}
+
 // GetKey returns the KVStoreKey for the provided store key.
 func (app *App) GetKey(storeKey string) *storetypes.KVStoreKey {
 	kvStoreKey, ok := app.UnsafeFindStoreKey(storeKey).(*storetypes.KVStoreKey)
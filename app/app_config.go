@@ -92,6 +92,7 @@ moduleAccPerms = []*authmodulev1.ModuleAccountPermission{
 {Account: testusdmoduletypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner, authtypes.Staking}},
 {Account: maincoinmoduletypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
 {Account: dexmoduletypes.ModuleName},
+{Account: mychainmoduletypes.ModuleName, Permissions: []string{authtypes.Minter}},
 // this line is used by starport scaffolding # stargate/app/maccPerms
 }
 
@@ -0,0 +1,173 @@
+package decorators
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	mychainkeeper "mychain/x/mychain/keeper"
+)
+
+var _ porttypes.IBCModule = IBCRecorderModule{}
+
+// IBCRecorderModule wraps the transfer and interchain account host IBC
+// modules to record incoming transfers, refunds and host-executed messages.
+// Packet callbacks run inside the relayer's tx, so their outcome cannot be
+// read from the tx's messages alone. Records are written in the callback's
+// context and are discarded together with a failed callback's state.
+type IBCRecorderModule struct {
+	porttypes.IBCModule
+
+	recorder TransactionRecorderDecorator
+	cdc      codec.Codec
+}
+
+// NewIBCRecorderModule wraps app with transaction recording.
+func NewIBCRecorderModule(app porttypes.IBCModule, recorder TransactionRecorderDecorator, cdc codec.Codec) IBCRecorderModule {
+	return IBCRecorderModule{
+		IBCModule: app,
+		recorder:  recorder,
+		cdc:       cdc,
+	}
+}
+
+// OnRecvPacket records a successfully received transfer or interchain
+// account tx.
+func (im IBCRecorderModule) OnRecvPacket(ctx sdk.Context, channelVersion string, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	ack := im.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	var err error
+	switch packet.DestinationPort {
+	case ibctransfertypes.PortID:
+		err = im.recordTransferIn(ctx, channelVersion, packet)
+	case icatypes.HostPortID:
+		err = im.recordHostTx(ctx, channelVersion, packet)
+	}
+	if err != nil {
+		ctx.Logger().Error("failed to record received packet", "error", err, "port", packet.DestinationPort)
+	}
+
+	return ack
+}
+
+// OnAcknowledgementPacket records the refund of a transfer the counterparty
+// rejected.
+func (im IBCRecorderModule) OnAcknowledgementPacket(ctx sdk.Context, channelVersion string, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+	if err := im.IBCModule.OnAcknowledgementPacket(ctx, channelVersion, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	var ack channeltypes.Acknowledgement
+	if packet.SourcePort != ibctransfertypes.PortID || ibctransfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack) != nil || ack.Success() {
+		return nil
+	}
+
+	if err := im.recordRefund(ctx, channelVersion, packet, "rejected"); err != nil {
+		ctx.Logger().Error("failed to record transfer refund", "error", err)
+	}
+	return nil
+}
+
+// OnTimeoutPacket records the refund of a transfer that timed out.
+func (im IBCRecorderModule) OnTimeoutPacket(ctx sdk.Context, channelVersion string, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	if err := im.IBCModule.OnTimeoutPacket(ctx, channelVersion, packet, relayer); err != nil {
+		return err
+	}
+
+	if packet.SourcePort != ibctransfertypes.PortID {
+		return nil
+	}
+	if err := im.recordRefund(ctx, channelVersion, packet, "timed out"); err != nil {
+		ctx.Logger().Error("failed to record transfer refund", "error", err)
+	}
+	return nil
+}
+
+func (im IBCRecorderModule) recordTransferIn(ctx sdk.Context, channelVersion string, packet channeltypes.Packet) error {
+	data, err := ibctransfertypes.UnmarshalPacketData(packet.Data, channelVersion, "")
+	if err != nil {
+		return err
+	}
+
+	// Tokens returning to this chain lose their last hop; others gain one
+	denom := data.Token.Denom
+	if denom.HasPrefix(packet.SourcePort, packet.SourceChannel) {
+		denom.Trace = denom.Trace[1:]
+	} else {
+		denom.Trace = append([]ibctransfertypes.Hop{ibctransfertypes.NewHop(packet.DestinationPort, packet.DestinationChannel)}, denom.Trace...)
+	}
+	amount, err := ibctransfertypes.Token{Denom: denom, Amount: data.Token.Amount}.ToCoin()
+	if err != nil {
+		return err
+	}
+
+	record := mychainkeeper.NewTransactionRecord(
+		data.Receiver,
+		mychainkeeper.TxTypeIBCTransferIn,
+		fmt.Sprintf("Received %s from %s over %s", amount.String(), shortenAddress(data.Sender), packet.DestinationChannel),
+		sdk.NewCoins(amount),
+		data.Sender,
+		data.Receiver,
+	)
+	return im.recorder.mychainKeeper.SaveTransactionHistory(ctx, record)
+}
+
+func (im IBCRecorderModule) recordRefund(ctx sdk.Context, channelVersion string, packet channeltypes.Packet, reason string) error {
+	data, err := ibctransfertypes.UnmarshalPacketData(packet.Data, channelVersion, "")
+	if err != nil {
+		return err
+	}
+
+	amount, err := data.Token.ToCoin()
+	if err != nil {
+		return err
+	}
+
+	record := mychainkeeper.NewTransactionRecord(
+		data.Sender,
+		mychainkeeper.TxTypeIBCRefund,
+		fmt.Sprintf("Refunded %s, transfer to %s %s", amount.String(), shortenAddress(data.Receiver), reason),
+		sdk.NewCoins(amount),
+		data.Receiver,
+		data.Sender,
+	)
+	return im.recorder.mychainKeeper.SaveTransactionHistory(ctx, record)
+}
+
+// recordHostTx records the messages an interchain account executed on this
+// chain, as if the account had sent them itself. The host re-emits the events
+// of the messages it ran into the callback's context, which is where the
+// rewards and commission they withdrew are read from.
+func (im IBCRecorderModule) recordHostTx(ctx sdk.Context, channelVersion string, packet channeltypes.Packet) error {
+	metadata, err := icatypes.MetadataFromVersion(channelVersion)
+	if err != nil {
+		return err
+	}
+
+	var data icatypes.InterchainAccountPacketData
+	if err := data.UnmarshalJSON(packet.Data); err != nil {
+		return err
+	}
+
+	msgs, err := icatypes.DeserializeCosmosTx(im.cdc, data.Data, metadata.Encoding)
+	if err != nil {
+		return err
+	}
+
+	rec := &txRecording{payouts: newPayouts(ctx.EventManager().Events())}
+	for _, msg := range msgs {
+		if err := im.recorder.recordMessage(ctx, msg, rec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
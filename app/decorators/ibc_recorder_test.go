@@ -0,0 +1,132 @@
+package decorators_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/gogoproto/proto"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	"github.com/stretchr/testify/require"
+
+	"mychain/app/decorators"
+	mychainkeeper "mychain/x/mychain/keeper"
+)
+
+// stubIBCModule stands in for the transfer and host modules. OnRecvPacket
+// emits events and returns ack.
+type stubIBCModule struct {
+	porttypes.IBCModule
+
+	ack    ibcexported.Acknowledgement
+	events sdk.Events
+}
+
+func (m stubIBCModule) OnRecvPacket(ctx sdk.Context, _ string, _ channeltypes.Packet, _ sdk.AccAddress) ibcexported.Acknowledgement {
+	ctx.EventManager().EmitEvents(m.events)
+	return m.ack
+}
+
+func (m stubIBCModule) OnAcknowledgementPacket(sdk.Context, string, channeltypes.Packet, []byte, sdk.AccAddress) error {
+	return nil
+}
+
+func (m stubIBCModule) OnTimeoutPacket(sdk.Context, string, channeltypes.Packet, sdk.AccAddress) error {
+	return nil
+}
+
+const remote = "remote1sender"
+
+func transferPacket(t *testing.T, denom, sender, receiver string) channeltypes.Packet {
+	t.Helper()
+
+	data := ibctransfertypes.NewFungibleTokenPacketData(denom, "1000", sender, receiver, "")
+	return channeltypes.Packet{
+		Data:               data.GetBytes(),
+		SourcePort:         ibctransfertypes.PortID,
+		SourceChannel:      "channel-7",
+		DestinationPort:    ibctransfertypes.PortID,
+		DestinationChannel: "channel-0",
+	}
+}
+
+func TestIBCRecorderTransferIn(t *testing.T) {
+	ctx, k, trd := setupRecorder(t)
+	ack := channeltypes.NewResultAcknowledgement([]byte{1})
+	im := decorators.NewIBCRecorderModule(stubIBCModule{ack: ack}, trd, nil)
+
+	im.OnRecvPacket(ctx, ibctransfertypes.V1, transferPacket(t, "uatom", remote, delegator), nil)
+
+	// The token gains this chain's hop
+	denom := ibctransfertypes.NewDenom("uatom", ibctransfertypes.NewHop(ibctransfertypes.PortID, "channel-0"))
+	records := recordsByType(t, ctx, k, delegator)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom.IBCDenom(), 1000)), records[mychainkeeper.TxTypeIBCTransferIn].Amount)
+	require.Equal(t, remote, records[mychainkeeper.TxTypeIBCTransferIn].From)
+
+	// A rejected transfer is not recorded
+	ctx, k, trd = setupRecorder(t)
+	im = decorators.NewIBCRecorderModule(stubIBCModule{ack: channeltypes.NewErrorAcknowledgement(errors.New("rejected"))}, trd, nil)
+	im.OnRecvPacket(ctx, ibctransfertypes.V1, transferPacket(t, "uatom", remote, delegator), nil)
+	require.Empty(t, recordsByType(t, ctx, k, delegator))
+}
+
+func TestIBCRecorderRefunds(t *testing.T) {
+	packet := transferPacket(t, "stake", delegator, remote)
+	refunded := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+
+	ctx, k, trd := setupRecorder(t)
+	im := decorators.NewIBCRecorderModule(stubIBCModule{}, trd, nil)
+	require.NoError(t, im.OnTimeoutPacket(ctx, ibctransfertypes.V1, packet, nil))
+	require.Equal(t, refunded, recordsByType(t, ctx, k, delegator)[mychainkeeper.TxTypeIBCRefund].Amount)
+
+	ctx, k, trd = setupRecorder(t)
+	im = decorators.NewIBCRecorderModule(stubIBCModule{}, trd, nil)
+	errAck := channeltypes.NewErrorAcknowledgement(errors.New("rejected"))
+	require.NoError(t, im.OnAcknowledgementPacket(ctx, ibctransfertypes.V1, packet, errAck.Acknowledgement(), nil))
+	require.Equal(t, refunded, recordsByType(t, ctx, k, delegator)[mychainkeeper.TxTypeIBCRefund].Amount)
+
+	// A delivered transfer refunds nothing
+	ctx, k, trd = setupRecorder(t)
+	im = decorators.NewIBCRecorderModule(stubIBCModule{}, trd, nil)
+	okAck := channeltypes.NewResultAcknowledgement([]byte{1})
+	require.NoError(t, im.OnAcknowledgementPacket(ctx, ibctransfertypes.V1, packet, okAck.Acknowledgement(), nil))
+	require.Empty(t, recordsByType(t, ctx, k, delegator))
+}
+
+func TestIBCRecorderHostTx(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	distributiontypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	withdraw := &distributiontypes.MsgWithdrawDelegatorReward{DelegatorAddress: delegator, ValidatorAddress: validator}
+	bz, err := icatypes.SerializeCosmosTx(cdc, []proto.Message{withdraw}, icatypes.EncodingProtobuf)
+	require.NoError(t, err)
+	data := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: bz}
+	packet := channeltypes.Packet{
+		Data:               data.GetBytes(),
+		SourcePort:         "icacontroller-owner",
+		SourceChannel:      "channel-3",
+		DestinationPort:    icatypes.HostPortID,
+		DestinationChannel: "channel-1",
+	}
+
+	// The host re-emits the events of the messages it executed
+	ctx, k, trd := setupRecorder(t)
+	stub := stubIBCModule{
+		ack:    channeltypes.NewResultAcknowledgement([]byte{1}),
+		events: sdk.Events{withdrawRewardsEvent("1234stake")},
+	}
+	im := decorators.NewIBCRecorderModule(stub, trd, cdc)
+	im.OnRecvPacket(ctx, icatypes.NewDefaultMetadataString("connection-0", "connection-1"), packet, nil)
+
+	reward := recordsByType(t, ctx, k, delegator)[mychainkeeper.TxTypeStakingReward]
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1234)), reward.Amount)
+	require.Equal(t, validator, reward.GetStakingReward().Validator)
+}
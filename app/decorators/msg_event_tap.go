@@ -0,0 +1,49 @@
+package decorators
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// payoutMsgs are the messages whose handlers pay out rewards or commission
+// and emit the withdraw events for it.
+var payoutMsgs = map[string]bool{
+	sdk.MsgTypeURL(&distributiontypes.MsgWithdrawDelegatorReward{}):     true,
+	sdk.MsgTypeURL(&distributiontypes.MsgWithdrawValidatorCommission{}): true,
+	sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}):                         true,
+	sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}):                       true,
+	sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}):                  true,
+}
+
+// MsgEventTap is the circuit breaker of the msg service router. It defers to
+// the chain's circuit breaker and, while the recorder collects a tx, keeps the
+// event manager of every payout message it lets through. Message events never
+// reach the post handler otherwise.
+type MsgEventTap struct {
+	breaker baseapp.CircuitBreaker
+}
+
+// NewMsgEventTap wraps breaker, which may be nil.
+func NewMsgEventTap(breaker baseapp.CircuitBreaker) MsgEventTap {
+	return MsgEventTap{breaker: breaker}
+}
+
+// IsAllowed implements baseapp.CircuitBreaker. The router calls it with the
+// context the message handler is about to run with.
+func (t MsgEventTap) IsAllowed(ctx context.Context, typeURL string) (bool, error) {
+	if t.breaker != nil {
+		allowed, err := t.breaker.IsAllowed(ctx, typeURL)
+		if err != nil || !allowed {
+			return allowed, err
+		}
+	}
+
+	if rec, ok := ctx.Value(txRecordingKey{}).(*txRecording); ok && payoutMsgs[typeURL] {
+		rec.payoutEvents = append(rec.payoutEvents, sdk.UnwrapSDKContext(ctx).EventManager())
+	}
+	return true, nil
+}
@@ -1,82 +1,297 @@
 package decorators
 
 import (
+	"context"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
-	
-	mychainkeeper "mychain/x/mychain/keeper"
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+
 	maincointypes "mychain/x/maincoin/types"
+	mychainkeeper "mychain/x/mychain/keeper"
+	mychaintypes "mychain/x/mychain/types"
 )
 
-// TransactionRecorderDecorator records transactions for various message types
+// GroupKeeper is the part of the group query server the recorder uses to
+// look up the messages of executed proposals.
+type GroupKeeper interface {
+	Proposal(ctx context.Context, req *group.QueryProposalRequest) (*group.QueryProposalResponse, error)
+}
+
+// TransactionRecorderDecorator records transactions for various message types.
+// As an ante decorator it captures what can only be read before the messages
+// run; as a post decorator it records the messages of successful txs.
 type TransactionRecorderDecorator struct {
 	mychainKeeper *mychainkeeper.Keeper
+	groupKeeper   GroupKeeper
 }
 
 // NewTransactionRecorderDecorator creates a new TransactionRecorderDecorator
-func NewTransactionRecorderDecorator(mk *mychainkeeper.Keeper) TransactionRecorderDecorator {
+func NewTransactionRecorderDecorator(mk *mychainkeeper.Keeper, gk GroupKeeper) TransactionRecorderDecorator {
 	return TransactionRecorderDecorator{
 		mychainKeeper: mk,
+		groupKeeper:   gk,
+	}
+}
+
+// txRecording holds what the recorder collects while a tx runs. Executed
+// group proposals are pruned, so their messages are looked up before they
+// run. The fee is taken from the event the fee decorator emits, which is the
+// amount actually deducted and names the account that paid it. Rewards and
+// commission are read from the withdraw events of the messages that paid
+// them out, whose event managers the MsgEventTap collects.
+type txRecording struct {
+	proposals map[uint64][]sdk.Msg
+
+	fee      sdk.Coins
+	feePayer string
+
+	payoutEvents []sdk.EventManagerI
+	payouts      *payouts
+}
+
+type txRecordingKey struct{}
+
+// payouts are the rewards and commission withdrawn by a tx, in the order the
+// withdraw events were emitted.
+type payouts struct {
+	rewards     map[string][]sdk.Coins
+	commissions []sdk.Coins
+}
+
+func rewardKey(delegator, validator string) string {
+	return delegator + "/" + validator
+}
+
+// newPayouts reads the withdraw_rewards and withdraw_commission events.
+func newPayouts(events sdk.Events) *payouts {
+	p := &payouts{rewards: make(map[string][]sdk.Coins)}
+	for _, event := range events {
+		switch event.Type {
+		case distributiontypes.EventTypeWithdrawRewards:
+			delegator, _ := event.GetAttribute(distributiontypes.AttributeKeyDelegator)
+			validator, _ := event.GetAttribute(distributiontypes.AttributeKeyValidator)
+			key := rewardKey(delegator.Value, validator.Value)
+			p.rewards[key] = append(p.rewards[key], eventAmount(event))
+		case distributiontypes.EventTypeWithdrawCommission:
+			p.commissions = append(p.commissions, eventAmount(event))
+		}
+	}
+	return p
+}
+
+func eventAmount(event sdk.Event) sdk.Coins {
+	amount, ok := event.GetAttribute(sdk.AttributeKeyAmount)
+	if !ok {
+		return nil
 	}
+	coins, err := sdk.ParseCoinsNormalized(amount.Value)
+	if err != nil {
+		return nil
+	}
+	return coins
 }
 
 func (trd TransactionRecorderDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
-	// Don't record in simulation mode
-	if simulate {
+	// Let records written by message handlers know the size of the tx
+	ctx = mychainkeeper.WithTxMsgCount(ctx, len(tx.GetMsgs()))
+
+	// Nothing is recorded in CheckTx. Simulations collect like delivered txs
+	// so the proposal lookups are part of the gas estimate. Baseapp runs
+	// simulations on the CheckTx state, so they are told apart by simulate.
+	if !simulate && (ctx.IsCheckTx() || ctx.IsReCheckTx()) {
 		return next(ctx, tx, simulate)
 	}
-	
-	// Process the transaction first
-	newCtx, err = next(ctx, tx, simulate)
-	if err != nil {
-		return newCtx, err
-	}
-	
-	// Record transactions after successful processing
-	// We need to use the transaction hash
-	txHash := fmt.Sprintf("%X", ctx.TxBytes())
-	
-	msgs := tx.GetMsgs()
+
+	rec := &txRecording{proposals: make(map[uint64][]sdk.Msg)}
+	trd.lookupProposals(ctx, rec, tx.GetMsgs())
+	readFee(ctx, rec)
+
+	return next(ctx.WithValue(txRecordingKey{}, rec), tx, simulate)
+}
+
+// lookupProposals keeps the messages of the group proposals a tx executes.
+func (trd TransactionRecorderDecorator) lookupProposals(ctx sdk.Context, rec *txRecording, msgs []sdk.Msg) {
 	for _, msg := range msgs {
-		if err := trd.recordMessage(newCtx, msg, txHash); err != nil {
+		switch msg := msg.(type) {
+		case *authz.MsgExec:
+			if inner, err := msg.GetMessages(); err == nil {
+				trd.lookupProposals(ctx, rec, inner)
+			}
+
+		case *group.MsgExec:
+			if trd.groupKeeper == nil {
+				continue
+			}
+			res, err := trd.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: msg.ProposalId})
+			if err != nil {
+				continue
+			}
+			if inner, err := res.Proposal.GetMsgs(); err == nil {
+				rec.proposals[msg.ProposalId] = inner
+				trd.lookupProposals(ctx, rec, inner)
+			}
+		}
+	}
+}
+
+// readFee reads the fee and fee payer from the tx event the fee decorator
+// emitted.
+func readFee(ctx sdk.Context, rec *txRecording) {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != sdk.EventTypeTx {
+			continue
+		}
+		fee, hasFee := event.GetAttribute(sdk.AttributeKeyFee)
+		payer, hasPayer := event.GetAttribute(sdk.AttributeKeyFeePayer)
+		if !hasFee || !hasPayer {
+			continue
+		}
+		coins, err := sdk.ParseCoinsNormalized(fee.Value)
+		if err != nil {
+			continue
+		}
+		rec.fee = coins
+		rec.feePayer = payer.Value
+	}
+}
+
+// readPayouts reads the withdraw events of the payout messages once they
+// have all run.
+func (rec *txRecording) readPayouts() {
+	var events sdk.Events
+	for _, em := range rec.payoutEvents {
+		events = append(events, em.Events()...)
+	}
+	rec.payouts = newPayouts(events)
+}
+
+// takeRewards returns the rewards the next message touching a delegation
+// withdrew from it.
+func (rec *txRecording) takeRewards(delegator, validator string) sdk.Coins {
+	if rec == nil || rec.payouts == nil {
+		return nil
+	}
+	key := rewardKey(delegator, validator)
+	withdrawn := rec.payouts.rewards[key]
+	if len(withdrawn) == 0 {
+		return nil
+	}
+	rec.payouts.rewards[key] = withdrawn[1:]
+	return withdrawn[0]
+}
+
+// takeCommission returns the commission the next commission withdrawal paid.
+// The event does not name the validator, so withdrawals are matched in the
+// order they ran.
+func (rec *txRecording) takeCommission() sdk.Coins {
+	if rec == nil || rec.payouts == nil || len(rec.payouts.commissions) == 0 {
+		return nil
+	}
+	commission := rec.payouts.commissions[0]
+	rec.payouts.commissions = rec.payouts.commissions[1:]
+	return commission
+}
+
+// PostHandle records the messages of a tx once they have executed
+// successfully. Failed txs and CheckTx are never recorded. Simulations record
+// into a branch that is thrown away, so the gas estimate covers the writes.
+func (trd TransactionRecorderDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (newCtx sdk.Context, err error) {
+	if !success || (!simulate && (ctx.IsCheckTx() || ctx.IsReCheckTx())) {
+		return next(ctx, tx, simulate, success)
+	}
+
+	recordCtx := ctx
+	if simulate {
+		recordCtx, _ = ctx.CacheContext()
+	}
+
+	rec, _ := ctx.Value(txRecordingKey{}).(*txRecording)
+	if rec != nil {
+		rec.readPayouts()
+	}
+
+	// Gas is read before recording, which consumes gas itself
+	gasUsed := ctx.GasMeter().GasConsumed()
+
+	for i, msg := range tx.GetMsgs() {
+		if err := trd.recordMessage(mychainkeeper.WithMsgIndex(recordCtx, i), msg, rec); err != nil {
 			// Log error but don't fail the transaction
 			ctx.Logger().Error("failed to record transaction", "error", err, "msg_type", sdk.MsgTypeURL(msg))
 		}
 	}
-	
-	return newCtx, nil
+
+	if rec != nil && rec.feePayer != "" && !rec.fee.IsZero() {
+		var gasWanted uint64
+		if feeTx, ok := tx.(sdk.FeeTx); ok {
+			gasWanted = feeTx.GetGas()
+		}
+		record := mychainkeeper.NewTransactionRecord(
+			rec.feePayer,
+			mychainkeeper.TxTypeFee,
+			fmt.Sprintf("Paid %s in fees (gas used %d of %d)", rec.fee.String(), gasUsed, gasWanted),
+			rec.fee,
+			rec.feePayer,
+			authtypes.FeeCollectorName,
+		)
+		record.Details = &mychaintypes.TransactionRecord_Fee{Fee: &mychaintypes.FeeDetails{
+			GasWanted: gasWanted,
+			GasUsed:   gasUsed,
+			MsgCount:  uint64(len(tx.GetMsgs())),
+		}}
+		if err := trd.mychainKeeper.SaveTransactionHistory(recordCtx, record); err != nil {
+			ctx.Logger().Error("failed to record transaction fee", "error", err)
+		}
+	}
+
+	return next(ctx, tx, simulate, success)
 }
 
-func (trd TransactionRecorderDecorator) recordMessage(ctx sdk.Context, msg sdk.Msg, txHash string) error {
+func (trd TransactionRecorderDecorator) recordMessage(ctx sdk.Context, msg sdk.Msg, rec *txRecording) error {
 	recorder := mychainkeeper.NewTransactionRecorder(trd.mychainKeeper)
-	
+
 	switch msg := msg.(type) {
 	case *banktypes.MsgSend:
-		recorder.RecordBankTransfer(ctx, msg.FromAddress, msg.ToAddress, msg.Amount, txHash)
-		
+		recorder.RecordBankTransfer(ctx, msg.FromAddress, msg.ToAddress, msg.Amount)
+
 	case *banktypes.MsgMultiSend:
 		for _, input := range msg.Inputs {
 			for _, output := range msg.Outputs {
-				recorder.RecordBankTransfer(ctx, input.Address, output.Address, output.Coins, txHash)
+				recorder.RecordBankTransfer(ctx, input.Address, output.Address, output.Coins)
 			}
 		}
-		
+
 	case *distributiontypes.MsgWithdrawDelegatorReward:
-		// This will be handled by the distribution module hooks
-		
+		rewards := rec.takeRewards(msg.DelegatorAddress, msg.ValidatorAddress)
+		return recorder.RecordStakingReward(ctx, msg.DelegatorAddress, rewards, msg.ValidatorAddress)
+
 	case *distributiontypes.MsgWithdrawValidatorCommission:
-		// This will be handled by the distribution module hooks
-		
+		commission := rec.takeCommission()
+		// Commission is paid to the validator operator's account
+		valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		operator := sdk.AccAddress(valAddr).String()
+		record := mychainkeeper.NewTransactionRecord(
+			operator,
+			mychainkeeper.TxTypeCommission,
+			fmt.Sprintf("Withdrew %s validator commission", commission.String()),
+			commission,
+			msg.ValidatorAddress,
+			operator,
+		)
+		return recorder.RecordTransaction(ctx, record)
+
 	case *stakingtypes.MsgDelegate:
 		// Record delegation
-		height := ctx.BlockHeight()
-		timestamp := ctx.BlockTime()
-		
 		record := mychainkeeper.NewTransactionRecord(
 			msg.DelegatorAddress,
 			mychainkeeper.TxTypeDelegate,
@@ -84,17 +299,12 @@ func (trd TransactionRecorderDecorator) recordMessage(ctx sdk.Context, msg sdk.M
 			sdk.NewCoins(msg.Amount),
 			msg.DelegatorAddress,
 			msg.ValidatorAddress,
-			txHash,
-			height,
-			timestamp,
 		)
 		recorder.RecordTransaction(ctx, record)
-		
+		trd.recordClaimedRewards(ctx, recorder, rec, msg.DelegatorAddress, msg.ValidatorAddress)
+
 	case *stakingtypes.MsgUndelegate:
 		// Record undelegation
-		height := ctx.BlockHeight()
-		timestamp := ctx.BlockTime()
-		
 		record := mychainkeeper.NewTransactionRecord(
 			msg.DelegatorAddress,
 			mychainkeeper.TxTypeUndelegate,
@@ -102,17 +312,12 @@ func (trd TransactionRecorderDecorator) recordMessage(ctx sdk.Context, msg sdk.M
 			sdk.NewCoins(msg.Amount),
 			msg.ValidatorAddress,
 			msg.DelegatorAddress,
-			txHash,
-			height,
-			timestamp,
 		)
 		recorder.RecordTransaction(ctx, record)
-		
+		trd.recordClaimedRewards(ctx, recorder, rec, msg.DelegatorAddress, msg.ValidatorAddress)
+
 	case *stakingtypes.MsgBeginRedelegate:
 		// Record redelegation
-		height := ctx.BlockHeight()
-		timestamp := ctx.BlockTime()
-		
 		record := mychainkeeper.NewTransactionRecord(
 			msg.DelegatorAddress,
 			mychainkeeper.TxTypeRedelegate,
@@ -120,28 +325,112 @@ func (trd TransactionRecorderDecorator) recordMessage(ctx sdk.Context, msg sdk.M
 			sdk.NewCoins(msg.Amount),
 			msg.ValidatorSrcAddress,
 			msg.ValidatorDstAddress,
-			txHash,
-			height,
-			timestamp,
 		)
 		recorder.RecordTransaction(ctx, record)
-		
+		trd.recordClaimedRewards(ctx, recorder, rec, msg.DelegatorAddress, msg.ValidatorSrcAddress)
+		trd.recordClaimedRewards(ctx, recorder, rec, msg.DelegatorAddress, msg.ValidatorDstAddress)
+
+	case *ibctransfertypes.MsgTransfer:
+		record := mychainkeeper.NewTransactionRecord(
+			msg.Sender,
+			mychainkeeper.TxTypeIBCTransferOut,
+			fmt.Sprintf("Sent %s to %s over %s", msg.Token.String(), shortenAddress(msg.Receiver), msg.SourceChannel),
+			sdk.NewCoins(msg.Token),
+			msg.Sender,
+			msg.Receiver,
+		)
+		return recorder.RecordTransaction(ctx, record)
+
+	case *icacontrollertypes.MsgSendTx:
+		record := mychainkeeper.NewTransactionRecord(
+			msg.Owner,
+			mychainkeeper.TxTypeICASendTx,
+			fmt.Sprintf("Sent interchain account tx over %s", msg.ConnectionId),
+			nil,
+			msg.Owner,
+			msg.ConnectionId,
+		)
+		return recorder.RecordTransaction(ctx, record)
+
 	case *maincointypes.MsgBuyMaincoin:
 		// This will be handled in the maincoin keeper
-		
+
 	case *maincointypes.MsgSellMaincoin:
 		// This will be handled in the maincoin keeper
-		
+
 	case *authz.MsgExec:
-		// TODO: Handle nested messages in authz
+		inner, err := msg.GetMessages()
+		if err != nil {
+			return err
+		}
+		record := mychainkeeper.NewTransactionRecord(
+			msg.Grantee,
+			mychainkeeper.TxTypeAuthzExec,
+			fmt.Sprintf("Executed %d message(s) through an authz grant", len(inner)),
+			nil,
+			msg.Grantee,
+			"",
+		)
+		if err := recorder.RecordTransaction(ctx, record); err != nil {
+			return err
+		}
+		// Nested messages are recorded for the granters that signed them
+		for _, m := range inner {
+			if err := trd.recordMessage(ctx, m, rec); err != nil {
+				return err
+			}
+		}
+
+	case *group.MsgExec:
+		if rec == nil || trd.groupKeeper == nil {
+			return nil
+		}
+		inner, ok := rec.proposals[msg.ProposalId]
+		if !ok {
+			return nil
+		}
+		// Successfully executed proposals are pruned; a proposal that is
+		// still stored only ran if its executor result says so
+		if res, err := trd.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: msg.ProposalId}); err == nil &&
+			res.Proposal.ExecutorResult != group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
+			return nil
+		}
+		record := mychainkeeper.NewTransactionRecord(
+			msg.Executor,
+			mychainkeeper.TxTypeGroupExec,
+			fmt.Sprintf("Executed group proposal #%d", msg.ProposalId),
+			nil,
+			msg.Executor,
+			"",
+		)
+		if err := recorder.RecordTransaction(ctx, record); err != nil {
+			return err
+		}
+		for _, m := range inner {
+			if err := trd.recordMessage(ctx, m, rec); err != nil {
+				return err
+			}
+		}
 	}
-	
+
 	return nil
 }
 
+// recordClaimedRewards records the rewards a delegation change withdrew
+// automatically, if there were any.
+func (trd TransactionRecorderDecorator) recordClaimedRewards(ctx sdk.Context, recorder *mychainkeeper.TransactionRecorder, rec *txRecording, delegator, validator string) {
+	rewards := rec.takeRewards(delegator, validator)
+	if rewards.IsZero() {
+		return
+	}
+	if err := recorder.RecordStakingReward(ctx, delegator, rewards, validator); err != nil {
+		ctx.Logger().Error("failed to record claimed rewards", "error", err)
+	}
+}
+
 func shortenAddress(address string) string {
 	if len(address) > 10 {
 		return address[:6] + "..." + address[len(address)-4:]
 	}
 	return address
-}
\ No newline at end of file
+}
@@ -0,0 +1,214 @@
+package decorators_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"mychain/app/decorators"
+	mychainkeeper "mychain/x/mychain/keeper"
+	mychainmodule "mychain/x/mychain/module"
+	mychaintypes "mychain/x/mychain/types"
+)
+
+type testTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx testTx) GetMsgs() []sdk.Msg { return tx.msgs }
+
+func (tx testTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+
+var (
+	delegator = sdk.AccAddress([]byte("delegator___________")).String()
+	grantee   = sdk.AccAddress([]byte("grantee_____________")).String()
+	validator = sdk.ValAddress([]byte("validator___________")).String()
+)
+
+func setupRecorder(t *testing.T) (sdk.Context, *mychainkeeper.Keeper, decorators.TransactionRecorderDecorator) {
+	t.Helper()
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(mychainmodule.AppModule{})
+	storeKey := storetypes.NewKVStoreKey(mychaintypes.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	k := mychainkeeper.NewKeeper(
+		runtime.NewKVStoreService(storeKey),
+		encCfg.Codec,
+		addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+		authtypes.NewModuleAddress(mychaintypes.GovModuleName),
+		nil,
+		nil,
+	)
+	require.NoError(t, k.Params.Set(ctx, mychaintypes.DefaultParams()))
+
+	return ctx.WithBlockHeight(10), &k, decorators.NewTransactionRecorderDecorator(&k, nil)
+}
+
+// runTx runs tx through the recorder the way baseapp does: the fee decorator
+// emits the tx event, each message runs with its own event manager behind
+// the circuit breaker and the post handler gets a fresh one. execute runs
+// the message at each index with the context its handler gets. A ctx in
+// simulate mode runs tx as a simulation.
+func runTx(t *testing.T, ctx sdk.Context, trd decorators.TransactionRecorderDecorator, tx testTx, success bool, execute func(i int, ctx sdk.Context)) {
+	t.Helper()
+
+	simulate := ctx.ExecMode() == sdk.ExecModeSimulate
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	ctx.EventManager().EmitEvent(sdk.NewEvent(sdk.EventTypeTx,
+		sdk.NewAttribute(sdk.AttributeKeyFee, "500stake"),
+		sdk.NewAttribute(sdk.AttributeKeyFeePayer, delegator),
+	))
+
+	ctx, err := trd.AnteHandle(ctx, tx, simulate, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	})
+	require.NoError(t, err)
+
+	for i, msg := range tx.msgs {
+		execute(i, routeMsg(t, ctx, msg))
+	}
+
+	_, err = trd.PostHandle(ctx.WithEventManager(sdk.NewEventManager()), tx, simulate, success, func(ctx sdk.Context, _ sdk.Tx, _, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	})
+	require.NoError(t, err)
+}
+
+// routeMsg returns the context the msg service router runs msg with.
+func routeMsg(t *testing.T, ctx sdk.Context, msg sdk.Msg) sdk.Context {
+	t.Helper()
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	allowed, err := decorators.NewMsgEventTap(nil).IsAllowed(ctx, sdk.MsgTypeURL(msg))
+	require.NoError(t, err)
+	require.True(t, allowed)
+	return ctx
+}
+
+func withdrawRewardsEvent(amount string) sdk.Event {
+	return sdk.NewEvent(distributiontypes.EventTypeWithdrawRewards,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount),
+		sdk.NewAttribute(distributiontypes.AttributeKeyValidator, validator),
+		sdk.NewAttribute(distributiontypes.AttributeKeyDelegator, delegator),
+	)
+}
+
+func recordsByType(t *testing.T, ctx sdk.Context, k *mychainkeeper.Keeper, address string) map[string]mychaintypes.TransactionRecord {
+	t.Helper()
+
+	records, err := k.GetTransactionHistory(ctx, address, 100)
+	require.NoError(t, err)
+	byType := make(map[string]mychaintypes.TransactionRecord)
+	for _, record := range records {
+		byType[record.Type] = record
+	}
+	return byType
+}
+
+func TestRecorderRecordsFee(t *testing.T) {
+	ctx, k, trd := setupRecorder(t)
+
+	tx := testTx{msgs: []sdk.Msg{&authz.MsgRevoke{Granter: delegator, Grantee: grantee, MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend"}}}
+	runTx(t, ctx, trd, tx, true, func(int, sdk.Context) {})
+
+	records := recordsByType(t, ctx, k, delegator)
+	require.Len(t, records, 1)
+	fee := records[mychainkeeper.TxTypeFee]
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 500)), fee.Amount)
+	require.Equal(t, authtypes.FeeCollectorName, fee.To)
+	require.Equal(t, uint64(1), fee.GetFee().MsgCount)
+}
+
+func TestRecorderReadsWithdrawEvents(t *testing.T) {
+	ctx, k, trd := setupRecorder(t)
+
+	tx := testTx{msgs: []sdk.Msg{
+		&distributiontypes.MsgWithdrawDelegatorReward{DelegatorAddress: delegator, ValidatorAddress: validator},
+		&distributiontypes.MsgWithdrawValidatorCommission{ValidatorAddress: validator},
+	}}
+	runTx(t, ctx, trd, tx, true, func(i int, msgCtx sdk.Context) {
+		if i == 0 {
+			msgCtx.EventManager().EmitEvent(withdrawRewardsEvent("1234stake"))
+			return
+		}
+		msgCtx.EventManager().EmitEvent(sdk.NewEvent(distributiontypes.EventTypeWithdrawCommission,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, "77stake"),
+		))
+	})
+
+	records := recordsByType(t, ctx, k, delegator)
+	reward := records[mychainkeeper.TxTypeStakingReward]
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1234)), reward.Amount)
+	require.Equal(t, validator, reward.GetStakingReward().Validator)
+
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	require.NoError(t, err)
+	operator := recordsByType(t, ctx, k, sdk.AccAddress(valAddr).String())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 77)), operator[mychainkeeper.TxTypeCommission].Amount)
+}
+
+func TestRecorderSkipsFailedTx(t *testing.T) {
+	ctx, k, trd := setupRecorder(t)
+
+	tx := testTx{msgs: []sdk.Msg{
+		&distributiontypes.MsgWithdrawDelegatorReward{DelegatorAddress: delegator, ValidatorAddress: validator},
+	}}
+	runTx(t, ctx, trd, tx, false, func(_ int, msgCtx sdk.Context) {
+		msgCtx.EventManager().EmitEvent(withdrawRewardsEvent("1234stake"))
+	})
+
+	require.Empty(t, recordsByType(t, ctx, k, delegator))
+}
+
+func TestRecorderChargesGasInSimulation(t *testing.T) {
+	tx := testTx{msgs: []sdk.Msg{
+		&distributiontypes.MsgWithdrawDelegatorReward{DelegatorAddress: delegator, ValidatorAddress: validator},
+	}}
+	withdraw := func(_ int, msgCtx sdk.Context) {
+		msgCtx.EventManager().EmitEvent(withdrawRewardsEvent("1234stake"))
+	}
+
+	ctx, _, trd := setupRecorder(t)
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	runTx(t, ctx, trd, tx, true, withdraw)
+
+	// baseapp simulates on the CheckTx state
+	simCtx, k, trd := setupRecorder(t)
+	simCtx = simCtx.WithGasMeter(storetypes.NewInfiniteGasMeter()).WithIsCheckTx(true).WithExecMode(sdk.ExecModeSimulate)
+	runTx(t, simCtx, trd, tx, true, withdraw)
+
+	require.Equal(t, ctx.GasMeter().GasConsumed(), simCtx.GasMeter().GasConsumed())
+	require.Empty(t, recordsByType(t, simCtx, k, delegator))
+}
+
+func TestRecorderAuthzWrappedWithdraw(t *testing.T) {
+	ctx, k, trd := setupRecorder(t)
+
+	granteeAddr, err := sdk.AccAddressFromBech32(grantee)
+	require.NoError(t, err)
+	withdraw := &distributiontypes.MsgWithdrawDelegatorReward{DelegatorAddress: delegator, ValidatorAddress: validator}
+	exec := authz.NewMsgExec(granteeAddr, []sdk.Msg{withdraw})
+
+	// authz dispatches the inner message through the router and re-emits
+	// its events; the payout must only be counted once
+	runTx(t, ctx, trd, testTx{msgs: []sdk.Msg{&exec}}, true, func(_ int, execCtx sdk.Context) {
+		innerCtx := routeMsg(t, execCtx, withdraw)
+		innerCtx.EventManager().EmitEvent(withdrawRewardsEvent("1234stake"))
+		execCtx.EventManager().EmitEvents(innerCtx.EventManager().Events())
+	})
+
+	records := recordsByType(t, ctx, k, delegator)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1234)), records[mychainkeeper.TxTypeStakingReward].Amount)
+	require.Contains(t, recordsByType(t, ctx, k, grantee), mychainkeeper.TxTypeAuthzExec)
+}
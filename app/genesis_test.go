@@ -0,0 +1,154 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	dextypes "mychain/x/dex/types"
+	maincointypes "mychain/x/maincoin/types"
+	mychaintypes "mychain/x/mychain/types"
+	testusdtypes "mychain/x/testusd/types"
+)
+
+// TestInitChainCustomGenesis boots the app from a genesis that differs from
+// the defaults in every chain module and checks that InitChain applied it.
+func TestInitChainCustomGenesis(t *testing.T) {
+	app, genesisState := newTestApp(t)
+	cdc := app.AppCodec()
+
+	mychainGenesis := mychaintypes.DefaultGenesis()
+	mychainGenesis.TxHistorySeq = 9
+	genesisState[mychaintypes.ModuleName] = cdc.MustMarshalJSON(mychainGenesis)
+
+	testusdGenesis := testusdtypes.DefaultGenesis()
+	testusdGenesis.NextBridgeRecordSequence = 3
+	testusdGenesis.NextWithdrawalId = 5
+	genesisState[testusdtypes.ModuleName] = cdc.MustMarshalJSON(testusdGenesis)
+
+	maincoinGenesis := maincointypes.DefaultGenesis()
+	maincoinGenesis.CurrentEpoch = 3
+	maincoinGenesis.CurrentPrice = sdkmath.LegacyMustNewDecFromStr("0.0002")
+	maincoinGenesis.ReserveBalance = sdkmath.NewInt(5_000_000)
+	maincoinGenesis.DevAllocationTotal = sdkmath.NewInt(100)
+	maincoinGenesis.PendingDevAllocation = sdkmath.NewInt(7)
+	genesisState[maincointypes.ModuleName] = cdc.MustMarshalJSON(maincoinGenesis)
+
+	dexGenesis := dextypes.DefaultGenesis()
+	dexGenesis.Params.MinOrderAmount = sdkmath.NewInt(12345)
+	dexGenesis.TradingPairs = dexGenesis.TradingPairs[:1]
+	dexGenesis.LcTotalSupply = sdkmath.NewInt(42)
+	dexGenesis.NextTradeId = 4
+	dexGenesis.AppliedFixes = []string{dextypes.FixCrossedOrders, dextypes.FixOrder18}
+	genesisState[dextypes.ModuleName] = cdc.MustMarshalJSON(dexGenesis)
+
+	initChain(t, app, genesisState)
+
+	ctx := app.NewContext(true)
+
+	// every module in the InitGenesis order must take part in genesis
+	exported, err := app.ModuleManager.ExportGenesis(ctx, cdc)
+	require.NoError(t, err)
+	for _, name := range app.ModuleManager.OrderInitGenesis {
+		if genesisState[name] == nil {
+			continue
+		}
+		require.Contains(t, exported, name, "module %s does not implement genesis", name)
+	}
+
+	gotMychain, err := app.MychainKeeper.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.JSONEq(t, string(cdc.MustMarshalJSON(&mychainGenesis.Params)), string(cdc.MustMarshalJSON(&gotMychain.Params)))
+	require.Equal(t, mychainGenesis.TxHistorySeq, gotMychain.TxHistorySeq)
+
+	gotTestusd, err := app.TestusdKeeper.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.JSONEq(t, string(cdc.MustMarshalJSON(&testusdGenesis.Params)), string(cdc.MustMarshalJSON(&gotTestusd.Params)))
+	require.Equal(t, testusdGenesis.NextBridgeRecordSequence, gotTestusd.NextBridgeRecordSequence)
+	require.Equal(t, testusdGenesis.NextWithdrawalId, gotTestusd.NextWithdrawalId)
+
+	gotMaincoin, err := app.MaincoinKeeper.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.JSONEq(t, string(cdc.MustMarshalJSON(&maincoinGenesis.Params)), string(cdc.MustMarshalJSON(&gotMaincoin.Params)))
+	require.Equal(t, maincoinGenesis.CurrentEpoch, gotMaincoin.CurrentEpoch)
+	require.True(t, maincoinGenesis.CurrentPrice.Equal(gotMaincoin.CurrentPrice))
+	require.True(t, maincoinGenesis.ReserveBalance.Equal(gotMaincoin.ReserveBalance))
+	require.True(t, maincoinGenesis.DevAllocationTotal.Equal(gotMaincoin.DevAllocationTotal))
+	require.True(t, maincoinGenesis.PendingDevAllocation.Equal(gotMaincoin.PendingDevAllocation))
+
+	gotDex, err := app.DexKeeper.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.JSONEq(t, string(cdc.MustMarshalJSON(&dexGenesis.Params)), string(cdc.MustMarshalJSON(&gotDex.Params)))
+	require.Equal(t, dexGenesis.TradingPairs, gotDex.TradingPairs)
+	require.Equal(t, dexGenesis.LiquidityTiers, gotDex.LiquidityTiers)
+	require.True(t, dexGenesis.LcTotalSupply.Equal(gotDex.LcTotalSupply))
+	require.Equal(t, dexGenesis.NextTradeId, gotDex.NextTradeId)
+	require.Equal(t, dexGenesis.AppliedFixes, gotDex.AppliedFixes)
+}
+
+// newTestApp returns an app and a default genesis with a single validator.
+func newTestApp(t *testing.T) (*App, GenesisState) {
+	t.Helper()
+
+	appOptions := make(simtestutil.AppOptionsMap, 0)
+	appOptions[flags.FlagHome] = t.TempDir()
+
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, appOptions, baseapp.SetChainID(SimAppChainID))
+
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+
+	acc := authtypes.NewBaseAccount(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()), nil, 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100_000_000_000_000))),
+	}
+
+	genesisState, err := simtestutil.GenesisStateWithValSet(app.AppCodec(), app.DefaultGenesis(), valSet, []authtypes.GenesisAccount{acc}, balance)
+	require.NoError(t, err)
+
+	return app, genesisState
+}
+
+// initChain initializes the chain from genesisState and commits the first block.
+func initChain(t *testing.T, app *App, genesisState GenesisState) {
+	t.Helper()
+
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+
+	genesisTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, err = app.InitChain(&abci.RequestInitChain{
+		ChainId:         SimAppChainID,
+		Time:            genesisTime,
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	require.NoError(t, err)
+	_, err = app.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: 1,
+		Time:   genesisTime.Add(time.Second),
+		Hash:   app.LastCommitID().Hash,
+	})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+}
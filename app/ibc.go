@@ -29,6 +29,9 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 	solomachine "github.com/cosmos/ibc-go/v10/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+
+	"mychain/app/decorators"
+	testusdmodule "mychain/x/testusd/module"
 )
 
 // registerIBCModules register IBC keepers and non dependency inject modules.
@@ -105,6 +108,15 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 		icaHostStack       porttypes.IBCModule = icahost.NewIBCModule(app.ICAHostKeeper)
 	)
 
+	// bridge USDC received over ICS-20 into TestUSD
+	app.TestusdKeeper.SetTransferKeeper(app.TransferKeeper)
+	transferStack = testusdmodule.NewIBCMiddleware(transferStack, app.TestusdKeeper)
+
+	// record incoming transfers, refunds and host-executed interchain account txs
+	txRecorder := decorators.NewTransactionRecorderDecorator(&app.MychainKeeper, app.GroupKeeper)
+	transferStack = decorators.NewIBCRecorderModule(transferStack, txRecorder, app.appCodec)
+	icaHostStack = decorators.NewIBCRecorderModule(icaHostStack, txRecorder, app.appCodec)
+
 	// create static IBC router, add transfer route, then set it on the keeper
 	ibcRouter := porttypes.NewRouter().
 		AddRoute(ibctransfertypes.ModuleName, transferStack).
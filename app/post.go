@@ -0,0 +1,21 @@
+package app
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"mychain/app/decorators"
+)
+
+// NewPostHandler creates the post handler that records transaction history
+// after a tx's messages have executed
+func NewPostHandler(options HandlerOptions) (sdk.PostHandler, error) {
+	if options.MychainKeeper == nil {
+		return nil, errors.Wrap(sdkerrors.ErrLogic, "mychain keeper is required")
+	}
+
+	return sdk.ChainPostDecorators(
+		decorators.NewTransactionRecorderDecorator(options.MychainKeeper, options.GroupKeeper),
+	), nil
+}
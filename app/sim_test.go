@@ -11,7 +11,9 @@ import (
 	"testing"
 	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/feegrant"
@@ -22,14 +24,24 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	simulationtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
+
+	dexkeeper "mychain/x/dex/keeper"
+	dextypes "mychain/x/dex/types"
+	maincoinsimulation "mychain/x/maincoin/simulation"
+	maincointypes "mychain/x/maincoin/types"
 )
 
 const (
@@ -56,6 +68,107 @@ func interBlockCacheOpt() func(*baseapp.BaseApp) {
 	return baseapp.SetInterBlockCache(store.NewCommitKVStoreCacheManager())
 }
 
+// simFunds are added to every simulation account at genesis so the custom
+// modules' operations have the denoms they trade in: TestUSD for maincoin
+// purchases and dex pair 1, USDC to bridge into x/testusd, and LC for dex
+// pair 2 and its fees.
+var simFunds = sdk.NewCoins(
+	sdk.NewCoin(maincointypes.TestUSDDenom, sdkmath.NewInt(10_000_000_000)),
+	sdk.NewCoin(maincoinsimulation.USDCDenom, sdkmath.NewInt(10_000_000_000_000)),
+	sdk.NewCoin("ulc", sdkmath.NewInt(10_000_000_000_000)),
+)
+
+// simAppStateFn returns the randomized genesis of app with simFunds added to
+// each simulation account.
+func simAppStateFn(app *App) simulationtypes.AppStateFn {
+	cdc := app.AppCodec()
+	return simtestutil.AppStateFnWithExtendedCb(cdc, app.SimulationManager(), app.DefaultGenesis(), func(rawState map[string]json.RawMessage) {
+		bankState := new(banktypes.GenesisState)
+		cdc.MustUnmarshalJSON(rawState[banktypes.ModuleName], bankState)
+
+		notBondedPool := authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName).String()
+		added := sdk.NewCoins()
+		for i, balance := range bankState.Balances {
+			if balance.Address == notBondedPool {
+				continue
+			}
+			bankState.Balances[i].Coins = balance.Coins.Add(simFunds...)
+			added = added.Add(simFunds...)
+		}
+		// An empty supply is computed from the balances at InitGenesis
+		if !bankState.Supply.IsZero() {
+			bankState.Supply = bankState.Supply.Add(added...)
+		}
+
+		rawState[banktypes.ModuleName] = cdc.MustMarshalJSON(bankState)
+	})
+}
+
+// invariantRegistry collects the invariants modules register.
+type invariantRegistry struct {
+	routes []invariantRoute
+}
+
+type invariantRoute struct {
+	module, route string
+	invariant     sdk.Invariant
+}
+
+func (ir *invariantRegistry) RegisterRoute(moduleName, route string, invariant sdk.Invariant) {
+	ir.routes = append(ir.routes, invariantRoute{module: moduleName, route: route, invariant: invariant})
+}
+
+// simulationOperations returns the weighted operations of app, each checking
+// the module invariants: before the first operation of every block, and
+// after every operation when config.OnOperation is set. A broken invariant
+// fails the operation, and with it the simulation.
+func simulationOperations(app *App, config simulationtypes.Config) []simulationtypes.WeightedOperation {
+	ir := new(invariantRegistry)
+	for _, name := range app.ModuleManager.OrderInitGenesis {
+		if m, ok := app.ModuleManager.Modules[name].(module.HasInvariants); ok {
+			m.RegisterInvariants(ir)
+		}
+	}
+
+	check := func(ctx sdk.Context) error {
+		var broken []string
+		for _, r := range ir.routes {
+			if msg, isBroken := r.invariant(ctx); isBroken {
+				broken = append(broken, fmt.Sprintf("%s/%s: %s", r.module, r.route, msg))
+				if !config.AllInvariants {
+					break
+				}
+			}
+		}
+		if len(broken) > 0 {
+			return fmt.Errorf("invariants broken at height %d:\n%s", ctx.BlockHeight(), strings.Join(broken, "\n"))
+		}
+		return nil
+	}
+
+	checkedHeight := int64(-1)
+	ops := simtestutil.SimulationOperations(app, app.AppCodec(), config)
+	for i, op := range ops {
+		inner := op.Op()
+		ops[i] = simulation.NewWeightedOperation(op.Weight(), func(
+			r *rand.Rand, bApp *baseapp.BaseApp, ctx sdk.Context, accs []simulationtypes.Account, chainID string,
+		) (simulationtypes.OperationMsg, []simulationtypes.FutureOperation, error) {
+			if ctx.BlockHeight() != checkedHeight {
+				checkedHeight = ctx.BlockHeight()
+				if err := check(ctx); err != nil {
+					return simulationtypes.NoOpMsg("invariants", "", "broken invariant"), nil, err
+				}
+			}
+			opMsg, futureOps, err := inner(r, bApp, ctx, accs, chainID)
+			if err == nil && config.OnOperation {
+				err = check(ctx)
+			}
+			return opMsg, futureOps, err
+		})
+	}
+	return ops
+}
+
 // BenchmarkSimulation run the chain simulation
 // Running using starport command:
 // `ignite chain simulate -v --numBlocks 200 --blockSize 50`
@@ -92,9 +205,9 @@ func BenchmarkSimulation(b *testing.B) {
 		b,
 		os.Stdout,
 		bApp.BaseApp,
-		simtestutil.AppStateFn(bApp.AppCodec(), bApp.SimulationManager(), bApp.DefaultGenesis()),
+		simAppStateFn(bApp),
 		simulationtypes.RandomAccounts,
-		simtestutil.SimulationOperations(bApp, bApp.AppCodec(), config),
+		simulationOperations(bApp, config),
 		BlockedAddresses(),
 		config,
 		bApp.AppCodec(),
@@ -139,9 +252,9 @@ func TestFullAppSimulation(t *testing.T) {
 		t,
 		os.Stdout,
 		app.BaseApp,
-		simtestutil.AppStateFn(app.AppCodec(), app.SimulationManager(), app.DefaultGenesis()),
+		simAppStateFn(app),
 		simulationtypes.RandomAccounts,
-		simtestutil.SimulationOperations(app, app.AppCodec(), config),
+		simulationOperations(app, config),
 		BlockedAddresses(),
 		config,
 		app.AppCodec(),
@@ -183,9 +296,9 @@ func TestAppImportExport(t *testing.T) {
 		t,
 		os.Stdout,
 		bApp.BaseApp,
-		simtestutil.AppStateFn(bApp.AppCodec(), bApp.SimulationManager(), bApp.DefaultGenesis()),
+		simAppStateFn(bApp),
 		simulationtypes.RandomAccounts,
-		simtestutil.SimulationOperations(bApp, bApp.AppCodec(), config),
+		simulationOperations(bApp, config),
 		BlockedAddresses(),
 		config,
 		bApp.AppCodec(),
@@ -200,6 +313,11 @@ func TestAppImportExport(t *testing.T) {
 		simtestutil.PrintStats(db)
 	}
 
+	// write one of everything the dex module tracks, whatever the
+	// simulation happened to reach, to make sure all of it survives export
+	// and import
+	seedDexState(t, bApp)
+
 	fmt.Printf("exporting genesis...\n")
 
 	exported, err := bApp.ExportAppStateAndValidators(false, []string{}, []string{})
@@ -251,8 +369,27 @@ func TestAppImportExport(t *testing.T) {
 		authzkeeper.StoreKey:   {authzkeeper.GrantQueuePrefix},
 		feegrant.StoreKey:      {feegrant.FeeAllowanceQueueKeyPrefix},
 		slashingtypes.StoreKey: {slashingtypes.ValidatorMissedBlockBitmapKeyPrefix},
+		// the segments purchases advanced at a height only count within that
+		// block and are cleared by the next purchase, so they are not exported
+		maincointypes.StoreKey: {maincointypes.BlockSegmentsKey},
 	}
 
+	// compare the exported dex genesis first so a mismatch is reported field
+	// by field rather than as raw store entries
+	dexA, err := bApp.DexKeeper.ExportGenesis(ctxA)
+	require.NoError(t, err)
+	dexB, err := newApp.DexKeeper.ExportGenesis(ctxB)
+	require.NoError(t, err)
+	require.Equal(t, dexA, dexB)
+	require.NotEmpty(t, dexA.Trades)
+	require.NotEmpty(t, dexA.AppliedFixes)
+	require.NotNil(t, dexA.LcPriceHistory)
+	require.NotNil(t, dexA.DynamicRewardState)
+
+	dexKVAs, dexKVBs := simtestutil.DiffKVStores(ctxA.KVStore(bApp.GetKey(dextypes.StoreKey)), ctxB.KVStore(newApp.GetKey(dextypes.StoreKey)), nil)
+	require.Empty(t, dexKVAs, simtestutil.GetSimulationLog(dextypes.StoreKey, bApp.SimulationManager().StoreDecoders, dexKVAs, dexKVBs))
+	require.Empty(t, dexKVBs)
+
 	storeKeys := bApp.GetStoreKeys()
 	require.NotEmpty(t, storeKeys)
 
@@ -275,6 +412,92 @@ func TestAppImportExport(t *testing.T) {
 
 		require.Equal(t, 0, len(failedKVAs), simtestutil.GetSimulationLog(keyName, bApp.SimulationManager().StoreDecoders, failedKVAs, failedKVBs))
 	}
+
+}
+
+// seedDexState places an order and writes a trade, reward state, LC price
+// history and an applied fix to the dex store of the latest committed state.
+func seedDexState(t *testing.T, app *App) {
+	t.Helper()
+
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: app.LastBlockHeight()})
+	k := app.DexKeeper
+
+	pairs, err := k.TradingPairs.Iterate(ctx, nil)
+	require.NoError(t, err)
+	pairIDs, err := pairs.Keys()
+	require.NoError(t, err)
+	require.NotEmpty(t, pairIDs)
+	pairID := pairIDs[0]
+
+	tiers, err := k.LiquidityTiers.Iterate(ctx, nil)
+	require.NoError(t, err)
+	tierIDs, err := tiers.Keys()
+	require.NoError(t, err)
+	require.NotEmpty(t, tierIDs)
+
+	pair, err := k.TradingPairs.Get(ctx, pairID)
+	require.NoError(t, err)
+
+	// the order goes through the msg server so that its funds are escrowed
+	// and it is indexed like any other; a bid at the lowest price stays open
+	makerAddr := sdk.AccAddress([]byte("dex_import_export___"))
+	maker := makerAddr.String()
+	price := sdk.NewInt64Coin(pair.QuoteDenom, 1)
+	amount := sdk.NewInt64Coin(pair.BaseDenom, 1_000_000_000)
+	require.NoError(t, banktestutil.FundAccount(ctx, app.BankKeeper, makerAddr, sdk.NewCoins(dextypes.OrderLock(true, price, amount))))
+	res, err := dexkeeper.NewMsgServerImpl(k).CreateOrder(ctx, &dextypes.MsgCreateOrder{
+		Maker:  maker,
+		PairId: pairID,
+		IsBuy:  true,
+		Price:  price,
+		Amount: amount,
+	})
+	require.NoError(t, err)
+	order, err := k.Orders.Get(ctx, res.OrderId)
+	require.NoError(t, err)
+	require.NoError(t, k.OrderRewards.Set(ctx, order.Id, dextypes.OrderRewardInfo{
+		OrderId:           order.Id,
+		TierId:            tierIDs[0],
+		TotalRewards:      sdkmath.NewInt(5),
+		SpreadMultiplier:  sdkmath.LegacyOneDec(),
+		VolumeCapFraction: sdkmath.LegacyOneDec(),
+	}))
+	require.NoError(t, k.UserRewards.Set(ctx, maker, dextypes.UserReward{
+		Address:        maker,
+		TotalRewards:   sdkmath.NewInt(5),
+		ClaimedRewards: sdkmath.ZeroInt(),
+	}))
+
+	tradeID, err := k.NextTradeID.Next(ctx)
+	require.NoError(t, err)
+	require.NoError(t, k.Trades.Set(ctx, tradeID, dextypes.Trade{
+		Id:          tradeID,
+		PairId:      pairID,
+		BuyOrderId:  order.Id,
+		SellOrderId: order.Id,
+		Buyer:       maker,
+		Seller:      maker,
+		Price:       order.Price,
+		Amount:      sdk.NewInt64Coin(pair.BaseDenom, 10_000_000),
+		ExecutedAt:  ctx.BlockTime().Unix(),
+	}))
+	require.NoError(t, k.PairTrades.Set(ctx, collections.Join(pairID, tradeID), tradeID))
+
+	require.NoError(t, k.LCTotalSupply.Set(ctx, sdkmath.NewInt(1_000_000)))
+	require.NoError(t, k.DynamicRewardState.Set(ctx, dextypes.DynamicRewardState{
+		CurrentAnnualRate: sdkmath.LegacyMustNewDecFromStr("0.5"),
+		LastUpdateBlock:   app.LastBlockHeight(),
+		LastUpdateTime:    ctx.BlockTime().Unix(),
+	}))
+	require.NoError(t, k.SetLCPriceHistory(ctx, dextypes.LCPriceHistory{
+		CurrentPrice:    sdkmath.LegacyMustNewDecFromStr("0.0001"),
+		HistoricalFloor: sdkmath.LegacyMustNewDecFromStr("0.0001"),
+		WindowMinPrice:  sdkmath.LegacyMustNewDecFromStr("0.0001"),
+		WindowStartTime: ctx.BlockTime().Unix(),
+		LastUpdateTime:  ctx.BlockTime().Unix(),
+	}))
+	require.NoError(t, k.AppliedFixes.Set(ctx, dextypes.FixCrossedOrders))
 }
 
 func TestAppSimulationAfterImport(t *testing.T) {
@@ -303,9 +526,9 @@ func TestAppSimulationAfterImport(t *testing.T) {
 		t,
 		os.Stdout,
 		bApp.BaseApp,
-		simtestutil.AppStateFn(bApp.AppCodec(), bApp.SimulationManager(), bApp.DefaultGenesis()),
+		simAppStateFn(bApp),
 		simulationtypes.RandomAccounts,
-		simtestutil.SimulationOperations(bApp, bApp.AppCodec(), config),
+		simulationOperations(bApp, config),
 		BlockedAddresses(),
 		config,
 		bApp.AppCodec(),
@@ -353,9 +576,9 @@ func TestAppSimulationAfterImport(t *testing.T) {
 		t,
 		os.Stdout,
 		newApp.BaseApp,
-		simtestutil.AppStateFn(bApp.AppCodec(), bApp.SimulationManager(), bApp.DefaultGenesis()),
+		simAppStateFn(bApp),
 		simulationtypes.RandomAccounts,
-		simtestutil.SimulationOperations(newApp, newApp.AppCodec(), config),
+		simulationOperations(newApp, config),
 		BlockedAddresses(),
 		config,
 		bApp.AppCodec(),
@@ -433,13 +656,9 @@ func TestAppStateDeterminism(t *testing.T) {
 				t,
 				os.Stdout,
 				bApp.BaseApp,
-				simtestutil.AppStateFn(
-					bApp.AppCodec(),
-					bApp.SimulationManager(),
-					bApp.DefaultGenesis(),
-				),
+				simAppStateFn(bApp),
 				simulationtypes.RandomAccounts,
-				simtestutil.SimulationOperations(bApp, bApp.AppCodec(), config),
+				simulationOperations(bApp, config),
 				BlockedAddresses(),
 				config,
 				bApp.AppCodec(),
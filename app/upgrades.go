@@ -0,0 +1,42 @@
+package app
+
+import (
+	"fmt"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"mychain/app/upgrades"
+	v2 "mychain/app/upgrades/v2"
+)
+
+// Upgrades lists the software upgrades the app knows how to run, oldest first.
+var Upgrades = []upgrades.Upgrade{
+	v2.Upgrade,
+}
+
+// setupUpgradeHandlers registers the upgrade handlers with x/upgrade and, if
+// the node is restarting at the height of a known upgrade, the store loader
+// that applies its store upgrades. It must run before the app is loaded.
+func (app *App) setupUpgradeHandlers() {
+	for _, upgrade := range Upgrades {
+		app.UpgradeKeeper.SetUpgradeHandler(
+			upgrade.UpgradeName,
+			upgrade.CreateUpgradeHandler(app.ModuleManager, app.Configurator()),
+		)
+	}
+
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(fmt.Errorf("failed to read upgrade info from disk: %w", err))
+	}
+	if upgradeInfo.Name == "" || app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+
+	for _, upgrade := range Upgrades {
+		if upgradeInfo.Name == upgrade.UpgradeName {
+			storeUpgrades := upgrade.StoreUpgrades
+			app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+		}
+	}
+}
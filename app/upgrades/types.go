@@ -0,0 +1,23 @@
+package upgrades
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// Upgrade defines a named software upgrade: the handler run by x/upgrade at
+// the upgrade height and the stores the upgrade adds, renames or deletes.
+//
+// Every upgrade lives in its own package under app/upgrades and is listed in
+// the app's Upgrades slice.
+type Upgrade struct {
+	// UpgradeName is the name of the upgrade plan submitted through governance.
+	UpgradeName string
+
+	// CreateUpgradeHandler returns the handler run at the upgrade height.
+	CreateUpgradeHandler func(*module.Manager, module.Configurator) upgradetypes.UpgradeHandler
+
+	// StoreUpgrades lists the stores added, renamed or deleted by the upgrade.
+	StoreUpgrades storetypes.StoreUpgrades
+}
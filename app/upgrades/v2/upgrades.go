@@ -0,0 +1,33 @@
+// Package v2 runs the store migrations of every module that is behind its
+// current consensus version. For x/dex this moves the state kept under raw
+// keys into collections (version 2) and runs the one-time crossed orders and
+// order 18 repairs (version 3) that were previously checked on every block.
+package v2
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"mychain/app/upgrades"
+)
+
+// UpgradeName is the name of the upgrade plan.
+const UpgradeName = "v2"
+
+// Upgrade is the v2 software upgrade.
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades:        storetypes.StoreUpgrades{},
+}
+
+// CreateUpgradeHandler returns a handler that runs the registered module
+// migrations from the versions stored before the upgrade.
+func CreateUpgradeHandler(mm *module.Manager, configurator module.Configurator) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		return mm.RunMigrations(ctx, configurator, fromVM)
+	}
+}
@@ -0,0 +1,71 @@
+package app
+
+import (
+	"testing"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	v2 "mychain/app/upgrades/v2"
+	dextypes "mychain/x/dex/types"
+)
+
+func TestUpgradeV2RunsDexRepairs(t *testing.T) {
+	app, genesisState := newTestApp(t)
+	cdc := app.AppCodec()
+
+	// order 18 is recorded as filled beyond its only trade
+	dexGenesis := dextypes.DefaultGenesis()
+	dexGenesis.NextOrderId = 19
+	dexGenesis.Orders = []dextypes.Order{
+		{
+			Id:           18,
+			Maker:        sdk.AccAddress([]byte("order_18_maker______")).String(),
+			PairId:       1,
+			Price:        sdk.NewInt64Coin("testusd", 100),
+			Amount:       sdk.NewInt64Coin("maincoin", 100),
+			FilledAmount: sdk.NewInt64Coin("maincoin", 50),
+		},
+	}
+	dexGenesis.NextTradeId = 1
+	dexGenesis.Trades = []dextypes.Trade{
+		{
+			Id:          0,
+			PairId:      1,
+			BuyOrderId:  17,
+			SellOrderId: 18,
+			Price:       sdk.NewInt64Coin("testusd", 100),
+			Amount:      sdk.NewInt64Coin("maincoin", 10),
+		},
+	}
+	genesisState[dextypes.ModuleName] = cdc.MustMarshalJSON(dexGenesis)
+	initChain(t, app, genesisState)
+
+	require.True(t, app.UpgradeKeeper.HasHandler(v2.UpgradeName))
+
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: app.LastBlockHeight() + 1})
+
+	// the repairs no longer run per block
+	order, err := app.DexKeeper.Orders.Get(ctx, 18)
+	require.NoError(t, err)
+	require.Equal(t, int64(50), order.FilledAmount.Amount.Int64())
+
+	fromVM := app.ModuleManager.GetVersionMap()
+	fromVM[dextypes.ModuleName] = 2
+	handler := v2.CreateUpgradeHandler(app.ModuleManager, app.Configurator())
+	toVM, err := handler(ctx, upgradetypes.Plan{Name: v2.UpgradeName, Height: ctx.BlockHeight()}, fromVM)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), toVM[dextypes.ModuleName])
+
+	order, err = app.DexKeeper.Orders.Get(ctx, 18)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), order.FilledAmount.Amount.Int64())
+
+	for _, fix := range []string{dextypes.FixCrossedOrders, dextypes.FixOrder18} {
+		applied, err := app.DexKeeper.AppliedFixes.Has(ctx, fix)
+		require.NoError(t, err)
+		require.True(t, applied, fix)
+	}
+}
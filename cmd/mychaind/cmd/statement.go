@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"mychain/app"
+	maincointypes "mychain/x/maincoin/types"
+	"mychain/x/mychain/statement"
+)
+
+const (
+	flagStatementFrom       = "from"
+	flagStatementTo         = "to"
+	flagStatementFormat     = "format"
+	flagStatementBasisDenom = "basis-denom"
+
+	statementDateFormat = "2006-01-02"
+)
+
+// StatementCmd returns the command that exports an account statement.
+func StatementCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "statement [address]",
+		Short: "Export an account statement with running balances and realized P&L as CSV or JSON",
+		Long: `Builds the statement of an address from the TransactionHistory, UserPurchaseHistory,
+dex Trades and StakingDistributionHistory queries: dex fills, maincoin buys and sells,
+LC reward claims, staking rewards, bridge and bank transfers and fees.
+
+Amounts are shown in display units from the bank denom metadata. Every asset is booked
+into FIFO lots from the start of the history so that disposals within the period realize
+P&L against their cost basis, valued in --basis-denom. Cost basis and P&L are left empty
+where no price in the basis denom is known, e.g. for rewards and incoming transfers.
+
+The CSV output lists the entries, then a blank line and the per-asset summary.`,
+		Example: fmt.Sprintf("%sd statement cosmos1... --from 2025-01-01 --to 2025-12-31 --format csv > statement.csv", app.Name),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			address := args[0]
+			if _, err := sdk.AccAddressFromBech32(address); err != nil {
+				return fmt.Errorf("invalid address: %w", err)
+			}
+
+			fromStr, _ := cmd.Flags().GetString(flagStatementFrom)
+			toStr, _ := cmd.Flags().GetString(flagStatementTo)
+			format, _ := cmd.Flags().GetString(flagStatementFormat)
+			basisDenom, _ := cmd.Flags().GetString(flagStatementBasisDenom)
+
+			var from time.Time
+			if fromStr != "" {
+				if from, err = parseStatementTime(fromStr, false); err != nil {
+					return err
+				}
+			}
+			to := time.Now().UTC()
+			if toStr != "" {
+				if to, err = parseStatementTime(toStr, true); err != nil {
+					return err
+				}
+			}
+			if to.Before(from) {
+				return fmt.Errorf("--%s must not be before --%s", flagStatementTo, flagStatementFrom)
+			}
+
+			fetcher := statement.NewFetcher(clientCtx, basisDenom)
+			movements, err := fetcher.Movements(cmd.Context(), address, to)
+			if err != nil {
+				return err
+			}
+
+			denoms := []string{basisDenom}
+			seen := map[string]bool{basisDenom: true}
+			for _, m := range movements {
+				if !seen[m.Denom] {
+					seen[m.Denom] = true
+					denoms = append(denoms, m.Denom)
+				}
+			}
+			units := fetcher.Units(cmd.Context(), denoms)
+
+			st := statement.Build(address, movements, from, to, basisDenom, units)
+			return statement.Write(cmd.OutOrStdout(), st, format)
+		},
+	}
+
+	cmd.Flags().String(flagStatementFrom, "", "Start of the period, as 2006-01-02 or RFC3339 (defaults to the start of the history)")
+	cmd.Flags().String(flagStatementTo, "", "End of the period, inclusive, as 2006-01-02 or RFC3339 (defaults to now)")
+	cmd.Flags().String(flagStatementFormat, statement.FormatCSV, "Output format (csv|json)")
+	cmd.Flags().String(flagStatementBasisDenom, maincointypes.TestUSDDenom, "Denom cost basis and P&L are valued in")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseStatementTime parses a date or RFC3339 time. A date as the end of the
+// period covers that whole day.
+func parseStatementTime(s string, endOfDay bool) (time.Time, error) {
+	if t, err := time.Parse(statementDateFormat, s); err == nil {
+		if endOfDay {
+			t = t.Add(24*time.Hour - time.Second)
+		}
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q, expected %s or RFC3339", s, statementDateFormat)
+	}
+	return t.UTC(), nil
+}
@@ -10,6 +10,7 @@ import (
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/libs/bytes"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -191,6 +192,19 @@ func initAppForTestnet(app *app.App, args valArgs) *app.App {
 		handleErr(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, account, defaultCoins))
 	}
 
+	// UPGRADE
+	//
+
+	// Schedule the requested upgrade a few blocks ahead so its handler runs
+	// against the copied state.
+	if args.upgradeToTrigger != "" {
+		upgradePlan := upgradetypes.Plan{
+			Name:   args.upgradeToTrigger,
+			Height: app.LastBlockHeight() + 10,
+		}
+		handleErr(app.UpgradeKeeper.ScheduleUpgrade(ctx, upgradePlan))
+	}
+
 	return app
 }
 
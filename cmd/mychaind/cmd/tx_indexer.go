@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"cosmossdk.io/log"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	gateway "github.com/cosmos/gogogateway"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+
+	"mychain/app"
+	"mychain/x/mychain/indexer"
+	mychaintypes "mychain/x/mychain/types"
+)
+
+const (
+	flagIndexerNode         = "node"
+	flagIndexerDBDir        = "db-dir"
+	flagIndexerGRPCAddress  = "grpc-address"
+	flagIndexerAPIAddress   = "api-address"
+	flagIndexerStartHeight  = "start-height"
+	flagIndexerPollInterval = "poll-interval"
+)
+
+// TxIndexerCmd returns the command that runs the off-chain transaction
+// history indexer.
+func TxIndexerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tx-indexer",
+		Short: "Index transaction history from block events and serve it over gRPC/REST",
+		Long: `Follows a node over CometBFT RPC and indexes transaction_record, create_order,
+trade_executed and bank transfer events of every finalized block into a local database.
+The indexed history is served through the mychain TransactionHistory query on its own
+gRPC and REST endpoints, so nodes can set the disable_onchain_tx_history param and
+stop keeping history in consensus state.`,
+		Example: fmt.Sprintf("%sd tx-indexer --node tcp://localhost:26657 --api-address localhost:1318", app.Name),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			node, _ := cmd.Flags().GetString(flagIndexerNode)
+			dbDir, _ := cmd.Flags().GetString(flagIndexerDBDir)
+			grpcAddress, _ := cmd.Flags().GetString(flagIndexerGRPCAddress)
+			apiAddress, _ := cmd.Flags().GetString(flagIndexerAPIAddress)
+			startHeight, _ := cmd.Flags().GetInt64(flagIndexerStartHeight)
+			pollInterval, _ := cmd.Flags().GetDuration(flagIndexerPollInterval)
+
+			if dbDir == "" {
+				home, _ := cmd.Flags().GetString(flags.FlagHome)
+				dbDir = filepath.Join(home, "data")
+			}
+
+			db, err := dbm.NewDB("tx_history", dbm.GoLevelDBBackend, dbDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			source, err := rpchttp.New(node, "/websocket")
+			if err != nil {
+				return err
+			}
+
+			// Module accounts only show up on the user side of transfers
+			moduleAccounts := make(map[string]bool)
+			for name := range app.GetMaccPerms() {
+				moduleAccounts[authtypes.NewModuleAddress(name).String()] = true
+			}
+
+			logger := log.NewLogger(cmd.OutOrStdout())
+			store := indexer.NewStore(db)
+			querier := indexer.NewQuerier(store)
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			grpcServer := grpc.NewServer()
+			mychaintypes.RegisterQueryServer(grpcServer, querier)
+
+			mux := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard, &gateway.JSONPb{
+				EmitDefaults: true,
+				OrigName:     true,
+			}))
+			if err := mychaintypes.RegisterQueryHandlerServer(ctx, mux, querier); err != nil {
+				return err
+			}
+			apiServer := &http.Server{Addr: apiAddress, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+			g, ctx := errgroup.WithContext(ctx)
+			g.Go(func() error {
+				return indexer.NewIndexer(source, store, logger, moduleAccounts).Run(ctx, startHeight, pollInterval)
+			})
+			g.Go(func() error {
+				listener, err := net.Listen("tcp", grpcAddress)
+				if err != nil {
+					return err
+				}
+				logger.Info("serving tx history over gRPC", "address", grpcAddress)
+				return grpcServer.Serve(listener)
+			})
+			g.Go(func() error {
+				logger.Info("serving tx history over REST", "address", apiAddress)
+				if err := apiServer.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+					return err
+				}
+				return nil
+			})
+			g.Go(func() error {
+				<-ctx.Done()
+				grpcServer.GracefulStop()
+				return apiServer.Shutdown(context.Background())
+			})
+
+			return g.Wait()
+		},
+	}
+
+	cmd.Flags().String(flagIndexerNode, "tcp://localhost:26657", "CometBFT RPC endpoint of the node to index")
+	cmd.Flags().String(flagIndexerDBDir, "", "Directory of the index database (defaults to <home>/data)")
+	cmd.Flags().String(flagIndexerGRPCAddress, "localhost:9190", "Address to serve the TransactionHistory gRPC query on")
+	cmd.Flags().String(flagIndexerAPIAddress, "localhost:1318", "Address to serve the TransactionHistory REST query on")
+	cmd.Flags().Int64(flagIndexerStartHeight, 1, "Height to start indexing from when the database is empty")
+	cmd.Flags().Duration(flagIndexerPollInterval, time.Second, "Interval between polls for new blocks")
+
+	return cmd
+}
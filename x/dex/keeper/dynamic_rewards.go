@@ -67,8 +67,8 @@ func (k Keeper) GetDynamicRewardConfig(ctx context.Context) (DynamicRewardConfig
 // CalculateDynamicRewardRate calculates the current reward rate based on liquidity depth
 func (k Keeper) CalculateDynamicRewardRate(ctx context.Context) math.Int {
 	// Get dynamic reward state
-	store := k.storeService.OpenKVStore(ctx)
-	bz, _ := store.Get([]byte("dynamic_reward_state"))
+	state, err := k.DynamicRewardState.Get(ctx)
+	initialized := err == nil
 	
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	currentBlock := sdkCtx.BlockHeight()
@@ -80,14 +80,12 @@ func (k Keeper) CalculateDynamicRewardRate(ctx context.Context) math.Int {
 	var currentRate math.Int
 	var lastUpdateBlock int64
 	
-	if bz == nil {
+	if !initialized {
 		// Initialize with max rate to attract initial liquidity
 		config, _, _ := k.GetDynamicRewardConfig(ctx)
 		currentRate = config.MaxRate
 		lastUpdateBlock = currentBlock
 	} else {
-		var state types.DynamicRewardState
-		k.cdc.MustUnmarshal(bz, &state)
 		currentRate = state.CurrentAnnualRate.Mul(math.LegacyNewDec(3175)).TruncateInt() // Convert percentage to rate
 		lastUpdateBlock = state.LastUpdateBlock
 		
@@ -150,8 +148,9 @@ func (k Keeper) CalculateDynamicRewardRate(ctx context.Context) math.Int {
 		LastUpdateTime:    currentTime,
 	}
 	
-	bz = k.cdc.MustMarshal(&newState)
-	store.Set([]byte("dynamic_reward_state"), bz)
+	if err := k.DynamicRewardState.Set(ctx, newState); err != nil {
+		k.Logger(ctx).Error("failed to store dynamic reward state", "error", err)
+	}
 	
 	k.Logger(ctx).Info("Dynamic reward rate updated",
 		"bidLiquidity", bidLiquidity,
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"mychain/x/dex/types"
+)
+
+// LockedOrderFunds returns the funds the module account holds for the
+// unfilled part of every open order.
+func (k Keeper) LockedOrderFunds(ctx context.Context) (sdk.Coins, error) {
+	locked := sdk.NewCoins()
+	err := k.Orders.Walk(ctx, nil, func(_ uint64, order types.Order) (bool, error) {
+		if order.Remaining().IsPositive() {
+			locked = locked.Add(order.LockedFunds())
+		}
+		return false, nil
+	})
+	return locked, err
+}
@@ -2,10 +2,12 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"mychain/x/dex/types"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 )
 
 // InitGenesis initializes the module's state from a provided genesis state.
@@ -94,7 +96,52 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 		}
 	}
 	
-	// Dynamic reward state is initialized automatically when needed
+	// Set LC total supply
+	lcTotalSupply := genState.LcTotalSupply
+	if lcTotalSupply.IsNil() {
+		lcTotalSupply = math.ZeroInt()
+	}
+	if err := k.LCTotalSupply.Set(ctx, lcTotalSupply); err != nil {
+		return err
+	}
+	
+	// Set dynamic reward state; left unset it is initialized when needed
+	if genState.DynamicRewardState != nil {
+		if err := k.DynamicRewardState.Set(ctx, *genState.DynamicRewardState); err != nil {
+			return err
+		}
+	}
+	
+	// Set next trade ID
+	if err := k.NextTradeID.Set(ctx, genState.NextTradeId); err != nil {
+		return err
+	}
+	
+	// Set trades
+	for _, trade := range genState.Trades {
+		if err := k.Trades.Set(ctx, trade.Id, trade); err != nil {
+			return err
+		}
+		
+		pairTradeKey := collections.Join(trade.PairId, trade.Id)
+		if err := k.PairTrades.Set(ctx, pairTradeKey, trade.Id); err != nil {
+			return err
+		}
+	}
+	
+	// Set LC price history
+	if genState.LcPriceHistory != nil {
+		if err := k.LCPriceHistory.Set(ctx, *genState.LcPriceHistory); err != nil {
+			return err
+		}
+	}
+	
+	// Set applied fixes
+	for _, fix := range genState.AppliedFixes {
+		if err := k.AppliedFixes.Set(ctx, fix); err != nil {
+			return err
+		}
+	}
 	
 	return nil
 }
@@ -113,8 +160,7 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 	// Get next order ID
 	nextOrderID, err := k.NextOrderID.Peek(ctx)
 	if err != nil {
-		// If not set, use 1
-		nextOrderID = 1
+		return nil, err
 	}
 	genesis.NextOrderId = nextOrderID
 	
@@ -188,5 +234,57 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 	
+	// Get LC total supply
+	lcTotalSupply, err := k.LCTotalSupply.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		lcTotalSupply = math.ZeroInt()
+	} else if err != nil {
+		return nil, err
+	}
+	genesis.LcTotalSupply = lcTotalSupply
+	
+	// Get dynamic reward state
+	rewardState, err := k.DynamicRewardState.Get(ctx)
+	if err == nil {
+		genesis.DynamicRewardState = &rewardState
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	
+	// Get next trade ID
+	nextTradeID, err := k.NextTradeID.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	genesis.NextTradeId = nextTradeID
+	
+	// Get trades
+	genesis.Trades = []types.Trade{}
+	err = k.Trades.Walk(ctx, nil, func(id uint64, trade types.Trade) (bool, error) {
+		genesis.Trades = append(genesis.Trades, trade)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	
+	// Get LC price history
+	priceHistory, err := k.LCPriceHistory.Get(ctx)
+	if err == nil {
+		genesis.LcPriceHistory = &priceHistory
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	
+	// Get applied fixes
+	genesis.AppliedFixes = []string{}
+	err = k.AppliedFixes.Walk(ctx, nil, func(fix string) (bool, error) {
+		genesis.AppliedFixes = append(genesis.AppliedFixes, fix)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	
 	return genesis, nil
 }
@@ -6,6 +6,9 @@ import (
 	"mychain/testutil/nullify"
 	"mychain/x/dex/types"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -26,3 +29,114 @@ func TestGenesis(t *testing.T) {
 
 	require.Equal(t, genesisState.Params, got.Params)
 }
+
+// populatedGenesis returns a genesis state that sets every piece of dex state.
+func populatedGenesis() *types.GenesisState {
+	gs := types.DefaultGenesis()
+	gs.NextOrderId = 3
+	gs.Orders = []types.Order{
+		{
+			Id:           1,
+			Maker:        "maker1",
+			PairId:       1,
+			IsBuy:        true,
+			Price:        sdk.NewInt64Coin("testusd", 100),
+			Amount:       sdk.NewInt64Coin("maincoin", 1000),
+			FilledAmount: sdk.NewInt64Coin("maincoin", 10),
+			CreatedAt:    100,
+			UpdatedAt:    200,
+		},
+		{
+			Id:           2,
+			Maker:        "maker2",
+			PairId:       2,
+			Price:        sdk.NewInt64Coin("liquiditycoin", 50),
+			Amount:       sdk.NewInt64Coin("maincoin", 500),
+			FilledAmount: sdk.NewInt64Coin("maincoin", 0),
+			CreatedAt:    150,
+			UpdatedAt:    150,
+		},
+	}
+	gs.UserRewards = []types.UserReward{
+		{Address: "maker1", TotalRewards: math.NewInt(40), ClaimedRewards: math.NewInt(15)},
+	}
+	gs.OrderRewards = []types.OrderRewardInfo{
+		{
+			OrderId:           1,
+			TierId:            2,
+			StartTime:         100,
+			LastUpdated:       200,
+			TotalRewards:      math.NewInt(40),
+			SpreadMultiplier:  math.LegacyOneDec(),
+			VolumeCapFraction: math.LegacyMustNewDecFromStr("0.5"),
+		},
+	}
+	gs.PriceReferences = []types.PriceReference{
+		{PairId: 1, ReferencePrice: math.LegacyMustNewDecFromStr("0.0001"), LastUpdated: 200},
+	}
+	gs.VolumeTrackers = []types.VolumeTracker{{PairId: 1}}
+	gs.LcTotalSupply = math.NewInt(1_000_000)
+	gs.DynamicRewardState = &types.DynamicRewardState{
+		CurrentAnnualRate: math.LegacyMustNewDecFromStr("0.5"),
+		LastUpdateBlock:   7,
+		LastUpdateTime:    200,
+	}
+	gs.NextTradeId = 1
+	gs.Trades = []types.Trade{
+		{
+			Id:          0,
+			PairId:      1,
+			BuyOrderId:  1,
+			SellOrderId: 2,
+			Buyer:       "maker1",
+			Seller:      "maker2",
+			Price:       sdk.NewInt64Coin("testusd", 100),
+			Amount:      sdk.NewInt64Coin("maincoin", 10),
+			ExecutedAt:  200,
+		},
+	}
+	gs.LcPriceHistory = &types.LCPriceHistory{
+		CurrentPrice:    math.LegacyMustNewDecFromStr("0.0001"),
+		HistoricalFloor: math.LegacyMustNewDecFromStr("0.00005"),
+		WindowStartTime: 100,
+		WindowMinPrice:  math.LegacyMustNewDecFromStr("0.00008"),
+		LastUpdateTime:  200,
+	}
+	gs.AppliedFixes = []string{types.FixCrossedOrders, types.FixOrder18}
+	return gs
+}
+
+func TestGenesisRoundTrip(t *testing.T) {
+	f := initFixture(t)
+	gs := populatedGenesis()
+	require.NoError(t, gs.Validate())
+
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, *gs))
+	got, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, gs, got)
+
+	// The order and trade indexes are rebuilt from genesis.
+	has, err := f.keeper.UserOrders.Has(f.ctx, collections.Join("maker2", uint64(2)))
+	require.NoError(t, err)
+	require.True(t, has)
+	has, err = f.keeper.PairTrades.Has(f.ctx, collections.Join(uint64(1), uint64(0)))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// Importing the export into a fresh store gives the same state.
+	f2 := initFixture(t)
+	require.NoError(t, f2.keeper.InitGenesis(f2.ctx, *got))
+	got2, err := f2.keeper.ExportGenesis(f2.ctx)
+	require.NoError(t, err)
+	require.Equal(t, got, got2)
+}
+
+func TestInitGenesisInvalid(t *testing.T) {
+	f := initFixture(t)
+	gs := populatedGenesis()
+	gs.Trades[0].PairId = 9
+
+	err := f.keeper.InitGenesis(f.ctx, *gs)
+	require.ErrorIs(t, err, types.ErrInvalidPairID)
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"mychain/x/dex/types"
+)
+
+// RegisterInvariants registers the dex module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "orders", OrdersInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "escrow", EscrowInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "rewards", RewardsInvariant(k))
+}
+
+// OrdersInvariant checks that every order is filled at most in full, belongs
+// to a known trading pair and is indexed by its maker and pair, and that the
+// indexes hold no other orders.
+func OrdersInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+		err := k.Orders.Walk(ctx, nil, func(id uint64, order types.Order) (bool, error) {
+			count++
+			if order.Id != id {
+				msg += fmt.Sprintf("\torder stored under %d has id %d\n", id, order.Id)
+			}
+			if order.FilledAmount.Amount.IsNegative() || order.Remaining().IsNegative() {
+				msg += fmt.Sprintf("\torder %d filled %s of %s\n", id, order.FilledAmount, order.Amount)
+			}
+			if has, err := k.TradingPairs.Has(ctx, order.PairId); err != nil || !has {
+				msg += fmt.Sprintf("\torder %d is for unknown pair %d\n", id, order.PairId)
+			}
+			if has, err := k.UserOrders.Has(ctx, collections.Join(order.Maker, id)); err != nil || !has {
+				msg += fmt.Sprintf("\torder %d is not indexed by maker %s\n", id, order.Maker)
+			}
+			if has, err := k.PairOrders.Has(ctx, collections.Join(order.PairId, id)); err != nil || !has {
+				msg += fmt.Sprintf("\torder %d is not indexed by pair %d\n", id, order.PairId)
+			}
+			return false, nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "orders", err.Error()), true
+		}
+
+		userIndexed, pairIndexed := 0, 0
+		err = k.UserOrders.Walk(ctx, nil, func(collections.Pair[string, uint64], uint64) (bool, error) {
+			userIndexed++
+			return false, nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "orders", err.Error()), true
+		}
+		err = k.PairOrders.Walk(ctx, nil, func(collections.Pair[uint64, uint64], uint64) (bool, error) {
+			pairIndexed++
+			return false, nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "orders", err.Error()), true
+		}
+		if userIndexed != count || pairIndexed != count {
+			msg += fmt.Sprintf("\t%d orders, %d indexed by maker, %d indexed by pair\n", count, userIndexed, pairIndexed)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "orders", msg), msg != ""
+	}
+}
+
+// EscrowInvariant checks that the module account holds the funds locked for
+// every open order.
+func EscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		locked, err := k.LockedOrderFunds(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "escrow", err.Error()), true
+		}
+		moduleAddr := k.authKeeper.GetModuleAddress(types.ModuleName)
+		held := sdk.NewCoins()
+		for _, coin := range locked {
+			held = held.Add(k.bankKeeper.GetBalance(ctx, moduleAddr, coin.Denom))
+		}
+
+		broken := !held.IsAllGTE(locked)
+
+		return sdk.FormatInvariant(types.ModuleName, "escrow", fmt.Sprintf(
+			"\tlocked by open orders: %s\n\theld by module: %s\n", locked, held,
+		)), broken
+	}
+}
+
+// RewardsInvariant checks that no user has claimed more LC rewards than they
+// earned.
+func RewardsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		err := k.UserRewards.Walk(ctx, nil, func(address string, reward types.UserReward) (bool, error) {
+			if reward.ClaimedRewards.GT(reward.TotalRewards) {
+				msg += fmt.Sprintf("\t%s claimed %s of %s\n", address, reward.ClaimedRewards, reward.TotalRewards)
+			}
+			return false, nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "rewards", err.Error()), true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "rewards", msg), msg != ""
+	}
+}
@@ -39,6 +39,9 @@ type Keeper struct {
 	DynamicRewardState collections.Item[types.DynamicRewardState]
 	NextTradeID      collections.Sequence
 	Trades           collections.Map[uint64, types.Trade]
+	LCPriceHistory   collections.Item[types.LCPriceHistory]
+	// AppliedFixes holds the names of the one-time state repairs that have run
+	AppliedFixes     collections.KeySet[string]
 	
 	// Indexes
 	UserOrders       collections.Map[collections.Pair[string, uint64], uint64] // (user, orderID) -> orderID
@@ -94,6 +97,8 @@ func NewKeeper(
 		DynamicRewardState: collections.NewItem(sb, types.DynamicRewardStateKey, "dynamic_reward_state", codec.CollValue[types.DynamicRewardState](cdc)),
 		NextTradeID:        collections.NewSequence(sb, types.NextTradeIDKey, "next_trade_id"),
 		Trades:             collections.NewMap(sb, types.TradesKey, "trades", collections.Uint64Key, codec.CollValue[types.Trade](cdc)),
+		LCPriceHistory:     collections.NewItem(sb, types.LCPriceHistoryKey, "lc_price_history", codec.CollValue[types.LCPriceHistory](cdc)),
+		AppliedFixes:       collections.NewKeySet(sb, types.AppliedFixesKey, "applied_fixes", collections.StringKey),
 		UserOrders:         collections.NewMap(sb, types.UserOrdersKey, "user_orders", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), collections.Uint64Value),
 		PairOrders:         collections.NewMap(sb, types.PairOrdersKey, "pair_orders", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), collections.Uint64Value),
 		PairTrades:         collections.NewMap(sb, types.PairTradesKey, "pair_trades", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), collections.Uint64Value),
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"cosmossdk.io/core/address"
+	corestore "cosmossdk.io/core/store"
 	storetypes "cosmossdk.io/store/types"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -22,6 +23,7 @@ type fixture struct {
 	ctx          context.Context
 	keeper       keeper.Keeper
 	addressCodec address.Codec
+	storeService corestore.KVStoreService
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -41,6 +43,11 @@ func initFixture(t *testing.T) *fixture {
 		encCfg.Codec,
 		addressCodec,
 		authority,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 
 	// Initialize params
@@ -52,5 +59,13 @@ func initFixture(t *testing.T) *fixture {
 		ctx:          ctx,
 		keeper:       k,
 		addressCodec: addressCodec,
+		storeService: storeService,
 	}
 }
+
+func setupKeeper(t *testing.T) (keeper.Keeper, sdk.Context) {
+	t.Helper()
+
+	f := initFixture(t)
+	return f.keeper, sdk.UnwrapSDKContext(f.ctx)
+}
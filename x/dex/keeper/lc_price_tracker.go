@@ -16,15 +16,6 @@ const (
 	InitialLCPrice = "0.0001"
 )
 
-// LCPriceHistory tracks the minimum price over time windows
-type LCPriceHistory struct {
-	CurrentPrice     math.LegacyDec
-	HistoricalFloor  math.LegacyDec // Never goes down
-	WindowStartTime  int64
-	WindowMinPrice   math.LegacyDec
-	LastUpdateTime   int64
-}
-
 // UpdateLCPrice updates the LC reference price based on 72-hour rule
 // Price can only increase if no lower price exists in the past 72 hours
 func (k Keeper) UpdateLCPrice(ctx context.Context) error {
@@ -36,7 +27,7 @@ func (k Keeper) UpdateLCPrice(ctx context.Context) error {
 	if !exists {
 		// Initialize with starting price
 		initialPrice := math.LegacyMustNewDecFromStr(InitialLCPrice)
-		history = LCPriceHistory{
+		history = types.LCPriceHistory{
 			CurrentPrice:     initialPrice,
 			HistoricalFloor:  initialPrice,
 			WindowStartTime:  currentTime,
@@ -140,14 +131,17 @@ func (k Keeper) GetLCReferencePrice(ctx context.Context) math.LegacyDec {
 	return history.CurrentPrice
 }
 
-// Storage helpers (these would use collections in real implementation)
-func (k Keeper) GetLCPriceHistory(ctx context.Context) (LCPriceHistory, bool) {
-	// This would retrieve from k.LCPriceHistory collections item
-	// For now, returning placeholder
-	return LCPriceHistory{}, false
+// GetLCPriceHistory returns the LC price history, and false if it has not
+// been initialized yet
+func (k Keeper) GetLCPriceHistory(ctx context.Context) (types.LCPriceHistory, bool) {
+	history, err := k.LCPriceHistory.Get(ctx)
+	if err != nil {
+		return types.LCPriceHistory{}, false
+	}
+	return history, true
 }
 
-func (k Keeper) SetLCPriceHistory(ctx context.Context, history LCPriceHistory) error {
-	// This would store to k.LCPriceHistory collections item
-	return nil
+// SetLCPriceHistory stores the LC price history
+func (k Keeper) SetLCPriceHistory(ctx context.Context, history types.LCPriceHistory) error {
+	return k.LCPriceHistory.Set(ctx, history)
 }
\ No newline at end of file
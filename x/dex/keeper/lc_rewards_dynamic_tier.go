@@ -6,6 +6,7 @@ import (
 	"sort"
 
 	"mychain/x/dex/types"
+	mychaintypes "mychain/x/mychain/types"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -719,31 +720,27 @@ func (k Keeper) DistributeLiquidityRewardsWithDynamicRate(ctx context.Context) e
 		
 		// Record the reward distribution in transaction history
 		if k.transactionKeeper != nil {
-			// Use a unique tx hash for each user to avoid key conflicts
-			txHash := fmt.Sprintf("DEX-REWARD-%d-%s", height, userAddr[:8])
-			
 			// Calculate effective APR for display
 			baseAPR := math.LegacyNewDecFromInt(dynamicRate).Quo(math.LegacyNewDec(3175))
 			description := fmt.Sprintf("DEX liquidity rewards (%s%% APR)", baseAPR.Mul(math.LegacyNewDec(100)).TruncateInt())
 			
-			// Use RecordTransaction with the fixed txHash as metadata
-			err = k.transactionKeeper.RecordTransaction(
-				ctx,
-				userAddr,
-				"dex_reward_distribution",
-				description,
-				userCoins,
-				"dex_module",
-				userAddr,
-				txHash, // Pass txHash as metadata
-			)
+			err = k.transactionKeeper.RecordTransaction(ctx, mychaintypes.TransactionRecord{
+				Address:     userAddr,
+				Type:        "dex_reward_distribution",
+				Description: description,
+				Amount:      userCoins,
+				From:        "dex_module",
+				To:          userAddr,
+				Details: &mychaintypes.TransactionRecord_LcRewardClaim{LcRewardClaim: &mychaintypes.LCRewardClaimDetails{
+					Apr: baseAPR,
+				}},
+			})
 			if err != nil {
 				k.Logger(ctx).Error("failed to record reward transaction", "user", userAddr, "error", err)
 			} else {
 				k.Logger(ctx).Info("DEX reward transaction recorded",
 					"user", userAddr,
 					"height", height,
-					"txHash", txHash,
 					"amount", userCoins.String())
 			}
 		}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"mychain/x/dex/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 moves the state written under raw keys into collections: the
+// dynamic reward state kept under "dynamic_reward_state" and the one-time fix
+// markers, which become entries of AppliedFixes.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	kvStore := m.keeper.storeService.OpenKVStore(ctx)
+
+	bz, err := kvStore.Get(types.LegacyDynamicRewardStateKey)
+	if err != nil {
+		return err
+	}
+	if bz != nil {
+		var state types.DynamicRewardState
+		if err := m.keeper.cdc.Unmarshal(bz, &state); err != nil {
+			return fmt.Errorf("failed to decode dynamic reward state: %w", err)
+		}
+		if err := m.keeper.DynamicRewardState.Set(ctx, state); err != nil {
+			return err
+		}
+		if err := kvStore.Delete(types.LegacyDynamicRewardStateKey); err != nil {
+			return err
+		}
+	}
+
+	for _, fix := range slices.Sorted(maps.Keys(types.LegacyFixKeys)) {
+		key := types.LegacyFixKeys[fix]
+		applied, err := kvStore.Has(key)
+		if err != nil {
+			return err
+		}
+		if !applied {
+			continue
+		}
+		if err := m.keeper.AppliedFixes.Set(ctx, fix); err != nil {
+			return err
+		}
+		if err := kvStore.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Migrate2to3 runs the one-time state repairs that were previously checked on
+// every block: matching orders that crossed before order matching existed and
+// recomputing the filled amount of the overfilled order 18. Repairs that a
+// node already applied under the version 1 markers are skipped.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	repairs := []struct {
+		name  string
+		apply func(context.Context) error
+	}{
+		{types.FixCrossedOrders, m.keeper.MatchAllCrossedOrders},
+		{types.FixOrder18, m.fixOrder18},
+	}
+
+	for _, repair := range repairs {
+		applied, err := m.keeper.AppliedFixes.Has(ctx, repair.name)
+		if err != nil {
+			return err
+		}
+		if applied {
+			continue
+		}
+		if err := repair.apply(ctx); err != nil {
+			return fmt.Errorf("failed to apply %s repair: %w", repair.name, err)
+		}
+		if err := m.keeper.AppliedFixes.Set(ctx, repair.name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fixOrder18 repairs order 18 if the chain has one.
+func (m Migrator) fixOrder18(ctx context.Context) error {
+	has, err := m.keeper.Orders.Has(ctx, 18)
+	if err != nil || !has {
+		return err
+	}
+	return m.keeper.FixOrder18(ctx)
+}
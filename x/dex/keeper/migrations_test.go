@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"mychain/x/dex/keeper"
+	"mychain/x/dex/types"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	store := f.storeService.OpenKVStore(ctx)
+
+	state := types.DynamicRewardState{
+		CurrentAnnualRate: math.LegacyMustNewDecFromStr("0.42"),
+		LastUpdateBlock:   12,
+		LastUpdateTime:    1000,
+	}
+	bz, err := state.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, store.Set(types.LegacyDynamicRewardStateKey, bz))
+	require.NoError(t, store.Set(types.LegacyFixKeys[types.FixOrder18], []byte{1}))
+
+	require.NoError(t, keeper.NewMigrator(&f.keeper).Migrate1to2(ctx))
+
+	got, err := f.keeper.DynamicRewardState.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, state, got)
+
+	applied, err := f.keeper.AppliedFixes.Has(ctx, types.FixOrder18)
+	require.NoError(t, err)
+	require.True(t, applied)
+	applied, err = f.keeper.AppliedFixes.Has(ctx, types.FixCrossedOrders)
+	require.NoError(t, err)
+	require.False(t, applied)
+
+	for _, key := range [][]byte{types.LegacyDynamicRewardStateKey, types.LegacyFixKeys[types.FixOrder18]} {
+		has, err := store.Has(key)
+		require.NoError(t, err)
+		require.False(t, has)
+	}
+}
+
+func TestMigrate2to3(t *testing.T) {
+	order := types.Order{
+		Id:           18,
+		Maker:        "maker",
+		PairId:       1,
+		Price:        sdk.NewInt64Coin("testusd", 100),
+		Amount:       sdk.NewInt64Coin("maincoin", 100),
+		FilledAmount: sdk.NewInt64Coin("maincoin", 50),
+	}
+	trade := types.Trade{
+		Id:          0,
+		PairId:      1,
+		SellOrderId: 18,
+		Amount:      sdk.NewInt64Coin("maincoin", 10),
+	}
+
+	for _, tc := range []struct {
+		desc       string
+		applied    []string
+		wantFilled int64
+	}{
+		{desc: "runs pending repairs", wantFilled: 10},
+		{desc: "skips applied repairs", applied: []string{types.FixOrder18}, wantFilled: 50},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			f := initFixture(t)
+			ctx := sdk.UnwrapSDKContext(f.ctx)
+			require.NoError(t, f.keeper.Orders.Set(ctx, order.Id, order))
+			require.NoError(t, f.keeper.Trades.Set(ctx, trade.Id, trade))
+			for _, fix := range tc.applied {
+				require.NoError(t, f.keeper.AppliedFixes.Set(ctx, fix))
+			}
+
+			require.NoError(t, keeper.NewMigrator(&f.keeper).Migrate2to3(ctx))
+
+			got, err := f.keeper.Orders.Get(ctx, order.Id)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantFilled, got.FilledAmount.Amount.Int64())
+
+			for _, fix := range []string{types.FixCrossedOrders, types.FixOrder18} {
+				applied, err := f.keeper.AppliedFixes.Has(ctx, fix)
+				require.NoError(t, err)
+				require.True(t, applied, fix)
+			}
+		})
+	}
+}
+
+func TestMigrate2to3WithoutOrder18(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	require.NoError(t, keeper.NewMigrator(&f.keeper).Migrate2to3(ctx))
+
+	applied, err := f.keeper.AppliedFixes.Has(ctx, types.FixOrder18)
+	require.NoError(t, err)
+	require.True(t, applied)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"mychain/x/dex/types"
+	mychaintypes "mychain/x/mychain/types"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
@@ -153,9 +154,23 @@ func (k msgServer) CancelOrder(ctx context.Context, msg *types.MsgCancelOrder) (
 			feeStr = fmt.Sprintf(", fee: %s ulc", cancelFee.String())
 		}
 		description := fmt.Sprintf("Cancelled order #%d, refunded %s%s", msg.OrderId, lockedAmount.String(), feeStr)
-		metadata := fmt.Sprintf(`{"order_id":%d,"refund":"%s","fee":"%s"}`, msg.OrderId, lockedAmount.String(), cancelFee.String())
+		record := mychaintypes.TransactionRecord{
+			Address:     msg.Maker,
+			Type:        "dex_cancel_order",
+			Description: description,
+			Amount:      sdk.NewCoins(lockedAmount),
+			From:        "dex_orderbook",
+			To:          msg.Maker,
+			Details: &mychaintypes.TransactionRecord_DexOrder{DexOrder: &mychaintypes.DexOrderDetails{
+				OrderId:   msg.OrderId,
+				PairId:    order.PairId,
+				IsBuy:     order.IsBuy,
+				Price:     order.Price,
+				CancelFee: sdk.NewCoin("ulc", cancelFee),
+			}},
+		}
 		
-		if err := tk.RecordTransaction(ctx, msg.Maker, "dex_cancel_order", description, sdk.NewCoins(lockedAmount), "dex_orderbook", msg.Maker, metadata); err != nil {
+		if err := tk.RecordTransaction(ctx, record); err != nil {
 			k.Logger(ctx).Error("failed to record transaction", "error", err)
 		}
 	}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"mychain/x/dex/types"
+	mychaintypes "mychain/x/mychain/types"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -148,20 +149,44 @@ func (k Keeper) ExecuteOrderWithFees(ctx context.Context, buyOrder, sellOrder *t
 	if tk := k.GetTransactionKeeper(); tk != nil {
 		// Buyer transaction
 		buyDesc := fmt.Sprintf("Bought %s for %s", sdk.NewCoin(buyOrder.Amount.Denom, buyerReceivesBase), sdk.NewCoin(sellOrder.Price.Denom, tradeValue))
-		if err := tk.RecordTransaction(ctx, buyOrder.Maker, "dex_trade_buy", buyDesc, 
-			sdk.NewCoins(sdk.NewCoin(buyOrder.Amount.Denom, buyerReceivesBase)), 
-			"dex_orderbook", buyOrder.Maker, 
-			fmt.Sprintf(`{"order_id":%d,"price":"%s","fees":"0"}`, buyOrder.Id, sellOrder.Price.String())); err != nil {
+		buyRecord := mychaintypes.TransactionRecord{
+			Address:     buyOrder.Maker,
+			Type:        "dex_trade_buy",
+			Description: buyDesc,
+			Amount:      sdk.NewCoins(sdk.NewCoin(buyOrder.Amount.Denom, buyerReceivesBase)),
+			From:        "dex_orderbook",
+			To:          buyOrder.Maker,
+			Details: &mychaintypes.TransactionRecord_DexFill{DexFill: &mychaintypes.DexFillDetails{
+				OrderId:        buyOrder.Id,
+				CounterOrderId: sellOrder.Id,
+				IsBuy:          true,
+				Price:          sellOrder.Price,
+				Fees:           sdk.NewCoin("ulc", math.ZeroInt()),
+			}},
+		}
+		if err := tk.RecordTransaction(ctx, buyRecord); err != nil {
 			k.Logger(ctx).Error("failed to record buy transaction", "error", err)
 		}
 		
 		// Seller transaction
 		sellDesc := fmt.Sprintf("Sold %s for %s (fees: %s)", sdk.NewCoin(sellOrder.Amount.Denom, matchAmount), 
 			sdk.NewCoin(sellOrder.Price.Denom, sellerReceivesQuote), sdk.NewCoin("ulc", makerFee.Add(takerFee).Add(sellFee)))
-		if err := tk.RecordTransaction(ctx, sellOrder.Maker, "dex_trade_sell", sellDesc, 
-			sdk.NewCoins(sdk.NewCoin(sellOrder.Price.Denom, sellerReceivesQuote)), 
-			"dex_orderbook", sellOrder.Maker, 
-			fmt.Sprintf(`{"order_id":%d,"price":"%s","fees":"%s"}`, sellOrder.Id, sellOrder.Price.String(), totalFees.String())); err != nil {
+		sellRecord := mychaintypes.TransactionRecord{
+			Address:     sellOrder.Maker,
+			Type:        "dex_trade_sell",
+			Description: sellDesc,
+			Amount:      sdk.NewCoins(sdk.NewCoin(sellOrder.Price.Denom, sellerReceivesQuote)),
+			From:        "dex_orderbook",
+			To:          sellOrder.Maker,
+			Details: &mychaintypes.TransactionRecord_DexFill{DexFill: &mychaintypes.DexFillDetails{
+				OrderId:        sellOrder.Id,
+				CounterOrderId: buyOrder.Id,
+				IsBuy:          false,
+				Price:          sellOrder.Price,
+				Fees:           sdk.NewCoin("ulc", totalFees),
+			}},
+		}
+		if err := tk.RecordTransaction(ctx, sellRecord); err != nil {
 			k.Logger(ctx).Error("failed to record sell transaction", "error", err)
 		}
 	}
@@ -2,9 +2,11 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"mychain/x/dex/types"
 	
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
@@ -18,12 +20,12 @@ func (q queryServer) DynamicRewardState(ctx context.Context, req *types.QueryDyn
 	}
 
 
-	// Get current state from KVStore
-	store := q.k.storeService.OpenKVStore(ctx)
-	bz, _ := store.Get([]byte("dynamic_reward_state"))
-	
-	var state types.DynamicRewardState
-	if bz == nil {
+	// Get current state
+	state, err := q.k.DynamicRewardState.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err != nil {
 		// Not initialized - calculate and return current rate
 		dynamicRate := q.k.CalculateDynamicRewardRate(ctx)
 		// Convert rate to percentage (rate / 3175 * 100)
@@ -34,7 +36,6 @@ func (q queryServer) DynamicRewardState(ctx context.Context, req *types.QueryDyn
 			LastUpdateTime: sdk.UnwrapSDKContext(ctx).BlockTime().Unix(),
 		}
 	} else {
-		q.k.cdc.MustUnmarshal(bz, &state)
 		// The stored rate is already a percentage (0-1), convert to 0-100
 		state.CurrentAnnualRate = state.CurrentAnnualRate.Mul(math.LegacyNewDec(100))
 	}
@@ -7,6 +7,7 @@ import (
 	"mychain/x/dex/types"
 
 	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -16,6 +17,10 @@ func (q queryServer) Trades(ctx context.Context, req *types.QueryTradesRequest)
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
+	if req.Pagination != nil {
+		return q.paginateTrades(ctx, req)
+	}
+
 	// Set default limit
 	limit := uint32(100)
 	if req.Limit > 0 && req.Limit <= 1000 {
@@ -69,4 +74,45 @@ func (q queryServer) Trades(ctx context.Context, req *types.QueryTradesRequest)
 	return &types.QueryTradesResponse{
 		Trades: trades,
 	}, nil
-}
\ No newline at end of file
+}
+
+// paginateTrades returns a page of trades, oldest first, optionally limited to
+// one trading pair.
+func (q queryServer) paginateTrades(ctx context.Context, req *types.QueryTradesRequest) (*types.QueryTradesResponse, error) {
+	var (
+		trades  []types.Trade
+		pageRes *query.PageResponse
+		err     error
+	)
+	if req.PairId > 0 {
+		if _, err := q.k.TradingPairs.Get(ctx, req.PairId); err != nil {
+			return nil, status.Error(codes.NotFound, "trading pair not found")
+		}
+		trades, pageRes, err = query.CollectionPaginate(
+			ctx,
+			q.k.PairTrades,
+			req.Pagination,
+			func(_ collections.Pair[uint64, uint64], tradeID uint64) (types.Trade, error) {
+				return q.k.Trades.Get(ctx, tradeID)
+			},
+			query.WithCollectionPaginationPairPrefix[uint64, uint64](req.PairId),
+		)
+	} else {
+		trades, pageRes, err = query.CollectionPaginate(
+			ctx,
+			q.k.Trades,
+			req.Pagination,
+			func(_ uint64, trade types.Trade) (types.Trade, error) {
+				return trade, nil
+			},
+		)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTradesResponse{
+		Trades:     trades,
+		Pagination: pageRes,
+	}, nil
+}
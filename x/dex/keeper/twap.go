@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"mychain/x/dex/types"
+)
+
+// GetTWAP returns the time-weighted average price of baseDenom in quoteDenom over the
+// trailing window. When no pair trades the two denoms directly, the price is routed
+// through a denom that both of them trade against.
+// It implements the maincoin PriceAdapter interface.
+func (k Keeper) GetTWAP(ctx context.Context, baseDenom, quoteDenom string, window time.Duration) (math.LegacyDec, error) {
+	price, err := k.directTWAP(ctx, baseDenom, quoteDenom, window)
+	if err == nil || !errors.Is(err, types.ErrInvalidTradingPair) {
+		return price, err
+	}
+
+	// Route through an intermediate denom, e.g. LC -> MC -> TUSD
+	var routed math.LegacyDec
+	walkErr := k.TradingPairs.Walk(ctx, nil, func(_ uint64, pair types.TradingPair) (bool, error) {
+		var mid string
+		switch baseDenom {
+		case pair.BaseDenom:
+			mid = pair.QuoteDenom
+		case pair.QuoteDenom:
+			mid = pair.BaseDenom
+		default:
+			return false, nil
+		}
+
+		toMid, err := k.directTWAP(ctx, baseDenom, mid, window)
+		if err != nil {
+			return false, nil
+		}
+		toQuote, err := k.directTWAP(ctx, mid, quoteDenom, window)
+		if err != nil {
+			return false, nil
+		}
+		routed = toMid.Mul(toQuote)
+		return true, nil
+	})
+	if walkErr != nil {
+		return math.LegacyDec{}, walkErr
+	}
+	if routed.IsNil() {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidTradingPair, "no route from %s to %s", baseDenom, quoteDenom)
+	}
+	return routed, nil
+}
+
+// directTWAP prices baseDenom in quoteDenom from a single active pair, inverting the
+// pair's price when it is quoted the other way round.
+func (k Keeper) directTWAP(ctx context.Context, baseDenom, quoteDenom string, window time.Duration) (math.LegacyDec, error) {
+	var (
+		pairID   uint64
+		inverted bool
+		found    bool
+	)
+	err := k.TradingPairs.Walk(ctx, nil, func(id uint64, pair types.TradingPair) (bool, error) {
+		if !pair.Active {
+			return false, nil
+		}
+		if pair.BaseDenom == baseDenom && pair.QuoteDenom == quoteDenom {
+			pairID, found = id, true
+			return true, nil
+		}
+		if pair.BaseDenom == quoteDenom && pair.QuoteDenom == baseDenom {
+			pairID, inverted, found = id, true, true
+			return true, nil
+		}
+		return false, nil
+	})
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if !found {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidTradingPair, "no active pair for %s/%s", baseDenom, quoteDenom)
+	}
+
+	price, err := k.PairTWAP(ctx, pairID, window)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if inverted {
+		return math.LegacyOneDec().Quo(price), nil
+	}
+	return price, nil
+}
+
+// PairTWAP returns the time-weighted average trade price of a pair, in quote units per
+// base unit, over the trailing window. Each trade price holds until the next trade, so
+// the last trade before the window prices the start of the window.
+func (k Keeper) PairTWAP(ctx context.Context, pairID uint64, window time.Duration) (math.LegacyDec, error) {
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	start := now - int64(window/time.Second)
+
+	weighted := math.LegacyZeroDec()
+	covered := int64(0)
+	end := now
+	var latest math.LegacyDec
+
+	rng := collections.NewPrefixedPairRange[uint64, uint64](pairID).Descending()
+	err := k.PairTrades.Walk(ctx, rng, func(_ collections.Pair[uint64, uint64], tradeID uint64) (bool, error) {
+		trade, err := k.Trades.Get(ctx, tradeID)
+		if err != nil {
+			return false, err
+		}
+
+		// Price is per whole base unit in micro quote units
+		price := math.LegacyNewDecFromInt(trade.Price.Amount).Quo(math.LegacyNewDec(1000000))
+		if latest.IsNil() {
+			latest = price
+		}
+
+		from := trade.ExecutedAt
+		if from < start {
+			from = start
+		}
+		if end > from {
+			weighted = weighted.Add(price.MulInt64(end - from))
+			covered += end - from
+		}
+		end = from
+
+		return trade.ExecutedAt <= start, nil
+	})
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if latest.IsNil() {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrNoPriceData, "pair %d", pairID)
+	}
+	if covered == 0 {
+		return latest, nil
+	}
+	return weighted.QuoInt64(covered), nil
+}
@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"mychain/x/dex/keeper"
+	"mychain/x/dex/types"
+)
+
+func setTrade(t *testing.T, ctx sdk.Context, k keeper.Keeper, id, pairID uint64, price int64, executedAt int64) {
+	t.Helper()
+
+	require.NoError(t, k.Trades.Set(ctx, id, types.Trade{
+		Id:         id,
+		PairId:     pairID,
+		Price:      sdk.NewInt64Coin("utusd", price),
+		Amount:     sdk.NewInt64Coin("umc", 1_000_000),
+		ExecutedAt: executedAt,
+	}))
+	require.NoError(t, k.PairTrades.Set(ctx, collections.Join(pairID, id), id))
+}
+
+func TestPairTWAP(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	now := time.Unix(1_700_000_000, 0)
+	ctx = ctx.WithBlockTime(now)
+
+	_, err := k.PairTWAP(ctx, 1, time.Minute)
+	require.ErrorIs(t, err, types.ErrNoPriceData)
+
+	// 2.0 until 40s ago, then 3.0
+	setTrade(t, ctx, k, 1, 1, 2_000_000, now.Unix()-100)
+	setTrade(t, ctx, k, 2, 1, 3_000_000, now.Unix()-40)
+
+	// 20s at 2.0 and 40s at 3.0
+	twap, err := k.PairTWAP(ctx, 1, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(8).QuoInt64(3), twap)
+
+	// A window the last trade covers entirely
+	twap, err = k.PairTWAP(ctx, 1, 30*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(3), twap)
+
+	// An empty window falls back to the latest trade
+	twap, err = k.PairTWAP(ctx, 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(3), twap)
+}
+
+func TestGetTWAP(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	now := time.Unix(1_700_000_000, 0)
+	ctx = ctx.WithBlockTime(now)
+
+	require.NoError(t, k.TradingPairs.Set(ctx, 1, types.TradingPair{Id: 1, BaseDenom: "umc", QuoteDenom: "utusd", Active: true}))
+	require.NoError(t, k.TradingPairs.Set(ctx, 2, types.TradingPair{Id: 2, BaseDenom: "ulc", QuoteDenom: "umc", Active: true}))
+	require.NoError(t, k.TradingPairs.Set(ctx, 3, types.TradingPair{Id: 3, BaseDenom: "uatom", QuoteDenom: "utusd", Active: false}))
+	setTrade(t, ctx, k, 1, 1, 2_000_000, now.Unix()-100)
+	setTrade(t, ctx, k, 2, 2, 500_000, now.Unix()-100)
+	setTrade(t, ctx, k, 3, 3, 9_000_000, now.Unix()-100)
+
+	direct, err := k.GetTWAP(ctx, "umc", "utusd", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(2), direct)
+
+	inverted, err := k.GetTWAP(ctx, "utusd", "umc", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 1), inverted)
+
+	// LC has no TUSD pair, so it is priced through MC
+	routed, err := k.GetTWAP(ctx, "ulc", "utusd", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyOneDec(), routed)
+
+	// Inactive pairs are not used
+	_, err = k.GetTWAP(ctx, "uatom", "utusd", time.Minute)
+	require.ErrorIs(t, err, types.ErrInvalidTradingPair)
+}
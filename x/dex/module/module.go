@@ -4,10 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"os"
 
 	"cosmossdk.io/core/appmodule"
-	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -25,7 +23,8 @@ import (
 var (
 	_ module.AppModuleBasic = (*AppModule)(nil)
 	_ module.AppModule      = (*AppModule)(nil)
-	_ module.HasABCIGenesis = (*AppModule)(nil) // Add this interface like staking module
+	_ module.HasGenesis     = (*AppModule)(nil)
+	_ module.HasInvariants  = (*AppModule)(nil)
 
 	_ appmodule.AppModule       = (*AppModule)(nil)
 	_ appmodule.HasBeginBlocker = (*AppModule)(nil)
@@ -92,6 +91,16 @@ func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
 	types.RegisterMsgServer(registrar, keeper.NewMsgServerImpl(*am.keeper))
 	types.RegisterQueryServer(registrar, keeper.NewQueryServerImpl(*am.keeper))
 
+	if cfg, ok := registrar.(module.Configurator); ok {
+		m := keeper.NewMigrator(am.keeper)
+		if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+			return fmt.Errorf("failed to migrate x/%s from version 1 to 2: %w", types.ModuleName, err)
+		}
+		if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+			return fmt.Errorf("failed to migrate x/%s from version 2 to 3: %w", types.ModuleName, err)
+		}
+	}
+
 	return nil
 }
 
@@ -112,169 +121,37 @@ func (am AppModule) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodin
 
 // InitGenesis performs genesis initialization for the dex module.
 // It returns no validator updates.
-func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) {
 	var genesisState types.GenesisState
 	cdc.MustUnmarshalJSON(data, &genesisState)
 	
-	am.keeper.Logger(ctx).Info("DEX InitGenesis called", 
-		"base_reward_rate", genesisState.Params.BaseRewardRate,
-		"fees_enabled", genesisState.Params.FeesEnabled)
-	
 	if err := am.keeper.InitGenesis(ctx, genesisState); err != nil {
-		panic(err)
+		panic(fmt.Errorf("failed to initialize %s genesis state: %w", types.ModuleName, err))
 	}
-	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis returns the exported genesis state as raw bytes for the dex module.
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
 	genState, err := am.keeper.ExportGenesis(ctx)
 	if err != nil {
-		panic(err)
+		panic(fmt.Errorf("failed to export %s genesis state: %w", types.ModuleName, err))
 	}
 	return cdc.MustMarshalJSON(genState)
 }
 
+// RegisterInvariants registers the dex module invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, *am.keeper)
+}
 
 // ConsensusVersion is a sequence number for state-breaking change of the module.
 // It should be incremented on each consensus-breaking change introduced by the module.
 // To avoid wrong/empty versions, the initial version should be set to 1.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 3 }
 
 // BeginBlock contains the logic that is automatically triggered at the beginning of each block.
 // The begin block implementation is optional.
 func (am AppModule) BeginBlock(ctx context.Context) error {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	
-	// Debug: Log that BeginBlock was called
-	if sdkCtx.BlockHeight() <= 2 {
-		// Use the same logging approach as maincoin
-		os.Stderr.WriteString(fmt.Sprintf("DEX: BeginBlock called at height %d\n", sdkCtx.BlockHeight()))
-	}
-	
-	// One-time matching of existing crossed orders
-	// This is needed because orders created before matching implementation won't match
-	// Check if fix has been applied by looking for a specific key in state
-	fixAppliedKey := []byte("dex_crossed_orders_fix_applied")
-	storeService := am.keeper.GetStoreService()
-	store := storeService.OpenKVStore(ctx)
-	hasKey, err := store.Has(fixAppliedKey)
-	if err == nil && !hasKey {
-		am.keeper.Logger(ctx).Info("Running one-time order matching for existing crossed orders")
-		if err := am.keeper.MatchAllCrossedOrders(ctx); err != nil {
-			am.keeper.Logger(ctx).Error("Failed to match crossed orders", "error", err)
-		} else {
-			// Mark as applied
-			if err := store.Set(fixAppliedKey, []byte{1}); err == nil {
-				am.keeper.Logger(ctx).Info("Crossed orders fix applied successfully")
-			}
-		}
-	}
-	
-	// Initialize on first block if not already initialized
-	// This is a workaround because InitGenesis is not being called by the framework for custom modules
-	if sdkCtx.BlockHeight() == 1 {
-		// Check if module is initialized by trying to get params
-		if _, err := am.keeper.Params.Get(ctx); err != nil {
-			am.keeper.Logger(ctx).Info("DEX: Module not initialized, initializing in BeginBlock at height 1")
-			
-			// Load genesis from file like maincoin does
-			genesisFile := "/home/dk/.mychain/config/genesis.json"
-			genesisData, err := os.ReadFile(genesisFile)
-			if err != nil {
-				am.keeper.Logger(ctx).Error("DEX: Failed to read genesis file", "error", err)
-				// Fall back to default genesis
-				genState := types.DefaultGenesis()
-				if err := am.keeper.InitGenesis(ctx, *genState); err != nil {
-					am.keeper.Logger(ctx).Error("DEX: Failed to initialize with default genesis", "error", err)
-					return nil
-				}
-				am.keeper.Logger(ctx).Info("DEX: Module initialized with default genesis")
-				return nil
-			}
-			
-			var genesisDoc map[string]interface{}
-			if err := json.Unmarshal(genesisData, &genesisDoc); err != nil {
-				am.keeper.Logger(ctx).Error("DEX: Failed to unmarshal genesis doc", "error", err)
-				// Fall back to default genesis
-				genState := types.DefaultGenesis()
-				if err := am.keeper.InitGenesis(ctx, *genState); err != nil {
-					am.keeper.Logger(ctx).Error("DEX: Failed to initialize with default genesis", "error", err)
-					return nil
-				}
-				am.keeper.Logger(ctx).Info("DEX: Module initialized with default genesis")
-				return nil
-			}
-			
-			appState, ok := genesisDoc["app_state"].(map[string]interface{})
-			if !ok {
-				am.keeper.Logger(ctx).Error("DEX: No app_state in genesis")
-				// Fall back to default genesis
-				genState := types.DefaultGenesis()
-				if err := am.keeper.InitGenesis(ctx, *genState); err != nil {
-					am.keeper.Logger(ctx).Error("DEX: Failed to initialize with default genesis", "error", err)
-					return nil
-				}
-				am.keeper.Logger(ctx).Info("DEX: Module initialized with default genesis")
-				return nil
-			}
-			
-			dexGenesis, ok := appState["dex"]
-			if !ok {
-				am.keeper.Logger(ctx).Error("DEX: No dex genesis state")
-				// Fall back to default genesis
-				genState := types.DefaultGenesis()
-				if err := am.keeper.InitGenesis(ctx, *genState); err != nil {
-					am.keeper.Logger(ctx).Error("DEX: Failed to initialize with default genesis", "error", err)
-					return nil
-				}
-				am.keeper.Logger(ctx).Info("DEX: Module initialized with default genesis")
-				return nil
-			}
-			
-			// Marshal dex genesis to JSON then unmarshal to our type
-			dexGenesisBytes, err := json.Marshal(dexGenesis)
-			if err != nil {
-				am.keeper.Logger(ctx).Error("DEX: Failed to marshal dex genesis", "error", err)
-				// Fall back to default genesis
-				genState := types.DefaultGenesis()
-				if err := am.keeper.InitGenesis(ctx, *genState); err != nil {
-					am.keeper.Logger(ctx).Error("DEX: Failed to initialize with default genesis", "error", err)
-					return nil
-				}
-				am.keeper.Logger(ctx).Info("DEX: Module initialized with default genesis")
-				return nil
-			}
-			
-			var genState types.GenesisState
-			if err := am.cdc.UnmarshalJSON(dexGenesisBytes, &genState); err != nil {
-				am.keeper.Logger(ctx).Error("DEX: Failed to unmarshal dex genesis state", "error", err)
-				// Fall back to default genesis
-				defaultGenState := types.DefaultGenesis()
-				if err := am.keeper.InitGenesis(ctx, *defaultGenState); err != nil {
-					am.keeper.Logger(ctx).Error("DEX: Failed to initialize with default genesis", "error", err)
-					return nil
-				}
-				am.keeper.Logger(ctx).Info("DEX: Module initialized with default genesis")
-				return nil
-			}
-			
-			am.keeper.Logger(ctx).Info("DEX: Initializing from genesis", 
-				"base_reward_rate", genState.Params.BaseRewardRate, 
-				"fees_enabled", genState.Params.FeesEnabled)
-			
-			if err := am.keeper.InitGenesis(ctx, genState); err != nil {
-				am.keeper.Logger(ctx).Error("DEX: Failed to initialize from genesis", "error", err)
-				return nil
-			}
-			
-			am.keeper.Logger(ctx).Info("DEX: Module initialized successfully")
-		}
-	}
-	
-	// Now proceed with normal BeginBlock operations
-	// The module should be initialized at this point
-	
 	// Distribute liquidity rewards with dynamic rate adjustment
 	// Uses tier-based volume caps to prevent manipulation
 	// Dynamic rate: 7-100% APR based on total liquidity depth
@@ -305,24 +182,6 @@ func (am AppModule) BeginBlock(ctx context.Context) error {
 func (am AppModule) EndBlock(ctx context.Context) error {
 	am.keeper.Logger(ctx).Info("DEX EndBlock called")
 	
-	// One-time fix for order 18 - run again with v2 key
-	// Check if fix has been applied
-	fixAppliedKey := []byte("order_18_fix_applied_v2")
-	storeService := am.keeper.GetStoreService()
-	store := storeService.OpenKVStore(ctx)
-	hasKey, err := store.Has(fixAppliedKey)
-	if err == nil && !hasKey {
-		am.keeper.Logger(ctx).Info("Running one-time fix for order 18 (v2)")
-		if err := am.keeper.FixOrder18(ctx); err != nil {
-			am.keeper.Logger(ctx).Error("failed to fix order 18", "error", err)
-		} else {
-			// Mark as applied
-			if err := store.Set(fixAppliedKey, []byte{1}); err == nil {
-				am.keeper.Logger(ctx).Info("Order 18 fix applied successfully (v2)")
-			}
-		}
-	}
-	
 	// Match all crossed orders
 	if err := am.keeper.MatchAllCrossedOrders(ctx); err != nil {
 		// Log error but don't halt the chain
@@ -13,14 +13,20 @@ import (
 
 // GenerateGenesisState creates a randomized GenState of the module.
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
-	accs := make([]string, len(simState.Accounts))
-	for i, acc := range simState.Accounts {
-		accs[i] = acc.Address.String()
+	dexGenesis := types.DefaultGenesis()
+	dexGenesis.Params = dexsimulation.RandomParams(simState.Rand)
+	// Fees are collected in LC, which the dex module account may not be able
+	// to burn; SimulateMsgUpdateDexParams enables them where it can
+	dexGenesis.Params.FeesEnabled = false
+	dexGenesis.TradingPairs = []types.TradingPair{
+		{Id: 1, BaseDenom: "umc", QuoteDenom: "utusd", Active: true},
+		{Id: 2, BaseDenom: "umc", QuoteDenom: "ulc", Active: true},
 	}
-	dexGenesis := types.GenesisState{
-		Params: types.DefaultParams(),
+	// Now and then start without trading pairs, for MsgInitDexState to set up
+	if simState.Rand.Intn(10) == 0 {
+		dexGenesis.TradingPairs = []types.TradingPair{}
 	}
-	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&dexGenesis)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(dexGenesis)
 }
 
 // RegisterStoreDecoder registers a decoder.
@@ -30,7 +36,7 @@ func (am AppModule) RegisterStoreDecoder(_ simtypes.StoreDecoderRegistry) {}
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
 	operations := make([]simtypes.WeightedOperation, 0)
 	const (
-		opWeightMsgCreateOrder          = "op_weight_msg_dex"
+		opWeightMsgCreateOrder          = "op_weight_msg_create_order"
 		defaultWeightMsgCreateOrder int = 100
 	)
 
@@ -45,8 +51,8 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		dexsimulation.SimulateMsgCreateOrder(am.authKeeper, am.bankKeeper, *am.keeper, simState.TxConfig),
 	))
 	const (
-		opWeightMsgCancelOrder          = "op_weight_msg_dex"
-		defaultWeightMsgCancelOrder int = 100
+		opWeightMsgCancelOrder          = "op_weight_msg_cancel_order"
+		defaultWeightMsgCancelOrder int = 40
 	)
 
 	var weightMsgCancelOrder int
@@ -60,8 +66,8 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		dexsimulation.SimulateMsgCancelOrder(am.authKeeper, am.bankKeeper, *am.keeper, simState.TxConfig),
 	))
 	const (
-		opWeightMsgClaimRewards          = "op_weight_msg_dex"
-		defaultWeightMsgClaimRewards int = 100
+		opWeightMsgClaimRewards          = "op_weight_msg_claim_rewards"
+		defaultWeightMsgClaimRewards int = 20
 	)
 
 	var weightMsgClaimRewards int
@@ -74,11 +80,82 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		weightMsgClaimRewards,
 		dexsimulation.SimulateMsgClaimRewards(am.authKeeper, am.bankKeeper, *am.keeper, simState.TxConfig),
 	))
+	const (
+		opWeightMsgClaimOrderRewards          = "op_weight_msg_claim_order_rewards"
+		defaultWeightMsgClaimOrderRewards int = 20
+	)
+
+	var weightMsgClaimOrderRewards int
+	simState.AppParams.GetOrGenerate(opWeightMsgClaimOrderRewards, &weightMsgClaimOrderRewards, nil,
+		func(_ *rand.Rand) {
+			weightMsgClaimOrderRewards = defaultWeightMsgClaimOrderRewards
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgClaimOrderRewards,
+		dexsimulation.SimulateMsgClaimOrderRewards(am.authKeeper, am.bankKeeper, *am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgCreateTradingPair          = "op_weight_msg_create_trading_pair"
+		defaultWeightMsgCreateTradingPair int = 5
+	)
+
+	var weightMsgCreateTradingPair int
+	simState.AppParams.GetOrGenerate(opWeightMsgCreateTradingPair, &weightMsgCreateTradingPair, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateTradingPair = defaultWeightMsgCreateTradingPair
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCreateTradingPair,
+		dexsimulation.SimulateMsgCreateTradingPair(am.authKeeper, am.bankKeeper, *am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgInitDexState          = "op_weight_msg_init_dex_state"
+		defaultWeightMsgInitDexState int = 5
+	)
+
+	var weightMsgInitDexState int
+	simState.AppParams.GetOrGenerate(opWeightMsgInitDexState, &weightMsgInitDexState, nil,
+		func(_ *rand.Rand) {
+			weightMsgInitDexState = defaultWeightMsgInitDexState
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgInitDexState,
+		dexsimulation.SimulateMsgInitDexState(am.authKeeper, am.bankKeeper, *am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgUpdateDexParams          = "op_weight_msg_update_dex_params"
+		defaultWeightMsgUpdateDexParams int = 5
+	)
+
+	var weightMsgUpdateDexParams int
+	simState.AppParams.GetOrGenerate(opWeightMsgUpdateDexParams, &weightMsgUpdateDexParams, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateDexParams = defaultWeightMsgUpdateDexParams
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUpdateDexParams,
+		dexsimulation.SimulateMsgUpdateDexParams(am.authKeeper, am.bankKeeper, *am.keeper, simState.TxConfig),
+	))
 
 	return operations
 }
 
 // ProposalMsgs returns msgs used for governance proposals for simulations.
 func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.WeightedProposalMsg {
-	return []simtypes.WeightedProposalMsg{}
+	const (
+		opWeightMsgUpdateParams          = "op_weight_msg_dex_update_params"
+		defaultWeightMsgUpdateParams int = 10
+	)
+
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgUpdateParams,
+			defaultWeightMsgUpdateParams,
+			dexsimulation.SimulateMsgUpdateParams(am.authKeeper, *am.keeper),
+		),
+	}
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 
 	"mychain/x/dex/keeper"
 	"mychain/x/dex/types"
@@ -20,13 +21,32 @@ func SimulateMsgCancelOrder(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgCancelOrder{
-			Maker: simAccount.Address.String(),
+		msg := &types.MsgCancelOrder{}
+		simAccount, orders, found, err := randomMaker(r, ctx, k, accs)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to read open orders"), nil, err
 		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no account has open orders"), nil, nil
+		}
+		msg.Maker = simAccount.Address.String()
+		msg.OrderId = orders[r.Intn(len(orders))].Id
 
-		// TODO: Handle the CancelOrder simulation
-
-		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "CancelOrder simulation not implemented"), nil, nil
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         txGen,
+			Cdc:           nil,
+			Msg:           msg,
+			Context:       ctx,
+			SimAccount:    simAccount,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    types.ModuleName,
+			// Leave the LC a cancellation fee may be charged in
+			CoinsSpentInMsg: sdk.NewCoins(sdk.NewCoin("ulc", bk.SpendableCoins(ctx, simAccount.Address).AmountOf("ulc"))),
+		}
+		// Makers without the LC for the cancellation fee cannot cancel
+		return deliverTx(txCtx, types.ErrInsufficientBalance)
 	}
 }
@@ -0,0 +1,61 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"mychain/x/dex/keeper"
+	"mychain/x/dex/types"
+)
+
+func SimulateMsgClaimOrderRewards(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgClaimOrderRewards{}
+		if !moduleHasPermission(ctx, ak, authtypes.Minter) {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "dex module account cannot mint"), nil, nil
+		}
+		simAccount, orders, found, err := randomMaker(r, ctx, k, accs)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to read open orders"), nil, err
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no account has open orders"), nil, nil
+		}
+		msg.User = simAccount.Address.String()
+		for _, order := range orders {
+			if r.Intn(2) == 0 {
+				msg.OrderIds = append(msg.OrderIds, order.Id)
+			}
+		}
+		if len(msg.OrderIds) == 0 {
+			msg.OrderIds = []uint64{orders[0].Id}
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return deliverTx(txCtx, types.ErrNoRewardsAvailable)
+	}
+}
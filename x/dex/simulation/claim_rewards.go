@@ -3,10 +3,13 @@ package simulation
 import (
 	"math/rand"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 
 	"mychain/x/dex/keeper"
 	"mychain/x/dex/types"
@@ -20,13 +23,43 @@ func SimulateMsgClaimRewards(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
 		msg := &types.MsgClaimRewards{
-			User: simAccount.Address.String(),
+			Amount: math.ZeroInt(),
 		}
+		if !moduleHasPermission(ctx, ak, authtypes.Minter) {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "dex module account cannot mint"), nil, nil
+		}
+		// Rewards accrue on open orders, so their makers are the claimants
+		simAccount, _, found, err := randomMaker(r, ctx, k, accs)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to read open orders"), nil, err
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no account has open orders"), nil, nil
+		}
+		msg.User = simAccount.Address.String()
 
-		// TODO: Handle the ClaimRewards simulation
+		// Claim part of the stored rewards now and then; zero claims all
+		if reward, err := k.UserRewards.Get(ctx, msg.User); err == nil && r.Intn(2) == 0 {
+			claimable := reward.TotalRewards.Sub(reward.ClaimedRewards)
+			if claimable.GT(math.OneInt()) {
+				msg.Amount = claimable.QuoRaw(2)
+			}
+		}
 
-		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "ClaimRewards simulation not implemented"), nil, nil
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return deliverTx(txCtx, types.ErrNoRewardsAvailable)
 	}
 }
@@ -3,10 +3,12 @@ package simulation
 import (
 	"math/rand"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 
 	"mychain/x/dex/keeper"
 	"mychain/x/dex/types"
@@ -25,8 +27,64 @@ func SimulateMsgCreateOrder(
 			Maker: simAccount.Address.String(),
 		}
 
-		// TODO: Handle the CreateOrder simulation
+		pairs, err := activePairs(ctx, k)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to read trading pairs"), nil, err
+		}
+		if len(pairs) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no active trading pairs"), nil, nil
+		}
+		orders, err := openOrders(ctx, k, msg.Maker)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to read open orders"), nil, err
+		}
+		if len(orders) >= maxOpenOrdersPerMaker {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "maker has too many open orders"), nil, nil
+		}
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to read params"), nil, err
+		}
 
-		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "CreateOrder simulation not implemented"), nil, nil
+		pair := pairs[r.Intn(len(pairs))]
+		msg.PairId = pair.Id
+		msg.IsBuy = r.Intn(2) == 0
+		// The LC a bid locks would be burned at the end of the block
+		if msg.IsBuy && pair.QuoteDenom == "ulc" && !canBurn(ctx, ak) {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "dex module account cannot burn"), nil, nil
+		}
+
+		// CreateOrder locks the quote of whole base units only, so orders
+		// are for whole units to keep the escrow covering them
+		unit := types.MicroUnitsPerWholeUnitDec
+		minAmount := math.MaxInt(params.MinOrderAmount, math.OneInt()).Add(unit.SubRaw(1)).Quo(unit).Mul(unit)
+		msg.Price = sdk.NewCoin(pair.QuoteDenom, randomPrice(r, pair.QuoteDenom))
+		msg.Amount = sdk.NewCoin(pair.BaseDenom, minAmount.MulRaw(int64(simtypes.RandIntBetween(r, 1, 101))))
+
+		lock := types.OrderLock(msg.IsBuy, msg.Price, msg.Amount)
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		if spendable.AmountOf(lock.Denom).LT(lock.Amount) {
+			// Sell what the maker holds rather than skipping when it has some
+			if msg.IsBuy || spendable.AmountOf(lock.Denom).LT(minAmount) {
+				return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "insufficient funds to lock"), nil, nil
+			}
+			msg.Amount.Amount = math.MaxInt(minAmount, spendable.AmountOf(lock.Denom).QuoRaw(2).Quo(unit).Mul(unit))
+			lock = types.OrderLock(msg.IsBuy, msg.Price, msg.Amount)
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(lock),
+		}
+		return deliverTx(txCtx)
 	}
 }
@@ -0,0 +1,60 @@
+package simulation
+
+import (
+	"math/rand"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"mychain/x/dex/keeper"
+	"mychain/x/dex/types"
+)
+
+func SimulateMsgCreateTradingPair(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgCreateTradingPair{
+			Authority:  simAccount.Address.String(),
+			BaseDenom:  "u" + strings.ToLower(simtypes.RandStringOfLength(r, 6)),
+			QuoteDenom: "utusd",
+		}
+		if r.Intn(2) == 0 {
+			msg.QuoteDenom = "ulc"
+		}
+
+		pairs, err := activePairs(ctx, k)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to read trading pairs"), nil, err
+		}
+		for _, pair := range pairs {
+			if pair.BaseDenom == msg.BaseDenom && pair.QuoteDenom == msg.QuoteDenom {
+				return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "trading pair already exists"), nil, nil
+			}
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return deliverTx(txCtx)
+	}
+}
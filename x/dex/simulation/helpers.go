@@ -0,0 +1,128 @@
+package simulation
+
+import (
+	"errors"
+	"math/rand"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"mychain/x/dex/keeper"
+	"mychain/x/dex/types"
+)
+
+// maxOpenOrdersPerMaker keeps the order book small enough for the matching
+// engine to stay fast over a long simulation.
+const maxOpenOrdersPerMaker = 5
+
+// deliverTx delivers the message of txCtx with random fees. A rejection with
+// one of the expected errors is reported as a no-op rather than a failure.
+func deliverTx(txCtx simulation.OperationInput, expected ...error) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	opMsg, futureOps, err := simulation.GenAndDeliverTxWithRandFees(txCtx)
+	for _, e := range expected {
+		if errors.Is(err, e) {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(txCtx.Msg), err.Error()), nil, nil
+		}
+	}
+	return opMsg, futureOps, err
+}
+
+// moduleHasPermission reports whether the dex module account holds perm.
+// The dex burns the LC it holds every EndBlock and mints LC rewards, both of
+// which panic without the permission, so operations that lead there wait
+// for the account to have it.
+func moduleHasPermission(ctx sdk.Context, ak types.AuthKeeper, perm string) bool {
+	acc, ok := ak.GetAccount(ctx, ak.GetModuleAddress(types.ModuleName)).(sdk.ModuleAccountI)
+	return ok && acc.HasPermission(perm)
+}
+
+// canBurn reports whether LC may end up in the dex module account.
+func canBurn(ctx sdk.Context, ak types.AuthKeeper) bool {
+	return moduleHasPermission(ctx, ak, authtypes.Burner)
+}
+
+// openOrders returns the orders of maker that are not fully filled.
+func openOrders(ctx sdk.Context, k keeper.Keeper, maker string) ([]types.Order, error) {
+	var orders []types.Order
+	err := k.UserOrders.Walk(ctx, collections.NewPrefixedPairRange[string, uint64](maker), func(_ collections.Pair[string, uint64], orderID uint64) (bool, error) {
+		order, err := k.Orders.Get(ctx, orderID)
+		if err != nil {
+			return true, err
+		}
+		if order.Remaining().IsPositive() {
+			orders = append(orders, order)
+		}
+		return false, nil
+	})
+	return orders, err
+}
+
+// randomMaker returns a random account among accs with open orders, along
+// with those orders.
+func randomMaker(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (simtypes.Account, []types.Order, bool, error) {
+	var (
+		makers []simtypes.Account
+		orders [][]types.Order
+	)
+	for _, acc := range accs {
+		open, err := openOrders(ctx, k, acc.Address.String())
+		if err != nil {
+			return simtypes.Account{}, nil, false, err
+		}
+		if len(open) > 0 {
+			makers = append(makers, acc)
+			orders = append(orders, open)
+		}
+	}
+	if len(makers) == 0 {
+		return simtypes.Account{}, nil, false, nil
+	}
+	i := r.Intn(len(makers))
+	return makers[i], orders[i], true, nil
+}
+
+// activePairs returns the trading pairs that accept orders.
+func activePairs(ctx sdk.Context, k keeper.Keeper) ([]types.TradingPair, error) {
+	var pairs []types.TradingPair
+	err := k.TradingPairs.Walk(ctx, nil, func(_ uint64, pair types.TradingPair) (bool, error) {
+		if pair.Active {
+			pairs = append(pairs, pair)
+		}
+		return false, nil
+	})
+	return pairs, err
+}
+
+// randomPrice returns a price per whole base unit in quoteDenom around the
+// launch price of MainCoin: 0.0001 TestUSD, or 10,000 LC.
+func randomPrice(r *rand.Rand, quoteDenom string) math.Int {
+	switch quoteDenom {
+	case "ulc":
+		return math.NewInt(int64(simtypes.RandIntBetween(r, 5_000, 20_001))).MulRaw(1_000_000)
+	default:
+		return math.NewInt(int64(simtypes.RandIntBetween(r, 50, 501)))
+	}
+}
+
+// RandomParams returns valid params with randomized fees, minimum order and
+// reward rate.
+func RandomParams(r *rand.Rand) types.Params {
+	params := types.DefaultParams()
+	params.FeesEnabled = r.Intn(2) == 0
+	params.MinOrderAmount = math.NewInt(int64(simtypes.RandIntBetween(r, 100_000, 1_000_001)))
+	params.BaseRewardRate = math.NewInt(int64(simtypes.RandIntBetween(r, 100, 501)))
+	params.BaseMakerFeePercentage = randomFeeRate(r)
+	params.BaseTakerFeePercentage = randomFeeRate(r)
+	params.BaseCancelFeePercentage = randomFeeRate(r)
+	params.BaseSellFeePercentage = randomFeeRate(r)
+	return params
+}
+
+// randomFeeRate returns a fee rate between 0.01% and 0.1%.
+func randomFeeRate(r *rand.Rand) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 11)), 4)
+}
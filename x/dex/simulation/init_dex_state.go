@@ -0,0 +1,62 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"mychain/x/dex/keeper"
+	"mychain/x/dex/types"
+)
+
+func SimulateMsgInitDexState(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgInitDexState{
+			Authority: simAccount.Address.String(),
+		}
+
+		// Initializing again resets the next order ID, so it is only sent to a
+		// DEX without trading pairs
+		initialized := false
+		err := k.TradingPairs.Walk(ctx, nil, func(uint64, types.TradingPair) (bool, error) {
+			initialized = true
+			return true, nil
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to read trading pairs"), nil, err
+		}
+		if initialized {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "dex state is already initialized"), nil, nil
+		}
+		// The default params it sets enable fees, which are collected in LC
+		if !canBurn(ctx, ak) {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "dex module account cannot burn"), nil, nil
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return deliverTx(txCtx)
+	}
+}
@@ -0,0 +1,28 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"mychain/x/dex/keeper"
+	"mychain/x/dex/types"
+)
+
+// SimulateMsgUpdateParams returns a governance proposal message that sets
+// random params.
+func SimulateMsgUpdateParams(ak types.AuthKeeper, k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		authority, err := ak.AddressCodec().BytesToString(k.GetAuthority())
+		if err != nil {
+			panic(err)
+		}
+		params := RandomParams(r)
+		params.FeesEnabled = params.FeesEnabled && canBurn(ctx, ak)
+		return &types.MsgUpdateParams{
+			Authority: authority,
+			Params:    params,
+		}
+	}
+}
@@ -23,4 +23,6 @@ var (
 	ErrNoRewardsToClaim     = errors.Register(ModuleName, 1112, "no rewards to claim")
 	ErrOrderAlreadyFilled   = errors.Register(ModuleName, 1113, "order already filled")
 	ErrNoRewardsAvailable   = errors.Register(ModuleName, 1114, "no rewards available")
+	ErrNoPriceData          = errors.Register(ModuleName, 1115, "no trades to price pair")
+	ErrInvalidGenesis       = errors.Register(ModuleName, 1116, "invalid genesis state")
 )
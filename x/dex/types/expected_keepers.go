@@ -6,6 +6,8 @@ import (
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	mychaintypes "mychain/x/mychain/types"
 )
 
 // AuthKeeper defines the expected interface for the Auth module.
@@ -37,7 +39,7 @@ type ParamSubspace interface {
 
 // TransactionKeeper defines the expected interface for transaction recording
 type TransactionKeeper interface {
-	RecordTransaction(ctx context.Context, address string, txType string, description string, amount sdk.Coins, from string, to string, metadata string) error
+	RecordTransaction(ctx context.Context, record mychaintypes.TransactionRecord) error
 }
 
 // DistributionKeeper defines the expected interface for the Distribution module.
@@ -1,6 +1,7 @@
 package types
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 )
 
@@ -32,6 +33,9 @@ func DefaultGenesis() *GenesisState {
 		VolumeTrackers:   []VolumeTracker{},
 		PriceReferences:  []PriceReference{},
 		OrderRewards:     []OrderRewardInfo{},
+		LcTotalSupply:    math.ZeroInt(),
+		Trades:           []Trade{},
+		AppliedFixes:     []string{},
 	}
 }
 
@@ -46,16 +50,16 @@ func (gs GenesisState) Validate() error {
 	pairMap := make(map[uint64]bool)
 	for _, pair := range gs.TradingPairs {
 		if pairMap[pair.Id] {
-			return ErrDuplicateTradingPair
+			return errorsmod.Wrapf(ErrDuplicateTradingPair, "pair %d", pair.Id)
 		}
 		pairMap[pair.Id] = true
 		
 		if pair.BaseDenom == "" || pair.QuoteDenom == "" {
-			return ErrInvalidTradingPair
+			return errorsmod.Wrapf(ErrInvalidTradingPair, "pair %d is missing a denom", pair.Id)
 		}
 		
 		if pair.BaseDenom == pair.QuoteDenom {
-			return ErrInvalidTradingPair
+			return errorsmod.Wrapf(ErrInvalidTradingPair, "pair %d trades %s against itself", pair.Id, pair.BaseDenom)
 		}
 	}
 	
@@ -63,17 +67,124 @@ func (gs GenesisState) Validate() error {
 	orderMap := make(map[uint64]bool)
 	for _, order := range gs.Orders {
 		if orderMap[order.Id] {
-			return ErrDuplicateOrder
+			return errorsmod.Wrapf(ErrDuplicateOrder, "order %d", order.Id)
 		}
 		orderMap[order.Id] = true
 		
 		if order.Id >= gs.NextOrderId {
-			return ErrInvalidOrderID
+			return errorsmod.Wrapf(ErrInvalidOrderID, "order %d is not below the next order ID %d", order.Id, gs.NextOrderId)
 		}
 		
 		if !pairMap[order.PairId] {
-			return ErrInvalidPairID
+			return errorsmod.Wrapf(ErrInvalidPairID, "order %d references unknown pair %d", order.Id, order.PairId)
 		}
+		
+		if order.Maker == "" {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "order %d has no maker", order.Id)
+		}
+	}
+	
+	// Validate liquidity tiers
+	tierMap := make(map[uint32]bool)
+	for _, tier := range gs.LiquidityTiers {
+		if tierMap[tier.Id] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate liquidity tier %d", tier.Id)
+		}
+		tierMap[tier.Id] = true
+	}
+	
+	// Validate order rewards. Reward infos outlive cancelled orders until
+	// claimed, so they only need to reference an order ID that was assigned.
+	rewardMap := make(map[uint64]bool)
+	for _, reward := range gs.OrderRewards {
+		if rewardMap[reward.OrderId] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate order reward for order %d", reward.OrderId)
+		}
+		rewardMap[reward.OrderId] = true
+		
+		if reward.OrderId >= gs.NextOrderId {
+			return errorsmod.Wrapf(ErrInvalidOrderID, "order reward references unassigned order %d", reward.OrderId)
+		}
+		
+		if !tierMap[reward.TierId] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "order reward for order %d references unknown tier %d", reward.OrderId, reward.TierId)
+		}
+	}
+	
+	// Validate user rewards
+	userMap := make(map[string]bool)
+	for _, reward := range gs.UserRewards {
+		if reward.Address == "" {
+			return errorsmod.Wrap(ErrInvalidGenesis, "user reward has no address")
+		}
+		if userMap[reward.Address] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate user reward for %s", reward.Address)
+		}
+		userMap[reward.Address] = true
+	}
+	
+	// Validate price references and volume trackers
+	priceMap := make(map[uint64]bool)
+	for _, ref := range gs.PriceReferences {
+		if !pairMap[ref.PairId] {
+			return errorsmod.Wrapf(ErrInvalidPairID, "price reference references unknown pair %d", ref.PairId)
+		}
+		if priceMap[ref.PairId] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate price reference for pair %d", ref.PairId)
+		}
+		priceMap[ref.PairId] = true
+	}
+	
+	volumeMap := make(map[uint64]bool)
+	for _, tracker := range gs.VolumeTrackers {
+		if !pairMap[tracker.PairId] {
+			return errorsmod.Wrapf(ErrInvalidPairID, "volume tracker references unknown pair %d", tracker.PairId)
+		}
+		if volumeMap[tracker.PairId] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate volume tracker for pair %d", tracker.PairId)
+		}
+		volumeMap[tracker.PairId] = true
+	}
+	
+	// Validate trades
+	tradeMap := make(map[uint64]bool)
+	for _, trade := range gs.Trades {
+		if tradeMap[trade.Id] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate trade %d", trade.Id)
+		}
+		tradeMap[trade.Id] = true
+		
+		if trade.Id >= gs.NextTradeId {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "trade %d is not below the next trade ID %d", trade.Id, gs.NextTradeId)
+		}
+		
+		if !pairMap[trade.PairId] {
+			return errorsmod.Wrapf(ErrInvalidPairID, "trade %d references unknown pair %d", trade.Id, trade.PairId)
+		}
+		
+		if trade.BuyOrderId >= gs.NextOrderId || trade.SellOrderId >= gs.NextOrderId {
+			return errorsmod.Wrapf(ErrInvalidOrderID, "trade %d references unassigned orders %d and %d", trade.Id, trade.BuyOrderId, trade.SellOrderId)
+		}
+	}
+	
+	if !gs.LcTotalSupply.IsNil() && gs.LcTotalSupply.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidGenesis, "LC total supply cannot be negative")
+	}
+	
+	if gs.DynamicRewardState != nil && (gs.DynamicRewardState.CurrentAnnualRate.IsNil() || gs.DynamicRewardState.CurrentAnnualRate.IsNegative()) {
+		return errorsmod.Wrap(ErrInvalidGenesis, "dynamic reward rate must not be negative")
+	}
+	
+	if gs.LcPriceHistory != nil && (gs.LcPriceHistory.CurrentPrice.IsNil() || !gs.LcPriceHistory.CurrentPrice.IsPositive()) {
+		return errorsmod.Wrap(ErrInvalidGenesis, "LC reference price must be positive")
+	}
+	
+	fixMap := make(map[string]bool)
+	for _, fix := range gs.AppliedFixes {
+		if fix == "" || fixMap[fix] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "invalid or duplicate applied fix %q", fix)
+		}
+		fixMap[fix] = true
 	}
 	
 	return nil
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -44,6 +45,20 @@ type GenesisState struct {
 	PriceReferences []PriceReference `protobuf:"bytes,8,rep,name=price_references,json=priceReferences,proto3" json:"price_references"`
 	// volume_trackers contains volume tracking information
 	VolumeTrackers []VolumeTracker `protobuf:"bytes,9,rep,name=volume_trackers,json=volumeTrackers,proto3" json:"volume_trackers"`
+	// lc_total_supply is the LC supply tracked by the module
+	LcTotalSupply cosmossdk_io_math.Int `protobuf:"bytes,10,opt,name=lc_total_supply,json=lcTotalSupply,proto3,customtype=cosmossdk.io/math.Int" json:"lc_total_supply"`
+	// dynamic_reward_state is the state of the dynamic reward rate, if it has
+	// been initialized
+	DynamicRewardState *DynamicRewardState `protobuf:"bytes,11,opt,name=dynamic_reward_state,json=dynamicRewardState,proto3" json:"dynamic_reward_state,omitempty"`
+	// next_trade_id is the next trade ID to be assigned
+	NextTradeId uint64 `protobuf:"varint,12,opt,name=next_trade_id,json=nextTradeId,proto3" json:"next_trade_id,omitempty"`
+	// trades contains all executed trades
+	Trades []Trade `protobuf:"bytes,13,rep,name=trades,proto3" json:"trades"`
+	// lc_price_history is the LC reference price tracker, if it has been
+	// initialized
+	LcPriceHistory *LCPriceHistory `protobuf:"bytes,14,opt,name=lc_price_history,json=lcPriceHistory,proto3" json:"lc_price_history,omitempty"`
+	// applied_fixes are the names of the one-time state fixes that have run
+	AppliedFixes []string `protobuf:"bytes,15,rep,name=applied_fixes,json=appliedFixes,proto3" json:"applied_fixes,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -142,6 +157,41 @@ func (m *GenesisState) GetVolumeTrackers() []VolumeTracker {
 	return nil
 }
 
+func (m *GenesisState) GetDynamicRewardState() *DynamicRewardState {
+	if m != nil {
+		return m.DynamicRewardState
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextTradeId() uint64 {
+	if m != nil {
+		return m.NextTradeId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetTrades() []Trade {
+	if m != nil {
+		return m.Trades
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLcPriceHistory() *LCPriceHistory {
+	if m != nil {
+		return m.LcPriceHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAppliedFixes() []string {
+	if m != nil {
+		return m.AppliedFixes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "mychain.dex.v1.GenesisState")
 }
@@ -149,36 +199,46 @@ func init() {
 func init() { proto.RegisterFile("mychain/dex/v1/genesis.proto", fileDescriptor_4436eb9629881fe7) }
 
 var fileDescriptor_4436eb9629881fe7 = []byte{
-	// 456 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0x69, 0x08, 0x74, 0x93, 0xb4, 0xb0, 0x7c, 0xc8, 0x72, 0xc1, 0x8d, 0x7a, 0x8a, 0x90,
-	0xb0, 0xd5, 0xf6, 0xc4, 0x35, 0x48, 0xa0, 0xa2, 0x4a, 0xad, 0x4c, 0xe0, 0xc0, 0xc5, 0x5a, 0xb2,
-	0xd3, 0xb0, 0x22, 0xf1, 0x9a, 0xdd, 0xb5, 0x71, 0xfe, 0x05, 0x3f, 0x83, 0x23, 0x3f, 0xa3, 0xc7,
-	0x1e, 0x39, 0x21, 0x94, 0x1c, 0xe0, 0x67, 0x20, 0x8f, 0xd7, 0xfd, 0x30, 0xb9, 0x58, 0xab, 0x37,
-	0xef, 0xbd, 0x79, 0x33, 0x1e, 0xf2, 0x64, 0xbe, 0x98, 0x7c, 0x62, 0x22, 0x09, 0x39, 0x14, 0x61,
-	0xbe, 0x1f, 0x4e, 0x21, 0x01, 0x2d, 0x74, 0x90, 0x2a, 0x69, 0x24, 0xdd, 0xb2, 0xd5, 0x80, 0x43,
-	0x11, 0xe4, 0xfb, 0xde, 0x7d, 0x36, 0x17, 0x89, 0x0c, 0xf1, 0x5b, 0x51, 0xbc, 0x87, 0x53, 0x39,
-	0x95, 0xf8, 0x0c, 0xcb, 0x97, 0x45, 0x77, 0x1a, 0xb6, 0x29, 0x53, 0x6c, 0x6e, 0x5d, 0x3d, 0xaf,
-	0x51, 0x34, 0x8b, 0x14, 0x6c, 0x6d, 0xef, 0x6f, 0x9b, 0xf4, 0x5e, 0x57, 0x19, 0xde, 0x1a, 0x66,
-	0x80, 0xbe, 0x20, 0x9d, 0x4a, 0xec, 0x3a, 0x03, 0x67, 0xd8, 0x3d, 0x78, 0x1c, 0xdc, 0xcc, 0x14,
-	0x9c, 0x62, 0x75, 0xb4, 0x79, 0xfe, 0x6b, 0xb7, 0xf5, 0xfd, 0xcf, 0x8f, 0x67, 0x4e, 0x64, 0x05,
-	0x74, 0x8f, 0xf4, 0x13, 0x28, 0x4c, 0x2c, 0x15, 0x07, 0x15, 0x0b, 0xee, 0xde, 0x1a, 0x38, 0xc3,
-	0x76, 0xd4, 0x2d, 0xc1, 0x93, 0x12, 0x3b, 0xe2, 0xf4, 0x15, 0xe9, 0x1b, 0xc5, 0xb8, 0x48, 0xa6,
-	0x71, 0xca, 0x84, 0xd2, 0xee, 0xc6, 0x60, 0x63, 0xd8, 0x3d, 0xd8, 0x69, 0x76, 0x19, 0x57, 0xa4,
-	0x53, 0x26, 0xd4, 0xa8, 0x5d, 0xb6, 0x8a, 0x7a, 0xe6, 0x0a, 0xd2, 0xf4, 0x90, 0x74, 0xb0, 0x8d,
-	0x76, 0xdb, 0x68, 0xf0, 0xa8, 0x69, 0x80, 0x0d, 0xad, 0xd4, 0x52, 0xe9, 0x4b, 0xd2, 0xcb, 0x34,
-	0xa8, 0x58, 0xc1, 0x57, 0xa6, 0xb8, 0x76, 0x6f, 0xa3, 0xd4, 0x6b, 0x4a, 0xdf, 0x69, 0x50, 0x11,
-	0x52, 0xac, 0xbe, 0x9b, 0x5d, 0x22, 0x9a, 0x1e, 0x93, 0xed, 0x99, 0xf8, 0x92, 0x09, 0x2e, 0xcc,
-	0x22, 0x36, 0xa2, 0x8c, 0xd0, 0x41, 0x9f, 0xa7, 0x4d, 0x9f, 0xe3, 0x9a, 0x36, 0x16, 0x97, 0x51,
-	0xb6, 0x66, 0xd7, 0x41, 0x4d, 0xdf, 0x90, 0x7e, 0xb5, 0xae, 0x3a, 0xd3, 0x1d, 0xf4, 0xda, 0x5d,
-	0x3b, 0x4e, 0x15, 0xe1, 0x28, 0x39, 0x93, 0xf5, 0x4e, 0xe4, 0x15, 0xac, 0xe9, 0x09, 0xb9, 0x97,
-	0x2a, 0x31, 0x81, 0x58, 0xc1, 0x19, 0x28, 0x48, 0x26, 0xa0, 0xdd, 0xbb, 0x68, 0xe7, 0xff, 0xf7,
-	0x13, 0x4b, 0x5e, 0x54, 0xd3, 0xac, 0xdb, 0x76, 0x7a, 0x03, 0xc5, 0x51, 0x73, 0x39, 0xcb, 0xe6,
-	0x10, 0x1b, 0xc5, 0x26, 0x9f, 0xcb, 0x51, 0x37, 0xd7, 0x8f, 0xfa, 0x1e, 0x69, 0xe3, 0x8a, 0x55,
-	0x8f, 0x9a, 0x5f, 0x07, 0xf5, 0xe8, 0xf9, 0xf9, 0xd2, 0x77, 0x2e, 0x96, 0xbe, 0xf3, 0x7b, 0xe9,
-	0x3b, 0xdf, 0x56, 0x7e, 0xeb, 0x62, 0xe5, 0xb7, 0x7e, 0xae, 0xfc, 0xd6, 0x87, 0x07, 0xf5, 0x81,
-	0x16, 0x78, 0xa2, 0x78, 0x9f, 0x1f, 0x3b, 0x78, 0xa0, 0x87, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0xc6, 0xf4, 0xe9, 0xe1, 0x32, 0x03, 0x00, 0x00,
+	// 621 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0x6e, 0xd8, 0xe8, 0xa8, 0xfb, 0x35, 0xcc, 0x86, 0xa2, 0x8e, 0x65, 0xd5, 0xb8, 0x54, 0x48,
+	0xa4, 0xda, 0x76, 0xe2, 0xda, 0xc1, 0x58, 0xd1, 0xa4, 0x4d, 0x59, 0xe1, 0xc0, 0xc5, 0x32, 0xb1,
+	0xd7, 0x59, 0x4b, 0xe2, 0x60, 0xbb, 0xa5, 0xf9, 0x17, 0xfc, 0x0c, 0x8e, 0xfc, 0x8c, 0x5d, 0x90,
+	0x76, 0x44, 0x1c, 0x26, 0xb4, 0x1d, 0xf8, 0x1b, 0xc8, 0x76, 0xb2, 0x8f, 0xac, 0x97, 0x2a, 0x7d,
+	0xde, 0xe7, 0x79, 0xfc, 0xbe, 0xf6, 0xa3, 0x17, 0xbc, 0x88, 0xb3, 0xf0, 0x14, 0xb3, 0xa4, 0x4f,
+	0xe8, 0xac, 0x3f, 0xdd, 0xea, 0x8f, 0x69, 0x42, 0x25, 0x93, 0x7e, 0x2a, 0xb8, 0xe2, 0xb0, 0x95,
+	0x57, 0x7d, 0x42, 0x67, 0xfe, 0x74, 0xab, 0xf3, 0x14, 0xc7, 0x2c, 0xe1, 0x7d, 0xf3, 0x6b, 0x29,
+	0x9d, 0x95, 0x31, 0x1f, 0x73, 0xf3, 0xd9, 0xd7, 0x5f, 0x39, 0xba, 0x56, 0xb2, 0x4d, 0xb1, 0xc0,
+	0x71, 0xee, 0xda, 0xe9, 0x94, 0x8a, 0x2a, 0x4b, 0x69, 0x5e, 0xdb, 0xfc, 0xb5, 0x04, 0x1a, 0xef,
+	0x6d, 0x0f, 0xc7, 0x0a, 0x2b, 0x0a, 0xdf, 0x80, 0xaa, 0x15, 0xbb, 0x4e, 0xd7, 0xe9, 0xd5, 0xb7,
+	0x9f, 0xfb, 0xf7, 0x7b, 0xf2, 0x8f, 0x4c, 0x75, 0x50, 0x3b, 0xbf, 0xdc, 0xa8, 0xfc, 0xf8, 0xf7,
+	0xf3, 0x95, 0x13, 0xe4, 0x02, 0xb8, 0x09, 0x9a, 0x09, 0x9d, 0x29, 0xc4, 0x05, 0xa1, 0x02, 0x31,
+	0xe2, 0x3e, 0xea, 0x3a, 0xbd, 0xc5, 0xa0, 0xae, 0xc1, 0x43, 0x8d, 0x0d, 0x09, 0xdc, 0x03, 0x4d,
+	0x25, 0x30, 0x61, 0xc9, 0x18, 0xa5, 0x98, 0x09, 0xe9, 0x2e, 0x74, 0x17, 0x7a, 0xf5, 0xed, 0xb5,
+	0xf2, 0x29, 0x23, 0x4b, 0x3a, 0xc2, 0x4c, 0x0c, 0x16, 0xf5, 0x51, 0x41, 0x43, 0xdd, 0x42, 0x12,
+	0xee, 0x80, 0xaa, 0x39, 0x46, 0xba, 0x8b, 0xc6, 0x60, 0xb5, 0x6c, 0x60, 0x0e, 0xcc, 0xa5, 0x39,
+	0x15, 0xee, 0x82, 0xc6, 0x44, 0x52, 0x81, 0x04, 0xfd, 0x86, 0x05, 0x91, 0xee, 0x63, 0x23, 0xed,
+	0x94, 0xa5, 0x1f, 0x25, 0x15, 0x81, 0xa1, 0xe4, 0xfa, 0xfa, 0xe4, 0x06, 0x91, 0xf0, 0x00, 0xb4,
+	0x23, 0xf6, 0x75, 0xc2, 0x08, 0x53, 0x19, 0x52, 0x4c, 0xb7, 0x50, 0x35, 0x3e, 0xeb, 0x65, 0x9f,
+	0x83, 0x82, 0x36, 0x62, 0x37, 0xad, 0xb4, 0xa2, 0xbb, 0xa0, 0x84, 0x1f, 0x40, 0xd3, 0x5e, 0x57,
+	0xd1, 0xd3, 0x92, 0xf1, 0xda, 0x98, 0x3b, 0x8e, 0x6d, 0x61, 0x98, 0x9c, 0xf0, 0xe2, 0x4e, 0xf8,
+	0x2d, 0x2c, 0xe1, 0x21, 0x58, 0x4e, 0x05, 0x0b, 0x29, 0x12, 0xf4, 0x84, 0x0a, 0x9a, 0x84, 0x54,
+	0xba, 0x4f, 0x8c, 0x9d, 0xf7, 0xe0, 0x11, 0x35, 0x2f, 0x28, 0x68, 0xb9, 0x5b, 0x3b, 0xbd, 0x87,
+	0x9a, 0x51, 0xa7, 0x3c, 0x9a, 0xc4, 0x14, 0x29, 0x81, 0xc3, 0x33, 0x3d, 0x6a, 0x6d, 0xfe, 0xa8,
+	0x9f, 0x0c, 0x6d, 0x64, 0x59, 0xc5, 0xa8, 0xd3, 0xbb, 0xa0, 0x84, 0xef, 0x40, 0x3b, 0x0a, 0x91,
+	0xe2, 0x0a, 0x47, 0x48, 0x4e, 0xd2, 0x34, 0xca, 0x5c, 0xd0, 0x75, 0x7a, 0xb5, 0xc1, 0xba, 0xa6,
+	0xff, 0xb9, 0xdc, 0x58, 0x0d, 0xb9, 0x8c, 0xb9, 0x94, 0xe4, 0xcc, 0x67, 0xbc, 0x1f, 0x63, 0x75,
+	0xea, 0x0f, 0x13, 0x15, 0x34, 0xa3, 0x70, 0xa4, 0x45, 0xc7, 0x46, 0x03, 0x47, 0x60, 0x85, 0x64,
+	0x09, 0x8e, 0x59, 0x98, 0xdf, 0x19, 0x92, 0x3a, 0xb8, 0x6e, 0xdd, 0xc4, 0x75, 0xb3, 0xdc, 0xd9,
+	0x5b, 0xcb, 0xb5, 0x77, 0x64, 0x22, 0x1e, 0x40, 0xf2, 0x00, 0xbb, 0xc9, 0xae, 0x0e, 0x19, 0xd5,
+	0xd9, 0x6d, 0xdc, 0x66, 0x57, 0x67, 0x91, 0x0e, 0x89, 0xce, 0x9c, 0x29, 0x4b, 0xb7, 0x39, 0x3f,
+	0x73, 0x86, 0x58, 0x64, 0xce, 0x52, 0xe1, 0x3e, 0x58, 0x8e, 0x42, 0x64, 0xdf, 0xe5, 0x94, 0x49,
+	0xc5, 0x45, 0xe6, 0xb6, 0xba, 0xce, 0xbc, 0x47, 0x39, 0xd8, 0x35, 0xcf, 0xb2, 0x6f, 0x59, 0x41,
+	0x2b, 0x0a, 0xef, 0xfe, 0x87, 0x2f, 0x41, 0x13, 0xa7, 0x69, 0xc4, 0x28, 0x41, 0x27, 0x6c, 0x46,
+	0xa5, 0xdb, 0xee, 0x2e, 0xf4, 0x6a, 0x41, 0x23, 0x07, 0xf7, 0x34, 0x36, 0x78, 0x7d, 0x7e, 0xe5,
+	0x39, 0x17, 0x57, 0x9e, 0xf3, 0xf7, 0xca, 0x73, 0xbe, 0x5f, 0x7b, 0x95, 0x8b, 0x6b, 0xaf, 0xf2,
+	0xfb, 0xda, 0xab, 0x7c, 0x7e, 0x56, 0x6c, 0x81, 0x99, 0xd9, 0x03, 0x66, 0x09, 0x7c, 0xa9, 0x9a,
+	0x2d, 0xb0, 0xf3, 0x7f, 0x00, 0x82, 0x04, 0x1b, 0xfc, 0x97, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -201,6 +261,68 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AppliedFixes) > 0 {
+		for iNdEx := len(m.AppliedFixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AppliedFixes[iNdEx])
+			copy(dAtA[i:], m.AppliedFixes[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AppliedFixes[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.LcPriceHistory != nil {
+		{
+			size, err := m.LcPriceHistory.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Trades) > 0 {
+		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.NextTradeId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextTradeId))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.DynamicRewardState != nil {
+		{
+			size, err := m.DynamicRewardState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	{
+		size := m.LcTotalSupply.Size()
+		i -= size
+		if _, err := m.LcTotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
 	if len(m.VolumeTrackers) > 0 {
 		for iNdEx := len(m.VolumeTrackers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -381,6 +503,31 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.LcTotalSupply.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.DynamicRewardState != nil {
+		l = m.DynamicRewardState.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.NextTradeId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextTradeId))
+	}
+	if len(m.Trades) > 0 {
+		for _, e := range m.Trades {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LcPriceHistory != nil {
+		l = m.LcPriceHistory.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.AppliedFixes) > 0 {
+		for _, s := range m.AppliedFixes {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -709,6 +856,197 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LcTotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LcTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicRewardState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DynamicRewardState == nil {
+				m.DynamicRewardState = &DynamicRewardState{}
+			}
+			if err := m.DynamicRewardState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTradeId", wireType)
+			}
+			m.NextTradeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextTradeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trades = append(m.Trades, Trade{})
+			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LcPriceHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LcPriceHistory == nil {
+				m.LcPriceHistory = &LCPriceHistory{}
+			}
+			if err := m.LcPriceHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppliedFixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppliedFixes = append(m.AppliedFixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
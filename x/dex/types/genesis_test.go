@@ -5,6 +5,8 @@ import (
 
 	"mychain/x/dex/types"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -36,3 +38,104 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisState_ValidateCrossReferences(t *testing.T) {
+	order := types.Order{
+		Id:           1,
+		Maker:        "maker",
+		PairId:       1,
+		IsBuy:        true,
+		Price:        sdk.NewInt64Coin("testusd", 100),
+		Amount:       sdk.NewInt64Coin("maincoin", 1000),
+		FilledAmount: sdk.NewInt64Coin("maincoin", 0),
+	}
+	trade := types.Trade{
+		Id:          0,
+		PairId:      1,
+		BuyOrderId:  1,
+		SellOrderId: 1,
+		Price:       sdk.NewInt64Coin("testusd", 100),
+		Amount:      sdk.NewInt64Coin("maincoin", 10),
+	}
+	reward := types.OrderRewardInfo{OrderId: 1, TierId: 1, TotalRewards: math.ZeroInt()}
+
+	tests := []struct {
+		desc   string
+		modify func(gs *types.GenesisState)
+		errMsg string
+	}{
+		{
+			desc:   "valid cross references",
+			modify: func(gs *types.GenesisState) {},
+		},
+		{
+			desc:   "order beyond next order id",
+			modify: func(gs *types.GenesisState) { gs.NextOrderId = 1 },
+			errMsg: "is not below the next order ID",
+		},
+		{
+			desc:   "order on unknown pair",
+			modify: func(gs *types.GenesisState) { gs.Orders[0].PairId = 9 },
+			errMsg: "order 1 references unknown pair 9",
+		},
+		{
+			desc:   "reward on unknown tier",
+			modify: func(gs *types.GenesisState) { gs.OrderRewards[0].TierId = 99 },
+			errMsg: "references unknown tier 99",
+		},
+		{
+			desc: "duplicate reward",
+			modify: func(gs *types.GenesisState) {
+				gs.OrderRewards = append(gs.OrderRewards, reward)
+			},
+			errMsg: "duplicate order reward",
+		},
+		{
+			desc:   "trade beyond next trade id",
+			modify: func(gs *types.GenesisState) { gs.NextTradeId = 0 },
+			errMsg: "is not below the next trade ID",
+		},
+		{
+			desc:   "trade on unassigned order",
+			modify: func(gs *types.GenesisState) { gs.Trades[0].SellOrderId = 5 },
+			errMsg: "references unassigned orders",
+		},
+		{
+			desc:   "price reference on unknown pair",
+			modify: func(gs *types.GenesisState) { gs.PriceReferences[0].PairId = 9 },
+			errMsg: "price reference references unknown pair 9",
+		},
+		{
+			desc:   "negative LC supply",
+			modify: func(gs *types.GenesisState) { gs.LcTotalSupply = math.NewInt(-1) },
+			errMsg: "LC total supply cannot be negative",
+		},
+		{
+			desc: "duplicate applied fix",
+			modify: func(gs *types.GenesisState) {
+				gs.AppliedFixes = append(gs.AppliedFixes, types.FixCrossedOrders)
+			},
+			errMsg: "duplicate applied fix",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			gs := types.DefaultGenesis()
+			gs.NextOrderId = 2
+			gs.NextTradeId = 1
+			gs.Orders = []types.Order{order}
+			gs.Trades = []types.Trade{trade}
+			gs.OrderRewards = []types.OrderRewardInfo{reward}
+			gs.PriceReferences = []types.PriceReference{{PairId: 1, ReferencePrice: math.LegacyOneDec()}}
+			gs.AppliedFixes = []string{types.FixCrossedOrders}
+			tc.modify(gs)
+
+			err := gs.Validate()
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errMsg)
+			}
+		})
+	}
+}
@@ -33,4 +33,23 @@ var (
 	NextTradeIDKey        = collections.NewPrefix(13) // "next_trade_id"
 	TradesKey             = collections.NewPrefix(14) // "trades"
 	PairTradesKey         = collections.NewPrefix(15) // "pair_trades"
+	LCPriceHistoryKey     = collections.NewPrefix(16) // "lc_price_history"
+	AppliedFixesKey       = collections.NewPrefix(17) // "applied_fixes"
+)
+
+// One-time state repairs run by store migrations and recorded in AppliedFixes
+const (
+	// FixCrossedOrders matches orders that crossed before matching existed
+	FixCrossedOrders = "crossed_orders"
+	// FixOrder18 recomputes the filled amount of the overfilled order 18
+	FixOrder18 = "order_18"
+)
+
+// Store keys written outside of collections by consensus version 1
+var (
+	LegacyDynamicRewardStateKey = []byte("dynamic_reward_state")
+	LegacyFixKeys               = map[string][]byte{
+		FixCrossedOrders: []byte("dex_crossed_orders_fix_applied"),
+		FixOrder18:       []byte("order_18_fix_applied_v2"),
+	}
 )
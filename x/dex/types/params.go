@@ -92,27 +92,27 @@ func DefaultParams() Params {
 // Validate validates the set of params.
 func (p Params) Validate() error {
 	// Validate BaseTransferFeePercentage
-	if p.BaseTransferFeePercentage.IsNegative() || p.BaseTransferFeePercentage.GTE(math.LegacyOneDec()) {
+	if p.BaseTransferFeePercentage.IsNil() || p.BaseTransferFeePercentage.IsNegative() || p.BaseTransferFeePercentage.GTE(math.LegacyOneDec()) {
 		return fmt.Errorf("base transfer fee percentage must be between 0 and 1: %s", p.BaseTransferFeePercentage)
 	}
 	
 	// Validate MinOrderAmount
-	if p.MinOrderAmount.IsNegative() {
+	if p.MinOrderAmount.IsNil() || p.MinOrderAmount.IsNegative() {
 		return fmt.Errorf("min order amount must be non-negative: %s", p.MinOrderAmount)
 	}
 	
 	// Validate LcInitialSupply
-	if p.LcInitialSupply.IsNegative() {
+	if p.LcInitialSupply.IsNil() || p.LcInitialSupply.IsNegative() {
 		return fmt.Errorf("LC initial supply must be non-negative: %s", p.LcInitialSupply)
 	}
 	
 	// Validate LcExchangeRate
-	if p.LcExchangeRate.IsNegative() || p.LcExchangeRate.IsZero() {
+	if p.LcExchangeRate.IsNil() || p.LcExchangeRate.IsNegative() || p.LcExchangeRate.IsZero() {
 		return fmt.Errorf("LC exchange rate must be positive: %s", p.LcExchangeRate)
 	}
 	
 	// Validate BaseRewardRate
-	if p.BaseRewardRate.IsNegative() {
+	if p.BaseRewardRate.IsNil() || p.BaseRewardRate.IsNegative() {
 		return fmt.Errorf("base reward rate must be non-negative: %s", p.BaseRewardRate)
 	}
 	
@@ -121,65 +121,65 @@ func (p Params) Validate() error {
 	}
 	
 	// Validate fee parameters
-	if p.BaseMakerFeePercentage.IsNegative() || p.BaseMakerFeePercentage.GTE(math.LegacyOneDec()) {
+	if p.BaseMakerFeePercentage.IsNil() || p.BaseMakerFeePercentage.IsNegative() || p.BaseMakerFeePercentage.GTE(math.LegacyOneDec()) {
 		return fmt.Errorf("base maker fee percentage must be between 0 and 1: %s", p.BaseMakerFeePercentage)
 	}
 	
-	if p.BaseTakerFeePercentage.IsNegative() || p.BaseTakerFeePercentage.GTE(math.LegacyOneDec()) {
+	if p.BaseTakerFeePercentage.IsNil() || p.BaseTakerFeePercentage.IsNegative() || p.BaseTakerFeePercentage.GTE(math.LegacyOneDec()) {
 		return fmt.Errorf("base taker fee percentage must be between 0 and 1: %s", p.BaseTakerFeePercentage)
 	}
 	
-	if p.BaseCancelFeePercentage.IsNegative() || p.BaseCancelFeePercentage.GTE(math.LegacyOneDec()) {
+	if p.BaseCancelFeePercentage.IsNil() || p.BaseCancelFeePercentage.IsNegative() || p.BaseCancelFeePercentage.GTE(math.LegacyOneDec()) {
 		return fmt.Errorf("base cancel fee percentage must be between 0 and 1: %s", p.BaseCancelFeePercentage)
 	}
 	
-	if p.BaseSellFeePercentage.IsNegative() || p.BaseSellFeePercentage.GTE(math.LegacyOneDec()) {
+	if p.BaseSellFeePercentage.IsNil() || p.BaseSellFeePercentage.IsNegative() || p.BaseSellFeePercentage.GTE(math.LegacyOneDec()) {
 		return fmt.Errorf("base sell fee percentage must be between 0 and 1: %s", p.BaseSellFeePercentage)
 	}
 	
-	if p.FeeIncrementPercentage.IsNegative() || p.FeeIncrementPercentage.GTE(math.LegacyOneDec()) {
+	if p.FeeIncrementPercentage.IsNil() || p.FeeIncrementPercentage.IsNegative() || p.FeeIncrementPercentage.GTE(math.LegacyOneDec()) {
 		return fmt.Errorf("fee increment percentage must be between 0 and 1: %s", p.FeeIncrementPercentage)
 	}
 	
-	if p.PriceThresholdPercentage.IsNegative() || p.PriceThresholdPercentage.GT(math.LegacyOneDec()) {
+	if p.PriceThresholdPercentage.IsNil() || p.PriceThresholdPercentage.IsNegative() || p.PriceThresholdPercentage.GT(math.LegacyOneDec()) {
 		return fmt.Errorf("price threshold percentage must be between 0 and 1: %s", p.PriceThresholdPercentage)
 	}
 	
 	// Validate minimum fees
-	if p.MinTransferFee.IsNegative() {
+	if p.MinTransferFee.IsNil() || p.MinTransferFee.IsNegative() {
 		return fmt.Errorf("min transfer fee must be non-negative: %s", p.MinTransferFee)
 	}
 	
-	if p.MinMakerFee.IsNegative() {
+	if p.MinMakerFee.IsNil() || p.MinMakerFee.IsNegative() {
 		return fmt.Errorf("min maker fee must be non-negative: %s", p.MinMakerFee)
 	}
 	
-	if p.MinTakerFee.IsNegative() {
+	if p.MinTakerFee.IsNil() || p.MinTakerFee.IsNegative() {
 		return fmt.Errorf("min taker fee must be non-negative: %s", p.MinTakerFee)
 	}
 	
-	if p.MinCancelFee.IsNegative() {
+	if p.MinCancelFee.IsNil() || p.MinCancelFee.IsNegative() {
 		return fmt.Errorf("min cancel fee must be non-negative: %s", p.MinCancelFee)
 	}
 	
-	if p.MinSellFee.IsNegative() {
+	if p.MinSellFee.IsNil() || p.MinSellFee.IsNegative() {
 		return fmt.Errorf("min sell fee must be non-negative: %s", p.MinSellFee)
 	}
 	
 	// Validate dynamic fee parameters
-	if p.LiquidityThreshold.IsNegative() || p.LiquidityThreshold.GT(math.LegacyOneDec()) {
+	if p.LiquidityThreshold.IsNil() || p.LiquidityThreshold.IsNegative() || p.LiquidityThreshold.GT(math.LegacyOneDec()) {
 		return fmt.Errorf("liquidity threshold must be between 0 and 1: %s", p.LiquidityThreshold)
 	}
 	
-	if p.PriceMultiplierAlpha.IsNegative() || p.PriceMultiplierAlpha.GT(math.LegacyOneDec()) {
+	if p.PriceMultiplierAlpha.IsNil() || p.PriceMultiplierAlpha.IsNegative() || p.PriceMultiplierAlpha.GT(math.LegacyOneDec()) {
 		return fmt.Errorf("price multiplier alpha must be between 0 and 1: %s", p.PriceMultiplierAlpha)
 	}
 	
-	if p.MaxLiquidityMultiplier.IsNegative() {
+	if p.MaxLiquidityMultiplier.IsNil() || p.MaxLiquidityMultiplier.IsNegative() {
 		return fmt.Errorf("max liquidity multiplier must be non-negative: %s", p.MaxLiquidityMultiplier)
 	}
 	
-	if p.BurnRatePercentage.IsNegative() || p.BurnRatePercentage.GT(math.LegacyOneDec()) {
+	if p.BurnRatePercentage.IsNil() || p.BurnRatePercentage.IsNegative() || p.BurnRatePercentage.GT(math.LegacyOneDec()) {
 		return fmt.Errorf("burn rate percentage must be between 0 and 1: %s", p.BurnRatePercentage)
 	}
 	
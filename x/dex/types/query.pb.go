@@ -8,7 +8,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -1319,8 +1319,12 @@ func (m *QueryAllOrderRewardsResponse) GetRewards() []OrderRewardInfo {
 type QueryTradesRequest struct {
 	// Optional: filter by specific trading pair
 	PairId uint64 `protobuf:"varint,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
-	// Optional: limit number of results (default 100, max 1000)
+	// Optional: limit number of results (default 100, max 1000). Only used
+	// when pagination is not set.
 	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// pagination pages through trades oldest first; when unset the most
+	// recent trades within limit are returned
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryTradesRequest) Reset()         { *m = QueryTradesRequest{} }
@@ -1370,9 +1374,17 @@ func (m *QueryTradesRequest) GetLimit() uint32 {
 	return 0
 }
 
+func (m *QueryTradesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryTradesResponse is response for Trades
 type QueryTradesResponse struct {
-	Trades []Trade `protobuf:"bytes,1,rep,name=trades,proto3" json:"trades"`
+	Trades     []Trade             `protobuf:"bytes,1,rep,name=trades,proto3" json:"trades"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryTradesResponse) Reset()         { *m = QueryTradesResponse{} }
@@ -1415,6 +1427,13 @@ func (m *QueryTradesResponse) GetTrades() []Trade {
 	return nil
 }
 
+func (m *QueryTradesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "mychain.dex.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "mychain.dex.v1.QueryParamsResponse")
@@ -1448,136 +1467,138 @@ func init() {
 func init() { proto.RegisterFile("mychain/dex/v1/query.proto", fileDescriptor_48f191be82aa2adc) }
 
 var fileDescriptor_48f191be82aa2adc = []byte{
-	// 2050 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x59, 0xcd, 0x6f, 0x1c, 0x59,
-	0x11, 0x4f, 0xfb, 0x73, 0xa6, 0xc6, 0x33, 0xb6, 0x5f, 0xec, 0xc4, 0x19, 0x6f, 0xc6, 0x4e, 0x7b,
-	0xe3, 0xf5, 0xe6, 0x63, 0x66, 0x9d, 0x48, 0x8b, 0x40, 0xb0, 0xc4, 0x63, 0xe3, 0x8d, 0x23, 0xa3,
-	0x4d, 0x3a, 0xe1, 0xc2, 0xa5, 0xd5, 0xd3, 0xfd, 0xec, 0xb4, 0x3c, 0xd3, 0xdd, 0xee, 0xee, 0x71,
-	0x32, 0x5a, 0xad, 0x84, 0x10, 0x42, 0x9c, 0xd0, 0x0a, 0x56, 0x42, 0xda, 0x2b, 0x17, 0x24, 0x84,
-	0x84, 0xb8, 0xc3, 0x85, 0xcb, 0x1e, 0x23, 0x71, 0x00, 0x71, 0x58, 0xa1, 0x04, 0x89, 0x0b, 0x37,
-	0xfe, 0x01, 0xf4, 0xea, 0xd5, 0xeb, 0xf9, 0xea, 0xc9, 0xf4, 0xec, 0x25, 0xca, 0xbc, 0xaa, 0xdf,
-	0xef, 0x55, 0xd5, 0xab, 0xae, 0xaa, 0xf7, 0x0c, 0xe5, 0x56, 0xc7, 0x7e, 0x6e, 0xb9, 0x5e, 0xcd,
-	0xe1, 0x2f, 0x6b, 0x17, 0xbb, 0xb5, 0xf3, 0x36, 0x0f, 0x3b, 0xd5, 0x20, 0xf4, 0x63, 0x9f, 0x95,
-	0x48, 0x56, 0x75, 0xf8, 0xcb, 0xea, 0xc5, 0x6e, 0x79, 0xd9, 0x6a, 0xb9, 0x9e, 0x5f, 0xc3, 0x7f,
-	0xa5, 0x4a, 0xf9, 0x96, 0xed, 0x47, 0x2d, 0x3f, 0xaa, 0x35, 0xac, 0x88, 0x4b, 0x6c, 0xed, 0x62,
-	0xb7, 0xc1, 0x63, 0x6b, 0xb7, 0x16, 0x58, 0xa7, 0xae, 0x67, 0xc5, 0xae, 0xef, 0x91, 0x6e, 0xa5,
-	0x57, 0x57, 0x69, 0xd9, 0xbe, 0xab, 0xe4, 0x2b, 0xa7, 0xfe, 0xa9, 0x8f, 0xff, 0xad, 0x89, 0xff,
-	0xd1, 0xea, 0x3b, 0xa7, 0xbe, 0x7f, 0xda, 0xe4, 0x35, 0x2b, 0x70, 0x6b, 0x96, 0xe7, 0xf9, 0x31,
-	0x52, 0x46, 0x24, 0x5d, 0x1f, 0x30, 0x3f, 0xb0, 0x42, 0xab, 0xa5, 0x84, 0x83, 0xbe, 0xc5, 0x9d,
-	0x80, 0x93, 0x4c, 0x5f, 0x01, 0xf6, 0x44, 0x98, 0xfb, 0x18, 0x01, 0x06, 0x3f, 0x6f, 0xf3, 0x28,
-	0xd6, 0x1f, 0xc3, 0xe5, 0xbe, 0xd5, 0x28, 0xf0, 0xbd, 0x88, 0xb3, 0x6f, 0xc3, 0x9c, 0x24, 0x5e,
-	0xd3, 0x36, 0xb5, 0x9d, 0xc2, 0xbd, 0x2b, 0xd5, 0xfe, 0xc8, 0x54, 0xa5, 0x7e, 0x3d, 0xff, 0xd5,
-	0xd7, 0x1b, 0x97, 0x7e, 0xf7, 0x9f, 0x3f, 0xde, 0xd2, 0x0c, 0x02, 0xe8, 0x1f, 0xc0, 0x2a, 0x32,
-	0x7e, 0x12, 0x3a, 0x3c, 0xac, 0xfb, 0xfe, 0x19, 0x6d, 0xc5, 0xae, 0xc2, 0x7c, 0x60, 0xb9, 0xa1,
-	0xe9, 0x3a, 0x48, 0x3a, 0x23, 0x10, 0x6e, 0x78, 0xe4, 0xe8, 0xbf, 0xd2, 0xe0, 0xca, 0x20, 0x84,
-	0xec, 0xf8, 0x0e, 0x40, 0xa3, 0xdd, 0x31, 0x7d, 0x21, 0x10, 0xb6, 0x4c, 0xef, 0x14, 0xee, 0xad,
-	0x0e, 0xda, 0x22, 0x61, 0x33, 0xc2, 0x14, 0x23, 0xdf, 0x68, 0x4b, 0x9a, 0x88, 0x7d, 0x17, 0x0a,
-	0x11, 0x6f, 0x36, 0x15, 0x78, 0x6a, 0x3c, 0x18, 0x84, 0xbe, 0x44, 0xeb, 0xf7, 0xe1, 0x2a, 0xda,
-	0xf4, 0xa3, 0x88, 0x87, 0x06, 0x7f, 0x61, 0x85, 0x8e, 0x8a, 0x19, 0x5b, 0x83, 0x79, 0xcb, 0x71,
-	0x42, 0x1e, 0xc9, 0xe8, 0xe4, 0x0d, 0xf5, 0x53, 0xff, 0x52, 0x83, 0xb5, 0x61, 0x14, 0xf9, 0xf2,
-	0x11, 0x40, 0xc0, 0x3d, 0xc7, 0xf5, 0x4e, 0xcd, 0xa6, 0x4d, 0x71, 0xbd, 0x56, 0x95, 0x29, 0x52,
-	0x15, 0x29, 0x52, 0xa5, 0x14, 0xa9, 0xee, 0xfb, 0xae, 0xa7, 0xfc, 0x21, 0xc8, 0xb1, 0x2d, 0xf0,
-	0x76, 0xd3, 0x72, 0x5b, 0xdc, 0x11, 0xf8, 0xa9, 0x8c, 0x78, 0x82, 0x1c, 0xdb, 0xfa, 0x13, 0xb2,
-	0x0d, 0x1d, 0xcc, 0xea, 0x12, 0x5b, 0x87, 0x3c, 0x06, 0xd0, 0x74, 0x1d, 0x19, 0xc3, 0x19, 0x23,
-	0x87, 0x0b, 0x47, 0x4e, 0xa4, 0xff, 0x41, 0x83, 0x6b, 0x29, 0x9c, 0xe4, 0xf0, 0x23, 0x28, 0x4a,
-	0x68, 0x28, 0x05, 0x74, 0x7e, 0x1b, 0xa9, 0x47, 0x20, 0xc1, 0x47, 0xde, 0x89, 0x4f, 0x96, 0x2f,
-	0xf8, 0x3d, 0x9c, 0xec, 0x00, 0x8a, 0xb1, 0x1f, 0x5b, 0x4d, 0x93, 0xe2, 0x91, 0xd5, 0xff, 0x05,
-	0x44, 0x3d, 0x96, 0x20, 0xbd, 0x06, 0x2b, 0x68, 0xee, 0x33, 0x97, 0x87, 0x62, 0xab, 0xb1, 0xa9,
-	0xf9, 0xc5, 0x14, 0x65, 0x73, 0x17, 0x41, 0xce, 0xdd, 0x80, 0x05, 0xbb, 0x1d, 0x86, 0xdc, 0x8b,
-	0xcd, 0xd8, 0xe5, 0x21, 0xe2, 0x8a, 0x46, 0x81, 0xd6, 0x84, 0x3a, 0x7b, 0x00, 0x79, 0x21, 0x32,
-	0x5d, 0xef, 0xc4, 0x27, 0x7b, 0xaf, 0x0f, 0xfa, 0x7e, 0xec, 0x9e, 0xb7, 0x5d, 0xc7, 0x8d, 0x71,
-	0x03, 0xb2, 0x39, 0x17, 0xd3, 0x66, 0xec, 0x21, 0x14, 0xd5, 0x26, 0x41, 0xe8, 0xda, 0x7c, 0x6d,
-	0x5a, 0x1c, 0x4e, 0x7d, 0x4b, 0xa8, 0xfd, 0xf3, 0xeb, 0x8d, 0x75, 0xe9, 0x7c, 0xe4, 0x9c, 0x55,
-	0x5d, 0xbf, 0xd6, 0xb2, 0xe2, 0xe7, 0xd5, 0x63, 0x7e, 0x6a, 0xd9, 0x9d, 0x03, 0x6e, 0x1b, 0xca,
-	0xbc, 0xc7, 0x02, 0xc8, 0x8e, 0x61, 0x31, 0xe4, 0x27, 0x3c, 0xe4, 0x9e, 0xcd, 0x89, 0x6b, 0x26,
-	0x3b, 0x57, 0x29, 0xc1, 0x22, 0x5b, 0x52, 0x4b, 0x8e, 0xf7, 0x7b, 0xa2, 0xa8, 0xff, 0x57, 0xa3,
-	0x62, 0xa2, 0x96, 0x29, 0x54, 0x75, 0x90, 0xa7, 0x60, 0x46, 0xed, 0x20, 0x68, 0x76, 0xb2, 0xa6,
-	0x7e, 0x01, 0x41, 0x4f, 0x11, 0x23, 0x22, 0xc1, 0x5f, 0xda, 0xcf, 0x2d, 0xef, 0x94, 0x9b, 0xa1,
-	0x15, 0x73, 0x8c, 0x67, 0xd6, 0x48, 0x28, 0xa4, 0x61, 0xc5, 0x9c, 0x7d, 0x0c, 0x4b, 0x62, 0x47,
-	0x4a, 0x4a, 0x49, 0x26, 0xc3, 0x7a, 0x9d, 0xc8, 0x56, 0x87, 0xc9, 0x8e, 0xbc, 0xd8, 0x28, 0x09,
-	0x98, 0xcc, 0x47, 0x41, 0xa4, 0x6f, 0x42, 0x05, 0xbd, 0x3d, 0xe8, 0x78, 0x56, 0xcb, 0xb5, 0xa5,
-	0xe4, 0x69, 0x6c, 0xc5, 0x5c, 0x05, 0xe4, 0xcf, 0x53, 0xb0, 0x31, 0x52, 0x25, 0xa9, 0x0a, 0xb3,
-	0x91, 0x58, 0xa0, 0xa8, 0xe8, 0x83, 0x09, 0x32, 0x0c, 0xa5, 0xf0, 0x48, 0x18, 0x7b, 0x04, 0xcb,
-	0x2a, 0x45, 0x9a, 0x2a, 0x97, 0x28, 0x38, 0x63, 0xfc, 0x59, 0x22, 0x5c, 0x92, 0x82, 0xec, 0x21,
-	0x2c, 0x25, 0x1c, 0x66, 0x6c, 0x85, 0xa7, 0x3c, 0xce, 0x16, 0x9a, 0xc5, 0x04, 0xf6, 0x0c, 0x51,
-	0xec, 0x00, 0x0a, 0x98, 0x64, 0x22, 0xbc, 0xae, 0x3f, 0x49, 0xaa, 0x01, 0xe2, 0x0c, 0x01, 0xd3,
-	0xbf, 0x05, 0xef, 0xc8, 0x7c, 0x52, 0xec, 0x75, 0xab, 0x69, 0x79, 0x36, 0x1f, 0xfb, 0xd9, 0xfe,
-	0x6f, 0x16, 0xae, 0x8f, 0x40, 0x26, 0x39, 0x59, 0x14, 0x8d, 0xa5, 0x1b, 0x32, 0x2d, 0x8b, 0x9f,
-	0x0b, 0x8d, 0x76, 0x97, 0x92, 0x1d, 0x40, 0x09, 0x1b, 0xcc, 0x84, 0x71, 0x2f, 0x0a, 0x50, 0x97,
-	0xe5, 0x10, 0x16, 0xe5, 0xd7, 0xd1, 0xa5, 0xc9, 0x96, 0x8e, 0x88, 0xea, 0xf2, 0x6c, 0xc3, 0x62,
-	0xd2, 0x2a, 0x4d, 0xdb, 0x6f, 0x7b, 0x31, 0x86, 0x7d, 0xc6, 0x28, 0xaa, 0x96, 0xb8, 0x2f, 0x16,
-	0xd9, 0x0e, 0x2c, 0x75, 0xdb, 0x22, 0x29, 0xce, 0xa2, 0x62, 0x29, 0x69, 0x7f, 0x52, 0xf3, 0x01,
-	0x88, 0x6e, 0x4a, 0x47, 0x38, 0x97, 0xfd, 0x08, 0x73, 0x8d, 0x76, 0x07, 0x0f, 0x90, 0xd5, 0x01,
-	0x5b, 0x2a, 0x51, 0xcc, 0x67, 0xa7, 0xc8, 0x0b, 0x98, 0xe4, 0x78, 0x08, 0xc5, 0x86, 0x3c, 0x3c,
-	0xa2, 0xc9, 0x4d, 0xf0, 0xe5, 0x13, 0x52, 0x32, 0x3d, 0x82, 0x92, 0xf0, 0xa7, 0xd5, 0x6e, 0xc6,
-	0x6e, 0xd0, 0x14, 0x45, 0x3b, 0x9f, 0x9d, 0x4a, 0x44, 0xf1, 0x87, 0x09, 0x52, 0xd4, 0x53, 0xf4,
-	0xac, 0x87, 0x0c, 0x26, 0xa8, 0xa7, 0x02, 0xdb, 0xc3, 0x76, 0x00, 0xaa, 0x71, 0x98, 0x56, 0x10,
-	0xae, 0x15, 0x26, 0xf8, 0x5c, 0x08, 0xb7, 0x17, 0x84, 0xfa, 0xdf, 0xd5, 0xf4, 0xf1, 0x83, 0x28,
-	0x76, 0x5b, 0x56, 0xcc, 0x0f, 0x39, 0x8f, 0xc6, 0x7d, 0x2b, 0x6c, 0x13, 0x16, 0xdc, 0xc8, 0x4c,
-	0x52, 0x07, 0x73, 0x38, 0x67, 0x80, 0x1b, 0xd5, 0x29, 0x6d, 0xd8, 0x03, 0x90, 0xbd, 0xd8, 0xb4,
-	0x5a, 0x98, 0x2d, 0x99, 0xd2, 0xb3, 0x80, 0x90, 0x3d, 0x44, 0xb0, 0x8f, 0x40, 0xfe, 0xec, 0xeb,
-	0x3c, 0x63, 0x08, 0x00, 0x11, 0xb2, 0xdf, 0xfc, 0x75, 0x8a, 0xe6, 0x8c, 0x7e, 0xcf, 0xe8, 0x5b,
-	0xfe, 0x1e, 0xe4, 0x38, 0xad, 0x53, 0x15, 0x5d, 0x1f, 0xac, 0xa2, 0x87, 0x9c, 0x2b, 0xa8, 0x6a,
-	0xb2, 0x0a, 0xc2, 0x8e, 0xa0, 0xd4, 0xb2, 0xce, 0x78, 0x68, 0x9e, 0xf0, 0x6f, 0xd0, 0x5b, 0x10,
-	0x7a, 0xc8, 0x65, 0x6f, 0x39, 0x82, 0x52, 0xdc, 0x4f, 0x35, 0x49, 0xc3, 0x8e, 0x7b, 0xa9, 0x9e,
-	0x00, 0xe3, 0x27, 0x27, 0xdc, 0x8e, 0xdd, 0x0b, 0xde, 0xa5, 0x9b, 0xa0, 0x90, 0x2e, 0x25, 0x70,
-	0xa2, 0xd4, 0xd7, 0x29, 0x88, 0x87, 0x9c, 0x8b, 0x46, 0xe2, 0x46, 0xb1, 0x6b, 0x27, 0x17, 0x81,
-	0x9f, 0x4f, 0x43, 0x39, 0x4d, 0x4a, 0x31, 0xfe, 0x04, 0x56, 0x64, 0x95, 0x3a, 0xe1, 0x3c, 0x32,
-	0x6d, 0xbf, 0xd9, 0xe4, 0x76, 0xcc, 0x9d, 0x6c, 0x65, 0x93, 0x21, 0x54, 0x9c, 0xd9, 0xbe, 0x02,
-	0xb2, 0x23, 0x58, 0xee, 0x21, 0x6c, 0xb4, 0x43, 0x8f, 0x3b, 0xd9, 0xea, 0xe7, 0x62, 0xc2, 0x56,
-	0x47, 0x14, 0xfb, 0x18, 0x0a, 0x22, 0x40, 0x8d, 0x8e, 0x29, 0xee, 0x3b, 0x6b, 0xd3, 0x38, 0x65,
-	0xde, 0x48, 0x49, 0x81, 0x67, 0x9d, 0xa0, 0xc7, 0x37, 0x35, 0x21, 0x9f, 0x70, 0x5e, 0xef, 0x08,
-	0x11, 0x7b, 0x0a, 0x97, 0xfb, 0xc6, 0xad, 0xc9, 0xbb, 0xd7, 0x72, 0xef, 0xd0, 0x25, 0xab, 0x4e,
-	0x15, 0x2e, 0x3b, 0xb2, 0x87, 0x4b, 0x57, 0x2d, 0x3c, 0x13, 0x2c, 0xb9, 0x39, 0x63, 0x99, 0x44,
-	0xc2, 0x9b, 0x3d, 0x14, 0xe8, 0x7f, 0xd1, 0x60, 0x79, 0xc8, 0x56, 0x76, 0x0d, 0x72, 0xc2, 0x47,
-	0x74, 0x90, 0x26, 0xf4, 0x13, 0xa9, 0xd4, 0x6d, 0x20, 0xdd, 0x53, 0x99, 0xca, 0xde, 0x40, 0xba,
-	0x27, 0x72, 0xd8, 0x9d, 0x68, 0x27, 0x4d, 0x5d, 0x55, 0xbd, 0x30, 0xcd, 0x7e, 0xa2, 0xc1, 0x66,
-	0xdf, 0xc7, 0x9a, 0x76, 0xe1, 0x18, 0x59, 0x8e, 0x56, 0x60, 0x56, 0x16, 0x09, 0xf4, 0xc1, 0x90,
-	0x3f, 0xd8, 0x15, 0x98, 0xeb, 0x2d, 0x3e, 0x06, 0xfd, 0x62, 0xab, 0x30, 0x27, 0x8b, 0x17, 0x1e,
-	0x52, 0xce, 0x98, 0xc5, 0xb2, 0xa5, 0xff, 0x69, 0x1a, 0x6e, 0xbc, 0xc5, 0x04, 0xca, 0xe9, 0x9b,
-	0x50, 0x52, 0x0e, 0x47, 0x41, 0xc8, 0x2d, 0xca, 0x66, 0x43, 0xcd, 0xdc, 0x4f, 0x71, 0x91, 0x5d,
-	0x07, 0xf0, 0xf8, 0x0b, 0xa5, 0x22, 0xcd, 0xca, 0x7b, 0xfc, 0x05, 0x89, 0xef, 0x02, 0x93, 0x22,
-	0xd3, 0x6d, 0x05, 0xa1, 0x7f, 0xc1, 0x5b, 0x3c, 0x31, 0x73, 0x59, 0x4a, 0x8e, 0xba, 0x02, 0x71,
-	0x90, 0x38, 0x7e, 0x5a, 0x81, 0xb4, 0x39, 0x6f, 0xcc, 0x8b, 0xdf, 0x7b, 0x41, 0x87, 0xdd, 0x06,
-	0xd2, 0xef, 0xed, 0x2a, 0xb3, 0xa8, 0xb3, 0x24, 0x05, 0x3d, 0x2d, 0x63, 0x0b, 0x8a, 0xdd, 0xfa,
-	0x20, 0xc8, 0xb0, 0x41, 0x1b, 0x0b, 0xc9, 0xa2, 0x60, 0xfc, 0x10, 0xae, 0xaa, 0x32, 0xe7, 0x98,
-	0x8e, 0xe5, 0x36, 0x3b, 0xc9, 0x5d, 0x0c, 0x9b, 0xb1, 0xb1, 0x9a, 0x88, 0x0f, 0x84, 0x54, 0xdd,
-	0xb6, 0x36, 0xa0, 0x40, 0xe3, 0x31, 0xde, 0x6d, 0xb0, 0xe3, 0x1a, 0x20, 0x97, 0xf0, 0x6a, 0xb3,
-	0x03, 0x6a, 0x7a, 0x34, 0x1b, 0x3c, 0x8a, 0xcd, 0x86, 0xeb, 0xc8, 0x66, 0x6a, 0xa8, 0x90, 0xd6,
-	0x79, 0x14, 0xd7, 0x5d, 0x67, 0x48, 0xd3, 0x8a, 0xce, 0x64, 0xa7, 0xec, 0xd3, 0xdc, 0x8b, 0xce,
-	0xf4, 0x0f, 0x61, 0x1d, 0xcf, 0x6c, 0x8f, 0x86, 0x90, 0x8c, 0x19, 0xa3, 0x9b, 0x34, 0x25, 0x0e,
-	0xe1, 0xe8, 0x98, 0xbf, 0x0f, 0xf3, 0xdf, 0xe8, 0x02, 0xaa, 0x50, 0xfa, 0x3e, 0xdd, 0x76, 0x9e,
-	0x85, 0x96, 0xc3, 0x33, 0x65, 0x70, 0xd3, 0x6d, 0xb9, 0x31, 0xa6, 0x4a, 0xd1, 0x90, 0x3f, 0xf4,
-	0x47, 0x74, 0x37, 0x52, 0x24, 0x64, 0xdc, 0x7d, 0x98, 0x8b, 0x71, 0x65, 0xd4, 0xe3, 0x06, 0xea,
-	0x93, 0x45, 0xa4, 0x7a, 0xef, 0x55, 0x09, 0x66, 0x91, 0x8c, 0x9d, 0xc3, 0x9c, 0x7c, 0x89, 0x61,
-	0x43, 0x17, 0x87, 0xe1, 0xc7, 0x9e, 0xf2, 0xd6, 0x5b, 0x75, 0xa4, 0x45, 0x7a, 0xe5, 0xa7, 0x7f,
-	0xfb, 0xf7, 0xaf, 0xa7, 0xd6, 0xd8, 0x95, 0x5a, 0xea, 0x4b, 0x13, 0xfb, 0x85, 0x06, 0xf9, 0xe4,
-	0xa1, 0x86, 0xdd, 0x4c, 0xa5, 0x1c, 0x7c, 0xfb, 0x29, 0x6f, 0x8f, 0x53, 0xa3, 0xcd, 0xef, 0xe0,
-	0xe6, 0xdb, 0xec, 0xdd, 0xc1, 0xcd, 0xe5, 0xf8, 0xd0, 0xf0, 0xfd, 0xb3, 0xda, 0xa7, 0x14, 0xf6,
-	0xcf, 0xd8, 0xe7, 0x1a, 0x14, 0x7a, 0x5e, 0x5a, 0xd8, 0x7b, 0xa9, 0xbb, 0x0c, 0xbf, 0xe0, 0x94,
-	0x77, 0xc6, 0x2b, 0x92, 0x41, 0x55, 0x34, 0x68, 0x87, 0x6d, 0x0f, 0x1a, 0xd4, 0x8e, 0xba, 0x0f,
-	0x1b, 0xb5, 0x4f, 0xe9, 0xb5, 0xe4, 0x33, 0xf6, 0x85, 0x06, 0x0b, 0xbd, 0x59, 0xc8, 0x76, 0x46,
-	0x7b, 0x3e, 0x60, 0xd4, 0xfb, 0x19, 0x34, 0xc9, 0xaa, 0x1a, 0x5a, 0xf5, 0x3e, 0x7b, 0x2f, 0x3d,
-	0x4c, 0xc3, 0x66, 0xfd, 0x4c, 0x83, 0x9c, 0x7a, 0xc2, 0x60, 0xef, 0xa6, 0x6e, 0x34, 0xf0, 0x26,
-	0x52, 0xbe, 0x39, 0x46, 0x8b, 0x4c, 0xb9, 0x8d, 0xa6, 0xdc, 0x64, 0x5b, 0x83, 0xa6, 0x24, 0x4f,
-	0x1f, 0x3d, 0x07, 0x16, 0xc2, 0x9c, 0x7c, 0x1b, 0x18, 0x91, 0xae, 0x7d, 0xef, 0x09, 0x23, 0xd2,
-	0xb5, 0xff, 0x71, 0x41, 0xdf, 0xc0, 0xfd, 0xaf, 0xb1, 0xab, 0x83, 0xfb, 0x37, 0x6d, 0xdc, 0x9d,
-	0xfd, 0x56, 0x03, 0x36, 0x7c, 0x89, 0x66, 0xd5, 0x54, 0xf2, 0x91, 0x77, 0xf9, 0x72, 0x2d, 0xb3,
-	0xfe, 0xb8, 0x54, 0x56, 0xd3, 0x00, 0x55, 0x58, 0x79, 0x8d, 0xff, 0x52, 0x83, 0xa5, 0xc1, 0xcb,
-	0x2a, 0xbb, 0x93, 0x1e, 0x80, 0xf4, 0xdb, 0x70, 0xf9, 0x6e, 0x46, 0x6d, 0xb2, 0xef, 0x7d, 0xb4,
-	0x6f, 0x8b, 0xdd, 0x18, 0x0a, 0x5c, 0xf2, 0x04, 0x40, 0xb7, 0x27, 0x4c, 0xea, 0xde, 0xc9, 0x7b,
-	0x44, 0x52, 0xa7, 0x5c, 0x3b, 0x46, 0x24, 0x75, 0xda, 0x18, 0x3f, 0x3a, 0xa9, 0x55, 0x8f, 0xc2,
-	0xf9, 0xa9, 0x27, 0x9b, 0x7e, 0xa9, 0x41, 0xb1, 0x6f, 0x5a, 0x65, 0xe9, 0xbb, 0xa5, 0xcd, 0xbb,
-	0xe5, 0x5b, 0x59, 0x54, 0xc9, 0xb2, 0x6d, 0xb4, 0x6c, 0x93, 0x55, 0x06, 0x2d, 0x13, 0x23, 0x59,
-	0xd4, 0xdd, 0xfe, 0xf7, 0x1a, 0xac, 0xa4, 0x4d, 0x1c, 0xec, 0x83, 0xb7, 0x46, 0x21, 0xad, 0x18,
-	0xec, 0x4e, 0x80, 0x18, 0x57, 0xaa, 0x92, 0xf8, 0xf5, 0x55, 0x07, 0xf6, 0x1b, 0x0d, 0x16, 0x07,
-	0x7a, 0x26, 0xbb, 0x9d, 0xba, 0x6d, 0x7a, 0x47, 0x2e, 0xdf, 0xc9, 0xa6, 0x3c, 0x2e, 0xdf, 0xac,
-	0xe4, 0x31, 0x42, 0x59, 0x76, 0x0e, 0x73, 0xb2, 0x4d, 0x8e, 0x28, 0x13, 0x7d, 0x8d, 0x78, 0x44,
-	0x99, 0xe8, 0xef, 0xb3, 0xa3, 0xbb, 0x9a, 0x6c, 0xa9, 0xf5, 0xbb, 0x5f, 0xbd, 0xae, 0x68, 0xaf,
-	0x5e, 0x57, 0xb4, 0x7f, 0xbd, 0xae, 0x68, 0x9f, 0xbf, 0xa9, 0x5c, 0x7a, 0xf5, 0xa6, 0x72, 0xe9,
-	0x1f, 0x6f, 0x2a, 0x97, 0x7e, 0x7c, 0x59, 0x01, 0x5e, 0x22, 0x04, 0xff, 0xa4, 0xd2, 0x98, 0xc3,
-	0xbf, 0xa9, 0xdc, 0xff, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x37, 0x73, 0xa2, 0xff, 0x4d, 0x1a,
-	0x00, 0x00,
+	// 2093 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x59, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x0f, 0xfd, 0x29, 0x3d, 0x59, 0xb2, 0x3d, 0xb1, 0x13, 0x47, 0xde, 0xc8, 0x0e, 0xbd, 0x71,
+	0x9c, 0x2f, 0x69, 0x9d, 0x00, 0x5b, 0xb4, 0x68, 0xb7, 0xb1, 0xe2, 0x3a, 0x71, 0xe0, 0x62, 0x1d,
+	0x26, 0xbd, 0xf4, 0x42, 0x8c, 0xc8, 0xb1, 0x42, 0x58, 0x22, 0x69, 0x92, 0x72, 0x22, 0x2c, 0x16,
+	0x28, 0x8a, 0xa2, 0x28, 0x50, 0xa0, 0x58, 0x74, 0x17, 0x28, 0xb0, 0xd7, 0x5e, 0x0a, 0x14, 0x05,
+	0x8a, 0xde, 0xdb, 0x4b, 0x2f, 0x7b, 0x0c, 0xd0, 0x43, 0x8b, 0x1e, 0x16, 0x45, 0x52, 0xa0, 0x97,
+	0xde, 0xfa, 0x0f, 0x14, 0xf3, 0x66, 0x86, 0xa2, 0x24, 0x2a, 0xa2, 0xf6, 0x12, 0x44, 0x33, 0xef,
+	0xf7, 0xe6, 0xbd, 0x37, 0x3f, 0xbe, 0xf7, 0xe6, 0x19, 0xca, 0xed, 0xae, 0xf5, 0x82, 0x3a, 0x6e,
+	0xcd, 0x66, 0xaf, 0x6a, 0xe7, 0xbb, 0xb5, 0xb3, 0x0e, 0x0b, 0xba, 0x55, 0x3f, 0xf0, 0x22, 0x8f,
+	0x94, 0xe4, 0x5e, 0xd5, 0x66, 0xaf, 0xaa, 0xe7, 0xbb, 0xe5, 0x65, 0xda, 0x76, 0x5c, 0xaf, 0x86,
+	0xff, 0x0a, 0x91, 0xf2, 0x2d, 0xcb, 0x0b, 0xdb, 0x5e, 0x58, 0x6b, 0xd0, 0x90, 0x09, 0x6c, 0xed,
+	0x7c, 0xb7, 0xc1, 0x22, 0xba, 0x5b, 0xf3, 0x69, 0xd3, 0x71, 0x69, 0xe4, 0x78, 0xae, 0x94, 0xad,
+	0x24, 0x65, 0x95, 0x94, 0xe5, 0x39, 0x6a, 0x7f, 0xa5, 0xe9, 0x35, 0x3d, 0xfc, 0x6f, 0x8d, 0xff,
+	0x4f, 0xae, 0xbe, 0xd7, 0xf4, 0xbc, 0x66, 0x8b, 0xd5, 0xa8, 0xef, 0xd4, 0xa8, 0xeb, 0x7a, 0x11,
+	0xaa, 0x0c, 0xe5, 0xee, 0xfa, 0x80, 0xf9, 0x3e, 0x0d, 0x68, 0x5b, 0x6d, 0x0e, 0xfa, 0x16, 0x75,
+	0x7d, 0x26, 0xf7, 0xf4, 0x15, 0x20, 0x4f, 0xb9, 0xb9, 0xc7, 0x08, 0x30, 0xd8, 0x59, 0x87, 0x85,
+	0x91, 0x7e, 0x0c, 0x17, 0xfb, 0x56, 0x43, 0xdf, 0x73, 0x43, 0x46, 0xbe, 0x0d, 0x73, 0x42, 0xf1,
+	0x9a, 0xb6, 0xa9, 0xed, 0x14, 0xee, 0x5d, 0xaa, 0xf6, 0x47, 0xa6, 0x2a, 0xe4, 0xeb, 0xf9, 0xaf,
+	0xbe, 0xde, 0xb8, 0xf0, 0xbb, 0xff, 0xfc, 0xf1, 0x96, 0x66, 0x48, 0x80, 0xfe, 0x01, 0xac, 0xa2,
+	0xc6, 0x8f, 0x03, 0x9b, 0x05, 0x75, 0xcf, 0x3b, 0x95, 0x47, 0x91, 0xcb, 0x30, 0xef, 0x53, 0x27,
+	0x30, 0x1d, 0x1b, 0x95, 0xce, 0x70, 0x84, 0x13, 0x1c, 0xda, 0xfa, 0xaf, 0x35, 0xb8, 0x34, 0x08,
+	0x91, 0x76, 0x7c, 0x07, 0xa0, 0xd1, 0xe9, 0x9a, 0x1e, 0xdf, 0xe0, 0xb6, 0x4c, 0xef, 0x14, 0xee,
+	0xad, 0x0e, 0xda, 0x22, 0x60, 0x33, 0xdc, 0x14, 0x23, 0xdf, 0xe8, 0x08, 0x35, 0x21, 0xf9, 0x2e,
+	0x14, 0x42, 0xd6, 0x6a, 0x29, 0xf0, 0xd4, 0x78, 0x30, 0x70, 0x79, 0x81, 0xd6, 0xef, 0xc3, 0x65,
+	0xb4, 0xe9, 0x47, 0x21, 0x0b, 0x0c, 0xf6, 0x92, 0x06, 0xb6, 0x8a, 0x19, 0x59, 0x83, 0x79, 0x6a,
+	0xdb, 0x01, 0x0b, 0x45, 0x74, 0xf2, 0x86, 0xfa, 0xa9, 0x7f, 0xa9, 0xc1, 0xda, 0x30, 0x4a, 0xfa,
+	0xf2, 0x11, 0x80, 0xcf, 0x5c, 0xdb, 0x71, 0x9b, 0x66, 0xcb, 0x92, 0x71, 0xbd, 0x52, 0x15, 0x14,
+	0xa9, 0x72, 0x8a, 0x54, 0x25, 0x45, 0xaa, 0x0f, 0x3d, 0xc7, 0x55, 0xfe, 0x48, 0xc8, 0x91, 0xc5,
+	0xf1, 0x56, 0x8b, 0x3a, 0x6d, 0x66, 0x73, 0xfc, 0x54, 0x46, 0xbc, 0x84, 0x1c, 0x59, 0xfa, 0x53,
+	0x69, 0x1b, 0x3a, 0x98, 0xd5, 0x25, 0xb2, 0x0e, 0x79, 0x0c, 0xa0, 0xe9, 0xd8, 0x22, 0x86, 0x33,
+	0x46, 0x0e, 0x17, 0x0e, 0xed, 0x50, 0xff, 0x83, 0x06, 0x57, 0x52, 0x74, 0x4a, 0x87, 0x9f, 0x40,
+	0x51, 0x40, 0x03, 0xb1, 0x21, 0xef, 0x6f, 0x23, 0xf5, 0x0a, 0x04, 0xf8, 0xd0, 0x3d, 0xf1, 0xa4,
+	0xe5, 0x0b, 0x5e, 0x42, 0x27, 0xd9, 0x87, 0x62, 0xe4, 0x45, 0xb4, 0x65, 0xca, 0x78, 0x64, 0xf5,
+	0x7f, 0x01, 0x51, 0xc7, 0x02, 0xa4, 0xd7, 0x60, 0x05, 0xcd, 0x7d, 0xee, 0xb0, 0x80, 0x1f, 0x35,
+	0x96, 0x9a, 0x5f, 0x4c, 0x49, 0x36, 0xf7, 0x10, 0xd2, 0xb9, 0x6b, 0xb0, 0x60, 0x75, 0x82, 0x80,
+	0xb9, 0x91, 0x19, 0x39, 0x2c, 0x40, 0x5c, 0xd1, 0x28, 0xc8, 0x35, 0x2e, 0x4e, 0x1e, 0x40, 0x9e,
+	0x6f, 0x99, 0x8e, 0x7b, 0xe2, 0x49, 0x7b, 0xaf, 0x0e, 0xfa, 0x7e, 0xe4, 0x9c, 0x75, 0x1c, 0xdb,
+	0x89, 0xf0, 0x00, 0x69, 0x73, 0x2e, 0x92, 0x87, 0x91, 0xc7, 0x50, 0x54, 0x87, 0xf8, 0x81, 0x63,
+	0xb1, 0xb5, 0x69, 0x7e, 0x39, 0xf5, 0x2d, 0x2e, 0xf6, 0xcf, 0xaf, 0x37, 0xd6, 0x85, 0xf3, 0xa1,
+	0x7d, 0x5a, 0x75, 0xbc, 0x5a, 0x9b, 0x46, 0x2f, 0xaa, 0x47, 0xac, 0x49, 0xad, 0xee, 0x3e, 0xb3,
+	0x0c, 0x65, 0xde, 0x31, 0x07, 0x92, 0x23, 0x58, 0x0c, 0xd8, 0x09, 0x0b, 0x98, 0x6b, 0x31, 0xa9,
+	0x6b, 0x26, 0xbb, 0xae, 0x52, 0x8c, 0x45, 0x6d, 0x71, 0x2e, 0x39, 0x7a, 0x98, 0x88, 0xa2, 0xfe,
+	0x5f, 0x4d, 0x26, 0x13, 0xb5, 0x2c, 0x43, 0x55, 0x07, 0x71, 0x0b, 0x66, 0xd8, 0xf1, 0xfd, 0x56,
+	0x37, 0x2b, 0xf5, 0x0b, 0x08, 0x7a, 0x86, 0x18, 0x1e, 0x09, 0xf6, 0xca, 0x7a, 0x41, 0xdd, 0x26,
+	0x33, 0x03, 0x1a, 0x31, 0x8c, 0x67, 0xd6, 0x48, 0x28, 0xa4, 0x41, 0x23, 0x46, 0x1e, 0xc1, 0x12,
+	0x3f, 0x51, 0x92, 0x52, 0x28, 0x13, 0x61, 0xbd, 0x2a, 0x95, 0xad, 0x0e, 0x2b, 0x3b, 0x74, 0x23,
+	0xa3, 0xc4, 0x61, 0x82, 0x8f, 0x5c, 0x91, 0xbe, 0x09, 0x15, 0xf4, 0x76, 0xbf, 0xeb, 0xd2, 0xb6,
+	0x63, 0x89, 0x9d, 0x67, 0x11, 0x8d, 0x98, 0x0a, 0xc8, 0x9f, 0xa7, 0x60, 0x63, 0xa4, 0x48, 0x9c,
+	0x15, 0x66, 0x43, 0xbe, 0x20, 0xa3, 0xa2, 0x0f, 0x12, 0x64, 0x18, 0x2a, 0xc3, 0x23, 0x60, 0xe4,
+	0x09, 0x2c, 0x2b, 0x8a, 0xb4, 0x14, 0x97, 0x64, 0x70, 0xc6, 0xf8, 0xb3, 0x24, 0x71, 0x31, 0x05,
+	0xc9, 0x63, 0x58, 0x8a, 0x75, 0x98, 0x11, 0x0d, 0x9a, 0x2c, 0xca, 0x16, 0x9a, 0xc5, 0x18, 0xf6,
+	0x1c, 0x51, 0x64, 0x1f, 0x0a, 0x48, 0x32, 0x1e, 0x5e, 0xc7, 0x9b, 0x84, 0x6a, 0x80, 0x38, 0x83,
+	0xc3, 0xf4, 0x6f, 0xc1, 0x7b, 0x82, 0x4f, 0x4a, 0x7b, 0x9d, 0xb6, 0xa8, 0x6b, 0xb1, 0xb1, 0x9f,
+	0xed, 0xff, 0x66, 0xe1, 0xea, 0x08, 0x64, 0xcc, 0xc9, 0x22, 0x2f, 0x2c, 0xbd, 0x90, 0x69, 0x59,
+	0xfc, 0x5c, 0x68, 0x74, 0x7a, 0x2a, 0xc9, 0x3e, 0x94, 0xb0, 0xc0, 0x4c, 0x18, 0xf7, 0x22, 0x07,
+	0xf5, 0xb4, 0x1c, 0xc0, 0xa2, 0xf8, 0x3a, 0x7a, 0x6a, 0xb2, 0xd1, 0x11, 0x51, 0x3d, 0x3d, 0xdb,
+	0xb0, 0x18, 0x97, 0x4a, 0xd3, 0xf2, 0x3a, 0x6e, 0x84, 0x61, 0x9f, 0x31, 0x8a, 0xaa, 0x24, 0x3e,
+	0xe4, 0x8b, 0x64, 0x07, 0x96, 0x7a, 0x65, 0x51, 0x0a, 0xce, 0xa2, 0x60, 0x29, 0x2e, 0x7f, 0x42,
+	0xf2, 0x01, 0xf0, 0x6a, 0x2a, 0xaf, 0x70, 0x2e, 0xfb, 0x15, 0xe6, 0x1a, 0x9d, 0x2e, 0x5e, 0x20,
+	0xa9, 0x03, 0x96, 0x54, 0xa9, 0x62, 0x3e, 0xbb, 0x8a, 0x3c, 0x87, 0x09, 0x1d, 0x8f, 0xa1, 0xd8,
+	0x10, 0x97, 0x27, 0xd5, 0xe4, 0x26, 0xf8, 0xf2, 0x25, 0x52, 0x68, 0x7a, 0x02, 0x25, 0xee, 0x4f,
+	0xbb, 0xd3, 0x8a, 0x1c, 0xbf, 0xc5, 0x93, 0x76, 0x3e, 0xbb, 0x2a, 0x1e, 0xc5, 0x1f, 0xc6, 0x48,
+	0x9e, 0x4f, 0xd1, 0xb3, 0x84, 0x32, 0x98, 0x20, 0x9f, 0x72, 0x6c, 0x42, 0xdb, 0x3e, 0xa8, 0xc2,
+	0x61, 0x52, 0x3f, 0x58, 0x2b, 0x4c, 0xf0, 0xb9, 0x48, 0xdc, 0x9e, 0x1f, 0xe8, 0x7f, 0x57, 0xdd,
+	0xc7, 0x0f, 0xc2, 0xc8, 0x69, 0xd3, 0x88, 0x1d, 0x30, 0x16, 0x8e, 0xfb, 0x56, 0xc8, 0x26, 0x2c,
+	0x38, 0xa1, 0x19, 0x53, 0x07, 0x39, 0x9c, 0x33, 0xc0, 0x09, 0xeb, 0x92, 0x36, 0xe4, 0x01, 0x88,
+	0x5a, 0x6c, 0xd2, 0x36, 0xb2, 0x25, 0x13, 0x3d, 0x0b, 0x08, 0xd9, 0x43, 0x04, 0xf9, 0x08, 0xc4,
+	0xcf, 0xbe, 0xca, 0x33, 0x46, 0x01, 0x20, 0x42, 0xd4, 0x9b, 0xbf, 0x4e, 0xc9, 0x3e, 0xa3, 0xdf,
+	0x33, 0xf9, 0x2d, 0x7f, 0x0f, 0x72, 0x4c, 0xae, 0xcb, 0x2c, 0xba, 0x3e, 0x98, 0x45, 0x0f, 0x18,
+	0x53, 0x50, 0x55, 0x64, 0x15, 0x84, 0x1c, 0x42, 0xa9, 0x4d, 0x4f, 0x59, 0x60, 0x9e, 0xb0, 0x6f,
+	0x50, 0x5b, 0x10, 0x7a, 0xc0, 0x44, 0x6d, 0x39, 0x84, 0x52, 0xd4, 0xaf, 0x6a, 0x92, 0x82, 0x1d,
+	0x25, 0x55, 0x3d, 0x05, 0xc2, 0x4e, 0x4e, 0x98, 0x15, 0x39, 0xe7, 0xac, 0xa7, 0x6e, 0x82, 0x44,
+	0xba, 0x14, 0xc3, 0xa5, 0x4a, 0x7d, 0x5d, 0x06, 0xf1, 0x80, 0x31, 0x5e, 0x48, 0x9c, 0x30, 0x72,
+	0xac, 0xf8, 0x21, 0xf0, 0xf3, 0x69, 0x28, 0xa7, 0xed, 0xca, 0x18, 0x7f, 0x0c, 0x2b, 0x22, 0x4b,
+	0x9d, 0x30, 0x16, 0x9a, 0x96, 0xd7, 0x6a, 0x31, 0x2b, 0x62, 0x76, 0xb6, 0xb4, 0x49, 0x10, 0xca,
+	0xef, 0xec, 0xa1, 0x02, 0x92, 0x43, 0x58, 0x4e, 0x28, 0x6c, 0x74, 0x02, 0x97, 0xd9, 0xd9, 0xf2,
+	0xe7, 0x62, 0xac, 0xad, 0x8e, 0x28, 0xf2, 0x08, 0x0a, 0x3c, 0x40, 0x8d, 0xae, 0xc9, 0xdf, 0x3b,
+	0x6b, 0xd3, 0xd8, 0x65, 0x5e, 0x4b, 0xa1, 0xc0, 0xf3, 0xae, 0x9f, 0xf0, 0x4d, 0x75, 0xc8, 0x27,
+	0x8c, 0xd5, 0xbb, 0x7c, 0x8b, 0x3c, 0x83, 0x8b, 0x7d, 0xed, 0xd6, 0xe4, 0xd5, 0x6b, 0x39, 0xd9,
+	0x74, 0x89, 0xac, 0x53, 0x85, 0x8b, 0xb6, 0xa8, 0xe1, 0xc2, 0x55, 0x8a, 0x77, 0x82, 0x29, 0x37,
+	0x67, 0x2c, 0xcb, 0x2d, 0xee, 0xcd, 0x1e, 0x6e, 0xe8, 0x7f, 0xd1, 0x60, 0x79, 0xc8, 0x56, 0x72,
+	0x05, 0x72, 0xdc, 0x47, 0x74, 0x50, 0x76, 0xe8, 0x27, 0x42, 0xa8, 0x57, 0x40, 0x7a, 0xb7, 0x32,
+	0x95, 0xbd, 0x80, 0xf4, 0x6e, 0xe4, 0xa0, 0xd7, 0xd1, 0x4e, 0x4a, 0x5d, 0x95, 0xbd, 0x90, 0x66,
+	0x3f, 0xd1, 0x60, 0xb3, 0xef, 0x63, 0x4d, 0x7b, 0x70, 0x8c, 0x4c, 0x47, 0x2b, 0x30, 0x2b, 0x92,
+	0x04, 0xfa, 0x60, 0x88, 0x1f, 0xe4, 0x12, 0xcc, 0x25, 0x93, 0x8f, 0x21, 0x7f, 0x91, 0x55, 0x98,
+	0x13, 0xc9, 0x0b, 0x2f, 0x29, 0x67, 0xcc, 0x62, 0xda, 0xd2, 0xff, 0x34, 0x0d, 0xd7, 0xde, 0x61,
+	0x82, 0xe4, 0xf4, 0x75, 0x28, 0x29, 0x87, 0x43, 0x3f, 0x60, 0x54, 0xb2, 0xd9, 0x50, 0x3d, 0xf7,
+	0x33, 0x5c, 0x24, 0x57, 0x01, 0x5c, 0xf6, 0x52, 0x89, 0x08, 0xb3, 0xf2, 0x2e, 0x7b, 0x29, 0xb7,
+	0xef, 0x02, 0x11, 0x5b, 0xa6, 0xd3, 0xf6, 0x03, 0xef, 0x9c, 0xb5, 0x59, 0x6c, 0xe6, 0xb2, 0xd8,
+	0x39, 0xec, 0x6d, 0xf0, 0x8b, 0xc4, 0xf6, 0x93, 0xfa, 0xc2, 0xe6, 0xbc, 0x31, 0xcf, 0x7f, 0xef,
+	0xf9, 0x5d, 0x72, 0x1b, 0xa4, 0x7c, 0xb2, 0xaa, 0xcc, 0xa2, 0xcc, 0x92, 0xd8, 0x48, 0x94, 0x8c,
+	0x2d, 0x28, 0xf6, 0xf2, 0x03, 0x57, 0x86, 0x05, 0xda, 0x58, 0x88, 0x17, 0xb9, 0xc6, 0x0f, 0xe1,
+	0xb2, 0x4a, 0x73, 0xb6, 0x69, 0x53, 0xa7, 0xd5, 0x8d, 0xdf, 0x62, 0x58, 0x8c, 0x8d, 0xd5, 0x78,
+	0x7b, 0x9f, 0xef, 0xaa, 0xd7, 0xd6, 0x06, 0x14, 0x64, 0x7b, 0x8c, 0x6f, 0x1b, 0xac, 0xb8, 0x06,
+	0x88, 0x25, 0x7c, 0xda, 0xec, 0x80, 0xea, 0x1e, 0xcd, 0x06, 0x0b, 0x23, 0xb3, 0xe1, 0xd8, 0xa2,
+	0x98, 0x1a, 0x2a, 0xa4, 0x75, 0x16, 0x46, 0x75, 0xc7, 0x1e, 0x92, 0xa4, 0xe1, 0xa9, 0xa8, 0x94,
+	0x7d, 0x92, 0x7b, 0xe1, 0xa9, 0xfe, 0x21, 0xac, 0xe3, 0x9d, 0xed, 0xc9, 0x26, 0x24, 0x23, 0x63,
+	0x74, 0x53, 0x76, 0x89, 0x43, 0x38, 0x79, 0xcd, 0xdf, 0x87, 0xf9, 0x6f, 0xf4, 0x00, 0x55, 0x28,
+	0xfd, 0x97, 0x9a, 0x7c, 0xee, 0x3c, 0x0f, 0xa8, 0xcd, 0x32, 0x51, 0xb8, 0xe5, 0xb4, 0x9d, 0x08,
+	0xb9, 0x52, 0x34, 0xc4, 0x0f, 0x72, 0x00, 0xd0, 0x1b, 0x10, 0x21, 0x3f, 0x0a, 0xf7, 0xb6, 0xfb,
+	0xde, 0x40, 0x62, 0x12, 0xa5, 0x5e, 0x42, 0xc7, 0xb4, 0xa9, 0x1a, 0x5d, 0x23, 0x81, 0xd4, 0x3f,
+	0x57, 0xaf, 0x2c, 0x65, 0x8d, 0x74, 0xf3, 0x3e, 0xcc, 0x45, 0xb8, 0x32, 0x6a, 0x4c, 0x82, 0xf2,
+	0xd2, 0x37, 0x29, 0x4a, 0x1e, 0xf5, 0x19, 0x25, 0xde, 0xa8, 0x37, 0xc6, 0x1a, 0x25, 0x4e, 0x4c,
+	0x5a, 0x75, 0xef, 0x75, 0x09, 0x66, 0xd1, 0x2a, 0x72, 0x06, 0x73, 0x62, 0x38, 0x44, 0x86, 0xde,
+	0x32, 0xc3, 0xf3, 0xa7, 0xf2, 0xd6, 0x3b, 0x65, 0xc4, 0x41, 0x7a, 0xe5, 0xa7, 0x7f, 0xfb, 0xf7,
+	0xe7, 0x53, 0x6b, 0xe4, 0x52, 0x2d, 0x75, 0xf8, 0x45, 0x7e, 0xa1, 0x41, 0x3e, 0x9e, 0x1d, 0x91,
+	0xeb, 0xa9, 0x2a, 0x07, 0xc7, 0x51, 0xe5, 0xed, 0x71, 0x62, 0xf2, 0xf0, 0x3b, 0x78, 0xf8, 0x36,
+	0x79, 0x7f, 0xf0, 0x70, 0xd1, 0xd1, 0x34, 0x3c, 0xef, 0xb4, 0xf6, 0x89, 0x24, 0xc2, 0xa7, 0xe4,
+	0x33, 0x0d, 0x0a, 0x89, 0xe1, 0x0f, 0xb9, 0x91, 0x7a, 0xca, 0xf0, 0x50, 0xa9, 0xbc, 0x33, 0x5e,
+	0x50, 0x1a, 0x54, 0x45, 0x83, 0x76, 0xc8, 0xf6, 0xa0, 0x41, 0x9d, 0xb0, 0x37, 0x6b, 0xa9, 0x7d,
+	0x22, 0x07, 0x38, 0x9f, 0x92, 0x2f, 0x34, 0x58, 0x48, 0x7e, 0x18, 0x64, 0x67, 0xb4, 0xe7, 0x03,
+	0x46, 0xdd, 0xcc, 0x20, 0x29, 0xad, 0xaa, 0xa1, 0x55, 0x37, 0xc9, 0x8d, 0xf4, 0x30, 0x0d, 0x9b,
+	0xf5, 0x33, 0x0d, 0x72, 0x6a, 0xaa, 0x42, 0xde, 0x4f, 0x3d, 0x68, 0x60, 0x4c, 0x53, 0xbe, 0x3e,
+	0x46, 0x4a, 0x9a, 0x72, 0x1b, 0x4d, 0xb9, 0x4e, 0xb6, 0x06, 0x4d, 0x89, 0xa7, 0x31, 0x89, 0x0b,
+	0x0b, 0x60, 0x4e, 0x8c, 0x2b, 0x46, 0xd0, 0xb5, 0x6f, 0xc4, 0x31, 0x82, 0xae, 0xfd, 0xf3, 0x0e,
+	0x7d, 0x03, 0xcf, 0xbf, 0x42, 0x2e, 0x0f, 0x9e, 0xdf, 0xb2, 0xf0, 0x74, 0xf2, 0x5b, 0x0d, 0xc8,
+	0xf0, 0xbb, 0x9e, 0x54, 0x53, 0x95, 0x8f, 0x1c, 0x2f, 0x94, 0x6b, 0x99, 0xe5, 0xc7, 0x51, 0x59,
+	0x35, 0x28, 0x32, 0xe9, 0x8b, 0xc9, 0xc2, 0x97, 0x1a, 0x2c, 0x0d, 0xbe, 0x9f, 0xc9, 0x9d, 0xf4,
+	0x00, 0xa4, 0x3f, 0xd0, 0xcb, 0x77, 0x33, 0x4a, 0x4b, 0xfb, 0x6e, 0xa2, 0x7d, 0x5b, 0xe4, 0xda,
+	0x50, 0xe0, 0xe2, 0xa9, 0x84, 0x7c, 0xd0, 0x21, 0xa9, 0x93, 0x8f, 0x81, 0x11, 0xa4, 0x4e, 0x79,
+	0x09, 0x8d, 0x20, 0x75, 0xda, 0xcb, 0x62, 0x34, 0xa9, 0x55, 0xd9, 0xc4, 0x96, 0x2e, 0xc1, 0xa6,
+	0x5f, 0x69, 0x50, 0xec, 0x6b, 0xa0, 0x49, 0xfa, 0x69, 0x69, 0x2d, 0x78, 0xf9, 0x56, 0x16, 0x51,
+	0x69, 0xd9, 0x36, 0x5a, 0xb6, 0x49, 0x2a, 0x83, 0x96, 0xf1, 0x2e, 0x31, 0xec, 0x1d, 0xff, 0x7b,
+	0x0d, 0x56, 0xd2, 0x9a, 0x20, 0xf2, 0xc1, 0x3b, 0xa3, 0x90, 0x96, 0x0c, 0x76, 0x27, 0x40, 0x8c,
+	0x4b, 0x55, 0x71, 0xfc, 0xfa, 0xb2, 0x03, 0xf9, 0x8d, 0x06, 0x8b, 0x03, 0x65, 0x9c, 0xdc, 0x4e,
+	0x3d, 0x36, 0xbd, 0x49, 0x28, 0xdf, 0xc9, 0x26, 0x3c, 0x8e, 0x6f, 0x34, 0x9e, 0x8f, 0x28, 0xcb,
+	0xce, 0x60, 0x4e, 0xd4, 0xdb, 0x11, 0x69, 0xa2, 0xaf, 0x35, 0x18, 0x91, 0x26, 0xfa, 0x0b, 0xf6,
+	0xe8, 0xaa, 0x26, 0x6a, 0x73, 0xfd, 0xee, 0x57, 0x6f, 0x2a, 0xda, 0xeb, 0x37, 0x15, 0xed, 0x5f,
+	0x6f, 0x2a, 0xda, 0x67, 0x6f, 0x2b, 0x17, 0x5e, 0xbf, 0xad, 0x5c, 0xf8, 0xc7, 0xdb, 0xca, 0x85,
+	0x1f, 0x5f, 0x54, 0x80, 0x57, 0x08, 0xc1, 0xbf, 0xf2, 0x34, 0xe6, 0xf0, 0xcf, 0x3c, 0xf7, 0xff,
+	0x1f, 0x00, 0x00, 0xff, 0xff, 0xd8, 0x40, 0x4d, 0xe0, 0xe0, 0x1a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3322,6 +3343,18 @@ func (m *QueryTradesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Limit != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
 		i--
@@ -3355,6 +3388,18 @@ func (m *QueryTradesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Trades) > 0 {
 		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3821,6 +3866,10 @@ func (m *QueryTradesRequest) Size() (n int) {
 	if m.Limit != 0 {
 		n += 1 + sovQuery(uint64(m.Limit))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3836,6 +3885,10 @@ func (m *QueryTradesResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -7267,6 +7320,42 @@ func (m *QueryTradesRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7351,6 +7440,42 @@ func (m *QueryTradesResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1 +1,28 @@
 package types
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OrderLock returns the funds locked in the module account for an order of
+// amount at price: the quote paid at price for a buy order, and the base
+// itself for a sell order. Prices are quoted per whole base unit.
+func OrderLock(isBuy bool, price, amount sdk.Coin) sdk.Coin {
+	if !isBuy {
+		return amount
+	}
+	quote := price.Amount.Mul(amount.Amount).Quo(MicroUnitsPerWholeUnitDec)
+	return sdk.NewCoin(price.Denom, quote)
+}
+
+// Remaining returns the part of the order that is not filled yet.
+func (o Order) Remaining() math.Int {
+	return o.Amount.Amount.Sub(o.FilledAmount.Amount)
+}
+
+// LockedFunds returns the funds still locked for the unfilled part of the
+// order.
+func (o Order) LockedFunds() sdk.Coin {
+	return OrderLock(o.IsBuy, o.Price, sdk.NewCoin(o.Amount.Denom, o.Remaining()))
+}
@@ -786,6 +786,68 @@ func (m *DynamicRewardState) GetVolumeHistory() []VolumeSnapshot {
 	return nil
 }
 
+// LCPriceHistory tracks the LC reference price. The price only rises, to the
+// lowest market price of a 72 hour window, if that is above the floor.
+type LCPriceHistory struct {
+	// current_price is the reference price in MC per LC
+	CurrentPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=current_price,json=currentPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"current_price"`
+	// historical_floor is the highest window minimum seen, never lowered
+	HistoricalFloor cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=historical_floor,json=historicalFloor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"historical_floor"`
+	// window_start_time is the unix time the current window started at
+	WindowStartTime int64 `protobuf:"varint,3,opt,name=window_start_time,json=windowStartTime,proto3" json:"window_start_time,omitempty"`
+	// window_min_price is the lowest market price in the current window
+	WindowMinPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=window_min_price,json=windowMinPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"window_min_price"`
+	// last_update_time is the unix time of the last update
+	LastUpdateTime int64 `protobuf:"varint,5,opt,name=last_update_time,json=lastUpdateTime,proto3" json:"last_update_time,omitempty"`
+}
+
+func (m *LCPriceHistory) Reset()         { *m = LCPriceHistory{} }
+func (m *LCPriceHistory) String() string { return proto.CompactTextString(m) }
+func (*LCPriceHistory) ProtoMessage()    {}
+func (*LCPriceHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_365ac4383fac3c97, []int{11}
+}
+func (m *LCPriceHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LCPriceHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LCPriceHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LCPriceHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LCPriceHistory.Merge(m, src)
+}
+func (m *LCPriceHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *LCPriceHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_LCPriceHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LCPriceHistory proto.InternalMessageInfo
+
+func (m *LCPriceHistory) GetWindowStartTime() int64 {
+	if m != nil {
+		return m.WindowStartTime
+	}
+	return 0
+}
+
+func (m *LCPriceHistory) GetLastUpdateTime() int64 {
+	if m != nil {
+		return m.LastUpdateTime
+	}
+	return 0
+}
+
 // VolumeSnapshot tracks trading volume at a specific point in time
 type VolumeSnapshot struct {
 	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
@@ -800,7 +862,7 @@ func (m *VolumeSnapshot) Reset()         { *m = VolumeSnapshot{} }
 func (m *VolumeSnapshot) String() string { return proto.CompactTextString(m) }
 func (*VolumeSnapshot) ProtoMessage()    {}
 func (*VolumeSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_365ac4383fac3c97, []int{11}
+	return fileDescriptor_365ac4383fac3c97, []int{12}
 }
 func (m *VolumeSnapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -858,7 +920,7 @@ func (m *FeeEstimate) Reset()         { *m = FeeEstimate{} }
 func (m *FeeEstimate) String() string { return proto.CompactTextString(m) }
 func (*FeeEstimate) ProtoMessage()    {}
 func (*FeeEstimate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_365ac4383fac3c97, []int{12}
+	return fileDescriptor_365ac4383fac3c97, []int{13}
 }
 func (m *FeeEstimate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -898,7 +960,7 @@ func (m *OrderBookDepth) Reset()         { *m = OrderBookDepth{} }
 func (m *OrderBookDepth) String() string { return proto.CompactTextString(m) }
 func (*OrderBookDepth) ProtoMessage()    {}
 func (*OrderBookDepth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_365ac4383fac3c97, []int{13}
+	return fileDescriptor_365ac4383fac3c97, []int{14}
 }
 func (m *OrderBookDepth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -958,7 +1020,7 @@ func (m *PriceLevel) Reset()         { *m = PriceLevel{} }
 func (m *PriceLevel) String() string { return proto.CompactTextString(m) }
 func (*PriceLevel) ProtoMessage()    {}
 func (*PriceLevel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_365ac4383fac3c97, []int{14}
+	return fileDescriptor_365ac4383fac3c97, []int{15}
 }
 func (m *PriceLevel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -998,7 +1060,7 @@ func (m *MarketDepthAnalysis) Reset()         { *m = MarketDepthAnalysis{} }
 func (m *MarketDepthAnalysis) String() string { return proto.CompactTextString(m) }
 func (*MarketDepthAnalysis) ProtoMessage()    {}
 func (*MarketDepthAnalysis) Descriptor() ([]byte, []int) {
-	return fileDescriptor_365ac4383fac3c97, []int{15}
+	return fileDescriptor_365ac4383fac3c97, []int{16}
 }
 func (m *MarketDepthAnalysis) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1060,7 +1122,7 @@ func (m *LiquidityLevel) Reset()         { *m = LiquidityLevel{} }
 func (m *LiquidityLevel) String() string { return proto.CompactTextString(m) }
 func (*LiquidityLevel) ProtoMessage()    {}
 func (*LiquidityLevel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_365ac4383fac3c97, []int{16}
+	return fileDescriptor_365ac4383fac3c97, []int{17}
 }
 func (m *LiquidityLevel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1101,6 +1163,7 @@ func init() {
 	proto.RegisterType((*UserReward)(nil), "mychain.dex.v1.UserReward")
 	proto.RegisterType((*UserRewardInfo)(nil), "mychain.dex.v1.UserRewardInfo")
 	proto.RegisterType((*DynamicRewardState)(nil), "mychain.dex.v1.DynamicRewardState")
+	proto.RegisterType((*LCPriceHistory)(nil), "mychain.dex.v1.LCPriceHistory")
 	proto.RegisterType((*VolumeSnapshot)(nil), "mychain.dex.v1.VolumeSnapshot")
 	proto.RegisterType((*FeeEstimate)(nil), "mychain.dex.v1.FeeEstimate")
 	proto.RegisterType((*OrderBookDepth)(nil), "mychain.dex.v1.OrderBookDepth")
@@ -1112,104 +1175,109 @@ func init() {
 func init() { proto.RegisterFile("mychain/dex/v1/types.proto", fileDescriptor_365ac4383fac3c97) }
 
 var fileDescriptor_365ac4383fac3c97 = []byte{
-	// 1552 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xcd, 0x6e, 0x1b, 0xc9,
-	0x11, 0xd6, 0x90, 0xa2, 0x48, 0x16, 0x7f, 0x24, 0x8f, 0xe4, 0x98, 0x56, 0x6c, 0x8a, 0x99, 0x5c,
-	0x94, 0x00, 0x21, 0x21, 0x07, 0xce, 0x1f, 0x8c, 0x00, 0xa2, 0x15, 0xc5, 0x4a, 0xe4, 0xd8, 0x19,
-	0xc9, 0x0e, 0x90, 0xcb, 0xa0, 0x39, 0xd3, 0x14, 0x1b, 0x9c, 0x1f, 0x7a, 0xba, 0x87, 0x16, 0x7d,
-	0xc8, 0x3d, 0x40, 0x0e, 0xb9, 0x26, 0x8f, 0xb1, 0xc0, 0x9e, 0xf6, 0xba, 0x07, 0xfb, 0xe6, 0xbd,
-	0x2d, 0x16, 0x58, 0x63, 0x61, 0x3f, 0xc1, 0xee, 0x13, 0x2c, 0xba, 0xba, 0x67, 0x48, 0x49, 0x2b,
-	0x68, 0xe4, 0xbd, 0xb1, 0xab, 0xea, 0xab, 0xae, 0xa9, 0xae, 0xfa, 0xaa, 0x9b, 0xb0, 0x19, 0xcc,
-	0xdc, 0x11, 0x61, 0x61, 0xcf, 0xa3, 0xa7, 0xbd, 0xe9, 0x4e, 0x4f, 0xcc, 0x26, 0x94, 0x77, 0x27,
-	0x71, 0x24, 0x22, 0xb3, 0xa9, 0x75, 0x5d, 0x8f, 0x9e, 0x76, 0xa7, 0x3b, 0x9b, 0x1b, 0x27, 0xd1,
-	0x49, 0x84, 0xaa, 0x9e, 0xfc, 0xa5, 0xac, 0x36, 0xdb, 0x6e, 0xc4, 0x83, 0x88, 0xf7, 0x06, 0x84,
-	0xd3, 0xde, 0x74, 0x67, 0x40, 0x05, 0xd9, 0xe9, 0xb9, 0x11, 0x0b, 0x95, 0xde, 0xfa, 0xa2, 0x00,
-	0xa5, 0x27, 0xb1, 0x47, 0x63, 0xb3, 0x09, 0x05, 0xe6, 0xb5, 0x8c, 0x8e, 0xb1, 0xbd, 0x6c, 0x17,
-	0x98, 0x67, 0x6e, 0x40, 0x29, 0x20, 0x63, 0x1a, 0xb7, 0x0a, 0x1d, 0x63, 0xbb, 0x6a, 0xab, 0x85,
-	0x79, 0x0b, 0xca, 0x13, 0xc2, 0x62, 0x87, 0x79, 0xad, 0x22, 0x9a, 0xae, 0xc8, 0xe5, 0x81, 0x67,
-	0xde, 0x84, 0x15, 0xc6, 0x9d, 0x41, 0x32, 0x6b, 0x2d, 0x77, 0x8c, 0xed, 0x8a, 0x5d, 0x62, 0xbc,
-	0x9f, 0xcc, 0xcc, 0xfb, 0x50, 0x9a, 0xc4, 0xcc, 0xa5, 0xad, 0x52, 0xc7, 0xd8, 0xae, 0xdd, 0xbb,
-	0xdd, 0x55, 0xf1, 0x74, 0x65, 0x3c, 0x5d, 0x1d, 0x4f, 0xf7, 0x61, 0xc4, 0xc2, 0xfe, 0xf2, 0xeb,
-	0x77, 0x5b, 0x4b, 0xb6, 0xb2, 0x36, 0x7f, 0x0b, 0x2b, 0x24, 0x88, 0x92, 0x50, 0xb4, 0x56, 0xf2,
-	0xe1, 0xb4, 0xb9, 0xb9, 0x07, 0x8d, 0x21, 0xf3, 0x7d, 0xea, 0x39, 0x1a, 0x5f, 0xce, 0x87, 0xaf,
-	0x2b, 0xd4, 0xae, 0xf2, 0x72, 0x17, 0xc0, 0x8d, 0x29, 0x11, 0xd2, 0x8d, 0x68, 0x55, 0x3a, 0xc6,
-	0x76, 0xd1, 0xae, 0x6a, 0xc9, 0x2e, 0xaa, 0x93, 0x89, 0x97, 0xaa, 0xab, 0x4a, 0xad, 0x25, 0xbb,
-	0xc2, 0x4a, 0xa0, 0x76, 0x1c, 0x13, 0x8f, 0x85, 0x27, 0x4f, 0x09, 0xbb, 0x98, 0xd8, 0xbb, 0x00,
-	0x32, 0x0a, 0xc7, 0xa3, 0x61, 0x14, 0xe8, 0xec, 0x56, 0xa5, 0x64, 0x4f, 0x0a, 0xcc, 0x2d, 0xa8,
-	0xbd, 0x48, 0x22, 0x91, 0xea, 0x8b, 0xa8, 0x07, 0x14, 0x29, 0x83, 0x9f, 0xc0, 0x0a, 0x71, 0x05,
-	0x9b, 0x52, 0x9d, 0x69, 0xbd, 0xb2, 0x3e, 0x2b, 0x40, 0xe3, 0x90, 0xbd, 0x48, 0x98, 0xc7, 0xc4,
-	0xec, 0x98, 0x9d, 0x39, 0xd2, 0x06, 0xee, 0x7c, 0x08, 0xab, 0x98, 0x5e, 0xc7, 0xa3, 0x53, 0x46,
-	0x04, 0x8b, 0x42, 0xb5, 0x7d, 0xff, 0xe7, 0x32, 0x07, 0x5f, 0xbd, 0xdb, 0xfa, 0xa9, 0xca, 0x12,
-	0xf7, 0xc6, 0x5d, 0x16, 0xf5, 0x02, 0x22, 0x46, 0xdd, 0x43, 0x7a, 0x42, 0xdc, 0xd9, 0x1e, 0x75,
-	0xed, 0x26, 0x62, 0xf7, 0x52, 0xa8, 0x79, 0x00, 0xcd, 0x01, 0xf3, 0x9c, 0x69, 0xe4, 0x27, 0x01,
-	0x75, 0x5c, 0x32, 0x51, 0xb1, 0xe6, 0x73, 0x56, 0x1f, 0x30, 0xef, 0x39, 0x22, 0x1f, 0x92, 0x89,
-	0x74, 0x45, 0xf8, 0x78, 0xd1, 0xd5, 0xf2, 0x35, 0x5c, 0x11, 0x3e, 0x9e, 0xbb, 0xfa, 0x0d, 0xdc,
-	0x7a, 0xc9, 0x42, 0x2f, 0x7a, 0xe9, 0x78, 0x49, 0x8c, 0x81, 0x3a, 0x9c, 0xba, 0x51, 0xe8, 0x71,
-	0x2c, 0xc1, 0xa2, 0x7d, 0x53, 0xa9, 0xf7, 0xb4, 0xf6, 0x48, 0x29, 0xad, 0x37, 0x45, 0x58, 0xc5,
-	0x46, 0xb0, 0xe9, 0x4b, 0x12, 0x7b, 0x07, 0xe1, 0x30, 0x32, 0x6f, 0x43, 0x25, 0x92, 0x22, 0x27,
-	0x3b, 0xbf, 0x32, 0xae, 0x0f, 0x3c, 0xd9, 0x07, 0x82, 0x29, 0x4d, 0x01, 0xf3, 0xbb, 0x22, 0x97,
-	0x07, 0x78, 0xba, 0x5c, 0x90, 0x58, 0x38, 0x82, 0x05, 0x14, 0x33, 0x52, 0xb4, 0xab, 0x28, 0x39,
-	0x66, 0x01, 0x35, 0x7f, 0x06, 0x75, 0x9f, 0x70, 0xe1, 0xe8, 0x6a, 0xc1, 0xef, 0x2c, 0xda, 0x35,
-	0x29, 0x7b, 0xa6, 0x44, 0xe6, 0x2f, 0x60, 0x8d, 0xb8, 0x6e, 0x12, 0x24, 0x3e, 0x56, 0x18, 0xfa,
-	0x51, 0xa1, 0xaf, 0x2e, 0xc8, 0xd1, 0x5b, 0x1f, 0x1a, 0x22, 0x12, 0xc4, 0x77, 0x62, 0x0c, 0x9a,
-	0x63, 0xb7, 0x54, 0xfb, 0x77, 0x75, 0xda, 0x6e, 0x5e, 0x4c, 0xdb, 0x41, 0x28, 0xec, 0x3a, 0x62,
-	0xd4, 0x77, 0x72, 0xf3, 0x97, 0x70, 0x03, 0x23, 0x72, 0x7d, 0xc2, 0x82, 0x74, 0xbf, 0xb2, 0xda,
-	0x4f, 0x2a, 0x1e, 0x2a, 0x39, 0xee, 0xf7, 0x14, 0x6e, 0xf0, 0x49, 0x4c, 0x89, 0xe7, 0x04, 0x89,
-	0x2f, 0xd8, 0xc4, 0x67, 0x34, 0xc6, 0xf6, 0xc8, 0x79, 0x54, 0x6b, 0x0a, 0xfd, 0x38, 0x03, 0x9b,
-	0x47, 0xb0, 0x3e, 0x3f, 0x75, 0x67, 0x18, 0xcb, 0x5a, 0x8e, 0x42, 0xec, 0xa9, 0x9c, 0x3e, 0x6f,
-	0x4c, 0xd3, 0xb3, 0xdf, 0xd7, 0x68, 0x6b, 0x08, 0x0d, 0x55, 0x10, 0xc7, 0x31, 0x71, 0xcf, 0xb1,
-	0x96, 0x71, 0x86, 0xb5, 0x1e, 0x40, 0x59, 0x95, 0x03, 0x6f, 0x15, 0x3a, 0xc5, 0xed, 0xda, 0xbd,
-	0x3b, 0xdd, 0xb3, 0xb4, 0xda, 0x55, 0x8e, 0xfe, 0x81, 0x46, 0x9a, 0x2b, 0x52, 0x88, 0xf5, 0xb9,
-	0x01, 0xf5, 0x45, 0xfd, 0xb9, 0xc3, 0x37, 0xce, 0x1f, 0xfe, 0x6d, 0xa8, 0xd0, 0x50, 0x67, 0xb8,
-	0x80, 0xca, 0x32, 0x0d, 0x55, 0x66, 0x1f, 0x00, 0xcc, 0x9b, 0x49, 0x37, 0xd2, 0x15, 0xc7, 0x58,
-	0xcd, 0x5a, 0x48, 0xa2, 0xe7, 0xfd, 0xa3, 0x7b, 0xe7, 0x2a, 0x74, 0xd6, 0x35, 0xd6, 0xff, 0x0d,
-	0x68, 0x3e, 0x95, 0xbd, 0x6d, 0xd3, 0x21, 0x8d, 0x69, 0xe8, 0xd2, 0xcb, 0x13, 0x76, 0x08, 0xab,
-	0x71, 0x6a, 0xe5, 0x28, 0x66, 0xbf, 0x0e, 0x85, 0x64, 0x58, 0xdc, 0xef, 0x42, 0x37, 0x14, 0x2f,
-	0x74, 0x83, 0xf5, 0x69, 0x01, 0x4a, 0x92, 0x4d, 0xe9, 0x05, 0x1e, 0x5d, 0x88, 0xb1, 0x70, 0x26,
-	0xc6, 0x0e, 0xd4, 0x07, 0xc9, 0xcc, 0xc9, 0x5a, 0x57, 0x0d, 0x2a, 0x18, 0x24, 0xb3, 0x27, 0xba,
-	0x7b, 0x2d, 0x68, 0x70, 0xea, 0xfb, 0x73, 0x93, 0x65, 0x34, 0xa9, 0x49, 0x61, 0x6a, 0xb3, 0x01,
-	0xa5, 0x41, 0x32, 0xa3, 0x31, 0xf6, 0x5e, 0xd5, 0x56, 0x0b, 0x49, 0xbe, 0xd2, 0x88, 0xc6, 0xaa,
-	0xd5, 0x6c, 0xbd, 0x9a, 0xcf, 0xb9, 0xf2, 0x47, 0xce, 0xb9, 0xca, 0xf5, 0xe6, 0xdc, 0x16, 0xd4,
-	0xe8, 0x29, 0x75, 0x93, 0x33, 0x33, 0x08, 0x52, 0xd1, 0xae, 0xb0, 0x3e, 0x31, 0x00, 0x9e, 0xf1,
-	0x94, 0xce, 0xcc, 0x16, 0x94, 0x89, 0xe7, 0xc5, 0x94, 0x73, 0xcc, 0x60, 0xd5, 0x4e, 0x97, 0x17,
-	0x39, 0xa4, 0x70, 0x7d, 0x0e, 0xd9, 0x87, 0xd5, 0x94, 0x3e, 0x52, 0x2f, 0xb9, 0x4a, 0xb8, 0xa9,
-	0x51, 0xda, 0x8f, 0xf5, 0x9d, 0x01, 0xcd, 0x79, 0xd0, 0xc8, 0xc1, 0x97, 0x07, 0xfe, 0x00, 0x60,
-	0x42, 0x43, 0x39, 0x66, 0x1d, 0xdf, 0xcd, 0x17, 0x75, 0x55, 0x03, 0x0e, 0x5d, 0x89, 0x4e, 0x43,
-	0xf6, 0xdd, 0x9c, 0x0d, 0xa7, 0x01, 0x87, 0xae, 0xf9, 0x17, 0x68, 0xa8, 0xda, 0x49, 0x3f, 0x77,
-	0x19, 0xd9, 0x63, 0xeb, 0x3c, 0x7b, 0x9c, 0x9b, 0x28, 0xe9, 0x65, 0x23, 0x9a, 0x8b, 0xb9, 0xf5,
-	0x9f, 0x02, 0x98, 0x7b, 0xb3, 0x90, 0x04, 0xcc, 0x55, 0xa2, 0x23, 0x41, 0x04, 0x95, 0xcc, 0xe8,
-	0x26, 0x71, 0x4c, 0x43, 0xe1, 0x90, 0x30, 0x4c, 0xe4, 0x01, 0x11, 0xa1, 0x48, 0x25, 0x2f, 0x33,
-	0x6a, 0xfc, 0x2e, 0xc2, 0x6d, 0xe9, 0x34, 0x25, 0x7b, 0xd5, 0x70, 0xce, 0xc0, 0x8f, 0xdc, 0xb1,
-	0xa6, 0xa2, 0xd5, 0x79, 0xd7, 0xf5, 0xa5, 0xd8, 0xdc, 0x86, 0xb5, 0x45, 0xdb, 0x85, 0x79, 0xd6,
-	0x9c, 0x9b, 0x22, 0x79, 0xfd, 0x15, 0x9a, 0x9a, 0xc4, 0x47, 0x8c, 0x8b, 0x28, 0x9e, 0xe9, 0x74,
-	0xb4, 0x7f, 0x98, 0x4c, 0x8f, 0x42, 0x32, 0xe1, 0xa3, 0x48, 0xe8, 0x6c, 0x34, 0x14, 0xf6, 0x91,
-	0x82, 0x5a, 0x5f, 0x1b, 0xd0, 0x3c, 0x6b, 0x27, 0x69, 0x02, 0x23, 0x75, 0x46, 0x94, 0x9d, 0x8c,
-	0x84, 0x26, 0xd6, 0x1a, 0xca, 0x1e, 0xa1, 0xc8, 0xbc, 0x03, 0x55, 0x19, 0x20, 0x17, 0x24, 0x98,
-	0xe8, 0x0f, 0x9a, 0x0b, 0x64, 0x8d, 0x8f, 0xa2, 0x24, 0xf6, 0x67, 0xd7, 0x22, 0xd8, 0xba, 0xc2,
-	0x68, 0x8e, 0xdd, 0x87, 0x55, 0x3f, 0xbd, 0x5d, 0x39, 0x1e, 0x9d, 0x88, 0x51, 0x3e, 0xa2, 0x6d,
-	0x66, 0xa8, 0x3d, 0x09, 0xb2, 0xbe, 0x2d, 0x42, 0x6d, 0x9f, 0xd2, 0x3f, 0x71, 0xc1, 0x02, 0x79,
-	0x24, 0x7f, 0x84, 0x9a, 0x2a, 0xa5, 0x29, 0xf1, 0x93, 0xf4, 0x7c, 0xaf, 0xf0, 0x09, 0x88, 0x78,
-	0x2e, 0x01, 0xe6, 0x1f, 0xa0, 0x8a, 0x57, 0x73, 0x67, 0x48, 0x69, 0xbe, 0x2e, 0xa8, 0xa0, 0xfd,
-	0x3e, 0x45, 0xac, 0xc8, 0xb0, 0xb9, 0x72, 0x52, 0x11, 0x29, 0xf6, 0x77, 0x50, 0x41, 0x0e, 0x95,
-	0xd0, 0x5c, 0x89, 0x28, 0x4b, 0x73, 0x89, 0x7c, 0x0e, 0x1b, 0xf3, 0x4c, 0x2e, 0x5c, 0x24, 0x4a,
-	0xf9, 0x4b, 0x7b, 0x3d, 0x73, 0xb0, 0x70, 0x97, 0xf8, 0x1b, 0xac, 0x93, 0x29, 0x61, 0x3e, 0x19,
-	0xf8, 0xd4, 0xc9, 0x0c, 0xf2, 0xdd, 0x89, 0xcc, 0x0c, 0x99, 0x5d, 0xa1, 0xcd, 0x47, 0xd0, 0x08,
-	0x48, 0x3c, 0xa6, 0xc2, 0x61, 0xc1, 0x84, 0xb8, 0xea, 0x2d, 0x91, 0xf7, 0x52, 0xaa, 0x90, 0x07,
-	0x08, 0xb4, 0xfe, 0x6d, 0x40, 0x13, 0xa9, 0xa0, 0x1f, 0x45, 0x63, 0x2c, 0x83, 0xcb, 0x27, 0x6c,
-	0x17, 0x96, 0x07, 0xcc, 0x4b, 0xef, 0x23, 0x9b, 0xe7, 0x5b, 0x08, 0x07, 0xe7, 0x21, 0x9d, 0x52,
-	0xdf, 0x46, 0x3b, 0x69, 0x4f, 0xf8, 0x58, 0x12, 0xee, 0x95, 0xf6, 0xd2, 0xce, 0xfa, 0x17, 0xc0,
-	0x5c, 0x66, 0xfe, 0x3e, 0x9d, 0x5b, 0xd7, 0xe0, 0x15, 0x3d, 0xbb, 0xee, 0x67, 0xb3, 0x2b, 0x57,
-	0xd5, 0x69, 0x63, 0xeb, 0x7f, 0x06, 0xac, 0x3f, 0xc6, 0xe4, 0x60, 0x22, 0x76, 0x43, 0xe2, 0xcf,
-	0x38, 0xe3, 0x97, 0x27, 0xa4, 0x03, 0x75, 0xf5, 0xb2, 0x54, 0xe3, 0x1a, 0x77, 0xab, 0xd8, 0x80,
-	0xef, 0x4b, 0xf5, 0x74, 0xfd, 0x33, 0xd4, 0xd5, 0xbb, 0xc6, 0x97, 0xdf, 0x94, 0xa6, 0xe2, 0x02,
-	0xfb, 0x64, 0x27, 0x8b, 0x9f, 0xae, 0xd9, 0xa7, 0x36, 0xc9, 0x92, 0xc1, 0xad, 0x37, 0x05, 0x68,
-	0x9e, 0xb5, 0x92, 0x73, 0x42, 0xb5, 0x27, 0x67, 0xaf, 0x72, 0x76, 0x67, 0x15, 0x01, 0x47, 0xec,
-	0x15, 0xbd, 0xac, 0x24, 0x0b, 0x1f, 0x5b, 0x92, 0x97, 0xb5, 0x4e, 0xf1, 0x47, 0xb6, 0xce, 0xdf,
-	0xc1, 0xa4, 0xc3, 0x21, 0xc5, 0x87, 0xa4, 0xec, 0x68, 0x35, 0x6b, 0xae, 0xf1, 0x08, 0x5b, 0xcb,
-	0xe0, 0xfb, 0x94, 0xca, 0x51, 0xd3, 0xff, 0xd5, 0xeb, 0xf7, 0x6d, 0xe3, 0xed, 0xfb, 0xb6, 0xf1,
-	0xcd, 0xfb, 0xb6, 0xf1, 0xdf, 0x0f, 0xed, 0xa5, 0xb7, 0x1f, 0xda, 0x4b, 0x5f, 0x7e, 0x68, 0x2f,
-	0xfd, 0x73, 0x3d, 0xfd, 0x57, 0xe3, 0x14, 0xff, 0xd7, 0xc0, 0x3f, 0x35, 0x06, 0x2b, 0xf8, 0x7f,
-	0xc4, 0xaf, 0xbf, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x51, 0x67, 0x52, 0x05, 0xf3, 0x10, 0x00, 0x00,
+	// 1631 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0x4b, 0x6f, 0x1b, 0xd7,
+	0x15, 0xd6, 0xf0, 0x21, 0x92, 0x87, 0x2f, 0x79, 0x24, 0xd7, 0xb4, 0x6a, 0x53, 0xec, 0x74, 0xa3,
+	0x16, 0x28, 0x09, 0xb9, 0x70, 0x5f, 0x30, 0x0a, 0x88, 0x52, 0x55, 0xab, 0xa5, 0x6c, 0x77, 0x24,
+	0xbb, 0x40, 0x37, 0x83, 0xcb, 0x99, 0x4b, 0xf1, 0x82, 0xf3, 0xa0, 0xe7, 0xde, 0xa1, 0x45, 0x2f,
+	0xba, 0x0f, 0x90, 0x45, 0xb6, 0xc9, 0xcf, 0x08, 0x90, 0x55, 0xb6, 0x59, 0xd8, 0xab, 0x38, 0xbb,
+	0x20, 0x40, 0x8c, 0xc0, 0xfe, 0x05, 0xc9, 0x2f, 0x08, 0xee, 0x63, 0x86, 0xa4, 0x68, 0xc1, 0x43,
+	0x67, 0xc7, 0x7b, 0xce, 0xf9, 0xce, 0xdc, 0xf9, 0xee, 0x39, 0xdf, 0xb9, 0x43, 0xd8, 0xf6, 0xa6,
+	0xf6, 0x10, 0x11, 0xbf, 0xe3, 0xe0, 0x8b, 0xce, 0x64, 0xaf, 0xc3, 0xa6, 0x63, 0x4c, 0xdb, 0xe3,
+	0x30, 0x60, 0x81, 0x5e, 0x53, 0xbe, 0xb6, 0x83, 0x2f, 0xda, 0x93, 0xbd, 0xed, 0xad, 0xf3, 0xe0,
+	0x3c, 0x10, 0xae, 0x0e, 0xff, 0x25, 0xa3, 0xb6, 0x9b, 0x76, 0x40, 0xbd, 0x80, 0x76, 0xfa, 0x88,
+	0xe2, 0xce, 0x64, 0xaf, 0x8f, 0x19, 0xda, 0xeb, 0xd8, 0x01, 0xf1, 0xa5, 0xdf, 0xf8, 0x26, 0x03,
+	0xf9, 0x87, 0xa1, 0x83, 0x43, 0xbd, 0x06, 0x19, 0xe2, 0x34, 0xb4, 0x96, 0xb6, 0x9b, 0x33, 0x33,
+	0xc4, 0xd1, 0xb7, 0x20, 0xef, 0xa1, 0x11, 0x0e, 0x1b, 0x99, 0x96, 0xb6, 0x5b, 0x32, 0xe5, 0x42,
+	0xbf, 0x01, 0x85, 0x31, 0x22, 0xa1, 0x45, 0x9c, 0x46, 0x56, 0x84, 0xae, 0xf3, 0xe5, 0xb1, 0xa3,
+	0x5f, 0x87, 0x75, 0x42, 0xad, 0x7e, 0x34, 0x6d, 0xe4, 0x5a, 0xda, 0x6e, 0xd1, 0xcc, 0x13, 0xda,
+	0x8d, 0xa6, 0xfa, 0x5d, 0xc8, 0x8f, 0x43, 0x62, 0xe3, 0x46, 0xbe, 0xa5, 0xed, 0x96, 0xef, 0xdc,
+	0x6c, 0xcb, 0xfd, 0xb4, 0xf9, 0x7e, 0xda, 0x6a, 0x3f, 0xed, 0x83, 0x80, 0xf8, 0xdd, 0xdc, 0x8b,
+	0xd7, 0x3b, 0x6b, 0xa6, 0x8c, 0xd6, 0xff, 0x0c, 0xeb, 0xc8, 0x0b, 0x22, 0x9f, 0x35, 0xd6, 0xd3,
+	0xe1, 0x54, 0xb8, 0x7e, 0x08, 0xd5, 0x01, 0x71, 0x5d, 0xec, 0x58, 0x0a, 0x5f, 0x48, 0x87, 0xaf,
+	0x48, 0xd4, 0xbe, 0xcc, 0x72, 0x1b, 0xc0, 0x0e, 0x31, 0x62, 0x3c, 0x0d, 0x6b, 0x14, 0x5b, 0xda,
+	0x6e, 0xd6, 0x2c, 0x29, 0xcb, 0xbe, 0x70, 0x47, 0x63, 0x27, 0x76, 0x97, 0xa4, 0x5b, 0x59, 0xf6,
+	0x99, 0x11, 0x41, 0xf9, 0x2c, 0x44, 0x0e, 0xf1, 0xcf, 0x1f, 0x21, 0xb2, 0x4c, 0xec, 0x6d, 0x00,
+	0xbe, 0x0b, 0xcb, 0xc1, 0x7e, 0xe0, 0x29, 0x76, 0x4b, 0xdc, 0x72, 0xc8, 0x0d, 0xfa, 0x0e, 0x94,
+	0x9f, 0x46, 0x01, 0x8b, 0xfd, 0x59, 0xe1, 0x07, 0x61, 0x92, 0x01, 0xbf, 0x82, 0x75, 0x64, 0x33,
+	0x32, 0xc1, 0x8a, 0x69, 0xb5, 0x32, 0xbe, 0xcc, 0x40, 0xb5, 0x47, 0x9e, 0x46, 0xc4, 0x21, 0x6c,
+	0x7a, 0x46, 0x16, 0x8e, 0xb4, 0x2a, 0x9e, 0xdc, 0x83, 0xba, 0xa0, 0xd7, 0x72, 0xf0, 0x84, 0x20,
+	0x46, 0x02, 0x5f, 0x3e, 0xbe, 0xfb, 0x5b, 0xce, 0xc1, 0x77, 0xaf, 0x77, 0x7e, 0x2d, 0x59, 0xa2,
+	0xce, 0xa8, 0x4d, 0x82, 0x8e, 0x87, 0xd8, 0xb0, 0xdd, 0xc3, 0xe7, 0xc8, 0x9e, 0x1e, 0x62, 0xdb,
+	0xac, 0x09, 0xec, 0x61, 0x0c, 0xd5, 0x8f, 0xa1, 0xd6, 0x27, 0x8e, 0x35, 0x09, 0xdc, 0xc8, 0xc3,
+	0x96, 0x8d, 0xc6, 0x8d, 0x6c, 0xfa, 0x64, 0x95, 0x3e, 0x71, 0x9e, 0x08, 0xe4, 0x01, 0x1a, 0xf3,
+	0x54, 0x88, 0x8e, 0xe6, 0x53, 0xe5, 0x56, 0x48, 0x85, 0xe8, 0x68, 0x96, 0xea, 0x4f, 0x70, 0xe3,
+	0x19, 0xf1, 0x9d, 0xe0, 0x99, 0xe5, 0x44, 0xa1, 0xd8, 0xa8, 0x45, 0xb1, 0x1d, 0xf8, 0x0e, 0x15,
+	0x25, 0x98, 0x35, 0xaf, 0x4b, 0xf7, 0xa1, 0xf2, 0x9e, 0x4a, 0xa7, 0xf1, 0x32, 0x0b, 0x75, 0xd1,
+	0x08, 0x26, 0x7e, 0x86, 0x42, 0xe7, 0xd8, 0x1f, 0x04, 0xfa, 0x4d, 0x28, 0x06, 0xdc, 0x64, 0x25,
+	0xe7, 0x57, 0x10, 0xeb, 0x63, 0x87, 0xf7, 0x01, 0x23, 0xd2, 0x93, 0x11, 0xfc, 0xae, 0xf3, 0xe5,
+	0xb1, 0x38, 0x5d, 0xca, 0x50, 0xc8, 0x2c, 0x46, 0x3c, 0x2c, 0x18, 0xc9, 0x9a, 0x25, 0x61, 0x39,
+	0x23, 0x1e, 0xd6, 0x7f, 0x03, 0x15, 0x17, 0x51, 0x66, 0xa9, 0x6a, 0x11, 0xef, 0x99, 0x35, 0xcb,
+	0xdc, 0xf6, 0x58, 0x9a, 0xf4, 0xdf, 0xc1, 0x06, 0xb2, 0xed, 0xc8, 0x8b, 0x5c, 0xbe, 0x94, 0x79,
+	0xe4, 0xd6, 0xeb, 0x73, 0x76, 0x91, 0xad, 0x0b, 0x55, 0x16, 0x30, 0xe4, 0x5a, 0xa1, 0xd8, 0x34,
+	0x15, 0xdd, 0x52, 0xea, 0xde, 0x56, 0xb4, 0x5d, 0x5f, 0xa6, 0xed, 0xd8, 0x67, 0x66, 0x45, 0x60,
+	0xe4, 0x7b, 0x52, 0xfd, 0xf7, 0x70, 0x4d, 0xec, 0xc8, 0x76, 0x11, 0xf1, 0xe2, 0xe7, 0x15, 0xe4,
+	0xf3, 0xb8, 0xe3, 0x40, 0xda, 0xc5, 0xf3, 0x1e, 0xc1, 0x35, 0x3a, 0x0e, 0x31, 0x72, 0x2c, 0x2f,
+	0x72, 0x19, 0x19, 0xbb, 0x04, 0x87, 0x8d, 0x62, 0xfa, 0xa3, 0xda, 0x90, 0xe8, 0x93, 0x04, 0xac,
+	0x9f, 0xc2, 0xe6, 0xec, 0xd4, 0xad, 0x41, 0xc8, 0x6b, 0x39, 0xf0, 0x1b, 0xa5, 0xf4, 0x39, 0xaf,
+	0x4d, 0xe2, 0xb3, 0x3f, 0x52, 0x68, 0x63, 0x00, 0x55, 0x59, 0x10, 0x67, 0x21, 0xb2, 0x2f, 0xa9,
+	0x96, 0xb6, 0xa0, 0x5a, 0xf7, 0xa0, 0x20, 0xcb, 0x81, 0x36, 0x32, 0xad, 0xec, 0x6e, 0xf9, 0xce,
+	0xad, 0xf6, 0xa2, 0xac, 0xb6, 0x65, 0xa2, 0xff, 0x8a, 0x20, 0xa5, 0x15, 0x31, 0xc4, 0xf8, 0x4a,
+	0x83, 0xca, 0xbc, 0xff, 0xd2, 0xe1, 0x6b, 0x97, 0x0f, 0xff, 0x26, 0x14, 0xb1, 0xaf, 0x18, 0xce,
+	0x08, 0x67, 0x01, 0xfb, 0x92, 0xd9, 0x7b, 0x00, 0xb3, 0x66, 0x6a, 0x64, 0xd3, 0x1c, 0x63, 0x29,
+	0x69, 0x21, 0x8e, 0x9e, 0xf5, 0x4f, 0x23, 0x97, 0x0a, 0x9d, 0x74, 0x8d, 0xf1, 0x99, 0x06, 0xb5,
+	0x47, 0xbc, 0xb7, 0x4d, 0x3c, 0xc0, 0x21, 0xf6, 0x6d, 0x7c, 0x35, 0x61, 0x3d, 0xa8, 0x87, 0x71,
+	0x94, 0x25, 0x95, 0x7d, 0x15, 0x09, 0x49, 0xb0, 0xe2, 0x79, 0x4b, 0xdd, 0x90, 0x5d, 0xea, 0x06,
+	0xe3, 0x8b, 0x0c, 0xe4, 0xb9, 0x9a, 0xe2, 0x25, 0x1d, 0x9d, 0xdb, 0x63, 0x66, 0x61, 0x8f, 0x2d,
+	0xa8, 0xf4, 0xa3, 0xa9, 0x95, 0xb4, 0xae, 0x1c, 0x54, 0xd0, 0x8f, 0xa6, 0x0f, 0x55, 0xf7, 0x1a,
+	0x50, 0xa5, 0xd8, 0x75, 0x67, 0x21, 0x39, 0x11, 0x52, 0xe6, 0xc6, 0x38, 0x66, 0x0b, 0xf2, 0xfd,
+	0x68, 0x8a, 0x43, 0xd1, 0x7b, 0x25, 0x53, 0x2e, 0xb8, 0xf8, 0xf2, 0x20, 0x1c, 0xca, 0x56, 0x33,
+	0xd5, 0x6a, 0x36, 0xe7, 0x0a, 0x1f, 0x38, 0xe7, 0x8a, 0xab, 0xcd, 0xb9, 0x1d, 0x28, 0xe3, 0x0b,
+	0x6c, 0x47, 0x0b, 0x33, 0x08, 0x62, 0xd3, 0x3e, 0x33, 0x3e, 0xd7, 0x00, 0x1e, 0xd3, 0x58, 0xce,
+	0xf4, 0x06, 0x14, 0x90, 0xe3, 0x84, 0x98, 0x52, 0xc1, 0x60, 0xc9, 0x8c, 0x97, 0xcb, 0x1a, 0x92,
+	0x59, 0x5d, 0x43, 0x8e, 0xa0, 0x1e, 0xcb, 0x47, 0x9c, 0x25, 0x55, 0x09, 0xd7, 0x14, 0x4a, 0xe5,
+	0x31, 0x7e, 0xd2, 0xa0, 0xf6, 0x98, 0x2e, 0x68, 0xf0, 0xd5, 0x1b, 0xbf, 0x07, 0x30, 0xc6, 0x3e,
+	0x1f, 0xb3, 0x96, 0x6b, 0xa7, 0xdb, 0x75, 0x49, 0x01, 0x7a, 0x36, 0x47, 0xc7, 0x5b, 0x76, 0xed,
+	0x94, 0x0d, 0xa7, 0x00, 0x3d, 0x5b, 0xff, 0x17, 0x54, 0x65, 0xed, 0xc4, 0xaf, 0x9b, 0x13, 0xea,
+	0xb1, 0x73, 0x59, 0x3d, 0x2e, 0x4d, 0x94, 0xf8, 0xb2, 0x11, 0xcc, 0xcc, 0xd4, 0xf8, 0x38, 0x03,
+	0xfa, 0xe1, 0xd4, 0x47, 0x1e, 0xb1, 0xa5, 0xe9, 0x94, 0x21, 0x86, 0xb9, 0x32, 0xda, 0x51, 0x18,
+	0x62, 0x9f, 0x59, 0xc8, 0xf7, 0x23, 0x7e, 0x40, 0x88, 0x49, 0x51, 0x49, 0xab, 0x8c, 0x0a, 0xbf,
+	0x2f, 0xe0, 0x26, 0x4f, 0x1a, 0x8b, 0xbd, 0x6c, 0x38, 0xab, 0xef, 0x06, 0xf6, 0xa8, 0x91, 0x99,
+	0x89, 0xbd, 0xec, 0xba, 0x2e, 0x37, 0xeb, 0xbb, 0xb0, 0x31, 0x1f, 0x3b, 0x37, 0xcf, 0x6a, 0xb3,
+	0x50, 0x21, 0x5e, 0xff, 0x86, 0x9a, 0x12, 0xf1, 0x21, 0xa1, 0x2c, 0x08, 0xa7, 0x8a, 0x8e, 0xe6,
+	0xbb, 0xc5, 0xf4, 0xd4, 0x47, 0x63, 0x3a, 0x0c, 0x98, 0x62, 0xa3, 0x2a, 0xb1, 0xf7, 0x25, 0xd4,
+	0xf8, 0x3a, 0x03, 0xb5, 0xde, 0x81, 0xd0, 0x07, 0x65, 0xd2, 0xef, 0x43, 0x35, 0xa6, 0x42, 0x36,
+	0xd9, 0x0a, 0x24, 0x54, 0x14, 0x52, 0x0a, 0xce, 0x03, 0xd8, 0x90, 0x5b, 0x24, 0x36, 0x72, 0xad,
+	0x81, 0x1b, 0x04, 0xe1, 0x2a, 0xfa, 0x55, 0x9f, 0x81, 0x8f, 0x38, 0x96, 0xf3, 0xa9, 0x6e, 0x1b,
+	0x4b, 0x43, 0xbf, 0x2e, 0x1d, 0xa7, 0x89, 0xfa, 0x9f, 0xc0, 0x86, 0x8a, 0xf5, 0x88, 0xaf, 0x5e,
+	0x64, 0x85, 0x6b, 0x4e, 0x4d, 0x82, 0x4f, 0x88, 0x2f, 0x5f, 0xe5, 0x5d, 0xc7, 0x93, 0x7f, 0xd7,
+	0xf1, 0x18, 0xdf, 0x6b, 0x50, 0x5b, 0x64, 0x9e, 0x0b, 0xaf, 0x38, 0x7b, 0x6b, 0x88, 0xc9, 0xf9,
+	0x90, 0xa9, 0x51, 0x55, 0x16, 0xb6, 0xfb, 0xc2, 0xa4, 0xdf, 0x82, 0x12, 0xcf, 0x49, 0x19, 0xf2,
+	0xc6, 0xaa, 0x44, 0x66, 0x06, 0xae, 0x1a, 0xc3, 0x20, 0x0a, 0xdd, 0xe9, 0x4a, 0x23, 0xab, 0x22,
+	0x31, 0x6a, 0x6a, 0x1d, 0x41, 0xdd, 0x8d, 0xef, 0xab, 0x96, 0x83, 0xc7, 0x6c, 0x98, 0x6e, 0x74,
+	0xd5, 0x12, 0xd4, 0x21, 0x07, 0x19, 0x3f, 0x66, 0xa1, 0x7c, 0x84, 0xf1, 0x3f, 0x28, 0x23, 0x1e,
+	0x2f, 0xf2, 0xbf, 0x43, 0x59, 0x36, 0xe7, 0x04, 0xb9, 0x51, 0x5c, 0x2c, 0xef, 0xc9, 0x09, 0x02,
+	0xf1, 0x84, 0x03, 0xf4, 0xbf, 0x41, 0x49, 0x7c, 0xec, 0x58, 0x03, 0x8c, 0xd3, 0xe9, 0x4a, 0x51,
+	0xc4, 0x1f, 0x61, 0x81, 0x65, 0x09, 0x36, 0x15, 0x27, 0x45, 0x16, 0x63, 0xff, 0x02, 0x45, 0x31,
+	0x95, 0x38, 0x34, 0x15, 0x11, 0x05, 0x1e, 0xce, 0x91, 0x4f, 0x60, 0x6b, 0xc6, 0xe4, 0xdc, 0xd5,
+	0x2c, 0x9f, 0xbe, 0xbc, 0x36, 0x93, 0x04, 0x73, 0xb7, 0xb3, 0x07, 0xb0, 0x89, 0x26, 0x88, 0xb8,
+	0xa8, 0xef, 0x62, 0x2b, 0x09, 0x48, 0x77, 0xcb, 0xd4, 0x13, 0x64, 0xf2, 0x51, 0xc2, 0x1b, 0xd9,
+	0x43, 0xe1, 0x08, 0x33, 0x8b, 0x78, 0x63, 0x64, 0xcb, 0xaf, 0xb3, 0xb4, 0x8d, 0x2c, 0x91, 0xc7,
+	0x02, 0x68, 0x7c, 0xa4, 0x41, 0x4d, 0x88, 0x6b, 0x37, 0x08, 0x46, 0xa2, 0x0c, 0xae, 0xbe, 0xb3,
+	0xb4, 0x21, 0xd7, 0x27, 0x4e, 0x7c, 0xc3, 0xdb, 0xbe, 0x2c, 0x4a, 0xa2, 0x9d, 0x7a, 0x78, 0x82,
+	0x5d, 0x53, 0xc4, 0xf1, 0x78, 0x44, 0x47, 0x7c, 0x84, 0xbd, 0x37, 0x9e, 0xc7, 0x19, 0xff, 0x07,
+	0x98, 0xd9, 0xf4, 0xbf, 0xc6, 0x37, 0x81, 0x15, 0x44, 0x4a, 0x22, 0xf4, 0xbb, 0xc9, 0x6d, 0x20,
+	0x55, 0xd5, 0xa9, 0x60, 0xe3, 0x53, 0x0d, 0x36, 0x4f, 0x04, 0x39, 0x82, 0x88, 0x7d, 0x1f, 0xb9,
+	0x53, 0x4a, 0xe8, 0xd5, 0x84, 0xb4, 0xa0, 0x22, 0xbf, 0xd5, 0xe5, 0x05, 0x48, 0x3c, 0xad, 0x68,
+	0x82, 0xf8, 0x62, 0x97, 0x7f, 0x06, 0xfc, 0x13, 0x2a, 0xf2, 0x4b, 0xd1, 0xe5, 0xef, 0x14, 0x53,
+	0xb1, 0xa4, 0xe7, 0xc9, 0xc9, 0x8a, 0x57, 0x57, 0x7a, 0x5e, 0x1e, 0x27, 0x64, 0x50, 0xe3, 0x25,
+	0x57, 0xf3, 0x85, 0x28, 0x3e, 0x79, 0x65, 0x7b, 0x52, 0xf2, 0x3c, 0x65, 0x77, 0x96, 0x04, 0xe0,
+	0x94, 0x3c, 0xc7, 0x57, 0x95, 0x64, 0xe6, 0x43, 0x4b, 0xf2, 0xaa, 0xd6, 0xc9, 0xfe, 0xc2, 0xd6,
+	0xf9, 0x0f, 0xe8, 0x78, 0x30, 0xc0, 0xe2, 0xd3, 0x9c, 0x77, 0xb4, 0x9c, 0xde, 0x2b, 0xe8, 0xfd,
+	0x46, 0x02, 0x3f, 0xc2, 0x98, 0x0f, 0xef, 0xee, 0x1f, 0x5e, 0xbc, 0x69, 0x6a, 0xaf, 0xde, 0x34,
+	0xb5, 0x1f, 0xde, 0x34, 0xb5, 0x4f, 0xde, 0x36, 0xd7, 0x5e, 0xbd, 0x6d, 0xae, 0x7d, 0xfb, 0xb6,
+	0xb9, 0xf6, 0xbf, 0xcd, 0xf8, 0x7f, 0xa2, 0x0b, 0xf1, 0x4f, 0x91, 0xf8, 0x9b, 0xa8, 0xbf, 0x2e,
+	0xfe, 0xe1, 0xf9, 0xe3, 0xcf, 0x03, 0x00, 0x2e, 0x62, 0x57, 0x83, 0x45, 0x12, 0x00, 0x00,
 }
 
 func (m *Order) Marshal() (dAtA []byte, err error) {
@@ -1891,6 +1959,69 @@ func (m *DynamicRewardState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LCPriceHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LCPriceHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LCPriceHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastUpdateTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastUpdateTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.WindowMinPrice.Size()
+		i -= size
+		if _, err := m.WindowMinPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.WindowStartTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.WindowStartTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.HistoricalFloor.Size()
+		i -= size
+		if _, err := m.HistoricalFloor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.CurrentPrice.Size()
+		i -= size
+		if _, err := m.CurrentPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *VolumeSnapshot) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2526,6 +2657,27 @@ func (m *DynamicRewardState) Size() (n int) {
 	return n
 }
 
+func (m *LCPriceHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrentPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.HistoricalFloor.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.WindowStartTime != 0 {
+		n += 1 + sovTypes(uint64(m.WindowStartTime))
+	}
+	l = m.WindowMinPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.LastUpdateTime != 0 {
+		n += 1 + sovTypes(uint64(m.LastUpdateTime))
+	}
+	return n
+}
+
 func (m *VolumeSnapshot) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4681,6 +4833,196 @@ func (m *DynamicRewardState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LCPriceHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LCPriceHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LCPriceHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalFloor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HistoricalFloor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStartTime", wireType)
+			}
+			m.WindowStartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowMinPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WindowMinPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+			}
+			m.LastUpdateTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdateTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *VolumeSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
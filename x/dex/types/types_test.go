@@ -0,0 +1,49 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"mychain/x/dex/types"
+)
+
+func TestOrderLock(t *testing.T) {
+	price := sdk.NewInt64Coin("utusd", 100)
+
+	tests := []struct {
+		desc   string
+		isBuy  bool
+		amount int64
+		locked sdk.Coin
+	}{
+		{"buy of whole units", true, 2_000_000, sdk.NewInt64Coin("utusd", 200)},
+		// the fraction of a whole unit is paid for as well
+		{"buy of a fraction", true, 1_500_000, sdk.NewInt64Coin("utusd", 150)},
+		{"buy below a whole unit", true, 500_000, sdk.NewInt64Coin("utusd", 50)},
+		{"buy worth less than a micro unit", true, 9_999, sdk.NewInt64Coin("utusd", 0)},
+		{"sell", false, 1_500_000, sdk.NewInt64Coin("umc", 1_500_000)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			locked := types.OrderLock(tc.isBuy, price, sdk.NewInt64Coin("umc", tc.amount))
+			require.Equal(t, tc.locked.Denom, locked.Denom)
+			require.Equal(t, tc.locked.Amount.Int64(), locked.Amount.Int64())
+		})
+	}
+}
+
+func TestOrderLockedFunds(t *testing.T) {
+	order := types.Order{
+		IsBuy:        true,
+		Price:        sdk.NewInt64Coin("utusd", 100),
+		Amount:       sdk.NewInt64Coin("umc", 3_000_000),
+		FilledAmount: sdk.NewInt64Coin("umc", 1_500_000),
+	}
+	require.Equal(t, int64(1_500_000), order.Remaining().Int64())
+	require.Equal(t, sdk.NewInt64Coin("utusd", 150), order.LockedFunds())
+
+	order.IsBuy = false
+	require.Equal(t, sdk.NewInt64Coin("umc", 1_500_000), order.LockedFunds())
+}
@@ -26,6 +26,8 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		CmdQueryParams(),
 		CmdQueryCurrentPrice(),
 		CmdQuerySegmentInfo(),
+		CmdQueryDevVesting(),
+		CmdQueryPurchaseAllowance(),
 	)
 
 	return queryCmd
@@ -104,4 +106,64 @@ func CmdQuerySegmentInfo() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
-}
\ No newline at end of file
+}
+
+// CmdQueryDevVesting implements a command to return the dev allocation vesting tranches.
+func CmdQueryDevVesting() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dev-vesting",
+		Short: "Query vested, unvested and claimed dev allocation per segment",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DevVesting(context.Background(), &types.QueryDevVestingRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "dev-vesting")
+
+	return cmd
+}
+
+// CmdQueryPurchaseAllowance implements a command to return an address's remaining purchase allowance.
+func CmdQueryPurchaseAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "purchase-allowance [address]",
+		Short: "Query how much an address can still buy under the purchase limits",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PurchaseAllowance(context.Background(), &types.QueryPurchaseAllowanceRequest{
+				Address: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
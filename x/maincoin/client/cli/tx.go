@@ -25,6 +25,7 @@ func GetTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		CmdBuyMaincoin(),
 		CmdSellMaincoin(),
+		CmdClaimDevAllocation(),
 	)
 
 	return txCmd
@@ -96,4 +97,30 @@ func CmdSellMaincoin() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
-}
\ No newline at end of file
+}
+
+// CmdClaimDevAllocation returns a command for claiming vested dev allocation
+func CmdClaimDevAllocation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-dev-allocation",
+		Short: "Claim all vested dev allocation (must be sent from the dev address)",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimDevAllocation(clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
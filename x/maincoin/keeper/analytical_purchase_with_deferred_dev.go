@@ -52,19 +52,19 @@ func (k Keeper) CalculateAnalyticalPurchaseWithDeferredDev(
 		// CRITICAL: First, handle pending dev allocation from previous segment
 		// Dev is distributed at START of segment by ADDING to total balance
 		// This happens at the start of EVERY segment, not just the first one
+		devDistributedInSegment := sdkmath.ZeroInt()
 		if segmentsProcessed == 0 && pendingDevAllocation.GT(sdkmath.ZeroInt()) {
 			// Initial pending dev allocation from previous transaction
-			currentSupplyDec = currentSupplyDec.Add(sdkmath.LegacyNewDecFromInt(pendingDevAllocation))
-			totalTokensBought = totalTokensBought.Add(pendingDevAllocation)
-			totalDevAllocation = totalDevAllocation.Add(pendingDevAllocation)
+			devDistributedInSegment = pendingDevAllocation
 		} else if segmentsProcessed > 0 && accumulatedPendingDev.GT(sdkmath.ZeroInt()) {
 			// Dev allocation from segments completed in THIS transaction
-			currentSupplyDec = currentSupplyDec.Add(sdkmath.LegacyNewDecFromInt(accumulatedPendingDev))
-			totalTokensBought = totalTokensBought.Add(accumulatedPendingDev)
-			totalDevAllocation = totalDevAllocation.Add(accumulatedPendingDev)
+			devDistributedInSegment = accumulatedPendingDev
 			// Reset accumulated pending dev after distribution
 			accumulatedPendingDev = sdkmath.ZeroInt()
 		}
+		currentSupplyDec = currentSupplyDec.Add(sdkmath.LegacyNewDecFromInt(devDistributedInSegment))
+		totalTokensBought = totalTokensBought.Add(devDistributedInSegment)
+		totalDevAllocation = totalDevAllocation.Add(devDistributedInSegment)
 
 		// Calculate current total value and required reserves
 		totalValue := currentSupplyDec.Mul(currentPriceCalc)
@@ -292,18 +292,6 @@ func (k Keeper) CalculateAnalyticalPurchaseWithDeferredDev(
 
 		// Store segment detail
 		// Dev allocation shown here is what was DISTRIBUTED at the START of this segment
-		var devDistributedInSegment sdkmath.Int
-		if segmentsProcessed == 0 && pendingDevAllocation.GT(sdkmath.ZeroInt()) {
-			// First segment gets the pending dev from previous transaction
-			devDistributedInSegment = pendingDevAllocation
-		} else if segmentsProcessed > 0 && accumulatedPendingDev.GT(sdkmath.ZeroInt()) {
-			// Subsequent segments get dev from previous segments in this transaction
-			// Note: This was already added to supply and tokens bought above
-			devDistributedInSegment = accumulatedPendingDev
-		} else {
-			devDistributedInSegment = sdkmath.ZeroInt()
-		}
-
 		segmentDetail := SegmentPurchaseDetail{
 			SegmentNumber:          currentEpochCalc,
 			TokensBought:           tokensToBuy,
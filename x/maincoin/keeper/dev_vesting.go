@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"mychain/x/maincoin/types"
+	mychaintypes "mychain/x/mychain/types"
+)
+
+// AllocateDevVesting mints dev allocation into the module account, splits it between
+// the dev recipients and locks their shares in the vesting tranche of the given segment.
+// Allocations distributed more than once at the start of the same segment are added to
+// its existing tranche and share its schedule. It returns the per-recipient split.
+func (k Keeper) AllocateDevVesting(ctx context.Context, segmentNumber uint64, amount math.Int) ([]types.DevRecipientAmount, error) {
+	if !amount.IsPositive() {
+		return nil, nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get params: %w", err)
+	}
+
+	devCoins := sdk.NewCoins(sdk.NewCoin(types.MainCoinDenom, amount))
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, devCoins); err != nil {
+		return nil, fmt.Errorf("failed to mint dev tokens: %w", err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	tranche, err := k.DevVestingTranches.Get(ctx, segmentNumber)
+	if errors.Is(err, collections.ErrNotFound) {
+		tranche = types.NewDevVestingTranche(segmentNumber, math.ZeroInt(), sdkCtx.BlockTime(), params.DevVestingCliff, params.DevVestingDuration)
+	} else if err != nil {
+		return nil, err
+	}
+
+	split := types.SplitDevAllocation(params.DevRecipients, amount)
+	tranche.AddShares(split)
+
+	if err := k.DevVestingTranches.Set(ctx, segmentNumber, tranche); err != nil {
+		return nil, fmt.Errorf("failed to store dev vesting tranche: %w", err)
+	}
+
+	for _, share := range split {
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDevVestingTranche,
+				sdk.NewAttribute(types.AttributeKeySegment, fmt.Sprintf("%d", segmentNumber)),
+				sdk.NewAttribute(types.AttributeKeyRecipient, share.Address),
+				sdk.NewAttribute(types.AttributeKeyAmount, share.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyVestingCliffTime, tranche.CliffTime.String()),
+				sdk.NewAttribute(types.AttributeKeyVestingEndTime, tranche.EndTime.String()),
+			),
+		)
+
+		if tk := k.GetTransactionKeeper(); tk != nil && share.Amount.IsPositive() {
+			if err := tk.RecordTransaction(ctx, mychaintypes.TransactionRecord{
+				Address:     share.Address,
+				Type:        "dev_allocation",
+				Description: fmt.Sprintf("Dev allocation of %s MainCoin from segment %d (vesting)", FormatMicroToDisplay(share.Amount), segmentNumber),
+				Amount:      sdk.NewCoins(sdk.NewCoin(types.MainCoinDenom, share.Amount)),
+				From:        types.ModuleName,
+				To:          share.Address,
+				Details: &mychaintypes.TransactionRecord_DevVesting{DevVesting: &mychaintypes.DevVestingDetails{
+					Segment:    segmentNumber,
+					VestingEnd: tranche.EndTime,
+				}},
+			}); err != nil {
+				sdkCtx.Logger().Error("failed to record dev allocation transaction", "error", err)
+			}
+		}
+	}
+
+	return split, nil
+}
+
+// allocatePurchaseDevVesting splits the dev allocation distributed by a purchase into the
+// tranches of the segments at whose start it was distributed, and records each segment's
+// per-recipient split in its details. Allocation without a segment detail was distributed
+// at the start of the segment the purchase stopped in, and goes to that segment's tranche.
+func (k Keeper) allocatePurchaseDevVesting(ctx context.Context, result *PurchaseResult) error {
+	attributed := math.ZeroInt()
+	for i, detail := range result.SegmentDetails {
+		if !detail.DevAllocation.IsPositive() {
+			continue
+		}
+		split, err := k.AllocateDevVesting(ctx, detail.SegmentNumber, detail.DevAllocation)
+		if err != nil {
+			return err
+		}
+		result.SegmentDetails[i].DevDistributions = split
+		attributed = attributed.Add(detail.DevAllocation)
+	}
+
+	_, err := k.AllocateDevVesting(ctx, result.FinalEpoch, result.TotalDevAllocation.Sub(attributed))
+	return err
+}
+
+// ClaimVestedDevAllocation releases the recipient's claimable share of every tranche
+// and returns the total released.
+func (k Keeper) ClaimVestedDevAllocation(ctx context.Context, recipient sdk.AccAddress) (math.Int, error) {
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	address := recipient.String()
+
+	var updated []types.DevVestingTranche
+	total := math.ZeroInt()
+	err := k.DevVestingTranches.Walk(ctx, nil, func(_ uint64, tranche types.DevVestingTranche) (bool, error) {
+		claimed := tranche.Claim(address, now)
+		if claimed.IsPositive() {
+			updated = append(updated, tranche)
+			total = total.Add(claimed)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	if total.IsZero() {
+		return total, types.ErrNothingToClaim
+	}
+
+	for _, tranche := range updated {
+		if err := k.DevVestingTranches.Set(ctx, tranche.SegmentNumber, tranche); err != nil {
+			return math.ZeroInt(), err
+		}
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(types.MainCoinDenom, total))
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins); err != nil {
+		return math.ZeroInt(), fmt.Errorf("failed to release dev tokens: %w", err)
+	}
+
+	return total, nil
+}
@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"mychain/x/maincoin/keeper"
+	"mychain/x/maincoin/types"
+)
+
+func TestPurchaseVestsDevAllocationInItsSegment(t *testing.T) {
+	ctx, k, bank := initBankFixture(t, types.DefaultGenesis())
+	ms := keeper.NewMsgServerImpl(&k)
+	alice := sdk.AccAddress([]byte("alice_______________"))
+
+	// enough to complete segments 0, 1 and 2
+	bank.balances[alice.String()] = sdk.NewCoins(sdk.NewInt64Coin(types.TestUSDDenom, 1_002_312))
+	_, err := ms.BuyMaincoin(ctx, &types.MsgBuyMaincoin{Buyer: alice.String(), Amount: sdk.NewInt64Coin(types.TestUSDDenom, 1_002_312)})
+	require.NoError(t, err)
+
+	// nothing was distributed at the start of segment 0; each later segment
+	// vests the allocation of the segment before it
+	has, err := k.DevVestingTranches.Has(ctx, 0)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	tranche, err := k.DevVestingTranches.Get(ctx, 1)
+	require.NoError(t, err)
+	require.True(t, tranche.Amount.IsPositive())
+
+	vested := math.ZeroInt()
+	require.NoError(t, k.DevVestingTranches.Walk(ctx, nil, func(segment uint64, tranche types.DevVestingTranche) (bool, error) {
+		require.Equal(t, segment, tranche.SegmentNumber)
+		vested = vested.Add(tranche.Amount)
+		return false, nil
+	}))
+	total, err := k.DevAllocationTotal.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, total.String(), vested.String())
+}
@@ -3,89 +3,121 @@ package keeper
 import (
 	"context"
 	"fmt"
-	"os"
 
+	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
 	"mychain/x/maincoin/types"
 )
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
-	// Write to stderr so we can see it in logs
-	fmt.Fprintf(os.Stderr, "MAINCOIN DEBUG: InitGenesis called\n")
-
 	if err := genState.Validate(); err != nil {
-		fmt.Fprintf(os.Stderr, "MAINCOIN DEBUG: Validation failed: %v\n", err)
 		return err
 	}
 
 	if err := k.Params.Set(ctx, genState.Params); err != nil {
-		fmt.Fprintf(os.Stderr, "MAINCOIN DEBUG: Failed to set Params: %v\n", err)
 		return err
 	}
-	fmt.Fprintf(os.Stderr, "MAINCOIN DEBUG: Params set successfully\n")
 
 	if err := k.CurrentEpoch.Set(ctx, genState.CurrentEpoch); err != nil {
-		fmt.Fprintf(os.Stderr, "MAINCOIN DEBUG: Failed to set CurrentEpoch: %v\n", err)
 		return err
 	}
-	fmt.Fprintf(os.Stderr, "MAINCOIN DEBUG: CurrentEpoch set to %d\n", genState.CurrentEpoch)
 
 	if err := k.CurrentPrice.Set(ctx, genState.CurrentPrice); err != nil {
-		fmt.Fprintf(os.Stderr, "MAINCOIN DEBUG: Failed to set CurrentPrice: %v\n", err)
 		return err
 	}
-	fmt.Fprintf(os.Stderr, "MAINCOIN DEBUG: CurrentPrice set to %s\n", genState.CurrentPrice.String())
 
-	// Check if bank module already has MainCoin supply
+	// The bank module is initialized first; any MainCoin it holds must be
+	// accounted for by the module's total supply.
 	bankSupply := k.bankKeeper.GetSupply(ctx, types.MainCoinDenom)
-	fmt.Fprintf(os.Stderr, "MAINCOIN DEBUG: Bank module has existing supply: %s\n", bankSupply.String())
-
-	// Validate that bank supply matches our genesis state
 	if !bankSupply.IsZero() && !bankSupply.Amount.Equal(genState.TotalSupply) {
-		// If there's a mismatch, this is a serious error
-		return fmt.Errorf("MAINCOIN ERROR: Bank supply (%s) doesn't match genesis total supply (%s). "+
-			"The MainCoin module must be the sole authority on MainCoin supply. "+
-			"Please fix the genesis file to remove pre-minted MainCoin from bank module.",
+		return fmt.Errorf("bank supply (%s) doesn't match genesis total supply (%s); "+
+			"the MainCoin module must be the sole authority on MainCoin supply",
 			bankSupply.Amount.String(), genState.TotalSupply.String())
 	}
 
-	// If both are zero, we're starting fresh (correct)
-	if bankSupply.IsZero() && genState.TotalSupply.IsZero() {
-		fmt.Fprintf(os.Stderr, "MAINCOIN DEBUG: Starting with zero supply (correct initialization)\n")
-	} else if !bankSupply.IsZero() && bankSupply.Amount.Equal(genState.TotalSupply) {
-		fmt.Fprintf(os.Stderr, "MAINCOIN DEBUG: Bank supply matches genesis total supply, state is synchronized\n")
-	}
-
 	if err := k.TotalSupply.Set(ctx, genState.TotalSupply); err != nil {
-		fmt.Fprintf(os.Stderr, "MAINCOIN DEBUG: Failed to set TotalSupply: %v\n", err)
 		return err
 	}
-	fmt.Fprintf(os.Stderr, "MAINCOIN DEBUG: TotalSupply set to %s\n", genState.TotalSupply.String())
 
 	if err := k.ReserveBalance.Set(ctx, genState.ReserveBalance); err != nil {
-		fmt.Fprintf(os.Stderr, "MAINCOIN DEBUG: Failed to set ReserveBalance: %v\n", err)
 		return err
 	}
-	fmt.Fprintf(os.Stderr, "MAINCOIN DEBUG: ReserveBalance set to %s\n", genState.ReserveBalance.String())
+
+	for _, held := range genState.ReserveHoldings {
+		if err := k.ReserveHoldings.Set(ctx, held.Denom, held.Amount); err != nil {
+			return err
+		}
+	}
 
 	if err := k.DevAllocationTotal.Set(ctx, genState.DevAllocationTotal); err != nil {
-		fmt.Fprintf(os.Stderr, "MAINCOIN DEBUG: Failed to set DevAllocationTotal: %v\n", err)
 		return err
 	}
-	fmt.Fprintf(os.Stderr, "MAINCOIN DEBUG: DevAllocationTotal set to %s\n", genState.DevAllocationTotal.String())
 
-	// Initialize PendingDevAllocation based on genesis state
-	// For Segment 0 start: No pending dev allocation
-	// When starting at epoch 0 with 0 supply, there's no pending dev
-	pendingDev := sdkmath.ZeroInt()
+	pendingDev := genState.PendingDevAllocation
+	if pendingDev.IsNil() {
+		pendingDev = sdkmath.ZeroInt()
+	}
 	if err := k.PendingDevAllocation.Set(ctx, pendingDev); err != nil {
-		fmt.Fprintf(os.Stderr, "MAINCOIN DEBUG: Failed to set PendingDevAllocation: %v\n", err)
 		return err
 	}
-	fmt.Fprintf(os.Stderr, "MAINCOIN DEBUG: PendingDevAllocation set to %s\n", pendingDev.String())
 
-	fmt.Fprintf(os.Stderr, "MAINCOIN DEBUG: InitGenesis completed successfully\n")
+	for _, tranche := range genState.DevVestingTranches {
+		if err := k.DevVestingTranches.Set(ctx, tranche.SegmentNumber, tranche); err != nil {
+			return err
+		}
+	}
+
+	for _, rate := range genState.PurchaseRates {
+		if err := k.PurchaseRates.Set(ctx, rate.Denom, rate); err != nil {
+			return err
+		}
+	}
+
+	segments := make(map[uint64]bool, len(genState.SegmentHistories))
+	for _, history := range genState.SegmentHistories {
+		if err := k.SegmentHistories.Set(ctx, history.SegmentNumber, history); err != nil {
+			return err
+		}
+		segments[history.SegmentNumber] = true
+	}
+	for _, history := range genState.UserHistories {
+		if err := k.UserHistories.Set(ctx, history.Address, history); err != nil {
+			return err
+		}
+	}
+
+	// Records keep their ids. Only records whose segment history is still
+	// there are indexed by segment, as when they were stored
+	for _, record := range genState.PurchaseRecords {
+		if err := k.PurchaseRecords.Set(ctx, record.Id, record); err != nil {
+			return err
+		}
+		if segments[record.SegmentNumber] {
+			if err := k.SegmentPurchases.Set(ctx, collections.Join(record.SegmentNumber, record.Id), record.Id); err != nil {
+				return err
+			}
+		}
+		if err := k.BuyerPurchases.Set(ctx, collections.Join(record.Buyer, record.Id), record.Id); err != nil {
+			return err
+		}
+	}
+	if err := k.PurchaseRecordSeq.Set(ctx, genState.PurchaseRecordSeq); err != nil {
+		return err
+	}
+
+	for _, spend := range genState.SegmentSpends {
+		if err := k.SegmentSpend.Set(ctx, collections.Join(spend.SegmentNumber, spend.Buyer), spend.Amount); err != nil {
+			return err
+		}
+	}
+
+	for _, entry := range genState.CompletedSegments {
+		if err := k.setCompletedSegment(ctx, entry); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -116,19 +148,95 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	reserveHoldings, err := k.GetReserveHoldings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	devAllocationTotal, err := k.DevAllocationTotal.Get(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	// Note: PendingDevAllocation is not exported as it's calculated from genesis supply
+	pendingDevAllocation, err := k.PendingDevAllocation.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var devVestingTranches []types.DevVestingTranche
+	if err := k.DevVestingTranches.Walk(ctx, nil, func(_ uint64, tranche types.DevVestingTranche) (bool, error) {
+		devVestingTranches = append(devVestingTranches, tranche)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var purchaseRates []types.PurchaseRate
+	if err := k.PurchaseRates.Walk(ctx, nil, func(_ string, rate types.PurchaseRate) (bool, error) {
+		purchaseRates = append(purchaseRates, rate)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var purchaseRecords []types.SegmentPurchaseRecord
+	if err := k.PurchaseRecords.Walk(ctx, nil, func(_ uint64, record types.SegmentPurchaseRecord) (bool, error) {
+		purchaseRecords = append(purchaseRecords, record)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	purchaseRecordSeq, err := k.PurchaseRecordSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var segmentHistories []types.SegmentHistory
+	if err := k.SegmentHistories.Walk(ctx, nil, func(_ uint64, history types.SegmentHistory) (bool, error) {
+		segmentHistories = append(segmentHistories, history)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var userHistories []types.UserPurchaseHistory
+	if err := k.UserHistories.Walk(ctx, nil, func(_ string, history types.UserPurchaseHistory) (bool, error) {
+		userHistories = append(userHistories, history)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var segmentSpends []types.SegmentSpend
+	if err := k.SegmentSpend.Walk(ctx, nil, func(key collections.Pair[uint64, string], amount sdkmath.Int) (bool, error) {
+		segmentSpends = append(segmentSpends, types.SegmentSpend{SegmentNumber: key.K1(), Buyer: key.K2(), Amount: amount})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	completedSegments, err := k.getCompletedSegments(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	return &types.GenesisState{
-		Params:             params,
-		CurrentEpoch:       currentEpoch,
-		CurrentPrice:       currentPrice,
-		TotalSupply:        totalSupply,
-		ReserveBalance:     reserveBalance,
-		DevAllocationTotal: devAllocationTotal,
+		Params:               params,
+		CurrentEpoch:         currentEpoch,
+		CurrentPrice:         currentPrice,
+		TotalSupply:          totalSupply,
+		ReserveBalance:       reserveBalance,
+		DevAllocationTotal:   devAllocationTotal,
+		PendingDevAllocation: pendingDevAllocation,
+		DevVestingTranches:   devVestingTranches,
+		PurchaseRates:        purchaseRates,
+		ReserveHoldings:      reserveHoldings,
+		PurchaseRecords:      purchaseRecords,
+		PurchaseRecordSeq:    purchaseRecordSeq,
+		SegmentHistories:     segmentHistories,
+		UserHistories:        userHistories,
+		SegmentSpends:        segmentSpends,
+		CompletedSegments:    completedSegments,
 	}, nil
 }
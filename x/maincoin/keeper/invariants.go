@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"mychain/x/maincoin/types"
+)
+
+// RegisterInvariants registers the maincoin module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "supply", SupplyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "dev-vesting", DevVestingInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "reserve-holdings", ReserveHoldingsInvariant(k))
+}
+
+// SupplyInvariant checks that the tracked MainCoin supply matches the bank
+// supply.
+func SupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		trackedSupply, err := k.TotalSupply.Get(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "supply", err.Error()), true
+		}
+		bankSupply := k.bankKeeper.GetSupply(ctx, types.MainCoinDenom).Amount
+
+		broken := !trackedSupply.Equal(bankSupply)
+
+		return sdk.FormatInvariant(types.ModuleName, "supply", fmt.Sprintf(
+			"\ttracked supply: %s\n\tbank supply: %s\n", trackedSupply, bankSupply,
+		)), broken
+	}
+}
+
+// DevVestingInvariant checks that every tranche has claimed at most its
+// amount and that the module account holds the MainCoin still locked in the
+// tranches.
+func DevVestingInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		locked := math.ZeroInt()
+		err := k.DevVestingTranches.Walk(ctx, nil, func(segment uint64, tranche types.DevVestingTranche) (bool, error) {
+			if tranche.Claimed.GT(tranche.Amount) {
+				msg += fmt.Sprintf("\ttranche %d claimed %s of %s\n", segment, tranche.Claimed, tranche.Amount)
+			}
+			locked = locked.Add(tranche.Amount.Sub(tranche.Claimed))
+			return false, nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "dev-vesting", err.Error()), true
+		}
+
+		moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+		held := k.bankKeeper.GetBalance(ctx, moduleAddr, types.MainCoinDenom).Amount
+		if held.LT(locked) {
+			msg += fmt.Sprintf("\tlocked in tranches: %s\n\theld by module: %s\n", locked, held)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "dev-vesting", msg), msg != ""
+	}
+}
+
+// ReserveHoldingsInvariant checks that the module account holds the coins
+// recorded as backing the reserve.
+func ReserveHoldingsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		holdings, err := k.GetReserveHoldings(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "reserve-holdings", err.Error()), true
+		}
+
+		var msg string
+		moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+		for _, recorded := range holdings {
+			held := k.bankKeeper.GetBalance(ctx, moduleAddr, recorded.Denom)
+			if held.Amount.LT(recorded.Amount) {
+				msg += fmt.Sprintf("\trecorded holding: %s\n\theld by module: %s\n", recorded, held)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "reserve-holdings", msg), msg != ""
+	}
+}
@@ -28,17 +28,37 @@ type Keeper struct {
 	CurrentEpoch         collections.Item[uint64]
 	CurrentPrice         collections.Item[math.LegacyDec]
 	TotalSupply          collections.Item[math.Int]
-	ReserveBalance       collections.Item[math.Int]
+	ReserveBalance       collections.Item[math.Int]        // TestUSD value of the reserve
+	ReserveHoldings      collections.Map[string, math.Int] // denom -> coins backing the reserve
 	DevAllocationTotal   collections.Item[math.Int]
 	PendingDevAllocation collections.Item[math.Int]
 
-	// Segment history tracking
+	// Segment history tracking: running totals per segment and per buyer
 	SegmentHistories collections.Map[uint64, types.SegmentHistory]
 	UserHistories    collections.Map[string, types.UserPurchaseHistory]
 
+	// Purchase records, each stored under its own id
+	PurchaseRecordSeq collections.Sequence
+	PurchaseRecords   collections.Map[uint64, types.SegmentPurchaseRecord]
+
+	// Indexes
+	SegmentPurchases collections.Map[collections.Pair[uint64, uint64], uint64] // (segment, recordID) -> recordID
+	BuyerPurchases   collections.Map[collections.Pair[string, uint64], uint64] // (buyer, recordID) -> recordID
+
+	// Dev allocation vesting tranches keyed by segment number
+	DevVestingTranches collections.Map[uint64, types.DevVestingTranche]
+
+	// Purchase limit counters
+	SegmentSpend  collections.Map[collections.Pair[uint64, string], math.Int] // (segment, buyer) -> TestUSD value spent
+	BlockSegments collections.Map[int64, uint64]                              // block height -> segments advanced
+
+	// Authority-set purchase denom rates keyed by denom
+	PurchaseRates collections.Map[string, types.PurchaseRate]
+
 	// Expected keepers
 	bankKeeper        types.BankKeeper
 	transactionKeeper types.TransactionKeeper
+	priceAdapter      types.PriceAdapter
 }
 
 func NewKeeper(
@@ -68,10 +88,19 @@ func NewKeeper(
 		CurrentPrice:         collections.NewItem(sb, types.CurrentPriceKey, "current_price", sdk.LegacyDecValue),
 		TotalSupply:          collections.NewItem(sb, types.TotalSupplyKey, "total_supply", sdk.IntValue),
 		ReserveBalance:       collections.NewItem(sb, types.ReserveBalanceKey, "reserve_balance", sdk.IntValue),
+		ReserveHoldings:      collections.NewMap(sb, types.ReserveHoldingsKey, "reserve_holdings", collections.StringKey, sdk.IntValue),
 		DevAllocationTotal:   collections.NewItem(sb, types.DevAllocationTotalKey, "dev_allocation_total", sdk.IntValue),
 		PendingDevAllocation: collections.NewItem(sb, collections.NewPrefix(10), "pending_dev_allocation", sdk.IntValue),
 		SegmentHistories:     collections.NewMap(sb, collections.NewPrefix(8), "segment_histories", collections.Uint64Key, codec.CollValue[types.SegmentHistory](cdc)),
 		UserHistories:        collections.NewMap(sb, collections.NewPrefix(9), "user_histories", collections.StringKey, codec.CollValue[types.UserPurchaseHistory](cdc)),
+		PurchaseRecordSeq:    collections.NewSequence(sb, types.PurchaseRecordSeqKey, "purchase_record_seq"),
+		PurchaseRecords:      collections.NewMap(sb, types.PurchaseRecordsKey, "purchase_records", collections.Uint64Key, codec.CollValue[types.SegmentPurchaseRecord](cdc)),
+		SegmentPurchases:     collections.NewMap(sb, types.SegmentPurchasesKey, "segment_purchases", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), collections.Uint64Value),
+		BuyerPurchases:       collections.NewMap(sb, types.BuyerPurchasesKey, "buyer_purchases", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), collections.Uint64Value),
+		DevVestingTranches:   collections.NewMap(sb, types.DevVestingTranchesKey, "dev_vesting_tranches", collections.Uint64Key, codec.CollValue[types.DevVestingTranche](cdc)),
+		SegmentSpend:         collections.NewMap(sb, types.SegmentSpendKey, "segment_spend", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), sdk.IntValue),
+		BlockSegments:        collections.NewMap(sb, types.BlockSegmentsKey, "block_segments", collections.Int64Key, collections.Uint64Value),
+		PurchaseRates:        collections.NewMap(sb, types.PurchaseRatesKey, "purchase_rates", collections.StringKey, codec.CollValue[types.PurchaseRate](cdc)),
 	}
 
 	schema, err := sb.Build()
@@ -102,3 +131,13 @@ func (k *Keeper) SetTransactionKeeper(tk types.TransactionKeeper) {
 func (k Keeper) GetTransactionKeeper() types.TransactionKeeper {
 	return k.transactionKeeper
 }
+
+// SetPriceAdapter sets the adapter used to price PRICE_SOURCE_DEX_TWAP purchase denoms
+func (k *Keeper) SetPriceAdapter(pa types.PriceAdapter) {
+	k.priceAdapter = pa
+}
+
+// GetPriceAdapter returns the price adapter
+func (k Keeper) GetPriceAdapter() types.PriceAdapter {
+	return k.priceAdapter
+}
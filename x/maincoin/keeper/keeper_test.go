@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/core/address"
+	storetypes "cosmossdk.io/store/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"mychain/x/maincoin/keeper"
+	module "mychain/x/maincoin/module"
+	"mychain/x/maincoin/types"
+)
+
+type fixture struct {
+	ctx          sdk.Context
+	keeper       *keeper.Keeper
+	addressCodec address.Codec
+}
+
+func initFixture(t *testing.T) *fixture {
+	t.Helper()
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModule{})
+	addressCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+
+	storeService := runtime.NewKVStoreService(storeKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	authority := authtypes.NewModuleAddress(types.GovModuleName)
+
+	k := keeper.NewKeeper(
+		storeService,
+		encCfg.Codec,
+		addressCodec,
+		authority,
+		nil,
+		nil,
+	)
+
+	// Initialize params
+	if err := k.Params.Set(ctx, types.DefaultParams()); err != nil {
+		t.Fatalf("failed to set params: %v", err)
+	}
+
+	return &fixture{
+		ctx:          ctx,
+		keeper:       &k,
+		addressCodec: addressCodec,
+	}
+}
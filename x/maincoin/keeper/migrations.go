@@ -0,0 +1,206 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"mychain/x/maincoin/types"
+)
+
+// Migrator is a struct for handling in-place store migrations. A migration is frozen
+// once released; later changes to the stored state get a new consensus version.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 sets the dev vesting schedule to the default cliff and duration. Chains
+// from before vesting was added store neither, which would vest every tranche at once.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.DevVestingCliff != 0 || params.DevVestingDuration != 0 {
+		return nil
+	}
+	params.DevVestingCliff = types.DefaultDevVestingCliff
+	params.DevVestingDuration = types.DefaultDevVestingDuration
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate2to3 replaces the single params dev address with a dev recipients list that
+// gives that address the full weight, and assigns existing vesting tranches to it.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(params.DevRecipients) == 0 {
+		if params.DevAddress != "" {
+			params.DevRecipients = []types.DevRecipient{
+				{
+					Address: params.DevAddress,
+					Weight:  math.LegacyOneDec(),
+					Label:   "dev",
+				},
+			}
+		} else {
+			params.DevRecipients = types.DefaultDevRecipients()
+		}
+	}
+	params.DevAddress = ""
+
+	// Later versions add more params, so only the fields set here are validated
+	if err := types.ValidateDevRecipients(params.DevRecipients); err != nil {
+		return err
+	}
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return err
+	}
+
+	owner := params.DevRecipients[0].Address
+	var tranches []types.DevVestingTranche
+	if err := m.keeper.DevVestingTranches.Walk(ctx, nil, func(_ uint64, tranche types.DevVestingTranche) (bool, error) {
+		if len(tranche.Shares) == 0 && tranche.Amount.IsPositive() {
+			tranche.Shares = []types.DevVestingShare{
+				{
+					Address: owner,
+					Amount:  tranche.Amount,
+					Claimed: tranche.Claimed,
+				},
+			}
+			tranches = append(tranches, tranche)
+		}
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, tranche := range tranches {
+		if err := m.keeper.DevVestingTranches.Set(ctx, tranche.SegmentNumber, tranche); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Migrate3to4 splits the purchase arrays stored inside segment and user histories into
+// individual purchase records indexed by segment and by buyer, keeping only the
+// running totals in the histories.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	k := m.keeper
+
+	var segments []types.SegmentHistory
+	if err := k.SegmentHistories.Walk(ctx, nil, func(_ uint64, history types.SegmentHistory) (bool, error) {
+		segments = append(segments, history)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	var users []types.UserPurchaseHistory
+	if err := k.UserHistories.Walk(ctx, nil, func(_ string, history types.UserPurchaseHistory) (bool, error) {
+		users = append(users, history)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	// Segment blobs hold every record that still has a segment; they are indexed by
+	// both segment and buyer
+	migrated := make(map[uint64]bool, len(segments))
+	for _, history := range segments {
+		for _, record := range history.Purchases {
+			if err := k.storePurchaseRecord(ctx, record, true); err != nil {
+				return err
+			}
+		}
+		migrated[history.SegmentNumber] = true
+
+		history.PurchaseCount = uint64(len(history.Purchases))
+		history.Purchases = nil
+		if err := k.SegmentHistories.Set(ctx, history.SegmentNumber, history); err != nil {
+			return err
+		}
+	}
+
+	// User blobs duplicate those records; only records whose segment history was
+	// already cleaned up are kept, indexed by buyer alone
+	for _, history := range users {
+		for _, record := range history.Purchases {
+			if migrated[record.SegmentNumber] {
+				continue
+			}
+			record.Buyer = history.Address
+			if err := k.storePurchaseRecord(ctx, record, false); err != nil {
+				return err
+			}
+		}
+
+		history.PurchaseCount = uint64(len(history.Purchases))
+		history.Purchases = nil
+		if err := k.UserHistories.Set(ctx, history.Address, history); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Migrate4to5 initializes the purchase limits added to the params with every limit disabled.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.PurchaseLimits.MaxSpendPerAddressPerSegment.IsNil() {
+		params.PurchaseLimits = types.DefaultPurchaseLimits()
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate5to6 records the coins backing the reserve. Earlier versions credited every
+// purchase denom to the reserve balance in TestUSD terms and refunded sells in the
+// params purchase denom; the holdings start from the module's balance of each
+// accepted purchase denom.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	denoms := []string{types.TestUSDDenom, params.PurchaseDenom}
+	for _, pd := range params.PurchaseDenoms {
+		denoms = append(denoms, pd.Denom)
+	}
+
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	for _, denom := range denoms {
+		if denom == types.MainCoinDenom {
+			continue
+		}
+		held := m.keeper.bankKeeper.GetBalance(ctx, moduleAddr, denom)
+		if !held.IsPositive() {
+			continue
+		}
+		if err := m.keeper.ReserveHoldings.Set(ctx, denom, held.Amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"mychain/x/maincoin/keeper"
+	"mychain/x/maincoin/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	f := initFixture(t)
+
+	// params stored before vesting was added have no schedule
+	params := types.DefaultParams()
+	params.DevVestingCliff = 0
+	params.DevVestingDuration = 0
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(f.ctx))
+
+	got, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultDevVestingCliff, got.DevVestingCliff)
+	require.Equal(t, types.DefaultDevVestingDuration, got.DevVestingDuration)
+}
+
+func TestMigrate1to2KeepsSchedule(t *testing.T) {
+	f := initFixture(t)
+
+	params := types.DefaultParams()
+	params.DevVestingCliff = 0
+	params.DevVestingDuration = 30 * 24 * time.Hour
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(f.ctx))
+
+	got, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, params, got)
+}
+
+func TestMigrate2to3(t *testing.T) {
+	f := initFixture(t)
+	dev := sdk.AccAddress([]byte("legacy_dev__________")).String()
+
+	// params from before the recipients list carry a single dev address and none of the later fields
+	params := types.Params{
+		DevAddress: dev,
+	}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	start := time.Unix(1_700_000_000, 0).UTC()
+	tranche := types.NewDevVestingTranche(3, math.NewInt(1000), start, time.Hour, 2*time.Hour)
+	tranche.Claimed = math.NewInt(250)
+	require.NoError(t, f.keeper.DevVestingTranches.Set(f.ctx, tranche.SegmentNumber, tranche))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate2to3(f.ctx))
+
+	got, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	require.Empty(t, got.DevAddress)
+	require.Equal(t, []types.DevRecipient{
+		{Address: dev, Weight: math.LegacyOneDec(), Label: "dev"},
+	}, got.DevRecipients)
+
+	migrated, err := f.keeper.DevVestingTranches.Get(f.ctx, 3)
+	require.NoError(t, err)
+	require.Equal(t, []types.DevVestingShare{
+		{Address: dev, Amount: math.NewInt(1000), Claimed: math.NewInt(250)},
+	}, migrated.Shares)
+}
+
+func TestMigrate2to3WithoutDevAddress(t *testing.T) {
+	f := initFixture(t)
+
+	require.NoError(t, f.keeper.Params.Set(f.ctx, types.Params{}))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate2to3(f.ctx))
+
+	got, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultDevRecipients(), got.DevRecipients)
+}
+
+func TestMigrate5to6(t *testing.T) {
+	ctx, k, bank := initBankFixture(t, atomGenesis())
+
+	// The module holds the purchase denoms it was paid in and the MainCoin locked
+	// in vesting; only the purchase denoms back the reserve
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	bank.balances[moduleAddr.String()] = sdk.NewCoins(
+		sdk.NewInt64Coin(types.TestUSDDenom, 400_000),
+		sdk.NewInt64Coin(atomDenom, 300_000),
+		sdk.NewInt64Coin(types.MainCoinDenom, 50_000),
+		sdk.NewInt64Coin("uother", 7),
+	)
+
+	require.NoError(t, keeper.NewMigrator(&k).Migrate5to6(ctx))
+
+	holdings, err := k.GetReserveHoldings(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewInt64Coin(types.TestUSDDenom, 400_000),
+		sdk.NewInt64Coin(atomDenom, 300_000),
+	), holdings)
+}
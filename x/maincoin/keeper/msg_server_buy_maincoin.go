@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	cmttypes "github.com/cometbft/cometbft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"mychain/x/maincoin/types"
+	mychaintypes "mychain/x/mychain/types"
 )
 
 // BuyMaincoin handles the corrected logic with deferred dev allocation
@@ -19,9 +22,14 @@ func (ms msgServer) BuyMaincoin(goCtx context.Context, msg *types.MsgBuyMaincoin
 
 	// State should be initialized through InitGenesis
 
-	// Validate TestUSD amount
-	if msg.Amount.Denom != types.TestUSDDenom {
-		return nil, fmt.Errorf("invalid denom %s, expected %s", msg.Amount.Denom, types.TestUSDDenom)
+	// Convert the payment into its TestUSD value; the reserve is kept in TestUSD
+	rate, err := k.GetPurchaseRate(ctx, msg.Amount.Denom)
+	if err != nil {
+		return nil, err
+	}
+	purchaseValue := types.ConvertToReserve(msg.Amount.Amount, rate)
+	if !purchaseValue.IsPositive() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAmount, "%s is worth less than 1 %s", msg.Amount, types.TestUSDDenom)
 	}
 
 	// Get current state
@@ -50,6 +58,11 @@ func (ms msgServer) BuyMaincoin(goCtx context.Context, msg *types.MsgBuyMaincoin
 		return nil, fmt.Errorf("failed to get params: %w", err)
 	}
 
+	// Fair-launch controls: only allow-listed buyers during the allow-list phase
+	if err := k.checkAllowList(params.PurchaseLimits, msg.Buyer, currentEpoch); err != nil {
+		return nil, err
+	}
+
 	// Get pending dev allocation
 	pendingDev, err := k.PendingDevAllocation.Get(ctx)
 	if err != nil {
@@ -60,7 +73,7 @@ func (ms msgServer) BuyMaincoin(goCtx context.Context, msg *types.MsgBuyMaincoin
 	// Use the corrected analytical calculation with deferred dev
 	result, err := k.CalculateAnalyticalPurchaseWithDeferredDev(
 		ctx,
-		msg.Amount.Amount,
+		purchaseValue,
 		currentPrice,
 		params.PriceIncrement,
 		currentEpoch,
@@ -72,13 +85,18 @@ func (ms msgServer) BuyMaincoin(goCtx context.Context, msg *types.MsgBuyMaincoin
 		return nil, fmt.Errorf("failed to calculate purchase: %w", err)
 	}
 
+	// Reject purchases over the per-address or per-block limits
+	if err := k.checkPurchaseLimits(ctx, params.PurchaseLimits, msg.Buyer, currentEpoch, result); err != nil {
+		return nil, err
+	}
+
 	// Execute the purchase
 	buyer, err := sdk.AccAddressFromBech32(msg.Buyer)
 	if err != nil {
 		return nil, fmt.Errorf("invalid buyer address: %w", err)
 	}
 
-	// Transfer TestUSD from buyer to module
+	// Transfer the payment from buyer to module
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(
 		ctx,
 		buyer,
@@ -88,9 +106,16 @@ func (ms msgServer) BuyMaincoin(goCtx context.Context, msg *types.MsgBuyMaincoin
 		return nil, fmt.Errorf("failed to transfer funds from buyer: %w", err)
 	}
 
-	// Refund any unspent funds
+	// Refund any unspent funds in the denom they were paid in
+	refundAmount := sdkmath.ZeroInt()
 	if result.RemainingFunds.IsPositive() {
-		refund := sdk.NewCoins(sdk.NewCoin(types.TestUSDDenom, result.RemainingFunds))
+		refundAmount = types.ConvertFromReserve(result.RemainingFunds, rate)
+		if refundAmount.GT(msg.Amount.Amount) {
+			refundAmount = msg.Amount.Amount
+		}
+	}
+	if refundAmount.IsPositive() {
+		refund := sdk.NewCoins(sdk.NewCoin(msg.Amount.Denom, refundAmount))
 		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, buyer, refund); err != nil {
 			return nil, fmt.Errorf("failed to refund remaining funds: %w", err)
 		}
@@ -108,20 +133,11 @@ func (ms msgServer) BuyMaincoin(goCtx context.Context, msg *types.MsgBuyMaincoin
 		}
 	}
 
-	// Handle dev allocation from PREVIOUS segment
-	if result.TotalDevAllocation.IsPositive() && params.DevAddress != "" {
-		devAddress, err := sdk.AccAddressFromBech32(params.DevAddress)
-		if err != nil {
-			return nil, fmt.Errorf("invalid dev address: %w", err)
-		}
-
-		devCoins := sdk.NewCoins(sdk.NewCoin(types.MainCoinDenom, result.TotalDevAllocation))
-		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, devCoins); err != nil {
-			return nil, fmt.Errorf("failed to mint dev tokens: %w", err)
-		}
-
-		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, devAddress, devCoins); err != nil {
-			return nil, fmt.Errorf("failed to send dev tokens: %w", err)
+	// Handle dev allocation from PREVIOUS segment: it is locked in vesting
+	// tranches and released to the dev address through MsgClaimDevAllocation
+	if result.TotalDevAllocation.IsPositive() {
+		if err := k.allocatePurchaseDevVesting(ctx, result); err != nil {
+			return nil, fmt.Errorf("failed to allocate dev vesting: %w", err)
 		}
 
 		// Update dev allocation total
@@ -142,12 +158,13 @@ func (ms msgServer) BuyMaincoin(goCtx context.Context, msg *types.MsgBuyMaincoin
 		return nil, fmt.Errorf("failed to update total supply: %w", err)
 	}
 
-	// Update reserve (100% of purchase goes to reserve)
-	reserveIncrease := result.TotalCost
-	newReserve := reserveBalance.Add(reserveIncrease)
-	if err := k.ReserveBalance.Set(ctx, newReserve); err != nil {
+	// Update reserve (100% of purchase goes to reserve), keeping the coins paid
+	// in their own denom so sells are refunded from what was actually received
+	paid := sdk.NewCoin(msg.Amount.Denom, msg.Amount.Amount.Sub(refundAmount))
+	if err := k.depositReserve(ctx, paid, result.TotalCost); err != nil {
 		return nil, fmt.Errorf("failed to update reserve balance: %w", err)
 	}
+	newReserve := reserveBalance.Add(result.TotalCost)
 
 	// Update epoch and price
 	if err := k.CurrentEpoch.Set(ctx, result.FinalEpoch); err != nil {
@@ -163,9 +180,17 @@ func (ms msgServer) BuyMaincoin(goCtx context.Context, msg *types.MsgBuyMaincoin
 		return nil, fmt.Errorf("failed to update pending dev allocation: %w", err)
 	}
 
+	// Update purchase limit counters
+	if err := k.recordPurchaseLimits(ctx, msg.Buyer, currentEpoch, result); err != nil {
+		return nil, fmt.Errorf("failed to update purchase limits: %w", err)
+	}
+
 	// Record segment history using optimized version
 	if len(result.SegmentDetails) > 0 {
-		txHash := fmt.Sprintf("%X", ctx.TxBytes())
+		txHash := ""
+		if txBytes := ctx.TxBytes(); len(txBytes) > 0 {
+			txHash = fmt.Sprintf("%X", cmttypes.Tx(txBytes).Hash())
+		}
 		if err := k.RecordSegmentPurchasesOptimized(ctx, msg.Buyer, txHash, result.SegmentDetails); err != nil {
 			// Log error but don't fail the transaction
 			ctx.Logger().Error("failed to record segment history", "error", err)
@@ -177,6 +202,8 @@ func (ms msgServer) BuyMaincoin(goCtx context.Context, msg *types.MsgBuyMaincoin
 		sdk.NewEvent(
 			types.EventTypeBuyMaincoin,
 			sdk.NewAttribute(types.AttributeKeyBuyer, msg.Buyer),
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Amount.Denom),
+			sdk.NewAttribute(types.AttributeKeyRate, rate.String()),
 			sdk.NewAttribute(types.AttributeKeyAmountSpent, result.TotalCost.String()),
 			sdk.NewAttribute(types.AttributeKeyTokensBought, result.TotalTokensBought.String()),
 			sdk.NewAttribute(types.AttributeKeyUserTokens, result.TotalUserTokens.String()),
@@ -191,9 +218,10 @@ func (ms msgServer) BuyMaincoin(goCtx context.Context, msg *types.MsgBuyMaincoin
 	// Log summary
 	ctx.Logger().Info("MainCoin purchase completed",
 		"buyer", msg.Buyer,
-		"requested", msg.Amount.Amount.String(),
+		"requested", msg.Amount.String(),
+		"rate", rate.String(),
 		"spent", result.TotalCost.String(),
-		"refunded", result.RemainingFunds.String(),
+		"refunded", refundAmount.String(),
 		"tokens_bought", result.TotalTokensBought.String(),
 		"user_tokens", result.TotalUserTokens.String(),
 		"dev_tokens_distributed", result.TotalDevAllocation.String(),
@@ -213,17 +241,22 @@ func (ms msgServer) BuyMaincoin(goCtx context.Context, msg *types.MsgBuyMaincoin
 	tk := ms.GetTransactionKeeper()
 	if tk != nil {
 		ctx.Logger().Info("Recording MainCoin purchase transaction", "buyer", msg.Buyer, "amount", result.TotalUserTokens.String())
-		metadata := fmt.Sprintf(`{"spent":"%s","received":"%s","segments":%d}`, result.TotalCost.String(), result.TotalUserTokens.String(), result.SegmentsProcessed)
-		err := tk.RecordTransaction(
-			ctx,
-			msg.Buyer,
-			"buy_maincoin",
-			fmt.Sprintf("Bought %s MainCoin for %s utestusd", result.TotalUserTokens.String(), result.TotalCost.String()),
-			sdk.NewCoins(sdk.NewCoin(types.MainCoinDenom, result.TotalUserTokens)),
-			msg.Buyer,
-			"maincoin_reserve",
-			metadata,
-		)
+		err := tk.RecordTransaction(ctx, mychaintypes.TransactionRecord{
+			Address:     msg.Buyer,
+			Type:        "buy_maincoin",
+			Description: fmt.Sprintf("Bought %s MainCoin for %s", result.TotalUserTokens.String(), paid.String()),
+			Amount:      sdk.NewCoins(sdk.NewCoin(types.MainCoinDenom, result.TotalUserTokens)),
+			From:        msg.Buyer,
+			To:          "maincoin_reserve",
+			Details: &mychaintypes.TransactionRecord_SegmentPurchase{SegmentPurchase: &mychaintypes.SegmentPurchaseDetails{
+				Paid:              paid,
+				Cost:              result.TotalCost,
+				TokensReceived:    result.TotalUserTokens,
+				DevAllocation:     result.TotalDevAllocation,
+				StartSegment:      currentEpoch,
+				SegmentsProcessed: uint64(result.SegmentsProcessed),
+			}},
+		})
 		if err != nil {
 			ctx.Logger().Error("failed to record transaction", "error", err)
 		} else {
@@ -243,6 +276,6 @@ func (ms msgServer) BuyMaincoin(goCtx context.Context, msg *types.MsgBuyMaincoin
 		TotalTokensBought: result.TotalUserTokens.String(),
 		TotalPaid:         result.TotalCost.String(),
 		AveragePrice:      avgPrice.String(),
-		RemainingFunds:    result.RemainingFunds.String(),
+		RemainingFunds:    refundAmount.String(),
 	}, nil
 }
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"mychain/x/maincoin/types"
+)
+
+// ClaimDevAllocation releases the claimer's vested, unclaimed share of every dev allocation tranche.
+func (ms msgServer) ClaimDevAllocation(ctx context.Context, msg *types.MsgClaimDevAllocation) (*types.MsgClaimDevAllocationResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	claimer, err := ms.addressCodec.StringToBytes(msg.Claimer)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid claimer address")
+	}
+
+	claimed, err := ms.ClaimVestedDevAllocation(ctx, sdk.AccAddress(claimer))
+	if err != nil {
+		return nil, err
+	}
+
+	claimedCoin := sdk.NewCoin(types.MainCoinDenom, claimed)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClaimDevAllocation,
+			sdk.NewAttribute(types.AttributeKeyClaimer, msg.Claimer),
+			sdk.NewAttribute(types.AttributeKeyAmount, claimedCoin.String()),
+		),
+	)
+
+	return &types.MsgClaimDevAllocationResponse{
+		AmountClaimed: claimedCoin,
+	}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"mychain/x/maincoin/types"
+	mychaintypes "mychain/x/mychain/types"
 
 	"cosmossdk.io/math"
 	errorsmod "cosmossdk.io/errors"
@@ -40,16 +41,6 @@ func (ms msgServer) SellMaincoin(ctx context.Context, msg *types.MsgSellMaincoin
 	refundAmountDec := currentPrice.Mul(math.LegacyNewDecFromInt(msg.Amount.Amount))
 	refundAmount := refundAmountDec.TruncateInt()
 	
-	// Check reserve has enough balance
-	currentReserve, err := ms.ReserveBalance.Get(ctx)
-	if err != nil {
-		return nil, err
-	}
-	
-	if currentReserve.LT(refundAmount) {
-		return nil, types.ErrInsufficientReserve
-	}
-	
 	// Burn the maincoins from seller
 	if err := ms.bankKeeper.SendCoinsFromAccountToModule(
 		ctx,
@@ -79,27 +70,22 @@ func (ms msgServer) SellMaincoin(ctx context.Context, msg *types.MsgSellMaincoin
 		return nil, err
 	}
 	
-	// Update reserve balance
-	newReserve := currentReserve.Sub(refundAmount)
-	if err := ms.ReserveBalance.Set(ctx, newReserve); err != nil {
-		return nil, err
-	}
-	
-	// Send TestUSD to seller
-	params, err := ms.Params.Get(ctx)
+	// Take the refund out of the reserve; it is paid in every denom the reserve
+	// holds, in proportion to its holdings
+	refund, err := ms.withdrawReserve(ctx, refundAmount)
 	if err != nil {
 		return nil, err
 	}
 	
-	refundCoin := sdk.NewCoin(params.PurchaseDenom, refundAmount)
 	if err := ms.bankKeeper.SendCoinsFromModuleToAccount(
 		ctx,
 		types.ModuleName,
 		sdk.AccAddress(sellerAddr),
-		sdk.NewCoins(refundCoin),
+		refund,
 	); err != nil {
 		return nil, err
 	}
+	refundCoin := sdk.NewCoin(types.TestUSDDenom, refund.AmountOf(types.TestUSDDenom))
 	
 	// Emit event
 	sdkCtx.EventManager().EmitEvent(
@@ -107,24 +93,25 @@ func (ms msgServer) SellMaincoin(ctx context.Context, msg *types.MsgSellMaincoin
 			"sell_maincoin",
 			sdk.NewAttribute("seller", msg.Seller),
 			sdk.NewAttribute("amount", msg.Amount.String()),
-			sdk.NewAttribute("refund", refundCoin.String()),
+			sdk.NewAttribute("refund", refund.String()),
 		),
 	)
 	
 	// Record transaction history
 	tk := ms.GetTransactionKeeper()
 	if tk != nil {
-		metadata := fmt.Sprintf(`{"sold":"%s","received":"%s"}`, msg.Amount.String(), refundCoin.String())
-		err := tk.RecordTransaction(
-			sdkCtx,
-			msg.Seller,
-			"sell_maincoin",
-			fmt.Sprintf("Sold %s MainCoin for %s", msg.Amount.String(), refundCoin.String()),
-			sdk.NewCoins(refundCoin),
-			"maincoin_reserve",
-			msg.Seller,
-			metadata,
-		)
+		err := tk.RecordTransaction(sdkCtx, mychaintypes.TransactionRecord{
+			Address:     msg.Seller,
+			Type:        "sell_maincoin",
+			Description: fmt.Sprintf("Sold %s MainCoin for %s", msg.Amount.String(), refund.String()),
+			Amount:      refund,
+			From:        "maincoin_reserve",
+			To:          msg.Seller,
+			Details: &mychaintypes.TransactionRecord_MaincoinSale{MaincoinSale: &mychaintypes.MaincoinSaleDetails{
+				Sold:     msg.Amount,
+				Proceeds: refundCoin,
+			}},
+		})
 		if err != nil {
 			sdkCtx.Logger().Error("failed to record transaction", "error", err)
 		}
@@ -132,5 +119,6 @@ func (ms msgServer) SellMaincoin(ctx context.Context, msg *types.MsgSellMaincoin
 
 	return &types.MsgSellMaincoinResponse{
 		AmountRefunded: refundCoin,
+		Refunded:       refund,
 	}, nil
 }
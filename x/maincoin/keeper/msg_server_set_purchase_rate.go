@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"mychain/x/maincoin/types"
+)
+
+// SetPurchaseRate records the TestUSD rate of a purchase denom priced by the authority.
+func (k msgServer) SetPurchaseRate(ctx context.Context, msg *types.MsgSetPurchaseRate) (*types.MsgSetPurchaseRateResponse, error) {
+	authority, err := k.addressCodec.StringToBytes(msg.Authority)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if !bytes.Equal(k.GetAuthority(), authority) {
+		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, msg.Authority)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pd, found := params.LookupPurchaseDenom(msg.Denom)
+	if !found || pd.Source != types.PRICE_SOURCE_AUTHORITY_RATE {
+		return nil, errorsmod.Wrapf(types.ErrUnsupportedDenom, "%s is not priced by the authority", msg.Denom)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	rate := types.PurchaseRate{
+		Denom:     msg.Denom,
+		Rate:      msg.Rate,
+		UpdatedAt: sdkCtx.BlockTime(),
+	}
+	if err := rate.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidPrice, err.Error())
+	}
+	if err := k.PurchaseRates.Set(ctx, msg.Denom, rate); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetPurchaseRate,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyRate, msg.Rate.String()),
+		),
+	)
+
+	return &types.MsgSetPurchaseRateResponse{}, nil
+}
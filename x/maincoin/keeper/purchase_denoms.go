@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"mychain/x/maincoin/types"
+)
+
+// GetPurchaseRate returns the TestUSD paid per unit of denom. TestUSD itself is
+// always accepted at par; any other denom must be listed in params.PurchaseDenoms.
+func (k Keeper) GetPurchaseRate(ctx context.Context, denom string) (math.LegacyDec, error) {
+	if denom == types.TestUSDDenom {
+		return math.LegacyOneDec(), nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	pd, found := params.LookupPurchaseDenom(denom)
+	if !found {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrUnsupportedDenom, "%s", denom)
+	}
+
+	var rate math.LegacyDec
+	switch pd.Source {
+	case types.PRICE_SOURCE_FIXED_PEG:
+		rate = pd.Rate
+
+	case types.PRICE_SOURCE_DEX_TWAP:
+		if k.priceAdapter == nil {
+			return math.LegacyDec{}, errorsmod.Wrapf(types.ErrPriceUnavailable, "no price adapter for %s", denom)
+		}
+		rate, err = k.priceAdapter.GetTWAP(ctx, denom, types.TestUSDDenom, pd.TwapWindow)
+		if err != nil {
+			return math.LegacyDec{}, errorsmod.Wrapf(types.ErrPriceUnavailable, "%s: %s", denom, err)
+		}
+
+	case types.PRICE_SOURCE_AUTHORITY_RATE:
+		pr, err := k.PurchaseRates.Get(ctx, denom)
+		if errors.Is(err, collections.ErrNotFound) {
+			return math.LegacyDec{}, errorsmod.Wrapf(types.ErrPriceUnavailable, "no rate set for %s", denom)
+		} else if err != nil {
+			return math.LegacyDec{}, err
+		}
+		if pd.MaxRateAge > 0 && sdk.UnwrapSDKContext(ctx).BlockTime().Sub(pr.UpdatedAt) > pd.MaxRateAge {
+			return math.LegacyDec{}, errorsmod.Wrapf(types.ErrPriceUnavailable, "rate for %s was set at %s and is stale", denom, pr.UpdatedAt)
+		}
+		rate = pr.Rate
+
+	default:
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrUnsupportedDenom, "%s has no price source", denom)
+	}
+
+	if rate.IsNil() || !rate.IsPositive() {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrPriceUnavailable, "non-positive rate for %s", denom)
+	}
+	return rate, nil
+}
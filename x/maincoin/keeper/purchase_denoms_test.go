@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"mychain/x/maincoin/types"
+)
+
+func TestGetPurchaseRate(t *testing.T) {
+	f := initFixture(t)
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx := f.ctx.WithBlockTime(now)
+
+	params := types.DefaultParams()
+	params.PurchaseDenoms = []types.PurchaseDenom{
+		{Denom: "uusdc", Source: types.PRICE_SOURCE_AUTHORITY_RATE, MaxRateAge: time.Hour},
+		{Denom: "uatom", Source: types.PRICE_SOURCE_FIXED_PEG, Rate: math.LegacyNewDec(2)},
+	}
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	rate, err := f.keeper.GetPurchaseRate(ctx, types.TestUSDDenom)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyOneDec(), rate)
+
+	rate, err = f.keeper.GetPurchaseRate(ctx, "uatom")
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(2), rate)
+
+	_, err = f.keeper.GetPurchaseRate(ctx, "uunknown")
+	require.ErrorIs(t, err, types.ErrUnsupportedDenom)
+
+	// No rate has been set yet
+	_, err = f.keeper.GetPurchaseRate(ctx, "uusdc")
+	require.ErrorIs(t, err, types.ErrPriceUnavailable)
+
+	fresh := math.LegacyMustNewDecFromStr("0.99")
+	require.NoError(t, f.keeper.PurchaseRates.Set(ctx, "uusdc", types.PurchaseRate{
+		Denom:     "uusdc",
+		Rate:      fresh,
+		UpdatedAt: now.Add(-time.Hour),
+	}))
+	rate, err = f.keeper.GetPurchaseRate(ctx, "uusdc")
+	require.NoError(t, err)
+	require.Equal(t, fresh, rate)
+
+	// One second past the max age the rate is stale
+	_, err = f.keeper.GetPurchaseRate(ctx.WithBlockTime(now.Add(time.Second)), "uusdc")
+	require.ErrorIs(t, err, types.ErrPriceUnavailable)
+}
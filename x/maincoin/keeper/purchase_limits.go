@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"mychain/x/maincoin/types"
+)
+
+// checkAllowList rejects buyers that are not allow-listed during the allow-list phase.
+func (k Keeper) checkAllowList(limits types.PurchaseLimits, buyer string, segment uint64) error {
+	if !limits.IsAllowed(buyer, segment) {
+		return errorsmod.Wrapf(types.ErrNotAllowListed, "%s cannot buy before segment %d", buyer, limits.AllowListUntilSegment)
+	}
+	return nil
+}
+
+// checkPurchaseLimits rejects a calculated purchase that would exceed the buyer's
+// per-segment spend limit or the per-block segment limit.
+func (k Keeper) checkPurchaseLimits(ctx context.Context, limits types.PurchaseLimits, buyer string, startEpoch uint64, result *PurchaseResult) error {
+	if limits.MaxSegmentsPerBlock > 0 && result.FinalEpoch > startEpoch {
+		advanced, err := k.blockSegmentsAdvanced(ctx)
+		if err != nil {
+			return err
+		}
+		segments := result.FinalEpoch - startEpoch
+		if advanced+segments > limits.MaxSegmentsPerBlock {
+			return errorsmod.Wrapf(types.ErrBlockSegmentLimit,
+				"purchase advances %d segments but only %d of %d remain in this block",
+				segments, remainingUint64(limits.MaxSegmentsPerBlock, advanced), limits.MaxSegmentsPerBlock)
+		}
+	}
+
+	if limits.SpendLimited() {
+		for _, detail := range result.SegmentDetails {
+			spent, err := k.segmentSpend(ctx, detail.SegmentNumber, buyer)
+			if err != nil {
+				return err
+			}
+			if spent.Add(detail.Cost).GT(limits.MaxSpendPerAddressPerSegment) {
+				return errorsmod.Wrapf(types.ErrSegmentSpendLimit,
+					"spending %s in segment %d exceeds the remaining allowance of %s",
+					detail.Cost, detail.SegmentNumber, limits.MaxSpendPerAddressPerSegment.Sub(spent))
+			}
+		}
+	}
+
+	return nil
+}
+
+// recordPurchaseLimits updates the purchase limit counters after a purchase and
+// drops counters that can no longer affect a limit.
+func (k Keeper) recordPurchaseLimits(ctx context.Context, buyer string, startEpoch uint64, result *PurchaseResult) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	if err := k.BlockSegments.Clear(ctx, new(collections.Range[int64]).EndExclusive(height)); err != nil {
+		return err
+	}
+	if err := k.SegmentSpend.Clear(ctx, new(collections.Range[collections.Pair[uint64, string]]).EndExclusive(collections.Join(startEpoch, ""))); err != nil {
+		return err
+	}
+
+	if result.FinalEpoch > startEpoch {
+		advanced, err := k.blockSegmentsAdvanced(ctx)
+		if err != nil {
+			return err
+		}
+		if err := k.BlockSegments.Set(ctx, height, advanced+(result.FinalEpoch-startEpoch)); err != nil {
+			return err
+		}
+	}
+
+	for _, detail := range result.SegmentDetails {
+		if !detail.Cost.IsPositive() {
+			continue
+		}
+		spent, err := k.segmentSpend(ctx, detail.SegmentNumber, buyer)
+		if err != nil {
+			return err
+		}
+		if err := k.SegmentSpend.Set(ctx, collections.Join(detail.SegmentNumber, buyer), spent.Add(detail.Cost)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetPurchaseAllowance reports what address can still buy under the purchase limits.
+func (k Keeper) GetPurchaseAllowance(ctx context.Context, address string) (*types.QueryPurchaseAllowanceResponse, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	segment, err := k.CurrentEpoch.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	limits := params.PurchaseLimits
+
+	spent, err := k.segmentSpend(ctx, segment, address)
+	if err != nil {
+		return nil, err
+	}
+	advanced, err := k.blockSegmentsAdvanced(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryPurchaseAllowanceResponse{
+		Segment:         segment,
+		Spent:           spent,
+		RemainingSpend:  math.ZeroInt(),
+		SpendLimited:    limits.SpendLimited(),
+		BlockLimited:    limits.MaxSegmentsPerBlock > 0,
+		AllowListActive: limits.AllowListActive(segment),
+		Allowed:         limits.IsAllowed(address, segment),
+	}
+	if res.SpendLimited && spent.LT(limits.MaxSpendPerAddressPerSegment) {
+		res.RemainingSpend = limits.MaxSpendPerAddressPerSegment.Sub(spent)
+	}
+	if res.BlockLimited {
+		res.RemainingBlockSegments = remainingUint64(limits.MaxSegmentsPerBlock, advanced)
+	}
+	return res, nil
+}
+
+// segmentSpend returns the TestUSD value buyer has spent in segment.
+func (k Keeper) segmentSpend(ctx context.Context, segment uint64, buyer string) (math.Int, error) {
+	spent, err := k.SegmentSpend.Get(ctx, collections.Join(segment, buyer))
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+	return spent, err
+}
+
+// blockSegmentsAdvanced returns how many segments purchases advanced in the current block.
+func (k Keeper) blockSegmentsAdvanced(ctx context.Context) (uint64, error) {
+	advanced, err := k.BlockSegments.Get(ctx, sdk.UnwrapSDKContext(ctx).BlockHeight())
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return advanced, err
+}
+
+func remainingUint64(limit, used uint64) uint64 {
+	if used >= limit {
+		return 0
+	}
+	return limit - used
+}
@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"mychain/x/maincoin/keeper"
+	"mychain/x/maincoin/types"
+)
+
+func TestPurchaseAllowance(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := f.ctx.WithBlockHeight(10)
+	alice := sdk.AccAddress([]byte("alice_______________")).String()
+	bob := sdk.AccAddress([]byte("bob_________________")).String()
+
+	params := types.DefaultParams()
+	params.PurchaseLimits = types.PurchaseLimits{
+		MaxSpendPerAddressPerSegment: math.NewInt(1_000),
+		MaxSegmentsPerBlock:          3,
+		AllowListUntilSegment:        5,
+		AllowList:                    []string{alice},
+	}
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	require.NoError(t, f.keeper.CurrentEpoch.Set(ctx, 2))
+	require.NoError(t, f.keeper.SegmentSpend.Set(ctx, collections.Join(uint64(2), alice), math.NewInt(300)))
+	require.NoError(t, f.keeper.BlockSegments.Set(ctx, 10, 1))
+
+	res, err := qs.PurchaseAllowance(ctx, &types.QueryPurchaseAllowanceRequest{Address: alice})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Segment)
+	require.Equal(t, math.NewInt(300), res.Spent)
+	require.Equal(t, math.NewInt(700), res.RemainingSpend)
+	require.True(t, res.SpendLimited)
+	require.Equal(t, uint64(2), res.RemainingBlockSegments)
+	require.True(t, res.AllowListActive)
+	require.True(t, res.Allowed)
+
+	res, err = qs.PurchaseAllowance(ctx, &types.QueryPurchaseAllowanceRequest{Address: bob})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1_000), res.RemainingSpend)
+	require.False(t, res.Allowed)
+
+	// The block counter only applies to the block it was recorded in
+	res, err = qs.PurchaseAllowance(ctx.WithBlockHeight(11), &types.QueryPurchaseAllowanceRequest{Address: alice})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), res.RemainingBlockSegments)
+}
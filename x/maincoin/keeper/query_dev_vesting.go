@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"mychain/x/maincoin/types"
+)
+
+// DevVesting implements the Query/DevVesting gRPC method
+func (q queryServer) DevVesting(ctx context.Context, req *types.QueryDevVestingRequest) (*types.QueryDevVestingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	tranches, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.DevVestingTranches,
+		req.Pagination,
+		func(_ uint64, tranche types.DevVestingTranche) (types.DevVestingTrancheStatus, error) {
+			return tranche.StatusAt(now), nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	totalVested := math.ZeroInt()
+	totalUnvested := math.ZeroInt()
+	totalClaimed := math.ZeroInt()
+	err = q.k.DevVestingTranches.Walk(ctx, nil, func(_ uint64, tranche types.DevVestingTranche) (bool, error) {
+		vested := tranche.VestedAt(now)
+		totalVested = totalVested.Add(vested)
+		totalUnvested = totalUnvested.Add(tranche.Amount.Sub(vested))
+		totalClaimed = totalClaimed.Add(tranche.Claimed)
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDevVestingResponse{
+		Tranches:      tranches,
+		TotalVested:   totalVested,
+		TotalUnvested: totalUnvested,
+		TotalClaimed:  totalClaimed,
+		Pagination:    pageRes,
+	}, nil
+}
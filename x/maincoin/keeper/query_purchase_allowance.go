@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"mychain/x/maincoin/types"
+)
+
+// PurchaseAllowance implements the Query/PurchaseAllowance gRPC method
+func (q queryServer) PurchaseAllowance(ctx context.Context, req *types.QueryPurchaseAllowanceRequest) (*types.QueryPurchaseAllowanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	res, err := q.k.GetPurchaseAllowance(ctx, req.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return res, nil
+}
@@ -2,9 +2,11 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	
 	"mychain/x/maincoin/types"
 	
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
@@ -23,47 +25,31 @@ func (q queryServer) SegmentHistory(ctx context.Context, req *types.QuerySegment
 	
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	
-	// Get segment history
+	// Get segment totals
 	history, err := q.k.SegmentHistories.Get(sdkCtx, req.SegmentNumber)
-	if err != nil {
+	if errors.Is(err, collections.ErrNotFound) {
 		// Return empty history if not found
 		history = types.SegmentHistory{
 			SegmentNumber:      req.SegmentNumber,
-			Purchases:          []types.SegmentPurchaseRecord{},
 			TotalTokensSold:    math.ZeroInt(),
 			TotalDevAllocation: math.ZeroInt(),
 			TotalRevenue:       math.ZeroInt(),
 			IsComplete:         false,
 		}
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 	
-	// Apply pagination if requested
-	// Note: In a real implementation, you'd want to paginate at the storage level
-	// This is a simplified approach
-	start := 0
-	end := len(history.Purchases)
-	
-	if req.Pagination != nil && req.Pagination.Offset > 0 {
-		start = int(req.Pagination.Offset)
-		if start >= len(history.Purchases) {
-			// Return empty result if offset is beyond the data
-			history.Purchases = []types.SegmentPurchaseRecord{}
-		}
-	}
-	
-	if req.Pagination != nil && req.Pagination.Limit > 0 && start < len(history.Purchases) {
-		proposedEnd := start + int(req.Pagination.Limit)
-		if proposedEnd < end {
-			end = proposedEnd
-		}
-		history.Purchases = history.Purchases[start:end]
-	} else if start < len(history.Purchases) {
-		history.Purchases = history.Purchases[start:]
+	// Fill in the requested page of purchase records
+	purchases, pageRes, err := q.k.PaginateSegmentPurchases(sdkCtx, req.SegmentNumber, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
 	}
+	history.Purchases = purchases
 	
 	return &types.QuerySegmentHistoryResponse{
 		SegmentHistory: &history,
-		// Pagination response would be set here in a real implementation
+		Pagination:     pageRes,
 	}, nil
 }
 
@@ -84,42 +70,21 @@ func (q queryServer) UserPurchaseHistory(ctx context.Context, req *types.QueryUs
 	
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	
-	// Get user history
-	history, err := q.k.UserHistories.Get(sdkCtx, req.Address)
+	// Get user totals
+	history, err := q.k.GetUserPurchaseHistory(sdkCtx, req.Address)
 	if err != nil {
-		// Return empty history if not found
-		history = types.UserPurchaseHistory{
-			Address:           req.Address,
-			Purchases:         []types.SegmentPurchaseRecord{},
-			TotalTokensBought: math.ZeroInt(),
-			TotalSpent:        math.ZeroInt(),
-		}
-	}
-	
-	// Apply pagination if requested
-	start := 0
-	end := len(history.Purchases)
-	
-	if req.Pagination != nil && req.Pagination.Offset > 0 {
-		start = int(req.Pagination.Offset)
-		if start >= len(history.Purchases) {
-			// Return empty result if offset is beyond the data
-			history.Purchases = []types.SegmentPurchaseRecord{}
-		}
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 	
-	if req.Pagination != nil && req.Pagination.Limit > 0 && start < len(history.Purchases) {
-		proposedEnd := start + int(req.Pagination.Limit)
-		if proposedEnd < end {
-			end = proposedEnd
-		}
-		history.Purchases = history.Purchases[start:end]
-	} else if start < len(history.Purchases) {
-		history.Purchases = history.Purchases[start:]
+	// Fill in the requested page of purchase records
+	purchases, pageRes, err := q.k.PaginateBuyerPurchases(sdkCtx, req.Address, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
 	}
+	history.Purchases = purchases
 	
 	return &types.QueryUserPurchaseHistoryResponse{
-		UserHistory: &history,
-		// Pagination response would be set here in a real implementation
+		UserHistory: history,
+		Pagination:  pageRes,
 	}, nil
-}
\ No newline at end of file
+}
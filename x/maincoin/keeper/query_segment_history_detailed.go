@@ -59,16 +59,30 @@ func (q queryServer) SegmentDetails(goCtx context.Context, req *types.QuerySegme
 		}
 	}
 	
-	// Get transactions for this segment
-	transactions := q.k.GetSegmentTransactions(ctx, req.SegmentNumber)
+	// Get the requested page of transactions for this segment
+	transactions, pageRes, err := q.k.GetSegmentTransactions(ctx, req.SegmentNumber, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	
+	// Totals come from the segment's running totals; largest and smallest
+	// purchase cover the returned page
+	totalTransactions := uint64(len(transactions))
+	averagePurchaseSize := q.k.calculateAveragePurchaseSize(transactions)
+	if history, err := q.k.SegmentHistories.Get(ctx, req.SegmentNumber); err == nil {
+		totalTransactions = history.PurchaseCount
+		if history.PurchaseCount > 0 {
+			averagePurchaseSize = history.TotalTokensSold.Quo(math.NewIntFromUint64(history.PurchaseCount))
+		}
+	}
 	
 	// Calculate metrics
 	details := &types.SegmentDetails{
 		Segment:               &segment,
 		PreviousSegment:       prevSegment,
 		Transactions:          transactions,
-		TotalTransactions:     uint64(len(transactions)),
-		AveragePurchaseSize:   q.k.calculateAveragePurchaseSize(transactions),
+		TotalTransactions:     totalTransactions,
+		AveragePurchaseSize:   averagePurchaseSize,
 		LargestPurchase:       q.k.findLargestPurchase(transactions),
 		SmallestPurchase:      q.k.findSmallestPurchase(transactions),
 		TimeToComplete:        q.k.calculateTimeToComplete(&segment, prevSegment),
@@ -76,7 +90,8 @@ func (q queryServer) SegmentDetails(goCtx context.Context, req *types.QuerySegme
 	}
 	
 	return &types.QuerySegmentDetailsResponse{
-		Details: details,
+		Details:    details,
+		Pagination: pageRes,
 	}, nil
 }
 
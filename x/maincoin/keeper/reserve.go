@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"mychain/x/maincoin/types"
+)
+
+// GetReserveHoldings returns the coins backing the reserve.
+func (k Keeper) GetReserveHoldings(ctx context.Context) (sdk.Coins, error) {
+	holdings := sdk.NewCoins()
+	err := k.ReserveHoldings.Walk(ctx, nil, func(denom string, amount math.Int) (bool, error) {
+		holdings = holdings.Add(sdk.NewCoin(denom, amount))
+		return false, nil
+	})
+	return holdings, err
+}
+
+// depositReserve adds paid to the reserve holdings and its TestUSD value to the
+// reserve balance.
+func (k Keeper) depositReserve(ctx context.Context, paid sdk.Coin, value math.Int) error {
+	if paid.IsPositive() {
+		held, err := k.ReserveHoldings.Get(ctx, paid.Denom)
+		if err != nil {
+			held = math.ZeroInt()
+		}
+		if err := k.ReserveHoldings.Set(ctx, paid.Denom, held.Add(paid.Amount)); err != nil {
+			return err
+		}
+	}
+
+	reserve, err := k.ReserveBalance.Get(ctx)
+	if err != nil {
+		return err
+	}
+	return k.ReserveBalance.Set(ctx, reserve.Add(value))
+}
+
+// withdrawReserve removes value from the reserve balance and returns the coins
+// that pay for it: the same fraction of every holding, so each denom carries
+// its share of the reserve whatever it was bought with. Rounding is in the
+// reserve's favor.
+func (k Keeper) withdrawReserve(ctx context.Context, value math.Int) (sdk.Coins, error) {
+	reserve, err := k.ReserveBalance.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if reserve.LT(value) {
+		return nil, types.ErrInsufficientReserve
+	}
+	if value.IsZero() {
+		return sdk.NewCoins(), nil
+	}
+
+	holdings, err := k.GetReserveHoldings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	payout := sdk.NewCoins()
+	for _, held := range holdings {
+		amount := held.Amount.Mul(value).Quo(reserve)
+		if !amount.IsPositive() {
+			continue
+		}
+		payout = payout.Add(sdk.NewCoin(held.Denom, amount))
+		if remaining := held.Amount.Sub(amount); remaining.IsZero() {
+			err = k.ReserveHoldings.Remove(ctx, held.Denom)
+		} else {
+			err = k.ReserveHoldings.Set(ctx, held.Denom, remaining)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return payout, k.ReserveBalance.Set(ctx, reserve.Sub(value))
+}
@@ -0,0 +1,183 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"mychain/x/maincoin/keeper"
+	module "mychain/x/maincoin/module"
+	"mychain/x/maincoin/types"
+)
+
+const atomDenom = "uatom"
+
+type fakeBankKeeper struct {
+	supply   sdk.Coins
+	balances map[string]sdk.Coins
+}
+
+func (b *fakeBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.balances[addr.String()]
+}
+
+func (b *fakeBankKeeper) GetBalance(_ context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, b.balances[addr.String()].AmountOf(denom))
+}
+
+func (b *fakeBankKeeper) GetSupply(_ context.Context, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, b.supply.AmountOf(denom))
+}
+
+func (b *fakeBankKeeper) send(from, to sdk.AccAddress, amt sdk.Coins) error {
+	remaining, hasNeg := b.balances[from.String()].SafeSub(amt...)
+	if hasNeg {
+		return fmt.Errorf("%s has insufficient funds for %s", from, amt)
+	}
+	b.balances[from.String()] = remaining
+	b.balances[to.String()] = b.balances[to.String()].Add(amt...)
+	return nil
+}
+
+func (b *fakeBankKeeper) SendCoinsFromAccountToModule(_ context.Context, sender sdk.AccAddress, module string, amt sdk.Coins) error {
+	return b.send(sender, authtypes.NewModuleAddress(module), amt)
+}
+
+func (b *fakeBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, module string, recipient sdk.AccAddress, amt sdk.Coins) error {
+	return b.send(authtypes.NewModuleAddress(module), recipient, amt)
+}
+
+func (b *fakeBankKeeper) MintCoins(_ context.Context, module string, amt sdk.Coins) error {
+	b.supply = b.supply.Add(amt...)
+	addr := authtypes.NewModuleAddress(module).String()
+	b.balances[addr] = b.balances[addr].Add(amt...)
+	return nil
+}
+
+func (b *fakeBankKeeper) BurnCoins(_ context.Context, module string, amt sdk.Coins) error {
+	b.supply = b.supply.Sub(amt...)
+	return b.send(authtypes.NewModuleAddress(module), authtypes.NewModuleAddress("burned"), amt)
+}
+
+// atomGenesis returns the default genesis with uatom accepted at a fixed peg
+// of 2 TestUSD.
+func atomGenesis() *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params.PurchaseDenoms = []types.PurchaseDenom{
+		{Denom: atomDenom, Source: types.PRICE_SOURCE_FIXED_PEG, Rate: math.LegacyNewDec(2)},
+	}
+	return genesis
+}
+
+// initBankFixture returns a keeper initialized from genesis and backed by an
+// in-memory bank whose module account holds the genesis reserve holdings.
+func initBankFixture(t *testing.T, genesis *types.GenesisState) (sdk.Context, keeper.Keeper, *fakeBankKeeper) {
+	t.Helper()
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModule{})
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	bank := &fakeBankKeeper{balances: make(map[string]sdk.Coins)}
+	bank.balances[authtypes.NewModuleAddress(types.ModuleName).String()] = genesis.ReserveHoldings
+	k := keeper.NewKeeper(
+		runtime.NewKVStoreService(storeKey),
+		encCfg.Codec,
+		addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+		authtypes.NewModuleAddress(types.GovModuleName),
+		bank,
+		nil,
+	)
+
+	require.NoError(t, k.InitGenesis(ctx, *genesis))
+
+	return ctx, k, bank
+}
+
+func TestSellAfterBuyInOtherDenom(t *testing.T) {
+	ctx, k, bank := initBankFixture(t, atomGenesis())
+	ms := keeper.NewMsgServerImpl(&k)
+	alice := sdk.AccAddress([]byte("alice_______________"))
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+	// The module also holds TestUSD that is not part of the reserve
+	bank.balances[moduleAddr.String()] = sdk.NewCoins(sdk.NewInt64Coin(types.TestUSDDenom, 1_000_000_000))
+	bank.balances[alice.String()] = sdk.NewCoins(sdk.NewInt64Coin(atomDenom, 500_000))
+
+	_, err := ms.BuyMaincoin(ctx, &types.MsgBuyMaincoin{Buyer: alice.String(), Amount: sdk.NewInt64Coin(atomDenom, 500_000)})
+	require.NoError(t, err)
+
+	holdings, err := k.GetReserveHoldings(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{atomDenom}, holdings.Denoms())
+	reserve, err := k.ReserveBalance.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, reserve.String(), holdings.AmountOf(atomDenom).MulRaw(2).String())
+
+	sold := bank.balances[alice.String()].AmountOf(types.MainCoinDenom).QuoRaw(2)
+	resp, err := ms.SellMaincoin(ctx, &types.MsgSellMaincoin{Seller: alice.String(), Amount: sdk.NewCoin(types.MainCoinDenom, sold)})
+	require.NoError(t, err)
+
+	// The refund comes out of the uatom that was paid in, not the TestUSD the
+	// reserve never received
+	require.Equal(t, []string{atomDenom}, resp.Refunded.Denoms())
+	require.True(t, resp.AmountRefunded.IsZero())
+	require.Equal(t, int64(1_000_000_000), bank.balances[moduleAddr.String()].AmountOf(types.TestUSDDenom).Int64())
+
+	newReserve, err := k.ReserveBalance.Get(ctx)
+	require.NoError(t, err)
+	newHoldings, err := k.GetReserveHoldings(ctx)
+	require.NoError(t, err)
+	require.Equal(t, holdings.Sub(resp.Refunded...), newHoldings)
+	require.True(t, newHoldings.AmountOf(atomDenom).MulRaw(2).GTE(newReserve))
+}
+
+func TestSellRefundsHoldingsProRata(t *testing.T) {
+	// A reserve worth 1,000,000 TestUSD: 400,000 utusd and 300,000 uatom at 2
+	genesis := atomGenesis()
+	genesis.TotalSupply = math.NewInt(10_000_000_000)
+	genesis.ReserveBalance = math.NewInt(1_000_000)
+	genesis.ReserveHoldings = sdk.NewCoins(
+		sdk.NewInt64Coin(types.TestUSDDenom, 400_000),
+		sdk.NewInt64Coin(atomDenom, 300_000),
+	)
+	ctx, k, bank := initBankFixture(t, genesis)
+	ms := keeper.NewMsgServerImpl(&k)
+
+	bob := sdk.AccAddress([]byte("bob_________________"))
+	bank.supply = sdk.NewCoins(sdk.NewCoin(types.MainCoinDenom, genesis.TotalSupply))
+	bank.balances[bob.String()] = bank.supply
+
+	// 2,000,000,000 umc at 0.0001 is a fifth of the reserve
+	resp, err := ms.SellMaincoin(ctx, &types.MsgSellMaincoin{Seller: bob.String(), Amount: sdk.NewInt64Coin(types.MainCoinDenom, 2_000_000_000)})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewInt64Coin(types.TestUSDDenom, 80_000),
+		sdk.NewInt64Coin(atomDenom, 60_000),
+	), resp.Refunded)
+	require.Equal(t, sdk.NewInt64Coin(types.TestUSDDenom, 80_000), resp.AmountRefunded)
+
+	reserve, err := k.ReserveBalance.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(800_000), reserve.Int64())
+	holdings, err := k.GetReserveHoldings(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewInt64Coin(types.TestUSDDenom, 320_000),
+		sdk.NewInt64Coin(atomDenom, 240_000),
+	), holdings)
+
+	exported, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, holdings, exported.ReserveHoldings)
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	
 	sdkmath "cosmossdk.io/math"
+
+	"mychain/x/maincoin/types"
 )
 
 // SegmentPurchaseDetail tracks details of tokens bought within a specific segment
@@ -17,6 +19,7 @@ type SegmentPurchaseDetail struct {
 	IsComplete         bool            `json:"is_complete"`
 	TokensInSegment    sdkmath.Int     `json:"tokens_in_segment"`    // Total tokens bought in this segment so far
 	TokensNeededToComplete sdkmath.Int `json:"tokens_needed_to_complete"` // Tokens needed to complete segment
+	DevDistributions   []types.DevRecipientAmount `json:"dev_distributions"` // Per-recipient split of DevAllocation
 }
 
 // PurchaseResult extends AnalyticalPurchase with segment details
@@ -2,11 +2,14 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	
 	"mychain/x/maincoin/types"
 	
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // RecordSegmentPurchases records the segment purchases from a transaction
@@ -35,6 +38,10 @@ func (k Keeper) RecordSegmentPurchases(
 			Timestamp:      timestamp,
 		}
 		
+		if err := k.storePurchaseRecord(ctx, record, true); err != nil {
+			return err
+		}
+		
 		// Update segment history
 		if err := k.updateSegmentHistory(ctx, record); err != nil {
 			return err
@@ -49,7 +56,27 @@ func (k Keeper) RecordSegmentPurchases(
 	return nil
 }
 
-// updateSegmentHistory updates the history for a specific segment
+// storePurchaseRecord stores the record under a new id and indexes it by buyer and,
+// when indexSegment is set, by segment
+func (k Keeper) storePurchaseRecord(ctx context.Context, record types.SegmentPurchaseRecord, indexSegment bool) error {
+	id, err := k.PurchaseRecordSeq.Next(ctx)
+	if err != nil {
+		return err
+	}
+	record.Id = id
+	
+	if err := k.PurchaseRecords.Set(ctx, id, record); err != nil {
+		return err
+	}
+	if indexSegment {
+		if err := k.SegmentPurchases.Set(ctx, collections.Join(record.SegmentNumber, id), id); err != nil {
+			return err
+		}
+	}
+	return k.BuyerPurchases.Set(ctx, collections.Join(record.Buyer, id), id)
+}
+
+// updateSegmentHistory updates the running totals of a specific segment
 func (k Keeper) updateSegmentHistory(ctx context.Context, record types.SegmentPurchaseRecord) error {
 	// Get existing history or create new
 	history, err := k.SegmentHistories.Get(ctx, record.SegmentNumber)
@@ -57,7 +84,6 @@ func (k Keeper) updateSegmentHistory(ctx context.Context, record types.SegmentPu
 		// Create new history if doesn't exist
 		history = types.SegmentHistory{
 			SegmentNumber:      record.SegmentNumber,
-			Purchases:          []types.SegmentPurchaseRecord{},
 			TotalTokensSold:    math.ZeroInt(),
 			TotalDevAllocation: math.ZeroInt(),
 			TotalRevenue:       math.ZeroInt(),
@@ -65,10 +91,8 @@ func (k Keeper) updateSegmentHistory(ctx context.Context, record types.SegmentPu
 		}
 	}
 	
-	// Add purchase record
-	history.Purchases = append(history.Purchases, record)
-	
 	// Update totals
+	history.PurchaseCount++
 	history.TotalTokensSold = history.TotalTokensSold.Add(record.TokensBought)
 	history.TotalDevAllocation = history.TotalDevAllocation.Add(record.DevAllocation)
 	history.TotalRevenue = history.TotalRevenue.Add(record.Cost)
@@ -84,7 +108,7 @@ func (k Keeper) updateSegmentHistory(ctx context.Context, record types.SegmentPu
 	return k.SegmentHistories.Set(ctx, record.SegmentNumber, history)
 }
 
-// updateUserHistory updates the purchase history for a specific user
+// updateUserHistory updates the running totals of a specific user
 func (k Keeper) updateUserHistory(ctx context.Context, buyer string, record types.SegmentPurchaseRecord) error {
 	// Get existing history or create new
 	history, err := k.UserHistories.Get(ctx, buyer)
@@ -92,16 +116,13 @@ func (k Keeper) updateUserHistory(ctx context.Context, buyer string, record type
 		// Create new history if doesn't exist
 		history = types.UserPurchaseHistory{
 			Address:           buyer,
-			Purchases:         []types.SegmentPurchaseRecord{},
 			TotalTokensBought: math.ZeroInt(),
 			TotalSpent:        math.ZeroInt(),
 		}
 	}
 	
-	// Add purchase record
-	history.Purchases = append(history.Purchases, record)
-	
 	// Update totals
+	history.PurchaseCount++
 	history.TotalTokensBought = history.TotalTokensBought.Add(record.UserTokens)
 	history.TotalSpent = history.TotalSpent.Add(record.Cost)
 	
@@ -110,10 +131,11 @@ func (k Keeper) updateUserHistory(ctx context.Context, buyer string, record type
 }
 
 
-// GetUserPurchaseHistory retrieves the purchase history for a specific user
+// GetUserPurchaseHistory retrieves the running totals of a specific user; use
+// BuyerPurchases to page through the individual records
 func (k Keeper) GetUserPurchaseHistory(ctx context.Context, address string) (*types.UserPurchaseHistory, error) {
 	history, err := k.UserHistories.Get(ctx, address)
-	if err != nil {
+	if errors.Is(err, collections.ErrNotFound) {
 		// Return empty history if not found
 		return &types.UserPurchaseHistory{
 			Address:           address,
@@ -121,44 +143,55 @@ func (k Keeper) GetUserPurchaseHistory(ctx context.Context, address string) (*ty
 			TotalTokensBought: math.ZeroInt(),
 			TotalSpent:        math.ZeroInt(),
 		}, nil
+	} else if err != nil {
+		return nil, err
 	}
 	return &history, nil
 }
 
 // GetRecentSegmentPurchases gets the most recent purchases across all segments
 func (k Keeper) GetRecentSegmentPurchases(ctx context.Context, limit int) ([]types.SegmentPurchaseRecord, error) {
-	var allPurchases []types.SegmentPurchaseRecord
+	var purchases []types.SegmentPurchaseRecord
+	if limit <= 0 {
+		return purchases, nil
+	}
 	
-	// Iterate through segment histories
-	err := k.SegmentHistories.Walk(ctx, nil, func(segmentNum uint64, history types.SegmentHistory) (bool, error) {
-		allPurchases = append(allPurchases, history.Purchases...)
-		return false, nil
+	// Record ids increase with every purchase, so walking backwards yields the newest first
+	rng := new(collections.Range[uint64]).Descending()
+	err := k.PurchaseRecords.Walk(ctx, rng, func(_ uint64, record types.SegmentPurchaseRecord) (bool, error) {
+		purchases = append(purchases, record)
+		return len(purchases) >= limit, nil
 	})
 	if err != nil {
 		return nil, err
 	}
-	
-	// Sort by block height (most recent first)
-	// Note: In production, you'd want a more efficient way to get recent purchases
-	sortPurchasesByBlockHeight(allPurchases)
-	
-	// Return limited results
-	if len(allPurchases) > limit {
-		return allPurchases[:limit], nil
-	}
-	return allPurchases, nil
+	return purchases, nil
 }
 
-// Helper function to sort purchases by block height (most recent first)
-func sortPurchasesByBlockHeight(purchases []types.SegmentPurchaseRecord) {
-	// Simple bubble sort - in production use sort.Slice
-	for i := 0; i < len(purchases); i++ {
-		for j := i + 1; j < len(purchases); j++ {
-			if purchases[j].BlockHeight > purchases[i].BlockHeight {
-				purchases[i], purchases[j] = purchases[j], purchases[i]
-			}
-		}
-	}
+// PaginateSegmentPurchases returns a page of the purchase records of a segment
+func (k Keeper) PaginateSegmentPurchases(ctx context.Context, segmentNumber uint64, pageReq *query.PageRequest) ([]types.SegmentPurchaseRecord, *query.PageResponse, error) {
+	return query.CollectionPaginate(
+		ctx,
+		k.SegmentPurchases,
+		pageReq,
+		func(_ collections.Pair[uint64, uint64], id uint64) (types.SegmentPurchaseRecord, error) {
+			return k.PurchaseRecords.Get(ctx, id)
+		},
+		query.WithCollectionPaginationPairPrefix[uint64, uint64](segmentNumber),
+	)
+}
+
+// PaginateBuyerPurchases returns a page of the purchase records of a buyer
+func (k Keeper) PaginateBuyerPurchases(ctx context.Context, buyer string, pageReq *query.PageRequest) ([]types.SegmentPurchaseRecord, *query.PageResponse, error) {
+	return query.CollectionPaginate(
+		ctx,
+		k.BuyerPurchases,
+		pageReq,
+		func(_ collections.Pair[string, uint64], id uint64) (types.SegmentPurchaseRecord, error) {
+			return k.PurchaseRecords.Get(ctx, id)
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](buyer),
+	)
 }
 
 // GetSegmentHistory retrieves a segment history entry
@@ -200,10 +233,25 @@ func (k Keeper) GetAllSegmentHistory(ctx sdk.Context) []types.SegmentHistoryEntr
 	return k.GetAllSegmentHistoryOptimized(ctx)
 }
 
-// GetSegmentTransactions retrieves all transactions for a specific segment
-func (k Keeper) GetSegmentTransactions(ctx sdk.Context, segmentNumber uint64) []*types.SegmentTransaction {
-	// For now, return empty slice - this would be implemented with proper transaction tracking
-	return []*types.SegmentTransaction{}
+// GetSegmentTransactions retrieves a page of the transactions of a specific segment
+func (k Keeper) GetSegmentTransactions(ctx sdk.Context, segmentNumber uint64, pageReq *query.PageRequest) ([]*types.SegmentTransaction, *query.PageResponse, error) {
+	records, pageRes, err := k.PaginateSegmentPurchases(ctx, segmentNumber, pageReq)
+	if err != nil {
+		return nil, nil, err
+	}
+	
+	transactions := make([]*types.SegmentTransaction, 0, len(records))
+	for _, record := range records {
+		transactions = append(transactions, &types.SegmentTransaction{
+			TxHash:       record.TxHash,
+			Buyer:        record.Buyer,
+			TokensBought: record.TokensBought,
+			AmountSpent:  record.Cost,
+			Timestamp:    record.Timestamp.Unix(),
+			SegmentsInTx: 1,
+		})
+	}
+	return transactions, pageRes, nil
 }
 
 // RecordSegmentCompletion records when a segment is completed
@@ -1,22 +1,30 @@
 package keeper
 
 import (
+	"context"
 	"fmt"
 	
 	"mychain/x/maincoin/types"
 	
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // RecordSegmentPurchasesOptimized records segment purchases with minimal gas usage
-// This version only stores aggregate data and emits events for detailed tracking
+// Each purchase record is written under its own key, so the cost per purchase stays
+// constant no matter how long the segment or buyer history grows
 func (k Keeper) RecordSegmentPurchasesOptimized(
 	ctx sdk.Context,
 	buyer string,
 	txHash string,
 	segmentDetails []SegmentPurchaseDetail,
 ) error {
+	if err := k.RecordSegmentPurchases(ctx, buyer, txHash, segmentDetails); err != nil {
+		return err
+	}
+	
 	// Process each segment
 	for _, detail := range segmentDetails {
 		// Only update aggregate data for completed segments
@@ -26,7 +34,7 @@ func (k Keeper) RecordSegmentPurchasesOptimized(
 			}
 		}
 		
-		// Emit event for each segment purchase (instead of storing full records)
+		// Emit event for each segment purchase
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				"segment_purchase",
@@ -58,6 +66,7 @@ func (k Keeper) recordSegmentCompletion(ctx sdk.Context, detail SegmentPurchaseD
 		Reserves:       math.ZeroInt(), // Can be retrieved from state
 		CompletedAt:    ctx.BlockTime().Unix(),
 		TxHash:         "", // Not storing individual tx hashes to save gas
+		DevDistributions: detail.DevDistributions,
 	}
 	
 	// Store using efficient key-value storage
@@ -79,6 +88,36 @@ func (k Keeper) recordSegmentCompletion(ctx sdk.Context, detail SegmentPurchaseD
 	return store.Set(key, bz)
 }
 
+// setCompletedSegment stores the summary of a completed segment as it was
+// recorded, for genesis import
+func (k Keeper) setCompletedSegment(ctx context.Context, entry types.SegmentHistoryEntry) error {
+	bz, err := k.cdc.Marshal(&entry)
+	if err != nil {
+		return err
+	}
+	return k.storeService.OpenKVStore(ctx).Set(types.GetSegmentHistoryKey(entry.SegmentNumber), bz)
+}
+
+// getCompletedSegments returns the stored summaries of the completed segments
+// without filling in the current state, for genesis export
+func (k Keeper) getCompletedSegments(ctx context.Context) ([]types.SegmentHistoryEntry, error) {
+	iterator, err := k.storeService.OpenKVStore(ctx).Iterator(types.KeyPrefixSegmentHistory, storetypes.PrefixEndBytes(types.KeyPrefixSegmentHistory))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var entries []types.SegmentHistoryEntry
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.SegmentHistoryEntry
+		if err := k.cdc.Unmarshal(iterator.Value(), &entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
 // GetSegmentHistoryOptimized retrieves a segment history entry efficiently
 func (k Keeper) GetSegmentHistoryOptimized(ctx sdk.Context, segmentNumber uint64) (types.SegmentHistoryEntry, bool) {
 	store := k.storeService.OpenKVStore(ctx)
@@ -148,7 +187,7 @@ func (k Keeper) GetAllSegmentHistoryOptimized(ctx sdk.Context) []types.SegmentHi
 }
 
 // CleanupOldSegmentData removes detailed purchase records to save space
-// This can be called periodically to clean up old data
+// This can be called periodically to clean up old data; buyer totals are kept
 func (k Keeper) CleanupOldSegmentData(ctx sdk.Context, keepLastNSegments uint64) error {
 	currentEpoch := k.GetCurrentEpoch(ctx)
 	if currentEpoch <= keepLastNSegments {
@@ -157,16 +196,48 @@ func (k Keeper) CleanupOldSegmentData(ctx sdk.Context, keepLastNSegments uint64)
 	
 	cleanupBefore := currentEpoch - keepLastNSegments
 	
-	// Remove old segment histories (if using the old format)
-	err := k.SegmentHistories.Walk(ctx, nil, func(segmentNum uint64, history types.SegmentHistory) (bool, error) {
-		if segmentNum < cleanupBefore {
-			// Remove the detailed history
-			return false, k.SegmentHistories.Remove(ctx, segmentNum)
-		}
+	// Collect the records first; collections must not be modified while walking
+	var (
+		segments []uint64
+		keys     []collections.Pair[uint64, uint64]
+	)
+	rng := new(collections.Range[collections.Pair[uint64, uint64]]).EndExclusive(collections.Join(cleanupBefore, uint64(0)))
+	if err := k.SegmentPurchases.Walk(ctx, rng, func(key collections.Pair[uint64, uint64], _ uint64) (bool, error) {
+		keys = append(keys, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	if err := k.SegmentHistories.Walk(ctx, new(collections.Range[uint64]).EndExclusive(cleanupBefore), func(segmentNum uint64, _ types.SegmentHistory) (bool, error) {
+		segments = append(segments, segmentNum)
 		return false, nil
-	})
+	}); err != nil {
+		return err
+	}
+	
+	for _, key := range keys {
+		id := key.K2()
+		record, err := k.PurchaseRecords.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := k.BuyerPurchases.Remove(ctx, collections.Join(record.Buyer, id)); err != nil {
+			return err
+		}
+		if err := k.PurchaseRecords.Remove(ctx, id); err != nil {
+			return err
+		}
+		if err := k.SegmentPurchases.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	for _, segmentNum := range segments {
+		if err := k.SegmentHistories.Remove(ctx, segmentNum); err != nil {
+			return err
+		}
+	}
 	
-	return err
+	return nil
 }
 
 // Helper to get segment history key
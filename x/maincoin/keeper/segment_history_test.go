@@ -0,0 +1,177 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"mychain/x/maincoin/keeper"
+	"mychain/x/maincoin/types"
+)
+
+func purchaseDetail(segment uint64, tokens int64) keeper.SegmentPurchaseDetail {
+	return keeper.SegmentPurchaseDetail{
+		SegmentNumber: segment,
+		TokensBought:  math.NewInt(tokens),
+		UserTokens:    math.NewInt(tokens),
+		DevAllocation: math.ZeroInt(),
+		Cost:          math.NewInt(tokens * 2),
+		Price:         math.LegacyNewDec(2),
+	}
+}
+
+func TestSegmentPurchasesPagination(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	alice := sdk.AccAddress([]byte("alice_______________")).String()
+	bob := sdk.AccAddress([]byte("bob_________________")).String()
+
+	for i := int64(1); i <= 3; i++ {
+		require.NoError(t, f.keeper.RecordSegmentPurchases(f.ctx, alice, "tx", []keeper.SegmentPurchaseDetail{purchaseDetail(1, i*100)}))
+	}
+	require.NoError(t, f.keeper.RecordSegmentPurchases(f.ctx, bob, "tx", []keeper.SegmentPurchaseDetail{
+		purchaseDetail(1, 50),
+		purchaseDetail(2, 70),
+	}))
+
+	res, err := qs.SegmentHistory(f.ctx, &types.QuerySegmentHistoryRequest{
+		SegmentNumber: 1,
+		Pagination:    &query.PageRequest{Limit: 3, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.SegmentHistory.Purchases, 3)
+	require.Equal(t, uint64(4), res.Pagination.Total)
+	require.NotNil(t, res.Pagination.NextKey)
+	require.Equal(t, uint64(4), res.SegmentHistory.PurchaseCount)
+	require.Equal(t, math.NewInt(650), res.SegmentHistory.TotalTokensSold)
+
+	res, err = qs.SegmentHistory(f.ctx, &types.QuerySegmentHistoryRequest{
+		SegmentNumber: 1,
+		Pagination:    &query.PageRequest{Key: res.Pagination.NextKey, Limit: 3},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.SegmentHistory.Purchases, 1)
+	require.Equal(t, bob, res.SegmentHistory.Purchases[0].Buyer)
+
+	userRes, err := qs.UserPurchaseHistory(f.ctx, &types.QueryUserPurchaseHistoryRequest{Address: bob})
+	require.NoError(t, err)
+	require.Len(t, userRes.UserHistory.Purchases, 2)
+	require.Equal(t, uint64(2), userRes.UserHistory.PurchaseCount)
+	require.Equal(t, math.NewInt(240), userRes.UserHistory.TotalSpent)
+
+	recent, err := f.keeper.GetRecentSegmentPurchases(f.ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, recent, 2)
+	require.Equal(t, uint64(2), recent[0].SegmentNumber)
+
+	// Totals no longer carry the records
+	stored, err := f.keeper.SegmentHistories.Get(f.ctx, 1)
+	require.NoError(t, err)
+	require.Empty(t, stored.Purchases)
+}
+
+func TestMigrate3to4SplitsPurchaseBlobs(t *testing.T) {
+	f := initFixture(t)
+	alice := sdk.AccAddress([]byte("alice_______________")).String()
+
+	record := func(segment uint64, tokens int64) types.SegmentPurchaseRecord {
+		return types.SegmentPurchaseRecord{
+			SegmentNumber: segment,
+			Buyer:         alice,
+			TokensBought:  math.NewInt(tokens),
+			UserTokens:    math.NewInt(tokens),
+			DevAllocation: math.ZeroInt(),
+			PricePerToken: math.LegacyOneDec(),
+			Cost:          math.NewInt(tokens),
+		}
+	}
+
+	// Segment 1 still has its history; segment 0 was cleaned up and only lives in the user blob
+	require.NoError(t, f.keeper.SegmentHistories.Set(f.ctx, 1, types.SegmentHistory{
+		SegmentNumber:      1,
+		Purchases:          []types.SegmentPurchaseRecord{record(1, 10), record(1, 20)},
+		TotalTokensSold:    math.NewInt(30),
+		TotalDevAllocation: math.ZeroInt(),
+		TotalRevenue:       math.NewInt(30),
+	}))
+	require.NoError(t, f.keeper.UserHistories.Set(f.ctx, alice, types.UserPurchaseHistory{
+		Address:           alice,
+		Purchases:         []types.SegmentPurchaseRecord{record(0, 5), record(1, 10), record(1, 20)},
+		TotalTokensBought: math.NewInt(35),
+		TotalSpent:        math.NewInt(35),
+	}))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate3to4(f.ctx))
+
+	segment, err := f.keeper.SegmentHistories.Get(f.ctx, 1)
+	require.NoError(t, err)
+	require.Empty(t, segment.Purchases)
+	require.Equal(t, uint64(2), segment.PurchaseCount)
+	require.Equal(t, math.NewInt(30), segment.TotalTokensSold)
+
+	user, err := f.keeper.UserHistories.Get(f.ctx, alice)
+	require.NoError(t, err)
+	require.Empty(t, user.Purchases)
+	require.Equal(t, uint64(3), user.PurchaseCount)
+
+	segmentRecords, _, err := f.keeper.PaginateSegmentPurchases(f.ctx, 1, nil)
+	require.NoError(t, err)
+	require.Len(t, segmentRecords, 2)
+
+	userRecords, _, err := f.keeper.PaginateBuyerPurchases(f.ctx, alice, nil)
+	require.NoError(t, err)
+	require.Len(t, userRecords, 3)
+}
+
+func TestPurchaseHistorySurvivesExport(t *testing.T) {
+	ctx, k, _ := initBankFixture(t, types.DefaultGenesis())
+	alice := sdk.AccAddress([]byte("alice_______________")).String()
+
+	// A record only its buyer indexes, as Migrate3to4 leaves behind for
+	// segments whose history was cleaned up
+	require.NoError(t, k.UserHistories.Set(ctx, alice, types.UserPurchaseHistory{
+		Address:           alice,
+		Purchases:         []types.SegmentPurchaseRecord{{SegmentNumber: 0, TokensBought: math.NewInt(5), UserTokens: math.NewInt(5), DevAllocation: math.ZeroInt(), PricePerToken: math.LegacyOneDec(), Cost: math.NewInt(5)}},
+		TotalTokensBought: math.NewInt(5),
+		TotalSpent:        math.NewInt(5),
+	}))
+	require.NoError(t, keeper.NewMigrator(&k).Migrate3to4(ctx))
+
+	completed := purchaseDetail(1, 100)
+	completed.IsComplete = true
+	require.NoError(t, k.RecordSegmentPurchasesOptimized(ctx, alice, "tx", []keeper.SegmentPurchaseDetail{completed, purchaseDetail(2, 30)}))
+	require.NoError(t, k.SegmentSpend.Set(ctx, collections.Join(uint64(2), alice), math.NewInt(60)))
+
+	exported, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.NoError(t, exported.Validate())
+	require.Len(t, exported.PurchaseRecords, 3)
+	require.Equal(t, uint64(3), exported.PurchaseRecordSeq)
+	require.Len(t, exported.CompletedSegments, 1)
+
+	importedCtx, imported, _ := initBankFixture(t, exported)
+	reexported, err := imported.ExportGenesis(importedCtx)
+	require.NoError(t, err)
+	require.Equal(t, exported, reexported)
+
+	// Both indexes are rebuilt, and the buyer-only record stays out of its segment
+	buyerRecords, _, err := imported.PaginateBuyerPurchases(importedCtx, alice, nil)
+	require.NoError(t, err)
+	require.Len(t, buyerRecords, 3)
+	segmentRecords, _, err := imported.PaginateSegmentPurchases(importedCtx, 0, nil)
+	require.NoError(t, err)
+	require.Empty(t, segmentRecords)
+	segmentRecords, _, err = imported.PaginateSegmentPurchases(importedCtx, 2, nil)
+	require.NoError(t, err)
+	require.Len(t, segmentRecords, 1)
+
+	// New records continue the sequence
+	require.NoError(t, imported.RecordSegmentPurchases(importedCtx, alice, "tx", []keeper.SegmentPurchaseDetail{purchaseDetail(2, 10)}))
+	recent, err := imported.GetRecentSegmentPurchases(importedCtx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), recent[0].Id)
+}
@@ -31,6 +31,19 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{},
 				},
 
+				{
+					RpcMethod: "DevVesting",
+					Use:       "dev-vesting",
+					Short:     "Query vested, unvested and claimed dev allocation per segment",
+				},
+
+				{
+					RpcMethod:      "PurchaseAllowance",
+					Use:            "purchase-allowance [address]",
+					Short:          "Query how much an address can still buy under the purchase limits",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -50,6 +63,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "SellMaincoin",
 					Skip:      true, // using custom handler
 				},
+				{
+					RpcMethod: "ClaimDevAllocation",
+					Skip:      true, // using custom handler
+				},
+				{
+					RpcMethod: "SetPurchaseRate",
+					Skip:      true, // skipped because authority gated
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
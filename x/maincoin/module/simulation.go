@@ -13,14 +13,13 @@ import (
 
 // GenerateGenesisState creates a randomized GenState of the module.
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
-	accs := make([]string, len(simState.Accounts))
-	for i, acc := range simState.Accounts {
-		accs[i] = acc.Address.String()
+	maincoinGenesis := types.DefaultGenesis()
+	maincoinGenesis.Params = maincoinsimulation.RandomParams(simState.Rand, simState.Accounts)
+	maincoinGenesis.CurrentPrice = maincoinGenesis.Params.InitialPrice
+	maincoinGenesis.PurchaseRates = []types.PurchaseRate{
+		maincoinsimulation.RandomPurchaseRate(simState.Rand, simState.GenTimestamp),
 	}
-	maincoinGenesis := types.GenesisState{
-		Params: types.DefaultParams(),
-	}
-	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&maincoinGenesis)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(maincoinGenesis)
 }
 
 // RegisterStoreDecoder registers a decoder.
@@ -30,7 +29,7 @@ func (am AppModule) RegisterStoreDecoder(_ simtypes.StoreDecoderRegistry) {}
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
 	operations := make([]simtypes.WeightedOperation, 0)
 	const (
-		opWeightMsgBuyMaincoin          = "op_weight_msg_maincoin"
+		opWeightMsgBuyMaincoin          = "op_weight_msg_buy_maincoin"
 		defaultWeightMsgBuyMaincoin int = 100
 	)
 
@@ -45,8 +44,8 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		maincoinsimulation.SimulateMsgBuyMaincoin(am.authKeeper, am.bankKeeper, *am.keeper, simState.TxConfig),
 	))
 	const (
-		opWeightMsgSellMaincoin          = "op_weight_msg_maincoin"
-		defaultWeightMsgSellMaincoin int = 100
+		opWeightMsgSellMaincoin          = "op_weight_msg_sell_maincoin"
+		defaultWeightMsgSellMaincoin int = 50
 	)
 
 	var weightMsgSellMaincoin int
@@ -59,11 +58,45 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		weightMsgSellMaincoin,
 		maincoinsimulation.SimulateMsgSellMaincoin(am.authKeeper, am.bankKeeper, *am.keeper, simState.TxConfig),
 	))
+	const (
+		opWeightMsgClaimDevAllocation          = "op_weight_msg_claim_dev_allocation"
+		defaultWeightMsgClaimDevAllocation int = 20
+	)
+
+	var weightMsgClaimDevAllocation int
+	simState.AppParams.GetOrGenerate(opWeightMsgClaimDevAllocation, &weightMsgClaimDevAllocation, nil,
+		func(_ *rand.Rand) {
+			weightMsgClaimDevAllocation = defaultWeightMsgClaimDevAllocation
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgClaimDevAllocation,
+		maincoinsimulation.SimulateMsgClaimDevAllocation(am.authKeeper, am.bankKeeper, *am.keeper, simState.TxConfig),
+	))
 
 	return operations
 }
 
 // ProposalMsgs returns msgs used for governance proposals for simulations.
 func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.WeightedProposalMsg {
-	return []simtypes.WeightedProposalMsg{}
+	const (
+		opWeightMsgUpdateParams          = "op_weight_msg_maincoin_update_params"
+		defaultWeightMsgUpdateParams int = 10
+
+		opWeightMsgSetPurchaseRate          = "op_weight_msg_set_purchase_rate"
+		defaultWeightMsgSetPurchaseRate int = 20
+	)
+
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgUpdateParams,
+			defaultWeightMsgUpdateParams,
+			maincoinsimulation.SimulateMsgUpdateParams(am.authKeeper, *am.keeper),
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgSetPurchaseRate,
+			defaultWeightMsgSetPurchaseRate,
+			maincoinsimulation.SimulateMsgSetPurchaseRate(am.authKeeper, *am.keeper),
+		),
+	}
 }
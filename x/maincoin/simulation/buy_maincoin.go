@@ -3,10 +3,12 @@ package simulation
 import (
 	"math/rand"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 
 	"mychain/x/maincoin/keeper"
 	"mychain/x/maincoin/types"
@@ -25,8 +27,44 @@ func SimulateMsgBuyMaincoin(
 			Buyer: simAccount.Address.String(),
 		}
 
-		// TODO: Handle the BuyMaincoin simulation
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to read params"), nil, err
+		}
+		denoms := []string{types.TestUSDDenom}
+		for _, pd := range params.PurchaseDenoms {
+			if _, err := k.GetPurchaseRate(ctx, pd.Denom); err == nil {
+				denoms = append(denoms, pd.Denom)
+			}
+		}
+		denom := denoms[r.Intn(len(denoms))]
 
-		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "BuyMaincoin simulation not implemented"), nil, nil
+		spendable := bk.SpendableCoins(ctx, simAccount.Address).AmountOf(denom)
+		if spendable.LT(math.NewInt(1_000_000)) {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "buyer has too little "+denom), nil, nil
+		}
+		amount := math.NewInt(int64(simtypes.RandIntBetween(r, 1, 1_001))).MulRaw(1_000_000)
+		msg.Amount = sdk.NewCoin(denom, math.MinInt(amount, spendable))
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(msg.Amount),
+		}
+		// The fair-launch limits reject some purchases by design
+		return deliverTx(txCtx,
+			types.ErrNotAllowListed,
+			types.ErrSegmentSpendLimit,
+			types.ErrBlockSegmentLimit,
+			types.ErrMaxSupplyReached,
+		)
 	}
 }
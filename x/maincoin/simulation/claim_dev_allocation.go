@@ -0,0 +1,69 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"mychain/x/maincoin/keeper"
+	"mychain/x/maincoin/types"
+)
+
+func SimulateMsgClaimDevAllocation(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgClaimDevAllocation{}
+
+		// Claim as one of the accounts holding a share of a tranche
+		seen := make(map[string]bool)
+		var claimers []simtypes.Account
+		err := k.DevVestingTranches.Walk(ctx, nil, func(_ uint64, tranche types.DevVestingTranche) (bool, error) {
+			for _, share := range tranche.Shares {
+				if seen[share.Address] {
+					continue
+				}
+				seen[share.Address] = true
+				addr, err := sdk.AccAddressFromBech32(share.Address)
+				if err != nil {
+					return true, err
+				}
+				if acc, found := simtypes.FindAccount(accs, addr); found {
+					claimers = append(claimers, acc)
+				}
+			}
+			return false, nil
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to read dev vesting tranches"), nil, err
+		}
+		if len(claimers) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no dev allocation held by a simulation account"), nil, nil
+		}
+		simAccount := claimers[r.Intn(len(claimers))]
+		msg.Claimer = simAccount.Address.String()
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return deliverTx(txCtx, types.ErrNothingToClaim)
+	}
+}
@@ -0,0 +1,121 @@
+package simulation
+
+import (
+	"errors"
+	"math/rand"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"mychain/x/maincoin/types"
+)
+
+// USDCDenom is the purchase denom the simulation lists next to TestUSD. It is
+// priced by the authority.
+const USDCDenom = "uusdc"
+
+// deliverTx delivers the message of txCtx with random fees. A rejection with
+// one of the expected errors is reported as a no-op rather than a failure.
+func deliverTx(txCtx simulation.OperationInput, expected ...error) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	opMsg, futureOps, err := simulation.GenAndDeliverTxWithRandFees(txCtx)
+	for _, e := range expected {
+		if errors.Is(err, e) {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(txCtx.Msg), err.Error()), nil, nil
+		}
+	}
+	return opMsg, futureOps, err
+}
+
+// RandomParams returns valid params that take TestUSD and USDC, split the
+// dev allocation between up to three of accs and vest it within hours. Each
+// fair-launch limit is enabled now and then.
+func RandomParams(r *rand.Rand, accs []simtypes.Account) types.Params {
+	params := types.DefaultParams()
+	params.PurchaseDenom = types.TestUSDDenom
+	params.PriceIncrement = math.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 5, 21)), 4)
+	params.DevRecipients = randomDevRecipients(r, accs)
+	params.DevVestingCliff = time.Duration(r.Intn(60)) * time.Minute
+	params.DevVestingDuration = params.DevVestingCliff + time.Duration(simtypes.RandIntBetween(r, 1, 13))*time.Hour
+	params.PurchaseDenoms = []types.PurchaseDenom{
+		{Denom: USDCDenom, Source: types.PRICE_SOURCE_AUTHORITY_RATE},
+	}
+
+	if r.Intn(4) == 0 {
+		params.PurchaseLimits.MaxSpendPerAddressPerSegment = math.NewInt(int64(simtypes.RandIntBetween(r, 100, 10_001))).MulRaw(1_000_000)
+	}
+	if r.Intn(4) == 0 {
+		params.PurchaseLimits.MaxSegmentsPerBlock = uint64(simtypes.RandIntBetween(r, 1, 6))
+	}
+	if r.Intn(5) == 0 && len(accs) > 0 {
+		params.PurchaseLimits.AllowListUntilSegment = uint64(simtypes.RandIntBetween(r, 1, 4))
+		for _, acc := range accs {
+			if r.Intn(2) == 0 {
+				params.PurchaseLimits.AllowList = append(params.PurchaseLimits.AllowList, acc.Address.String())
+			}
+		}
+		if len(params.PurchaseLimits.AllowList) == 0 {
+			params.PurchaseLimits.AllowList = []string{accs[0].Address.String()}
+		}
+	}
+	return params
+}
+
+// randomDevRecipients returns one to three distinct accounts with weights
+// that sum to 1.
+func randomDevRecipients(r *rand.Rand, accs []simtypes.Account) []types.DevRecipient {
+	if len(accs) == 0 {
+		return types.DefaultDevRecipients()
+	}
+
+	n := simtypes.RandIntBetween(r, 1, 4)
+	if n > len(accs) {
+		n = len(accs)
+	}
+	recipients := make([]types.DevRecipient, 0, n)
+	remaining := math.LegacyOneDec()
+	for _, i := range r.Perm(len(accs))[:n] {
+		weight := remaining
+		if len(recipients) < n-1 {
+			weight = math.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 4)), 1)
+		}
+		remaining = remaining.Sub(weight)
+		recipients = append(recipients, types.DevRecipient{
+			Address: accs[i].Address.String(),
+			Weight:  weight,
+			Label:   "dev",
+		})
+	}
+	return recipients
+}
+
+// randomUSDCRate returns a USDC rate in TestUSD between 0.97 and 1.01.
+func randomUSDCRate(r *rand.Rand) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 97, 102)), 2)
+}
+
+// RandomPurchaseRate returns a rate for the USDC purchase denom set at now.
+func RandomPurchaseRate(r *rand.Rand, now time.Time) types.PurchaseRate {
+	return types.PurchaseRate{
+		Denom:     USDCDenom,
+		Rate:      randomUSDCRate(r),
+		UpdatedAt: now,
+	}
+}
+
+// randomHolder returns a random account among accs with a spendable balance
+// of denom.
+func randomHolder(r *rand.Rand, ctx sdk.Context, bk types.BankKeeper, accs []simtypes.Account, denom string) (simtypes.Account, bool) {
+	var holders []simtypes.Account
+	for _, acc := range accs {
+		if bk.SpendableCoins(ctx, acc.Address).AmountOf(denom).IsPositive() {
+			holders = append(holders, acc)
+		}
+	}
+	if len(holders) == 0 {
+		return simtypes.Account{}, false
+	}
+	return holders[r.Intn(len(holders))], true
+}
@@ -0,0 +1,43 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"mychain/x/maincoin/keeper"
+	"mychain/x/maincoin/types"
+)
+
+// SimulateMsgUpdateParams returns a governance proposal message that sets
+// random params.
+func SimulateMsgUpdateParams(ak types.AuthKeeper, k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, _ sdk.Context, accs []simtypes.Account) sdk.Msg {
+		return &types.MsgUpdateParams{
+			Authority: authority(ak, k),
+			Params:    RandomParams(r, accs),
+		}
+	}
+}
+
+// SimulateMsgSetPurchaseRate returns a governance proposal message that sets
+// a new USDC purchase rate.
+func SimulateMsgSetPurchaseRate(ak types.AuthKeeper, k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) sdk.Msg {
+		return &types.MsgSetPurchaseRate{
+			Authority: authority(ak, k),
+			Denom:     USDCDenom,
+			Rate:      randomUSDCRate(r),
+		}
+	}
+}
+
+// authority returns the address of the module authority.
+func authority(ak types.AuthKeeper, k keeper.Keeper) string {
+	addr, err := ak.AddressCodec().BytesToString(k.GetAuthority())
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 
 	"mychain/x/maincoin/keeper"
 	"mychain/x/maincoin/types"
@@ -20,13 +21,54 @@ func SimulateMsgSellMaincoin(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgSellMaincoin{
-			Seller: simAccount.Address.String(),
+		msg := &types.MsgSellMaincoin{}
+		simAccount, found := randomHolder(r, ctx, bk, accs, types.MainCoinDenom)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no account holds maincoin"), nil, nil
 		}
+		msg.Seller = simAccount.Address.String()
 
-		// TODO: Handle the SellMaincoin simulation
+		spendable := bk.SpendableCoins(ctx, simAccount.Address).AmountOf(types.MainCoinDenom)
+		if !spendable.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "seller holds no maincoin"), nil, nil
+		}
+		amount, err := simtypes.RandPositiveInt(r, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to pick an amount"), nil, err
+		}
+		msg.Amount = sdk.NewCoin(types.MainCoinDenom, amount)
+		// ValidateBasic only accepts the umaincoin denom, which nothing holds
+		if err := msg.ValidateBasic(); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), err.Error()), nil, nil
+		}
+
+		// The refund is paid out of the reserve holdings
+		currentPrice, err := k.CurrentPrice.Get(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to read current price"), nil, err
+		}
+		reserve, err := k.ReserveBalance.Get(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to read reserve"), nil, err
+		}
+		refund := currentPrice.MulInt(amount).TruncateInt()
+		if refund.GT(reserve) {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "reserve cannot pay the refund"), nil, nil
+		}
 
-		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "SellMaincoin simulation not implemented"), nil, nil
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(msg.Amount),
+		}
+		return deliverTx(txCtx)
 	}
 }
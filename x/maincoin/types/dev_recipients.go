@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultDevRecipients returns the default dev recipients: the dev address receives everything.
+func DefaultDevRecipients() []DevRecipient {
+	return []DevRecipient{
+		{
+			Address: DefaultDevAddress,
+			Weight:  math.LegacyOneDec(),
+			Label:   "dev",
+		},
+	}
+}
+
+// ValidateDevRecipients checks that the recipients have valid, unique addresses and
+// positive weights that sum to exactly 1.
+func ValidateDevRecipients(recipients []DevRecipient) error {
+	if len(recipients) == 0 {
+		return fmt.Errorf("at least one dev recipient is required")
+	}
+
+	seen := make(map[string]bool, len(recipients))
+	totalWeight := math.LegacyZeroDec()
+	for _, recipient := range recipients {
+		if _, err := sdk.AccAddressFromBech32(recipient.Address); err != nil {
+			return fmt.Errorf("invalid dev recipient address %s: %w", recipient.Address, err)
+		}
+		if seen[recipient.Address] {
+			return fmt.Errorf("duplicate dev recipient %s", recipient.Address)
+		}
+		seen[recipient.Address] = true
+
+		if recipient.Weight.IsNil() || !recipient.Weight.IsPositive() {
+			return fmt.Errorf("dev recipient %s weight must be positive", recipient.Address)
+		}
+		totalWeight = totalWeight.Add(recipient.Weight)
+	}
+
+	if !totalWeight.Equal(math.LegacyOneDec()) {
+		return fmt.Errorf("dev recipient weights must sum to 1, got %s", totalWeight)
+	}
+	return nil
+}
+
+// SplitDevAllocation divides amount between the recipients by weight. Each share is
+// rounded down and the rounding dust goes to the first recipient.
+func SplitDevAllocation(recipients []DevRecipient, amount math.Int) []DevRecipientAmount {
+	if len(recipients) == 0 {
+		return nil
+	}
+
+	split := make([]DevRecipientAmount, len(recipients))
+	distributed := math.ZeroInt()
+	for i, recipient := range recipients {
+		share := recipient.Weight.MulInt(amount).TruncateInt()
+		split[i] = DevRecipientAmount{Address: recipient.Address, Amount: share}
+		distributed = distributed.Add(share)
+	}
+	split[0].Amount = split[0].Amount.Add(amount.Sub(distributed))
+
+	return split
+}
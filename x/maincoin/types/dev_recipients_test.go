@@ -0,0 +1,127 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"mychain/x/maincoin/types"
+)
+
+func testAddr(b byte) string {
+	return sdk.AccAddress([]byte{b, b, b, b, b, b, b, b, b, b, b, b, b, b, b, b, b, b, b, b}).String()
+}
+
+func TestValidateDevRecipients(t *testing.T) {
+	a, b := testAddr(1), testAddr(2)
+
+	tests := []struct {
+		desc       string
+		recipients []types.DevRecipient
+		valid      bool
+	}{
+		{
+			desc:       "default",
+			recipients: types.DefaultDevRecipients(),
+			valid:      true,
+		},
+		{
+			desc: "weighted",
+			recipients: []types.DevRecipient{
+				{Address: a, Weight: math.LegacyMustNewDecFromStr("0.7"), Label: "core"},
+				{Address: b, Weight: math.LegacyMustNewDecFromStr("0.3"), Label: "grants"},
+			},
+			valid: true,
+		},
+		{
+			desc:       "empty",
+			recipients: nil,
+		},
+		{
+			desc: "weights below one",
+			recipients: []types.DevRecipient{
+				{Address: a, Weight: math.LegacyMustNewDecFromStr("0.5")},
+				{Address: b, Weight: math.LegacyMustNewDecFromStr("0.4")},
+			},
+		},
+		{
+			desc: "zero weight",
+			recipients: []types.DevRecipient{
+				{Address: a, Weight: math.LegacyOneDec()},
+				{Address: b, Weight: math.LegacyZeroDec()},
+			},
+		},
+		{
+			desc: "duplicate address",
+			recipients: []types.DevRecipient{
+				{Address: a, Weight: math.LegacyMustNewDecFromStr("0.5")},
+				{Address: a, Weight: math.LegacyMustNewDecFromStr("0.5")},
+			},
+		},
+		{
+			desc: "invalid address",
+			recipients: []types.DevRecipient{
+				{Address: "invalid", Weight: math.LegacyOneDec()},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := types.ValidateDevRecipients(tc.recipients)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestSplitDevAllocation(t *testing.T) {
+	a, b, c := testAddr(1), testAddr(2), testAddr(3)
+	third := math.LegacyMustNewDecFromStr("0.333333333333333333")
+	recipients := []types.DevRecipient{
+		{Address: a, Weight: math.LegacyOneDec().Sub(third).Sub(third)},
+		{Address: b, Weight: third},
+		{Address: c, Weight: third},
+	}
+
+	split := types.SplitDevAllocation(recipients, math.NewInt(100))
+	require.Len(t, split, 3)
+	require.Equal(t, math.NewInt(34), split[0].Amount)
+	require.Equal(t, math.NewInt(33), split[1].Amount)
+	require.Equal(t, math.NewInt(33), split[2].Amount)
+
+	total := math.ZeroInt()
+	for _, s := range split {
+		total = total.Add(s.Amount)
+	}
+	require.Equal(t, math.NewInt(100), total)
+}
+
+func TestDevVestingTranche_ClaimShares(t *testing.T) {
+	a, b := testAddr(1), testAddr(2)
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	tranche := types.NewDevVestingTranche(1, math.ZeroInt(), start, 0, 100*day)
+	tranche.AddShares([]types.DevRecipientAmount{
+		{Address: a, Amount: math.NewInt(600)},
+		{Address: b, Amount: math.NewInt(400)},
+	})
+	require.Equal(t, math.NewInt(1000), tranche.Amount)
+	require.NoError(t, tranche.Validate())
+
+	now := start.Add(50 * day)
+	require.Equal(t, math.NewInt(500), tranche.ClaimableAt(now))
+	require.Equal(t, math.NewInt(300), tranche.Claim(a, now))
+	require.True(t, tranche.Claim(a, now).IsZero())
+	require.Equal(t, math.NewInt(200), tranche.ClaimableAt(now))
+	require.True(t, tranche.Claim(testAddr(3), now).IsZero())
+
+	require.Equal(t, math.NewInt(300), tranche.Claimed)
+	require.NoError(t, tranche.Validate())
+}
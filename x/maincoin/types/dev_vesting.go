@@ -0,0 +1,148 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// NewDevVestingTranche creates a tranche for the dev allocation distributed at the
+// start of a segment, vesting according to the given cliff and linear duration.
+func NewDevVestingTranche(segmentNumber uint64, amount math.Int, start time.Time, cliff, duration time.Duration) DevVestingTranche {
+	return DevVestingTranche{
+		SegmentNumber: segmentNumber,
+		Amount:        amount,
+		Claimed:       math.ZeroInt(),
+		StartTime:     start,
+		CliffTime:     start.Add(cliff),
+		EndTime:       start.Add(duration),
+	}
+}
+
+// VestedAt returns the portion of the tranche that has vested at the given time.
+// Nothing vests before the cliff; afterwards the amount vests linearly from the
+// start time until the end time.
+func (t DevVestingTranche) VestedAt(now time.Time) math.Int {
+	return t.vestedOf(t.Amount, now)
+}
+
+// vestedOf applies the tranche's vesting schedule to amount.
+func (t DevVestingTranche) vestedOf(amount math.Int, now time.Time) math.Int {
+	if now.Before(t.CliffTime) {
+		return math.ZeroInt()
+	}
+	if !now.Before(t.EndTime) {
+		return amount
+	}
+
+	elapsed := now.Sub(t.StartTime)
+	total := t.EndTime.Sub(t.StartTime)
+	return amount.Mul(math.NewInt(int64(elapsed))).Quo(math.NewInt(int64(total)))
+}
+
+// AddShares adds each recipient amount to that recipient's share of the tranche.
+func (t *DevVestingTranche) AddShares(amounts []DevRecipientAmount) {
+	for _, amount := range amounts {
+		if !amount.Amount.IsPositive() {
+			continue
+		}
+
+		found := false
+		for i := range t.Shares {
+			if t.Shares[i].Address == amount.Address {
+				t.Shares[i].Amount = t.Shares[i].Amount.Add(amount.Amount)
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Shares = append(t.Shares, DevVestingShare{
+				Address: amount.Address,
+				Amount:  amount.Amount,
+				Claimed: math.ZeroInt(),
+			})
+		}
+		t.Amount = t.Amount.Add(amount.Amount)
+	}
+}
+
+// Claim marks the vested, unclaimed part of address's share as claimed and returns it.
+func (t *DevVestingTranche) Claim(address string, now time.Time) math.Int {
+	for i := range t.Shares {
+		share := &t.Shares[i]
+		if share.Address != address {
+			continue
+		}
+
+		claimable := t.vestedOf(share.Amount, now).Sub(share.Claimed)
+		if !claimable.IsPositive() {
+			return math.ZeroInt()
+		}
+		share.Claimed = share.Claimed.Add(claimable)
+		t.Claimed = t.Claimed.Add(claimable)
+		return claimable
+	}
+	return math.ZeroInt()
+}
+
+// ClaimableAt returns the vested amount that has not been claimed yet.
+func (t DevVestingTranche) ClaimableAt(now time.Time) math.Int {
+	if len(t.Shares) == 0 {
+		claimable := t.VestedAt(now).Sub(t.Claimed)
+		if claimable.IsNegative() {
+			return math.ZeroInt()
+		}
+		return claimable
+	}
+
+	total := math.ZeroInt()
+	for _, share := range t.Shares {
+		claimable := t.vestedOf(share.Amount, now).Sub(share.Claimed)
+		if claimable.IsPositive() {
+			total = total.Add(claimable)
+		}
+	}
+	return total
+}
+
+// Validate performs basic validation of a vesting tranche.
+func (t DevVestingTranche) Validate() error {
+	if t.Amount.IsNil() || t.Amount.IsNegative() {
+		return fmt.Errorf("tranche %d: amount must be non-negative", t.SegmentNumber)
+	}
+	if t.Claimed.IsNil() || t.Claimed.IsNegative() {
+		return fmt.Errorf("tranche %d: claimed must be non-negative", t.SegmentNumber)
+	}
+	if t.Claimed.GT(t.Amount) {
+		return fmt.Errorf("tranche %d: claimed %s exceeds amount %s", t.SegmentNumber, t.Claimed, t.Amount)
+	}
+	if t.CliffTime.Before(t.StartTime) || t.EndTime.Before(t.CliffTime) {
+		return fmt.Errorf("tranche %d: expected start <= cliff <= end", t.SegmentNumber)
+	}
+
+	shareAmount := math.ZeroInt()
+	shareClaimed := math.ZeroInt()
+	for _, share := range t.Shares {
+		if share.Amount.IsNil() || share.Claimed.IsNil() || share.Claimed.IsNegative() || share.Claimed.GT(share.Amount) {
+			return fmt.Errorf("tranche %d: invalid share for %s", t.SegmentNumber, share.Address)
+		}
+		shareAmount = shareAmount.Add(share.Amount)
+		shareClaimed = shareClaimed.Add(share.Claimed)
+	}
+	if len(t.Shares) > 0 && (!shareAmount.Equal(t.Amount) || !shareClaimed.Equal(t.Claimed)) {
+		return fmt.Errorf("tranche %d: shares do not add up to the tranche totals", t.SegmentNumber)
+	}
+	return nil
+}
+
+// StatusAt reports the vested, unvested and claimable amounts of the tranche at the given time.
+func (t DevVestingTranche) StatusAt(now time.Time) DevVestingTrancheStatus {
+	vested := t.VestedAt(now)
+	return DevVestingTrancheStatus{
+		Tranche:   t,
+		Vested:    vested,
+		Unvested:  t.Amount.Sub(vested),
+		Claimable: t.ClaimableAt(now),
+	}
+}
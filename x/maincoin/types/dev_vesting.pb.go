@@ -0,0 +1,1223 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: mychain/maincoin/v1/dev_vesting.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DevVestingTranche holds the dev allocation distributed at the start of a segment
+// until it vests and is claimed by the dev recipients
+type DevVestingTranche struct {
+	// segment_number is the segment at whose start the allocation was distributed
+	SegmentNumber uint64 `protobuf:"varint,1,opt,name=segment_number,json=segmentNumber,proto3" json:"segment_number,omitempty"`
+	// amount is the total umc locked in this tranche
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// claimed is the umc already released to the dev recipients
+	Claimed cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=claimed,proto3,customtype=cosmossdk.io/math.Int" json:"claimed"`
+	// start_time is when the tranche was created and linear vesting begins
+	StartTime time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// cliff_time is the time before which nothing is vested
+	CliffTime time.Time `protobuf:"bytes,5,opt,name=cliff_time,json=cliffTime,proto3,stdtime" json:"cliff_time"`
+	// end_time is the time at which the tranche is fully vested
+	EndTime time.Time `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// shares split the tranche between the dev recipients; amount and claimed are their totals
+	Shares []DevVestingShare `protobuf:"bytes,7,rep,name=shares,proto3" json:"shares"`
+}
+
+func (m *DevVestingTranche) Reset()         { *m = DevVestingTranche{} }
+func (m *DevVestingTranche) String() string { return proto.CompactTextString(m) }
+func (*DevVestingTranche) ProtoMessage()    {}
+func (*DevVestingTranche) Descriptor() ([]byte, []int) {
+	return fileDescriptor_987a9226690a0d5c, []int{0}
+}
+func (m *DevVestingTranche) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DevVestingTranche) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DevVestingTranche.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DevVestingTranche) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DevVestingTranche.Merge(m, src)
+}
+func (m *DevVestingTranche) XXX_Size() int {
+	return m.Size()
+}
+func (m *DevVestingTranche) XXX_DiscardUnknown() {
+	xxx_messageInfo_DevVestingTranche.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DevVestingTranche proto.InternalMessageInfo
+
+func (m *DevVestingTranche) GetSegmentNumber() uint64 {
+	if m != nil {
+		return m.SegmentNumber
+	}
+	return 0
+}
+
+func (m *DevVestingTranche) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *DevVestingTranche) GetCliffTime() time.Time {
+	if m != nil {
+		return m.CliffTime
+	}
+	return time.Time{}
+}
+
+func (m *DevVestingTranche) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *DevVestingTranche) GetShares() []DevVestingShare {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+// DevVestingShare is a single recipient's part of a vesting tranche
+type DevVestingShare struct {
+	Address string                `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Claimed cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=claimed,proto3,customtype=cosmossdk.io/math.Int" json:"claimed"`
+}
+
+func (m *DevVestingShare) Reset()         { *m = DevVestingShare{} }
+func (m *DevVestingShare) String() string { return proto.CompactTextString(m) }
+func (*DevVestingShare) ProtoMessage()    {}
+func (*DevVestingShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_987a9226690a0d5c, []int{1}
+}
+func (m *DevVestingShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DevVestingShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DevVestingShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DevVestingShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DevVestingShare.Merge(m, src)
+}
+func (m *DevVestingShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *DevVestingShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_DevVestingShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DevVestingShare proto.InternalMessageInfo
+
+func (m *DevVestingShare) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// DevVestingTrancheStatus reports the vesting progress of a tranche at the current block time
+type DevVestingTrancheStatus struct {
+	Tranche   DevVestingTranche     `protobuf:"bytes,1,opt,name=tranche,proto3" json:"tranche"`
+	Vested    cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=vested,proto3,customtype=cosmossdk.io/math.Int" json:"vested"`
+	Unvested  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=unvested,proto3,customtype=cosmossdk.io/math.Int" json:"unvested"`
+	Claimable cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=claimable,proto3,customtype=cosmossdk.io/math.Int" json:"claimable"`
+}
+
+func (m *DevVestingTrancheStatus) Reset()         { *m = DevVestingTrancheStatus{} }
+func (m *DevVestingTrancheStatus) String() string { return proto.CompactTextString(m) }
+func (*DevVestingTrancheStatus) ProtoMessage()    {}
+func (*DevVestingTrancheStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_987a9226690a0d5c, []int{2}
+}
+func (m *DevVestingTrancheStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DevVestingTrancheStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DevVestingTrancheStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DevVestingTrancheStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DevVestingTrancheStatus.Merge(m, src)
+}
+func (m *DevVestingTrancheStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *DevVestingTrancheStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DevVestingTrancheStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DevVestingTrancheStatus proto.InternalMessageInfo
+
+func (m *DevVestingTrancheStatus) GetTranche() DevVestingTranche {
+	if m != nil {
+		return m.Tranche
+	}
+	return DevVestingTranche{}
+}
+
+func init() {
+	proto.RegisterType((*DevVestingTranche)(nil), "mychain.maincoin.v1.DevVestingTranche")
+	proto.RegisterType((*DevVestingShare)(nil), "mychain.maincoin.v1.DevVestingShare")
+	proto.RegisterType((*DevVestingTrancheStatus)(nil), "mychain.maincoin.v1.DevVestingTrancheStatus")
+}
+
+func init() {
+	proto.RegisterFile("mychain/maincoin/v1/dev_vesting.proto", fileDescriptor_987a9226690a0d5c)
+}
+
+var fileDescriptor_987a9226690a0d5c = []byte{
+	// 471 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0xc1, 0x8a, 0xd3, 0x40,
+	0x18, 0xc7, 0x3b, 0xdb, 0xda, 0xb4, 0x53, 0x54, 0x1c, 0x15, 0x43, 0xc1, 0xb4, 0x14, 0x57, 0x7a,
+	0x9a, 0xb0, 0x15, 0x11, 0xf1, 0x20, 0x44, 0x11, 0xbc, 0x78, 0xc8, 0x2e, 0x1e, 0xbc, 0x94, 0x69,
+	0x32, 0x4d, 0x07, 0x3b, 0x33, 0x25, 0x33, 0x09, 0xee, 0x5b, 0xf4, 0xee, 0x93, 0xf8, 0x06, 0x7b,
+	0xdc, 0xa3, 0x78, 0x58, 0xa5, 0x7d, 0x11, 0x99, 0x99, 0xc4, 0x82, 0x0a, 0xdb, 0x5e, 0xf6, 0x96,
+	0xf9, 0xe6, 0xf7, 0xff, 0x27, 0xff, 0x7c, 0xdf, 0x07, 0x8f, 0xf9, 0x79, 0xb2, 0x20, 0x4c, 0x84,
+	0x9c, 0x30, 0x91, 0x48, 0x26, 0xc2, 0xf2, 0x24, 0x4c, 0x69, 0x39, 0x2d, 0xa9, 0xd2, 0x4c, 0x64,
+	0x78, 0x95, 0x4b, 0x2d, 0xd1, 0xfd, 0x0a, 0xc3, 0x35, 0x86, 0xcb, 0x93, 0xfe, 0x83, 0x4c, 0x66,
+	0xd2, 0xde, 0x87, 0xe6, 0xc9, 0xa1, 0xfd, 0x41, 0x26, 0x65, 0xb6, 0xa4, 0xa1, 0x3d, 0xcd, 0x8a,
+	0x79, 0xa8, 0x19, 0xa7, 0x4a, 0x13, 0xbe, 0x72, 0xc0, 0xe8, 0x5b, 0x13, 0xde, 0x7b, 0x4b, 0xcb,
+	0x8f, 0xee, 0x05, 0x67, 0x39, 0x11, 0xc9, 0x82, 0xa2, 0x63, 0x78, 0x47, 0xd1, 0x8c, 0x53, 0xa1,
+	0xa7, 0xa2, 0xe0, 0x33, 0x9a, 0xfb, 0x60, 0x08, 0xc6, 0xad, 0xf8, 0x76, 0x55, 0xfd, 0x60, 0x8b,
+	0xe8, 0x39, 0x6c, 0x13, 0x2e, 0x0b, 0xa1, 0xfd, 0xa3, 0x21, 0x18, 0x77, 0xa3, 0xc7, 0x17, 0x57,
+	0x83, 0xc6, 0x8f, 0xab, 0xc1, 0xc3, 0x44, 0x2a, 0x2e, 0x95, 0x4a, 0x3f, 0x63, 0x26, 0x43, 0x4e,
+	0xf4, 0x02, 0xbf, 0x17, 0x3a, 0xae, 0x60, 0xf4, 0x02, 0x7a, 0xc9, 0x92, 0x30, 0x4e, 0x53, 0xbf,
+	0xb9, 0x8f, 0xae, 0xa6, 0xd1, 0x1b, 0x08, 0x95, 0x26, 0xb9, 0x9e, 0x9a, 0x14, 0x7e, 0x6b, 0x08,
+	0xc6, 0xbd, 0x49, 0x1f, 0xbb, 0x88, 0xb8, 0x8e, 0x88, 0xcf, 0xea, 0x88, 0x51, 0xc7, 0xf8, 0xae,
+	0x7f, 0x0e, 0x40, 0xdc, 0xb5, 0x3a, 0x73, 0x63, 0x4c, 0x92, 0x25, 0x9b, 0xcf, 0x9d, 0xc9, 0xad,
+	0x43, 0x4c, 0xac, 0xce, 0x9a, 0xbc, 0x86, 0x1d, 0x2a, 0x52, 0x67, 0xd1, 0x3e, 0xc0, 0xc2, 0xa3,
+	0x22, 0xb5, 0x06, 0x11, 0x6c, 0xab, 0x05, 0xc9, 0xa9, 0xf2, 0xbd, 0x61, 0x73, 0xdc, 0x9b, 0x3c,
+	0xc1, 0xff, 0x69, 0x2a, 0xde, 0x75, 0xe6, 0xd4, 0xc0, 0x51, 0xcb, 0x18, 0xc5, 0x95, 0x72, 0xf4,
+	0x15, 0xc0, 0xbb, 0x7f, 0x11, 0xc8, 0x87, 0x1e, 0x49, 0xd3, 0x9c, 0x2a, 0x65, 0x5b, 0xd6, 0x8d,
+	0xeb, 0xe3, 0x4d, 0x37, 0x6b, 0xb4, 0x3e, 0x82, 0x8f, 0xfe, 0x99, 0xac, 0x53, 0x4d, 0x74, 0xa1,
+	0xd0, 0x3b, 0xe8, 0x69, 0x57, 0xb0, 0x5f, 0xd9, 0x9b, 0x3c, 0xbd, 0x26, 0x7e, 0x25, 0xaf, 0x7e,
+	0x40, 0x2d, 0x36, 0x99, 0xcc, 0x6a, 0xd0, 0x74, 0xcf, 0x4c, 0x0e, 0x46, 0x2f, 0x61, 0xa7, 0x10,
+	0x95, 0x70, 0xaf, 0x50, 0x7f, 0x70, 0xf4, 0x0a, 0x76, 0x6d, 0x40, 0x32, 0x5b, 0xba, 0x09, 0xbc,
+	0x56, 0xbb, 0xe3, 0xa3, 0xc9, 0xc5, 0x26, 0x00, 0x97, 0x9b, 0x00, 0xfc, 0xda, 0x04, 0x60, 0xbd,
+	0x0d, 0x1a, 0x97, 0xdb, 0xa0, 0xf1, 0x7d, 0x1b, 0x34, 0x3e, 0xf9, 0xf5, 0xe6, 0x7f, 0xd9, 0xed,
+	0xbe, 0x3e, 0x5f, 0x51, 0x35, 0x6b, 0xdb, 0x79, 0x7a, 0xf6, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x0d,
+	0x5f, 0x6f, 0x4a, 0x1c, 0x04, 0x00, 0x00,
+}
+
+func (m *DevVestingTranche) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DevVestingTranche) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DevVestingTranche) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDevVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintDevVesting(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CliffTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CliffTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintDevVesting(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintDevVesting(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Claimed.Size()
+		i -= size
+		if _, err := m.Claimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDevVesting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDevVesting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.SegmentNumber != 0 {
+		i = encodeVarintDevVesting(dAtA, i, uint64(m.SegmentNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DevVestingShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DevVestingShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DevVestingShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Claimed.Size()
+		i -= size
+		if _, err := m.Claimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDevVesting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDevVesting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDevVesting(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DevVestingTrancheStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DevVestingTrancheStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DevVestingTrancheStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Claimable.Size()
+		i -= size
+		if _, err := m.Claimable.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDevVesting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Unvested.Size()
+		i -= size
+		if _, err := m.Unvested.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDevVesting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Vested.Size()
+		i -= size
+		if _, err := m.Vested.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDevVesting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Tranche.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDevVesting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDevVesting(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDevVesting(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DevVestingTranche) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SegmentNumber != 0 {
+		n += 1 + sovDevVesting(uint64(m.SegmentNumber))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovDevVesting(uint64(l))
+	l = m.Claimed.Size()
+	n += 1 + l + sovDevVesting(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovDevVesting(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CliffTime)
+	n += 1 + l + sovDevVesting(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovDevVesting(uint64(l))
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovDevVesting(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DevVestingShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDevVesting(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovDevVesting(uint64(l))
+	l = m.Claimed.Size()
+	n += 1 + l + sovDevVesting(uint64(l))
+	return n
+}
+
+func (m *DevVestingTrancheStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tranche.Size()
+	n += 1 + l + sovDevVesting(uint64(l))
+	l = m.Vested.Size()
+	n += 1 + l + sovDevVesting(uint64(l))
+	l = m.Unvested.Size()
+	n += 1 + l + sovDevVesting(uint64(l))
+	l = m.Claimable.Size()
+	n += 1 + l + sovDevVesting(uint64(l))
+	return n
+}
+
+func sovDevVesting(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDevVesting(x uint64) (n int) {
+	return sovDevVesting(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DevVestingTranche) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDevVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DevVestingTranche: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DevVestingTranche: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentNumber", wireType)
+			}
+			m.SegmentNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SegmentNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDevVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDevVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CliffTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDevVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDevVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, DevVestingShare{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDevVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDevVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DevVestingShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDevVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DevVestingShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DevVestingShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDevVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDevVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DevVestingTrancheStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDevVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DevVestingTrancheStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DevVestingTrancheStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tranche", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDevVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tranche.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Vested.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Unvested.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimable", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claimable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDevVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDevVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDevVesting(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDevVesting
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDevVesting
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDevVesting
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDevVesting
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDevVesting
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDevVesting
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDevVesting        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDevVesting          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDevVesting = fmt.Errorf("proto: unexpected end of group")
+)
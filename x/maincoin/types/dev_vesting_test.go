@@ -0,0 +1,80 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"mychain/x/maincoin/types"
+)
+
+func TestDevVestingTranche_VestedAt(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	tranche := types.NewDevVestingTranche(3, math.NewInt(1_000_000), start, 30*day, 100*day)
+
+	tests := []struct {
+		desc   string
+		now    time.Time
+		vested math.Int
+	}{
+		{desc: "at start", now: start, vested: math.ZeroInt()},
+		{desc: "before cliff", now: start.Add(29 * day), vested: math.ZeroInt()},
+		{desc: "at cliff", now: start.Add(30 * day), vested: math.NewInt(300_000)},
+		{desc: "half way", now: start.Add(50 * day), vested: math.NewInt(500_000)},
+		{desc: "at end", now: start.Add(100 * day), vested: math.NewInt(1_000_000)},
+		{desc: "after end", now: start.Add(400 * day), vested: math.NewInt(1_000_000)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, tc.vested, tranche.VestedAt(tc.now))
+		})
+	}
+}
+
+func TestDevVestingTranche_ImmediateVesting(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tranche := types.NewDevVestingTranche(1, math.NewInt(42), start, 0, 0)
+
+	require.Equal(t, math.NewInt(42), tranche.VestedAt(start))
+}
+
+func TestDevVestingTranche_StatusAt(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tranche := types.NewDevVestingTranche(1, math.NewInt(1_000), start, 0, 10*time.Hour)
+	tranche.Claimed = math.NewInt(200)
+
+	status := tranche.StatusAt(start.Add(5 * time.Hour))
+	require.Equal(t, math.NewInt(500), status.Vested)
+	require.Equal(t, math.NewInt(500), status.Unvested)
+	require.Equal(t, math.NewInt(300), status.Claimable)
+}
+
+func TestDevVestingTranche_Validate(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	valid := types.NewDevVestingTranche(1, math.NewInt(100), start, time.Hour, 2*time.Hour)
+	require.NoError(t, valid.Validate())
+
+	overClaimed := valid
+	overClaimed.Claimed = math.NewInt(101)
+	require.Error(t, overClaimed.Validate())
+
+	badSchedule := valid
+	badSchedule.EndTime = start
+	require.Error(t, badSchedule.Validate())
+}
+
+func TestParams_ValidateDevVesting(t *testing.T) {
+	params := types.DefaultParams()
+	require.NoError(t, params.Validate())
+
+	params.DevVestingCliff = params.DevVestingDuration + time.Hour
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.DevVestingDuration = -time.Hour
+	require.Error(t, params.Validate())
+}
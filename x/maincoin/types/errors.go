@@ -6,7 +6,8 @@ import (
 	"cosmossdk.io/errors"
 )
 
-// x/maincoin module sentinel errors
+// x/maincoin module sentinel errors. Released codes are never reused: 1110 was the
+// claimer-is-not-the-dev-address error and is retired.
 var (
 	ErrInvalidSigner        = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
 	ErrInvalidPrice         = errors.Register(ModuleName, 1101, "invalid price")
@@ -18,4 +19,10 @@ var (
 	ErrInvalidDenom         = errors.Register(ModuleName, 1107, "invalid denomination")
 	ErrInsufficientReserve  = errors.Register(ModuleName, 1108, "insufficient reserve")
 	ErrMaxSupplyReached     = errors.Register(ModuleName, 1109, "max supply reached")
+	ErrUnsupportedDenom     = errors.Register(ModuleName, 1111, "denom is not accepted for purchases")
+	ErrPriceUnavailable     = errors.Register(ModuleName, 1112, "purchase denom price unavailable")
+	ErrNotAllowListed       = errors.Register(ModuleName, 1113, "address is not on the purchase allow list")
+	ErrSegmentSpendLimit    = errors.Register(ModuleName, 1114, "per-address segment spend limit exceeded")
+	ErrBlockSegmentLimit    = errors.Register(ModuleName, 1115, "per-block segment limit exceeded")
+	ErrNothingToClaim       = errors.Register(ModuleName, 1116, "no vested dev allocation to claim")
 )
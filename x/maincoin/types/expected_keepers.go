@@ -2,9 +2,13 @@ package types
 
 import (
 	"context"
+	"time"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	mychaintypes "mychain/x/mychain/types"
 )
 
 // AuthKeeper defines the expected interface for the Auth module.
@@ -33,5 +37,11 @@ type ParamSubspace interface {
 
 // TransactionKeeper defines the expected interface for recording transactions
 type TransactionKeeper interface {
-	RecordTransaction(ctx context.Context, address string, txType string, description string, amount sdk.Coins, from string, to string, metadata string) error
+	RecordTransaction(ctx context.Context, record mychaintypes.TransactionRecord) error
+}
+
+// PriceAdapter supplies market prices for purchase denoms priced from x/dex
+type PriceAdapter interface {
+	// GetTWAP returns the time-weighted average price of baseDenom in quoteDenom over the trailing window
+	GetTWAP(ctx context.Context, baseDenom, quoteDenom string, window time.Duration) (math.LegacyDec, error)
 }
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	"cosmossdk.io/math"
 )
 
@@ -8,12 +10,13 @@ import (
 func DefaultGenesis() *GenesisState {
 	params := DefaultParams()
 	return &GenesisState{
-		Params:             params,
-		CurrentEpoch:       0,                    // Start at Segment 0
-		CurrentPrice:       params.InitialPrice,  // $0.0001
-		TotalSupply:        math.ZeroInt(),       // Start with 0 MC
-		ReserveBalance:     math.ZeroInt(),       // Start with $0 reserves
-		DevAllocationTotal: math.ZeroInt(),       // No dev allocation yet
+		Params:               params,
+		CurrentEpoch:         0,                   // Start at Segment 0
+		CurrentPrice:         params.InitialPrice, // $0.0001
+		TotalSupply:          math.ZeroInt(),      // Start with 0 MC
+		ReserveBalance:       math.ZeroInt(),      // Start with $0 reserves
+		DevAllocationTotal:   math.ZeroInt(),      // No dev allocation yet
+		PendingDevAllocation: math.ZeroInt(),      // No dev allocation owed
 	}
 }
 
@@ -35,10 +38,94 @@ func (gs GenesisState) Validate() error {
 	if gs.ReserveBalance.IsNegative() {
 		return ErrInvalidReserve
 	}
+
+	if err := gs.ReserveHoldings.Validate(); err != nil {
+		return fmt.Errorf("invalid reserve holdings: %w", err)
+	}
 	
 	if gs.DevAllocationTotal.IsNegative() {
 		return ErrInvalidDevAllocation
 	}
+
+	if !gs.PendingDevAllocation.IsNil() && gs.PendingDevAllocation.IsNegative() {
+		return ErrInvalidDevAllocation
+	}
+
+	seenTranches := make(map[uint64]bool, len(gs.DevVestingTranches))
+	for _, tranche := range gs.DevVestingTranches {
+		if seenTranches[tranche.SegmentNumber] {
+			return fmt.Errorf("duplicate dev vesting tranche for segment %d", tranche.SegmentNumber)
+		}
+		seenTranches[tranche.SegmentNumber] = true
+
+		if err := tranche.Validate(); err != nil {
+			return err
+		}
+	}
+
+	seenRates := make(map[string]bool, len(gs.PurchaseRates))
+	for _, rate := range gs.PurchaseRates {
+		if seenRates[rate.Denom] {
+			return fmt.Errorf("duplicate purchase rate for %s", rate.Denom)
+		}
+		seenRates[rate.Denom] = true
+
+		if err := rate.Validate(); err != nil {
+			return err
+		}
+	}
+
+	seenRecords := make(map[uint64]bool, len(gs.PurchaseRecords))
+	for _, record := range gs.PurchaseRecords {
+		if seenRecords[record.Id] {
+			return fmt.Errorf("duplicate purchase record %d", record.Id)
+		}
+		seenRecords[record.Id] = true
+
+		if record.Id >= gs.PurchaseRecordSeq {
+			return fmt.Errorf("purchase record %d is not below the record sequence %d", record.Id, gs.PurchaseRecordSeq)
+		}
+		if record.Buyer == "" {
+			return fmt.Errorf("purchase record %d has no buyer", record.Id)
+		}
+	}
+
+	seenSegments := make(map[uint64]bool, len(gs.SegmentHistories))
+	for _, history := range gs.SegmentHistories {
+		if seenSegments[history.SegmentNumber] {
+			return fmt.Errorf("duplicate segment history %d", history.SegmentNumber)
+		}
+		seenSegments[history.SegmentNumber] = true
+	}
+
+	seenUsers := make(map[string]bool, len(gs.UserHistories))
+	for _, history := range gs.UserHistories {
+		if seenUsers[history.Address] {
+			return fmt.Errorf("duplicate user history %s", history.Address)
+		}
+		seenUsers[history.Address] = true
+	}
+
+	seenSpends := make(map[string]bool, len(gs.SegmentSpends))
+	for _, spend := range gs.SegmentSpends {
+		key := fmt.Sprintf("%d/%s", spend.SegmentNumber, spend.Buyer)
+		if seenSpends[key] {
+			return fmt.Errorf("duplicate segment spend %s", key)
+		}
+		seenSpends[key] = true
+
+		if spend.Amount.IsNil() || spend.Amount.IsNegative() {
+			return fmt.Errorf("invalid segment spend %s", key)
+		}
+	}
+
+	seenCompleted := make(map[uint64]bool, len(gs.CompletedSegments))
+	for _, entry := range gs.CompletedSegments {
+		if seenCompleted[entry.SegmentNumber] {
+			return fmt.Errorf("duplicate completed segment %d", entry.SegmentNumber)
+		}
+		seenCompleted[entry.SegmentNumber] = true
+	}
 	
 	return nil
 }
@@ -6,6 +6,8 @@ package types
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -41,6 +43,25 @@ type GenesisState struct {
 	DevAllocationTotal cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=dev_allocation_total,json=devAllocationTotal,proto3,customtype=cosmossdk.io/math.Int" json:"dev_allocation_total"`
 	// pending_dev_allocation is the dev allocation pending for next segment
 	PendingDevAllocation cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=pending_dev_allocation,json=pendingDevAllocation,proto3,customtype=cosmossdk.io/math.Int" json:"pending_dev_allocation"`
+	// dev_vesting_tranches are the dev allocation tranches that are still tracked
+	DevVestingTranches []DevVestingTranche `protobuf:"bytes,8,rep,name=dev_vesting_tranches,json=devVestingTranches,proto3" json:"dev_vesting_tranches"`
+	// purchase_rates are the authority-set rates of purchase denoms
+	PurchaseRates []PurchaseRate `protobuf:"bytes,9,rep,name=purchase_rates,json=purchaseRates,proto3" json:"purchase_rates"`
+	// reserve_holdings are the coins backing reserve_balance, per purchase denom
+	ReserveHoldings github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=reserve_holdings,json=reserveHoldings,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reserve_holdings"`
+	// purchase_records are the retained purchase records, with their ids
+	PurchaseRecords []SegmentPurchaseRecord `protobuf:"bytes,11,rep,name=purchase_records,json=purchaseRecords,proto3" json:"purchase_records"`
+	// purchase_record_seq is the id of the next purchase record
+	PurchaseRecordSeq uint64 `protobuf:"varint,12,opt,name=purchase_record_seq,json=purchaseRecordSeq,proto3" json:"purchase_record_seq,omitempty"`
+	// segment_histories are the running totals of the segments
+	SegmentHistories []SegmentHistory `protobuf:"bytes,13,rep,name=segment_histories,json=segmentHistories,proto3" json:"segment_histories"`
+	// user_histories are the running totals of the buyers
+	UserHistories []UserPurchaseHistory `protobuf:"bytes,14,rep,name=user_histories,json=userHistories,proto3" json:"user_histories"`
+	// segment_spends are what each buyer spent in the segments the purchase
+	// limits still track
+	SegmentSpends []SegmentSpend `protobuf:"bytes,15,rep,name=segment_spends,json=segmentSpends,proto3" json:"segment_spends"`
+	// completed_segments are the summaries of the completed segments
+	CompletedSegments []SegmentHistoryEntry `protobuf:"bytes,16,rep,name=completed_segments,json=completedSegments,proto3" json:"completed_segments"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -90,40 +111,182 @@ func (m *GenesisState) GetCurrentEpoch() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetDevVestingTranches() []DevVestingTranche {
+	if m != nil {
+		return m.DevVestingTranches
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPurchaseRates() []PurchaseRate {
+	if m != nil {
+		return m.PurchaseRates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReserveHoldings() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ReserveHoldings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPurchaseRecords() []SegmentPurchaseRecord {
+	if m != nil {
+		return m.PurchaseRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPurchaseRecordSeq() uint64 {
+	if m != nil {
+		return m.PurchaseRecordSeq
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSegmentHistories() []SegmentHistory {
+	if m != nil {
+		return m.SegmentHistories
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUserHistories() []UserPurchaseHistory {
+	if m != nil {
+		return m.UserHistories
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSegmentSpends() []SegmentSpend {
+	if m != nil {
+		return m.SegmentSpends
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCompletedSegments() []SegmentHistoryEntry {
+	if m != nil {
+		return m.CompletedSegments
+	}
+	return nil
+}
+
+// SegmentSpend is the TestUSD value a buyer spent in a segment.
+type SegmentSpend struct {
+	SegmentNumber uint64                `protobuf:"varint,1,opt,name=segment_number,json=segmentNumber,proto3" json:"segment_number,omitempty"`
+	Buyer         string                `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	Amount        cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *SegmentSpend) Reset()         { *m = SegmentSpend{} }
+func (m *SegmentSpend) String() string { return proto.CompactTextString(m) }
+func (*SegmentSpend) ProtoMessage()    {}
+func (*SegmentSpend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8c516b42a8e3925, []int{1}
+}
+func (m *SegmentSpend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SegmentSpend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SegmentSpend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SegmentSpend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentSpend.Merge(m, src)
+}
+func (m *SegmentSpend) XXX_Size() int {
+	return m.Size()
+}
+func (m *SegmentSpend) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentSpend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentSpend proto.InternalMessageInfo
+
+func (m *SegmentSpend) GetSegmentNumber() uint64 {
+	if m != nil {
+		return m.SegmentNumber
+	}
+	return 0
+}
+
+func (m *SegmentSpend) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "mychain.maincoin.v1.GenesisState")
+	proto.RegisterType((*SegmentSpend)(nil), "mychain.maincoin.v1.SegmentSpend")
 }
 
 func init() { proto.RegisterFile("mychain/maincoin/v1/genesis.proto", fileDescriptor_d8c516b42a8e3925) }
 
 var fileDescriptor_d8c516b42a8e3925 = []byte{
-	// 402 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x3f, 0x6f, 0xd4, 0x30,
-	0x18, 0xc6, 0x63, 0x5a, 0x0e, 0xd5, 0x3d, 0x40, 0x98, 0x03, 0x59, 0xad, 0x48, 0x03, 0x5d, 0x4e,
-	0x0c, 0x89, 0x5a, 0x76, 0x04, 0x51, 0xf9, 0x27, 0x21, 0x51, 0xdd, 0x31, 0xb1, 0x44, 0xae, 0xf3,
-	0x2a, 0x67, 0x91, 0xd8, 0x56, 0xec, 0x46, 0xe4, 0x5b, 0xf0, 0x31, 0x18, 0xf9, 0x18, 0x1d, 0x3b,
-	0x22, 0x86, 0x0a, 0xdd, 0x0d, 0x4c, 0x7c, 0x07, 0x14, 0xc7, 0xed, 0x81, 0x7a, 0xc3, 0x2d, 0xd6,
-	0xab, 0x57, 0xcf, 0xef, 0xf7, 0x7a, 0x78, 0xf0, 0xe3, 0xaa, 0xe5, 0x33, 0x26, 0x64, 0x52, 0x31,
-	0x21, 0xb9, 0x12, 0x32, 0x69, 0x0e, 0x92, 0x02, 0x24, 0x18, 0x61, 0x62, 0x5d, 0x2b, 0xab, 0xc8,
-	0x7d, 0x1f, 0x89, 0x2f, 0x23, 0x71, 0x73, 0xb0, 0x73, 0x8f, 0x55, 0x42, 0xaa, 0xc4, 0xbd, 0x7d,
-	0x6e, 0x67, 0x54, 0xa8, 0x42, 0xb9, 0x31, 0xe9, 0x26, 0xbf, 0x8d, 0x56, 0x1d, 0xd0, 0xac, 0x66,
-	0x95, 0xf7, 0x3f, 0xf9, 0xb3, 0x81, 0x87, 0x6f, 0xfa, 0x8b, 0x53, 0xcb, 0x2c, 0x90, 0xe7, 0x78,
-	0xd0, 0x07, 0x28, 0x8a, 0xd0, 0x78, 0xfb, 0x70, 0x37, 0x5e, 0xf1, 0x83, 0xf8, 0xd8, 0x45, 0xd2,
-	0xad, 0xb3, 0x8b, 0xbd, 0xe0, 0xdb, 0xef, 0xef, 0x4f, 0xd1, 0xc4, 0x53, 0x64, 0x1f, 0xdf, 0xe6,
-	0xa7, 0x75, 0x0d, 0xd2, 0x66, 0xa0, 0x15, 0x9f, 0xd1, 0x1b, 0x11, 0x1a, 0x6f, 0x4e, 0x86, 0x7e,
-	0xf9, 0xaa, 0xdb, 0x91, 0xb7, 0xcb, 0x90, 0xae, 0x05, 0x07, 0xba, 0x11, 0xa1, 0xf1, 0x56, 0xba,
-	0xdf, 0xe9, 0x7e, 0x5e, 0xec, 0xed, 0x72, 0x65, 0x2a, 0x65, 0x4c, 0xfe, 0x39, 0x16, 0x2a, 0xa9,
-	0x98, 0x9d, 0xc5, 0xef, 0xa1, 0x60, 0xbc, 0x3d, 0x02, 0x7e, 0x65, 0x3a, 0xee, 0x40, 0xf2, 0x02,
-	0x0f, 0xad, 0xb2, 0xac, 0xcc, 0xcc, 0xa9, 0xd6, 0x65, 0x4b, 0x37, 0x9d, 0xe8, 0x91, 0x17, 0x3d,
-	0xb8, 0x2e, 0x7a, 0x27, 0xed, 0x64, 0xdb, 0x21, 0x53, 0x47, 0x90, 0xd7, 0xf8, 0x6e, 0x0d, 0x06,
-	0xea, 0x06, 0xb2, 0x13, 0x56, 0x32, 0xc9, 0x81, 0xde, 0x5c, 0x47, 0x72, 0xc7, 0x53, 0x69, 0x0f,
-	0x91, 0x0f, 0x78, 0x94, 0x43, 0x93, 0xb1, 0xb2, 0x54, 0x9c, 0x59, 0xa1, 0x64, 0xe6, 0xae, 0xd0,
-	0xc1, 0x3a, 0x32, 0x92, 0x43, 0xf3, 0xf2, 0x8a, 0xfc, 0xd8, 0x81, 0x64, 0x8a, 0x1f, 0x6a, 0x90,
-	0xb9, 0x90, 0x45, 0xf6, 0xbf, 0x98, 0xde, 0x5a, 0x47, 0x39, 0xf2, 0xf0, 0xd1, 0xbf, 0xe6, 0xf4,
-	0xf0, 0x6c, 0x1e, 0xa2, 0xf3, 0x79, 0x88, 0x7e, 0xcd, 0x43, 0xf4, 0x75, 0x11, 0x06, 0xe7, 0x8b,
-	0x30, 0xf8, 0xb1, 0x08, 0x83, 0x4f, 0xf4, 0xb2, 0x2b, 0x5f, 0x96, 0x6d, 0xb1, 0xad, 0x06, 0x73,
-	0x32, 0x70, 0x55, 0x79, 0xf6, 0x37, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x6e, 0xc8, 0xec, 0xaf, 0x02,
-	0x00, 0x00,
+	// 790 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0x6b, 0xda, 0x2d, 0x74, 0x9a, 0xa6, 0xcd, 0x6c, 0x40, 0x43, 0x57, 0xa4, 0xd9, 0xad,
+	0x16, 0xc2, 0x4a, 0xd8, 0xa4, 0x88, 0x2b, 0x82, 0xd0, 0x85, 0x22, 0xa1, 0xb2, 0x4a, 0x76, 0xf7,
+	0x00, 0x02, 0x6b, 0xe2, 0x3c, 0xd9, 0xd6, 0xc6, 0x33, 0xde, 0x79, 0x63, 0x8b, 0xdc, 0x10, 0x9f,
+	0x80, 0x8f, 0x81, 0x38, 0xf1, 0x31, 0xf6, 0xb8, 0x47, 0xc4, 0x61, 0x41, 0xed, 0x81, 0xaf, 0x81,
+	0x3c, 0x1e, 0x27, 0x31, 0x58, 0x55, 0x2e, 0xad, 0xf3, 0xfc, 0x7f, 0xbf, 0xf7, 0x7f, 0x9e, 0xf7,
+	0x86, 0xdc, 0x4d, 0x16, 0x41, 0xc4, 0x63, 0xe1, 0x25, 0x3c, 0x16, 0x81, 0x8c, 0x85, 0x97, 0x0f,
+	0xbd, 0x10, 0x04, 0x60, 0x8c, 0x6e, 0xaa, 0xa4, 0x96, 0xf4, 0xb6, 0x95, 0xb8, 0x95, 0xc4, 0xcd,
+	0x87, 0xc7, 0x1d, 0x9e, 0xc4, 0x42, 0x7a, 0xe6, 0x6f, 0xa9, 0x3b, 0xee, 0x05, 0x12, 0x13, 0x89,
+	0xde, 0x94, 0x23, 0x78, 0xf9, 0x70, 0x0a, 0x9a, 0x0f, 0x3d, 0xa3, 0x2f, 0xdf, 0x77, 0x43, 0x19,
+	0x4a, 0xf3, 0xe8, 0x15, 0x4f, 0x36, 0x7a, 0xbf, 0xc9, 0xc0, 0x0c, 0x72, 0x3f, 0x07, 0xd4, 0xb1,
+	0x08, 0xad, 0xac, 0xdf, 0x24, 0x4b, 0xb9, 0xe2, 0x89, 0xb5, 0x79, 0xfc, 0x5e, 0xa3, 0x22, 0x53,
+	0x41, 0xc4, 0x11, 0x7c, 0xc5, 0x35, 0x58, 0xe1, 0xfb, 0x4d, 0x42, 0x84, 0x30, 0x01, 0xa1, 0xfd,
+	0x28, 0x46, 0x2d, 0xd5, 0xa2, 0x94, 0xde, 0xfb, 0x89, 0x90, 0xd6, 0x97, 0xe5, 0xc7, 0x98, 0x68,
+	0xae, 0x81, 0x7e, 0x42, 0x76, 0xcb, 0xa2, 0xcc, 0xe9, 0x3b, 0x83, 0xfd, 0xb3, 0x3b, 0x6e, 0xc3,
+	0xc7, 0x71, 0x1f, 0x19, 0xc9, 0x68, 0xef, 0xc5, 0xab, 0x93, 0xad, 0x5f, 0xff, 0xf9, 0xfd, 0x81,
+	0x33, 0xb6, 0x59, 0xf4, 0x94, 0x1c, 0x04, 0x99, 0x52, 0x45, 0x25, 0x48, 0x65, 0x10, 0xb1, 0xd7,
+	0xfa, 0xce, 0x60, 0x67, 0xdc, 0xb2, 0xc1, 0x87, 0x45, 0x8c, 0x5e, 0xac, 0x44, 0xa9, 0x8a, 0x03,
+	0x60, 0xdb, 0x7d, 0x67, 0xb0, 0x37, 0x3a, 0x2d, 0x70, 0x7f, 0xbe, 0x3a, 0xb9, 0x53, 0x7e, 0x67,
+	0x9c, 0x3d, 0x73, 0x63, 0xe9, 0x25, 0x5c, 0x47, 0xee, 0xd7, 0x10, 0xf2, 0x60, 0x71, 0x0e, 0xc1,
+	0x92, 0xf4, 0xa8, 0x48, 0xa4, 0x9f, 0x92, 0x96, 0x96, 0x9a, 0xcf, 0x7d, 0xcc, 0xd2, 0x74, 0xbe,
+	0x60, 0x3b, 0x06, 0xf4, 0x8e, 0x05, 0xbd, 0xf9, 0x7f, 0xd0, 0x57, 0x42, 0x8f, 0xf7, 0x4d, 0xca,
+	0xc4, 0x64, 0xd0, 0x2f, 0xc8, 0xa1, 0x02, 0x04, 0x95, 0x83, 0x3f, 0xe5, 0x73, 0x2e, 0x02, 0x60,
+	0xb7, 0x36, 0x81, 0xb4, 0x6d, 0xd6, 0xa8, 0x4c, 0xa2, 0xdf, 0x90, 0x6e, 0x71, 0xa8, 0x7c, 0x3e,
+	0x97, 0x01, 0xd7, 0xb1, 0x14, 0xbe, 0xa9, 0xc2, 0x76, 0x37, 0x81, 0xd1, 0x19, 0xe4, 0x9f, 0x2d,
+	0x33, 0x1f, 0x17, 0x89, 0x74, 0x42, 0xde, 0x4a, 0x41, 0xcc, 0x62, 0x11, 0xfa, 0x75, 0x30, 0x7b,
+	0x7d, 0x13, 0x64, 0xd7, 0x26, 0x9f, 0xaf, 0x93, 0xe9, 0x0f, 0xa4, 0xbb, 0x36, 0x7a, 0xbe, 0x56,
+	0x5c, 0x04, 0x11, 0x20, 0x7b, 0xa3, 0xbf, 0x3d, 0xd8, 0x3f, 0x7b, 0xb7, 0xf1, 0xb0, 0xcf, 0x21,
+	0x7f, 0x5a, 0xea, 0x1f, 0x97, 0xf2, 0xd1, 0x4e, 0x51, 0xda, 0x98, 0xae, 0xbf, 0x40, 0x7a, 0x49,
+	0xda, 0xb5, 0x89, 0x44, 0xb6, 0x67, 0xc8, 0x77, 0x9b, 0xc7, 0xc8, 0x4a, 0xc7, 0x5c, 0x57, 0xd0,
+	0x83, 0x74, 0x2d, 0x86, 0x34, 0x27, 0x47, 0xd5, 0xe9, 0x44, 0x72, 0x5e, 0xf4, 0x83, 0x8c, 0x18,
+	0xe2, 0xdb, 0x6e, 0xd9, 0xb7, 0x5b, 0x6c, 0xa3, 0x6b, 0xb7, 0xd1, 0xfd, 0x5c, 0xc6, 0x62, 0xf4,
+	0x61, 0x41, 0xfa, 0xed, 0xaf, 0x93, 0x41, 0x18, 0xeb, 0x28, 0x9b, 0xba, 0x81, 0x4c, 0x3c, 0xbb,
+	0xba, 0xe5, 0xbf, 0x0f, 0x70, 0xf6, 0xcc, 0xd3, 0x8b, 0x14, 0xd0, 0x24, 0xe0, 0xb8, 0x1a, 0x81,
+	0x0b, 0x5b, 0x83, 0x7e, 0x47, 0x8e, 0x56, 0x7d, 0x40, 0x20, 0xd5, 0x0c, 0xd9, 0xbe, 0xa9, 0xfb,
+	0xa0, 0xb1, 0x93, 0x49, 0xb9, 0x5d, 0xcb, 0x86, 0x4c, 0x8a, 0x6d, 0xe9, 0x30, 0xad, 0x45, 0x91,
+	0xba, 0xe4, 0xf6, 0x7f, 0xe0, 0x3e, 0xc2, 0x73, 0xd6, 0x32, 0x9b, 0xd2, 0xa9, 0xab, 0x27, 0xf0,
+	0x9c, 0x3e, 0x25, 0x9d, 0xfa, 0xf6, 0xc6, 0x80, 0xec, 0xc0, 0xb8, 0x39, 0xbd, 0xc9, 0xcd, 0x45,
+	0xb9, 0xea, 0xd6, 0xc6, 0x11, 0xae, 0x47, 0x63, 0x40, 0xfa, 0x84, 0xb4, 0x33, 0x04, 0xb5, 0x06,
+	0x6d, 0x1b, 0xe8, 0xa0, 0x11, 0xfa, 0x04, 0x41, 0x55, 0xfd, 0xd5, 0xc9, 0x07, 0x05, 0x65, 0x85,
+	0xbd, 0x24, 0xed, 0xca, 0x2e, 0x16, 0x43, 0x88, 0xec, 0xf0, 0x86, 0x19, 0xb0, 0x5e, 0x27, 0x85,
+	0xb2, 0xe2, 0xe1, 0x5a, 0x0c, 0xe9, 0xf7, 0x84, 0x06, 0x32, 0x49, 0xe7, 0xa0, 0x61, 0xe6, 0xdb,
+	0x57, 0xc8, 0x8e, 0x6e, 0xb0, 0x5a, 0xef, 0xff, 0xa1, 0xd0, 0x4b, 0xab, 0x9d, 0x25, 0xc9, 0x6a,
+	0xf0, 0xde, 0xcf, 0x0e, 0x69, 0xad, 0x9b, 0xa0, 0xf7, 0x57, 0xfe, 0x45, 0x96, 0x4c, 0x41, 0x99,
+	0xab, 0x70, 0x67, 0x69, 0xeb, 0xd2, 0x04, 0x69, 0x97, 0xdc, 0x9a, 0x66, 0x0b, 0x50, 0xe6, 0x86,
+	0xdb, 0x1b, 0x97, 0x3f, 0xe8, 0xc7, 0x64, 0x97, 0x27, 0x32, 0x13, 0x9a, 0x6d, 0x6f, 0xb2, 0xa5,
+	0x56, 0x3c, 0x3a, 0x7b, 0x71, 0xd5, 0x73, 0x5e, 0x5e, 0xf5, 0x9c, 0xbf, 0xaf, 0x7a, 0xce, 0x2f,
+	0xd7, 0xbd, 0xad, 0x97, 0xd7, 0xbd, 0xad, 0x3f, 0xae, 0x7b, 0x5b, 0xdf, 0xb2, 0xea, 0x32, 0xff,
+	0x71, 0x75, 0x9d, 0x9b, 0xd1, 0x9d, 0xee, 0x9a, 0x2b, 0xfc, 0xa3, 0x7f, 0x07, 0x00, 0xd0, 0xe9,
+	0x8f, 0xdc, 0xe2, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -146,6 +309,125 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CompletedSegments) > 0 {
+		for iNdEx := len(m.CompletedSegments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompletedSegments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.SegmentSpends) > 0 {
+		for iNdEx := len(m.SegmentSpends) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SegmentSpends[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.UserHistories) > 0 {
+		for iNdEx := len(m.UserHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.SegmentHistories) > 0 {
+		for iNdEx := len(m.SegmentHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SegmentHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.PurchaseRecordSeq != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PurchaseRecordSeq))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.PurchaseRecords) > 0 {
+		for iNdEx := len(m.PurchaseRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PurchaseRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ReserveHoldings) > 0 {
+		for iNdEx := len(m.ReserveHoldings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReserveHoldings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PurchaseRates) > 0 {
+		for iNdEx := len(m.PurchaseRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PurchaseRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.DevVestingTranches) > 0 {
+		for iNdEx := len(m.DevVestingTranches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DevVestingTranches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	{
 		size := m.PendingDevAllocation.Size()
 		i -= size
@@ -214,6 +496,51 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SegmentSpend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SegmentSpend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SegmentSpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SegmentNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SegmentNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -246,6 +573,75 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.PendingDevAllocation.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DevVestingTranches) > 0 {
+		for _, e := range m.DevVestingTranches {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PurchaseRates) > 0 {
+		for _, e := range m.PurchaseRates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReserveHoldings) > 0 {
+		for _, e := range m.ReserveHoldings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PurchaseRecords) > 0 {
+		for _, e := range m.PurchaseRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PurchaseRecordSeq != 0 {
+		n += 1 + sovGenesis(uint64(m.PurchaseRecordSeq))
+	}
+	if len(m.SegmentHistories) > 0 {
+		for _, e := range m.SegmentHistories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UserHistories) > 0 {
+		for _, e := range m.UserHistories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SegmentSpends) > 0 {
+		for _, e := range m.SegmentSpends {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CompletedSegments) > 0 {
+		for _, e := range m.CompletedSegments {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SegmentSpend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SegmentNumber != 0 {
+		n += 1 + sovGenesis(uint64(m.SegmentNumber))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -506,6 +902,432 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevVestingTranches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevVestingTranches = append(m.DevVestingTranches, DevVestingTranche{})
+			if err := m.DevVestingTranches[len(m.DevVestingTranches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PurchaseRates = append(m.PurchaseRates, PurchaseRate{})
+			if err := m.PurchaseRates[len(m.PurchaseRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveHoldings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveHoldings = append(m.ReserveHoldings, types.Coin{})
+			if err := m.ReserveHoldings[len(m.ReserveHoldings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PurchaseRecords = append(m.PurchaseRecords, SegmentPurchaseRecord{})
+			if err := m.PurchaseRecords[len(m.PurchaseRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseRecordSeq", wireType)
+			}
+			m.PurchaseRecordSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PurchaseRecordSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SegmentHistories = append(m.SegmentHistories, SegmentHistory{})
+			if err := m.SegmentHistories[len(m.SegmentHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserHistories = append(m.UserHistories, UserPurchaseHistory{})
+			if err := m.UserHistories[len(m.UserHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentSpends", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SegmentSpends = append(m.SegmentSpends, SegmentSpend{})
+			if err := m.SegmentSpends[len(m.SegmentSpends)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedSegments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompletedSegments = append(m.CompletedSegments, SegmentHistoryEntry{})
+			if err := m.CompletedSegments[len(m.CompletedSegments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SegmentSpend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SegmentSpend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SegmentSpend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentNumber", wireType)
+			}
+			m.SegmentNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SegmentNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -32,6 +32,21 @@ const (
 	AttributeKeyTokensBought = "tokens_bought"
 	AttributeKeyUserTokens = "user_tokens"
 	AttributeKeyDevTokens = "dev_tokens"
+
+	// Dev vesting events
+	EventTypeDevVestingTranche   = "dev_vesting_tranche"
+	EventTypeClaimDevAllocation  = "claim_dev_allocation"
+	AttributeKeySegment          = "segment"
+	AttributeKeyAmount           = "amount"
+	AttributeKeyClaimer          = "claimer"
+	AttributeKeyRecipient        = "recipient"
+	AttributeKeyVestingCliffTime = "cliff_time"
+	AttributeKeyVestingEndTime   = "end_time"
+
+	// Purchase denom events
+	EventTypeSetPurchaseRate = "set_purchase_rate"
+	AttributeKeyDenom        = "denom"
+	AttributeKeyRate         = "rate"
 )
 
 // Storage keys
@@ -43,6 +58,15 @@ var (
 	ReserveBalanceKey     = collections.NewPrefix(4) // "reserve_balance"
 	DevAllocationTotalKey = collections.NewPrefix(5) // "dev_allocation_total"
 	KeyPrefixSegmentHistory = []byte{6} // "segment_history"
+	DevVestingTranchesKey   = collections.NewPrefix(11) // "dev_vesting_tranches"
+	PurchaseRatesKey        = collections.NewPrefix(12) // "purchase_rates"
+	PurchaseRecordSeqKey    = collections.NewPrefix(13) // "purchase_record_seq"
+	PurchaseRecordsKey      = collections.NewPrefix(14) // "purchase_records"
+	SegmentPurchasesKey     = collections.NewPrefix(15) // "segment_purchases"
+	BuyerPurchasesKey       = collections.NewPrefix(16) // "buyer_purchases"
+	SegmentSpendKey         = collections.NewPrefix(17) // "segment_spend"
+	BlockSegmentsKey        = collections.NewPrefix(18) // "block_segments"
+	ReserveHoldingsKey      = collections.NewPrefix(19) // "reserve_holdings"
 )
 
 // GetSegmentHistoryKey returns the key for a segment history entry
@@ -0,0 +1,21 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func NewMsgClaimDevAllocation(claimer string) *MsgClaimDevAllocation {
+	return &MsgClaimDevAllocation{
+		Claimer: claimer,
+	}
+}
+
+func (msg *MsgClaimDevAllocation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Claimer); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid claimer address (%s)", err)
+	}
+
+	return nil
+}
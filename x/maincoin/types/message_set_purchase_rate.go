@@ -0,0 +1,32 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func NewMsgSetPurchaseRate(authority, denom string, rate math.LegacyDec) *MsgSetPurchaseRate {
+	return &MsgSetPurchaseRate{
+		Authority: authority,
+		Denom:     denom,
+		Rate:      rate,
+	}
+}
+
+func (msg *MsgSetPurchaseRate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	if msg.Rate.IsNil() || !msg.Rate.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidPrice, "rate must be positive")
+	}
+
+	return nil
+}
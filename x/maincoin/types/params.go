@@ -1,8 +1,10 @@
 package types
 
 import (
-	"cosmossdk.io/math"
 	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
 )
 
 const (
@@ -12,18 +14,26 @@ const (
 	DefaultPurchaseDenom  = "utestusd"
 	DefaultFeePercentage  = "0.0001"                                        // 0.01% dev fee
 	DefaultMaxSupply      = "0"                                             // unlimited
-	DefaultDevAddress     = "cosmos1596fcwtk69cy2k8vuax3xcugcrj8zcj80cw4yt" // Dev address
+	DefaultDevAddress     = "cosmos1596fcwtk69cy2k8vuax3xcugcrj8zcj80cw4yt" // Dev address, sole default dev recipient
+
+	// Dev allocation vests linearly over a year with nothing released in the first 90 days
+	DefaultDevVestingCliff    = 90 * 24 * time.Hour
+	DefaultDevVestingDuration = 365 * 24 * time.Hour
 )
 
 // NewParams creates a new Params instance.
-func NewParams(initialPrice, priceIncrement math.LegacyDec, purchaseDenom string, feePercentage math.LegacyDec, maxSupply math.Int, devAddress string) Params {
+func NewParams(initialPrice, priceIncrement math.LegacyDec, purchaseDenom string, feePercentage math.LegacyDec, maxSupply math.Int, devRecipients []DevRecipient, devVestingCliff, devVestingDuration time.Duration, purchaseDenoms []PurchaseDenom, purchaseLimits PurchaseLimits) Params {
 	return Params{
-		InitialPrice:   initialPrice,
-		PriceIncrement: priceIncrement,
-		PurchaseDenom:  purchaseDenom,
-		FeePercentage:  feePercentage,
-		MaxSupply:      maxSupply,
-		DevAddress:     devAddress,
+		InitialPrice:       initialPrice,
+		PriceIncrement:     priceIncrement,
+		PurchaseDenom:      purchaseDenom,
+		FeePercentage:      feePercentage,
+		MaxSupply:          maxSupply,
+		DevRecipients:      devRecipients,
+		DevVestingCliff:    devVestingCliff,
+		DevVestingDuration: devVestingDuration,
+		PurchaseDenoms:     purchaseDenoms,
+		PurchaseLimits:     purchaseLimits,
 	}
 }
 
@@ -35,7 +45,11 @@ func DefaultParams() Params {
 		DefaultPurchaseDenom,
 		math.LegacyMustNewDecFromStr(DefaultFeePercentage),
 		math.ZeroInt(),
-		DefaultDevAddress,
+		DefaultDevRecipients(),
+		DefaultDevVestingCliff,
+		DefaultDevVestingDuration,
+		nil,
+		DefaultPurchaseLimits(),
 	)
 }
 
@@ -56,5 +70,23 @@ func (p Params) Validate() error {
 	if p.MaxSupply.IsNegative() {
 		return fmt.Errorf("max supply must be non-negative: %s", p.MaxSupply)
 	}
+	if err := ValidateDevRecipients(p.DevRecipients); err != nil {
+		return err
+	}
+	if err := ValidatePurchaseDenoms(p.PurchaseDenoms); err != nil {
+		return err
+	}
+	if err := p.PurchaseLimits.Validate(); err != nil {
+		return err
+	}
+	if p.DevVestingCliff < 0 {
+		return fmt.Errorf("dev vesting cliff must be non-negative: %s", p.DevVestingCliff)
+	}
+	if p.DevVestingDuration < 0 {
+		return fmt.Errorf("dev vesting duration must be non-negative: %s", p.DevVestingDuration)
+	}
+	if p.DevVestingCliff > p.DevVestingDuration {
+		return fmt.Errorf("dev vesting cliff (%s) cannot exceed vesting duration (%s)", p.DevVestingCliff, p.DevVestingDuration)
+	}
 	return nil
 }
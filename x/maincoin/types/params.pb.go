@@ -9,15 +9,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,6 +29,42 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PriceSource selects how a purchase denom is converted into TestUSD
+type PriceSource int32
+
+const (
+	// PRICE_SOURCE_UNSPECIFIED is invalid
+	PRICE_SOURCE_UNSPECIFIED PriceSource = 0
+	// PRICE_SOURCE_FIXED_PEG converts at the constant rate in the params
+	PRICE_SOURCE_FIXED_PEG PriceSource = 1
+	// PRICE_SOURCE_DEX_TWAP converts at the x/dex time-weighted average price
+	PRICE_SOURCE_DEX_TWAP PriceSource = 2
+	// PRICE_SOURCE_AUTHORITY_RATE converts at the rate last set through MsgSetPurchaseRate
+	PRICE_SOURCE_AUTHORITY_RATE PriceSource = 3
+)
+
+var PriceSource_name = map[int32]string{
+	0: "PRICE_SOURCE_UNSPECIFIED",
+	1: "PRICE_SOURCE_FIXED_PEG",
+	2: "PRICE_SOURCE_DEX_TWAP",
+	3: "PRICE_SOURCE_AUTHORITY_RATE",
+}
+
+var PriceSource_value = map[string]int32{
+	"PRICE_SOURCE_UNSPECIFIED":    0,
+	"PRICE_SOURCE_FIXED_PEG":      1,
+	"PRICE_SOURCE_DEX_TWAP":       2,
+	"PRICE_SOURCE_AUTHORITY_RATE": 3,
+}
+
+func (x PriceSource) String() string {
+	return proto.EnumName(PriceSource_name, int32(x))
+}
+
+func (PriceSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_98f74e4f9d9707fa, []int{0}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	// initial_price is the starting price of the maincoin
@@ -37,8 +77,21 @@ type Params struct {
 	FeePercentage cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=fee_percentage,json=feePercentage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_percentage"`
 	// max_supply is the maximum supply of maincoin (0 for unlimited)
 	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply"`
-	// dev_address is the address that receives dev allocation
-	DevAddress string `protobuf:"bytes,6,opt,name=dev_address,json=devAddress,proto3" json:"dev_address,omitempty"`
+	// dev_address is the single address that received dev allocation before
+	// dev_recipients was introduced.
+	// Deprecated: only read by the v1 to v2 params migration.
+	DevAddress string `protobuf:"bytes,6,opt,name=dev_address,json=devAddress,proto3" json:"dev_address,omitempty"` // Deprecated: Do not use.
+	// dev_vesting_cliff is how long after a tranche is created before any of it vests
+	DevVestingCliff time.Duration `protobuf:"bytes,7,opt,name=dev_vesting_cliff,json=devVestingCliff,proto3,stdduration" json:"dev_vesting_cliff"`
+	// dev_vesting_duration is the linear vesting period of a tranche, measured from its creation
+	DevVestingDuration time.Duration `protobuf:"bytes,8,opt,name=dev_vesting_duration,json=devVestingDuration,proto3,stdduration" json:"dev_vesting_duration"`
+	// dev_recipients split the dev allocation by weight; weights must sum to 1
+	DevRecipients []DevRecipient `protobuf:"bytes,9,rep,name=dev_recipients,json=devRecipients,proto3" json:"dev_recipients"`
+	// purchase_denoms lists the denoms other than TestUSD that can buy maincoin,
+	// together with how each is converted into the TestUSD-denominated reserve
+	PurchaseDenoms []PurchaseDenom `protobuf:"bytes,10,rep,name=purchase_denoms,json=purchaseDenoms,proto3" json:"purchase_denoms"`
+	// purchase_limits are the fair-launch controls applied to MsgBuyMaincoin
+	PurchaseLimits PurchaseLimits `protobuf:"bytes,11,opt,name=purchase_limits,json=purchaseLimits,proto3" json:"purchase_limits"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -81,6 +134,7 @@ func (m *Params) GetPurchaseDenom() string {
 	return ""
 }
 
+// Deprecated: Do not use.
 func (m *Params) GetDevAddress() string {
 	if m != nil {
 		return m.DevAddress
@@ -88,38 +142,360 @@ func (m *Params) GetDevAddress() string {
 	return ""
 }
 
+func (m *Params) GetDevVestingCliff() time.Duration {
+	if m != nil {
+		return m.DevVestingCliff
+	}
+	return 0
+}
+
+func (m *Params) GetDevVestingDuration() time.Duration {
+	if m != nil {
+		return m.DevVestingDuration
+	}
+	return 0
+}
+
+func (m *Params) GetDevRecipients() []DevRecipient {
+	if m != nil {
+		return m.DevRecipients
+	}
+	return nil
+}
+
+func (m *Params) GetPurchaseDenoms() []PurchaseDenom {
+	if m != nil {
+		return m.PurchaseDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetPurchaseLimits() PurchaseLimits {
+	if m != nil {
+		return m.PurchaseLimits
+	}
+	return PurchaseLimits{}
+}
+
+// PurchaseLimits caps how much a single address or block can buy. Zero values
+// disable the corresponding limit.
+type PurchaseLimits struct {
+	// max_spend_per_address_per_segment is the most TestUSD value one address may
+	// spend within a single segment
+	MaxSpendPerAddressPerSegment cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=max_spend_per_address_per_segment,json=maxSpendPerAddressPerSegment,proto3,customtype=cosmossdk.io/math.Int" json:"max_spend_per_address_per_segment"`
+	// max_segments_per_block is the most segments all purchases in a block may advance
+	MaxSegmentsPerBlock uint64 `protobuf:"varint,2,opt,name=max_segments_per_block,json=maxSegmentsPerBlock,proto3" json:"max_segments_per_block,omitempty"`
+	// allow_list_until_segment restricts purchases to allow_list while the current
+	// segment is below it
+	AllowListUntilSegment uint64 `protobuf:"varint,3,opt,name=allow_list_until_segment,json=allowListUntilSegment,proto3" json:"allow_list_until_segment,omitempty"`
+	// allow_list holds the addresses allowed to buy during the allow-list phase
+	AllowList []string `protobuf:"bytes,4,rep,name=allow_list,json=allowList,proto3" json:"allow_list,omitempty"`
+}
+
+func (m *PurchaseLimits) Reset()         { *m = PurchaseLimits{} }
+func (m *PurchaseLimits) String() string { return proto.CompactTextString(m) }
+func (*PurchaseLimits) ProtoMessage()    {}
+func (*PurchaseLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98f74e4f9d9707fa, []int{1}
+}
+func (m *PurchaseLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PurchaseLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PurchaseLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PurchaseLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurchaseLimits.Merge(m, src)
+}
+func (m *PurchaseLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *PurchaseLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurchaseLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurchaseLimits proto.InternalMessageInfo
+
+func (m *PurchaseLimits) GetMaxSegmentsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxSegmentsPerBlock
+	}
+	return 0
+}
+
+func (m *PurchaseLimits) GetAllowListUntilSegment() uint64 {
+	if m != nil {
+		return m.AllowListUntilSegment
+	}
+	return 0
+}
+
+func (m *PurchaseLimits) GetAllowList() []string {
+	if m != nil {
+		return m.AllowList
+	}
+	return nil
+}
+
+// PurchaseDenom is a denom accepted by MsgBuyMaincoin besides TestUSD
+type PurchaseDenom struct {
+	// denom is the bank denom accepted for purchases, e.g. an IBC USDC denom
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// source is how the denom is priced in TestUSD
+	Source PriceSource `protobuf:"varint,2,opt,name=source,proto3,enum=mychain.maincoin.v1.PriceSource" json:"source,omitempty"`
+	// rate is the TestUSD paid per unit of denom for PRICE_SOURCE_FIXED_PEG
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+	// twap_window is the averaging window for PRICE_SOURCE_DEX_TWAP
+	TwapWindow time.Duration `protobuf:"bytes,4,opt,name=twap_window,json=twapWindow,proto3,stdduration" json:"twap_window"`
+	// max_rate_age rejects PRICE_SOURCE_AUTHORITY_RATE rates older than this; zero disables the check
+	MaxRateAge time.Duration `protobuf:"bytes,5,opt,name=max_rate_age,json=maxRateAge,proto3,stdduration" json:"max_rate_age"`
+}
+
+func (m *PurchaseDenom) Reset()         { *m = PurchaseDenom{} }
+func (m *PurchaseDenom) String() string { return proto.CompactTextString(m) }
+func (*PurchaseDenom) ProtoMessage()    {}
+func (*PurchaseDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98f74e4f9d9707fa, []int{2}
+}
+func (m *PurchaseDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PurchaseDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PurchaseDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PurchaseDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurchaseDenom.Merge(m, src)
+}
+func (m *PurchaseDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *PurchaseDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurchaseDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurchaseDenom proto.InternalMessageInfo
+
+func (m *PurchaseDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *PurchaseDenom) GetSource() PriceSource {
+	if m != nil {
+		return m.Source
+	}
+	return PRICE_SOURCE_UNSPECIFIED
+}
+
+func (m *PurchaseDenom) GetTwapWindow() time.Duration {
+	if m != nil {
+		return m.TwapWindow
+	}
+	return 0
+}
+
+func (m *PurchaseDenom) GetMaxRateAge() time.Duration {
+	if m != nil {
+		return m.MaxRateAge
+	}
+	return 0
+}
+
+// DevRecipient is a weighted receiver of the maincoin dev allocation
+type DevRecipient struct {
+	// address receives this recipient's share of the dev allocation
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// weight is the fraction of the dev allocation sent to address
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+	// label is a human readable name such as "treasury" or "grants"
+	Label string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (m *DevRecipient) Reset()         { *m = DevRecipient{} }
+func (m *DevRecipient) String() string { return proto.CompactTextString(m) }
+func (*DevRecipient) ProtoMessage()    {}
+func (*DevRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98f74e4f9d9707fa, []int{3}
+}
+func (m *DevRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DevRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DevRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DevRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DevRecipient.Merge(m, src)
+}
+func (m *DevRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *DevRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_DevRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DevRecipient proto.InternalMessageInfo
+
+func (m *DevRecipient) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DevRecipient) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+// DevRecipientAmount is the dev allocation sent to a single recipient
+type DevRecipientAmount struct {
+	Address string                `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *DevRecipientAmount) Reset()         { *m = DevRecipientAmount{} }
+func (m *DevRecipientAmount) String() string { return proto.CompactTextString(m) }
+func (*DevRecipientAmount) ProtoMessage()    {}
+func (*DevRecipientAmount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98f74e4f9d9707fa, []int{4}
+}
+func (m *DevRecipientAmount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DevRecipientAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DevRecipientAmount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DevRecipientAmount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DevRecipientAmount.Merge(m, src)
+}
+func (m *DevRecipientAmount) XXX_Size() int {
+	return m.Size()
+}
+func (m *DevRecipientAmount) XXX_DiscardUnknown() {
+	xxx_messageInfo_DevRecipientAmount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DevRecipientAmount proto.InternalMessageInfo
+
+func (m *DevRecipientAmount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("mychain.maincoin.v1.PriceSource", PriceSource_name, PriceSource_value)
 	proto.RegisterType((*Params)(nil), "mychain.maincoin.v1.Params")
+	proto.RegisterType((*PurchaseLimits)(nil), "mychain.maincoin.v1.PurchaseLimits")
+	proto.RegisterType((*PurchaseDenom)(nil), "mychain.maincoin.v1.PurchaseDenom")
+	proto.RegisterType((*DevRecipient)(nil), "mychain.maincoin.v1.DevRecipient")
+	proto.RegisterType((*DevRecipientAmount)(nil), "mychain.maincoin.v1.DevRecipientAmount")
 }
 
 func init() { proto.RegisterFile("mychain/maincoin/v1/params.proto", fileDescriptor_98f74e4f9d9707fa) }
 
 var fileDescriptor_98f74e4f9d9707fa = []byte{
-	// 373 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x3f, 0x6b, 0xdb, 0x40,
-	0x18, 0xc6, 0xa5, 0xba, 0x35, 0xf8, 0x5a, 0xbb, 0x54, 0x6d, 0x41, 0x75, 0xa9, 0x64, 0x5c, 0x0a,
-	0xa5, 0x83, 0x84, 0x9b, 0x2d, 0x64, 0x89, 0xf1, 0x10, 0x07, 0x0f, 0xc6, 0xd9, 0xb2, 0x88, 0xcb,
-	0xe9, 0xb5, 0x7c, 0xc4, 0xf7, 0x07, 0x9d, 0x2c, 0xac, 0xaf, 0x90, 0x29, 0x53, 0xe6, 0x7c, 0x84,
-	0x7c, 0x0c, 0x8f, 0x1e, 0x43, 0x06, 0x13, 0xec, 0x21, 0xf9, 0x18, 0x41, 0x27, 0x2b, 0x19, 0x92,
-	0xc1, 0x8b, 0x78, 0x79, 0x78, 0x7e, 0x3f, 0x1d, 0x3c, 0xa8, 0xc5, 0x32, 0x32, 0xc1, 0x94, 0xfb,
-	0x0c, 0x53, 0x4e, 0x04, 0xe5, 0x7e, 0xda, 0xf1, 0x25, 0x8e, 0x31, 0x53, 0x9e, 0x8c, 0x45, 0x22,
-	0xac, 0xaf, 0xdb, 0x86, 0x57, 0x36, 0xbc, 0xb4, 0xd3, 0xfc, 0x82, 0x19, 0xe5, 0xc2, 0xd7, 0xdf,
-	0xa2, 0xd7, 0xfc, 0x16, 0x89, 0x48, 0xe8, 0xd3, 0xcf, 0xaf, 0x22, 0x6d, 0x5f, 0x55, 0x50, 0x75,
-	0xa8, 0x75, 0xd6, 0x11, 0xaa, 0x53, 0x4e, 0x13, 0x8a, 0xa7, 0x81, 0x8c, 0x29, 0x01, 0xdb, 0x6c,
-	0x99, 0x7f, 0x6b, 0xdd, 0xdf, 0x8b, 0x95, 0x6b, 0xdc, 0xad, 0xdc, 0x9f, 0x44, 0x28, 0x26, 0x94,
-	0x0a, 0xcf, 0x3d, 0x2a, 0x7c, 0x86, 0x93, 0x89, 0x37, 0x80, 0x08, 0x93, 0xac, 0x07, 0x64, 0xf4,
-	0x69, 0x4b, 0x0e, 0x73, 0xd0, 0x1a, 0xa0, 0xcf, 0xda, 0x10, 0x50, 0x4e, 0x62, 0x60, 0xc0, 0x13,
-	0xfb, 0xdd, 0xee, 0xae, 0x86, 0x66, 0xfb, 0x25, 0x6a, 0xfd, 0x41, 0x0d, 0x39, 0x8b, 0xc9, 0x04,
-	0x2b, 0x08, 0x42, 0xe0, 0x82, 0xd9, 0x95, 0x5c, 0x36, 0xaa, 0x97, 0x69, 0x2f, 0x0f, 0xad, 0x63,
-	0xd4, 0x18, 0x03, 0x04, 0x12, 0x62, 0x02, 0x3c, 0xc1, 0x11, 0xd8, 0xef, 0x77, 0xff, 0x67, 0x7d,
-	0x0c, 0x30, 0x7c, 0x26, 0xad, 0x03, 0x84, 0x18, 0x9e, 0x07, 0x6a, 0x26, 0xe5, 0x34, 0xb3, 0x3f,
-	0x68, 0xcf, 0xaf, 0xad, 0xe7, 0xfb, 0x6b, 0x4f, 0x9f, 0x27, 0xa3, 0x1a, 0xc3, 0xf3, 0x13, 0xdd,
-	0xb7, 0x5c, 0xf4, 0x31, 0x84, 0x34, 0xc0, 0x61, 0x18, 0x83, 0x52, 0x76, 0x55, 0xbf, 0x16, 0x85,
-	0x90, 0x1e, 0x16, 0xc9, 0x7e, 0xfb, 0xf1, 0xda, 0x35, 0x2f, 0x1e, 0x6e, 0xfe, 0xfd, 0x28, 0xd7,
-	0x9d, 0xbf, 0xec, 0x5b, 0xac, 0xd1, 0xfd, 0xbf, 0x58, 0x3b, 0xe6, 0x72, 0xed, 0x98, 0xf7, 0x6b,
-	0xc7, 0xbc, 0xdc, 0x38, 0xc6, 0x72, 0xe3, 0x18, 0xb7, 0x1b, 0xc7, 0x38, 0xb5, 0xdf, 0x80, 0x92,
-	0x4c, 0x82, 0x3a, 0xab, 0xea, 0x4d, 0xf7, 0x9e, 0x02, 0x00, 0x00, 0xff, 0xff, 0x41, 0xab, 0xb3,
-	0x61, 0x35, 0x02, 0x00, 0x00,
+	// 932 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbf, 0x6f, 0xdb, 0x46,
+	0x14, 0x16, 0x25, 0x59, 0x89, 0x9e, 0x2c, 0xd9, 0xb9, 0xd8, 0x01, 0xed, 0x24, 0x92, 0x22, 0xa3,
+	0x80, 0xe1, 0x81, 0x42, 0x1c, 0x14, 0x29, 0xd2, 0x2e, 0x92, 0xc5, 0x34, 0x2a, 0x8c, 0x9a, 0xa5,
+	0xac, 0x3a, 0xed, 0x42, 0x9c, 0xc9, 0x13, 0x7d, 0x08, 0xc9, 0x23, 0x78, 0x94, 0x64, 0x8f, 0xdd,
+	0xfa, 0x63, 0xe9, 0xd8, 0xb1, 0x40, 0x97, 0x02, 0x5d, 0xf2, 0x3f, 0x74, 0xc9, 0x98, 0xb1, 0xe8,
+	0x90, 0x16, 0xf6, 0x90, 0xfe, 0x19, 0xc5, 0x1d, 0x49, 0x5b, 0x42, 0x8d, 0x54, 0x59, 0x88, 0xbb,
+	0x7b, 0xdf, 0xf7, 0xdd, 0xe3, 0x7d, 0xef, 0x3d, 0x68, 0xfa, 0x67, 0xf6, 0x09, 0xa6, 0x41, 0xdb,
+	0xc7, 0x34, 0xb0, 0x19, 0x0d, 0xda, 0x93, 0x87, 0xed, 0x10, 0x47, 0xd8, 0xe7, 0x5a, 0x18, 0xb1,
+	0x98, 0xa1, 0xdb, 0x29, 0x42, 0xcb, 0x10, 0xda, 0xe4, 0xe1, 0xe6, 0x2d, 0xec, 0xd3, 0x80, 0xb5,
+	0xe5, 0x37, 0xc1, 0x6d, 0xae, 0xb9, 0xcc, 0x65, 0x72, 0xd9, 0x16, 0xab, 0xf4, 0xb4, 0xee, 0x32,
+	0xe6, 0x7a, 0xa4, 0x2d, 0x77, 0xc7, 0xe3, 0x51, 0xdb, 0x19, 0x47, 0x38, 0xa6, 0x2c, 0x48, 0xe2,
+	0xad, 0xdf, 0x4b, 0x50, 0x32, 0xe4, 0x75, 0xe8, 0x19, 0x54, 0x69, 0x40, 0x63, 0x8a, 0x3d, 0x2b,
+	0x8c, 0xa8, 0x4d, 0x54, 0xa5, 0xa9, 0x6c, 0x97, 0xbb, 0x5b, 0xaf, 0xde, 0x34, 0x72, 0x7f, 0xbe,
+	0x69, 0xdc, 0xb5, 0x19, 0xf7, 0x19, 0xe7, 0xce, 0x0b, 0x8d, 0xb2, 0xb6, 0x8f, 0xe3, 0x13, 0x6d,
+	0x9f, 0xb8, 0xd8, 0x3e, 0xeb, 0x11, 0xdb, 0x5c, 0x4e, 0x99, 0x86, 0x20, 0xa2, 0x7d, 0x58, 0x91,
+	0x0a, 0x16, 0x0d, 0xec, 0x88, 0xf8, 0x24, 0x88, 0xd5, 0xfc, 0xe2, 0x5a, 0x35, 0xc9, 0xed, 0x67,
+	0x54, 0xf4, 0x01, 0xd4, 0xc2, 0x71, 0x64, 0x9f, 0x60, 0x4e, 0x2c, 0x87, 0x04, 0xcc, 0x57, 0x0b,
+	0x42, 0xcc, 0xac, 0x66, 0xa7, 0x3d, 0x71, 0x88, 0x3e, 0x83, 0xda, 0x88, 0x10, 0x2b, 0x24, 0x91,
+	0x4d, 0x82, 0x18, 0xbb, 0x44, 0x2d, 0x2e, 0x7e, 0x67, 0x75, 0x44, 0x88, 0x71, 0xc9, 0x44, 0x9f,
+	0x00, 0xf8, 0xf8, 0xd4, 0xe2, 0xe3, 0x30, 0xf4, 0xce, 0xd4, 0x25, 0xa9, 0x73, 0x3f, 0xd5, 0x59,
+	0xff, 0xaf, 0x4e, 0x3f, 0x88, 0xcd, 0xb2, 0x8f, 0x4f, 0x07, 0x12, 0x8f, 0xb6, 0xa0, 0xe2, 0x90,
+	0x89, 0x85, 0x1d, 0x27, 0x22, 0x9c, 0xab, 0x25, 0x49, 0xcf, 0xab, 0x8a, 0x09, 0x0e, 0x99, 0x74,
+	0x92, 0x53, 0x74, 0x00, 0xb7, 0x04, 0x68, 0x42, 0x78, 0x4c, 0x03, 0xd7, 0xb2, 0x3d, 0x3a, 0x1a,
+	0xa9, 0x37, 0x9a, 0xca, 0x76, 0x65, 0x77, 0x43, 0x4b, 0x4c, 0xd3, 0x32, 0xd3, 0xb4, 0x5e, 0x6a,
+	0x5a, 0xf7, 0xa6, 0x48, 0xe2, 0xa7, 0xbf, 0x1a, 0x8a, 0xb9, 0xe2, 0x90, 0xc9, 0x97, 0x09, 0x79,
+	0x4f, 0x70, 0xd1, 0x10, 0xd6, 0x66, 0x05, 0x33, 0x9f, 0xd5, 0x9b, 0x8b, 0x6b, 0xa2, 0x2b, 0xcd,
+	0x2c, 0x8a, 0x06, 0x50, 0x13, 0xb2, 0x11, 0xb1, 0x69, 0x48, 0x49, 0x10, 0x73, 0xb5, 0xdc, 0x2c,
+	0x6c, 0x57, 0x76, 0x1f, 0x68, 0xd7, 0xd4, 0xa5, 0xd6, 0x23, 0x13, 0x33, 0x43, 0x76, 0xcb, 0x42,
+	0xf8, 0xd7, 0xb7, 0x2f, 0x77, 0x14, 0xb3, 0xea, 0xcc, 0x04, 0x38, 0xfa, 0x02, 0x56, 0xe6, 0x2d,
+	0xe5, 0x2a, 0x48, 0xd5, 0xd6, 0xb5, 0xaa, 0xc6, 0xac, 0xd1, 0xdd, 0xa2, 0x90, 0x35, 0x6b, 0x73,
+	0xee, 0x73, 0x74, 0x34, 0x23, 0xe9, 0x51, 0x9f, 0xc6, 0x5c, 0xad, 0xc8, 0x3f, 0xdf, 0x7a, 0xa7,
+	0xe4, 0xbe, 0x84, 0xce, 0xa6, 0x7a, 0x29, 0x9c, 0x84, 0x9e, 0xb4, 0xfe, 0xf9, 0xb9, 0xa1, 0x7c,
+	0xff, 0xf6, 0xe5, 0xce, 0x46, 0xd6, 0xaa, 0xa7, 0x57, 0xcd, 0x9a, 0xb4, 0x4e, 0xeb, 0x87, 0x3c,
+	0xd4, 0xe6, 0x15, 0xd1, 0x08, 0x1e, 0xc8, 0x12, 0x0a, 0x49, 0xe0, 0x88, 0xa2, 0xcc, 0xca, 0x41,
+	0xae, 0x39, 0x71, 0x65, 0x57, 0x28, 0x8b, 0x54, 0xd6, 0x3d, 0x51, 0x59, 0x42, 0xc6, 0x20, 0x51,
+	0x5a, 0x3d, 0x06, 0x89, 0x06, 0x89, 0x04, 0x7a, 0x04, 0x77, 0xe4, 0x3d, 0xc9, 0x36, 0x91, 0x3f,
+	0xf6, 0x98, 0xfd, 0x42, 0xb6, 0x5c, 0xd1, 0xbc, 0x2d, 0xd8, 0x69, 0xd0, 0x20, 0x51, 0x57, 0x84,
+	0xd0, 0x63, 0x50, 0xb1, 0xe7, 0xb1, 0xa9, 0xe5, 0x51, 0x1e, 0x5b, 0xe3, 0x20, 0xa6, 0xde, 0x65,
+	0x4e, 0x05, 0x49, 0x5b, 0x97, 0xf1, 0x7d, 0xca, 0xe3, 0xa1, 0x88, 0x66, 0xb7, 0xdd, 0x07, 0xb8,
+	0x22, 0xaa, 0xc5, 0x66, 0x61, 0xbb, 0x6c, 0x96, 0x2f, 0xa1, 0x4f, 0x8a, 0xe2, 0xad, 0x5a, 0xbf,
+	0xe5, 0xa1, 0x3a, 0x67, 0x19, 0x5a, 0x83, 0xa5, 0xa4, 0x73, 0xe5, 0x0f, 0x9b, 0xc9, 0x06, 0x7d,
+	0x04, 0x25, 0xce, 0xc6, 0x91, 0x4d, 0x64, 0xaa, 0xb5, 0xdd, 0xe6, 0xf5, 0x4e, 0x89, 0x69, 0x30,
+	0x90, 0x38, 0x33, 0xc5, 0xa3, 0xc7, 0x50, 0x8c, 0x70, 0x4c, 0x92, 0x41, 0xb0, 0x58, 0x87, 0x4b,
+	0x02, 0xea, 0x41, 0x25, 0x9e, 0xe2, 0xd0, 0x9a, 0xd2, 0xc0, 0x61, 0x53, 0x39, 0x21, 0x16, 0xec,
+	0x0d, 0x10, 0xbc, 0x23, 0x49, 0x43, 0x3a, 0x2c, 0x8b, 0x37, 0x17, 0x8a, 0x96, 0x18, 0x34, 0x4b,
+	0xef, 0x21, 0xe3, 0xe3, 0x53, 0x13, 0xc7, 0xa4, 0xe3, 0x92, 0xf4, 0xb5, 0xbe, 0x51, 0x60, 0x79,
+	0xb6, 0x6d, 0x90, 0x0a, 0x37, 0xb2, 0xd1, 0x91, 0x3c, 0x57, 0xb6, 0x45, 0x1f, 0x43, 0x69, 0x4a,
+	0xa8, 0x7b, 0xf2, 0x5e, 0xe3, 0x34, 0xa5, 0x08, 0x0f, 0x3c, 0x7c, 0x4c, 0xbc, 0x74, 0x7a, 0x26,
+	0x9b, 0x34, 0x07, 0x02, 0x68, 0x36, 0x85, 0x8e, 0xcf, 0xc6, 0xef, 0x4c, 0xe4, 0x43, 0x28, 0x61,
+	0x89, 0x49, 0x13, 0xf9, 0x9f, 0x0a, 0x4e, 0xc1, 0x3b, 0xdf, 0x29, 0x50, 0x99, 0xb1, 0x13, 0xdd,
+	0x03, 0xd5, 0x30, 0xfb, 0x7b, 0xba, 0x35, 0x38, 0x18, 0x9a, 0x7b, 0xba, 0x35, 0xfc, 0x7c, 0x60,
+	0xe8, 0x7b, 0xfd, 0xa7, 0x7d, 0xbd, 0xb7, 0x9a, 0x43, 0x9b, 0x70, 0x67, 0x2e, 0xfa, 0xb4, 0xff,
+	0x5c, 0xef, 0x59, 0x86, 0xfe, 0xe9, 0xaa, 0x82, 0x36, 0x60, 0x7d, 0x2e, 0xd6, 0xd3, 0x9f, 0x5b,
+	0x87, 0x47, 0x1d, 0x63, 0x35, 0x8f, 0x1a, 0x70, 0x77, 0x2e, 0xd4, 0x19, 0x1e, 0x3e, 0x3b, 0x30,
+	0xfb, 0x87, 0x5f, 0x59, 0x66, 0xe7, 0x50, 0x5f, 0x2d, 0x6c, 0x16, 0xbf, 0xfd, 0xa5, 0x9e, 0xeb,
+	0xee, 0xbe, 0x3a, 0xaf, 0x2b, 0xaf, 0xcf, 0xeb, 0xca, 0xdf, 0xe7, 0x75, 0xe5, 0xc7, 0x8b, 0x7a,
+	0xee, 0xf5, 0x45, 0x3d, 0xf7, 0xc7, 0x45, 0x3d, 0xf7, 0xb5, 0x7a, 0x4d, 0x9f, 0xc7, 0x67, 0x21,
+	0xe1, 0xc7, 0x25, 0xe9, 0xec, 0xa3, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xf3, 0x76, 0x95, 0x9c,
+	0xb5, 0x07, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -159,128 +535,1179 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.DevAddress != that1.DevAddress {
 		return false
 	}
-	return true
-}
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.DevVestingCliff != that1.DevVestingCliff {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DevAddress) > 0 {
-		i -= len(m.DevAddress)
-		copy(dAtA[i:], m.DevAddress)
-		i = encodeVarintParams(dAtA, i, uint64(len(m.DevAddress)))
-		i--
-		dAtA[i] = 0x32
+	if this.DevVestingDuration != that1.DevVestingDuration {
+		return false
 	}
-	{
-		size := m.MaxSupply.Size()
-		i -= size
-		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintParams(dAtA, i, uint64(size))
+	if len(this.DevRecipients) != len(that1.DevRecipients) {
+		return false
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.FeePercentage.Size()
-		i -= size
-		if _, err := m.FeePercentage.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	for i := range this.DevRecipients {
+		if !this.DevRecipients[i].Equal(&that1.DevRecipients[i]) {
+			return false
 		}
-		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.PurchaseDenom) > 0 {
-		i -= len(m.PurchaseDenom)
-		copy(dAtA[i:], m.PurchaseDenom)
-		i = encodeVarintParams(dAtA, i, uint64(len(m.PurchaseDenom)))
-		i--
-		dAtA[i] = 0x1a
+	if len(this.PurchaseDenoms) != len(that1.PurchaseDenoms) {
+		return false
 	}
-	{
-		size := m.PriceIncrement.Size()
-		i -= size
-		if _, err := m.PriceIncrement.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	for i := range this.PurchaseDenoms {
+		if !this.PurchaseDenoms[i].Equal(&that1.PurchaseDenoms[i]) {
+			return false
 		}
-		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.InitialPrice.Size()
-		i -= size
-		if _, err := m.InitialPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintParams(dAtA, i, uint64(size))
+	if !this.PurchaseLimits.Equal(&that1.PurchaseLimits) {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return true
 }
+func (this *PurchaseLimits) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	that1, ok := that.(*PurchaseLimits)
+	if !ok {
+		that2, ok := that.(PurchaseLimits)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MaxSpendPerAddressPerSegment.Equal(that1.MaxSpendPerAddressPerSegment) {
+		return false
+	}
+	if this.MaxSegmentsPerBlock != that1.MaxSegmentsPerBlock {
+		return false
+	}
+	if this.AllowListUntilSegment != that1.AllowListUntilSegment {
+		return false
+	}
+	if len(this.AllowList) != len(that1.AllowList) {
+		return false
+	}
+	for i := range this.AllowList {
+		if this.AllowList[i] != that1.AllowList[i] {
+			return false
+		}
+	}
+	return true
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *PurchaseDenom) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PurchaseDenom)
+	if !ok {
+		that2, ok := that.(PurchaseDenom)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Source != that1.Source {
+		return false
+	}
+	if !this.Rate.Equal(that1.Rate) {
+		return false
+	}
+	if this.TwapWindow != that1.TwapWindow {
+		return false
+	}
+	if this.MaxRateAge != that1.MaxRateAge {
+		return false
+	}
+	return true
+}
+func (this *DevRecipient) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DevRecipient)
+	if !ok {
+		that2, ok := that.(DevRecipient)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	if this.Label != that1.Label {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.InitialPrice.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.PriceIncrement.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = len(m.PurchaseDenom)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
+	{
+		size, err := m.PurchaseLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	l = m.FeePercentage.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.MaxSupply.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = len(m.DevAddress)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
+	i--
+	dAtA[i] = 0x5a
+	if len(m.PurchaseDenoms) > 0 {
+		for iNdEx := len(m.PurchaseDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PurchaseDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.DevRecipients) > 0 {
+		for iNdEx := len(m.DevRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DevRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DevVestingDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DevVestingDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x42
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DevVestingCliff, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DevVestingCliff):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.DevAddress) > 0 {
+		i -= len(m.DevAddress)
+		copy(dAtA[i:], m.DevAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.DevAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.FeePercentage.Size()
+		i -= size
+		if _, err := m.FeePercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.PurchaseDenom) > 0 {
+		i -= len(m.PurchaseDenom)
+		copy(dAtA[i:], m.PurchaseDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.PurchaseDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.PriceIncrement.Size()
+		i -= size
+		if _, err := m.PriceIncrement.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.InitialPrice.Size()
+		i -= size
+		if _, err := m.InitialPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PurchaseLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PurchaseLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurchaseLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowList) > 0 {
+		for iNdEx := len(m.AllowList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowList[iNdEx])
+			copy(dAtA[i:], m.AllowList[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowList[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.AllowListUntilSegment != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AllowListUntilSegment))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxSegmentsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxSegmentsPerBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.MaxSpendPerAddressPerSegment.Size()
+		i -= size
+		if _, err := m.MaxSpendPerAddressPerSegment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PurchaseDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PurchaseDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurchaseDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxRateAge, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxRateAge):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintParams(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x2a
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TwapWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapWindow):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintParams(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Source != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Source))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DevRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DevRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DevRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DevRecipientAmount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DevRecipientAmount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DevRecipientAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InitialPrice.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.PriceIncrement.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.PurchaseDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.FeePercentage.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.DevAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DevVestingCliff)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DevVestingDuration)
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.DevRecipients) > 0 {
+		for _, e := range m.DevRecipients {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.PurchaseDenoms) > 0 {
+		for _, e := range m.PurchaseDenoms {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.PurchaseLimits.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *PurchaseLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxSpendPerAddressPerSegment.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxSegmentsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxSegmentsPerBlock))
+	}
+	if m.AllowListUntilSegment != 0 {
+		n += 1 + sovParams(uint64(m.AllowListUntilSegment))
+	}
+	if len(m.AllowList) > 0 {
+		for _, s := range m.AllowList {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PurchaseDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Source != 0 {
+		n += 1 + sovParams(uint64(m.Source))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapWindow)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxRateAge)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *DevRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *DevRecipientAmount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceIncrement", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceIncrement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PurchaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevVestingCliff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DevVestingCliff, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevVestingDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DevVestingDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevRecipients = append(m.DevRecipients, DevRecipient{})
+			if err := m.DevRecipients[len(m.DevRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PurchaseDenoms = append(m.PurchaseDenoms, PurchaseDenom{})
+			if err := m.PurchaseDenoms[len(m.PurchaseDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PurchaseLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PurchaseLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurchaseLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurchaseLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpendPerAddressPerSegment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSpendPerAddressPerSegment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSegmentsPerBlock", wireType)
+			}
+			m.MaxSegmentsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSegmentsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowListUntilSegment", wireType)
+			}
+			m.AllowListUntilSegment = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AllowListUntilSegment |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowList = append(m.AllowList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *PurchaseDenom) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -303,15 +1730,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: PurchaseDenom: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PurchaseDenom: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -339,13 +1766,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= PriceSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceIncrement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -373,13 +1817,129 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PriceIncrement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TwapWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRateAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxRateAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DevRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DevRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DevRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -407,11 +1967,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PurchaseDenom = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeePercentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -439,13 +1999,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -473,13 +2033,93 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DevRecipientAmount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DevRecipientAmount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DevRecipientAmount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -507,7 +2147,9 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DevAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidatePurchaseDenoms checks that every purchase denom is unique, is not TestUSD
+// (which is always accepted at par) and has a usable price source.
+func ValidatePurchaseDenoms(denoms []PurchaseDenom) error {
+	seen := make(map[string]bool, len(denoms))
+	for _, pd := range denoms {
+		if err := pd.Validate(); err != nil {
+			return err
+		}
+		if seen[pd.Denom] {
+			return fmt.Errorf("duplicate purchase denom %s", pd.Denom)
+		}
+		seen[pd.Denom] = true
+	}
+	return nil
+}
+
+// Validate performs basic validation of a purchase denom.
+func (pd PurchaseDenom) Validate() error {
+	if err := sdk.ValidateDenom(pd.Denom); err != nil {
+		return fmt.Errorf("invalid purchase denom %s: %w", pd.Denom, err)
+	}
+	if pd.Denom == TestUSDDenom || pd.Denom == MainCoinDenom {
+		return fmt.Errorf("%s cannot be listed as a purchase denom", pd.Denom)
+	}
+
+	switch pd.Source {
+	case PRICE_SOURCE_FIXED_PEG:
+		if pd.Rate.IsNil() || !pd.Rate.IsPositive() {
+			return fmt.Errorf("purchase denom %s: fixed peg rate must be positive", pd.Denom)
+		}
+	case PRICE_SOURCE_DEX_TWAP:
+		if pd.TwapWindow <= 0 {
+			return fmt.Errorf("purchase denom %s: twap window must be positive", pd.Denom)
+		}
+	case PRICE_SOURCE_AUTHORITY_RATE:
+		if pd.MaxRateAge < 0 {
+			return fmt.Errorf("purchase denom %s: max rate age must be non-negative", pd.Denom)
+		}
+	default:
+		return fmt.Errorf("purchase denom %s: unsupported price source %s", pd.Denom, pd.Source)
+	}
+	return nil
+}
+
+// LookupPurchaseDenom returns the purchase denom config for denom, if it is listed.
+func (p Params) LookupPurchaseDenom(denom string) (PurchaseDenom, bool) {
+	for _, pd := range p.PurchaseDenoms {
+		if pd.Denom == denom {
+			return pd, true
+		}
+	}
+	return PurchaseDenom{}, false
+}
+
+// Validate performs basic validation of an authority-set purchase rate.
+func (r PurchaseRate) Validate() error {
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return fmt.Errorf("invalid purchase rate denom %s: %w", r.Denom, err)
+	}
+	if r.Rate.IsNil() || !r.Rate.IsPositive() {
+		return fmt.Errorf("purchase rate for %s must be positive", r.Denom)
+	}
+	return nil
+}
+
+// ConvertToReserve returns the TestUSD value of amount at rate, rounded down.
+func ConvertToReserve(amount math.Int, rate math.LegacyDec) math.Int {
+	return rate.MulInt(amount).TruncateInt()
+}
+
+// ConvertFromReserve returns how much of a purchase denom is worth value TestUSD at
+// rate, rounded down.
+func ConvertFromReserve(value math.Int, rate math.LegacyDec) math.Int {
+	return math.LegacyNewDecFromInt(value).Quo(rate).TruncateInt()
+}
@@ -0,0 +1,79 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"mychain/x/maincoin/types"
+)
+
+func TestValidatePurchaseDenoms(t *testing.T) {
+	usdc := types.PurchaseDenom{
+		Denom:  "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+		Source: types.PRICE_SOURCE_FIXED_PEG,
+		Rate:   math.LegacyOneDec(),
+	}
+	lc := types.PurchaseDenom{
+		Denom:      "ulc",
+		Source:     types.PRICE_SOURCE_DEX_TWAP,
+		Rate:       math.LegacyZeroDec(),
+		TwapWindow: time.Hour,
+	}
+	authority := types.PurchaseDenom{
+		Denom:      "uatom",
+		Source:     types.PRICE_SOURCE_AUTHORITY_RATE,
+		Rate:       math.LegacyZeroDec(),
+		MaxRateAge: 24 * time.Hour,
+	}
+
+	tests := []struct {
+		desc   string
+		denoms []types.PurchaseDenom
+		valid  bool
+	}{
+		{desc: "empty", denoms: nil, valid: true},
+		{desc: "all sources", denoms: []types.PurchaseDenom{usdc, lc, authority}, valid: true},
+		{desc: "duplicate", denoms: []types.PurchaseDenom{usdc, usdc}},
+		{
+			desc:   "testusd listed",
+			denoms: []types.PurchaseDenom{{Denom: types.TestUSDDenom, Source: types.PRICE_SOURCE_FIXED_PEG, Rate: math.LegacyOneDec()}},
+		},
+		{
+			desc:   "zero peg",
+			denoms: []types.PurchaseDenom{{Denom: "uusdc", Source: types.PRICE_SOURCE_FIXED_PEG, Rate: math.LegacyZeroDec()}},
+		},
+		{
+			desc:   "twap without window",
+			denoms: []types.PurchaseDenom{{Denom: "ulc", Source: types.PRICE_SOURCE_DEX_TWAP, Rate: math.LegacyZeroDec()}},
+		},
+		{
+			desc:   "unspecified source",
+			denoms: []types.PurchaseDenom{{Denom: "uusdc", Rate: math.LegacyOneDec()}},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := types.ValidatePurchaseDenoms(tc.denoms)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestConvertReserve(t *testing.T) {
+	rate := math.LegacyMustNewDecFromStr("0.998")
+
+	value := types.ConvertToReserve(math.NewInt(1_000_000), rate)
+	require.Equal(t, math.NewInt(998_000), value)
+
+	// Refunds round down so the buyer never gets back more than the value left over
+	refund := types.ConvertFromReserve(math.NewInt(1_000), rate)
+	require.Equal(t, math.NewInt(1_002), refund)
+	require.True(t, types.ConvertToReserve(refund, rate).LTE(math.NewInt(1_000)))
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultPurchaseLimits returns purchase limits with every limit disabled.
+func DefaultPurchaseLimits() PurchaseLimits {
+	return PurchaseLimits{
+		MaxSpendPerAddressPerSegment: math.ZeroInt(),
+		MaxSegmentsPerBlock:          0,
+		AllowListUntilSegment:        0,
+		AllowList:                    nil,
+	}
+}
+
+// Validate performs basic validation of the purchase limits.
+func (l PurchaseLimits) Validate() error {
+	if l.MaxSpendPerAddressPerSegment.IsNil() || l.MaxSpendPerAddressPerSegment.IsNegative() {
+		return fmt.Errorf("max spend per address per segment must be non-negative")
+	}
+
+	seen := make(map[string]bool, len(l.AllowList))
+	for _, addr := range l.AllowList {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid allow list address %s: %w", addr, err)
+		}
+		if seen[addr] {
+			return fmt.Errorf("duplicate allow list address %s", addr)
+		}
+		seen[addr] = true
+	}
+
+	if l.AllowListUntilSegment > 0 && len(l.AllowList) == 0 {
+		return fmt.Errorf("allow list phase until segment %d requires a non-empty allow list", l.AllowListUntilSegment)
+	}
+	return nil
+}
+
+// SpendLimited reports whether a per-address spend limit is configured.
+func (l PurchaseLimits) SpendLimited() bool {
+	return !l.MaxSpendPerAddressPerSegment.IsNil() && l.MaxSpendPerAddressPerSegment.IsPositive()
+}
+
+// AllowListActive reports whether only allow-listed addresses may buy in the segment.
+func (l PurchaseLimits) AllowListActive(segment uint64) bool {
+	return segment < l.AllowListUntilSegment
+}
+
+// IsAllowed reports whether address may buy in the segment.
+func (l PurchaseLimits) IsAllowed(address string, segment uint64) bool {
+	if !l.AllowListActive(segment) {
+		return true
+	}
+	for _, addr := range l.AllowList {
+		if addr == address {
+			return true
+		}
+	}
+	return false
+}
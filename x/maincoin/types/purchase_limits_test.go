@@ -0,0 +1,85 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"mychain/x/maincoin/types"
+)
+
+func TestPurchaseLimits_Validate(t *testing.T) {
+	a := testAddr(1)
+
+	tests := []struct {
+		desc   string
+		limits types.PurchaseLimits
+		valid  bool
+	}{
+		{desc: "default", limits: types.DefaultPurchaseLimits(), valid: true},
+		{
+			desc: "all limits",
+			limits: types.PurchaseLimits{
+				MaxSpendPerAddressPerSegment: math.NewInt(1_000_000),
+				MaxSegmentsPerBlock:          3,
+				AllowListUntilSegment:        10,
+				AllowList:                    []string{a},
+			},
+			valid: true,
+		},
+		{
+			desc:   "negative spend",
+			limits: types.PurchaseLimits{MaxSpendPerAddressPerSegment: math.NewInt(-1)},
+		},
+		{
+			desc:   "nil spend",
+			limits: types.PurchaseLimits{},
+		},
+		{
+			desc: "phase without allow list",
+			limits: types.PurchaseLimits{
+				MaxSpendPerAddressPerSegment: math.ZeroInt(),
+				AllowListUntilSegment:        5,
+			},
+		},
+		{
+			desc: "duplicate allow list address",
+			limits: types.PurchaseLimits{
+				MaxSpendPerAddressPerSegment: math.ZeroInt(),
+				AllowList:                    []string{a, a},
+			},
+		},
+		{
+			desc: "invalid allow list address",
+			limits: types.PurchaseLimits{
+				MaxSpendPerAddressPerSegment: math.ZeroInt(),
+				AllowList:                    []string{"invalid"},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.limits.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestPurchaseLimits_IsAllowed(t *testing.T) {
+	a, b := testAddr(1), testAddr(2)
+	limits := types.PurchaseLimits{
+		MaxSpendPerAddressPerSegment: math.ZeroInt(),
+		AllowListUntilSegment:        3,
+		AllowList:                    []string{a},
+	}
+
+	require.True(t, limits.IsAllowed(a, 0))
+	require.False(t, limits.IsAllowed(b, 2))
+	require.True(t, limits.IsAllowed(b, 3))
+	require.False(t, limits.SpendLimited())
+}
@@ -0,0 +1,432 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: mychain/maincoin/v1/purchase_rate.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PurchaseRate is an authority-set TestUSD rate for a purchase denom
+type PurchaseRate struct {
+	// denom is the purchase denom being priced
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate is the TestUSD paid per unit of denom
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+	// updated_at is the block time the rate was set
+	UpdatedAt time.Time `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at"`
+}
+
+func (m *PurchaseRate) Reset()         { *m = PurchaseRate{} }
+func (m *PurchaseRate) String() string { return proto.CompactTextString(m) }
+func (*PurchaseRate) ProtoMessage()    {}
+func (*PurchaseRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c76dd2c3905820f4, []int{0}
+}
+func (m *PurchaseRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PurchaseRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PurchaseRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PurchaseRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurchaseRate.Merge(m, src)
+}
+func (m *PurchaseRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *PurchaseRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurchaseRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurchaseRate proto.InternalMessageInfo
+
+func (m *PurchaseRate) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *PurchaseRate) GetUpdatedAt() time.Time {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*PurchaseRate)(nil), "mychain.maincoin.v1.PurchaseRate")
+}
+
+func init() {
+	proto.RegisterFile("mychain/maincoin/v1/purchase_rate.proto", fileDescriptor_c76dd2c3905820f4)
+}
+
+var fileDescriptor_c76dd2c3905820f4 = []byte{
+	// 283 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0xbb, 0x4e, 0xc3, 0x30,
+	0x14, 0x86, 0x63, 0x6e, 0xa2, 0x86, 0x29, 0x74, 0x88, 0x82, 0xe4, 0x54, 0x30, 0xd0, 0xc9, 0x56,
+	0xcb, 0xc0, 0x4c, 0x60, 0x64, 0x40, 0x11, 0x13, 0x4b, 0xe5, 0x3a, 0x26, 0x89, 0xc0, 0x39, 0x51,
+	0xec, 0x54, 0xe4, 0x2d, 0xfa, 0x0c, 0x3c, 0x4d, 0xc7, 0x8e, 0x88, 0xa1, 0xa0, 0xe4, 0x45, 0x50,
+	0x6e, 0xea, 0xe6, 0x63, 0x7f, 0xe7, 0xf7, 0xa7, 0x1f, 0xdf, 0xa8, 0x52, 0xc4, 0x3c, 0x49, 0x99,
+	0xe2, 0x49, 0x2a, 0x20, 0x49, 0xd9, 0x6a, 0xc6, 0xb2, 0x22, 0x17, 0x31, 0xd7, 0x72, 0x91, 0x73,
+	0x23, 0x69, 0x96, 0x83, 0x01, 0xfb, 0xa2, 0x07, 0xe9, 0x00, 0xd2, 0xd5, 0xcc, 0x1d, 0x47, 0x10,
+	0x41, 0xfb, 0xce, 0x9a, 0x53, 0x87, 0xba, 0x5e, 0x04, 0x10, 0x7d, 0x48, 0xd6, 0x4e, 0xcb, 0xe2,
+	0x8d, 0x99, 0x44, 0x49, 0x6d, 0xb8, 0xca, 0x3a, 0xe0, 0xea, 0x0b, 0xe1, 0xf3, 0xe7, 0xfe, 0x8f,
+	0x80, 0x1b, 0x69, 0x8f, 0xf1, 0x71, 0x28, 0x53, 0x50, 0x0e, 0x9a, 0xa0, 0xe9, 0x28, 0xe8, 0x06,
+	0xfb, 0x0e, 0x1f, 0x35, 0x02, 0xce, 0x41, 0x73, 0xe9, 0x5f, 0x6f, 0x76, 0x9e, 0xf5, 0xb3, 0xf3,
+	0x2e, 0x05, 0x68, 0x05, 0x5a, 0x87, 0xef, 0x34, 0x01, 0xa6, 0xb8, 0x89, 0xe9, 0x93, 0x8c, 0xb8,
+	0x28, 0x1f, 0xa5, 0x08, 0xda, 0x05, 0xfb, 0x01, 0xe3, 0x22, 0x0b, 0xb9, 0x91, 0xe1, 0x82, 0x1b,
+	0xe7, 0x70, 0x82, 0xa6, 0x67, 0x73, 0x97, 0x76, 0x56, 0x74, 0xb0, 0xa2, 0x2f, 0x83, 0x95, 0x7f,
+	0xda, 0x44, 0xaf, 0x7f, 0x3d, 0x14, 0x8c, 0xfa, 0xbd, 0x7b, 0xe3, 0xcf, 0x37, 0x15, 0x41, 0xdb,
+	0x8a, 0xa0, 0xbf, 0x8a, 0xa0, 0x75, 0x4d, 0xac, 0x6d, 0x4d, 0xac, 0xef, 0x9a, 0x58, 0xaf, 0xce,
+	0xd0, 0xd9, 0xe7, 0xbe, 0x35, 0x53, 0x66, 0x52, 0x2f, 0x4f, 0xda, 0xf0, 0xdb, 0xff, 0x00, 0x00,
+	0x00, 0xff, 0xff, 0x23, 0x3c, 0xc9, 0xfc, 0x56, 0x01, 0x00, 0x00,
+}
+
+func (m *PurchaseRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PurchaseRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurchaseRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintPurchaseRate(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPurchaseRate(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintPurchaseRate(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPurchaseRate(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPurchaseRate(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PurchaseRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovPurchaseRate(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovPurchaseRate(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt)
+	n += 1 + l + sovPurchaseRate(uint64(l))
+	return n
+}
+
+func sovPurchaseRate(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPurchaseRate(x uint64) (n int) {
+	return sovPurchaseRate(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PurchaseRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPurchaseRate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurchaseRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurchaseRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPurchaseRate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPurchaseRate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPurchaseRate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPurchaseRate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPurchaseRate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPurchaseRate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPurchaseRate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPurchaseRate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPurchaseRate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPurchaseRate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPurchaseRate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPurchaseRate(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPurchaseRate
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPurchaseRate
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPurchaseRate
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPurchaseRate
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPurchaseRate
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPurchaseRate
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPurchaseRate        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPurchaseRate          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPurchaseRate = fmt.Errorf("proto: unexpected end of group")
+)
@@ -591,6 +591,8 @@ func (m *QuerySegmentHistoryAllResponse) GetPagination() *query.PageResponse {
 // QuerySegmentDetailsRequest is the request for specific segment details
 type QuerySegmentDetailsRequest struct {
 	SegmentNumber uint64 `protobuf:"varint,1,opt,name=segment_number,json=segmentNumber,proto3" json:"segment_number,omitempty"`
+	// pagination pages through the segment's transactions
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QuerySegmentDetailsRequest) Reset()         { *m = QuerySegmentDetailsRequest{} }
@@ -633,6 +635,13 @@ func (m *QuerySegmentDetailsRequest) GetSegmentNumber() uint64 {
 	return 0
 }
 
+func (m *QuerySegmentDetailsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // SegmentTransaction represents a transaction within a segment
 type SegmentTransaction struct {
 	TxHash       string                `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
@@ -787,7 +796,8 @@ func (m *SegmentDetails) GetTimeToComplete() int64 {
 
 // QuerySegmentDetailsResponse is the response for segment details
 type QuerySegmentDetailsResponse struct {
-	Details *SegmentDetails `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	Details    *SegmentDetails     `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QuerySegmentDetailsResponse) Reset()         { *m = QuerySegmentDetailsResponse{} }
@@ -830,6 +840,13 @@ func (m *QuerySegmentDetailsResponse) GetDetails() *SegmentDetails {
 	return nil
 }
 
+func (m *QuerySegmentDetailsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QuerySegmentStatisticsRequest is the request for segment statistics
 type QuerySegmentStatisticsRequest struct {
 }
@@ -1026,6 +1043,250 @@ func (m *QuerySegmentStatisticsResponse) GetStats() *SegmentStatistics {
 	return nil
 }
 
+// QueryDevVestingRequest is the request type for Query/DevVesting
+type QueryDevVestingRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDevVestingRequest) Reset()         { *m = QueryDevVestingRequest{} }
+func (m *QueryDevVestingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDevVestingRequest) ProtoMessage()    {}
+func (*QueryDevVestingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3da961af857ffeef, []int{19}
+}
+func (m *QueryDevVestingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDevVestingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDevVestingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDevVestingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDevVestingRequest.Merge(m, src)
+}
+func (m *QueryDevVestingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDevVestingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDevVestingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDevVestingRequest proto.InternalMessageInfo
+
+func (m *QueryDevVestingRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDevVestingResponse is the response type for Query/DevVesting
+type QueryDevVestingResponse struct {
+	Tranches []DevVestingTrancheStatus `protobuf:"bytes,1,rep,name=tranches,proto3" json:"tranches"`
+	// totals cover every tranche, not only the returned page
+	TotalVested   cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total_vested,json=totalVested,proto3,customtype=cosmossdk.io/math.Int" json:"total_vested"`
+	TotalUnvested cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=total_unvested,json=totalUnvested,proto3,customtype=cosmossdk.io/math.Int" json:"total_unvested"`
+	TotalClaimed  cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=total_claimed,json=totalClaimed,proto3,customtype=cosmossdk.io/math.Int" json:"total_claimed"`
+	Pagination    *query.PageResponse   `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDevVestingResponse) Reset()         { *m = QueryDevVestingResponse{} }
+func (m *QueryDevVestingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDevVestingResponse) ProtoMessage()    {}
+func (*QueryDevVestingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3da961af857ffeef, []int{20}
+}
+func (m *QueryDevVestingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDevVestingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDevVestingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDevVestingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDevVestingResponse.Merge(m, src)
+}
+func (m *QueryDevVestingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDevVestingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDevVestingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDevVestingResponse proto.InternalMessageInfo
+
+func (m *QueryDevVestingResponse) GetTranches() []DevVestingTrancheStatus {
+	if m != nil {
+		return m.Tranches
+	}
+	return nil
+}
+
+func (m *QueryDevVestingResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPurchaseAllowanceRequest is the request type for the Query/PurchaseAllowance RPC method.
+type QueryPurchaseAllowanceRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryPurchaseAllowanceRequest) Reset()         { *m = QueryPurchaseAllowanceRequest{} }
+func (m *QueryPurchaseAllowanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPurchaseAllowanceRequest) ProtoMessage()    {}
+func (*QueryPurchaseAllowanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3da961af857ffeef, []int{21}
+}
+func (m *QueryPurchaseAllowanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPurchaseAllowanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPurchaseAllowanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPurchaseAllowanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPurchaseAllowanceRequest.Merge(m, src)
+}
+func (m *QueryPurchaseAllowanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPurchaseAllowanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPurchaseAllowanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPurchaseAllowanceRequest proto.InternalMessageInfo
+
+func (m *QueryPurchaseAllowanceRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryPurchaseAllowanceResponse is the response type for the Query/PurchaseAllowance RPC method.
+type QueryPurchaseAllowanceResponse struct {
+	// segment is the current segment the allowance applies to
+	Segment uint64 `protobuf:"varint,1,opt,name=segment,proto3" json:"segment,omitempty"`
+	// spent is the TestUSD value the address has spent in the current segment
+	Spent cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=spent,proto3,customtype=cosmossdk.io/math.Int" json:"spent"`
+	// remaining_spend is the TestUSD value the address may still spend in the current
+	// segment; only meaningful when spend_limited is set
+	RemainingSpend cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=remaining_spend,json=remainingSpend,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_spend"`
+	// spend_limited reports whether a per-address spend limit is configured
+	SpendLimited bool `protobuf:"varint,4,opt,name=spend_limited,json=spendLimited,proto3" json:"spend_limited,omitempty"`
+	// remaining_block_segments is how many more segments purchases may advance in this
+	// block; only meaningful when block_limited is set
+	RemainingBlockSegments uint64 `protobuf:"varint,5,opt,name=remaining_block_segments,json=remainingBlockSegments,proto3" json:"remaining_block_segments,omitempty"`
+	// block_limited reports whether a per-block segment limit is configured
+	BlockLimited bool `protobuf:"varint,6,opt,name=block_limited,json=blockLimited,proto3" json:"block_limited,omitempty"`
+	// allow_list_active reports whether the allow-list phase is in effect
+	AllowListActive bool `protobuf:"varint,7,opt,name=allow_list_active,json=allowListActive,proto3" json:"allow_list_active,omitempty"`
+	// allowed reports whether the address may buy at all right now
+	Allowed bool `protobuf:"varint,8,opt,name=allowed,proto3" json:"allowed,omitempty"`
+}
+
+func (m *QueryPurchaseAllowanceResponse) Reset()         { *m = QueryPurchaseAllowanceResponse{} }
+func (m *QueryPurchaseAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPurchaseAllowanceResponse) ProtoMessage()    {}
+func (*QueryPurchaseAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3da961af857ffeef, []int{22}
+}
+func (m *QueryPurchaseAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPurchaseAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPurchaseAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPurchaseAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPurchaseAllowanceResponse.Merge(m, src)
+}
+func (m *QueryPurchaseAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPurchaseAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPurchaseAllowanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPurchaseAllowanceResponse proto.InternalMessageInfo
+
+func (m *QueryPurchaseAllowanceResponse) GetSegment() uint64 {
+	if m != nil {
+		return m.Segment
+	}
+	return 0
+}
+
+func (m *QueryPurchaseAllowanceResponse) GetSpendLimited() bool {
+	if m != nil {
+		return m.SpendLimited
+	}
+	return false
+}
+
+func (m *QueryPurchaseAllowanceResponse) GetRemainingBlockSegments() uint64 {
+	if m != nil {
+		return m.RemainingBlockSegments
+	}
+	return 0
+}
+
+func (m *QueryPurchaseAllowanceResponse) GetBlockLimited() bool {
+	if m != nil {
+		return m.BlockLimited
+	}
+	return false
+}
+
+func (m *QueryPurchaseAllowanceResponse) GetAllowListActive() bool {
+	if m != nil {
+		return m.AllowListActive
+	}
+	return false
+}
+
+func (m *QueryPurchaseAllowanceResponse) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "mychain.maincoin.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "mychain.maincoin.v1.QueryParamsResponse")
@@ -1046,114 +1307,139 @@ func init() {
 	proto.RegisterType((*QuerySegmentStatisticsRequest)(nil), "mychain.maincoin.v1.QuerySegmentStatisticsRequest")
 	proto.RegisterType((*SegmentStatistics)(nil), "mychain.maincoin.v1.SegmentStatistics")
 	proto.RegisterType((*QuerySegmentStatisticsResponse)(nil), "mychain.maincoin.v1.QuerySegmentStatisticsResponse")
+	proto.RegisterType((*QueryDevVestingRequest)(nil), "mychain.maincoin.v1.QueryDevVestingRequest")
+	proto.RegisterType((*QueryDevVestingResponse)(nil), "mychain.maincoin.v1.QueryDevVestingResponse")
+	proto.RegisterType((*QueryPurchaseAllowanceRequest)(nil), "mychain.maincoin.v1.QueryPurchaseAllowanceRequest")
+	proto.RegisterType((*QueryPurchaseAllowanceResponse)(nil), "mychain.maincoin.v1.QueryPurchaseAllowanceResponse")
 }
 
 func init() { proto.RegisterFile("mychain/maincoin/v1/query.proto", fileDescriptor_3da961af857ffeef) }
 
 var fileDescriptor_3da961af857ffeef = []byte{
-	// 1617 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x5b, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0xe6, 0xda, 0x9c, 0x5c, 0x9c, 0x4c, 0xd2, 0x7f, 0x5d, 0xa7, 0x4d, 0xfa, 0x77, 0x4a,
-	0x93, 0x94, 0xc6, 0xdb, 0xa4, 0xad, 0x10, 0x12, 0x45, 0xd4, 0x4d, 0x4b, 0x7a, 0x25, 0x75, 0x52,
-	0x1e, 0x10, 0x62, 0x35, 0x59, 0x4f, 0xec, 0x55, 0xf7, 0xd6, 0x9d, 0xb1, 0x49, 0x5a, 0x55, 0x42,
-	0xf0, 0x88, 0x10, 0x48, 0xa8, 0x0f, 0x7c, 0x03, 0x84, 0x84, 0x84, 0x10, 0xa8, 0x7c, 0x84, 0x3e,
-	0x56, 0xe2, 0x05, 0xf1, 0x50, 0xa1, 0x16, 0x89, 0x77, 0x3e, 0x01, 0xda, 0xb9, 0xac, 0x77, 0xe3,
-	0x75, 0xb2, 0xa9, 0xfa, 0x12, 0xc5, 0x67, 0xce, 0xef, 0xcc, 0x6f, 0xce, 0x6d, 0xce, 0x2c, 0xcc,
-	0x38, 0x3b, 0x66, 0x1d, 0x5b, 0xae, 0xee, 0x60, 0xcb, 0x35, 0x3d, 0xcb, 0xd5, 0x9b, 0x4b, 0xfa,
-	0xfd, 0x06, 0x09, 0x76, 0x4a, 0x7e, 0xe0, 0x31, 0x0f, 0x4d, 0x48, 0x85, 0x92, 0x52, 0x28, 0x35,
-	0x97, 0x0a, 0xe3, 0xd8, 0xb1, 0x5c, 0x4f, 0xe7, 0x7f, 0x85, 0x5e, 0xe1, 0xb4, 0xe9, 0x51, 0xc7,
-	0xa3, 0xfa, 0x26, 0xa6, 0x44, 0x18, 0xd0, 0x9b, 0x4b, 0x9b, 0x84, 0xe1, 0x25, 0xdd, 0xc7, 0x35,
-	0xcb, 0xc5, 0xcc, 0xf2, 0x5c, 0xa9, 0x3b, 0x59, 0xf3, 0x6a, 0x1e, 0xff, 0x57, 0x0f, 0xff, 0x93,
-	0xd2, 0x63, 0x35, 0xcf, 0xab, 0xd9, 0x44, 0xc7, 0xbe, 0xa5, 0x63, 0xd7, 0xf5, 0x18, 0x87, 0x50,
-	0xb9, 0x7a, 0x22, 0x8d, 0xa8, 0x8f, 0x03, 0xec, 0x28, 0x8d, 0x85, 0x34, 0x0d, 0x4a, 0x6a, 0x0e,
-	0x71, 0x99, 0x51, 0xb7, 0x28, 0xf3, 0xd4, 0xa1, 0x8a, 0x93, 0x80, 0xee, 0x84, 0x14, 0xd7, 0x38,
-	0xbe, 0x42, 0xee, 0x37, 0x08, 0x65, 0xc5, 0xbb, 0x30, 0x91, 0x90, 0x52, 0xdf, 0x73, 0x29, 0x41,
-	0xef, 0x42, 0xbf, 0xd8, 0x27, 0xaf, 0x9d, 0xd0, 0xe6, 0x87, 0x96, 0xa7, 0x4a, 0x29, 0x2e, 0x29,
-	0x09, 0x50, 0x79, 0xf0, 0xe9, 0xf3, 0x99, 0xae, 0xef, 0xff, 0xf9, 0xe9, 0xb4, 0x56, 0x91, 0xa8,
-	0x62, 0x01, 0xf2, 0xdc, 0xec, 0xe5, 0x46, 0x10, 0x10, 0x97, 0xad, 0x05, 0x96, 0x49, 0xd4, 0x96,
-	0x1f, 0xc2, 0xd1, 0x94, 0x35, 0xb9, 0xf1, 0xdb, 0xd0, 0xe7, 0x87, 0x02, 0xbe, 0xef, 0x60, 0x79,
-	0x36, 0x34, 0xfd, 0xe7, 0xf3, 0x99, 0x29, 0xe1, 0x69, 0x5a, 0xbd, 0x57, 0xb2, 0x3c, 0xdd, 0xc1,
-	0xac, 0x5e, 0xba, 0x49, 0x6a, 0xd8, 0xdc, 0x59, 0x21, 0x66, 0x45, 0x20, 0x8a, 0x47, 0xe1, 0x08,
-	0xb7, 0xbb, 0x2e, 0x8e, 0x7f, 0xcd, 0xdd, 0xf2, 0xd4, 0x96, 0xff, 0xf6, 0x48, 0x3e, 0x89, 0x35,
-	0xb9, 0xe5, 0x2c, 0x8c, 0x98, 0x82, 0x8a, 0x41, 0x7c, 0xcf, 0xac, 0xf3, 0xad, 0x7b, 0x2b, 0xc3,
-	0x52, 0x78, 0x25, 0x94, 0xa1, 0xd5, 0x96, 0x92, 0xe0, 0xd7, 0x9d, 0x9d, 0x9f, 0xb2, 0xc4, 0x4f,
-	0x8a, 0xde, 0x83, 0x61, 0xe6, 0x31, 0x6c, 0x1b, 0xb4, 0xe1, 0xfb, 0xf6, 0x4e, 0xbe, 0x87, 0x1b,
-	0x3a, 0x2e, 0x0d, 0x1d, 0x6e, 0x37, 0x74, 0xcd, 0x65, 0x95, 0x21, 0x0e, 0x59, 0xe7, 0x08, 0x74,
-	0x15, 0x72, 0x01, 0xa1, 0x24, 0x68, 0x12, 0x63, 0x13, 0xdb, 0xd8, 0x35, 0x49, 0xbe, 0x37, 0x8b,
-	0x91, 0x51, 0x89, 0x2a, 0x0b, 0x10, 0x2a, 0xc3, 0x08, 0xf3, 0xee, 0x11, 0x97, 0x1a, 0x2e, 0x21,
-	0x55, 0x52, 0xcd, 0xf7, 0x65, 0xb1, 0x32, 0x2c, 0x30, 0xb7, 0x39, 0x24, 0xf4, 0x8b, 0xe2, 0x12,
-	0x84, 0xb9, 0x9b, 0xef, 0x3f, 0x80, 0x5f, 0x24, 0xb2, 0x12, 0x02, 0xd1, 0x07, 0x30, 0x59, 0x25,
-	0x4d, 0x03, 0xdb, 0xb6, 0x67, 0xf2, 0x2a, 0x30, 0xf8, 0x99, 0xf3, 0x03, 0x59, 0x48, 0xa1, 0x2a,
-	0x69, 0x5e, 0x8a, 0x90, 0x1b, 0x21, 0xb0, 0xf8, 0xa5, 0x06, 0x85, 0x78, 0xd0, 0x57, 0x45, 0x39,
-	0xc8, 0x9c, 0x40, 0x6f, 0xc0, 0xa8, 0x2a, 0x14, 0xb7, 0xe1, 0x6c, 0x92, 0x40, 0xc6, 0x7d, 0x44,
-	0x4a, 0x6f, 0x73, 0x21, 0xba, 0x0a, 0xd0, 0xaa, 0x65, 0x1e, 0xf5, 0xa1, 0xe5, 0x53, 0x25, 0xc1,
-	0xa2, 0x14, 0x16, 0x7e, 0x49, 0x74, 0x0e, 0x59, 0xf8, 0xa5, 0x35, 0x5c, 0x53, 0x99, 0x5e, 0x89,
-	0x21, 0x8b, 0xbf, 0x68, 0x30, 0x95, 0xca, 0x46, 0x66, 0xe1, 0x4d, 0xc8, 0xed, 0xaa, 0x5b, 0x59,
-	0x7a, 0xb3, 0xa9, 0xa5, 0xb7, 0xcb, 0x8a, 0x3a, 0x8a, 0xfc, 0x8d, 0xde, 0x4f, 0x61, 0x3d, 0xb7,
-	0x2f, 0x6b, 0x41, 0x25, 0x41, 0xfb, 0x0b, 0x0d, 0x66, 0x38, 0xed, 0xbb, 0x94, 0x04, 0x6b, 0x8d,
-	0xc0, 0xac, 0x63, 0x4a, 0x76, 0x79, 0x32, 0x0f, 0x03, 0xb8, 0x5a, 0x0d, 0x08, 0x15, 0xdd, 0x62,
-	0xb0, 0xa2, 0x7e, 0xbe, 0x36, 0xe7, 0xfd, 0xa6, 0xc1, 0x89, 0xce, 0x2c, 0xa4, 0x07, 0x6f, 0xc0,
-	0x70, 0x83, 0x92, 0x60, 0x97, 0xfb, 0xe6, 0x53, 0xdd, 0x97, 0x66, 0x67, 0x28, 0x44, 0xbf, 0x76,
-	0x07, 0xd6, 0xe0, 0x78, 0x4a, 0xd8, 0x2f, 0xd9, 0xb6, 0xf2, 0x5e, 0xd2, 0x47, 0xda, 0x2b, 0xfb,
-	0xe8, 0x57, 0x0d, 0xa6, 0x3b, 0xed, 0x24, 0x3d, 0x74, 0x1d, 0x0e, 0xc9, 0x3c, 0x09, 0x23, 0xd5,
-	0xd3, 0xd1, 0x3b, 0x49, 0x0b, 0x57, 0x5c, 0x16, 0xec, 0x94, 0x7b, 0xc3, 0x02, 0xac, 0x44, 0xf8,
-	0xd7, 0xe7, 0xa0, 0xcb, 0xc9, 0x2a, 0x5d, 0x21, 0x0c, 0x5b, 0x36, 0x3d, 0x58, 0x95, 0x16, 0xbf,
-	0xea, 0x06, 0x24, 0x0d, 0x6c, 0x04, 0xd8, 0xa5, 0xd8, 0x0c, 0x6d, 0xa3, 0x23, 0x30, 0xc0, 0xb6,
-	0x8d, 0x3a, 0xa6, 0x75, 0x99, 0x99, 0xfd, 0x6c, 0x7b, 0x15, 0xd3, 0x3a, 0x9a, 0x84, 0xbe, 0xcd,
-	0xc6, 0x0e, 0x09, 0x44, 0x1b, 0xaf, 0x88, 0x1f, 0xb1, 0x86, 0xb8, 0xe9, 0x35, 0x6a, 0x75, 0x96,
-	0xad, 0x37, 0xcb, 0x86, 0x58, 0xe6, 0x90, 0xb0, 0xbd, 0x63, 0xc7, 0x6b, 0xb8, 0xcc, 0xa0, 0x3e,
-	0x71, 0x59, 0xb6, 0xce, 0x3c, 0x24, 0x20, 0xeb, 0x21, 0x02, 0x1d, 0x83, 0x41, 0x66, 0x39, 0x84,
-	0x32, 0xec, 0xf8, 0xbc, 0x25, 0xf7, 0x54, 0x5a, 0x02, 0x74, 0x32, 0x72, 0x08, 0x35, 0x2c, 0xd7,
-	0x60, 0xdb, 0xbc, 0xe3, 0x8e, 0x54, 0x86, 0x95, 0xf4, 0x9a, 0xbb, 0xb1, 0x5d, 0xfc, 0xba, 0x0f,
-	0x46, 0x93, 0x0e, 0x45, 0x65, 0x18, 0x90, 0x2a, 0x7b, 0x56, 0x46, 0x4a, 0xec, 0x2b, 0x0a, 0x88,
-	0xd6, 0x61, 0xcc, 0x0f, 0x48, 0xd3, 0xf2, 0x1a, 0xd4, 0x50, 0xc6, 0xba, 0x0f, 0x68, 0x2c, 0xa7,
-	0x2c, 0xc8, 0xc5, 0xb0, 0x6e, 0x59, 0x2b, 0x66, 0x34, 0xdf, 0xc3, 0x33, 0x73, 0x6e, 0x2f, 0x83,
-	0xb1, 0x18, 0x57, 0x12, 0x60, 0xb4, 0x08, 0x48, 0xdc, 0xae, 0x09, 0x93, 0xbd, 0x3c, 0x67, 0xc6,
-	0xf9, 0xca, 0x46, 0x5c, 0xfd, 0x0e, 0x1c, 0xc6, 0x4d, 0x12, 0xe0, 0x1a, 0x31, 0x7c, 0xd9, 0x0e,
-	0x0c, 0x6a, 0x3d, 0x20, 0xd9, 0xae, 0xc2, 0x09, 0x89, 0x55, 0x9d, 0x64, 0xdd, 0x7a, 0x40, 0xd0,
-	0x2a, 0x8c, 0xd9, 0x38, 0xa8, 0x11, 0xca, 0x22, 0x93, 0xf2, 0x52, 0xdc, 0xc7, 0x5a, 0x4e, 0xc2,
-	0x94, 0x35, 0x74, 0x1d, 0xc6, 0xa9, 0x83, 0x6d, 0x3b, 0x61, 0x2a, 0xd3, 0x75, 0x38, 0xa6, 0x70,
-	0x91, 0xad, 0x79, 0x18, 0x0b, 0x73, 0xc8, 0x60, 0x9e, 0x61, 0x7a, 0x8e, 0x6f, 0x13, 0x46, 0xf2,
-	0x87, 0x78, 0x6e, 0x8d, 0x86, 0xf2, 0x0d, 0xef, 0xb2, 0x94, 0xa2, 0xbb, 0x70, 0x24, 0x71, 0xa3,
-	0x1b, 0x55, 0xd2, 0xb4, 0x44, 0x95, 0x0f, 0x66, 0xd9, 0xfb, 0x70, 0xfc, 0x56, 0x5f, 0x51, 0xd8,
-	0xe2, 0xc7, 0xc9, 0xeb, 0x2f, 0x2a, 0x73, 0xd9, 0x9a, 0x2e, 0xc2, 0x40, 0x55, 0x88, 0xb2, 0x5c,
-	0x7b, 0x0a, 0xad, 0x30, 0xc5, 0x99, 0x64, 0x97, 0x5d, 0x0f, 0xe7, 0x68, 0xca, 0x2c, 0x33, 0x9a,
-	0x73, 0x1f, 0xf7, 0xc3, 0x78, 0xdb, 0x62, 0xd8, 0x5d, 0xe4, 0x2c, 0xd6, 0x6a, 0x8b, 0xbc, 0xbb,
-	0x88, 0x71, 0x4b, 0xf5, 0xba, 0x1b, 0x2a, 0xa9, 0x1c, 0x33, 0x0a, 0x44, 0x55, 0x4e, 0x80, 0xfb,
-	0x45, 0x82, 0x03, 0x6f, 0x99, 0x2a, 0x10, 0x55, 0x74, 0x0b, 0x26, 0x84, 0xb1, 0xd8, 0xb4, 0x43,
-	0xaa, 0xd9, 0x5a, 0x8d, 0xc8, 0xe0, 0x95, 0x68, 0xd6, 0x21, 0x55, 0xb4, 0xa2, 0x8e, 0x20, 0xdd,
-	0x4e, 0xb3, 0x75, 0x1c, 0x71, 0xc2, 0x8a, 0xc4, 0xa0, 0xb3, 0x30, 0xa9, 0xea, 0x40, 0xb5, 0xdb,
-	0x30, 0x2d, 0x64, 0xfb, 0x41, 0x72, 0x4d, 0x55, 0x9f, 0xe5, 0x10, 0x34, 0x07, 0xb9, 0x2d, 0x4c,
-	0x59, 0x98, 0x9b, 0xaa, 0x13, 0xf4, 0x73, 0xdf, 0x8d, 0x4a, 0xb1, 0x2a, 0xef, 0x39, 0xc8, 0x51,
-	0xdb, 0xfb, 0x34, 0xae, 0x38, 0x20, 0x14, 0xa5, 0x58, 0x29, 0x9e, 0x87, 0xff, 0xf9, 0x24, 0xd8,
-	0x22, 0x26, 0x93, 0x89, 0x17, 0x05, 0xe5, 0x10, 0xd7, 0x9f, 0x94, 0xab, 0x3c, 0xb1, 0xa2, 0xd8,
-	0x2c, 0xc0, 0x58, 0x95, 0x6c, 0x59, 0xa6, 0xc5, 0x5a, 0xfa, 0x83, 0x5c, 0x3f, 0x27, 0xe5, 0x71,
-	0x55, 0xda, 0x08, 0x7c, 0xbb, 0xd5, 0xbc, 0x68, 0x1e, 0x84, 0xaa, 0x94, 0x47, 0xaa, 0x73, 0x90,
-	0x53, 0xe3, 0xbe, 0x22, 0x3d, 0x24, 0x48, 0x4b, 0xb1, 0x22, 0xdd, 0xf6, 0x2e, 0x18, 0x7e, 0xd5,
-	0x77, 0xc1, 0x0a, 0x8c, 0x46, 0x5b, 0x8a, 0x97, 0xc1, 0x48, 0xa6, 0x40, 0x2a, 0x42, 0xe2, 0x6d,
-	0x50, 0x82, 0x09, 0x1b, 0xc7, 0xa3, 0x22, 0xe2, 0x38, 0xca, 0xe3, 0x38, 0x2e, 0x96, 0x62, 0x61,
-	0x2c, 0x7e, 0x92, 0x1c, 0x1a, 0xe2, 0x85, 0x23, 0x2b, 0xf3, 0x1d, 0xe8, 0xa3, 0x0c, 0x33, 0x1a,
-	0x8d, 0x26, 0x7b, 0xd4, 0x65, 0x0c, 0x2e, 0x40, 0xcb, 0x4f, 0x00, 0xfa, 0xf8, 0x06, 0xe8, 0x33,
-	0x0d, 0xfa, 0xc5, 0x83, 0x11, 0xa5, 0xf7, 0xf6, 0xf6, 0xd7, 0x69, 0x61, 0x7e, 0x7f, 0x45, 0xc1,
-	0xb2, 0x38, 0xfb, 0xf9, 0xef, 0x7f, 0x7f, 0xdb, 0x7d, 0x1c, 0x4d, 0xe9, 0x9d, 0xdf, 0xcc, 0xe8,
-	0x3b, 0x0d, 0x86, 0xe3, 0xaf, 0x4e, 0xb4, 0xd8, 0xd9, 0x7e, 0xca, 0xcb, 0xb5, 0x50, 0xca, 0xaa,
-	0x2e, 0x49, 0x9d, 0xe6, 0xa4, 0x4e, 0xa2, 0x62, 0x2a, 0xa9, 0x44, 0xde, 0xa0, 0xc7, 0x1a, 0x0c,
-	0xc5, 0x5e, 0xa7, 0xe8, 0x4c, 0xe7, 0xbd, 0xda, 0x1f, 0xb8, 0x85, 0xc5, 0x8c, 0xda, 0x92, 0xd8,
-	0x02, 0x27, 0x36, 0x8b, 0xfe, 0xaf, 0xef, 0xf5, 0xfd, 0xc0, 0x0a, 0x79, 0xfc, 0xac, 0x45, 0x93,
-	0x84, 0x9a, 0x8d, 0xf5, 0x7d, 0x37, 0x4b, 0x3e, 0x10, 0x0a, 0x67, 0xb3, 0x03, 0x24, 0xc1, 0x8b,
-	0x9c, 0xe0, 0x5b, 0xe8, 0x82, 0x9e, 0xe1, 0x03, 0x87, 0xfe, 0x30, 0x39, 0x22, 0x3e, 0x42, 0x4f,
-	0x34, 0x98, 0x48, 0x19, 0xf1, 0xd1, 0xf9, 0xce, 0x44, 0x3a, 0xbf, 0x6f, 0x0a, 0x17, 0x0e, 0x88,
-	0x92, 0x67, 0x38, 0xc7, 0xcf, 0xb0, 0x88, 0xde, 0x4c, 0x3d, 0x43, 0xfc, 0xa9, 0xa2, 0x3f, 0x94,
-	0x0f, 0xa6, 0x47, 0xe8, 0x07, 0x2d, 0xba, 0xa7, 0x5a, 0x03, 0x3c, 0x5a, 0xce, 0xea, 0xc0, 0xd6,
-	0xbb, 0xa2, 0x70, 0xee, 0x40, 0x18, 0xc9, 0xf9, 0x0c, 0xe7, 0x7c, 0x0a, 0x9d, 0xdc, 0xcb, 0xef,
-	0x8b, 0x92, 0x76, 0x48, 0x76, 0xf7, 0x94, 0xb9, 0x7f, 0x6e, 0x24, 0x07, 0xfc, 0x0c, 0xb9, 0xb1,
-	0x6b, 0x54, 0x28, 0x5e, 0xe0, 0x1c, 0x75, 0xb4, 0xb8, 0x17, 0xc7, 0xf6, 0x9c, 0xf8, 0x51, 0x4b,
-	0x9b, 0x00, 0xf6, 0xf7, 0x6c, 0xdb, 0x2c, 0x91, 0xc1, 0xb3, 0xed, 0x6d, 0xb4, 0xa8, 0x73, 0xd6,
-	0x0b, 0x68, 0x6e, 0x4f, 0xcf, 0xd2, 0x08, 0x58, 0x5e, 0x7e, 0xfa, 0x62, 0x5a, 0x7b, 0xf6, 0x62,
-	0x5a, 0xfb, 0xeb, 0xc5, 0xb4, 0xf6, 0xcd, 0xcb, 0xe9, 0xae, 0x67, 0x2f, 0xa7, 0xbb, 0xfe, 0x78,
-	0x39, 0xdd, 0xf5, 0x51, 0x5e, 0x59, 0xd8, 0x6e, 0xd9, 0x60, 0x3b, 0x3e, 0xa1, 0x9b, 0xfd, 0xfc,
-	0x53, 0xdf, 0xb9, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x61, 0x6e, 0x91, 0x91, 0xe2, 0x14, 0x00,
-	0x00,
+	// 1955 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x5b, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf6, 0x4a, 0xa2, 0x2e, 0xa3, 0x0b, 0xa5, 0x91, 0x6c, 0x33, 0x74, 0x2c, 0xb9, 0x2b, 0x27,
+	0x92, 0x1d, 0x8b, 0x1b, 0x49, 0x31, 0x5a, 0x03, 0x4d, 0x51, 0xd3, 0x4a, 0x6a, 0x27, 0x8e, 0xeb,
+	0xac, 0x64, 0x3f, 0xf4, 0xa1, 0xdb, 0xe1, 0xee, 0x98, 0x5c, 0x78, 0x6f, 0xd9, 0x19, 0xd2, 0x52,
+	0x82, 0x00, 0xbd, 0xa0, 0x4f, 0x45, 0xd1, 0x16, 0x45, 0x1e, 0xfa, 0x03, 0x0a, 0x14, 0x05, 0x0a,
+	0x14, 0x45, 0x8b, 0xf6, 0x27, 0xe4, 0x31, 0x40, 0x5f, 0x8a, 0x3e, 0x04, 0x85, 0x5d, 0xa0, 0xef,
+	0xfd, 0x01, 0x45, 0x31, 0xb7, 0xbd, 0x90, 0x4b, 0x72, 0x65, 0x08, 0xc8, 0x8b, 0xa0, 0x3d, 0x73,
+	0xbe, 0x33, 0xdf, 0x9c, 0x33, 0xe7, 0xcc, 0x99, 0x21, 0xd8, 0xf0, 0x4f, 0xec, 0x0e, 0x72, 0x03,
+	0xc3, 0x47, 0x6e, 0x60, 0x87, 0x6e, 0x60, 0xf4, 0x76, 0x8d, 0x8f, 0xba, 0x38, 0x3e, 0x69, 0x44,
+	0x71, 0x48, 0x43, 0xb8, 0x2a, 0x15, 0x1a, 0x4a, 0xa1, 0xd1, 0xdb, 0xad, 0xaf, 0x20, 0xdf, 0x0d,
+	0x42, 0x83, 0xff, 0x15, 0x7a, 0xf5, 0xeb, 0x76, 0x48, 0xfc, 0x90, 0x18, 0x2d, 0x44, 0xb0, 0x30,
+	0x60, 0xf4, 0x76, 0x5b, 0x98, 0xa2, 0x5d, 0x23, 0x42, 0x6d, 0x37, 0x40, 0xd4, 0x0d, 0x03, 0xa9,
+	0xbb, 0xd6, 0x0e, 0xdb, 0x21, 0xff, 0xd7, 0x60, 0xff, 0x49, 0xe9, 0xab, 0xed, 0x30, 0x6c, 0x7b,
+	0xd8, 0x40, 0x91, 0x6b, 0xa0, 0x20, 0x08, 0x29, 0x87, 0x10, 0x39, 0xfa, 0x5a, 0x11, 0x51, 0x07,
+	0xf7, 0xac, 0x1e, 0x26, 0xd4, 0x0d, 0xda, 0x52, 0xed, 0x4a, 0x91, 0x5a, 0x84, 0x62, 0xe4, 0x2b,
+	0x43, 0xd7, 0x8a, 0x34, 0x08, 0x6e, 0xfb, 0x38, 0xa0, 0x56, 0xc7, 0x25, 0x34, 0x54, 0x6b, 0xd7,
+	0xd7, 0x00, 0xfc, 0x90, 0xad, 0xe4, 0x21, 0xc7, 0x9b, 0xf8, 0xa3, 0x2e, 0x26, 0x54, 0x7f, 0x04,
+	0x56, 0x73, 0x52, 0x12, 0x85, 0x01, 0xc1, 0xf0, 0x5b, 0x60, 0x5a, 0xcc, 0x53, 0xd3, 0xae, 0x68,
+	0xdb, 0xf3, 0x7b, 0x97, 0x1a, 0x05, 0x9e, 0x6b, 0x08, 0x50, 0x73, 0xee, 0xf3, 0x2f, 0x37, 0xce,
+	0xfd, 0xee, 0x3f, 0x7f, 0xbc, 0xae, 0x99, 0x12, 0xa5, 0xd7, 0x41, 0x8d, 0x9b, 0xbd, 0xd3, 0x8d,
+	0x63, 0x1c, 0xd0, 0x87, 0xb1, 0x6b, 0x63, 0x35, 0xe5, 0x63, 0xf0, 0x4a, 0xc1, 0x98, 0x9c, 0xf8,
+	0x16, 0xa8, 0x44, 0x4c, 0xc0, 0xe7, 0x9d, 0x6b, 0x6e, 0x32, 0xd3, 0xff, 0xfc, 0x72, 0xe3, 0x92,
+	0x08, 0x08, 0x71, 0x9e, 0x36, 0xdc, 0xd0, 0xf0, 0x11, 0xed, 0x34, 0xee, 0xe3, 0x36, 0xb2, 0x4f,
+	0x0e, 0xb0, 0x6d, 0x0a, 0x84, 0xfe, 0x0a, 0xb8, 0xc8, 0xed, 0x1e, 0x8a, 0xe5, 0xdf, 0x0b, 0x9e,
+	0x84, 0x6a, 0xca, 0xff, 0x4e, 0x4a, 0x3e, 0xb9, 0x31, 0x39, 0xe5, 0x26, 0x58, 0xb4, 0x05, 0x15,
+	0x0b, 0x47, 0xa1, 0xdd, 0xe1, 0x53, 0x4f, 0x99, 0x0b, 0x52, 0xf8, 0x0e, 0x93, 0xc1, 0xbb, 0xa9,
+	0x92, 0xe0, 0x37, 0x51, 0x9e, 0x9f, 0xb2, 0xc4, 0x57, 0x0a, 0xbf, 0x0d, 0x16, 0x68, 0x48, 0x91,
+	0x67, 0x91, 0x6e, 0x14, 0x79, 0x27, 0xb5, 0x49, 0x6e, 0xe8, 0xb2, 0x34, 0x74, 0x7e, 0xd0, 0xd0,
+	0xbd, 0x80, 0x9a, 0xf3, 0x1c, 0x72, 0xc8, 0x11, 0xf0, 0x5d, 0x50, 0x8d, 0x31, 0xc1, 0x71, 0x0f,
+	0x5b, 0x2d, 0xe4, 0xa1, 0xc0, 0xc6, 0xb5, 0xa9, 0x32, 0x46, 0x96, 0x24, 0xaa, 0x29, 0x40, 0xb0,
+	0x09, 0x16, 0x69, 0xf8, 0x14, 0x07, 0xc4, 0x0a, 0x30, 0x76, 0xb0, 0x53, 0xab, 0x94, 0xb1, 0xb2,
+	0x20, 0x30, 0x0f, 0x38, 0x84, 0xf9, 0x45, 0x71, 0x89, 0xd9, 0x16, 0xaf, 0x4d, 0x9f, 0xc2, 0x2f,
+	0x12, 0x69, 0x32, 0x20, 0xfc, 0x2e, 0x58, 0x63, 0x19, 0x80, 0x3c, 0x2f, 0xb4, 0x79, 0xb2, 0x58,
+	0x7c, 0xcd, 0xb5, 0x99, 0x32, 0xa4, 0xa0, 0x83, 0x7b, 0xb7, 0x13, 0xe4, 0x11, 0x03, 0xea, 0x3f,
+	0xd3, 0x40, 0x3d, 0x1b, 0xf4, 0xbb, 0x22, 0x1d, 0xe4, 0x9e, 0x80, 0xaf, 0x81, 0x25, 0x95, 0x28,
+	0x41, 0xd7, 0x6f, 0xe1, 0x58, 0xc6, 0x7d, 0x51, 0x4a, 0x1f, 0x70, 0x21, 0x7c, 0x17, 0x80, 0x34,
+	0xe5, 0x79, 0xd4, 0xe7, 0xf7, 0x5e, 0x6f, 0x08, 0x16, 0x0d, 0x56, 0x1f, 0x1a, 0xa2, 0xc0, 0xc8,
+	0xfa, 0xd0, 0x78, 0x88, 0xda, 0x6a, 0xa7, 0x9b, 0x19, 0xa4, 0xfe, 0x67, 0x0d, 0x5c, 0x2a, 0x64,
+	0x23, 0x77, 0xe1, 0x7d, 0x50, 0xed, 0xcb, 0x5b, 0x99, 0x7a, 0x9b, 0x85, 0xa9, 0xd7, 0x67, 0x45,
+	0x2d, 0x45, 0x7e, 0xc3, 0xef, 0x14, 0xb0, 0xde, 0x1a, 0xcb, 0x5a, 0x50, 0xc9, 0xd1, 0xfe, 0x89,
+	0x06, 0x36, 0x38, 0xed, 0x47, 0x04, 0xc7, 0x0f, 0xbb, 0xb1, 0xdd, 0x41, 0x04, 0xf7, 0x79, 0xb2,
+	0x06, 0x66, 0x90, 0xe3, 0xc4, 0x98, 0x88, 0x6a, 0x31, 0x67, 0xaa, 0xcf, 0x33, 0x73, 0xde, 0xdf,
+	0x34, 0x70, 0x65, 0x38, 0x0b, 0xe9, 0xc1, 0xf7, 0xc1, 0x42, 0x97, 0xe0, 0xb8, 0xcf, 0x7d, 0xdb,
+	0x85, 0xee, 0x2b, 0xb2, 0x33, 0xcf, 0xd0, 0x67, 0xee, 0xc0, 0x36, 0xb8, 0x5c, 0x10, 0xf6, 0xdb,
+	0x9e, 0xa7, 0xbc, 0x97, 0xf7, 0x91, 0xf6, 0xd2, 0x3e, 0xfa, 0x8b, 0x06, 0xd6, 0x87, 0xcd, 0x24,
+	0x3d, 0xf4, 0x1e, 0x98, 0x95, 0xfb, 0x84, 0x45, 0x6a, 0x72, 0xa8, 0x77, 0xf2, 0x16, 0xde, 0x09,
+	0x68, 0x7c, 0xd2, 0x9c, 0x62, 0x09, 0x68, 0x26, 0xf8, 0xb3, 0x73, 0x50, 0x7f, 0x9a, 0x1e, 0x60,
+	0x8a, 0x5c, 0x8f, 0x7c, 0x45, 0x69, 0xfa, 0xf3, 0x09, 0x00, 0x25, 0x91, 0xa3, 0x18, 0x05, 0x04,
+	0xd9, 0x4c, 0x0c, 0x2f, 0x82, 0x19, 0x7a, 0x6c, 0x75, 0x10, 0xe9, 0xc8, 0x2d, 0x3e, 0x4d, 0x8f,
+	0xef, 0x22, 0xd2, 0x81, 0x6b, 0xa0, 0xd2, 0xea, 0x9e, 0xe0, 0x58, 0x9c, 0x07, 0xa6, 0xf8, 0xc8,
+	0x54, 0xd6, 0x56, 0xd8, 0x6d, 0x77, 0x68, 0xb9, 0x22, 0x2f, 0x2b, 0x6b, 0x93, 0x43, 0xd8, 0x39,
+	0x81, 0xfc, 0xb0, 0x1b, 0x50, 0x8b, 0x44, 0x38, 0xa0, 0xe5, 0x4a, 0xfc, 0xbc, 0x80, 0x1c, 0x32,
+	0x04, 0x7c, 0x15, 0xcc, 0x51, 0xd7, 0xc7, 0x84, 0x22, 0x3f, 0xe2, 0xb5, 0x7d, 0xd2, 0x4c, 0x05,
+	0xf0, 0x6a, 0xe2, 0x58, 0x62, 0xb9, 0x81, 0x45, 0x8f, 0x79, 0xe9, 0x5e, 0x34, 0x17, 0x94, 0xf4,
+	0x5e, 0x70, 0x74, 0xac, 0xff, 0xa2, 0x02, 0x96, 0xf2, 0x81, 0x81, 0x4d, 0x30, 0x23, 0x55, 0x46,
+	0xa6, 0x58, 0xc1, 0x26, 0x32, 0x15, 0x10, 0x1e, 0x82, 0xe5, 0x28, 0xc6, 0x3d, 0x37, 0xec, 0x12,
+	0x4b, 0x19, 0x9b, 0x38, 0xa5, 0xb1, 0xaa, 0xb2, 0x20, 0x07, 0x59, 0x01, 0xa0, 0x69, 0xcc, 0x48,
+	0x6d, 0x92, 0x6f, 0xf1, 0xad, 0x51, 0x06, 0x33, 0x31, 0x36, 0x73, 0x60, 0xb8, 0x03, 0xa0, 0x38,
+	0xa6, 0x73, 0x26, 0xa7, 0xf8, 0xde, 0x5b, 0xe1, 0x23, 0x47, 0x59, 0xf5, 0x0f, 0xc1, 0x79, 0xd4,
+	0xc3, 0x31, 0x6a, 0x63, 0x2b, 0x92, 0x75, 0xc5, 0x22, 0xee, 0xc7, 0xb8, 0xdc, 0x99, 0xba, 0x2a,
+	0xb1, 0xaa, 0x24, 0x1d, 0xba, 0x1f, 0x63, 0x78, 0x17, 0x2c, 0x7b, 0x28, 0x6e, 0x63, 0x42, 0x13,
+	0x93, 0xf2, 0x74, 0x1d, 0x63, 0xad, 0x2a, 0x61, 0xca, 0x1a, 0x7c, 0x0f, 0xac, 0x10, 0x1f, 0x79,
+	0x5e, 0xce, 0x54, 0xa9, 0x73, 0x75, 0x59, 0xe1, 0x12, 0x5b, 0xdb, 0x60, 0x99, 0xed, 0x21, 0x8b,
+	0x86, 0x96, 0x1d, 0xfa, 0x91, 0x87, 0x29, 0xae, 0xcd, 0xf2, 0xbd, 0xb5, 0xc4, 0xe4, 0x47, 0xe1,
+	0x1d, 0x29, 0x85, 0x8f, 0xc0, 0xc5, 0x5c, 0x6b, 0x60, 0x39, 0xb8, 0xe7, 0x8a, 0xfc, 0x9c, 0x2b,
+	0x33, 0xf7, 0xf9, 0x6c, 0x7b, 0x70, 0xa0, 0xb0, 0xfa, 0x6f, 0xfb, 0x0e, 0xd2, 0xa4, 0x5e, 0xc8,
+	0x22, 0xf7, 0x36, 0x98, 0x71, 0x84, 0xa8, 0xcc, 0x01, 0xaa, 0xd0, 0x0a, 0x73, 0x76, 0x75, 0x6d,
+	0x23, 0x5f, 0xf8, 0x0f, 0xd9, 0x0d, 0x80, 0x50, 0xd7, 0x4e, 0x5a, 0xef, 0xcf, 0xa6, 0xc1, 0xca,
+	0xc0, 0x20, 0xab, 0x77, 0xb2, 0x3d, 0x4c, 0x2b, 0x35, 0xaf, 0x77, 0xa2, 0x03, 0x54, 0xe5, 0xf7,
+	0x7d, 0xb5, 0x3d, 0x7d, 0x3b, 0x09, 0xa9, 0x23, 0x9b, 0xd2, 0x71, 0x31, 0xe5, 0xc0, 0x0f, 0x6c,
+	0x15, 0x52, 0x07, 0x7e, 0x00, 0x56, 0x85, 0xb1, 0x4c, 0x03, 0x86, 0x9d, 0x72, 0x45, 0x4b, 0xe4,
+	0xc2, 0x41, 0xd2, 0x7e, 0x61, 0x07, 0x1e, 0xa8, 0x25, 0xc8, 0x00, 0x92, 0x72, 0xb5, 0x4b, 0xac,
+	0xd0, 0x94, 0x18, 0xf8, 0x26, 0x58, 0x53, 0x19, 0xa5, 0x0e, 0x00, 0xb6, 0xc1, 0x64, 0x21, 0x83,
+	0x72, 0x4c, 0xe5, 0xb1, 0xeb, 0x63, 0xb8, 0x05, 0xaa, 0x4f, 0x10, 0xa1, 0x6c, 0x97, 0xab, 0x9a,
+	0x32, 0xcd, 0x7d, 0xb7, 0x24, 0xc5, 0xaa, 0x50, 0x6c, 0x81, 0x2a, 0xf1, 0xc2, 0x67, 0x59, 0xc5,
+	0x19, 0xa1, 0x28, 0xc5, 0x4a, 0xf1, 0x2d, 0x70, 0x21, 0xc2, 0xf1, 0x13, 0x6c, 0x53, 0xb9, 0x85,
+	0x93, 0xa0, 0xcc, 0x72, 0xfd, 0x35, 0x39, 0xca, 0xb7, 0x68, 0x12, 0x9b, 0x6b, 0x60, 0xd9, 0xc1,
+	0x4f, 0x5c, 0xdb, 0xa5, 0xa9, 0xfe, 0x1c, 0xd7, 0xaf, 0x4a, 0x79, 0x56, 0x95, 0x74, 0xe3, 0xc8,
+	0x4b, 0xcb, 0x20, 0xa9, 0x01, 0xa1, 0x2a, 0xe5, 0x89, 0xea, 0x16, 0xa8, 0xaa, 0x1b, 0x88, 0x22,
+	0x3d, 0x2f, 0x48, 0x4b, 0xb1, 0x22, 0x3d, 0x70, 0x55, 0x59, 0x78, 0xd9, 0xab, 0xca, 0x01, 0x58,
+	0x4a, 0xa6, 0x14, 0x97, 0x95, 0xc5, 0x52, 0x81, 0x54, 0x84, 0xc4, 0x75, 0xa5, 0x01, 0x56, 0x3d,
+	0x94, 0x8d, 0x8a, 0x88, 0xe3, 0x12, 0x8f, 0xe3, 0x8a, 0x18, 0xca, 0x84, 0x51, 0xff, 0x7e, 0xbe,
+	0x8f, 0xc9, 0x26, 0x8e, 0x4c, 0xf1, 0x6f, 0x82, 0x0a, 0xa1, 0x88, 0x92, 0xa4, 0x5b, 0x1a, 0x91,
+	0xe0, 0x19, 0xb8, 0x00, 0xe9, 0x3f, 0x00, 0x17, 0xb8, 0xfd, 0x03, 0xdc, 0x7b, 0x2c, 0xae, 0xdb,
+	0x67, 0xdd, 0x8a, 0xfd, 0x6f, 0x42, 0x5e, 0x45, 0xb3, 0x53, 0x48, 0xee, 0x0f, 0xc0, 0x2c, 0x3b,
+	0x51, 0xec, 0x0e, 0x56, 0x3d, 0xd8, 0x8d, 0x42, 0xfa, 0x29, 0xf4, 0x48, 0xa8, 0xb3, 0x85, 0x74,
+	0x89, 0xea, 0xc3, 0x94, 0x8d, 0xf4, 0x3a, 0xd9, 0xc3, 0x84, 0x96, 0x2d, 0x01, 0xe2, 0x3a, 0xf9,
+	0x98, 0x23, 0xd2, 0x74, 0xed, 0x06, 0xd2, 0xc6, 0x64, 0xf9, 0x74, 0x7d, 0x24, 0x31, 0xa2, 0xe5,
+	0x61, 0x56, 0x6c, 0x0f, 0xb9, 0x3e, 0x76, 0xca, 0xe5, 0xbc, 0xe0, 0x7e, 0x47, 0x40, 0xfa, 0x6a,
+	0x6f, 0xe5, 0xe5, 0x6b, 0xef, 0x2d, 0x59, 0x7b, 0x55, 0x89, 0x63, 0xb5, 0xe9, 0x19, 0xbb, 0xf3,
+	0x8e, 0xbd, 0xb2, 0xe8, 0x3f, 0x9a, 0x94, 0xdb, 0xaf, 0x00, 0x2b, 0x43, 0x58, 0xcb, 0x37, 0x40,
+	0x53, 0x69, 0x5b, 0xb3, 0x0f, 0x2a, 0xa2, 0x59, 0x2b, 0x15, 0x05, 0xa1, 0x2b, 0xae, 0xf3, 0x2c,
+	0xf4, 0x6e, 0xd0, 0xe6, 0xbd, 0x5e, 0xc9, 0x00, 0x2c, 0x25, 0x28, 0xd6, 0xee, 0x39, 0x70, 0x13,
+	0x2c, 0x72, 0xb4, 0xe5, 0xb9, 0xbe, 0x4b, 0x65, 0x04, 0x66, 0xcd, 0x05, 0x2e, 0xbc, 0x2f, 0x64,
+	0xf0, 0x1b, 0xa0, 0x96, 0x4e, 0xd6, 0xf2, 0x42, 0xfb, 0x69, 0x5a, 0x78, 0x2a, 0x7c, 0x31, 0x17,
+	0x92, 0xf1, 0x26, 0x1b, 0x4e, 0xea, 0xcf, 0x26, 0x58, 0x14, 0xfa, 0xca, 0xfc, 0xb4, 0x30, 0xcf,
+	0x85, 0xca, 0xfc, 0x75, 0xb0, 0xc2, 0xce, 0x8f, 0x67, 0x96, 0xe7, 0x12, 0x6a, 0xb1, 0xe6, 0xa8,
+	0x27, 0x3a, 0x8d, 0x59, 0xb3, 0xca, 0x07, 0xee, 0xbb, 0x84, 0xde, 0xe6, 0x62, 0x1e, 0x03, 0x26,
+	0xc2, 0x0e, 0xaf, 0xa6, 0xb3, 0xa6, 0xfa, 0xdc, 0xfb, 0xe9, 0x22, 0xa8, 0xf0, 0x18, 0xc0, 0x1f,
+	0x6a, 0x60, 0x5a, 0xbc, 0x32, 0xc1, 0xe2, 0x3e, 0x6e, 0xf0, 0x49, 0xab, 0xbe, 0x3d, 0x5e, 0x51,
+	0x04, 0x52, 0xdf, 0xfc, 0xf1, 0xdf, 0xff, 0xfd, 0xeb, 0x89, 0xcb, 0xf0, 0x92, 0x31, 0xfc, 0xa1,
+	0x0d, 0xfe, 0x46, 0x03, 0x0b, 0xd9, 0xa7, 0x2a, 0xb8, 0x33, 0xdc, 0x7e, 0xc1, 0x73, 0x57, 0xbd,
+	0x51, 0x56, 0x5d, 0x92, 0xba, 0xce, 0x49, 0x5d, 0x85, 0x7a, 0x21, 0xa9, 0x5c, 0x65, 0x87, 0x9f,
+	0x69, 0x60, 0x3e, 0xf3, 0xa4, 0x05, 0x6f, 0x0c, 0x9f, 0x6b, 0xf0, 0x55, 0xac, 0xbe, 0x53, 0x52,
+	0x5b, 0x12, 0xbb, 0xc6, 0x89, 0x6d, 0xc2, 0xaf, 0x19, 0xa3, 0x1e, 0x1d, 0x5d, 0xc6, 0xe3, 0x4f,
+	0x5a, 0x72, 0x6b, 0x50, 0x17, 0x6a, 0x63, 0xec, 0x64, 0xf9, 0x57, 0x85, 0xfa, 0x9b, 0xe5, 0x01,
+	0x92, 0xe0, 0xdb, 0x9c, 0xe0, 0xd7, 0xe1, 0x4d, 0xa3, 0xc4, 0xab, 0xa8, 0xf1, 0x49, 0xfe, 0x5a,
+	0xf9, 0x29, 0xfc, 0xab, 0x06, 0x56, 0x0b, 0xde, 0x05, 0xe0, 0x5b, 0xc3, 0x89, 0x0c, 0x7f, 0x14,
+	0xa9, 0xdf, 0x3c, 0x25, 0x4a, 0xae, 0x61, 0x9f, 0xaf, 0x61, 0x07, 0xbe, 0x51, 0xb8, 0x86, 0xec,
+	0xfb, 0x86, 0xf1, 0x89, 0x2c, 0x59, 0x9f, 0xc2, 0xdf, 0x6b, 0x49, 0x27, 0x99, 0xde, 0xfa, 0xe1,
+	0x5e, 0x59, 0x07, 0xa6, 0x8f, 0x11, 0xf5, 0xfd, 0x53, 0x61, 0x24, 0xe7, 0x1b, 0x9c, 0xf3, 0xeb,
+	0xf0, 0xea, 0x28, 0xbf, 0xef, 0x48, 0xda, 0x8c, 0x6c, 0xff, 0x8d, 0x72, 0xfc, 0xde, 0xc8, 0x3f,
+	0x0a, 0x94, 0xd8, 0x1b, 0x7d, 0xb7, 0x02, 0xfd, 0x26, 0xe7, 0x68, 0xc0, 0x9d, 0x51, 0x1c, 0x07,
+	0xf7, 0xc4, 0x1f, 0xb4, 0xa2, 0x1e, 0x7d, 0xbc, 0x67, 0x07, 0xba, 0xfd, 0x12, 0x9e, 0x1d, 0x6c,
+	0x74, 0x74, 0x83, 0xb3, 0xbe, 0x06, 0xb7, 0x46, 0x7a, 0x96, 0xa4, 0xcc, 0x7e, 0xa5, 0x01, 0x90,
+	0x76, 0x0e, 0xf0, 0x8d, 0xe1, 0x93, 0x0e, 0x74, 0x3f, 0xf5, 0x1b, 0xe5, 0x94, 0x25, 0xb5, 0x6d,
+	0x4e, 0x4d, 0x87, 0x57, 0x8c, 0x31, 0xbf, 0x65, 0xb0, 0xbc, 0x5a, 0x19, 0x38, 0x4c, 0x47, 0xf9,
+	0x70, 0xd8, 0xa9, 0x3d, 0xca, 0x87, 0x43, 0x4f, 0x6b, 0xfd, 0x16, 0x27, 0xba, 0x0f, 0x77, 0x8b,
+	0x8b, 0xbc, 0xba, 0xac, 0x23, 0x05, 0x4c, 0xf3, 0xaa, 0xb9, 0xf7, 0xf9, 0xf3, 0x75, 0xed, 0x8b,
+	0xe7, 0xeb, 0xda, 0xbf, 0x9e, 0xaf, 0x6b, 0xbf, 0x7c, 0xb1, 0x7e, 0xee, 0x8b, 0x17, 0xeb, 0xe7,
+	0xfe, 0xf1, 0x62, 0xfd, 0xdc, 0xf7, 0x6a, 0xca, 0xd6, 0x71, 0x6a, 0x8d, 0x9e, 0x44, 0x98, 0xb4,
+	0xa6, 0xf9, 0xaf, 0x2d, 0xfb, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x92, 0x02, 0x3d, 0xba, 0x8c,
+	0x1a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1184,6 +1470,10 @@ type QueryClient interface {
 	SegmentDetails(ctx context.Context, in *QuerySegmentDetailsRequest, opts ...grpc.CallOption) (*QuerySegmentDetailsResponse, error)
 	// SegmentStatistics queries aggregated segment statistics
 	SegmentStatistics(ctx context.Context, in *QuerySegmentStatisticsRequest, opts ...grpc.CallOption) (*QuerySegmentStatisticsResponse, error)
+	// DevVesting queries vested, unvested and claimed dev allocation per segment
+	DevVesting(ctx context.Context, in *QueryDevVestingRequest, opts ...grpc.CallOption) (*QueryDevVestingResponse, error)
+	// PurchaseAllowance queries how much an address can still buy under the purchase limits
+	PurchaseAllowance(ctx context.Context, in *QueryPurchaseAllowanceRequest, opts ...grpc.CallOption) (*QueryPurchaseAllowanceResponse, error)
 }
 
 type queryClient struct {
@@ -1266,6 +1556,24 @@ func (c *queryClient) SegmentStatistics(ctx context.Context, in *QuerySegmentSta
 	return out, nil
 }
 
+func (c *queryClient) DevVesting(ctx context.Context, in *QueryDevVestingRequest, opts ...grpc.CallOption) (*QueryDevVestingResponse, error) {
+	out := new(QueryDevVestingResponse)
+	err := c.cc.Invoke(ctx, "/mychain.maincoin.v1.Query/DevVesting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PurchaseAllowance(ctx context.Context, in *QueryPurchaseAllowanceRequest, opts ...grpc.CallOption) (*QueryPurchaseAllowanceResponse, error) {
+	out := new(QueryPurchaseAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/mychain.maincoin.v1.Query/PurchaseAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -1284,6 +1592,10 @@ type QueryServer interface {
 	SegmentDetails(context.Context, *QuerySegmentDetailsRequest) (*QuerySegmentDetailsResponse, error)
 	// SegmentStatistics queries aggregated segment statistics
 	SegmentStatistics(context.Context, *QuerySegmentStatisticsRequest) (*QuerySegmentStatisticsResponse, error)
+	// DevVesting queries vested, unvested and claimed dev allocation per segment
+	DevVesting(context.Context, *QueryDevVestingRequest) (*QueryDevVestingResponse, error)
+	// PurchaseAllowance queries how much an address can still buy under the purchase limits
+	PurchaseAllowance(context.Context, *QueryPurchaseAllowanceRequest) (*QueryPurchaseAllowanceResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1314,6 +1626,12 @@ func (*UnimplementedQueryServer) SegmentDetails(ctx context.Context, req *QueryS
 func (*UnimplementedQueryServer) SegmentStatistics(ctx context.Context, req *QuerySegmentStatisticsRequest) (*QuerySegmentStatisticsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SegmentStatistics not implemented")
 }
+func (*UnimplementedQueryServer) DevVesting(ctx context.Context, req *QueryDevVestingRequest) (*QueryDevVestingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DevVesting not implemented")
+}
+func (*UnimplementedQueryServer) PurchaseAllowance(ctx context.Context, req *QueryPurchaseAllowanceRequest) (*QueryPurchaseAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseAllowance not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1463,6 +1781,42 @@ func _Query_SegmentStatistics_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DevVesting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDevVestingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DevVesting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mychain.maincoin.v1.Query/DevVesting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DevVesting(ctx, req.(*QueryDevVestingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PurchaseAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPurchaseAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PurchaseAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mychain.maincoin.v1.Query/PurchaseAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PurchaseAllowance(ctx, req.(*QueryPurchaseAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "mychain.maincoin.v1.Query",
@@ -1500,6 +1854,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SegmentStatistics",
 			Handler:    _Query_SegmentStatistics_Handler,
 		},
+		{
+			MethodName: "DevVesting",
+			Handler:    _Query_DevVesting_Handler,
+		},
+		{
+			MethodName: "PurchaseAllowance",
+			Handler:    _Query_PurchaseAllowance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "mychain/maincoin/v1/query.proto",
@@ -2008,6 +2370,18 @@ func (m *QuerySegmentDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.SegmentNumber != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.SegmentNumber))
 		i--
@@ -2214,6 +2588,18 @@ func (m *QuerySegmentDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Details != nil {
 		{
 			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
@@ -2405,46 +2791,283 @@ func (m *QuerySegmentStatisticsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDevVestingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryDevVestingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCurrentPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDevVestingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
-}
-
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDevVestingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDevVestingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDevVestingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.TotalClaimed.Size()
+		i -= size
+		if _, err := m.TotalClaimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TotalUnvested.Size()
+		i -= size
+		if _, err := m.TotalUnvested.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalVested.Size()
+		i -= size
+		if _, err := m.TotalVested.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Tranches) > 0 {
+		for iNdEx := len(m.Tranches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tranches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPurchaseAllowanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPurchaseAllowanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPurchaseAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPurchaseAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPurchaseAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPurchaseAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.AllowListActive {
+		i--
+		if m.AllowListActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BlockLimited {
+		i--
+		if m.BlockLimited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RemainingBlockSegments != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemainingBlockSegments))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SpendLimited {
+		i--
+		if m.SpendLimited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.RemainingSpend.Size()
+		i -= size
+		if _, err := m.RemainingSpend.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Spent.Size()
+		i -= size
+		if _, err := m.Spent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Segment != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Segment))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCurrentPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *QueryCurrentPriceResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2597,6 +3220,10 @@ func (m *QuerySegmentDetailsRequest) Size() (n int) {
 	if m.SegmentNumber != 0 {
 		n += 1 + sovQuery(uint64(m.SegmentNumber))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -2674,6 +3301,10 @@ func (m *QuerySegmentDetailsResponse) Size() (n int) {
 		l = m.Details.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -2745,18 +3376,100 @@ func (m *QuerySegmentStatisticsResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryDevVestingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryDevVestingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tranches) > 0 {
+		for _, e := range m.Tranches {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TotalVested.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalUnvested.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalClaimed.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+
+func (m *QueryPurchaseAllowanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPurchaseAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Segment != 0 {
+		n += 1 + sovQuery(uint64(m.Segment))
+	}
+	l = m.Spent.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RemainingSpend.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.SpendLimited {
+		n += 2
+	}
+	if m.RemainingBlockSegments != 0 {
+		n += 1 + sovQuery(uint64(m.RemainingBlockSegments))
+	}
+	if m.BlockLimited {
+		n += 2
+	}
+	if m.AllowListActive {
+		n += 2
+	}
+	if m.Allowed {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
@@ -4062,6 +4775,42 @@ func (m *QuerySegmentDetailsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4698,6 +5447,42 @@ func (m *QuerySegmentDetailsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5246,6 +6031,632 @@ func (m *QuerySegmentStatisticsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDevVestingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDevVestingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDevVestingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDevVestingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDevVestingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDevVestingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tranches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tranches = append(m.Tranches, DevVestingTrancheStatus{})
+			if err := m.Tranches[len(m.Tranches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVested", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalVested.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalUnvested", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalUnvested.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalClaimed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalClaimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPurchaseAllowanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPurchaseAllowanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPurchaseAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPurchaseAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPurchaseAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPurchaseAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Segment", wireType)
+			}
+			m.Segment = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Segment |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSpend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingSpend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SpendLimited = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBlockSegments", wireType)
+			}
+			m.RemainingBlockSegments = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingBlockSegments |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockLimited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlockLimited = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowListActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowListActive = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -41,6 +41,8 @@ type SegmentPurchaseRecord struct {
 	TxHash        string                      `protobuf:"bytes,9,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
 	BlockHeight   int64                       `protobuf:"varint,10,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 	Timestamp     time.Time                   `protobuf:"bytes,11,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	// id is the record's key in the purchase record store
+	Id uint64 `protobuf:"varint,12,opt,name=id,proto3" json:"id,omitempty"`
 }
 
 func (m *SegmentPurchaseRecord) Reset()         { *m = SegmentPurchaseRecord{} }
@@ -118,9 +120,18 @@ func (m *SegmentPurchaseRecord) GetTimestamp() time.Time {
 	return time.Time{}
 }
 
-// SegmentHistory stores all purchases for a specific segment
+func (m *SegmentPurchaseRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// SegmentHistory stores the running totals of a segment. Its purchase records are
+// stored individually and only filled in by queries, one page at a time.
 type SegmentHistory struct {
-	SegmentNumber      uint64                  `protobuf:"varint,1,opt,name=segment_number,json=segmentNumber,proto3" json:"segment_number,omitempty"`
+	SegmentNumber uint64 `protobuf:"varint,1,opt,name=segment_number,json=segmentNumber,proto3" json:"segment_number,omitempty"`
+	// purchases is the requested page of the segment's purchase records; it is never stored
 	Purchases          []SegmentPurchaseRecord `protobuf:"bytes,2,rep,name=purchases,proto3" json:"purchases"`
 	TotalTokensSold    cosmossdk_io_math.Int   `protobuf:"bytes,3,opt,name=total_tokens_sold,json=totalTokensSold,proto3,customtype=cosmossdk.io/math.Int" json:"total_tokens_sold"`
 	TotalDevAllocation cosmossdk_io_math.Int   `protobuf:"bytes,4,opt,name=total_dev_allocation,json=totalDevAllocation,proto3,customtype=cosmossdk.io/math.Int" json:"total_dev_allocation"`
@@ -128,6 +139,8 @@ type SegmentHistory struct {
 	IsComplete         bool                    `protobuf:"varint,6,opt,name=is_complete,json=isComplete,proto3" json:"is_complete,omitempty"`
 	CompletedAtHeight  int64                   `protobuf:"varint,7,opt,name=completed_at_height,json=completedAtHeight,proto3" json:"completed_at_height,omitempty"`
 	CompletedAt        *time.Time              `protobuf:"bytes,8,opt,name=completed_at,json=completedAt,proto3,stdtime" json:"completed_at,omitempty"`
+	// purchase_count is the number of purchase records in the segment
+	PurchaseCount uint64 `protobuf:"varint,9,opt,name=purchase_count,json=purchaseCount,proto3" json:"purchase_count,omitempty"`
 }
 
 func (m *SegmentHistory) Reset()         { *m = SegmentHistory{} }
@@ -198,12 +211,23 @@ func (m *SegmentHistory) GetCompletedAt() *time.Time {
 	return nil
 }
 
-// UserPurchaseHistory stores all purchases made by a specific user
+func (m *SegmentHistory) GetPurchaseCount() uint64 {
+	if m != nil {
+		return m.PurchaseCount
+	}
+	return 0
+}
+
+// UserPurchaseHistory stores the running totals of a buyer. Its purchase records are
+// stored individually and only filled in by queries, one page at a time.
 type UserPurchaseHistory struct {
-	Address           string                  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// purchases is the requested page of the buyer's purchase records; it is never stored
 	Purchases         []SegmentPurchaseRecord `protobuf:"bytes,2,rep,name=purchases,proto3" json:"purchases"`
 	TotalTokensBought cosmossdk_io_math.Int   `protobuf:"bytes,3,opt,name=total_tokens_bought,json=totalTokensBought,proto3,customtype=cosmossdk.io/math.Int" json:"total_tokens_bought"`
 	TotalSpent        cosmossdk_io_math.Int   `protobuf:"bytes,4,opt,name=total_spent,json=totalSpent,proto3,customtype=cosmossdk.io/math.Int" json:"total_spent"`
+	// purchase_count is the number of purchase records of the buyer
+	PurchaseCount uint64 `protobuf:"varint,5,opt,name=purchase_count,json=purchaseCount,proto3" json:"purchase_count,omitempty"`
 }
 
 func (m *UserPurchaseHistory) Reset()         { *m = UserPurchaseHistory{} }
@@ -253,6 +277,13 @@ func (m *UserPurchaseHistory) GetPurchases() []SegmentPurchaseRecord {
 	return nil
 }
 
+func (m *UserPurchaseHistory) GetPurchaseCount() uint64 {
+	if m != nil {
+		return m.PurchaseCount
+	}
+	return 0
+}
+
 // SegmentHistoryEntry represents a completed segment's summary
 type SegmentHistoryEntry struct {
 	SegmentNumber  uint64                      `protobuf:"varint,1,opt,name=segment_number,json=segmentNumber,proto3" json:"segment_number,omitempty"`
@@ -263,6 +294,8 @@ type SegmentHistoryEntry struct {
 	Reserves       cosmossdk_io_math.Int       `protobuf:"bytes,6,opt,name=reserves,proto3,customtype=cosmossdk.io/math.Int" json:"reserves"`
 	CompletedAt    int64                       `protobuf:"varint,7,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
 	TxHash         string                      `protobuf:"bytes,8,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// dev_distributions splits dev_distributed between the dev recipients
+	DevDistributions []DevRecipientAmount `protobuf:"bytes,9,rep,name=dev_distributions,json=devDistributions,proto3" json:"dev_distributions"`
 }
 
 func (m *SegmentHistoryEntry) Reset()         { *m = SegmentHistoryEntry{} }
@@ -319,6 +352,13 @@ func (m *SegmentHistoryEntry) GetTxHash() string {
 	return ""
 }
 
+func (m *SegmentHistoryEntry) GetDevDistributions() []DevRecipientAmount {
+	if m != nil {
+		return m.DevDistributions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SegmentPurchaseRecord)(nil), "mychain.maincoin.v1.SegmentPurchaseRecord")
 	proto.RegisterType((*SegmentHistory)(nil), "mychain.maincoin.v1.SegmentHistory")
@@ -331,56 +371,61 @@ func init() {
 }
 
 var fileDescriptor_5f533e5164146594 = []byte{
-	// 776 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xcd, 0x6e, 0xd3, 0x40,
-	0x14, 0x85, 0xe3, 0x26, 0xcd, 0xcf, 0x4d, 0xd2, 0xaa, 0x93, 0x56, 0x58, 0x45, 0x24, 0x21, 0x08,
-	0x29, 0xb0, 0x70, 0xd4, 0xb2, 0xea, 0x06, 0xd1, 0x34, 0xa0, 0x56, 0xd0, 0x52, 0x39, 0x65, 0xc3,
-	0xc6, 0x72, 0xec, 0xc1, 0xb6, 0x6a, 0x7b, 0x2c, 0xcf, 0x38, 0x6a, 0x5e, 0x02, 0xf5, 0x81, 0x90,
-	0xd8, 0x76, 0xd9, 0x25, 0x62, 0x51, 0x50, 0xfb, 0x1c, 0x48, 0xc8, 0x33, 0x76, 0x7e, 0xda, 0x0a,
-	0x8c, 0xc4, 0x2e, 0xbe, 0x73, 0xcf, 0x9d, 0xd1, 0x9c, 0x6f, 0x4e, 0xe0, 0x99, 0x37, 0x31, 0x6c,
-	0xdd, 0xf1, 0x7b, 0x9e, 0xee, 0xf8, 0x06, 0x71, 0xfc, 0xde, 0x78, 0xab, 0x47, 0xb1, 0xe5, 0x61,
-	0x9f, 0x69, 0xb6, 0x43, 0x19, 0x09, 0x27, 0x4a, 0x10, 0x12, 0x46, 0x50, 0x23, 0x69, 0x55, 0xd2,
-	0x56, 0x65, 0xbc, 0xb5, 0xb9, 0x6e, 0x11, 0x8b, 0xf0, 0xf5, 0x5e, 0xfc, 0x4b, 0xb4, 0x6e, 0xb6,
-	0x2c, 0x42, 0x2c, 0x17, 0xf7, 0xf8, 0xd7, 0x28, 0xfa, 0xd4, 0x63, 0x8e, 0x87, 0x29, 0xd3, 0xbd,
-	0x40, 0x34, 0x74, 0xbe, 0x16, 0x60, 0x63, 0x28, 0x76, 0x39, 0x8e, 0x42, 0xc3, 0xd6, 0x29, 0x56,
-	0xb1, 0x41, 0x42, 0x13, 0x3d, 0x85, 0x95, 0x74, 0x7b, 0x3f, 0xf2, 0x46, 0x38, 0x94, 0xa5, 0xb6,
-	0xd4, 0x2d, 0xa8, 0xf5, 0xa4, 0x7a, 0xc4, 0x8b, 0x68, 0x1d, 0x96, 0x47, 0xd1, 0x04, 0x87, 0xf2,
-	0x52, 0x5b, 0xea, 0x56, 0x54, 0xf1, 0x81, 0xfa, 0x50, 0x67, 0xe4, 0x14, 0xfb, 0x54, 0x1b, 0x91,
-	0xc8, 0xb2, 0x99, 0x9c, 0x8f, 0x57, 0xfb, 0x8f, 0x2e, 0xae, 0x5a, 0xb9, 0xef, 0x57, 0xad, 0x0d,
-	0x83, 0x50, 0x8f, 0x50, 0x6a, 0x9e, 0x2a, 0x0e, 0xe9, 0x79, 0x3a, 0xb3, 0x95, 0x03, 0x9f, 0xa9,
-	0x35, 0xa1, 0xe9, 0x73, 0x09, 0x7a, 0x09, 0xd5, 0x88, 0xe2, 0x50, 0x13, 0x45, 0xb9, 0x90, 0x65,
-	0x02, 0xc4, 0x8a, 0x13, 0x2e, 0x40, 0x03, 0x58, 0x31, 0xf1, 0x58, 0xd3, 0x5d, 0x97, 0x18, 0x3a,
-	0x73, 0x88, 0x2f, 0x2f, 0x67, 0x19, 0x51, 0x37, 0xf1, 0x78, 0x77, 0xaa, 0x41, 0x6f, 0x61, 0x35,
-	0x08, 0x1d, 0x03, 0x6b, 0x41, 0x7a, 0x14, 0xb9, 0xc8, 0xc7, 0x3c, 0x49, 0xc6, 0x3c, 0xbc, 0x3b,
-	0xe6, 0x1d, 0xb6, 0x74, 0x63, 0x32, 0xc0, 0x86, 0x5a, 0xe7, 0xda, 0xe3, 0xe4, 0x4c, 0x68, 0x0b,
-	0x0a, 0x06, 0xa1, 0x4c, 0x2e, 0x65, 0x39, 0x08, 0x6f, 0x45, 0x2d, 0xa8, 0x3a, 0x54, 0x33, 0x88,
-	0x17, 0xb8, 0x98, 0x61, 0xb9, 0xdc, 0x96, 0xba, 0x65, 0x15, 0x1c, 0xba, 0x97, 0x54, 0xd0, 0x03,
-	0x28, 0xb1, 0x33, 0xcd, 0xd6, 0xa9, 0x2d, 0x57, 0xb8, 0x05, 0x45, 0x76, 0xb6, 0xaf, 0x53, 0x1b,
-	0x3d, 0x86, 0xda, 0xc8, 0x25, 0xc6, 0xa9, 0x66, 0x63, 0x27, 0xb6, 0x00, 0xda, 0x52, 0x37, 0xaf,
-	0x56, 0x79, 0x6d, 0x9f, 0x97, 0x50, 0x1f, 0x2a, 0x53, 0x20, 0xe4, 0x6a, 0x5b, 0xea, 0x56, 0xb7,
-	0x37, 0x15, 0x81, 0x8c, 0x92, 0x22, 0xa3, 0x9c, 0xa4, 0x1d, 0xfd, 0x72, 0x7c, 0xe0, 0xf3, 0x1f,
-	0x2d, 0x49, 0x9d, 0xc9, 0x3a, 0xbf, 0xf2, 0xb0, 0x92, 0x10, 0xb4, 0x2f, 0x30, 0xcd, 0x8a, 0xce,
-	0x11, 0x54, 0x82, 0x84, 0x39, 0x2a, 0x2f, 0xb5, 0xf3, 0xdd, 0xea, 0xf6, 0x73, 0xe5, 0x1e, 0xb6,
-	0x95, 0x7b, 0x01, 0xed, 0x17, 0xe2, 0xd3, 0xa8, 0xb3, 0x11, 0xe8, 0x00, 0xd6, 0x18, 0x61, 0xba,
-	0x9b, 0x10, 0xa3, 0x51, 0xe2, 0x9a, 0xd9, 0xc0, 0x5b, 0xe5, 0x3a, 0xc1, 0xcd, 0x90, 0xb8, 0x26,
-	0x7a, 0x0f, 0xeb, 0x62, 0xd4, 0x2d, 0x82, 0x32, 0x41, 0x88, 0xb8, 0x74, 0xb0, 0x80, 0x11, 0x7f,
-	0x10, 0xf1, 0xc0, 0x10, 0x8f, 0xb1, 0x1f, 0xe1, 0x6c, 0x2c, 0xd6, 0xb8, 0x46, 0x15, 0x92, 0xdb,
-	0x28, 0x14, 0xef, 0xa0, 0xa0, 0x40, 0x23, 0x5d, 0x35, 0x35, 0x9d, 0xa5, 0xc6, 0x97, 0xb8, 0xf1,
-	0x6b, 0xd3, 0xa5, 0x5d, 0x96, 0xd8, 0xbf, 0x07, 0xb5, 0xf9, 0x7e, 0x0e, 0xd7, 0x9f, 0x09, 0x28,
-	0x70, 0xf7, 0xab, 0x73, 0xa3, 0x3a, 0x9f, 0x97, 0xa0, 0xf1, 0x81, 0xe2, 0x30, 0x75, 0x27, 0x85,
-	0x40, 0x86, 0x92, 0x6e, 0x9a, 0x21, 0xa6, 0x94, 0xbb, 0x5f, 0x51, 0xd3, 0xcf, 0xff, 0xee, 0xfb,
-	0x21, 0x34, 0x16, 0x7c, 0xff, 0x97, 0xc8, 0x59, 0x9b, 0x73, 0x7e, 0x96, 0x3b, 0x62, 0x1c, 0x0d,
-	0xb0, 0xcf, 0x32, 0xe6, 0x0e, 0x57, 0x0c, 0x63, 0x41, 0xe7, 0x4b, 0x1e, 0x1a, 0x8b, 0x0f, 0xe2,
-	0xb5, 0xcf, 0xb2, 0xbf, 0x8a, 0x59, 0x74, 0x7a, 0x8e, 0xcf, 0xb0, 0x29, 0x82, 0x35, 0x63, 0x74,
-	0x1e, 0x72, 0x09, 0x7a, 0x03, 0xab, 0x31, 0xb8, 0xa6, 0x43, 0x59, 0xe8, 0x8c, 0xa2, 0x78, 0x4a,
-	0xa6, 0xdb, 0x88, 0x03, 0x73, 0x30, 0x13, 0xa1, 0x57, 0x50, 0x4b, 0xae, 0x22, 0x0a, 0x02, 0x77,
-	0x92, 0xed, 0x2e, 0xc4, 0xed, 0x0d, 0xb9, 0x02, 0xed, 0xc0, 0x32, 0x8f, 0xc0, 0x84, 0xf7, 0x4c,
-	0xa1, 0x29, 0x14, 0x68, 0x07, 0xca, 0x21, 0xa6, 0x38, 0x1c, 0x63, 0x9a, 0x44, 0xee, 0x5f, 0x36,
-	0x9e, 0xb6, 0xc7, 0xd1, 0xb7, 0x00, 0xb6, 0x78, 0x01, 0xf3, 0xd8, 0xce, 0xc7, 0x66, 0x79, 0x3e,
-	0x36, 0xfb, 0xdb, 0x17, 0xd7, 0x4d, 0xe9, 0xf2, 0xba, 0x29, 0xfd, 0xbc, 0x6e, 0x4a, 0xe7, 0x37,
-	0xcd, 0xdc, 0xe5, 0x4d, 0x33, 0xf7, 0xed, 0xa6, 0x99, 0xfb, 0x28, 0xa7, 0x7f, 0xd1, 0x67, 0xb3,
-	0x3f, 0x69, 0x36, 0x09, 0x30, 0x1d, 0x15, 0xf9, 0x53, 0x79, 0xf1, 0x3b, 0x00, 0x00, 0xff, 0xff,
-	0xfa, 0x00, 0xe6, 0xaa, 0xc5, 0x07, 0x00, 0x00,
+	// 859 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xdf, 0x6e, 0xe3, 0x44,
+	0x14, 0xc6, 0xeb, 0xd4, 0x6d, 0x93, 0x93, 0xa4, 0xa5, 0x93, 0xae, 0xb0, 0x8a, 0x48, 0x42, 0x11,
+	0x22, 0x70, 0xe1, 0xa8, 0xe5, 0x6a, 0x6f, 0x10, 0x4d, 0x03, 0xea, 0x0a, 0x76, 0x59, 0x39, 0xcb,
+	0xcd, 0xde, 0x58, 0x8e, 0x7d, 0x88, 0x47, 0xb5, 0x3d, 0x96, 0x67, 0x1c, 0x35, 0x6f, 0xb1, 0x97,
+	0x3c, 0x00, 0xaf, 0xc0, 0x3b, 0xec, 0x1d, 0x7b, 0x89, 0xb8, 0x58, 0x50, 0xfb, 0x22, 0x68, 0x66,
+	0xec, 0xfc, 0xd9, 0x46, 0x60, 0xa4, 0xbd, 0xcb, 0x9c, 0x39, 0xdf, 0xc9, 0xd1, 0x99, 0xdf, 0xf9,
+	0x64, 0xf8, 0x22, 0x5e, 0xf8, 0xa1, 0x47, 0x93, 0x61, 0xec, 0xd1, 0xc4, 0x67, 0x34, 0x19, 0xce,
+	0xcf, 0x87, 0x1c, 0x67, 0x31, 0x26, 0xc2, 0x0d, 0x29, 0x17, 0x2c, 0x5b, 0xd8, 0x69, 0xc6, 0x04,
+	0x23, 0x9d, 0x22, 0xd5, 0x2e, 0x53, 0xed, 0xf9, 0xf9, 0xe9, 0xc9, 0x8c, 0xcd, 0x98, 0xba, 0x1f,
+	0xca, 0x5f, 0x3a, 0xf5, 0xb4, 0x37, 0x63, 0x6c, 0x16, 0xe1, 0x50, 0x9d, 0xa6, 0xf9, 0xcf, 0x43,
+	0x41, 0x63, 0xe4, 0xc2, 0x8b, 0xd3, 0x22, 0xa1, 0xbf, 0xed, 0x6f, 0x53, 0x2f, 0xf3, 0x62, 0xae,
+	0x33, 0xce, 0x7e, 0x37, 0xe1, 0xd1, 0x44, 0xf7, 0xf1, 0x3c, 0xcf, 0xfc, 0xd0, 0xe3, 0xe8, 0xa0,
+	0xcf, 0xb2, 0x80, 0x7c, 0x06, 0x87, 0x65, 0x83, 0x49, 0x1e, 0x4f, 0x31, 0xb3, 0x8c, 0xbe, 0x31,
+	0x30, 0x9d, 0x76, 0x11, 0x7d, 0xa6, 0x82, 0xe4, 0x04, 0xf6, 0xa6, 0xf9, 0x02, 0x33, 0xab, 0xd6,
+	0x37, 0x06, 0x0d, 0x47, 0x1f, 0xc8, 0x08, 0xda, 0x82, 0xdd, 0x60, 0xc2, 0xdd, 0x29, 0xcb, 0x67,
+	0xa1, 0xb0, 0x76, 0xe5, 0xed, 0xe8, 0xe3, 0xd7, 0x6f, 0x7b, 0x3b, 0x7f, 0xbe, 0xed, 0x3d, 0xf2,
+	0x19, 0x8f, 0x19, 0xe7, 0xc1, 0x8d, 0x4d, 0xd9, 0x30, 0xf6, 0x44, 0x68, 0x3f, 0x49, 0x84, 0xd3,
+	0xd2, 0x9a, 0x91, 0x92, 0x90, 0xaf, 0xa1, 0x99, 0x73, 0xcc, 0x5c, 0x1d, 0xb4, 0xcc, 0x2a, 0x15,
+	0x40, 0x2a, 0x5e, 0x28, 0x01, 0x19, 0xc3, 0x61, 0x80, 0x73, 0xd7, 0x8b, 0x22, 0xe6, 0x7b, 0x82,
+	0xb2, 0xc4, 0xda, 0xab, 0x52, 0xa2, 0x1d, 0xe0, 0xfc, 0x72, 0xa9, 0x21, 0xdf, 0xc3, 0x51, 0x9a,
+	0x51, 0x1f, 0xdd, 0xb4, 0x6c, 0xc5, 0xda, 0x57, 0x65, 0x3e, 0x2d, 0xca, 0x7c, 0xf4, 0xb0, 0xcc,
+	0x0f, 0x38, 0xf3, 0xfc, 0xc5, 0x18, 0x7d, 0xa7, 0xad, 0xb4, 0xcf, 0x8b, 0x9e, 0xc8, 0x39, 0x98,
+	0x3e, 0xe3, 0xc2, 0x3a, 0xa8, 0xd2, 0x88, 0x4a, 0x25, 0x3d, 0x68, 0x52, 0xee, 0xfa, 0x2c, 0x4e,
+	0x23, 0x14, 0x68, 0xd5, 0xfb, 0xc6, 0xa0, 0xee, 0x00, 0xe5, 0x57, 0x45, 0x84, 0x7c, 0x08, 0x07,
+	0xe2, 0xd6, 0x0d, 0x3d, 0x1e, 0x5a, 0x0d, 0xf5, 0x04, 0xfb, 0xe2, 0xf6, 0xda, 0xe3, 0x21, 0xf9,
+	0x04, 0x5a, 0xd3, 0x88, 0xf9, 0x37, 0x6e, 0x88, 0x54, 0x3e, 0x01, 0xf4, 0x8d, 0xc1, 0xae, 0xd3,
+	0x54, 0xb1, 0x6b, 0x15, 0x22, 0x23, 0x68, 0x2c, 0x91, 0xb1, 0x9a, 0x7d, 0x63, 0xd0, 0xbc, 0x38,
+	0xb5, 0x35, 0x54, 0x76, 0x09, 0x95, 0xfd, 0xa2, 0xcc, 0x18, 0xd5, 0x65, 0xc3, 0xaf, 0xfe, 0xea,
+	0x19, 0xce, 0x4a, 0x46, 0x0e, 0xa1, 0x46, 0x03, 0xab, 0xa5, 0xd8, 0xa8, 0xd1, 0xe0, 0xec, 0x57,
+	0x13, 0x0e, 0x0b, 0xa2, 0xae, 0x35, 0xd8, 0x55, 0x51, 0x7a, 0x06, 0x8d, 0xb4, 0x60, 0x90, 0x5b,
+	0xb5, 0xfe, 0xee, 0xa0, 0x79, 0xf1, 0xa5, 0xbd, 0x65, 0x1b, 0xec, 0xad, 0xc0, 0x8e, 0x4c, 0xd9,
+	0x9d, 0xb3, 0x2a, 0x41, 0x9e, 0xc0, 0xb1, 0x60, 0xc2, 0x8b, 0x0a, 0x82, 0x5c, 0xce, 0xa2, 0xa0,
+	0x1a, 0x88, 0x47, 0x4a, 0xa7, 0x39, 0x9a, 0xb0, 0x28, 0x20, 0x3f, 0xc2, 0x89, 0x2e, 0xf5, 0x0e,
+	0x51, 0x95, 0xa0, 0x24, 0x4a, 0x3a, 0xde, 0xc0, 0x4a, 0x2d, 0x88, 0x2c, 0x98, 0xe1, 0x1c, 0x93,
+	0x1c, 0xab, 0xb1, 0xd9, 0x52, 0x1a, 0x47, 0x4b, 0xde, 0x45, 0x63, 0xff, 0x01, 0x1a, 0x36, 0x74,
+	0xca, 0xdb, 0xc0, 0xf5, 0x44, 0x09, 0xc2, 0x81, 0x02, 0xe1, 0x78, 0x79, 0x75, 0x29, 0x0a, 0x1c,
+	0xae, 0xa0, 0xb5, 0x9e, 0xaf, 0x60, 0xfb, 0x77, 0x22, 0x4c, 0x45, 0x43, 0x73, 0xad, 0x94, 0x7c,
+	0xec, 0xf2, 0x09, 0x5c, 0x9f, 0xe5, 0x89, 0x50, 0x58, 0x9a, 0x4e, 0xbb, 0x8c, 0x5e, 0xc9, 0xe0,
+	0xd9, 0x6f, 0x35, 0xe8, 0xfc, 0xc4, 0x31, 0x2b, 0x1f, 0xb1, 0x64, 0xc5, 0x82, 0x03, 0x2f, 0x08,
+	0x32, 0xe4, 0x5c, 0x41, 0xd2, 0x70, 0xca, 0xe3, 0x7b, 0xc7, 0xe3, 0x29, 0x74, 0x36, 0xf0, 0xf8,
+	0x3f, 0x4e, 0x75, 0xbc, 0x06, 0xc8, 0xca, 0xae, 0x74, 0x39, 0x9e, 0x62, 0x22, 0x2a, 0xda, 0x95,
+	0x52, 0x4c, 0xa4, 0x60, 0xcb, 0xdc, 0xf6, 0xb6, 0xcd, 0xed, 0x17, 0x13, 0x3a, 0x9b, 0xeb, 0xf5,
+	0x6d, 0x22, 0xaa, 0xef, 0xd8, 0xca, 0x98, 0x63, 0x9a, 0x08, 0x0c, 0xb4, 0x6d, 0x57, 0x34, 0xe6,
+	0xa7, 0x4a, 0x42, 0xbe, 0x83, 0x23, 0xb9, 0x06, 0x01, 0xe5, 0x22, 0xa3, 0xd3, 0x5c, 0x56, 0xa9,
+	0x34, 0x34, 0x69, 0xc7, 0xe3, 0x95, 0x88, 0x7c, 0x03, 0xad, 0x62, 0x62, 0x79, 0x9a, 0x46, 0x8b,
+	0x6a, 0x23, 0xd3, 0x43, 0x9e, 0x28, 0x05, 0x79, 0x0c, 0x7b, 0xca, 0x60, 0x8b, 0xed, 0xa9, 0x64,
+	0xc9, 0x5a, 0x41, 0x1e, 0x43, 0x3d, 0x43, 0x8e, 0xd9, 0x1c, 0x79, 0x61, 0xe8, 0xff, 0xf1, 0xc7,
+	0xcb, 0x74, 0x69, 0xac, 0x1b, 0x6b, 0xa2, 0xf7, 0x69, 0x63, 0x09, 0xd6, 0x4c, 0xb9, 0xbe, 0x61,
+	0xca, 0x2f, 0xe1, 0x78, 0x63, 0x76, 0x94, 0x25, 0xdc, 0x6a, 0x28, 0x98, 0x3f, 0xdf, 0x0a, 0xf3,
+	0x18, 0xe7, 0x0e, 0xfa, 0x34, 0xa5, 0x98, 0x88, 0xcb, 0x58, 0x22, 0x50, 0x90, 0xfc, 0xc1, 0xfa,
+	0x34, 0x65, 0x99, 0xd1, 0xc5, 0xeb, 0xbb, 0xae, 0xf1, 0xe6, 0xae, 0x6b, 0xfc, 0x7d, 0xd7, 0x35,
+	0x5e, 0xdd, 0x77, 0x77, 0xde, 0xdc, 0x77, 0x77, 0xfe, 0xb8, 0xef, 0xee, 0xbc, 0xb4, 0xca, 0xef,
+	0x80, 0xdb, 0xd5, 0x97, 0x80, 0x58, 0xa4, 0xc8, 0xa7, 0xfb, 0x6a, 0xa9, 0xbf, 0xfa, 0x27, 0x00,
+	0x00, 0xff, 0xff, 0x2e, 0x81, 0xd6, 0x91, 0xa1, 0x08, 0x00, 0x00,
 }
 
 func (m *SegmentPurchaseRecord) Marshal() (dAtA []byte, err error) {
@@ -403,6 +448,11 @@ func (m *SegmentPurchaseRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Id != 0 {
+		i = encodeVarintSegmentHistory(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x60
+	}
 	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
 	if err1 != nil {
 		return 0, err1
@@ -518,6 +568,11 @@ func (m *SegmentHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PurchaseCount != 0 {
+		i = encodeVarintSegmentHistory(dAtA, i, uint64(m.PurchaseCount))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.CompletedAt != nil {
 		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CompletedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CompletedAt):])
 		if err2 != nil {
@@ -615,6 +670,11 @@ func (m *UserPurchaseHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PurchaseCount != 0 {
+		i = encodeVarintSegmentHistory(dAtA, i, uint64(m.PurchaseCount))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size := m.TotalSpent.Size()
 		i -= size
@@ -679,6 +739,20 @@ func (m *SegmentHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DevDistributions) > 0 {
+		for iNdEx := len(m.DevDistributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DevDistributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSegmentHistory(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.TxHash) > 0 {
 		i -= len(m.TxHash)
 		copy(dAtA[i:], m.TxHash)
@@ -795,6 +869,9 @@ func (m *SegmentPurchaseRecord) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
 	n += 1 + l + sovSegmentHistory(uint64(l))
+	if m.Id != 0 {
+		n += 1 + sovSegmentHistory(uint64(m.Id))
+	}
 	return n
 }
 
@@ -829,6 +906,9 @@ func (m *SegmentHistory) Size() (n int) {
 		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CompletedAt)
 		n += 1 + l + sovSegmentHistory(uint64(l))
 	}
+	if m.PurchaseCount != 0 {
+		n += 1 + sovSegmentHistory(uint64(m.PurchaseCount))
+	}
 	return n
 }
 
@@ -852,6 +932,9 @@ func (m *UserPurchaseHistory) Size() (n int) {
 	n += 1 + l + sovSegmentHistory(uint64(l))
 	l = m.TotalSpent.Size()
 	n += 1 + l + sovSegmentHistory(uint64(l))
+	if m.PurchaseCount != 0 {
+		n += 1 + sovSegmentHistory(uint64(m.PurchaseCount))
+	}
 	return n
 }
 
@@ -881,6 +964,12 @@ func (m *SegmentHistoryEntry) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSegmentHistory(uint64(l))
 	}
+	if len(m.DevDistributions) > 0 {
+		for _, e := range m.DevDistributions {
+			l = e.Size()
+			n += 1 + l + sovSegmentHistory(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1244,6 +1333,25 @@ func (m *SegmentPurchaseRecord) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSegmentHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSegmentHistory(dAtA[iNdEx:])
@@ -1524,6 +1632,25 @@ func (m *SegmentHistory) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseCount", wireType)
+			}
+			m.PurchaseCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSegmentHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PurchaseCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSegmentHistory(dAtA[iNdEx:])
@@ -1708,6 +1835,25 @@ func (m *UserPurchaseHistory) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseCount", wireType)
+			}
+			m.PurchaseCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSegmentHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PurchaseCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSegmentHistory(dAtA[iNdEx:])
@@ -1998,6 +2144,40 @@ func (m *SegmentHistoryEntry) Unmarshal(dAtA []byte) error {
 			}
 			m.TxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevDistributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSegmentHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSegmentHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSegmentHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevDistributions = append(m.DevDistributions, DevRecipientAmount{})
+			if err := m.DevDistributions[len(m.DevDistributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSegmentHistory(dAtA[iNdEx:])
@@ -5,8 +5,10 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -126,6 +128,7 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
 // MsgBuyMaincoin defines the MsgBuyMaincoin message.
+// amount may be TestUSD or any denom listed in Params.purchase_denoms.
 type MsgBuyMaincoin struct {
 	Buyer  string     `protobuf:"bytes,1,opt,name=buyer,proto3" json:"buyer,omitempty"`
 	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
@@ -435,6 +438,8 @@ func (m *MsgSellMaincoin) GetAmount() types.Coin {
 type MsgSellMaincoinResponse struct {
 	// amount of testusd refunded
 	AmountRefunded types.Coin `protobuf:"bytes,1,opt,name=amount_refunded,json=amountRefunded,proto3" json:"amount_refunded"`
+	// refunded is every coin paid out of the reserve, in proportion to its holdings
+	Refunded github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=refunded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refunded"`
 }
 
 func (m *MsgSellMaincoinResponse) Reset()         { *m = MsgSellMaincoinResponse{} }
@@ -477,6 +482,199 @@ func (m *MsgSellMaincoinResponse) GetAmountRefunded() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgSellMaincoinResponse) GetRefunded() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Refunded
+	}
+	return nil
+}
+
+// MsgClaimDevAllocation claims the claimer's vested share of every tranche.
+type MsgClaimDevAllocation struct {
+	// claimer is a dev recipient holding shares in the vesting tranches
+	Claimer string `protobuf:"bytes,1,opt,name=claimer,proto3" json:"claimer,omitempty"`
+}
+
+func (m *MsgClaimDevAllocation) Reset()         { *m = MsgClaimDevAllocation{} }
+func (m *MsgClaimDevAllocation) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimDevAllocation) ProtoMessage()    {}
+func (*MsgClaimDevAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e2f50e7843e7310, []int{7}
+}
+func (m *MsgClaimDevAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimDevAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimDevAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimDevAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimDevAllocation.Merge(m, src)
+}
+func (m *MsgClaimDevAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimDevAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimDevAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimDevAllocation proto.InternalMessageInfo
+
+func (m *MsgClaimDevAllocation) GetClaimer() string {
+	if m != nil {
+		return m.Claimer
+	}
+	return ""
+}
+
+// MsgClaimDevAllocationResponse defines the MsgClaimDevAllocationResponse message.
+type MsgClaimDevAllocationResponse struct {
+	// amount of maincoin released to the claimer
+	AmountClaimed types.Coin `protobuf:"bytes,1,opt,name=amount_claimed,json=amountClaimed,proto3" json:"amount_claimed"`
+}
+
+func (m *MsgClaimDevAllocationResponse) Reset()         { *m = MsgClaimDevAllocationResponse{} }
+func (m *MsgClaimDevAllocationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimDevAllocationResponse) ProtoMessage()    {}
+func (*MsgClaimDevAllocationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e2f50e7843e7310, []int{8}
+}
+func (m *MsgClaimDevAllocationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimDevAllocationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimDevAllocationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimDevAllocationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimDevAllocationResponse.Merge(m, src)
+}
+func (m *MsgClaimDevAllocationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimDevAllocationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimDevAllocationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimDevAllocationResponse proto.InternalMessageInfo
+
+func (m *MsgClaimDevAllocationResponse) GetAmountClaimed() types.Coin {
+	if m != nil {
+		return m.AmountClaimed
+	}
+	return types.Coin{}
+}
+
+// MsgSetPurchaseRate sets the rate of a PRICE_SOURCE_AUTHORITY_RATE purchase denom.
+type MsgSetPurchaseRate struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// denom is the purchase denom being priced
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate is the TestUSD paid per unit of denom
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+}
+
+func (m *MsgSetPurchaseRate) Reset()         { *m = MsgSetPurchaseRate{} }
+func (m *MsgSetPurchaseRate) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPurchaseRate) ProtoMessage()    {}
+func (*MsgSetPurchaseRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e2f50e7843e7310, []int{9}
+}
+func (m *MsgSetPurchaseRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPurchaseRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPurchaseRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPurchaseRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPurchaseRate.Merge(m, src)
+}
+func (m *MsgSetPurchaseRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPurchaseRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPurchaseRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPurchaseRate proto.InternalMessageInfo
+
+func (m *MsgSetPurchaseRate) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetPurchaseRate) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgSetPurchaseRateResponse defines the MsgSetPurchaseRateResponse message.
+type MsgSetPurchaseRateResponse struct {
+}
+
+func (m *MsgSetPurchaseRateResponse) Reset()         { *m = MsgSetPurchaseRateResponse{} }
+func (m *MsgSetPurchaseRateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPurchaseRateResponse) ProtoMessage()    {}
+func (*MsgSetPurchaseRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e2f50e7843e7310, []int{10}
+}
+func (m *MsgSetPurchaseRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPurchaseRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPurchaseRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPurchaseRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPurchaseRateResponse.Merge(m, src)
+}
+func (m *MsgSetPurchaseRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPurchaseRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPurchaseRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPurchaseRateResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "mychain.maincoin.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "mychain.maincoin.v1.MsgUpdateParamsResponse")
@@ -485,64 +683,82 @@ func init() {
 	proto.RegisterType((*MsgBuyMaincoinResponse)(nil), "mychain.maincoin.v1.MsgBuyMaincoinResponse")
 	proto.RegisterType((*MsgSellMaincoin)(nil), "mychain.maincoin.v1.MsgSellMaincoin")
 	proto.RegisterType((*MsgSellMaincoinResponse)(nil), "mychain.maincoin.v1.MsgSellMaincoinResponse")
+	proto.RegisterType((*MsgClaimDevAllocation)(nil), "mychain.maincoin.v1.MsgClaimDevAllocation")
+	proto.RegisterType((*MsgClaimDevAllocationResponse)(nil), "mychain.maincoin.v1.MsgClaimDevAllocationResponse")
+	proto.RegisterType((*MsgSetPurchaseRate)(nil), "mychain.maincoin.v1.MsgSetPurchaseRate")
+	proto.RegisterType((*MsgSetPurchaseRateResponse)(nil), "mychain.maincoin.v1.MsgSetPurchaseRateResponse")
 }
 
 func init() { proto.RegisterFile("mychain/maincoin/v1/tx.proto", fileDescriptor_2e2f50e7843e7310) }
 
 var fileDescriptor_2e2f50e7843e7310 = []byte{
-	// 821 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcb, 0x6f, 0xe3, 0x44,
-	0x18, 0xaf, 0xfb, 0xc8, 0x36, 0x5f, 0xd2, 0x46, 0x9d, 0xad, 0xba, 0x6e, 0x80, 0x6c, 0xf0, 0xf2,
-	0xa8, 0x0a, 0xd8, 0x6a, 0x11, 0x20, 0xf6, 0x80, 0xd8, 0x54, 0x42, 0x70, 0xe8, 0x2a, 0x72, 0xcb,
-	0x85, 0x8b, 0x35, 0xb6, 0x07, 0xc7, 0x5a, 0x7b, 0xc6, 0xf2, 0x8c, 0xa3, 0xcd, 0x0d, 0x71, 0xe4,
-	0x04, 0xff, 0x05, 0xc7, 0x1e, 0xe0, 0xce, 0x71, 0x8f, 0x2b, 0x4e, 0x9c, 0x10, 0x6a, 0x0f, 0xfd,
-	0x17, 0x38, 0xa2, 0x79, 0xc4, 0x79, 0x28, 0x95, 0x2a, 0x2e, 0x51, 0xe6, 0xf7, 0xfb, 0x7d, 0xef,
-	0x6f, 0xc6, 0xf0, 0x66, 0x3e, 0x89, 0x46, 0x38, 0xa5, 0x5e, 0x8e, 0x53, 0x1a, 0xb1, 0x94, 0x7a,
-	0xe3, 0x13, 0x4f, 0xbc, 0x74, 0x8b, 0x92, 0x09, 0x86, 0x1e, 0x1a, 0xd6, 0x9d, 0xb2, 0xee, 0xf8,
-	0xa4, 0xbb, 0x87, 0xf3, 0x94, 0x32, 0x4f, 0xfd, 0x6a, 0x5d, 0xb7, 0x17, 0x31, 0x9e, 0x33, 0xee,
-	0x85, 0x98, 0x13, 0x6f, 0x7c, 0x12, 0x12, 0x81, 0x4f, 0x3c, 0xa5, 0xd7, 0xfc, 0x23, 0xc3, 0xe7,
-	0x3c, 0x91, 0xfe, 0x73, 0x9e, 0x18, 0xe2, 0x50, 0x13, 0x81, 0x3a, 0x79, 0xfa, 0x60, 0xa8, 0xfd,
-	0x84, 0x25, 0x4c, 0xe3, 0xf2, 0x9f, 0x41, 0xfb, 0xab, 0xf2, 0x2d, 0x70, 0x89, 0x73, 0x63, 0xe7,
-	0xfc, 0x61, 0x41, 0xe7, 0x9c, 0x27, 0xdf, 0x16, 0x31, 0x16, 0x64, 0xa8, 0x18, 0xf4, 0x29, 0x34,
-	0x71, 0x25, 0x46, 0xac, 0x4c, 0xc5, 0xc4, 0xb6, 0xfa, 0xd6, 0x51, 0x73, 0x60, 0xff, 0xf9, 0xdb,
-	0x47, 0xfb, 0x26, 0xe0, 0xb3, 0x38, 0x2e, 0x09, 0xe7, 0x17, 0xa2, 0x4c, 0x69, 0xe2, 0xcf, 0xa4,
-	0xe8, 0x0b, 0x68, 0x68, 0xdf, 0xf6, 0x7a, 0xdf, 0x3a, 0x6a, 0x9d, 0xbe, 0xe1, 0xae, 0x68, 0x88,
-	0xab, 0x83, 0x0c, 0x9a, 0xaf, 0xfe, 0x7e, 0xbc, 0xf6, 0xeb, 0xed, 0xd5, 0xb1, 0xe5, 0x1b, 0xab,
-	0xa7, 0x9f, 0xfc, 0x78, 0x7b, 0x75, 0x3c, 0xf3, 0xf7, 0xd3, 0xed, 0xd5, 0xb1, 0x33, 0x2d, 0xe0,
-	0xe5, 0xac, 0x84, 0xa5, 0x74, 0x9d, 0x43, 0x78, 0xb4, 0x04, 0xf9, 0x84, 0x17, 0x8c, 0x72, 0xe2,
-	0xbc, 0x80, 0xdd, 0x73, 0x9e, 0x0c, 0xaa, 0xc9, 0xb9, 0x31, 0x46, 0xfb, 0xb0, 0x15, 0x56, 0x13,
-	0x52, 0xea, 0xba, 0x7c, 0x7d, 0x40, 0x9f, 0x41, 0x03, 0xe7, 0xac, 0xa2, 0xc2, 0x64, 0x7e, 0xe8,
-	0x9a, 0x5a, 0xe5, 0x88, 0x5c, 0x33, 0x22, 0xf7, 0x8c, 0xa5, 0x74, 0xb0, 0x29, 0xf3, 0xf6, 0x8d,
-	0xfc, 0x29, 0xc8, 0x94, 0xb5, 0x13, 0xe7, 0xdf, 0x75, 0xe8, 0x5c, 0x90, 0x24, 0x27, 0x54, 0x0c,
-	0xab, 0x32, 0x1a, 0x61, 0x4e, 0xd0, 0xbb, 0xb0, 0xcb, 0x35, 0x14, 0xd0, 0x2a, 0x0f, 0x4d, 0xdc,
-	0x4d, 0x7f, 0xc7, 0xa0, 0xcf, 0x15, 0x88, 0x9e, 0xc0, 0x8e, 0x60, 0x2f, 0x08, 0xe5, 0x41, 0xc8,
-	0xaa, 0x64, 0xa4, 0xd3, 0x68, 0xfa, 0x6d, 0x0d, 0x0e, 0x14, 0x86, 0xde, 0x83, 0x4e, 0x51, 0xa6,
-	0x11, 0x09, 0x0a, 0x52, 0x06, 0x8a, 0xb1, 0x37, 0x94, 0x6c, 0x47, 0xc1, 0x43, 0x52, 0x5e, 0x4a,
-	0x10, 0xbd, 0x0d, 0xed, 0x69, 0xcc, 0x88, 0x71, 0x61, 0x6f, 0x2a, 0x51, 0xcb, 0x60, 0x67, 0x8c,
-	0x0b, 0x99, 0x56, 0x4c, 0xc6, 0x01, 0xce, 0x32, 0x16, 0x61, 0x91, 0x32, 0x6a, 0x6f, 0x69, 0x4f,
-	0x31, 0x19, 0x3f, 0xab, 0x41, 0xf4, 0x18, 0x5a, 0x15, 0x9f, 0x06, 0xe3, 0x76, 0x43, 0x69, 0x40,
-	0x42, 0x2a, 0x12, 0x97, 0x82, 0x94, 0x07, 0x11, 0xcb, 0x8b, 0x8c, 0x08, 0x62, 0x3f, 0xe8, 0x5b,
-	0x47, 0xdb, 0x3e, 0xa4, 0xfc, 0xcc, 0x20, 0xe8, 0x18, 0xf6, 0x4c, 0x61, 0x29, 0x0d, 0x4c, 0x06,
-	0xf6, 0xb6, 0xf2, 0xd3, 0xd1, 0xc4, 0x37, 0xd4, 0xf4, 0x0c, 0x7d, 0x0e, 0x87, 0x46, 0x4b, 0x09,
-	0x89, 0x49, 0x1c, 0x08, 0x36, 0x73, 0xdd, 0x54, 0x36, 0x07, 0x5a, 0xf0, 0x5c, 0xf1, 0x97, 0x6c,
-	0x1a, 0xc6, 0xf9, 0x65, 0x1d, 0x0e, 0x16, 0x07, 0x3d, 0x5d, 0x01, 0xe4, 0xc2, 0x43, 0xc1, 0x04,
-	0xce, 0x82, 0xc5, 0x06, 0xeb, 0xf1, 0xef, 0x29, 0xea, 0x72, 0xbe, 0xcb, 0x6f, 0x01, 0x68, 0x7d,
-	0x81, 0xd3, 0xd8, 0xcc, 0xa1, 0xa9, 0x90, 0x21, 0x4e, 0x63, 0x39, 0x29, 0x3c, 0x26, 0x25, 0x4e,
-	0x48, 0xa0, 0xba, 0x6e, 0x46, 0xd0, 0x36, 0xe0, 0x50, 0x62, 0xe8, 0x4b, 0xd8, 0x36, 0xb5, 0x72,
-	0x7b, 0xb3, 0xbf, 0x71, 0xd4, 0x3a, 0x7d, 0x67, 0xe5, 0x55, 0x58, 0xda, 0x16, 0xbf, 0xb6, 0x42,
-	0xef, 0x43, 0xa7, 0x24, 0x52, 0x9a, 0xd2, 0x24, 0xf8, 0xbe, 0xa2, 0x31, 0x37, 0x13, 0xda, 0xad,
-	0xe1, 0xaf, 0x24, 0x8a, 0x6c, 0x78, 0x90, 0x13, 0xce, 0x71, 0x42, 0xcc, 0x78, 0xa6, 0x47, 0x87,
-	0xa9, 0x8b, 0x7d, 0x41, 0xb2, 0xac, 0x5e, 0xfe, 0x03, 0x68, 0x70, 0x92, 0x65, 0xf5, 0xf6, 0x9b,
-	0xd3, 0xff, 0x5f, 0xff, 0x96, 0x5c, 0x7f, 0xe3, 0xc5, 0x89, 0xd4, 0x3d, 0x9c, 0x0f, 0x58, 0x0f,
-	0xe1, 0x6b, 0xe8, 0x68, 0x8b, 0xa0, 0x24, 0xb2, 0x1a, 0x12, 0xab, 0x0c, 0xee, 0x11, 0x69, 0x57,
-	0xdb, 0xf9, 0xc6, 0xec, 0xf4, 0xf7, 0x75, 0xd8, 0x38, 0xe7, 0x09, 0x0a, 0xa1, 0xbd, 0xf0, 0x66,
-	0xad, 0x6e, 0xf0, 0xd2, 0xbb, 0xd0, 0xfd, 0xf0, 0x3e, 0xaa, 0x3a, 0xeb, 0x00, 0x5a, 0xf3, 0x4f,
-	0xc7, 0x93, 0xbb, 0x8c, 0xe7, 0x44, 0xdd, 0x0f, 0xee, 0x21, 0xaa, 0x03, 0x84, 0xd0, 0x5e, 0x98,
-	0xcf, 0x9d, 0x45, 0xcc, 0xab, 0xee, 0x2e, 0x62, 0x55, 0xeb, 0xbb, 0x5b, 0x3f, 0xc8, 0x37, 0x76,
-	0x70, 0xfa, 0xea, 0xba, 0x67, 0xbd, 0xbe, 0xee, 0x59, 0xff, 0x5c, 0xf7, 0xac, 0x9f, 0x6f, 0x7a,
-	0x6b, 0xaf, 0x6f, 0x7a, 0x6b, 0x7f, 0xdd, 0xf4, 0xd6, 0xbe, 0xb3, 0x57, 0x3c, 0xb1, 0x62, 0x52,
-	0x10, 0x1e, 0x36, 0xd4, 0x27, 0xe2, 0xe3, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x98, 0x2b, 0x79,
-	0x15, 0xf6, 0x06, 0x00, 0x00,
+	// 1043 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x26, 0x4e, 0x1a, 0x3f, 0x27, 0xb1, 0x32, 0x0d, 0xc9, 0xc6, 0x6d, 0x1d, 0xb3, 0xa1,
+	0xd4, 0x0a, 0xed, 0x2e, 0x31, 0x82, 0xd2, 0x1c, 0x10, 0x75, 0xaa, 0x0a, 0x24, 0x52, 0x59, 0x9b,
+	0x70, 0xe1, 0xb2, 0x1a, 0xef, 0x0e, 0xeb, 0x95, 0xbd, 0x3b, 0xd6, 0xce, 0xac, 0x55, 0xdf, 0x10,
+	0x07, 0x0e, 0x9c, 0xe0, 0xc6, 0x47, 0x40, 0x9c, 0x72, 0xe0, 0x03, 0x70, 0xec, 0x81, 0x43, 0x85,
+	0x84, 0x84, 0x38, 0x14, 0x94, 0x1c, 0xf2, 0x15, 0x38, 0xa2, 0x9d, 0x99, 0x5d, 0xc7, 0x8e, 0x03,
+	0x56, 0x2f, 0x89, 0xe7, 0xf7, 0x7e, 0xef, 0xff, 0x7b, 0x33, 0x0b, 0xb7, 0xc3, 0xa1, 0xdb, 0xc1,
+	0x41, 0x64, 0x85, 0x38, 0x88, 0x5c, 0x1a, 0x44, 0xd6, 0x60, 0xdf, 0xe2, 0xcf, 0xcd, 0x7e, 0x4c,
+	0x39, 0x45, 0x37, 0x95, 0xd4, 0xcc, 0xa4, 0xe6, 0x60, 0xbf, 0xb2, 0x8e, 0xc3, 0x20, 0xa2, 0x96,
+	0xf8, 0x2b, 0x79, 0x95, 0xaa, 0x4b, 0x59, 0x48, 0x99, 0xd5, 0xc6, 0x8c, 0x58, 0x83, 0xfd, 0x36,
+	0xe1, 0x78, 0xdf, 0x12, 0x7c, 0x29, 0xdf, 0x52, 0xf2, 0x90, 0xf9, 0xa9, 0xfd, 0x90, 0xf9, 0x4a,
+	0xb0, 0x2d, 0x05, 0x8e, 0x38, 0x59, 0xf2, 0xa0, 0x44, 0x1b, 0x3e, 0xf5, 0xa9, 0xc4, 0xd3, 0x5f,
+	0x0a, 0xad, 0x4d, 0x8b, 0xb7, 0x8f, 0x63, 0x1c, 0x2a, 0x3d, 0xe3, 0x17, 0x0d, 0xca, 0x47, 0xcc,
+	0xff, 0xbc, 0xef, 0x61, 0x4e, 0x5a, 0x42, 0x82, 0x3e, 0x80, 0x22, 0x4e, 0x78, 0x87, 0xc6, 0x01,
+	0x1f, 0xea, 0x5a, 0x4d, 0xab, 0x17, 0x9b, 0xfa, 0x6f, 0x3f, 0x3f, 0xd8, 0x50, 0x0e, 0x1f, 0x7b,
+	0x5e, 0x4c, 0x18, 0x3b, 0xe6, 0x71, 0x10, 0xf9, 0xf6, 0x88, 0x8a, 0x3e, 0x82, 0x25, 0x69, 0x5b,
+	0x9f, 0xaf, 0x69, 0xf5, 0x52, 0xe3, 0x96, 0x39, 0xa5, 0x20, 0xa6, 0x74, 0xd2, 0x2c, 0xbe, 0x78,
+	0xb5, 0x33, 0xf7, 0xe3, 0xc5, 0xe9, 0x9e, 0x66, 0x2b, 0xad, 0x83, 0xf7, 0xbf, 0xbe, 0x38, 0xdd,
+	0x1b, 0xd9, 0xfb, 0xf6, 0xe2, 0x74, 0xcf, 0xc8, 0x12, 0x78, 0x3e, 0x4a, 0x61, 0x22, 0x5c, 0x63,
+	0x1b, 0xb6, 0x26, 0x20, 0x9b, 0xb0, 0x3e, 0x8d, 0x18, 0x31, 0xba, 0xb0, 0x76, 0xc4, 0xfc, 0x66,
+	0x32, 0x3c, 0x52, 0xca, 0x68, 0x03, 0x16, 0xdb, 0xc9, 0x90, 0xc4, 0x32, 0x2f, 0x5b, 0x1e, 0xd0,
+	0x43, 0x58, 0xc2, 0x21, 0x4d, 0x22, 0xae, 0x22, 0xdf, 0x36, 0x55, 0xae, 0x69, 0x8b, 0x4c, 0xd5,
+	0x22, 0xf3, 0x90, 0x06, 0x51, 0xb3, 0x90, 0xc6, 0x6d, 0x2b, 0xfa, 0x01, 0xa4, 0x21, 0x4b, 0x23,
+	0xc6, 0x3f, 0xf3, 0x50, 0x3e, 0x26, 0x7e, 0x48, 0x22, 0xde, 0x4a, 0x62, 0xb7, 0x83, 0x19, 0x41,
+	0x77, 0x61, 0x8d, 0x49, 0xc8, 0x89, 0x92, 0xb0, 0xad, 0xfc, 0x16, 0xec, 0x55, 0x85, 0x3e, 0x13,
+	0x20, 0xda, 0x85, 0x55, 0x4e, 0xbb, 0x24, 0x62, 0x4e, 0x9b, 0x26, 0x7e, 0x47, 0x86, 0x51, 0xb4,
+	0x57, 0x24, 0xd8, 0x14, 0x18, 0x7a, 0x1b, 0xca, 0xfd, 0x38, 0x70, 0x89, 0xd3, 0x27, 0xb1, 0x23,
+	0x24, 0xfa, 0x82, 0xa0, 0xad, 0x0a, 0xb8, 0x45, 0xe2, 0x93, 0x14, 0x44, 0x6f, 0xc2, 0x4a, 0xe6,
+	0xd3, 0xa5, 0x8c, 0xeb, 0x05, 0x41, 0x2a, 0x29, 0xec, 0x90, 0x32, 0x9e, 0x86, 0xe5, 0x91, 0x81,
+	0x83, 0x7b, 0x3d, 0xea, 0x62, 0x1e, 0xd0, 0x48, 0x5f, 0x94, 0x96, 0x3c, 0x32, 0x78, 0x9c, 0x83,
+	0x68, 0x07, 0x4a, 0x09, 0xcb, 0x9c, 0x31, 0x7d, 0x49, 0x70, 0x20, 0x61, 0xca, 0x13, 0x4b, 0x09,
+	0x01, 0x73, 0x5c, 0x1a, 0xf6, 0x7b, 0x84, 0x13, 0xfd, 0x46, 0x4d, 0xab, 0x2f, 0xdb, 0x10, 0xb0,
+	0x43, 0x85, 0xa0, 0x3d, 0x58, 0x57, 0x89, 0x05, 0x91, 0xa3, 0x22, 0xd0, 0x97, 0x85, 0x9d, 0xb2,
+	0x14, 0x7c, 0x1a, 0xa9, 0x9a, 0xa1, 0x47, 0xb0, 0xad, 0xb8, 0x11, 0x21, 0x1e, 0xf1, 0x1c, 0x4e,
+	0x47, 0xa6, 0x8b, 0x42, 0x67, 0x53, 0x12, 0x9e, 0x09, 0xf9, 0x09, 0xcd, 0xdc, 0x18, 0xdf, 0xcf,
+	0xc3, 0xe6, 0x78, 0xa3, 0xb3, 0x11, 0x40, 0x26, 0xdc, 0xe4, 0x94, 0xe3, 0x9e, 0x33, 0x5e, 0x60,
+	0xd9, 0xfe, 0x75, 0x21, 0x3a, 0xb9, 0x5c, 0xe5, 0x3b, 0x00, 0x92, 0xdf, 0xc7, 0x81, 0xa7, 0xfa,
+	0x50, 0x14, 0x48, 0x0b, 0x07, 0x5e, 0xda, 0x29, 0x3c, 0x20, 0x31, 0xf6, 0x89, 0x23, 0xaa, 0xae,
+	0x5a, 0xb0, 0xa2, 0xc0, 0x56, 0x8a, 0xa1, 0x8f, 0x61, 0x59, 0xe5, 0xca, 0xf4, 0x42, 0x6d, 0xa1,
+	0x5e, 0x6a, 0xbc, 0x35, 0x75, 0x15, 0x26, 0xa6, 0xc5, 0xce, 0xb5, 0xd0, 0x3d, 0x28, 0xc7, 0x24,
+	0xa5, 0x06, 0x91, 0xef, 0x7c, 0x99, 0x44, 0x1e, 0x53, 0x1d, 0x5a, 0xcb, 0xe1, 0xa7, 0x29, 0x8a,
+	0x74, 0xb8, 0x11, 0x12, 0xc6, 0xb0, 0x4f, 0x54, 0x7b, 0xb2, 0xa3, 0x41, 0xc5, 0x62, 0x1f, 0x93,
+	0x5e, 0x2f, 0x1f, 0xfe, 0x4d, 0x58, 0x62, 0xa4, 0xd7, 0xcb, 0xa7, 0x5f, 0x9d, 0x5e, 0x7f, 0xfc,
+	0x4b, 0xe9, 0xf8, 0x2b, 0x2b, 0xc6, 0xaf, 0x1a, 0x6c, 0x4d, 0x78, 0xcc, 0xbb, 0xf0, 0x09, 0x94,
+	0xa5, 0x8a, 0x13, 0x93, 0x34, 0x1d, 0xe2, 0xe9, 0xda, 0x6c, 0xae, 0xd6, 0xa4, 0x9e, 0xad, 0xd4,
+	0x90, 0x0f, 0xcb, 0xb9, 0x89, 0xf9, 0xda, 0xc2, 0x7f, 0x9b, 0x78, 0x37, 0x35, 0xf1, 0xd3, 0x5f,
+	0x3b, 0x75, 0x3f, 0xe0, 0x9d, 0xa4, 0x6d, 0xba, 0x34, 0x54, 0xd7, 0xa6, 0xfa, 0xf7, 0x80, 0x79,
+	0x5d, 0x8b, 0x0f, 0xfb, 0x84, 0x09, 0x05, 0x66, 0xe7, 0xc6, 0x8d, 0x6f, 0x34, 0x78, 0xe3, 0x88,
+	0xf9, 0x87, 0x3d, 0x1c, 0x84, 0x4f, 0xc6, 0xd6, 0xa2, 0x01, 0x37, 0xdc, 0x14, 0x25, 0xf1, 0xff,
+	0xde, 0x8e, 0x19, 0xf1, 0xe0, 0x51, 0x5a, 0xa9, 0xec, 0x94, 0xde, 0x6c, 0xf5, 0xe9, 0x37, 0xdb,
+	0x55, 0x77, 0x86, 0x0f, 0x77, 0xa6, 0x0a, 0xf2, 0xe2, 0x3e, 0x05, 0x55, 0x24, 0x47, 0xda, 0x9f,
+	0xb9, 0xb6, 0xab, 0x52, 0xed, 0x50, 0x6a, 0x19, 0xbf, 0x6b, 0x80, 0x44, 0x03, 0x47, 0x13, 0x89,
+	0x39, 0x79, 0xed, 0xe7, 0x60, 0x03, 0x16, 0x3d, 0x12, 0xd1, 0x50, 0x2d, 0x91, 0x3c, 0xa0, 0x87,
+	0x50, 0x88, 0x31, 0x57, 0x7b, 0xd3, 0xdc, 0x4d, 0xe3, 0xf8, 0xf3, 0xd5, 0xce, 0x2d, 0x69, 0x8c,
+	0x79, 0x5d, 0x33, 0xa0, 0x56, 0x88, 0x79, 0xc7, 0xfc, 0x8c, 0xf8, 0xd8, 0x1d, 0x3e, 0x21, 0xae,
+	0x2d, 0x14, 0x0e, 0x3e, 0xbc, 0xfa, 0x3a, 0xdc, 0x9d, 0x5e, 0xc3, 0x89, 0x04, 0x8c, 0xdb, 0x50,
+	0xb9, 0x8a, 0x66, 0xd5, 0x6b, 0xfc, 0x50, 0x80, 0x85, 0x23, 0xe6, 0xa3, 0x36, 0xac, 0x8c, 0xbd,
+	0x82, 0xd3, 0x57, 0x76, 0xe2, 0xa5, 0xa9, 0xdc, 0x9f, 0x85, 0x95, 0x77, 0xca, 0x81, 0xd2, 0xe5,
+	0xc7, 0x68, 0xf7, 0x3a, 0xe5, 0x4b, 0xa4, 0xca, 0x3b, 0x33, 0x90, 0x72, 0x07, 0x6d, 0x58, 0x19,
+	0xdb, 0xf8, 0x6b, 0x93, 0xb8, 0xcc, 0xaa, 0xdc, 0x9f, 0x85, 0x95, 0xfb, 0xe0, 0x80, 0xa6, 0x2c,
+	0xc5, 0xde, 0x75, 0x36, 0xae, 0x72, 0x2b, 0x8d, 0xd9, 0xb9, 0xb9, 0xd7, 0x2e, 0x94, 0x27, 0x3a,
+	0x88, 0xee, 0x5d, 0x1f, 0xf6, 0x18, 0xb1, 0x62, 0xcd, 0x48, 0xcc, 0x9c, 0x55, 0x16, 0xbf, 0x4a,
+	0x3f, 0x4c, 0x9a, 0x8d, 0x17, 0x67, 0x55, 0xed, 0xe5, 0x59, 0x55, 0xfb, 0xfb, 0xac, 0xaa, 0x7d,
+	0x77, 0x5e, 0x9d, 0x7b, 0x79, 0x5e, 0x9d, 0xfb, 0xe3, 0xbc, 0x3a, 0xf7, 0x85, 0x3e, 0x65, 0xf2,
+	0xc4, 0x35, 0xd2, 0x5e, 0x12, 0xdf, 0x55, 0xef, 0xfd, 0x3b, 0x00, 0x1c, 0x98, 0xc4, 0x76, 0x2b,
+	0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -564,6 +780,10 @@ type MsgClient interface {
 	BuyMaincoin(ctx context.Context, in *MsgBuyMaincoin, opts ...grpc.CallOption) (*MsgBuyMaincoinResponse, error)
 	// SellMaincoin defines the SellMaincoin RPC.
 	SellMaincoin(ctx context.Context, in *MsgSellMaincoin, opts ...grpc.CallOption) (*MsgSellMaincoinResponse, error)
+	// ClaimDevAllocation releases the claimer's vested dev allocation.
+	ClaimDevAllocation(ctx context.Context, in *MsgClaimDevAllocation, opts ...grpc.CallOption) (*MsgClaimDevAllocationResponse, error)
+	// SetPurchaseRate sets the TestUSD rate of a purchase denom priced by the authority.
+	SetPurchaseRate(ctx context.Context, in *MsgSetPurchaseRate, opts ...grpc.CallOption) (*MsgSetPurchaseRateResponse, error)
 }
 
 type msgClient struct {
@@ -601,6 +821,24 @@ func (c *msgClient) SellMaincoin(ctx context.Context, in *MsgSellMaincoin, opts
 	return out, nil
 }
 
+func (c *msgClient) ClaimDevAllocation(ctx context.Context, in *MsgClaimDevAllocation, opts ...grpc.CallOption) (*MsgClaimDevAllocationResponse, error) {
+	out := new(MsgClaimDevAllocationResponse)
+	err := c.cc.Invoke(ctx, "/mychain.maincoin.v1.Msg/ClaimDevAllocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPurchaseRate(ctx context.Context, in *MsgSetPurchaseRate, opts ...grpc.CallOption) (*MsgSetPurchaseRateResponse, error) {
+	out := new(MsgSetPurchaseRateResponse)
+	err := c.cc.Invoke(ctx, "/mychain.maincoin.v1.Msg/SetPurchaseRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
@@ -610,6 +848,10 @@ type MsgServer interface {
 	BuyMaincoin(context.Context, *MsgBuyMaincoin) (*MsgBuyMaincoinResponse, error)
 	// SellMaincoin defines the SellMaincoin RPC.
 	SellMaincoin(context.Context, *MsgSellMaincoin) (*MsgSellMaincoinResponse, error)
+	// ClaimDevAllocation releases the claimer's vested dev allocation.
+	ClaimDevAllocation(context.Context, *MsgClaimDevAllocation) (*MsgClaimDevAllocationResponse, error)
+	// SetPurchaseRate sets the TestUSD rate of a purchase denom priced by the authority.
+	SetPurchaseRate(context.Context, *MsgSetPurchaseRate) (*MsgSetPurchaseRateResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -625,6 +867,12 @@ func (*UnimplementedMsgServer) BuyMaincoin(ctx context.Context, req *MsgBuyMainc
 func (*UnimplementedMsgServer) SellMaincoin(ctx context.Context, req *MsgSellMaincoin) (*MsgSellMaincoinResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SellMaincoin not implemented")
 }
+func (*UnimplementedMsgServer) ClaimDevAllocation(ctx context.Context, req *MsgClaimDevAllocation) (*MsgClaimDevAllocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimDevAllocation not implemented")
+}
+func (*UnimplementedMsgServer) SetPurchaseRate(ctx context.Context, req *MsgSetPurchaseRate) (*MsgSetPurchaseRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPurchaseRate not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -684,6 +932,42 @@ func _Msg_SellMaincoin_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ClaimDevAllocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimDevAllocation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimDevAllocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mychain.maincoin.v1.Msg/ClaimDevAllocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimDevAllocation(ctx, req.(*MsgClaimDevAllocation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPurchaseRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPurchaseRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPurchaseRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mychain.maincoin.v1.Msg/SetPurchaseRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPurchaseRate(ctx, req.(*MsgSetPurchaseRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "mychain.maincoin.v1.Msg",
@@ -701,6 +985,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SellMaincoin",
 			Handler:    _Msg_SellMaincoin_Handler,
 		},
+		{
+			MethodName: "ClaimDevAllocation",
+			Handler:    _Msg_ClaimDevAllocation_Handler,
+		},
+		{
+			MethodName: "SetPurchaseRate",
+			Handler:    _Msg_SetPurchaseRate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "mychain/maincoin/v1/tx.proto",
@@ -1028,6 +1320,20 @@ func (m *MsgSellMaincoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Refunded) > 0 {
+		for iNdEx := len(m.Refunded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refunded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.AmountRefunded.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1041,63 +1347,196 @@ func (m *MsgSellMaincoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgClaimDevAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgClaimDevAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimDevAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Claimer) > 0 {
+		i -= len(m.Claimer)
+		copy(dAtA[i:], m.Claimer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Claimer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgClaimDevAllocationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgBuyMaincoin) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgClaimDevAllocationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimDevAllocationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Buyer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.AmountClaimed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *SegmentPurchase) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetPurchaseRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPurchaseRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPurchaseRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SegmentNumber != 0 {
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPurchaseRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPurchaseRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPurchaseRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBuyMaincoin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *SegmentPurchase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SegmentNumber != 0 {
 		n += 1 + sovTx(uint64(m.SegmentNumber))
 	}
 	l = len(m.TokensBought)
@@ -1192,6 +1631,64 @@ func (m *MsgSellMaincoinResponse) Size() (n int) {
 	_ = l
 	l = m.AmountRefunded.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if len(m.Refunded) > 0 {
+		for _, e := range m.Refunded {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimDevAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Claimer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimDevAllocationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AmountClaimed.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetPurchaseRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetPurchaseRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	return n
 }
 
@@ -2215,6 +2712,403 @@ func (m *MsgSellMaincoinResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refunded = append(m.Refunded, types.Coin{})
+			if err := m.Refunded[len(m.Refunded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimDevAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimDevAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimDevAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimDevAllocationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimDevAllocationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimDevAllocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountClaimed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountClaimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPurchaseRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPurchaseRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPurchaseRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPurchaseRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPurchaseRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPurchaseRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,240 @@
+package indexer
+
+import (
+	"fmt"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"mychain/x/mychain/types"
+)
+
+// Event types consumed by the indexer besides transaction_record.
+const (
+	EventTypeCreateOrder   = "create_order"
+	EventTypeTradeExecuted = "trade_executed"
+	EventTypeTransfer      = "transfer"
+)
+
+// Record kinds used to de-duplicate records derived from module events
+// against transaction_record events emitted by the same tx.
+const (
+	kindTransfer = "transfer"
+	kindOrder    = "order"
+	kindTrade    = "trade"
+)
+
+const timestampFormat = "2006-01-02T15:04:05Z"
+
+// Block is the part of a finalized block the indexer consumes.
+type Block struct {
+	Height      int64
+	Time        time.Time
+	Txs         [][]byte
+	TxResults   []*abci.ExecTxResult
+	BlockEvents []abci.Event
+}
+
+// recordCodec decodes the typed records carried by transaction_record events.
+var recordCodec = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+// ExtractRecords converts the events of a block into per-address history
+// records. Failed txs are skipped. Transfers touching an address in
+// moduleAccounts are not recorded for that address.
+func ExtractRecords(block Block, moduleAccounts map[string]bool) []types.TransactionRecord {
+	timestamp := block.Time.UTC().Format(timestampFormat)
+
+	// Block level events (BeginBlock/EndBlock) only carry explicit records
+	var records []types.TransactionRecord
+	for _, event := range block.BlockEvents {
+		if event.Type != types.EventTypeTransactionRecord {
+			continue
+		}
+		if rec, ok := parseTransactionRecord(event, "", block.Height, timestamp); ok {
+			records = append(records, rec)
+		}
+	}
+
+	for i, result := range block.TxResults {
+		if result == nil || result.Code != 0 || i >= len(block.Txs) {
+			continue
+		}
+		txHash := fmt.Sprintf("%X", cmttypes.Tx(block.Txs[i]).Hash())
+		records = append(records, extractTxRecords(result.Events, txHash, block.Height, timestamp, moduleAccounts)...)
+	}
+
+	return records
+}
+
+func extractTxRecords(events []abci.Event, txHash string, height int64, timestamp string, moduleAccounts map[string]bool) []types.TransactionRecord {
+	var records []types.TransactionRecord
+	covered := make(map[string]bool)
+
+	for _, event := range events {
+		if event.Type != types.EventTypeTransactionRecord {
+			continue
+		}
+		if rec, ok := parseTransactionRecord(event, txHash, height, timestamp); ok {
+			records = append(records, rec)
+			covered[rec.Address+"/"+recordKind(rec.Type)] = true
+		}
+	}
+
+	add := func(address, kind string, record types.TransactionRecord) {
+		if address == "" || covered[address+"/"+kind] {
+			return
+		}
+		record.Address = address
+		records = append(records, record)
+	}
+
+	for _, event := range events {
+		attrs := attributeMap(event)
+		switch event.Type {
+		case EventTypeCreateOrder:
+			orderType := "buy"
+			if attrs["is_buy"] == "false" {
+				orderType = "sell"
+			}
+			amount, _ := sdk.ParseCoinsNormalized(attrs["amount"])
+			add(attrs["maker"], kindOrder, types.TransactionRecord{
+				TxHash:      txHash,
+				Type:        "dex_create_order",
+				Description: fmt.Sprintf("Created %s order for %s at %s", orderType, attrs["amount"], attrs["price"]),
+				Amount:      amount,
+				From:        attrs["maker"],
+				To:          "dex_orderbook",
+				Height:      height,
+				Timestamp:   timestamp,
+			})
+
+		case EventTypeTradeExecuted:
+			buyer, seller := attrs["buyer"], attrs["seller"]
+			add(buyer, kindTrade, types.TransactionRecord{
+				TxHash:      txHash,
+				Type:        "dex_order_filled",
+				Description: fmt.Sprintf("Bought %s at %s (order #%s)", attrs["amount"], attrs["price"], attrs["buy_order_id"]),
+				From:        seller,
+				To:          buyer,
+				Height:      height,
+				Timestamp:   timestamp,
+			})
+			add(seller, kindTrade, types.TransactionRecord{
+				TxHash:      txHash,
+				Type:        "dex_order_filled",
+				Description: fmt.Sprintf("Sold %s at %s (order #%s)", attrs["amount"], attrs["price"], attrs["sell_order_id"]),
+				From:        seller,
+				To:          buyer,
+				Height:      height,
+				Timestamp:   timestamp,
+			})
+
+		case EventTypeTransfer:
+			sender, recipient := attrs["sender"], attrs["recipient"]
+			coins, err := sdk.ParseCoinsNormalized(attrs["amount"])
+			if err != nil || sender == "" || recipient == "" {
+				continue
+			}
+			if !moduleAccounts[sender] {
+				add(sender, kindTransfer, types.TransactionRecord{
+					TxHash:      txHash,
+					Type:        "send",
+					Description: fmt.Sprintf("Sent %s to %s", coins.String(), shortenAddress(recipient)),
+					Amount:      coins,
+					From:        sender,
+					To:          recipient,
+					Height:      height,
+					Timestamp:   timestamp,
+				})
+			}
+			if !moduleAccounts[recipient] {
+				add(recipient, kindTransfer, types.TransactionRecord{
+					TxHash:      txHash,
+					Type:        "receive",
+					Description: fmt.Sprintf("Received %s from %s", coins.String(), shortenAddress(sender)),
+					Amount:      coins,
+					From:        sender,
+					To:          recipient,
+					Height:      height,
+					Timestamp:   timestamp,
+				})
+			}
+		}
+	}
+
+	return records
+}
+
+// parseTransactionRecord reads a transaction_record event. The typed record
+// attribute is preferred; events without one fall back to the flat
+// attributes. The event's own tx_hash is only used when the record was not
+// emitted by a tx.
+func parseTransactionRecord(event abci.Event, txHash string, height int64, timestamp string) (types.TransactionRecord, bool) {
+	attrs := attributeMap(event)
+
+	var record types.TransactionRecord
+	if raw := attrs[types.AttributeKeyRecord]; raw == "" || recordCodec.UnmarshalJSON([]byte(raw), &record) != nil {
+		// Amounts that are not coins (e.g. bare integers) are kept in the description only
+		amount, err := sdk.ParseCoinsNormalized(attrs[types.AttributeKeyAmount])
+		if err != nil {
+			amount = sdk.NewCoins()
+		}
+
+		record = types.TransactionRecord{
+			Address:     attrs[types.AttributeKeyAddress],
+			Type:        attrs[types.AttributeKeyType],
+			Description: attrs[types.AttributeKeyDescription],
+			Amount:      amount,
+			From:        attrs[types.AttributeKeyFrom],
+			To:          attrs[types.AttributeKeyTo],
+		}
+	}
+	if record.Address == "" {
+		return types.TransactionRecord{}, false
+	}
+
+	if txHash == "" {
+		txHash = attrs[types.AttributeKeyTxHash]
+	}
+	if ts := attrs[types.AttributeKeyTimestamp]; ts != "" {
+		timestamp = ts
+	}
+
+	record.TxHash = txHash
+	record.Height = height
+	record.Timestamp = timestamp
+	record.Sequence = 0
+
+	return record, true
+}
+
+// recordKind maps a recorded tx type to the module event it duplicates.
+func recordKind(txType string) string {
+	switch txType {
+	case "dex_create_order", "dex_order":
+		return kindOrder
+	case "dex_trade_buy", "dex_trade_sell", "dex_order_filled":
+		return kindTrade
+	default:
+		return kindTransfer
+	}
+}
+
+func attributeMap(event abci.Event) map[string]string {
+	attrs := make(map[string]string, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+	return attrs
+}
+
+func shortenAddress(address string) string {
+	if len(address) > 10 {
+		return address[:6] + "..." + address[len(address)-4:]
+	}
+	return address
+}
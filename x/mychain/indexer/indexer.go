@@ -0,0 +1,105 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/log"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+)
+
+// BlockSource is the subset of the CometBFT RPC client used by the indexer.
+type BlockSource interface {
+	Status(ctx context.Context) (*coretypes.ResultStatus, error)
+	Block(ctx context.Context, height *int64) (*coretypes.ResultBlock, error)
+	BlockResults(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error)
+}
+
+// Indexer follows a node's finalized blocks and writes transaction history
+// derived from their ABCI events to a Store.
+type Indexer struct {
+	source         BlockSource
+	store          *Store
+	logger         log.Logger
+	moduleAccounts map[string]bool
+}
+
+// NewIndexer creates an Indexer. moduleAccounts holds the bech32 addresses
+// of module accounts, whose side of bank transfers is not recorded.
+func NewIndexer(source BlockSource, store *Store, logger log.Logger, moduleAccounts map[string]bool) *Indexer {
+	return &Indexer{
+		source:         source,
+		store:          store,
+		logger:         logger,
+		moduleAccounts: moduleAccounts,
+	}
+}
+
+// Run indexes blocks from startHeight (or the block after the last indexed
+// one) and then polls for new blocks until ctx is cancelled.
+func (idx *Indexer) Run(ctx context.Context, startHeight int64, pollInterval time.Duration) error {
+	next, err := idx.store.LastHeight()
+	if err != nil {
+		return err
+	}
+	next++
+	if next < startHeight {
+		next = startHeight
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		status, err := idx.source.Status(ctx)
+		if err != nil {
+			idx.logger.Error("failed to query node status", "error", err)
+		} else {
+			for ; next <= status.SyncInfo.LatestBlockHeight; next++ {
+				if err := idx.IndexHeight(ctx, next); err != nil {
+					idx.logger.Error("failed to index block", "height", next, "error", err)
+					break
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// IndexHeight indexes a single block.
+func (idx *Indexer) IndexHeight(ctx context.Context, height int64) error {
+	block, err := idx.source.Block(ctx, &height)
+	if err != nil {
+		return fmt.Errorf("failed to fetch block: %w", err)
+	}
+	results, err := idx.source.BlockResults(ctx, &height)
+	if err != nil {
+		return fmt.Errorf("failed to fetch block results: %w", err)
+	}
+
+	txs := make([][]byte, len(block.Block.Txs))
+	for i, tx := range block.Block.Txs {
+		txs[i] = tx
+	}
+
+	records := ExtractRecords(Block{
+		Height:      height,
+		Time:        block.Block.Time,
+		Txs:         txs,
+		TxResults:   results.TxsResults,
+		BlockEvents: results.FinalizeBlockEvents,
+	}, idx.moduleAccounts)
+
+	if err := idx.store.SaveBlock(height, records); err != nil {
+		return err
+	}
+
+	idx.logger.Debug("indexed block", "height", height, "records", len(records))
+	return nil
+}
@@ -0,0 +1,131 @@
+package indexer_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"mychain/x/mychain/indexer"
+	"mychain/x/mychain/types"
+)
+
+func event(typ string, kv ...string) abci.Event {
+	e := abci.Event{Type: typ}
+	for i := 0; i+1 < len(kv); i += 2 {
+		e.Attributes = append(e.Attributes, abci.EventAttribute{Key: kv[i], Value: kv[i+1]})
+	}
+	return e
+}
+
+func TestExtractRecords(t *testing.T) {
+	tx := []byte("tx-1")
+	failedTx := []byte("tx-2")
+	moduleAccounts := map[string]bool{"module": true}
+
+	// Typed records carry their details in the record attribute
+	order := types.TransactionRecord{
+		Address: "alice",
+		Type:    "dex_create_order",
+		Amount:  sdk.NewCoins(sdk.NewInt64Coin("umc", 3)),
+		Details: &types.TransactionRecord_DexOrder{DexOrder: &types.DexOrderDetails{OrderId: 42, PairId: 1, IsBuy: true}},
+	}
+	orderJSON, err := codec.NewProtoCodec(codectypes.NewInterfaceRegistry()).MarshalJSON(&order)
+	require.NoError(t, err)
+
+	block := indexer.Block{
+		Height: 10,
+		Time:   time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		Txs:    [][]byte{tx, failedTx},
+		TxResults: []*abci.ExecTxResult{
+			{Events: []abci.Event{
+				// MsgSend recorded by the ante decorator covers both transfer sides
+				event(types.EventTypeTransactionRecord, "address", "alice", "type", "send", "amount", "5utusd", "from", "alice", "to", "bob"),
+				event(indexer.EventTypeTransfer, "sender", "alice", "recipient", "bob", "amount", "5utusd"),
+				event(types.EventTypeTransactionRecord, "address", "alice", "type", "dex_create_order", "record", string(orderJSON)),
+				event(indexer.EventTypeCreateOrder, "maker", "alice", "amount", "3umc", "price", "1ulc"),
+				// Fee payment to a module account only shows up for the payer
+				event(indexer.EventTypeTransfer, "sender", "carol", "recipient", "module", "amount", "1ulc"),
+				event(indexer.EventTypeTradeExecuted, "buyer", "alice", "seller", "dave", "amount", "7", "price", "100"),
+			}},
+			{Code: 1, Events: []abci.Event{
+				event(indexer.EventTypeTransfer, "sender", "erin", "recipient", "frank", "amount", "1ulc"),
+			}},
+		},
+		BlockEvents: []abci.Event{
+			event(types.EventTypeTransactionRecord, "address", "mint", "type", "mint", "amount", "100ulc", "tx_hash", "mint-10"),
+		},
+	}
+
+	records := indexer.ExtractRecords(block, moduleAccounts)
+
+	got := make(map[string][]string)
+	for _, rec := range records {
+		got[rec.Address] = append(got[rec.Address], rec.Type)
+	}
+	require.Equal(t, map[string][]string{
+		"mint":  {"mint"},
+		"alice": {"send", "dex_create_order", "dex_order_filled"},
+		"bob":   {"receive"},
+		"carol": {"send"},
+		"dave":  {"dex_order_filled"},
+	}, got)
+
+	hash := fmt.Sprintf("%X", cmttypes.Tx(tx).Hash())
+	for _, rec := range records {
+		require.Equal(t, int64(10), rec.Height)
+		require.Equal(t, "2025-01-02T03:04:05Z", rec.Timestamp)
+		if rec.Type == "dex_create_order" {
+			require.Equal(t, uint64(42), rec.GetDexOrder().OrderId)
+			require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("umc", 3)), rec.Amount)
+		}
+		if rec.Address == "mint" {
+			require.Equal(t, "mint-10", rec.TxHash)
+		} else {
+			require.Equal(t, hash, rec.TxHash)
+		}
+	}
+}
+
+func TestStoreHistory(t *testing.T) {
+	store := indexer.NewStore(dbm.NewMemDB())
+
+	height, err := store.LastHeight()
+	require.NoError(t, err)
+	require.Zero(t, height)
+
+	for h := int64(1); h <= 3; h++ {
+		require.NoError(t, store.SaveBlock(h, []types.TransactionRecord{
+			{Address: "alice", Type: "send", Height: h},
+			{Address: "alice2", Type: "receive", Height: h},
+		}))
+	}
+
+	height, err = store.LastHeight()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), height)
+
+	// Newest first, and "alice" must not pick up "alice2" records
+	history, pageRes, err := store.PaginateHistory("alice", 0, 0, nil, &query.PageRequest{Limit: 2, Reverse: true})
+	require.NoError(t, err)
+	require.NotNil(t, pageRes.NextKey)
+	require.Len(t, history, 2)
+	require.Equal(t, int64(3), history[0].Height)
+	require.Equal(t, int64(2), history[1].Height)
+	for _, rec := range history {
+		require.Equal(t, "send", rec.Type)
+	}
+
+	resp, err := indexer.NewQuerier(store).TransactionHistory(context.Background(), &types.QueryTransactionHistoryRequest{Address: "alice2"})
+	require.NoError(t, err)
+	require.Len(t, resp.Transactions, 3)
+}
@@ -0,0 +1,48 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"mychain/x/mychain/types"
+)
+
+var _ types.QueryServer = &Querier{}
+
+// Querier serves the mychain TransactionHistory query from the indexer
+// store. All other mychain queries are left unimplemented.
+type Querier struct {
+	types.UnimplementedQueryServer
+
+	store *Store
+}
+
+// NewQuerier creates a Querier reading from store.
+func NewQuerier(store *Store) *Querier {
+	return &Querier{store: store}
+}
+
+func (q *Querier) TransactionHistory(_ context.Context, req *types.QueryTransactionHistoryRequest) (*types.QueryTransactionHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	transactions, pageRes, err := q.store.PaginateHistory(req.Address, req.MinHeight, req.MaxHeight, req.Filter(), req.PageRequest())
+	if errors.Is(err, types.ErrInvalidPageKey) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTransactionHistoryResponse{
+		Transactions: transactions,
+		Pagination:   pageRes,
+	}, nil
+}
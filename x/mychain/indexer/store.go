@@ -0,0 +1,80 @@
+package indexer
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/prefix"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"mychain/x/mychain/types"
+)
+
+var (
+	// historyPrefix keys records as h/<address>/<height>/<sequence>
+	historyPrefix = []byte("h/")
+
+	// lastHeightKey stores the last fully indexed block height
+	lastHeightKey = []byte("m/last_height")
+)
+
+// Store persists indexed transaction history in a local database.
+type Store struct {
+	db dbm.DB
+}
+
+// NewStore creates a Store backed by db.
+func NewStore(db dbm.DB) *Store {
+	return &Store{db: db}
+}
+
+// LastHeight returns the last indexed height, or 0 if nothing is indexed.
+func (s *Store) LastHeight() (int64, error) {
+	bz, err := s.db.Get(lastHeightKey)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	return int64(binary.BigEndian.Uint64(bz)), nil
+}
+
+// SaveBlock writes the records of a block and advances the last indexed
+// height in a single batch, so a block is either fully indexed or not at all.
+func (s *Store) SaveBlock(height int64, records []types.TransactionRecord) error {
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	for i, rec := range records {
+		bz, err := rec.Marshal()
+		if err != nil {
+			return err
+		}
+		if err := batch.Set(historyKey(rec.Address, height, i), bz); err != nil {
+			return err
+		}
+	}
+
+	heightBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBz, uint64(height))
+	if err := batch.Set(lastHeightKey, heightBz); err != nil {
+		return err
+	}
+
+	return batch.WriteSync()
+}
+
+// PaginateHistory returns a page of the records of address between minHeight
+// and maxHeight accepted by filter.
+func (s *Store) PaginateHistory(address string, minHeight, maxHeight int64, filter func(types.TransactionRecord) bool, pageReq *query.PageRequest) ([]types.TransactionRecord, *query.PageResponse, error) {
+	store := prefix.NewStore(&dbadapter.Store{DB: s.db}, addressPrefix(address))
+	return types.PaginateTxHistory(store, minHeight, maxHeight, filter, pageReq)
+}
+
+func addressPrefix(address string) []byte {
+	return append(append([]byte{}, historyPrefix...), []byte(address+"/")...)
+}
+
+func historyKey(address string, height int64, sequence int) []byte {
+	return append(addressPrefix(address), []byte(fmt.Sprintf("%020d/%08d", height, sequence))...)
+}
@@ -2,13 +2,51 @@ package keeper
 
 import (
 	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
 
 	"mychain/x/mychain/types"
 )
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
-	return k.Params.Set(ctx, genState.Params)
+	if err := k.Params.Set(ctx, genState.Params); err != nil {
+		return err
+	}
+
+	// Records keep their exported sequence so keys and ordering survive
+	for _, record := range genState.TxHistory {
+		if err := k.writeTransactionHistory(ctx, record); err != nil {
+			return err
+		}
+	}
+	if err := k.TxHistorySeq.Set(ctx, genState.TxHistorySeq); err != nil {
+		return err
+	}
+
+	for _, record := range genState.StakingDistributions {
+		if err := k.setStakingDistributionRecord(ctx, record); err != nil {
+			return err
+		}
+	}
+
+	// The staking reward interval in progress keeps what x/mint minted so far
+	if !genState.MintModuleRewards.IsNil() {
+		if err := k.MintModuleRewards.Set(ctx, genState.MintModuleRewards); err != nil {
+			return err
+		}
+	}
+
+	// The mint recorder keeps comparing against the supply it saw last. A
+	// zero supply means it has not run yet
+	if !genState.LastMintSupply.IsNil() && genState.LastMintSupply.IsPositive() {
+		if err := k.setLastMintSupply(ctx, genState.LastMintSupply); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // ExportGenesis returns the module's exported genesis.
@@ -21,5 +59,29 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	genesis.TxHistory, err = k.GetAllTransactionHistory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	genesis.TxHistorySeq, err = k.TxHistorySeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	genesis.StakingDistributions, err = k.GetAllStakingDistributions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mintModuleRewards, err := k.MintModuleRewards.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	if err == nil {
+		genesis.MintModuleRewards = mintModuleRewards
+	}
+	genesis.LastMintSupply, err = k.lastMintSupply(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return genesis, nil
 }
@@ -3,7 +3,12 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"mychain/testutil/nullify"
+	"mychain/x/mychain/keeper"
 	"mychain/x/mychain/types"
 
 	"github.com/stretchr/testify/require"
@@ -26,3 +31,43 @@ func TestGenesis(t *testing.T) {
 
 	require.Equal(t, genesisState.Params, got.Params)
 }
+
+func TestGenesisTxHistory(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("ulc", 5))
+	require.NoError(t, f.keeper.RecordTransaction(ctx.WithBlockHeight(4), keeper.NewTransactionRecord("alice", "send", "first", coins, "alice", "bob")))
+	require.NoError(t, f.keeper.RecordTransaction(ctx.WithBlockHeight(6), keeper.NewTransactionRecord("bob", "receive", "second", coins, "alice", "bob")))
+	f.keeper.RecordStakingDistribution(ctx, keeper.StakingRewardDistribution{Height: 720})
+	require.NoError(t, f.keeper.MintModuleRewards.Set(ctx, math.NewInt(42)))
+
+	exported, err := f.keeper.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.NoError(t, exported.Validate())
+	require.Len(t, exported.TxHistory, 2)
+	require.Equal(t, uint64(2), exported.TxHistorySeq)
+	require.Len(t, exported.StakingDistributions, 1)
+	require.Equal(t, int64(42), exported.MintModuleRewards.Int64())
+	require.True(t, exported.LastMintSupply.IsNil())
+	exported.LastMintSupply = math.NewInt(1_000_000)
+
+	// Importing into a fresh store restores records, indexes and sequence
+	g := initFixture(t)
+	require.NoError(t, g.keeper.InitGenesis(g.ctx, *exported))
+
+	reexported, err := g.keeper.ExportGenesis(g.ctx)
+	require.NoError(t, err)
+	require.Equal(t, exported, reexported)
+
+	count, err := g.keeper.TxHistoryCount.Get(g.ctx, "alice")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), count)
+	address, err := g.keeper.TxHistoryByHeight.Get(g.ctx, collections.Join(int64(6), exported.TxHistory[1].Sequence))
+	require.NoError(t, err)
+	require.Equal(t, "bob", address)
+
+	next, err := g.keeper.TxHistorySeq.Next(g.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), next)
+}
@@ -6,7 +6,9 @@ import (
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"mychain/x/mychain/types"
 )
@@ -18,12 +20,27 @@ type Keeper struct {
 	// Address capable of executing a MsgUpdateParams message.
 	// Typically, this should be the x/gov module account.
 	authority []byte
-	
+
 	stakingKeeper types.StakingKeeper
 	bankKeeper    types.BankKeeper
+	mintKeeper    types.MintKeeper
+	dexKeeper     types.DexKeeper
 
 	Schema collections.Schema
 	Params collections.Item[types.Params]
+	// TxHistorySeq numbers transaction history records in the order they are written
+	TxHistorySeq collections.Sequence
+	// TxHistoryByHeight maps (height, sequence) to the address a record is
+	// filed under, so records can be pruned by age
+	TxHistoryByHeight collections.Map[collections.Pair[int64, uint64], string]
+	// TxHistoryCount is the number of records stored per address
+	TxHistoryCount collections.Map[string, uint64]
+	// TxHistoryOverCap holds the addresses with more records than the
+	// retention cap, pending pruning
+	TxHistoryOverCap collections.KeySet[string]
+	// MintModuleRewards is what x/mint minted in the reward denom since the
+	// last staking reward distribution
+	MintModuleRewards collections.Item[math.Int]
 }
 
 func NewKeeper(
@@ -48,7 +65,13 @@ func NewKeeper(
 		stakingKeeper: stakingKeeper,
 		bankKeeper:    bankKeeper,
 
-		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		TxHistorySeq: collections.NewSequence(sb, types.TxHistorySeqKey, "tx_history_seq"),
+		TxHistoryByHeight: collections.NewMap(sb, types.TxHistoryByHeightKey, "tx_history_by_height",
+			collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key), collections.StringValue),
+		TxHistoryCount:    collections.NewMap(sb, types.TxHistoryCountKey, "tx_history_count", collections.StringKey, collections.Uint64Value),
+		TxHistoryOverCap:  collections.NewKeySet(sb, types.TxHistoryOverCapKey, "tx_history_over_cap", collections.StringKey),
+		MintModuleRewards: collections.NewItem(sb, types.MintModuleRewardsKey, "mint_module_rewards", sdk.IntValue),
 	}
 
 	schema, err := sb.Build()
@@ -69,3 +92,13 @@ func (k Keeper) GetAuthority() []byte {
 func (k *Keeper) SetStakingKeeper(sk types.StakingKeeper) {
 	k.stakingKeeper = sk
 }
+
+// SetMintKeeper sets the mint query server read by the staking metrics
+func (k *Keeper) SetMintKeeper(mk types.MintKeeper) {
+	k.mintKeeper = mk
+}
+
+// SetDexKeeper sets the dex keeper read by the staking metrics
+func (k *Keeper) SetDexKeeper(dk types.DexKeeper) {
+	k.dexKeeper = dk
+}
@@ -0,0 +1,322 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"mychain/x/mychain/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// legacyTransactionHistory is the JSON encoding of transaction history
+// records before typed records were stored with the codec (versions 1 and 2).
+type legacyTransactionHistory struct {
+	TxHash      string    `json:"tx_hash"`
+	Type        string    `json:"type"`
+	Description string    `json:"description"`
+	Amount      sdk.Coins `json:"amount"`
+	From        string    `json:"from"`
+	To          string    `json:"to"`
+	Height      int64     `json:"height"`
+	Timestamp   string    `json:"timestamp"`
+	MsgIndex    int64     `json:"msg_index"`
+	Sequence    uint64    `json:"sequence"`
+	Metadata    string    `json:"metadata,omitempty"`
+}
+
+type legacyTxHistoryEntry struct {
+	key     []byte
+	address string
+	tx      legacyTransactionHistory
+}
+
+// legacyTxHistory loads every JSON encoded transaction history record.
+func (m Migrator) legacyTxHistory(ctx sdk.Context) ([]legacyTxHistoryEntry, error) {
+	kvStore := m.keeper.storeService.OpenKVStore(ctx)
+	prefix := []byte("tx-history/")
+
+	iterator, err := kvStore.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var entries []legacyTxHistoryEntry
+	for ; iterator.Valid(); iterator.Next() {
+		key := append([]byte{}, iterator.Key()...)
+		rest := bytes.TrimPrefix(key, prefix)
+		sep := bytes.IndexByte(rest, '/')
+		if sep <= 0 {
+			continue
+		}
+
+		var tx legacyTransactionHistory
+		if err := json.Unmarshal(iterator.Value(), &tx); err != nil {
+			return nil, fmt.Errorf("failed to decode transaction history %q: %w", key, err)
+		}
+		entries = append(entries, legacyTxHistoryEntry{key: key, address: string(rest[:sep]), tx: tx})
+	}
+
+	return entries, nil
+}
+
+// Migrate1to2 rewrites tx-history/<address>/<height>/<hex tx> keys to
+// tx-history/<address>/<height>/<sequence>. Raw tx hex stored as the hash is
+// replaced by the tx's SHA-256 hash, and non-hex pseudo hashes (metadata
+// written outside of a tx) are moved to the metadata field.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	kvStore := m.keeper.storeService.OpenKVStore(ctx)
+
+	entries, err := m.legacyTxHistory(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if err := kvStore.Delete(entry.key); err != nil {
+			return err
+		}
+
+		tx := entry.tx
+		tx.TxHash, tx.Metadata = migrateTxHash(tx.TxHash, tx.Metadata)
+		tx.MsgIndex = -1
+		tx.Sequence, err = m.keeper.TxHistorySeq.Next(ctx)
+		if err != nil {
+			return err
+		}
+
+		bz, err := json.Marshal(tx)
+		if err != nil {
+			return err
+		}
+		if err := kvStore.Set(TxHistoryKey(entry.address, tx.Height, tx.Sequence), bz); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Migrate2to3 re-encodes JSON transaction history records as typed
+// TransactionRecords. The free-form metadata is kept as legacy metadata and
+// decoded into typed details where its format is known.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	kvStore := m.keeper.storeService.OpenKVStore(ctx)
+
+	entries, err := m.legacyTxHistory(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		record := types.TransactionRecord{
+			TxHash:         entry.tx.TxHash,
+			Type:           entry.tx.Type,
+			Description:    entry.tx.Description,
+			Amount:         entry.tx.Amount,
+			From:           entry.tx.From,
+			To:             entry.tx.To,
+			Height:         entry.tx.Height,
+			Timestamp:      entry.tx.Timestamp,
+			Address:        entry.address,
+			MsgIndex:       entry.tx.MsgIndex,
+			Sequence:       entry.tx.Sequence,
+			LegacyMetadata: entry.tx.Metadata,
+		}
+		setLegacyDetails(&record)
+
+		bz, err := m.keeper.cdc.Marshal(&record)
+		if err != nil {
+			return err
+		}
+		if err := kvStore.Set(entry.key, bz); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Migrate3to4 enables the default history retention and indexes existing
+// records by height and counts them per address. Existing records are pruned
+// gradually by EndBlock once the migration has run.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	params.TxHistoryRetention = types.DefaultTxHistoryRetention()
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return err
+	}
+
+	records, err := m.keeper.GetAllTransactionHistory(ctx)
+	if err != nil {
+		return err
+	}
+	counts := make(map[string]uint64)
+	for _, record := range records {
+		if err := m.keeper.TxHistoryByHeight.Set(ctx, collections.Join(record.Height, record.Sequence), record.Address); err != nil {
+			return err
+		}
+		counts[record.Address]++
+	}
+
+	for _, address := range slices.Sorted(maps.Keys(counts)) {
+		if err := m.keeper.TxHistoryCount.Set(ctx, address, counts[address]); err != nil {
+			return err
+		}
+		if counts[address] > params.TxHistoryRetention.MaxRecordsPerAddress {
+			if err := m.keeper.TxHistoryOverCap.Set(ctx, address); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Migrate4to5 adds the staking reward params. The engine starts disabled, so
+// staking rewards keep coming from x/mint until governance enables it.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	params.StakingRewards = types.DefaultStakingRewardParams()
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// setLegacyDetails decodes the metadata formats the dex, maincoin and testusd
+// handlers used to write into typed details. Unknown or malformed metadata is
+// left as legacy metadata only.
+func setLegacyDetails(record *types.TransactionRecord) {
+	if record.LegacyMetadata == "" {
+		return
+	}
+
+	var meta struct {
+		OrderID       uint64 `json:"order_id"`
+		PairID        uint64 `json:"pair_id"`
+		IsBuy         bool   `json:"is_buy"`
+		Price         string `json:"price"`
+		Fee           string `json:"fee"`
+		Fees          string `json:"fees"`
+		Spent         string `json:"spent"`
+		Paid          string `json:"paid"`
+		Received      string `json:"received"`
+		Segments      uint64 `json:"segments"`
+		UsdcAmount    string `json:"usdc_amount"`
+		TestusdAmount string `json:"testusd_amount"`
+		PegRatio      string `json:"peg_ratio"`
+	}
+	if err := json.Unmarshal([]byte(record.LegacyMetadata), &meta); err != nil {
+		return
+	}
+
+	parseCoin := func(s string) sdk.Coin {
+		coin, err := sdk.ParseCoinNormalized(s)
+		if err != nil {
+			return sdk.Coin{}
+		}
+		return coin
+	}
+	// Dex fees were written as bare ulc amounts
+	parseFee := func(s string) sdk.Coin {
+		amount, ok := math.NewIntFromString(s)
+		if !ok {
+			return sdk.Coin{}
+		}
+		return sdk.NewCoin("ulc", amount)
+	}
+	parseInt := func(s string) math.Int {
+		i, ok := math.NewIntFromString(s)
+		if !ok {
+			return math.ZeroInt()
+		}
+		return i
+	}
+
+	switch record.Type {
+	case "dex_create_order", "dex_cancel_order":
+		record.Details = &types.TransactionRecord_DexOrder{DexOrder: &types.DexOrderDetails{
+			OrderId:   meta.OrderID,
+			PairId:    meta.PairID,
+			IsBuy:     meta.IsBuy,
+			Price:     parseCoin(meta.Price),
+			CancelFee: parseFee(meta.Fee),
+		}}
+	case "dex_trade_buy", "dex_trade_sell":
+		record.Details = &types.TransactionRecord_DexFill{DexFill: &types.DexFillDetails{
+			OrderId: meta.OrderID,
+			IsBuy:   record.Type == "dex_trade_buy",
+			Price:   parseCoin(meta.Price),
+			Fees:    parseFee(meta.Fees),
+		}}
+	case "buy_maincoin":
+		record.Details = &types.TransactionRecord_SegmentPurchase{SegmentPurchase: &types.SegmentPurchaseDetails{
+			Paid:              parseCoin(meta.Paid),
+			Cost:              parseInt(meta.Spent),
+			TokensReceived:    parseInt(meta.Received),
+			DevAllocation:     math.ZeroInt(),
+			SegmentsProcessed: meta.Segments,
+		}}
+	case "bridge_in", "bridge_out":
+		pegRatio, err := math.LegacyNewDecFromStr(meta.PegRatio)
+		if err != nil {
+			pegRatio = math.LegacyZeroDec()
+		}
+		direction := "in"
+		if record.Type == "bridge_out" {
+			direction = "out"
+		}
+		record.Details = &types.TransactionRecord_Bridge{Bridge: &types.BridgeDetails{
+			Direction: direction,
+			Usdc:      parseCoin(meta.UsdcAmount),
+			Testusd:   parseCoin(meta.TestusdAmount),
+			PegRatio:  pegRatio,
+		}}
+	}
+}
+
+// migrateTxHash converts a legacy tx hash field to a real tx hash, moving
+// anything that is not hex encoded tx bytes into metadata.
+func migrateTxHash(legacy, metadata string) (string, string) {
+	if legacy == "" {
+		return "", metadata
+	}
+
+	txBytes, err := hex.DecodeString(legacy)
+	if err != nil {
+		if metadata == "" {
+			metadata = legacy
+		}
+		return "", metadata
+	}
+
+	// Already a hash
+	if len(txBytes) == 32 {
+		return legacy, metadata
+	}
+
+	return fmt.Sprintf("%X", cmttypes.Tx(txBytes).Hash()), metadata
+}
@@ -1,10 +1,13 @@
 package keeper
 
 import (
+	"context"
 	"fmt"
 	
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"mychain/x/mychain/types"
 )
 
 const (
@@ -22,25 +25,13 @@ func (k Keeper) RecordMintingIfOccurred(ctx sdk.Context) error {
 	currentSupply := k.bankKeeper.GetSupply(ctx, "ulc")
 	
 	// Get last recorded supply
-	store := k.storeService.OpenKVStore(ctx)
-	key := []byte(LastSupplyKey)
-	
-	var lastSupply sdkmath.Int
-	bz, err := store.Get(key)
+	lastSupply, err := k.lastMintSupply(ctx)
 	if err != nil {
 		return err
 	}
-	
-	if bz != nil {
-		err = lastSupply.Unmarshal(bz)
-		if err != nil {
-			return err
-		}
-	} else {
+	if lastSupply.IsNil() {
 		// First time, just record current supply
-		bz, _ := currentSupply.Amount.Marshal()
-		store.Set(key, bz)
-		return nil
+		return k.setLastMintSupply(ctx, currentSupply.Amount)
 	}
 	
 	// Calculate minted amount
@@ -70,8 +61,8 @@ func (k Keeper) RecordMintingIfOccurred(ctx sdk.Context) error {
 		}
 		
 		// Create mint transaction record
-		mintTx := TransactionHistory{
-			TxHash:      fmt.Sprintf("MINT-%d", ctx.BlockHeight()),
+		mintTx := types.TransactionRecord{
+			Address:     "mint",
 			Type:        "mint_inflation",
 			Description: fmt.Sprintf("Inflation minting at %.2f%% APR (Bonded: %.1f%%)", 
 				inflationRate.MulInt64(100).MustFloat64(),
@@ -79,36 +70,33 @@ func (k Keeper) RecordMintingIfOccurred(ctx sdk.Context) error {
 			Amount:      sdk.NewCoins(sdk.NewCoin("ulc", mintedAmount)),
 			From:        MintModuleAccount,
 			To:          DistrModuleAccount,
-			Height:      ctx.BlockHeight(),
-			Timestamp:   ctx.BlockTime().Format("2006-01-02T15:04:05Z"),
 		}
 		
 		// Save mint transaction
-		if err := k.SaveTransactionHistory(ctx, "mint", mintTx); err != nil {
+		if err := k.SaveTransactionHistory(ctx, mintTx); err != nil {
 			ctx.Logger().Error("Failed to save mint transaction", "error", err)
 		}
 		
 		// Create distribution record
-		distTx := TransactionHistory{
-			TxHash:      fmt.Sprintf("DIST-%d", ctx.BlockHeight()),
+		distTx := types.TransactionRecord{
+			Address:     "distribution",
 			Type:        "distribution",
 			Description: fmt.Sprintf("Distributed %.6f LC to validators and delegators", 
 				float64(mintedAmount.Int64())/1_000_000),
 			Amount:      sdk.NewCoins(sdk.NewCoin("ulc", mintedAmount)),
 			From:        DistrModuleAccount,
 			To:          "validators",
-			Height:      ctx.BlockHeight(),
-			Timestamp:   ctx.BlockTime().Format("2006-01-02T15:04:05Z"),
 		}
 		
 		// Save distribution transaction
-		if err := k.SaveTransactionHistory(ctx, "distribution", distTx); err != nil {
+		if err := k.SaveTransactionHistory(ctx, distTx); err != nil {
 			ctx.Logger().Error("Failed to save distribution transaction", "error", err)
 		}
 		
 		// Update last supply
-		bz, _ := currentSupply.Amount.Marshal()
-		store.Set(key, bz)
+		if err := k.setLastMintSupply(ctx, currentSupply.Amount); err != nil {
+			return err
+		}
 		
 		// Log the event
 		ctx.Logger().Info("Minting recorded",
@@ -131,4 +119,27 @@ func (k Keeper) RecordMintingIfOccurred(ctx sdk.Context) error {
 	}
 	
 	return nil
-}
\ No newline at end of file
+}
+
+// lastMintSupply returns the supply the mint recorder saw last, or a nil Int
+// before its first block.
+func (k Keeper) lastMintSupply(ctx context.Context) (sdkmath.Int, error) {
+	bz, err := k.storeService.OpenKVStore(ctx).Get([]byte(LastSupplyKey))
+	if err != nil || bz == nil {
+		return sdkmath.Int{}, err
+	}
+	var supply sdkmath.Int
+	if err := supply.Unmarshal(bz); err != nil {
+		return sdkmath.Int{}, err
+	}
+	return supply, nil
+}
+
+// setLastMintSupply stores the supply the mint recorder saw last.
+func (k Keeper) setLastMintSupply(ctx context.Context, supply sdkmath.Int) error {
+	bz, err := supply.Marshal()
+	if err != nil {
+		return err
+	}
+	return k.storeService.OpenKVStore(ctx).Set([]byte(LastSupplyKey), bz)
+}
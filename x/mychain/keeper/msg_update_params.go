@@ -3,8 +3,12 @@ package keeper
 import (
 	"bytes"
 	"context"
+	"errors"
+	"strconv"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"mychain/x/mychain/types"
 )
@@ -24,9 +28,27 @@ func (k msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams)
 		return nil, err
 	}
 
+	previous, err := k.Params.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
 	if err := k.Params.Set(ctx, req.Params); err != nil {
 		return nil, err
 	}
 
+	if sr := req.Params.StakingRewards; !sr.Equal(previous.StakingRewards) {
+		sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeStakingRewardParamsUpdated,
+				sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(sr.Enabled)),
+				sdk.NewAttribute(types.AttributeKeyMode, sr.Mode.String()),
+				sdk.NewAttribute(types.AttributeKeyRate, sr.Rate.String()),
+				sdk.NewAttribute(types.AttributeKeyIntervalBlocks, strconv.FormatUint(sr.IntervalBlocks, 10)),
+				sdk.NewAttribute(types.AttributeKeyRewardDenom, sr.RewardDenom),
+			),
+		)
+	}
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
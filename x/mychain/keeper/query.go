@@ -27,3 +27,8 @@ func (q queryServer) StakingInfo(ctx context.Context, req *types.QueryStakingInf
 func (q queryServer) StakingDistributionHistory(ctx context.Context, req *types.QueryStakingDistributionHistoryRequest) (*types.QueryStakingDistributionHistoryResponse, error) {
 	return q.k.StakingDistributionHistory(ctx, req)
 }
+
+// StakingMetrics implements the Query/StakingMetrics gRPC method
+func (q queryServer) StakingMetrics(ctx context.Context, req *types.QueryStakingMetricsRequest) (*types.QueryStakingMetricsResponse, error) {
+	return q.k.StakingMetrics(ctx, req)
+}
@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"fmt"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,33 +17,32 @@ func (k Keeper) StakingInfo(goCtx context.Context, req *types.QueryStakingInfoRe
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sr := params.StakingRewards
+
 	// Return empty info if staking keeper not set
 	if k.stakingKeeper == nil {
+		zero := sdk.NewCoin(sr.RewardDenom, math.ZeroInt()).String()
 		return &types.QueryStakingInfoResponse{
 			Info: &types.StakingInfo{
-				TotalSupply:      "0alc",
-				TotalStaked:      "0alc",
-				EffectiveApr:     "0",
-				AnnualRewards:    "0alc",
-				HourlyRewards:    "0alc",
-				NumDelegators:    0,
-				NextDistributionHeight: 0,
+				TotalSupply:     zero,
+				TotalStaked:     zero,
+				EffectiveApr:    "0",
+				AnnualRewards:   zero,
+				HourlyRewards:   zero,
+				IntervalRewards: zero,
+				Enabled:         sr.Enabled,
+				Mode:            sr.Mode,
+				IntervalBlocks:  sr.IntervalBlocks,
 			},
 		}, nil
 	}
 
-	// Calculate effective APR
-	effectiveAPR, err := k.CalculateEffectiveAPR(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	// Get total supply
-	totalSupply := k.bankKeeper.GetSupply(ctx, "alc")
-
-	// Get total staked
-	totalStaked, err := k.stakingKeeper.TotalBondedTokens(ctx)
+	supply, bonded, err := k.stakingTotals(ctx, sr)
 	if err != nil {
 		return nil, err
 	}
@@ -52,19 +50,15 @@ func (k Keeper) StakingInfo(goCtx context.Context, req *types.QueryStakingInfoRe
 	if err != nil {
 		return nil, err
 	}
-	
-	// Convert if needed
-	stakedDisplay := totalStaked
-	if bondDenom == "ulc" {
-		stakedDisplay = totalStaked.Quo(math.NewInt(1000000))
-	}
 
-	// Calculate annual and hourly rewards (20% of total supply)
-	annualRate, _ := math.LegacyNewDecFromStr(AnnualRewardRate)
-	annualRewards := math.LegacyNewDecFromInt(totalSupply.Amount).Mul(annualRate).TruncateInt()
-	
-	hoursPerYear := math.LegacyNewDec(BlocksPerYear).Quo(math.LegacyNewDec(BlocksPerHour))
-	hourlyRewards := math.LegacyNewDecFromInt(annualRewards).Quo(hoursPerYear).TruncateInt()
+	// Rewards the engine targets; zero while it is disabled
+	effectiveAPR := math.LegacyZeroDec()
+	annualRewards, intervalRewards := math.ZeroInt(), math.ZeroInt()
+	if sr.Enabled {
+		effectiveAPR = sr.EffectiveAPR(supply, bonded)
+		annualRewards = sr.AnnualRewards(supply, bonded)
+		intervalRewards = sr.IntervalRewards(supply, bonded)
+	}
 
 	// Count delegators
 	validators, err := k.stakingKeeper.GetAllValidators(ctx)
@@ -83,18 +77,19 @@ func (k Keeper) StakingInfo(goCtx context.Context, req *types.QueryStakingInfoRe
 		}
 	}
 
-	// Calculate next distribution height
-	currentHeight := ctx.BlockHeight()
-	nextDistribution := ((currentHeight / BlocksPerHour) + 1) * BlocksPerHour
-
+	interval := sdk.NewCoin(sr.RewardDenom, intervalRewards).String()
 	info := &types.StakingInfo{
-		TotalSupply:      totalSupply.String(),
-		TotalStaked:      fmt.Sprintf("%salc", stakedDisplay.String()),
-		EffectiveApr:     effectiveAPR.String(),
-		AnnualRewards:    fmt.Sprintf("%salc", annualRewards.String()),
-		HourlyRewards:    fmt.Sprintf("%salc", hourlyRewards.String()),
-		NumDelegators:    int64(len(delegatorMap)),
-		NextDistributionHeight: nextDistribution,
+		TotalSupply:            sdk.NewCoin(sr.RewardDenom, supply).String(),
+		TotalStaked:            sdk.NewCoin(bondDenom, bonded).String(),
+		EffectiveApr:           effectiveAPR.String(),
+		AnnualRewards:          sdk.NewCoin(sr.RewardDenom, annualRewards).String(),
+		HourlyRewards:          interval,
+		NumDelegators:          int64(len(delegatorMap)),
+		NextDistributionHeight: sr.NextDistributionHeight(ctx.BlockHeight()),
+		Enabled:                sr.Enabled,
+		Mode:                   sr.Mode,
+		IntervalRewards:        interval,
+		IntervalBlocks:         sr.IntervalBlocks,
 	}
 
 	return &types.QueryStakingInfoResponse{Info: info}, nil
@@ -118,7 +113,7 @@ func (k Keeper) StakingDistributionHistory(goCtx context.Context, req *types.Que
 		offset = req.Offset
 	}
 
-	records, err := k.GetStakingDistributionHistory(ctx, limit, offset)
+	records, err := k.GetStakingDistributionHistory(ctx, limit, offset, req.BeforeHeight)
 	if err != nil {
 		return nil, err
 	}
@@ -129,4 +124,17 @@ func (k Keeper) StakingDistributionHistory(goCtx context.Context, req *types.Que
 		Distributions: records,
 		Total:         uint64(len(records)),
 	}, nil
-}
\ No newline at end of file
+}
+// StakingMetrics returns the bonded ratio, inflation and staking yield
+func (k Keeper) StakingMetrics(goCtx context.Context, req *types.QueryStakingMetricsRequest) (*types.QueryStakingMetricsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	metrics, err := k.GetStakingMetrics(goCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryStakingMetricsResponse{Metrics: metrics}, nil
+}
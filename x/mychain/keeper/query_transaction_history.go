@@ -2,8 +2,8 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	
@@ -19,39 +19,15 @@ func (q queryServer) TransactionHistory(ctx context.Context, req *types.QueryTra
 		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
 	}
 	
-	// Default limit
-	limit := req.Limit
-	if limit == 0 {
-		limit = 50
-	}
-	if limit > 100 {
-		limit = 100
-	}
-	
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	
-	// Get transaction history
-	txHistory, err := q.k.GetTransactionHistory(sdkCtx, req.Address, limit)
-	if err != nil {
+	transactions, pageRes, err := q.k.PaginateTransactionHistory(ctx, req.Address, req.MinHeight, req.MaxHeight, req.Filter(), req.PageRequest())
+	if errors.Is(err, types.ErrInvalidPageKey) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 	
-	// Convert to proto format
-	var transactions []types.TransactionRecord
-	for _, tx := range txHistory {
-		transactions = append(transactions, types.TransactionRecord{
-			TxHash:      tx.TxHash,
-			Type:        tx.Type,
-			Description: tx.Description,
-			Amount:      tx.Amount,
-			From:        tx.From,
-			To:          tx.To,
-			Height:      tx.Height,
-			Timestamp:   tx.Timestamp,
-		})
-	}
-	
 	return &types.QueryTransactionHistoryResponse{
 		Transactions: transactions,
+		Pagination:   pageRes,
 	}, nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"mychain/x/mychain/types"
+)
+
+// GetStakingMetrics returns the bonded ratio, inflation and staking yield of
+// the bond denom. Supply and bonded tokens are both read in base units of the
+// bond denom and converted to display units with its bank metadata.
+func (k Keeper) GetStakingMetrics(ctx context.Context) (types.StakingMetrics, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.StakingMetrics{}, err
+	}
+
+	bondDenom := params.StakingRewards.RewardDenom
+	bonded := math.ZeroInt()
+	if k.stakingKeeper != nil {
+		if bondDenom, err = k.stakingKeeper.BondDenom(ctx); err != nil {
+			return types.StakingMetrics{}, err
+		}
+		if bonded, err = k.stakingKeeper.TotalBondedTokens(ctx); err != nil {
+			return types.StakingMetrics{}, err
+		}
+	}
+	supply := k.bankKeeper.GetSupply(ctx, bondDenom).Amount
+
+	md, found := k.bankKeeper.GetDenomMetaData(ctx, bondDenom)
+	display, exponent := types.DisplayUnit(bondDenom, md, found)
+
+	mintInflation := math.LegacyZeroDec()
+	if k.mintKeeper != nil {
+		res, err := k.mintKeeper.Inflation(ctx, &minttypes.QueryInflationRequest{})
+		if err != nil {
+			return types.StakingMetrics{}, err
+		}
+		mintInflation = res.Inflation
+	}
+
+	// The dex rate is an APR on order liquidity, not on supply, so it is
+	// reported on its own
+	dexRewardAPR := math.LegacyZeroDec()
+	if k.dexKeeper != nil {
+		_, dexRewardAPR, _ = k.dexKeeper.CalculateTotalSystemInflation(ctx)
+	}
+
+	// The engine only adds to the staking yield when it pays the bond denom
+	engineAPR := math.LegacyZeroDec()
+	if params.StakingRewards.Enabled && params.StakingRewards.RewardDenom == bondDenom {
+		engineAPR = params.StakingRewards.EffectiveAPR(supply, bonded)
+	}
+
+	bondedRatio := types.BondedRatio(supply, bonded)
+	stakingYield := types.StakingYield(mintInflation, bondedRatio, engineAPR)
+	// Staking rewards grow the supply by the yield on the bonded share
+	totalInflation := stakingYield.Mul(bondedRatio)
+
+	return types.StakingMetrics{
+		TotalSupply:        sdk.NewCoin(bondDenom, supply),
+		TotalBonded:        sdk.NewCoin(bondDenom, bonded),
+		DisplayDenom:       display,
+		DisplayExponent:    exponent,
+		TotalSupplyDisplay: types.ToDisplay(supply, exponent),
+		TotalBondedDisplay: types.ToDisplay(bonded, exponent),
+		BondedRatio:        bondedRatio,
+		MintInflation:      mintInflation,
+		DexRewardApr:       dexRewardAPR,
+		EngineApr:          engineAPR,
+		StakingYield:       stakingYield,
+		TotalInflation:     totalInflation,
+		RealYield:          types.RealYield(stakingYield, totalInflation),
+	}, nil
+}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+
+	"mychain/x/mychain/types"
+)
+
+type fakeMintKeeper struct {
+	inflation math.LegacyDec
+}
+
+func (m fakeMintKeeper) Inflation(context.Context, *minttypes.QueryInflationRequest) (*minttypes.QueryInflationResponse, error) {
+	return &minttypes.QueryInflationResponse{Inflation: m.inflation}, nil
+}
+
+type fakeDexKeeper struct {
+	inflation math.LegacyDec
+}
+
+func (d fakeDexKeeper) CalculateTotalSystemInflation(context.Context) (math.LegacyDec, math.LegacyDec, math.LegacyDec) {
+	return math.LegacyZeroDec(), d.inflation, d.inflation
+}
+
+var lcMetadata = banktypes.Metadata{
+	Base:    "ulc",
+	Display: "lc",
+	DenomUnits: []*banktypes.DenomUnit{
+		{Denom: "ulc", Exponent: 0},
+		{Denom: "lc", Exponent: 6},
+	},
+}
+
+func TestStakingMetricsDisplayUnits(t *testing.T) {
+	ctx, k, bank := initRewardFixture(t, types.STAKING_REWARD_MODE_SUPPLY_PERCENTAGE, math.LegacyNewDecWithPrec(20, 2))
+	bank.metadata = map[string]banktypes.Metadata{"ulc": lcMetadata}
+	k.SetMintKeeper(fakeMintKeeper{inflation: math.LegacyNewDecWithPrec(10, 2)})
+	k.SetDexKeeper(fakeDexKeeper{inflation: math.LegacyNewDecWithPrec(5, 2)})
+
+	res, err := k.StakingMetrics(ctx, &types.QueryStakingMetricsRequest{})
+	require.NoError(t, err)
+	m := res.Metrics
+
+	// Amounts stay in ulc, display amounts are in lc
+	require.Equal(t, sdk.NewInt64Coin("ulc", 1_000_000_000), m.TotalSupply)
+	require.Equal(t, sdk.NewInt64Coin("ulc", 500_000_000), m.TotalBonded)
+	require.Equal(t, "lc", m.DisplayDenom)
+	require.Equal(t, uint32(6), m.DisplayExponent)
+	require.Equal(t, math.LegacyNewDec(1000), m.TotalSupplyDisplay)
+	require.Equal(t, math.LegacyNewDec(500), m.TotalBondedDisplay)
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 1), m.BondedRatio)
+
+	// x/mint pays stakers 10% / 0.5 = 20%, the engine tops that up to 40%
+	require.Equal(t, math.LegacyNewDecWithPrec(10, 2), m.MintInflation)
+	require.Equal(t, math.LegacyNewDecWithPrec(40, 2), m.EngineApr)
+	require.Equal(t, math.LegacyNewDecWithPrec(40, 2), m.StakingYield)
+	// 40% on half the supply; the dex APR is on order liquidity and is
+	// reported apart from it
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 2), m.DexRewardApr)
+	require.Equal(t, math.LegacyNewDecWithPrec(20, 2), m.TotalInflation)
+	// 1.40 / 1.20 - 1
+	require.Equal(t, math.LegacyNewDecWithPrec(14, 1).Quo(math.LegacyNewDecWithPrec(12, 1)).Sub(math.LegacyOneDec()), m.RealYield)
+}
+
+func TestStakingMetricsRewardDenomNotBondDenom(t *testing.T) {
+	ctx, k, bank := initRewardFixture(t, types.STAKING_REWARD_MODE_SUPPLY_PERCENTAGE, math.LegacyNewDecWithPrec(20, 2))
+	bank.supply = bank.supply.Add(sdk.NewInt64Coin("alc", 7_000_000_000))
+	bank.metadata = map[string]banktypes.Metadata{"ulc": lcMetadata}
+	params, err := k.Params.Get(ctx)
+	require.NoError(t, err)
+	params.StakingRewards.RewardDenom = "alc"
+	require.NoError(t, k.Params.Set(ctx, params))
+	k.SetMintKeeper(fakeMintKeeper{inflation: math.LegacyNewDecWithPrec(10, 2)})
+
+	m, err := k.GetStakingMetrics(ctx)
+	require.NoError(t, err)
+
+	// Supply and bonded are read in the bond denom, not the reward denom
+	require.Equal(t, sdk.NewInt64Coin("ulc", 1_000_000_000), m.TotalSupply)
+	require.Equal(t, sdk.NewInt64Coin("ulc", 500_000_000), m.TotalBonded)
+	require.Equal(t, "lc", m.DisplayDenom)
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 1), m.BondedRatio)
+
+	// An engine paying alc does not add to the ulc staking yield
+	require.True(t, m.EngineApr.IsZero())
+	require.Equal(t, math.LegacyNewDecWithPrec(20, 2), m.StakingYield)
+	require.Equal(t, math.LegacyNewDecWithPrec(10, 2), m.TotalInflation)
+}
+
+func TestStakingMetricsWithoutMetadata(t *testing.T) {
+	ctx, k, _ := initRewardFixture(t, types.STAKING_REWARD_MODE_SUPPLY_PERCENTAGE, math.LegacyNewDecWithPrec(20, 2))
+	params, err := k.Params.Get(ctx)
+	require.NoError(t, err)
+	params.StakingRewards.Enabled = false
+	require.NoError(t, k.Params.Set(ctx, params))
+	k.SetMintKeeper(fakeMintKeeper{inflation: math.LegacyNewDecWithPrec(10, 2)})
+
+	m, err := k.GetStakingMetrics(ctx)
+	require.NoError(t, err)
+
+	// Without metadata amounts are shown in ulc
+	require.Equal(t, "ulc", m.DisplayDenom)
+	require.Zero(t, m.DisplayExponent)
+	require.Equal(t, math.LegacyNewDec(1_000_000_000), m.TotalSupplyDisplay)
+	require.Equal(t, math.LegacyNewDec(500_000_000), m.TotalBondedDisplay)
+
+	// Only x/mint pays stakers, and the real yield is what that outpaces
+	// inflation by: 1.2 / 1.1 - 1
+	require.True(t, m.EngineApr.IsZero())
+	require.Equal(t, math.LegacyNewDecWithPrec(20, 2), m.StakingYield)
+	require.Equal(t, math.LegacyNewDecWithPrec(10, 2), m.TotalInflation)
+	require.Equal(t, math.LegacyNewDecWithPrec(12, 1).Quo(math.LegacyNewDecWithPrec(11, 1)).Sub(math.LegacyOneDec()), m.RealYield)
+}
+
+func TestDisplayUnit(t *testing.T) {
+	denom, exp := types.DisplayUnit("ulc", lcMetadata, true)
+	require.Equal(t, "lc", denom)
+	require.Equal(t, uint32(6), exp)
+	require.Equal(t, math.LegacyNewDecWithPrec(15, 1), types.ToDisplay(math.NewInt(1_500_000), exp))
+
+	// A display unit missing from the denom units falls back to the base
+	md := lcMetadata
+	md.Display = "klc"
+	denom, exp = types.DisplayUnit("ulc", md, true)
+	require.Equal(t, "ulc", denom)
+	require.Zero(t, exp)
+}
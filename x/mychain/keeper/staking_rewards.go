@@ -1,140 +1,180 @@
 package keeper
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"mychain/x/mychain/types"
-)
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
-const (
-	// Annual reward rate: 20% of total supply
-	AnnualRewardRate = "0.20"
-	// Distribution frequency: every hour (720 blocks at 5s/block)
-	BlocksPerHour = 720
-	// Blocks per year (365.25 days)
-	BlocksPerYear = 6311520
+	"mychain/x/mychain/types"
 )
 
 // StakingRewardDistribution represents a single distribution event
 type StakingRewardDistribution struct {
-	Height           int64
-	Timestamp        time.Time
-	TotalSupply      sdk.Coins
-	TotalStaked      sdk.Coins
+	Height             int64
+	Timestamp          time.Time
+	TotalSupply        sdk.Coins
+	TotalStaked        sdk.Coins
 	RewardsDistributed sdk.Coins
-	EffectiveAPR     math.LegacyDec
-	NumDelegators    int64
+	EffectiveAPR       math.LegacyDec
+	NumDelegators      int64
+	Mode               types.StakingRewardMode
+	// Minted is the part of RewardsDistributed minted by the reward engine
+	Minted sdk.Coins
+}
+
+// stakingTotals returns the reward denom supply and the bonded tokens.
+func (k Keeper) stakingTotals(ctx context.Context, params types.StakingRewardParams) (supply, bonded math.Int, err error) {
+	supply = k.bankKeeper.GetSupply(ctx, params.RewardDenom).Amount
+	bonded, err = k.stakingKeeper.TotalBondedTokens(ctx)
+	if err != nil {
+		return math.Int{}, math.Int{}, err
+	}
+	return supply, bonded, nil
 }
 
-// CalculateEffectiveAPR calculates the effective APR based on total supply and staked amount
+// CalculateEffectiveAPR returns the APR stakers earn under the staking reward
+// params, or zero while the engine is disabled.
 func (k Keeper) CalculateEffectiveAPR(ctx sdk.Context) (math.LegacyDec, error) {
-	// Skip if staking keeper not set
 	if k.stakingKeeper == nil {
 		return math.LegacyZeroDec(), nil
 	}
-	
-	// Get total supply of ALC
-	totalSupply := k.bankKeeper.GetSupply(ctx, "alc")
-	if totalSupply.IsZero() {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+	if !params.StakingRewards.Enabled {
 		return math.LegacyZeroDec(), nil
 	}
 
-	// Get total staked amount
-	stakingKeeper := k.stakingKeeper
-	bondDenom, err := stakingKeeper.BondDenom(ctx)
+	supply, bonded, err := k.stakingTotals(ctx, params.StakingRewards)
 	if err != nil {
 		return math.LegacyZeroDec(), err
 	}
-	totalStaked, err := stakingKeeper.TotalBondedTokens(ctx)
+	return params.StakingRewards.EffectiveAPR(supply, bonded), nil
+}
+
+// TrackMintModuleRewards adds what x/mint minted in this block's BeginBlock to
+// the amount minted since the last distribution. x/mint runs before this
+// module in BeginBlock and its mint event is visible in the shared event
+// manager.
+func (k Keeper) TrackMintModuleRewards(ctx sdk.Context) error {
+	params, err := k.Params.Get(ctx)
 	if err != nil {
-		return math.LegacyZeroDec(), err
+		return err
 	}
-
-	if totalStaked.IsZero() {
-		// If nothing is staked, return 0 (rewards won't be distributed)
-		return math.LegacyZeroDec(), nil
+	if !params.StakingRewards.Enabled {
+		return nil
 	}
 
-	// Convert bond denom (ulc) to display denom (alc) if needed
-	// 1 alc = 1,000,000 ulc
-	if bondDenom == "ulc" {
-		totalStaked = totalStaked.Quo(math.NewInt(1000000))
+	minted := math.ZeroInt()
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != minttypes.EventTypeMint {
+			continue
+		}
+		attr, ok := event.GetAttribute(sdk.AttributeKeyAmount)
+		if !ok {
+			continue
+		}
+		amount, ok := math.NewIntFromString(attr.Value)
+		if ok {
+			minted = minted.Add(amount)
+		}
 	}
-
-	// Calculate effective APR
-	// Effective APR = (Total Supply × 20%) / Total Staked
-	annualRate, _ := math.LegacyNewDecFromStr(AnnualRewardRate)
-	totalSupplyDec := math.LegacyNewDecFromInt(totalSupply.Amount)
-	totalStakedDec := math.LegacyNewDecFromInt(totalStaked)
-
-	effectiveAPR := totalSupplyDec.Mul(annualRate).Quo(totalStakedDec)
-
-	return effectiveAPR, nil
-}
-
-// CalculateHourlyRewards calculates rewards to distribute this hour
-func (k Keeper) CalculateHourlyRewards(ctx sdk.Context) (sdk.Coins, error) {
-	// Get total supply of ALC
-	totalSupply := k.bankKeeper.GetSupply(ctx, "alc")
-	if totalSupply.IsZero() {
-		return sdk.NewCoins(), nil
+	if minted.IsZero() || k.stakingKeeper == nil {
+		return nil
 	}
 
-	// Calculate annual rewards (20% of total supply)
-	annualRate, _ := math.LegacyNewDecFromStr(AnnualRewardRate)
-	annualRewards := math.LegacyNewDecFromInt(totalSupply.Amount).Mul(annualRate).TruncateInt()
-
-	// Calculate hourly rewards
-	hoursPerYear := math.LegacyNewDec(BlocksPerYear).Quo(math.LegacyNewDec(BlocksPerHour))
-	hourlyRewards := math.LegacyNewDecFromInt(annualRewards).Quo(hoursPerYear).TruncateInt()
+	// x/mint only mints the bond denom
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+	if bondDenom != params.StakingRewards.RewardDenom {
+		return nil
+	}
 
-	return sdk.NewCoins(sdk.NewCoin("alc", hourlyRewards)), nil
+	total, err := k.MintModuleRewards.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if total.IsNil() {
+		total = math.ZeroInt()
+	}
+	return k.MintModuleRewards.Set(ctx, total.Add(minted))
 }
 
-// DistributeStakingRewards distributes rewards to all stakers
+// DistributeStakingRewards pays the interval's staking rewards every
+// interval_blocks. x/mint inflation minted over the interval counts towards
+// the target and only the shortfall is minted, then sent to the fee collector
+// for x/distribution to pay out to stakers.
 func (k Keeper) DistributeStakingRewards(ctx sdk.Context) error {
-	// Skip if staking keeper not set
 	if k.stakingKeeper == nil {
 		return nil
 	}
-	
-	// Check if it's time to distribute (every hour)
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	sr := params.StakingRewards
 	height := ctx.BlockHeight()
-	if height%BlocksPerHour != 0 {
+	if !sr.Enabled || sr.IntervalBlocks == 0 || height%int64(sr.IntervalBlocks) != 0 {
 		return nil
 	}
 
-	// Calculate hourly rewards
-	rewards, err := k.CalculateHourlyRewards(ctx)
-	if err != nil {
+	mintModule, err := k.MintModuleRewards.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
 		return err
 	}
-
-	if rewards.IsZero() {
-		return nil
+	if mintModule.IsNil() {
+		mintModule = math.ZeroInt()
+	}
+	// Every interval starts counting x/mint afresh
+	if err := k.MintModuleRewards.Set(ctx, math.ZeroInt()); err != nil {
+		return err
 	}
 
-	// Get staking info for recording
-	stakingKeeper := k.stakingKeeper
-	totalStaked, err := stakingKeeper.TotalBondedTokens(ctx)
+	supply, bonded, err := k.stakingTotals(ctx, sr)
 	if err != nil {
 		return err
 	}
-	validators, err := stakingKeeper.GetAllValidators(ctx)
+	if !bonded.IsPositive() {
+		// Nobody to pay
+		return nil
+	}
+
+	target := sr.IntervalRewards(supply, bonded)
+	topUp := math.ZeroInt()
+	if target.GT(mintModule) {
+		topUp = target.Sub(mintModule)
+	}
+	minted := sdk.NewCoins(sdk.NewCoin(sr.RewardDenom, topUp))
+	if !minted.IsZero() {
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, minted); err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, minted); err != nil {
+			return err
+		}
+	}
+
+	// Count delegators
+	validators, err := k.stakingKeeper.GetAllValidators(ctx)
 	if err != nil {
 		return err
 	}
-	
-	// Count delegators
-	delegatorCount := int64(0)
 	delegatorMap := make(map[string]bool)
 	for _, val := range validators {
 		operAddr, _ := sdk.ValAddressFromBech32(val.GetOperator())
-		delegations, err := stakingKeeper.GetValidatorDelegations(ctx, operAddr)
+		delegations, err := k.stakingKeeper.GetValidatorDelegations(ctx, operAddr)
 		if err != nil {
 			continue
 		}
@@ -142,45 +182,38 @@ func (k Keeper) DistributeStakingRewards(ctx sdk.Context) error {
 			delegatorMap[del.DelegatorAddress] = true
 		}
 	}
-	delegatorCount = int64(len(delegatorMap))
+	delegatorCount := int64(len(delegatorMap))
 
-	// Mint the rewards
-	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, rewards)
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
 	if err != nil {
 		return err
 	}
+	distributed := sdk.NewCoins(sdk.NewCoin(sr.RewardDenom, mintModule.Add(topUp)))
+	effectiveAPR := sr.EffectiveAPR(supply, bonded)
 
-	// Send to distribution module for distribution to stakers
-	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, "distribution", rewards)
-	if err != nil {
-		return err
-	}
-
-	// Calculate effective APR for recording
-	effectiveAPR, _ := k.CalculateEffectiveAPR(ctx)
-
-	// Record the distribution
-	distribution := StakingRewardDistribution{
+	k.RecordStakingDistribution(ctx, StakingRewardDistribution{
 		Height:             height,
 		Timestamp:          ctx.BlockTime(),
-		TotalSupply:        sdk.NewCoins(k.bankKeeper.GetSupply(ctx, "alc")),
-		TotalStaked:        sdk.NewCoins(sdk.NewCoin("ulc", totalStaked)),
-		RewardsDistributed: rewards,
+		TotalSupply:        sdk.NewCoins(k.bankKeeper.GetSupply(ctx, sr.RewardDenom)),
+		TotalStaked:        sdk.NewCoins(sdk.NewCoin(bondDenom, bonded)),
+		RewardsDistributed: distributed,
 		EffectiveAPR:       effectiveAPR,
 		NumDelegators:      delegatorCount,
-	}
+		Mode:               sr.Mode,
+		Minted:             minted,
+	})
 
-	// Store distribution record
-	k.RecordStakingDistribution(ctx, distribution)
-
-	// Emit event
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
-			"staking_rewards_distributed",
-			sdk.NewAttribute("height", fmt.Sprintf("%d", height)),
-			sdk.NewAttribute("rewards", rewards.String()),
-			sdk.NewAttribute("effective_apr", effectiveAPR.String()),
-			sdk.NewAttribute("delegators", fmt.Sprintf("%d", delegatorCount)),
+			types.EventTypeStakingRewardsDistributed,
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", height)),
+			sdk.NewAttribute(types.AttributeKeyMode, sr.Mode.String()),
+			sdk.NewAttribute(types.AttributeKeyRewards, distributed.String()),
+			sdk.NewAttribute(types.AttributeKeyTarget, target.String()),
+			sdk.NewAttribute(types.AttributeKeyMinted, minted.String()),
+			sdk.NewAttribute(types.AttributeKeyMintModuleRewards, mintModule.String()),
+			sdk.NewAttribute(types.AttributeKeyEffectiveAPR, effectiveAPR.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegators, fmt.Sprintf("%d", delegatorCount)),
 		),
 	)
 
@@ -200,6 +233,8 @@ func (k Keeper) RecordStakingDistribution(ctx sdk.Context, distribution StakingR
 		RewardsDistributed: distribution.RewardsDistributed.String(),
 		EffectiveApr:       distribution.EffectiveAPR.String(),
 		NumDelegators:      distribution.NumDelegators,
+		Mode:               distribution.Mode,
+		Minted:             distribution.Minted.String(),
 	}
 	
 	bz, err := json.Marshal(record)
@@ -210,28 +245,64 @@ func (k Keeper) RecordStakingDistribution(ctx sdk.Context, distribution StakingR
 	store.Set(key, bz)
 }
 
-// GetStakingDistributionHistory returns distribution history with pagination
-func (k Keeper) GetStakingDistributionHistory(ctx sdk.Context, limit, offset uint64) ([]*types.StakingDistributionRecord, error) {
+// setStakingDistributionRecord stores an already built distribution record.
+func (k Keeper) setStakingDistributionRecord(ctx context.Context, record types.StakingDistributionRecord) error {
+	bz, err := json.Marshal(&record)
+	if err != nil {
+		return err
+	}
+	return k.storeService.OpenKVStore(ctx).Set(types.GetStakingDistributionKey(record.Height), bz)
+}
+
+// GetAllStakingDistributions returns every stored distribution record,
+// oldest first.
+func (k Keeper) GetAllStakingDistributions(ctx context.Context) ([]types.StakingDistributionRecord, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.StakingDistributionPrefix, storetypes.PrefixEndBytes(types.StakingDistributionPrefix))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var records []types.StakingDistributionRecord
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.StakingDistributionRecord
+		if err := json.Unmarshal(iterator.Value(), &record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// GetStakingDistributionHistory returns distribution history, newest first,
+// with pagination. If beforeHeight is positive only distributions recorded
+// below it are returned.
+func (k Keeper) GetStakingDistributionHistory(ctx sdk.Context, limit, offset uint64, beforeHeight int64) ([]*types.StakingDistributionRecord, error) {
 	store := k.storeService.OpenKVStore(ctx)
 	prefix := types.StakingDistributionPrefix
-	
+	end := storetypes.PrefixEndBytes(prefix)
+	if beforeHeight > 0 {
+		end = types.GetStakingDistributionKey(beforeHeight)
+	}
+
 	var records []*types.StakingDistributionRecord
 	count := uint64(0)
 	skipped := uint64(0)
-	
+
 	// Create an iterator
-	iterator, err := store.ReverseIterator(prefix, nil)
+	iterator, err := store.ReverseIterator(prefix, end)
 	if err != nil {
 		return nil, err
 	}
 	defer iterator.Close()
-	
+
 	for ; iterator.Valid() && count < limit; iterator.Next() {
 		if skipped < offset {
 			skipped++
 			continue
 		}
-		
+
 		var record types.StakingDistributionRecord
 		err := json.Unmarshal(iterator.Value(), &record)
 		if err != nil {
@@ -240,13 +311,13 @@ func (k Keeper) GetStakingDistributionHistory(ctx sdk.Context, limit, offset uin
 		records = append(records, &record)
 		count++
 	}
-	
+
 	return records, nil
 }
 
 // GetLatestDistribution returns the most recent distribution record
 func (k Keeper) GetLatestDistribution(ctx sdk.Context) (*types.StakingDistributionRecord, error) {
-	records, err := k.GetStakingDistributionHistory(ctx, 1, 0)
+	records, err := k.GetStakingDistributionHistory(ctx, 1, 0, 0)
 	if err != nil || len(records) == 0 {
 		return nil, err
 	}
@@ -0,0 +1,244 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"mychain/x/mychain/keeper"
+	module "mychain/x/mychain/module"
+	"mychain/x/mychain/types"
+)
+
+type fakeBankKeeper struct {
+	supply   sdk.Coins
+	modules  map[string]sdk.Coins
+	metadata map[string]banktypes.Metadata
+}
+
+func (b *fakeBankKeeper) SpendableCoins(context.Context, sdk.AccAddress) sdk.Coins {
+	return sdk.NewCoins()
+}
+
+func (b *fakeBankKeeper) GetSupply(_ context.Context, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, b.supply.AmountOf(denom))
+}
+
+func (b *fakeBankKeeper) GetDenomMetaData(_ context.Context, denom string) (banktypes.Metadata, bool) {
+	md, ok := b.metadata[denom]
+	return md, ok
+}
+
+func (b *fakeBankKeeper) MintCoins(_ context.Context, moduleName string, amounts sdk.Coins) error {
+	b.supply = b.supply.Add(amounts...)
+	b.modules[moduleName] = b.modules[moduleName].Add(amounts...)
+	return nil
+}
+
+func (b *fakeBankKeeper) SendCoinsFromModuleToModule(_ context.Context, sender, recipient string, amt sdk.Coins) error {
+	b.modules[sender] = b.modules[sender].Sub(amt...)
+	b.modules[recipient] = b.modules[recipient].Add(amt...)
+	return nil
+}
+
+type fakeStakingKeeper struct {
+	bonded math.Int
+}
+
+func (s fakeStakingKeeper) BondDenom(context.Context) (string, error) {
+	return "ulc", nil
+}
+
+func (s fakeStakingKeeper) TotalBondedTokens(context.Context) (math.Int, error) {
+	return s.bonded, nil
+}
+
+func (s fakeStakingKeeper) GetAllValidators(context.Context) ([]stakingtypes.Validator, error) {
+	return nil, nil
+}
+
+func (s fakeStakingKeeper) GetValidatorDelegations(context.Context, sdk.ValAddress) ([]stakingtypes.Delegation, error) {
+	return nil, nil
+}
+
+// initRewardFixture returns a keeper with 1000 LC supplied, 500 LC bonded and
+// the reward engine enabled in the given mode.
+func initRewardFixture(t *testing.T, mode types.StakingRewardMode, rate math.LegacyDec) (sdk.Context, keeper.Keeper, *fakeBankKeeper) {
+	t.Helper()
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModule{})
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	bank := &fakeBankKeeper{
+		supply:  sdk.NewCoins(sdk.NewInt64Coin("ulc", 1_000_000_000)),
+		modules: make(map[string]sdk.Coins),
+	}
+	k := keeper.NewKeeper(
+		runtime.NewKVStoreService(storeKey),
+		encCfg.Codec,
+		addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+		authtypes.NewModuleAddress(types.GovModuleName),
+		fakeStakingKeeper{bonded: math.NewInt(500_000_000)},
+		bank,
+	)
+
+	params := types.DefaultParams()
+	params.StakingRewards.Enabled = true
+	params.StakingRewards.Mode = mode
+	params.StakingRewards.Rate = rate
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	return ctx, k, bank
+}
+
+func emitMint(ctx sdk.Context, amount int64) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(minttypes.EventTypeMint,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, math.NewInt(amount).String())))
+}
+
+func TestDistributeStakingRewardsSupplyPercentage(t *testing.T) {
+	ctx, k, bank := initRewardFixture(t, types.STAKING_REWARD_MODE_SUPPLY_PERCENTAGE, math.LegacyNewDecWithPrec(20, 2))
+
+	// x/mint inflation counts towards the target
+	mintCtx := ctx.WithEventManager(sdk.NewEventManager())
+	emitMint(mintCtx, 10_000)
+	require.NoError(t, k.TrackMintModuleRewards(mintCtx))
+
+	// Nothing happens between distribution heights
+	require.NoError(t, k.DistributeStakingRewards(ctx.WithBlockHeight(719)))
+	require.Empty(t, bank.modules[authtypes.FeeCollectorName])
+
+	// 20% of 1000 LC a year over 720 of 6311520 blocks is 22815ulc
+	ctx = ctx.WithBlockHeight(720).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.DistributeStakingRewards(ctx))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ulc", 12_815)), bank.modules[authtypes.FeeCollectorName])
+	require.True(t, bank.modules[types.ModuleName].IsZero())
+
+	record, err := k.GetLatestDistribution(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(720), record.Height)
+	require.Equal(t, types.STAKING_REWARD_MODE_SUPPLY_PERCENTAGE, record.Mode)
+	require.Equal(t, "22815ulc", record.RewardsDistributed)
+	require.Equal(t, "12815ulc", record.Minted)
+	// Half the supply is bonded, so stakers earn twice the supply rate
+	require.Equal(t, math.LegacyNewDecWithPrec(40, 2).String(), record.EffectiveApr)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeStakingRewardsDistributed, events[0].Type)
+
+	// The x/mint total restarts every interval
+	mintModule, err := k.MintModuleRewards.Get(ctx)
+	require.NoError(t, err)
+	require.True(t, mintModule.IsZero())
+}
+
+func TestDistributeStakingRewardsTargetAPR(t *testing.T) {
+	ctx, k, bank := initRewardFixture(t, types.STAKING_REWARD_MODE_TARGET_APR, math.LegacyNewDecWithPrec(10, 2))
+
+	// 10% of the 500 LC bonded a year over 720 of 6311520 blocks is 5703ulc
+	require.NoError(t, k.DistributeStakingRewards(ctx.WithBlockHeight(1440)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ulc", 5_703)), bank.modules[authtypes.FeeCollectorName])
+
+	apr, err := k.CalculateEffectiveAPR(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(10, 2), apr)
+
+	// x/mint minting more than the target leaves nothing to top up
+	mintCtx := ctx.WithEventManager(sdk.NewEventManager())
+	emitMint(mintCtx, 100_000)
+	require.NoError(t, k.TrackMintModuleRewards(mintCtx))
+	require.NoError(t, k.DistributeStakingRewards(ctx.WithBlockHeight(2160)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ulc", 5_703)), bank.modules[authtypes.FeeCollectorName])
+
+	record, err := k.GetLatestDistribution(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(2160), record.Height)
+	require.Equal(t, "100000ulc", record.RewardsDistributed)
+	require.Empty(t, record.Minted)
+}
+
+func TestDistributeStakingRewardsDisabled(t *testing.T) {
+	ctx, k, bank := initRewardFixture(t, types.STAKING_REWARD_MODE_SUPPLY_PERCENTAGE, math.LegacyNewDecWithPrec(20, 2))
+
+	params, err := k.Params.Get(ctx)
+	require.NoError(t, err)
+	params.StakingRewards.Enabled = false
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	mintCtx := ctx.WithEventManager(sdk.NewEventManager())
+	emitMint(mintCtx, 10_000)
+	require.NoError(t, k.TrackMintModuleRewards(mintCtx))
+	require.NoError(t, k.DistributeStakingRewards(ctx.WithBlockHeight(720)))
+	require.Empty(t, bank.modules)
+
+	record, err := k.GetLatestDistribution(ctx)
+	require.NoError(t, err)
+	require.Nil(t, record)
+
+	res, err := k.StakingInfo(ctx, &types.QueryStakingInfoRequest{})
+	require.NoError(t, err)
+	require.False(t, res.Info.Enabled)
+	require.Equal(t, int64(0), res.Info.NextDistributionHeight)
+	require.Equal(t, "0ulc", res.Info.IntervalRewards)
+}
+
+func TestMintModuleRewardsSurviveExport(t *testing.T) {
+	ctx, k, _ := initRewardFixture(t, types.STAKING_REWARD_MODE_SUPPLY_PERCENTAGE, math.LegacyNewDecWithPrec(20, 2))
+
+	// x/mint mints halfway through the interval, then the chain is exported
+	mintCtx := ctx.WithBlockHeight(360).WithEventManager(sdk.NewEventManager())
+	emitMint(mintCtx, 10_000)
+	require.NoError(t, k.TrackMintModuleRewards(mintCtx))
+
+	exported, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.NoError(t, exported.Validate())
+	require.Equal(t, int64(10_000), exported.MintModuleRewards.Int64())
+
+	// The imported chain only tops up the rest of the 22815ulc target
+	ctx, k, bank := initRewardFixture(t, types.STAKING_REWARD_MODE_SUPPLY_PERCENTAGE, math.LegacyNewDecWithPrec(20, 2))
+	require.NoError(t, k.InitGenesis(ctx, *exported))
+	require.NoError(t, k.DistributeStakingRewards(ctx.WithBlockHeight(720)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ulc", 12_815)), bank.modules[authtypes.FeeCollectorName])
+}
+
+func TestStakingDistributionHistoryPaging(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	for _, height := range []int64{720, 1440, 2160} {
+		f.keeper.RecordStakingDistribution(ctx, keeper.StakingRewardDistribution{Height: height})
+	}
+	// a key of another prefix sorts after every distribution
+	require.NoError(t, f.keeper.MintModuleRewards.Set(ctx, math.NewInt(5)))
+
+	heights := func(req *types.QueryStakingDistributionHistoryRequest) []int64 {
+		res, err := f.keeper.StakingDistributionHistory(ctx, req)
+		require.NoError(t, err)
+		var hs []int64
+		for _, record := range res.Distributions {
+			hs = append(hs, record.Height)
+		}
+		return hs
+	}
+
+	require.Equal(t, []int64{1440, 720}, heights(&types.QueryStakingDistributionHistoryRequest{Offset: 1}))
+	require.Equal(t, []int64{2160, 1440}, heights(&types.QueryStakingDistributionHistoryRequest{Limit: 2}))
+
+	// a distribution recorded between two pages does not shift the next one
+	f.keeper.RecordStakingDistribution(ctx, keeper.StakingRewardDistribution{Height: 2880})
+	require.Equal(t, []int64{720}, heights(&types.QueryStakingDistributionHistoryRequest{Limit: 2, BeforeHeight: 1440}))
+}
@@ -1,149 +1,168 @@
 package keeper
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
-	
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"mychain/x/mychain/types"
 )
 
-// TransactionHistory represents a simple transaction record
-type TransactionHistory struct {
-	TxHash      string    `json:"tx_hash"`
-	Type        string    `json:"type"`
-	Description string    `json:"description"`
-	Amount      sdk.Coins `json:"amount"`
-	From        string    `json:"from"`
-	To          string    `json:"to"`
-	Height      int64     `json:"height"`
-	Timestamp   string    `json:"timestamp"`
+// TxHistoryKeyPrefix returns the store prefix of an address' transaction history.
+func TxHistoryKeyPrefix(address string) []byte {
+	return []byte(fmt.Sprintf("tx-history/%s/", address))
 }
 
-// SaveTransactionHistory saves a transaction history record
-func (k Keeper) SaveTransactionHistory(ctx sdk.Context, address string, tx TransactionHistory) error {
-	kvStore := k.storeService.OpenKVStore(ctx)
-	
-	// Create key: tx-history/address/height/txhash
-	// Pad height to 20 digits to ensure proper ordering
-	key := []byte(fmt.Sprintf("tx-history/%s/%020d/%s", address, tx.Height, tx.TxHash))
-	
-	// Log the key being saved
-	ctx.Logger().Debug("Saving transaction with key", "key", string(key))
-	
-	bz, err := json.Marshal(tx)
+// TxHistoryKey returns the store key of a transaction history record:
+// tx-history/<address>/<height>/<sequence>, padded so keys sort by height
+// and then by write order.
+func TxHistoryKey(address string, height int64, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("tx-history/%s/%020d/%020d", address, height, sequence))
+}
+
+// SaveTransactionHistory saves a transaction history record. The tx hash,
+// height, timestamp and message index are taken from the context. The record
+// is always emitted as a transaction_record event so off-chain indexers see
+// it; it is only written to state while on-chain history is enabled in params.
+func (k Keeper) SaveTransactionHistory(ctx sdk.Context, record types.TransactionRecord) error {
+	if record.Address == "" {
+		return fmt.Errorf("transaction record has no address")
+	}
+
+	record.TxHash = TxHash(ctx)
+	record.Height = ctx.BlockHeight()
+	record.Timestamp = ctx.BlockTime().UTC().Format(types.TxHistoryTimeFormat)
+	record.MsgIndex = MsgIndex(ctx)
+
+	recordJSON, err := k.cdc.MarshalJSON(&record)
 	if err != nil {
 		return err
 	}
-	
-	return kvStore.Set(key, bz)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransactionRecord,
+			sdk.NewAttribute(types.AttributeKeyAddress, record.Address),
+			sdk.NewAttribute(types.AttributeKeyType, record.Type),
+			sdk.NewAttribute(types.AttributeKeyDescription, record.Description),
+			sdk.NewAttribute(types.AttributeKeyAmount, record.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyFrom, record.From),
+			sdk.NewAttribute(types.AttributeKeyTo, record.To),
+			sdk.NewAttribute(types.AttributeKeyTxHash, record.TxHash),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", record.Height)),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, record.Timestamp),
+			sdk.NewAttribute(types.AttributeKeyRecord, string(recordJSON)),
+		),
+	)
+
+	if !k.OnChainTxHistoryEnabled(ctx) {
+		return nil
+	}
+
+	return k.setTransactionHistory(ctx, record)
 }
 
-// GetTransactionHistory retrieves transaction history for an address
-func (k Keeper) GetTransactionHistory(ctx sdk.Context, address string, limit uint64) ([]TransactionHistory, error) {
+// setTransactionHistory assigns the next sequence to record and writes it to state.
+func (k Keeper) setTransactionHistory(ctx context.Context, record types.TransactionRecord) error {
+	seq, err := k.TxHistorySeq.Next(ctx)
+	if err != nil {
+		return err
+	}
+	record.Sequence = seq
+
+	return k.writeTransactionHistory(ctx, record)
+}
+
+// writeTransactionHistory stores record under its own sequence and updates
+// the retention indexes.
+func (k Keeper) writeTransactionHistory(ctx context.Context, record types.TransactionRecord) error {
+	bz, err := k.cdc.Marshal(&record)
+	if err != nil {
+		return err
+	}
+
 	kvStore := k.storeService.OpenKVStore(ctx)
-	
-	var txs []TransactionHistory
-	
-	// Create prefix for address
-	prefix := []byte(fmt.Sprintf("tx-history/%s/", address))
-	
-	// Debug: Log what we're searching for
-	ctx.Logger().Debug("Searching for transactions", "prefix", string(prefix), "address", address)
-	
-	// Use ReverseIterator to get newest transactions first
-	// The issue was that ReverseIterator(prefix, nil) was returning ALL transactions
-	// We need to properly bound the iteration to our prefix
-	
-	// Create a proper end bound for the prefix
-	prefixEnd := append([]byte{}, prefix...)
-	// Increment the last character to create an exclusive upper bound
-	if len(prefixEnd) > 0 {
-		prefixEnd[len(prefixEnd)-1]++
+	if err := kvStore.Set(TxHistoryKey(record.Address, record.Height, record.Sequence), bz); err != nil {
+		return err
+	}
+	if err := k.TxHistoryByHeight.Set(ctx, collections.Join(record.Height, record.Sequence), record.Address); err != nil {
+		return err
 	}
-	
-	iterator, err := kvStore.ReverseIterator(prefix, prefixEnd)
+
+	count, err := k.TxHistoryCount.Get(ctx, record.Address)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	count++
+	if err := k.TxHistoryCount.Set(ctx, record.Address, count); err != nil {
+		return err
+	}
+
+	// Addresses over the cap are trimmed in EndBlock
+	params, err := k.Params.Get(ctx)
+	if err == nil && params.TxHistoryRetention.MaxRecordsPerAddress > 0 && count > params.TxHistoryRetention.MaxRecordsPerAddress {
+		return k.TxHistoryOverCap.Set(ctx, record.Address)
+	}
+	return nil
+}
+
+// OnChainTxHistoryEnabled reports whether transaction history is written to
+// consensus state. Missing params are treated as enabled.
+func (k Keeper) OnChainTxHistoryEnabled(ctx context.Context) bool {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return true
+	}
+	return !params.DisableOnchainTxHistory
+}
+
+// GetTransactionHistory returns up to limit of an address' transactions,
+// newest first. A limit of 0 returns all of them.
+func (k Keeper) GetTransactionHistory(ctx sdk.Context, address string, limit uint64) ([]types.TransactionRecord, error) {
+	kvStore := k.storeService.OpenKVStore(ctx)
+	prefix := TxHistoryKeyPrefix(address)
+
+	iterator, err := kvStore.ReverseIterator(prefix, storetypes.PrefixEndBytes(prefix))
 	if err != nil {
 		return nil, err
 	}
 	defer iterator.Close()
-	
-	count := uint64(0)
-	keysFound := 0
-	for ; iterator.Valid() && (limit == 0 || count < limit); iterator.Next() {
-		key := iterator.Key()
-		
-		// Double-check that the key actually starts with our prefix
-		// This is the critical fix - ensure we only get transactions for this address
-		if !bytes.HasPrefix(key, prefix) {
-			ctx.Logger().Debug("Skipping key without prefix", "key", string(key), "prefix", string(prefix))
-			continue
-		}
-		
-		keysFound++
-		value := iterator.Value()
-		
-		// Debug: Log first few keys found
-		if keysFound <= 3 {
-			ctx.Logger().Debug("Found transaction key", "key", string(key))
-		}
-		
-		var tx TransactionHistory
-		if err := json.Unmarshal(value, &tx); err != nil {
-			ctx.Logger().Error("Failed to unmarshal transaction", "error", err, "key", string(key))
+
+	var txs []types.TransactionRecord
+	for ; iterator.Valid() && (limit == 0 || uint64(len(txs)) < limit); iterator.Next() {
+		var tx types.TransactionRecord
+		if err := k.cdc.Unmarshal(iterator.Value(), &tx); err != nil {
 			return nil, err
 		}
 		txs = append(txs, tx)
-		count++
 	}
-	
-	ctx.Logger().Debug("Transaction search complete", "found", len(txs), "keysScanned", keysFound)
-	
+
 	return txs, nil
 }
 
-// RecordTransaction implements the TransactionKeeper interface for MainCoin module
-func (k Keeper) RecordTransaction(ctx context.Context, address string, txType string, description string, amount sdk.Coins, from string, to string, metadata string) error {
+// PaginateTransactionHistory returns a page of an address' transaction
+// history between minHeight and maxHeight. Only records accepted by filter
+// count towards the page.
+func (k Keeper) PaginateTransactionHistory(ctx context.Context, address string, minHeight, maxHeight int64, filter func(types.TransactionRecord) bool, pageReq *query.PageRequest) ([]types.TransactionRecord, *query.PageResponse, error) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), TxHistoryKeyPrefix(address))
+	return types.PaginateTxHistory(store, minHeight, maxHeight, filter, pageReq)
+}
+
+// RecordTransaction implements the TransactionKeeper interface used by the
+// dex, maincoin and testusd modules.
+func (k Keeper) RecordTransaction(ctx context.Context, record types.TransactionRecord) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	
-	// Log the transaction recording attempt
-	sdkCtx.Logger().Info("Recording transaction", 
-		"address", address,
-		"type", txType,
-		"amount", amount.String(),
-		"height", sdkCtx.BlockHeight())
-	
-	txHash := ""
-	if txBytes := sdkCtx.TxBytes(); len(txBytes) > 0 {
-		txHash = fmt.Sprintf("%X", txBytes)
-	}
-	
-	// Use metadata as txHash if no transaction bytes available (e.g., during BeginBlock)
-	if txHash == "" && metadata != "" {
-		txHash = metadata
-	}
-	
-	tx := TransactionHistory{
-		TxHash:      txHash,
-		Type:        txType,
-		Description: description,
-		Amount:      amount,
-		From:        from,
-		To:          to,
-		Height:      sdkCtx.BlockHeight(),
-		Timestamp:   sdkCtx.BlockTime().Format("2006-01-02T15:04:05Z"),
-	}
-	
-	err := k.SaveTransactionHistory(sdkCtx, address, tx)
-	if err != nil {
-		sdkCtx.Logger().Error("Failed to save transaction history", "error", err)
-	} else {
-		sdkCtx.Logger().Info("Transaction history saved successfully", 
-			"address", address, 
-			"type", txType,
-			"height", tx.Height,
-			"tx_hash", tx.TxHash)
+
+	if err := k.SaveTransactionHistory(sdkCtx, record); err != nil {
+		sdkCtx.Logger().Error("Failed to save transaction history", "address", record.Address, "type", record.Type, "error", err)
+		return err
 	}
-	return err
-}
\ No newline at end of file
+	return nil
+}
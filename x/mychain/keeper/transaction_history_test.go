@@ -0,0 +1,204 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"mychain/x/mychain/keeper"
+	"mychain/x/mychain/types"
+)
+
+func TestRecordTransactionKeys(t *testing.T) {
+	f := initFixture(t)
+	txBytes := []byte("raw tx bytes")
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(7).WithTxBytes(txBytes)
+
+	// Two records for the same address in the same tx must not collide
+	coins := sdk.NewCoins(sdk.NewInt64Coin("ulc", 5))
+	require.NoError(t, f.keeper.RecordTransaction(ctx, types.TransactionRecord{
+		Address: "alice", Type: "dex_create_order", Description: "first", Amount: coins, From: "alice", To: "dex_orderbook",
+		Details: &types.TransactionRecord_DexOrder{DexOrder: &types.DexOrderDetails{OrderId: 9, Price: sdk.NewInt64Coin("utusd", 2)}},
+	}))
+	require.NoError(t, f.keeper.RecordTransaction(keeper.WithMsgIndex(ctx, 1), keeper.NewTransactionRecord("alice", "send", "second", coins, "alice", "carol")))
+
+	history, err := f.keeper.GetTransactionHistory(ctx, "alice", 0)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+
+	hash := fmt.Sprintf("%X", tmhash.Sum(txBytes))
+	require.Equal(t, "second", history[0].Description)
+	require.Equal(t, int64(1), history[0].MsgIndex)
+	require.Equal(t, "first", history[1].Description)
+	require.Equal(t, int64(-1), history[1].MsgIndex)
+	require.Equal(t, uint64(9), history[1].GetDexOrder().OrderId)
+	require.Equal(t, sdk.NewInt64Coin("utusd", 2), history[1].GetDexOrder().Price)
+	require.Nil(t, history[0].Details)
+	for _, tx := range history {
+		require.Equal(t, hash, tx.TxHash)
+	}
+	require.Greater(t, history[0].Sequence, history[1].Sequence)
+
+	// Single-message txs get index 0 from the ante handler's message count
+	require.Equal(t, int64(0), keeper.MsgIndex(keeper.WithTxMsgCount(ctx, 1)))
+}
+
+func TestRecordTransactionDisabled(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(3).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.Params.Set(ctx, types.NewParams(true, types.DefaultTxHistoryRetention(), types.DefaultStakingRewardParams())))
+
+	require.NoError(t, f.keeper.RecordTransaction(ctx, keeper.NewTransactionRecord("alice", "send", "", nil, "alice", "bob")))
+
+	history, err := f.keeper.GetTransactionHistory(ctx, "alice", 0)
+	require.NoError(t, err)
+	require.Empty(t, history)
+
+	// The record is still emitted for off-chain indexers
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeTransactionRecord, events[0].Type)
+}
+
+func TestMigrateTxHistory(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	kvStore := f.storeService.OpenKVStore(ctx)
+
+	// Version 1 records were JSON keyed by the raw tx hex
+	txBytes := []byte("legacy tx")
+	legacy := map[string]map[string]any{
+		fmt.Sprintf("tx-history/alice/%020d/%X", 5, txBytes): {
+			"tx_hash": fmt.Sprintf("%X", txBytes), "type": "dex_cancel_order", "height": 5,
+			"metadata": `{"order_id":3,"refund":"10ulc","fee":"7"}`,
+		},
+		fmt.Sprintf("tx-history/mint/%020d/MINT-6", 6): {"tx_hash": "MINT-6", "type": "mint_inflation", "height": 6},
+	}
+	for key, tx := range legacy {
+		bz, err := json.Marshal(tx)
+		require.NoError(t, err)
+		require.NoError(t, kvStore.Set([]byte(key), bz))
+	}
+
+	m := keeper.NewMigrator(&f.keeper)
+	require.NoError(t, m.Migrate1to2(ctx))
+	for key := range legacy {
+		has, err := kvStore.Has([]byte(key))
+		require.NoError(t, err)
+		require.False(t, has, key)
+	}
+	require.NoError(t, m.Migrate2to3(ctx))
+
+	alice, err := f.keeper.GetTransactionHistory(ctx, "alice", 0)
+	require.NoError(t, err)
+	require.Len(t, alice, 1)
+	require.Equal(t, "alice", alice[0].Address)
+	require.Equal(t, fmt.Sprintf("%X", tmhash.Sum(txBytes)), alice[0].TxHash)
+	require.Equal(t, int64(-1), alice[0].MsgIndex)
+	require.Equal(t, uint64(3), alice[0].GetDexOrder().OrderId)
+	require.Equal(t, sdk.NewInt64Coin("ulc", 7), alice[0].GetDexOrder().CancelFee)
+
+	mint, err := f.keeper.GetTransactionHistory(ctx, "mint", 0)
+	require.NoError(t, err)
+	require.Len(t, mint, 1)
+	require.Empty(t, mint[0].TxHash)
+	require.Equal(t, "MINT-6", mint[0].LegacyMetadata)
+	require.Nil(t, mint[0].Details)
+
+	// Version 4 indexes the records for retention
+	require.NoError(t, m.Migrate3to4(ctx))
+	count, err := f.keeper.TxHistoryCount.Get(ctx, "alice")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), count)
+	address, err := f.keeper.TxHistoryByHeight.Get(ctx, collections.Join(int64(6), mint[0].Sequence))
+	require.NoError(t, err)
+	require.Equal(t, "mint", address)
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultTxHistoryRetention(), params.TxHistoryRetention)
+}
+
+func TestTransactionHistoryQueryFilters(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	records := []struct {
+		txType string
+		amount sdk.Coins
+		to     string
+	}{
+		{"bridge_out", sdk.NewCoins(sdk.NewInt64Coin("utusd", 50)), "external_bridge"},
+		{"dex_trade_buy", sdk.NewCoins(sdk.NewInt64Coin("umc", 10)), "dex_orderbook"},
+		{"bridge_out", sdk.NewCoins(sdk.NewInt64Coin("utusd", 500)), "external_bridge"},
+		{"send", sdk.NewCoins(sdk.NewInt64Coin("utusd", 700)), "bob"},
+		{"dex_trade_buy", sdk.NewCoins(sdk.NewInt64Coin("umc", 20)), "dex_orderbook"},
+	}
+	for i, r := range records {
+		ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(int64(i + 1)).WithBlockTime(base.Add(time.Duration(i) * 24 * time.Hour))
+		require.NoError(t, f.keeper.RecordTransaction(ctx, keeper.NewTransactionRecord("alice", r.txType, "", r.amount, "alice", r.to)))
+	}
+
+	query := func(req *types.QueryTransactionHistoryRequest) []int64 {
+		req.Address = "alice"
+		res, err := qs.TransactionHistory(f.ctx, req)
+		require.NoError(t, err)
+		heights := make([]int64, len(res.Transactions))
+		for i, tx := range res.Transactions {
+			heights[i] = tx.Height
+		}
+		return heights
+	}
+
+	minAmount := math.NewInt(100)
+	start, end := base.Add(24*time.Hour), base.Add(3*24*time.Hour)
+
+	require.Equal(t, []int64{5, 4, 3, 2, 1}, query(&types.QueryTransactionHistoryRequest{}))
+	require.Equal(t, []int64{5, 2}, query(&types.QueryTransactionHistoryRequest{Types: []string{"dex_trade_buy"}}))
+	require.Equal(t, []int64{3}, query(&types.QueryTransactionHistoryRequest{Types: []string{"bridge_out"}, Denom: "utusd", MinAmount: &minAmount}))
+	require.Equal(t, []int64{4}, query(&types.QueryTransactionHistoryRequest{Counterparty: "bob"}))
+	require.Equal(t, []int64{4, 3}, query(&types.QueryTransactionHistoryRequest{MinHeight: 3, MaxHeight: 4}))
+	require.Equal(t, []int64{4, 3, 2}, query(&types.QueryTransactionHistoryRequest{StartTime: &start, EndTime: &end}))
+
+	// Oldest first with cursor pagination over a filtered set
+	req := &types.QueryTransactionHistoryRequest{Address: "alice", Denom: "utusd", Pagination: &sdkquery.PageRequest{Limit: 2}}
+	res, err := qs.TransactionHistory(f.ctx, req)
+	require.NoError(t, err)
+	require.Len(t, res.Transactions, 2)
+	require.Equal(t, int64(1), res.Transactions[0].Height)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	req.Pagination = &sdkquery.PageRequest{Key: res.Pagination.NextKey, Limit: 2}
+	res, err = qs.TransactionHistory(f.ctx, req)
+	require.NoError(t, err)
+	require.Len(t, res.Transactions, 1)
+	require.Equal(t, int64(4), res.Transactions[0].Height)
+	require.Nil(t, res.Pagination.NextKey)
+
+	// The default order is newest first, and its page key keeps that order when
+	// a client sends it back without the reverse flag
+	req = &types.QueryTransactionHistoryRequest{Address: "alice", Limit: 2}
+	res, err = qs.TransactionHistory(f.ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), res.Transactions[0].Height)
+	req.Pagination = &sdkquery.PageRequest{Key: res.Pagination.NextKey, Limit: 2}
+	res, err = qs.TransactionHistory(f.ctx, req)
+	require.NoError(t, err)
+	require.Len(t, res.Transactions, 2)
+	require.Equal(t, int64(3), res.Transactions[0].Height)
+	require.Equal(t, int64(2), res.Transactions[1].Height)
+
+	req.Pagination = &sdkquery.PageRequest{Key: []byte("not-a-page-key"), Limit: 2}
+	_, err = qs.TransactionHistory(f.ctx, req)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -2,9 +2,10 @@ package keeper
 
 import (
 	"fmt"
-	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"mychain/x/mychain/types"
 )
 
 // TransactionRecorder provides methods for recording different types of transactions
@@ -25,6 +26,7 @@ const (
 	TxTypeUndelegate      = "undelegate"
 	TxTypeRedelegate      = "redelegate"
 	TxTypeStakingReward   = "staking_reward"
+	TxTypeCommission      = "validator_commission"
 	TxTypeDexCreateOrder  = "dex_create_order"
 	TxTypeDexCancelOrder  = "dex_cancel_order"
 	TxTypeDexOrderFilled  = "dex_order_filled"
@@ -32,59 +34,36 @@ const (
 	TxTypeDexRewardDist   = "dex_reward_distribution"
 	TxTypeBridgeIn        = "bridge_in"
 	TxTypeBridgeOut       = "bridge_out"
+	TxTypeIBCTransferOut  = "ibc_transfer_out"
+	TxTypeIBCTransferIn   = "ibc_transfer_in"
+	TxTypeIBCRefund       = "ibc_transfer_refund"
+	TxTypeICASendTx       = "ica_send_tx"
+	TxTypeAuthzExec       = "authz_exec"
+	TxTypeGroupExec       = "group_exec"
 	TxTypeSwap            = "swap"
 	TxTypeFee             = "fee"
 )
 
-// TransactionRecord represents a transaction for history
-type TransactionRecord struct {
-	Address     string
-	Type        string
-	Description string
-	Amount      sdk.Coins
-	From        string
-	To          string
-	TxHash      string
-	Height      int64
-	Timestamp   time.Time
-}
-
-// NewTransactionRecord creates a new transaction record
-func NewTransactionRecord(address, txType, description string, amount sdk.Coins, from, to, txHash string, height int64, timestamp time.Time) TransactionRecord {
-	return TransactionRecord{
+// NewTransactionRecord creates a new transaction record for address. The tx
+// hash, height and timestamp are filled in when the record is saved.
+func NewTransactionRecord(address, txType, description string, amount sdk.Coins, from, to string) types.TransactionRecord {
+	return types.TransactionRecord{
 		Address:     address,
 		Type:        txType,
 		Description: description,
 		Amount:      amount,
 		From:        from,
 		To:          to,
-		TxHash:      txHash,
-		Height:      height,
-		Timestamp:   timestamp,
 	}
 }
 
 // RecordTransaction records a generic transaction
-func (tr *TransactionRecorder) RecordTransaction(ctx sdk.Context, record TransactionRecord) error {
-	history := TransactionHistory{
-		TxHash:      record.TxHash,
-		Type:        record.Type,
-		Description: record.Description,
-		Amount:      record.Amount,
-		From:        record.From,
-		To:          record.To,
-		Height:      record.Height,
-		Timestamp:   record.Timestamp.Format("2006-01-02T15:04:05Z"),
-	}
-	
-	return tr.keeper.SaveTransactionHistory(ctx, record.Address, history)
+func (tr *TransactionRecorder) RecordTransaction(ctx sdk.Context, record types.TransactionRecord) error {
+	return tr.keeper.SaveTransactionHistory(ctx, record)
 }
 
 // RecordBankTransfer records a bank transfer (send/receive)
-func (tr *TransactionRecorder) RecordBankTransfer(ctx sdk.Context, from, to string, amount sdk.Coins, txHash string) {
-	height := ctx.BlockHeight()
-	timestamp := ctx.BlockTime()
-	
+func (tr *TransactionRecorder) RecordBankTransfer(ctx sdk.Context, from, to string, amount sdk.Coins) {
 	// Record send transaction for sender
 	sendRecord := NewTransactionRecord(
 		from,
@@ -93,9 +72,6 @@ func (tr *TransactionRecorder) RecordBankTransfer(ctx sdk.Context, from, to stri
 		amount,
 		from,
 		to,
-		txHash,
-		height,
-		timestamp,
 	)
 	tr.RecordTransaction(ctx, sendRecord)
 	
@@ -107,18 +83,12 @@ func (tr *TransactionRecorder) RecordBankTransfer(ctx sdk.Context, from, to stri
 		amount,
 		from,
 		to,
-		txHash,
-		height,
-		timestamp,
 	)
 	tr.RecordTransaction(ctx, receiveRecord)
 }
 
 // RecordDexOrder records a DEX order creation
-func (tr *TransactionRecorder) RecordDexOrder(ctx sdk.Context, creator string, orderType string, sellCoin, buyCoin sdk.Coin, txHash string) error {
-	height := ctx.BlockHeight()
-	timestamp := ctx.BlockTime()
-	
+func (tr *TransactionRecorder) RecordDexOrder(ctx sdk.Context, creator string, orderType string, sellCoin, buyCoin sdk.Coin) error {
 	description := fmt.Sprintf("Created %s order: Selling %s for %s", orderType, sellCoin.String(), buyCoin.String())
 	
 	record := NewTransactionRecord(
@@ -128,19 +98,13 @@ func (tr *TransactionRecorder) RecordDexOrder(ctx sdk.Context, creator string, o
 		sdk.NewCoins(sellCoin),
 		creator,
 		"dex_orderbook",
-		txHash,
-		height,
-		timestamp,
 	)
 	
 	return tr.RecordTransaction(ctx, record)
 }
 
 // RecordDexOrderCancel records a DEX order cancellation
-func (tr *TransactionRecorder) RecordDexOrderCancel(ctx sdk.Context, creator string, orderId uint64, refundAmount sdk.Coins, txHash string) error {
-	height := ctx.BlockHeight()
-	timestamp := ctx.BlockTime()
-	
+func (tr *TransactionRecorder) RecordDexOrderCancel(ctx sdk.Context, creator string, orderId uint64, refundAmount sdk.Coins) error {
 	description := fmt.Sprintf("Cancelled order #%d, refunded %s", orderId, refundAmount.String())
 	
 	record := NewTransactionRecord(
@@ -150,19 +114,13 @@ func (tr *TransactionRecorder) RecordDexOrderCancel(ctx sdk.Context, creator str
 		refundAmount,
 		"dex_orderbook",
 		creator,
-		txHash,
-		height,
-		timestamp,
 	)
 	
 	return tr.RecordTransaction(ctx, record)
 }
 
 // RecordDexOrderFilled records when a DEX order is filled
-func (tr *TransactionRecorder) RecordDexOrderFilled(ctx sdk.Context, buyer, seller string, boughtCoin, soldCoin sdk.Coin, txHash string) error {
-	height := ctx.BlockHeight()
-	timestamp := ctx.BlockTime()
-	
+func (tr *TransactionRecorder) RecordDexOrderFilled(ctx sdk.Context, buyer, seller string, boughtCoin, soldCoin sdk.Coin) error {
 	// Record for buyer
 	buyRecord := NewTransactionRecord(
 		buyer,
@@ -171,9 +129,6 @@ func (tr *TransactionRecorder) RecordDexOrderFilled(ctx sdk.Context, buyer, sell
 		sdk.NewCoins(boughtCoin),
 		seller,
 		buyer,
-		txHash,
-		height,
-		timestamp,
 	)
 	if err := tr.RecordTransaction(ctx, buyRecord); err != nil {
 		return err
@@ -187,19 +142,13 @@ func (tr *TransactionRecorder) RecordDexOrderFilled(ctx sdk.Context, buyer, sell
 		sdk.NewCoins(boughtCoin),
 		seller,
 		buyer,
-		txHash,
-		height,
-		timestamp,
 	)
 	
 	return tr.RecordTransaction(ctx, sellRecord)
 }
 
 // RecordBridge records bridge in/out transactions
-func (tr *TransactionRecorder) RecordBridge(ctx sdk.Context, address string, amount sdk.Coin, bridgeType string, txHash string) error {
-	height := ctx.BlockHeight()
-	timestamp := ctx.BlockTime()
-	
+func (tr *TransactionRecorder) RecordBridge(ctx sdk.Context, address string, amount sdk.Coin, bridgeType string) error {
 	var description string
 	var from, to string
 	
@@ -220,19 +169,13 @@ func (tr *TransactionRecorder) RecordBridge(ctx sdk.Context, address string, amo
 		sdk.NewCoins(amount),
 		from,
 		to,
-		txHash,
-		height,
-		timestamp,
 	)
 	
 	return tr.RecordTransaction(ctx, record)
 }
 
 // RecordStakingReward records staking reward distribution
-func (tr *TransactionRecorder) RecordStakingReward(ctx sdk.Context, delegator string, rewards sdk.Coins, validator string, txHash string) error {
-	height := ctx.BlockHeight()
-	timestamp := ctx.BlockTime()
-	
+func (tr *TransactionRecorder) RecordStakingReward(ctx sdk.Context, delegator string, rewards sdk.Coins, validator string) error {
 	description := fmt.Sprintf("Claimed staking rewards from %s", shortenAddress(validator))
 	
 	record := NewTransactionRecord(
@@ -242,11 +185,10 @@ func (tr *TransactionRecorder) RecordStakingReward(ctx sdk.Context, delegator st
 		rewards,
 		validator,
 		delegator,
-		txHash,
-		height,
-		timestamp,
 	)
 	
+	record.Details = &types.TransactionRecord_StakingReward{StakingReward: &types.StakingRewardDetails{Validator: validator}}
+
 	return tr.RecordTransaction(ctx, record)
 }
 
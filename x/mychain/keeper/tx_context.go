@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"fmt"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type (
+	msgIndexKey   struct{}
+	txMsgCountKey struct{}
+)
+
+// TxHash returns the CometBFT hash of the tx being executed, or an empty
+// string outside of a tx.
+func TxHash(ctx sdk.Context) string {
+	txBytes := ctx.TxBytes()
+	if len(txBytes) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%X", cmttypes.Tx(txBytes).Hash())
+}
+
+// WithTxMsgCount records how many messages the current tx carries. The ante
+// handler sets it so records written by message handlers of single-message
+// txs get a message index.
+func WithTxMsgCount(ctx sdk.Context, count int) sdk.Context {
+	return ctx.WithValue(txMsgCountKey{}, count)
+}
+
+// WithMsgIndex records the index of the message records are written for.
+func WithMsgIndex(ctx sdk.Context, index int) sdk.Context {
+	return ctx.WithValue(msgIndexKey{}, int64(index))
+}
+
+// MsgIndex returns the message index set by WithMsgIndex. Without one it is 0
+// for single-message txs and -1 otherwise.
+func MsgIndex(ctx sdk.Context) int64 {
+	if index, ok := ctx.Value(msgIndexKey{}).(int64); ok {
+		return index
+	}
+	if count, ok := ctx.Value(txMsgCountKey{}).(int); ok && count == 1 {
+		return 0
+	}
+	return -1
+}
@@ -0,0 +1,269 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"mychain/x/mychain/types"
+)
+
+// PruneTxHistory removes transaction history and staking distribution records
+// that fall outside the retention params, at most PruneBudget per call. Every
+// pruned record is emitted as an archive event. Records past the max age are
+// pruned before records over the per-address cap.
+func (k Keeper) PruneTxHistory(ctx sdk.Context) error {
+	params, err := k.Params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		// Without params nothing is retained beyond what was written
+		return nil
+	} else if err != nil {
+		return err
+	}
+	retention := params.TxHistoryRetention
+	if !retention.Enabled() {
+		return nil
+	}
+	budget := retention.PruneBudget
+
+	if retention.MaxAgeBlocks > 0 && ctx.BlockHeight() > int64(retention.MaxAgeBlocks) {
+		cutoff := ctx.BlockHeight() - int64(retention.MaxAgeBlocks)
+
+		pruned, err := k.pruneTxHistoryBefore(ctx, cutoff, budget)
+		if err != nil {
+			return err
+		}
+		budget -= pruned
+
+		pruned, err = k.pruneStakingDistributionsBefore(ctx, cutoff, budget)
+		if err != nil {
+			return err
+		}
+		budget -= pruned
+	}
+
+	if retention.MaxRecordsPerAddress > 0 && budget > 0 {
+		return k.pruneOverCap(ctx, retention.MaxRecordsPerAddress, budget)
+	}
+	return nil
+}
+
+// pruneTxHistoryBefore prunes up to budget records written below height.
+func (k Keeper) pruneTxHistoryBefore(ctx sdk.Context, height int64, budget uint64) (uint64, error) {
+	if budget == 0 {
+		return 0, nil
+	}
+
+	rng := new(collections.Range[collections.Pair[int64, uint64]]).EndExclusive(collections.Join(height, uint64(0)))
+	iter, err := k.TxHistoryByHeight.Iterate(ctx, rng)
+	if err != nil {
+		return 0, err
+	}
+	var expired []collections.KeyValue[collections.Pair[int64, uint64], string]
+	for ; iter.Valid() && uint64(len(expired)) < budget; iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			iter.Close()
+			return 0, err
+		}
+		expired = append(expired, kv)
+	}
+	iter.Close()
+
+	for _, kv := range expired {
+		if err := k.pruneTransactionRecord(ctx, kv.Value, kv.Key.K1(), kv.Key.K2(), types.PruneReasonMaxAge); err != nil {
+			return 0, err
+		}
+	}
+	return uint64(len(expired)), nil
+}
+
+// pruneStakingDistributionsBefore prunes up to budget staking distribution
+// records written below height.
+func (k Keeper) pruneStakingDistributionsBefore(ctx sdk.Context, height int64, budget uint64) (uint64, error) {
+	if budget == 0 || height <= 0 {
+		return 0, nil
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.StakingDistributionPrefix, types.GetStakingDistributionKey(height))
+	if err != nil {
+		return 0, err
+	}
+	type entry struct{ key, value []byte }
+	var expired []entry
+	for ; iterator.Valid() && uint64(len(expired)) < budget; iterator.Next() {
+		expired = append(expired, entry{key: append([]byte{}, iterator.Key()...), value: append([]byte{}, iterator.Value()...)})
+	}
+	iterator.Close()
+
+	for _, e := range expired {
+		if err := store.Delete(e.key); err != nil {
+			return 0, err
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeStakingDistributionArchived,
+				sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", sdk.BigEndianToUint64(e.key[len(types.StakingDistributionPrefix):]))),
+				sdk.NewAttribute(types.AttributeKeyReason, types.PruneReasonMaxAge),
+				sdk.NewAttribute(types.AttributeKeyRecord, string(e.value)),
+			),
+		)
+	}
+	return uint64(len(expired)), nil
+}
+
+// pruneOverCap trims the oldest records of addresses holding more than
+// maxRecords, spending at most budget deletions.
+func (k Keeper) pruneOverCap(ctx sdk.Context, maxRecords, budget uint64) error {
+	// Read only the addresses the budget reaches, then prune them once the
+	// iterator is closed
+	type overCap struct {
+		address string
+		excess  uint64
+	}
+	var pending []overCap
+	iter, err := k.TxHistoryOverCap.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for planned := uint64(0); iter.Valid() && planned < budget; iter.Next() {
+		address, err := iter.Key()
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		count, err := k.TxHistoryCount.Get(ctx, address)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			iter.Close()
+			return err
+		}
+		excess := uint64(0)
+		if count > maxRecords {
+			excess = count - maxRecords
+		}
+		pending = append(pending, overCap{address: address, excess: excess})
+		planned += max(excess, 1)
+	}
+	iter.Close()
+
+	for _, entry := range pending {
+		n := min(entry.excess, budget)
+
+		oldest, err := k.oldestTransactionRecords(ctx, entry.address, n)
+		if err != nil {
+			return err
+		}
+		for _, record := range oldest {
+			if err := k.pruneTransactionRecord(ctx, entry.address, record.Height, record.Sequence, types.PruneReasonMaxRecords); err != nil {
+				return err
+			}
+		}
+		budget -= n
+
+		if n == entry.excess {
+			if err := k.TxHistoryOverCap.Remove(ctx, entry.address); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// oldestTransactionRecords returns up to limit of an address' oldest records.
+func (k Keeper) oldestTransactionRecords(ctx context.Context, address string, limit uint64) ([]types.TransactionRecord, error) {
+	if limit == 0 {
+		return nil, nil
+	}
+
+	prefix := TxHistoryKeyPrefix(address)
+	iterator, err := k.storeService.OpenKVStore(ctx).Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var records []types.TransactionRecord
+	for ; iterator.Valid() && uint64(len(records)) < limit; iterator.Next() {
+		var record types.TransactionRecord
+		if err := k.cdc.Unmarshal(iterator.Value(), &record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// pruneTransactionRecord deletes a record with its index entries and emits
+// it as a transaction_record_archived event.
+func (k Keeper) pruneTransactionRecord(ctx sdk.Context, address string, height int64, sequence uint64, reason string) error {
+	kvStore := k.storeService.OpenKVStore(ctx)
+	key := TxHistoryKey(address, height, sequence)
+
+	bz, err := kvStore.Get(key)
+	if err != nil {
+		return err
+	}
+	if err := k.TxHistoryByHeight.Remove(ctx, collections.Join(height, sequence)); err != nil {
+		return err
+	}
+	if bz == nil {
+		return nil
+	}
+
+	var record types.TransactionRecord
+	if err := k.cdc.Unmarshal(bz, &record); err != nil {
+		return err
+	}
+	recordJSON, err := k.cdc.MarshalJSON(&record)
+	if err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransactionRecordArchived,
+			sdk.NewAttribute(types.AttributeKeyAddress, address),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", height)),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", sequence)),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+			sdk.NewAttribute(types.AttributeKeyRecord, string(recordJSON)),
+		),
+	)
+	if err := kvStore.Delete(key); err != nil {
+		return err
+	}
+
+	count, err := k.TxHistoryCount.Get(ctx, address)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if count <= 1 {
+		return k.TxHistoryCount.Remove(ctx, address)
+	}
+	return k.TxHistoryCount.Set(ctx, address, count-1)
+}
+
+// GetAllTransactionHistory returns every stored transaction history record,
+// ordered by address and then by age.
+func (k Keeper) GetAllTransactionHistory(ctx context.Context) ([]types.TransactionRecord, error) {
+	prefix := []byte("tx-history/")
+	iterator, err := k.storeService.OpenKVStore(ctx).Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var records []types.TransactionRecord
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TransactionRecord
+		if err := k.cdc.Unmarshal(iterator.Value(), &record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
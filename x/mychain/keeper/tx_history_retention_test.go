@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"mychain/x/mychain/keeper"
+	"mychain/x/mychain/types"
+)
+
+func TestPruneTxHistory(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	require.NoError(t, f.keeper.Params.Set(ctx, types.NewParams(false, types.TxHistoryRetention{
+		MaxAgeBlocks:         10,
+		MaxRecordsPerAddress: 3,
+		PruneBudget:          2,
+	}, types.DefaultStakingRewardParams())))
+
+	record := func(height int64, address string) {
+		require.NoError(t, f.keeper.RecordTransaction(ctx.WithBlockHeight(height), keeper.NewTransactionRecord(address, "send", "", nil, address, "carol")))
+	}
+	heights := func(address string) []int64 {
+		history, err := f.keeper.GetTransactionHistory(ctx, address, 0)
+		require.NoError(t, err)
+		var hs []int64
+		for _, tx := range history {
+			hs = append(hs, tx.Height)
+		}
+		return hs
+	}
+	prune := func(height int64) sdk.Events {
+		pruneCtx := ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, f.keeper.PruneTxHistory(pruneCtx))
+		return pruneCtx.EventManager().Events()
+	}
+
+	record(1, "alice")
+	record(2, "alice")
+	record(3, "bob")
+	for h := int64(20); h < 25; h++ {
+		record(h, "carol")
+	}
+
+	// Aged records go first and the budget caps each block
+	events := prune(13)
+	require.Len(t, events, 2)
+	require.Equal(t, types.EventTypeTransactionRecordArchived, events[0].Type)
+	reason, _ := events[0].GetAttribute(types.AttributeKeyReason)
+	require.Equal(t, types.PruneReasonMaxAge, reason.Value)
+	require.Empty(t, heights("alice"))
+	require.Equal(t, []int64{3}, heights("bob"))
+
+	// Then carol is trimmed down to the cap, oldest first
+	events = prune(13)
+	require.Len(t, events, 2)
+	reason, _ = events[1].GetAttribute(types.AttributeKeyReason)
+	require.Equal(t, types.PruneReasonMaxRecords, reason.Value)
+	require.Equal(t, []int64{24, 23, 22}, heights("carol"))
+	require.Empty(t, prune(13))
+
+	// Bob's record expires a block later
+	require.Len(t, prune(14), 1)
+	require.Empty(t, heights("bob"))
+	require.Equal(t, []int64{24, 23, 22}, heights("carol"))
+
+	count, err := f.keeper.TxHistoryCount.Get(ctx, "carol")
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), count)
+	has, err := f.keeper.TxHistoryOverCap.Has(ctx, "carol")
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestPruneOverCapSpendsBudgetInAddressOrder(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	require.NoError(t, f.keeper.Params.Set(ctx, types.NewParams(false, types.TxHistoryRetention{
+		MaxRecordsPerAddress: 1,
+		PruneBudget:          3,
+	}, types.DefaultStakingRewardParams())))
+
+	// each address holds two records over the cap
+	addresses := []string{"dave", "erin", "frank"}
+	for _, address := range addresses {
+		for h := int64(1); h <= 3; h++ {
+			require.NoError(t, f.keeper.RecordTransaction(ctx.WithBlockHeight(h), keeper.NewTransactionRecord(address, "send", "", nil, address, "carol")))
+		}
+	}
+
+	require.NoError(t, f.keeper.PruneTxHistory(ctx.WithBlockHeight(4)))
+
+	for address, want := range map[string]struct {
+		count   uint64
+		overCap bool
+	}{
+		"dave":  {count: 1, overCap: false},
+		"erin":  {count: 2, overCap: true},
+		"frank": {count: 3, overCap: true},
+	} {
+		count, err := f.keeper.TxHistoryCount.Get(ctx, address)
+		require.NoError(t, err)
+		require.Equal(t, want.count, count, address)
+		has, err := f.keeper.TxHistoryOverCap.Has(ctx, address)
+		require.NoError(t, err)
+		require.Equal(t, want.overCap, has, address)
+	}
+
+	// the next block finishes erin and frank
+	require.NoError(t, f.keeper.PruneTxHistory(ctx.WithBlockHeight(5)))
+	for _, address := range addresses {
+		count, err := f.keeper.TxHistoryCount.Get(ctx, address)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), count, address)
+	}
+}
+
+func TestPruneStakingDistributions(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	require.NoError(t, f.keeper.Params.Set(ctx, types.NewParams(false, types.TxHistoryRetention{MaxAgeBlocks: 100, PruneBudget: 10}, types.DefaultStakingRewardParams())))
+
+	for _, height := range []int64{720, 1440} {
+		f.keeper.RecordStakingDistribution(ctx, keeper.StakingRewardDistribution{Height: height})
+	}
+
+	pruneCtx := ctx.WithBlockHeight(1000).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.PruneTxHistory(pruneCtx))
+
+	events := pruneCtx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeStakingDistributionArchived, events[0].Type)
+	height, _ := events[0].GetAttribute(types.AttributeKeyHeight)
+	require.Equal(t, "720", height.Value)
+
+	records, err := f.keeper.GetAllStakingDistributions(ctx)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, int64(1440), records[0].Height)
+}
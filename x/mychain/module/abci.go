@@ -7,24 +7,34 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	
 	"mychain/x/mychain/keeper"
+	"mychain/x/mychain/types"
 )
 
 // BeginBlock - handles beginning of block processing
 func BeginBlock(ctx sdk.Context, k *keeper.Keeper) error {
 	// Track SDK minting module inflation
 	// This runs AFTER the mint module has already minted new tokens
-	return k.RecordMintingIfOccurred(ctx)
+	if err := k.RecordMintingIfOccurred(ctx); err != nil {
+		return err
+	}
+
+	// Count x/mint inflation towards the staking reward target
+	return k.TrackMintModuleRewards(ctx)
 }
 
 // EndBlock - handles end of block processing
 func EndBlock(ctx sdk.Context, k *keeper.Keeper) error {
-	// Custom staking rewards DISABLED - using SDK minting module instead
-	// The SDK minting module provides dynamic inflation based on bonding ratio
-	// Configuration: 50% goal bonded, 7-100% inflation range, 93% rate change
-	
+	// Top up x/mint inflation to the staking reward target when the reward
+	// engine is enabled in params; otherwise x/mint alone pays stakers
+	if err := k.DistributeStakingRewards(ctx); err != nil {
+		return err
+	}
+
 	// Transaction recording is now handled directly in message handlers
 	// and decorators, not through events in EndBlock
-	return nil
+
+	// Prune history outside the retention params
+	return k.PruneTxHistory(ctx)
 }
 
 func processTransactionRecord(ctx sdk.Context, k keeper.Keeper, event sdk.Event) error {
@@ -75,19 +85,17 @@ func processTransactionRecord(ctx sdk.Context, k keeper.Keeper, event sdk.Event)
 	}
 	
 	// Create transaction history record
-	tx := keeper.TransactionHistory{
-		TxHash:      txHash,
+	tx := types.TransactionRecord{
+		Address:     address,
 		Type:        txType,
 		Description: description,
 		Amount:      coins,
 		From:        from,
 		To:          to,
-		Height:      ctx.BlockHeight(),
-		Timestamp:   ctx.BlockTime().Format("2006-01-02T15:04:05Z"),
 	}
 	
 	// Save transaction history
-	if err := k.SaveTransactionHistory(ctx, address, tx); err != nil {
+	if err := k.SaveTransactionHistory(ctx, tx); err != nil {
 		return fmt.Errorf("failed to save transaction history: %w", err)
 	}
 	
@@ -133,39 +141,30 @@ func processBankEvents(ctx sdk.Context, k keeper.Keeper) {
 				continue
 			}
 			
-			txHash := ""
-			if txBytes := ctx.TxBytes(); len(txBytes) > 0 {
-				txHash = fmt.Sprintf("%X", txBytes)
-			}
-			
 			// Record send transaction
 			if !isModuleAccount(sender) {
-				tx := keeper.TransactionHistory{
-					TxHash:      txHash,
+				tx := types.TransactionRecord{
+					Address:     sender,
 					Type:        "send",
 					Description: fmt.Sprintf("Sent %s to %s", coins.String(), shortenAddress(recipient)),
 					Amount:      coins,
 					From:        sender,
 					To:          recipient,
-					Height:      ctx.BlockHeight(),
-					Timestamp:   ctx.BlockTime().Format("2006-01-02T15:04:05Z"),
 				}
-				k.SaveTransactionHistory(ctx, sender, tx)
+				k.SaveTransactionHistory(ctx, tx)
 			}
 			
 			// Record receive transaction
 			if !isModuleAccount(recipient) {
-				tx := keeper.TransactionHistory{
-					TxHash:      txHash,
+				tx := types.TransactionRecord{
+					Address:     recipient,
 					Type:        "receive",
 					Description: fmt.Sprintf("Received %s from %s", coins.String(), shortenAddress(sender)),
 					Amount:      coins,
 					From:        sender,
 					To:          recipient,
-					Height:      ctx.BlockHeight(),
-					Timestamp:   ctx.BlockTime().Format("2006-01-02T15:04:05Z"),
 				}
-				k.SaveTransactionHistory(ctx, recipient, tx)
+				k.SaveTransactionHistory(ctx, tx)
 			}
 		}
 	}
@@ -17,6 +17,20 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Shows the parameters of the module",
 				},
+				{
+					RpcMethod: "TransactionHistory",
+					Use:       "tx-history [address]",
+					Short:     "Query an address' transaction history",
+					Long: `Query an address' transaction history, newest first unless pagination flags are given.
+Records can be filtered by type, denom, minimum amount, counterparty, height range and time range.`,
+					Example:        "mychaind q mychain tx-history cosmos1... --types dex_order_filled,dex_trade_buy --start-time 2025-01-01T00:00:00Z --end-time 2025-02-01T00:00:00Z",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "StakingMetrics",
+					Use:       "staking-metrics",
+					Short:     "Shows the bonded ratio, inflation and real staking yield",
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -0,0 +1,359 @@
+package statement
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+
+	dextypes "mychain/x/dex/types"
+	maincointypes "mychain/x/maincoin/types"
+	mychaintypes "mychain/x/mychain/types"
+)
+
+// Movement sources
+const (
+	SourceTxHistory        = "tx_history"
+	SourceMaincoinPurchase = "maincoin_purchase"
+	SourceDexTrade         = "dex_trade"
+)
+
+// pageLimit is the page size used for all queries of a Fetcher.
+const pageLimit = 100
+
+// Fetcher pages through the chain queries an account statement is built from.
+type Fetcher struct {
+	basisDenom string
+	history    mychaintypes.QueryClient
+	maincoin   maincointypes.QueryClient
+	dex        dextypes.QueryClient
+	bank       banktypes.QueryClient
+}
+
+// NewFetcher creates a Fetcher querying over conn. Movements are valued in
+// basisDenom where their price in it is known.
+func NewFetcher(conn gogogrpc.ClientConn, basisDenom string) *Fetcher {
+	return &Fetcher{
+		basisDenom: basisDenom,
+		history:    mychaintypes.NewQueryClient(conn),
+		maincoin:   maincointypes.NewQueryClient(conn),
+		dex:        dextypes.NewQueryClient(conn),
+		bank:       banktypes.NewQueryClient(conn),
+	}
+}
+
+// Movements returns every movement of address up to to. Dex fills come from
+// the dex trade log, maincoin buys from the purchase records and everything
+// else from the transaction history.
+func (f *Fetcher) Movements(ctx context.Context, address string, to time.Time) ([]Movement, error) {
+	records, err := f.transactionHistory(ctx, address, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query transaction history: %w", err)
+	}
+	distributions, err := f.stakingDistributions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query staking distribution history: %w", err)
+	}
+
+	// Dex fill records only carry one side of a trade; they are used to place
+	// the trade in its block and to get the seller's net proceeds
+	fills := make(map[[2]uint64]mychaintypes.TransactionRecord)
+	var movements []Movement
+	for _, rec := range records {
+		if fill := rec.GetDexFill(); fill != nil {
+			fills[[2]uint64{fill.OrderId, fill.CounterOrderId}] = rec
+			continue
+		}
+		movements = append(movements, f.recordMovements(rec, distributions)...)
+	}
+
+	purchases, err := f.purchases(ctx, address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query maincoin purchase history: %w", err)
+	}
+	for _, p := range purchases {
+		if p.UserTokens.IsNil() || !p.UserTokens.IsPositive() {
+			continue
+		}
+		// Purchase costs are TestUSD values whatever denom was paid
+		movements = append(movements, Movement{
+			Time:        p.Timestamp,
+			Height:      p.BlockHeight,
+			TxHash:      p.TxHash,
+			Source:      SourceMaincoinPurchase,
+			Type:        "buy_maincoin",
+			Description: fmt.Sprintf("Bought %s in segment %d at %s", p.UserTokens, p.SegmentNumber, p.PricePerToken),
+			Kind:        KindAcquire,
+			Denom:       maincointypes.MainCoinDenom,
+			Amount:      p.UserTokens,
+			Value:       f.valueOf(maincointypes.TestUSDDenom, p.Cost),
+		})
+	}
+
+	trades, err := f.trades(ctx, address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query dex trades: %w", err)
+	}
+	for _, t := range trades {
+		movements = append(movements, f.tradeMovements(t, address, fills)...)
+	}
+
+	return movements, nil
+}
+
+// Units returns the display units of denoms from their bank metadata.
+func (f *Fetcher) Units(ctx context.Context, denoms []string) Units {
+	units := make(Units, len(denoms))
+	for _, denom := range denoms {
+		res, err := f.bank.DenomMetadata(ctx, &banktypes.QueryDenomMetadataRequest{Denom: denom})
+		if err != nil {
+			units[denom] = DefaultUnit(denom)
+			continue
+		}
+		units[denom] = UnitFromMetadata(res.Metadata)
+	}
+	return units
+}
+
+func (f *Fetcher) transactionHistory(ctx context.Context, address string, to time.Time) ([]mychaintypes.TransactionRecord, error) {
+	req := &mychaintypes.QueryTransactionHistoryRequest{
+		Address:    address,
+		EndTime:    &to,
+		Pagination: &query.PageRequest{Limit: pageLimit},
+	}
+	var records []mychaintypes.TransactionRecord
+	for {
+		res, err := f.history.TransactionHistory(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, res.Transactions...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return records, nil
+		}
+		req.Pagination.Key = res.Pagination.NextKey
+	}
+}
+
+func (f *Fetcher) purchases(ctx context.Context, address string) ([]maincointypes.SegmentPurchaseRecord, error) {
+	req := &maincointypes.QueryUserPurchaseHistoryRequest{
+		Address:    address,
+		Pagination: &query.PageRequest{Limit: pageLimit},
+	}
+	var purchases []maincointypes.SegmentPurchaseRecord
+	for {
+		res, err := f.maincoin.UserPurchaseHistory(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if res.UserHistory != nil {
+			purchases = append(purchases, res.UserHistory.Purchases...)
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return purchases, nil
+		}
+		req.Pagination.Key = res.Pagination.NextKey
+	}
+}
+
+// trades returns the trades address took part in. The trade log has no
+// per-address index, so every trade is paged through.
+func (f *Fetcher) trades(ctx context.Context, address string) ([]dextypes.Trade, error) {
+	req := &dextypes.QueryTradesRequest{Pagination: &query.PageRequest{Limit: pageLimit}}
+	var trades []dextypes.Trade
+	for {
+		res, err := f.dex.Trades(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range res.Trades {
+			if t.Buyer == address || t.Seller == address {
+				trades = append(trades, t)
+			}
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return trades, nil
+		}
+		req.Pagination.Key = res.Pagination.NextKey
+	}
+}
+
+// stakingDistributions returns the staking distribution history, oldest first.
+// It pages on the height of the oldest distribution seen so far, so a
+// distribution recorded between two pages does not shift the next one.
+func (f *Fetcher) stakingDistributions(ctx context.Context) ([]*mychaintypes.StakingDistributionRecord, error) {
+	var distributions []*mychaintypes.StakingDistributionRecord
+	req := &mychaintypes.QueryStakingDistributionHistoryRequest{Limit: pageLimit}
+	for {
+		res, err := f.history.StakingDistributionHistory(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		distributions = append(distributions, res.Distributions...)
+		if len(res.Distributions) < pageLimit {
+			break
+		}
+		req.BeforeHeight = res.Distributions[len(res.Distributions)-1].Height
+	}
+	sort.Slice(distributions, func(i, j int) bool {
+		return distributions[i].Height < distributions[j].Height
+	})
+	return distributions, nil
+}
+
+// valueOf returns amount as a movement value if denom is the basis denom.
+func (f *Fetcher) valueOf(denom string, amount math.Int) *math.Int {
+	if denom != f.basisDenom || amount.IsNil() {
+		return nil
+	}
+	return &amount
+}
+
+// recordMovements converts a transaction history record into movements.
+// Records that do not move the account's funds yield none.
+func (f *Fetcher) recordMovements(rec mychaintypes.TransactionRecord, distributions []*mychaintypes.StakingDistributionRecord) []Movement {
+	ts, err := time.Parse(mychaintypes.TxHistoryTimeFormat, rec.Timestamp)
+	if err != nil {
+		return nil
+	}
+	base := Movement{
+		Time:        ts,
+		Height:      rec.Height,
+		TxHash:      rec.TxHash,
+		Source:      SourceTxHistory,
+		Type:        rec.Type,
+		Description: rec.Description,
+	}
+
+	var movements []Movement
+	each := func(kind Kind, sign int64, note string) {
+		for _, coin := range rec.Amount {
+			m := base
+			m.Kind = kind
+			m.Denom = coin.Denom
+			m.Amount = coin.Amount.MulRaw(sign)
+			m.Note = note
+			movements = append(movements, m)
+		}
+	}
+
+	switch rec.Type {
+	case "receive", "bridge_in", "ibc_transfer_in", "ibc_transfer_refund":
+		each(KindTransfer, 1, "")
+	case "send", "bridge_out", "ibc_transfer_out", "fee":
+		each(KindTransfer, -1, "")
+	case "dex_reward_distribution", "dex_claim_rewards", "dev_allocation":
+		each(KindIncome, 1, "")
+	case "staking_reward":
+		each(KindIncome, 1, stakingNote(rec.Height, distributions))
+	case "buy_maincoin":
+		// The maincoin received comes from the purchase records
+		if p := rec.GetSegmentPurchase(); p != nil && p.Paid.IsPositive() {
+			m := base
+			m.Kind = KindDispose
+			m.Denom = p.Paid.Denom
+			m.Amount = p.Paid.Amount.Neg()
+			m.Value = f.valueOf(maincointypes.TestUSDDenom, p.Cost)
+			movements = append(movements, m)
+		}
+	case "sell_maincoin":
+		sale := rec.GetMaincoinSale()
+		if sale == nil {
+			each(KindTransfer, 1, "")
+			break
+		}
+		sold := base
+		sold.Kind = KindDispose
+		sold.Denom = sale.Sold.Denom
+		sold.Amount = sale.Sold.Amount.Neg()
+		sold.Value = f.valueOf(sale.Proceeds.Denom, sale.Proceeds.Amount)
+		received := base
+		received.Kind = KindTransfer
+		received.Denom = sale.Proceeds.Denom
+		received.Amount = sale.Proceeds.Amount
+		movements = append(movements, sold, received)
+	case "dex_cancel_order":
+		if o := rec.GetDexOrder(); o != nil && o.CancelFee.IsPositive() {
+			m := base
+			m.Kind = KindTransfer
+			m.Denom = o.CancelFee.Denom
+			m.Amount = o.CancelFee.Amount.Neg()
+			m.Note = "cancel fee"
+			movements = append(movements, m)
+		}
+	}
+
+	return movements
+}
+
+// stakingNote describes the latest staking distribution at or before height.
+func stakingNote(height int64, distributions []*mychaintypes.StakingDistributionRecord) string {
+	i := sort.Search(len(distributions), func(i int) bool { return distributions[i].Height > height })
+	if i == 0 {
+		return ""
+	}
+	return fmt.Sprintf("effective_apr=%s", distributions[i-1].EffectiveApr)
+}
+
+// tradeMovements converts a dex trade into the movements of address. The
+// trade's quote value mirrors the dex: the price is per whole base unit.
+func (f *Fetcher) tradeMovements(t dextypes.Trade, address string, fills map[[2]uint64]mychaintypes.TransactionRecord) []Movement {
+	value := math.LegacyNewDecFromInt(t.Amount.Amount).
+		Quo(math.LegacyNewDec(1000000)).
+		MulInt(t.Price.Amount).
+		TruncateInt()
+	base := Movement{
+		Time:   time.Unix(t.ExecutedAt, 0).UTC(),
+		Source: SourceDexTrade,
+	}
+
+	var movements []Movement
+	if t.Buyer == address {
+		m := base
+		if rec, ok := fills[[2]uint64{t.BuyOrderId, t.SellOrderId}]; ok {
+			m.Height, m.TxHash = rec.Height, rec.TxHash
+		}
+		m.Type = "dex_trade_buy"
+		m.Description = fmt.Sprintf("Trade %d: bought %s for %s", t.Id, t.Amount, sdk.NewCoin(t.Price.Denom, value))
+
+		bought, paid := m, m
+		bought.Kind = KindAcquire
+		bought.Denom = t.Amount.Denom
+		bought.Amount = t.Amount.Amount
+		bought.Value = f.valueOf(t.Price.Denom, value)
+		paid.Kind = KindDispose
+		paid.Denom = t.Price.Denom
+		paid.Amount = value.Neg()
+		paid.Value = f.valueOf(t.Amount.Denom, t.Amount.Amount)
+		movements = append(movements, bought, paid)
+	}
+	if t.Seller == address {
+		m := base
+		proceeds := value
+		if rec, ok := fills[[2]uint64{t.SellOrderId, t.BuyOrderId}]; ok {
+			m.Height, m.TxHash = rec.Height, rec.TxHash
+			// The seller's record holds the quote received net of fees
+			proceeds = rec.Amount.AmountOf(t.Price.Denom)
+		}
+		m.Type = "dex_trade_sell"
+		m.Description = fmt.Sprintf("Trade %d: sold %s for %s", t.Id, t.Amount, sdk.NewCoin(t.Price.Denom, proceeds))
+
+		sold, received := m, m
+		sold.Kind = KindDispose
+		sold.Denom = t.Amount.Denom
+		sold.Amount = t.Amount.Amount.Neg()
+		sold.Value = f.valueOf(t.Price.Denom, proceeds)
+		received.Kind = KindTransfer
+		received.Denom = t.Price.Denom
+		received.Amount = proceeds
+		movements = append(movements, sold, received)
+	}
+
+	return movements
+}
@@ -0,0 +1,173 @@
+package statement
+
+import (
+	"sort"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// Kind classifies how a movement affects the cost basis of its asset.
+type Kind int
+
+const (
+	// KindTransfer moves funds without a known price. Incoming transfers open
+	// lots of unknown cost, outgoing ones close lots without realizing P&L.
+	KindTransfer Kind = iota
+	// KindAcquire opens a lot costing the movement's value.
+	KindAcquire
+	// KindDispose closes lots FIFO and realizes P&L against the movement's value.
+	KindDispose
+	// KindIncome opens a lot for rewards; its cost is the value when known.
+	KindIncome
+)
+
+// Movement is a signed change of one asset of the account.
+type Movement struct {
+	Time        time.Time
+	Height      int64
+	TxHash      string
+	Source      string
+	Type        string
+	Description string
+	Kind        Kind
+	Denom       string
+	// Amount is negative when funds leave the account
+	Amount math.Int
+	// Value is the movement's worth in base units of the basis denom, nil
+	// when unknown
+	Value *math.Int
+	Note  string
+}
+
+// lot is an open FIFO lot. Its cost is in base units of the basis denom and
+// nil when unknown.
+type lot struct {
+	amount math.Int
+	cost   *math.LegacyDec
+}
+
+// Result is the effect of applying a movement to a Ledger. Cost, proceeds
+// and P&L are in base units of the basis denom and nil when unknown or not
+// applicable.
+type Result struct {
+	Balance     math.Int
+	CostBasis   *math.LegacyDec
+	Proceeds    *math.LegacyDec
+	RealizedPnL *math.LegacyDec
+}
+
+// Ledger keeps running balances and FIFO lots per asset. The basis denom
+// itself is the unit of account and never has lots.
+type Ledger struct {
+	basisDenom string
+	balances   map[string]math.Int
+	lots       map[string][]lot
+}
+
+// NewLedger creates an empty Ledger valuing assets in basisDenom.
+func NewLedger(basisDenom string) *Ledger {
+	return &Ledger{
+		basisDenom: basisDenom,
+		balances:   make(map[string]math.Int),
+		lots:       make(map[string][]lot),
+	}
+}
+
+// Balance returns the running balance of denom.
+func (l *Ledger) Balance(denom string) math.Int {
+	if b, ok := l.balances[denom]; ok {
+		return b
+	}
+	return math.ZeroInt()
+}
+
+// OpenCostBasis returns the cost of the open lots of denom, or nil when any
+// of them has an unknown cost.
+func (l *Ledger) OpenCostBasis(denom string) *math.LegacyDec {
+	total := math.LegacyZeroDec()
+	for _, lt := range l.lots[denom] {
+		if lt.cost == nil {
+			return nil
+		}
+		total = total.Add(*lt.cost)
+	}
+	return &total
+}
+
+// Apply books m and returns its effect.
+func (l *Ledger) Apply(m Movement) Result {
+	balance := l.Balance(m.Denom).Add(m.Amount)
+	l.balances[m.Denom] = balance
+	res := Result{Balance: balance}
+
+	if m.Denom == l.basisDenom || m.Amount.IsZero() {
+		return res
+	}
+
+	if m.Amount.IsPositive() {
+		var cost *math.LegacyDec
+		if m.Value != nil && (m.Kind == KindAcquire || m.Kind == KindIncome) {
+			c := math.LegacyNewDecFromInt(*m.Value)
+			cost = &c
+		}
+		l.lots[m.Denom] = append(l.lots[m.Denom], lot{amount: m.Amount, cost: cost})
+		res.CostBasis = cost
+		return res
+	}
+
+	res.CostBasis = l.consume(m.Denom, m.Amount.Neg())
+	if m.Kind == KindDispose && m.Value != nil {
+		proceeds := math.LegacyNewDecFromInt(*m.Value)
+		res.Proceeds = &proceeds
+		if res.CostBasis != nil {
+			pnl := proceeds.Sub(*res.CostBasis)
+			res.RealizedPnL = &pnl
+		}
+	}
+	return res
+}
+
+// consume closes amount of denom's lots FIFO and returns their cost, or nil
+// when any consumed lot has an unknown cost or the lots run out.
+func (l *Ledger) consume(denom string, amount math.Int) *math.LegacyDec {
+	lots := l.lots[denom]
+	cost := math.LegacyZeroDec()
+	known := true
+	for amount.IsPositive() && len(lots) > 0 {
+		head := &lots[0]
+		take := math.MinInt(amount, head.amount)
+		if head.cost == nil {
+			known = false
+		} else {
+			part := head.cost.MulInt(take).QuoInt(head.amount)
+			cost = cost.Add(part)
+			remaining := head.cost.Sub(part)
+			head.cost = &remaining
+		}
+		head.amount = head.amount.Sub(take)
+		amount = amount.Sub(take)
+		if head.amount.IsZero() {
+			lots = lots[1:]
+		}
+	}
+	l.lots[denom] = lots
+
+	// Funds without lots predate the statement's history
+	if !known || amount.IsPositive() {
+		return nil
+	}
+	return &cost
+}
+
+// SortMovements orders movements by time, then height, keeping the order of
+// movements of the same block. Time comes first because dex trades are only
+// known by their execution time.
+func SortMovements(movements []Movement) {
+	sort.SliceStable(movements, func(i, j int) bool {
+		if !movements[i].Time.Equal(movements[j].Time) {
+			return movements[i].Time.Before(movements[j].Time)
+		}
+		return movements[i].Height < movements[j].Height
+	})
+}
@@ -0,0 +1,143 @@
+package statement
+
+import (
+	"sort"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// Entry is one line of an account statement. Amounts are in display units;
+// cost basis, proceeds and P&L are in display units of the basis denom and
+// empty when unknown.
+type Entry struct {
+	Time        string `json:"time"`
+	Height      int64  `json:"height"`
+	TxHash      string `json:"tx_hash"`
+	Source      string `json:"source"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+	Asset       string `json:"asset"`
+	Amount      string `json:"amount"`
+	Balance     string `json:"balance"`
+	CostBasis   string `json:"cost_basis"`
+	Proceeds    string `json:"proceeds"`
+	RealizedPnL string `json:"realized_pnl"`
+	Note        string `json:"note"`
+}
+
+// AssetSummary sums up one asset over the statement period.
+type AssetSummary struct {
+	Asset          string `json:"asset"`
+	OpeningBalance string `json:"opening_balance"`
+	ClosingBalance string `json:"closing_balance"`
+	// RealizedPnL sums the P&L of disposals with a known P&L
+	RealizedPnL string `json:"realized_pnl"`
+	// UnknownPnLCount counts disposals whose P&L could not be determined
+	UnknownPnLCount int `json:"unknown_pnl_count"`
+	// OpenCostBasis is the cost of the lots still held, empty when unknown
+	OpenCostBasis string `json:"open_cost_basis"`
+}
+
+// Statement is the account statement of an address over a period.
+type Statement struct {
+	Address    string         `json:"address"`
+	From       string         `json:"from"`
+	To         string         `json:"to"`
+	BasisDenom string         `json:"basis_denom"`
+	Entries    []Entry        `json:"entries"`
+	Assets     []AssetSummary `json:"assets"`
+}
+
+// Build replays all movements up to to through a FIFO ledger and returns the
+// statement of the movements within [from, to]. Movements before from only
+// set opening balances and lots.
+func Build(address string, movements []Movement, from, to time.Time, basisDenom string, units Units) Statement {
+	SortMovements(movements)
+
+	ledger := NewLedger(basisDenom)
+	opening := make(map[string]math.Int)
+	realized := make(map[string]math.LegacyDec)
+	unknown := make(map[string]int)
+	var denoms []string
+
+	toBasis := func(d *math.LegacyDec) string {
+		if d == nil {
+			return ""
+		}
+		return formatDec(units.Convert(basisDenom, *d))
+	}
+
+	st := Statement{
+		Address:    address,
+		From:       from.UTC().Format(time.RFC3339),
+		To:         to.UTC().Format(time.RFC3339),
+		BasisDenom: units.Display(basisDenom),
+		Entries:    []Entry{},
+		Assets:     []AssetSummary{},
+	}
+
+	for _, m := range movements {
+		if m.Time.After(to) {
+			continue
+		}
+		if _, seen := opening[m.Denom]; !seen {
+			denoms = append(denoms, m.Denom)
+			opening[m.Denom] = math.ZeroInt()
+		}
+		if m.Time.Before(from) {
+			opening[m.Denom] = ledger.Apply(m).Balance
+			continue
+		}
+
+		res := ledger.Apply(m)
+		if m.Kind == KindDispose && m.Amount.IsNegative() && m.Denom != basisDenom {
+			if res.RealizedPnL != nil {
+				total, ok := realized[m.Denom]
+				if !ok {
+					total = math.LegacyZeroDec()
+				}
+				realized[m.Denom] = total.Add(*res.RealizedPnL)
+			} else {
+				unknown[m.Denom]++
+			}
+		}
+
+		st.Entries = append(st.Entries, Entry{
+			Time:        m.Time.UTC().Format(time.RFC3339),
+			Height:      m.Height,
+			TxHash:      m.TxHash,
+			Source:      m.Source,
+			Type:        m.Type,
+			Description: m.Description,
+			Asset:       units.Display(m.Denom),
+			Amount:      formatDec(units.Convert(m.Denom, math.LegacyNewDecFromInt(m.Amount))),
+			Balance:     formatDec(units.Convert(m.Denom, math.LegacyNewDecFromInt(res.Balance))),
+			CostBasis:   toBasis(res.CostBasis),
+			Proceeds:    toBasis(res.Proceeds),
+			RealizedPnL: toBasis(res.RealizedPnL),
+			Note:        m.Note,
+		})
+	}
+
+	sort.Strings(denoms)
+	for _, denom := range denoms {
+		pnl, ok := realized[denom]
+		if !ok {
+			pnl = math.LegacyZeroDec()
+		}
+		summary := AssetSummary{
+			Asset:           units.Display(denom),
+			OpeningBalance:  formatDec(units.Convert(denom, math.LegacyNewDecFromInt(opening[denom]))),
+			ClosingBalance:  formatDec(units.Convert(denom, math.LegacyNewDecFromInt(ledger.Balance(denom)))),
+			RealizedPnL:     toBasis(&pnl),
+			UnknownPnLCount: unknown[denom],
+		}
+		if denom != basisDenom {
+			summary.OpenCostBasis = toBasis(ledger.OpenCostBasis(denom))
+		}
+		st.Assets = append(st.Assets, summary)
+	}
+
+	return st
+}
@@ -0,0 +1,95 @@
+package statement_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"mychain/x/mychain/statement"
+)
+
+func day(month time.Month, d int) time.Time {
+	return time.Date(2025, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func value(v int64) *math.Int {
+	i := math.NewInt(v)
+	return &i
+}
+
+func TestBuildFIFO(t *testing.T) {
+	movements := []statement.Movement{
+		// Out of order on purpose; Build sorts by time
+		{Time: day(3, 1), Height: 30, Type: "dex_trade_sell", Kind: statement.KindDispose, Denom: "umc", Amount: math.NewInt(-2_500_000), Value: value(5_000_000)},
+		{Time: day(1, 1), Height: 10, Type: "buy_maincoin", Kind: statement.KindAcquire, Denom: "umc", Amount: math.NewInt(2_000_000), Value: value(1_000_000)},
+		{Time: day(2, 1), Height: 20, Type: "buy_maincoin", Kind: statement.KindAcquire, Denom: "umc", Amount: math.NewInt(1_000_000), Value: value(2_000_000)},
+		{Time: day(3, 1), Height: 30, Type: "dex_trade_sell", Kind: statement.KindTransfer, Denom: "utusd", Amount: math.NewInt(5_000_000)},
+		// Rewards have no known cost, so disposing of them has no known P&L
+		{Time: day(3, 2), Height: 40, Type: "dex_reward_distribution", Kind: statement.KindIncome, Denom: "ulc", Amount: math.NewInt(500_000)},
+		{Time: day(3, 3), Height: 50, Type: "dex_trade_buy", Kind: statement.KindDispose, Denom: "ulc", Amount: math.NewInt(-500_000), Value: value(100)},
+		// After the period
+		{Time: day(5, 1), Height: 60, Type: "send", Kind: statement.KindTransfer, Denom: "umc", Amount: math.NewInt(-1)},
+	}
+	units := statement.Units{"ulc": {Display: "lc", Exponent: 6}}
+
+	st := statement.Build("alice", movements, day(3, 1), day(4, 1), "utusd", units)
+	require.Equal(t, "tusd", st.BasisDenom)
+	require.Len(t, st.Entries, 4)
+
+	// 2 MC of the first lot cost 1 TUSD and 0.5 MC of the second one 1 TUSD
+	sell := st.Entries[0]
+	require.Equal(t, "mc", sell.Asset)
+	require.Equal(t, "-2.5", sell.Amount)
+	require.Equal(t, "0.5", sell.Balance)
+	require.Equal(t, "2", sell.CostBasis)
+	require.Equal(t, "5", sell.Proceeds)
+	require.Equal(t, "3", sell.RealizedPnL)
+
+	require.Equal(t, "tusd", st.Entries[1].Asset)
+	require.Equal(t, "5", st.Entries[1].Balance)
+	require.Empty(t, st.Entries[1].CostBasis)
+
+	lcSale := st.Entries[3]
+	require.Equal(t, "lc", lcSale.Asset)
+	require.Equal(t, "0.0001", lcSale.Proceeds)
+	require.Empty(t, lcSale.CostBasis)
+	require.Empty(t, lcSale.RealizedPnL)
+
+	require.Equal(t, []statement.AssetSummary{
+		{Asset: "lc", OpeningBalance: "0", ClosingBalance: "0", RealizedPnL: "0", UnknownPnLCount: 1, OpenCostBasis: "0"},
+		{Asset: "mc", OpeningBalance: "3", ClosingBalance: "0.5", RealizedPnL: "3", OpenCostBasis: "1"},
+		{Asset: "tusd", OpeningBalance: "0", ClosingBalance: "5", RealizedPnL: "0"},
+	}, st.Assets)
+
+	var buf bytes.Buffer
+	require.NoError(t, statement.Write(&buf, st, statement.FormatCSV))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 1+4+1+1+3)
+	require.True(t, strings.HasPrefix(lines[0], "time,height,tx_hash"))
+	require.Contains(t, lines[0], "realized_pnl_tusd")
+	require.Empty(t, lines[5])
+
+	require.Error(t, statement.Write(&buf, st, "xml"))
+}
+
+func TestLedgerUnknownLots(t *testing.T) {
+	ledger := statement.NewLedger("utusd")
+
+	// Selling funds that predate the history has no known cost
+	res := ledger.Apply(statement.Movement{Kind: statement.KindDispose, Denom: "umc", Amount: math.NewInt(-10), Value: value(20)})
+	require.Equal(t, math.NewInt(-10), res.Balance)
+	require.Nil(t, res.CostBasis)
+	require.Nil(t, res.RealizedPnL)
+	require.Equal(t, math.LegacyNewDec(20), *res.Proceeds)
+
+	// Transfers close lots without realizing P&L
+	ledger.Apply(statement.Movement{Kind: statement.KindAcquire, Denom: "umc", Amount: math.NewInt(10), Value: value(30)})
+	res = ledger.Apply(statement.Movement{Kind: statement.KindTransfer, Denom: "umc", Amount: math.NewInt(-4)})
+	require.Equal(t, math.LegacyNewDec(12), *res.CostBasis)
+	require.Nil(t, res.RealizedPnL)
+	require.Equal(t, math.LegacyNewDec(18), *ledger.OpenCostBasis("umc"))
+}
@@ -0,0 +1,76 @@
+package statement
+
+import (
+	"strings"
+
+	"cosmossdk.io/math"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// microExponent is the exponent assumed for "u" prefixed denoms that have
+// no bank metadata, matching how the dex and maincoin modules price them.
+const microExponent = 6
+
+// Unit is the display unit of a base denom.
+type Unit struct {
+	Display  string
+	Exponent uint32
+}
+
+// Units maps base denoms to their display units.
+type Units map[string]Unit
+
+// UnitFromMetadata returns the display unit described by bank denom
+// metadata, falling back to the base denom when the display unit is not
+// listed.
+func UnitFromMetadata(md banktypes.Metadata) Unit {
+	for _, du := range md.DenomUnits {
+		if du.Denom == md.Display {
+			return Unit{Display: md.Display, Exponent: du.Exponent}
+		}
+	}
+	return Unit{Display: md.Base}
+}
+
+// DefaultUnit returns the unit used for a denom without bank metadata:
+// "umc" is shown as "mc" with six decimals, other denoms as they are.
+func DefaultUnit(denom string) Unit {
+	if len(denom) > 1 && strings.HasPrefix(denom, "u") {
+		return Unit{Display: denom[1:], Exponent: microExponent}
+	}
+	return Unit{Display: denom}
+}
+
+// Unit returns the display unit of denom.
+func (u Units) Unit(denom string) Unit {
+	if unit, ok := u[denom]; ok {
+		return unit
+	}
+	return DefaultUnit(denom)
+}
+
+// Display returns the display name of denom.
+func (u Units) Display(denom string) string {
+	return u.Unit(denom).Display
+}
+
+// Convert converts an amount of base units of denom to display units.
+func (u Units) Convert(denom string, amount math.LegacyDec) math.LegacyDec {
+	exp := u.Unit(denom).Exponent
+	if exp == 0 {
+		return amount
+	}
+	return amount.Quo(math.LegacyNewDec(10).Power(uint64(exp)))
+}
+
+// formatDec formats d without trailing zeros.
+func formatDec(d math.LegacyDec) string {
+	s := d.String()
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	if s == "-0" {
+		return "0"
+	}
+	return s
+}
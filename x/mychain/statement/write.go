@@ -0,0 +1,70 @@
+package statement
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+const (
+	// FormatCSV writes the entries followed by the asset summaries as CSV.
+	FormatCSV = "csv"
+	// FormatJSON writes the whole statement as indented JSON.
+	FormatJSON = "json"
+)
+
+// Write writes st to w in the given format.
+func Write(w io.Writer, st Statement, format string) error {
+	switch format {
+	case FormatCSV:
+		return WriteCSV(w, st)
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(st)
+	default:
+		return fmt.Errorf("unknown statement format %q, expected %s or %s", format, FormatCSV, FormatJSON)
+	}
+}
+
+// WriteCSV writes the entries of st, a blank line and its asset summaries.
+func WriteCSV(w io.Writer, st Statement) error {
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write([]string{
+		"time", "height", "tx_hash", "source", "type", "description", "asset", "amount", "balance",
+		"cost_basis_" + st.BasisDenom, "proceeds_" + st.BasisDenom, "realized_pnl_" + st.BasisDenom, "note",
+	}); err != nil {
+		return err
+	}
+	for _, e := range st.Entries {
+		if err := cw.Write([]string{
+			e.Time, strconv.FormatInt(e.Height, 10), e.TxHash, e.Source, e.Type, e.Description, e.Asset,
+			e.Amount, e.Balance, e.CostBasis, e.Proceeds, e.RealizedPnL, e.Note,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err := cw.Write(nil); err != nil {
+		return err
+	}
+	if err := cw.Write([]string{
+		"asset", "opening_balance", "closing_balance", "realized_pnl_" + st.BasisDenom, "unknown_pnl_count",
+		"open_cost_basis_" + st.BasisDenom,
+	}); err != nil {
+		return err
+	}
+	for _, a := range st.Assets {
+		if err := cw.Write([]string{
+			a.Asset, a.OpeningBalance, a.ClosingBalance, a.RealizedPnL, strconv.Itoa(a.UnknownPnLCount), a.OpenCostBasis,
+		}); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
@@ -8,5 +8,6 @@ import (
 
 // x/mychain module sentinel errors
 var (
-	ErrInvalidSigner = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidSigner  = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidPageKey = errors.Register(ModuleName, 1101, "invalid transaction history page key")
 )
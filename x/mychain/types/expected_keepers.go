@@ -6,6 +6,8 @@ import (
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -20,6 +22,7 @@ type AuthKeeper interface {
 type BankKeeper interface {
 	SpendableCoins(context.Context, sdk.AccAddress) sdk.Coins
 	GetSupply(ctx context.Context, denom string) sdk.Coin
+	GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool)
 	MintCoins(ctx context.Context, moduleName string, amounts sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
 }
@@ -32,6 +35,17 @@ type StakingKeeper interface {
 	GetValidatorDelegations(ctx context.Context, valAddr sdk.ValAddress) ([]stakingtypes.Delegation, error)
 }
 
+// MintKeeper is the part of the mint query server used for the staking
+// metrics.
+type MintKeeper interface {
+	Inflation(ctx context.Context, req *minttypes.QueryInflationRequest) (*minttypes.QueryInflationResponse, error)
+}
+
+// DexKeeper defines the expected interface for the Dex module.
+type DexKeeper interface {
+	CalculateTotalSystemInflation(ctx context.Context) (mintInflation, dexInflation, totalInflation math.LegacyDec)
+}
+
 // ParamSubspace defines the expected Subspace interface for parameters.
 type ParamSubspace interface {
 	Get(context.Context, []byte, interface{})
@@ -1,14 +1,54 @@
 package types
 
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:            DefaultParams(),
+		MintModuleRewards: math.ZeroInt(),
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	sequences := make(map[uint64]bool, len(gs.TxHistory))
+	for _, record := range gs.TxHistory {
+		if record.Address == "" {
+			return fmt.Errorf("transaction record %d has no address", record.Sequence)
+		}
+		if sequences[record.Sequence] {
+			return fmt.Errorf("duplicate transaction record sequence %d", record.Sequence)
+		}
+		if record.Sequence >= gs.TxHistorySeq {
+			return fmt.Errorf("transaction record sequence %d is not below the next sequence %d", record.Sequence, gs.TxHistorySeq)
+		}
+		sequences[record.Sequence] = true
+	}
+
+	heights := make(map[int64]bool, len(gs.StakingDistributions))
+	for _, record := range gs.StakingDistributions {
+		if heights[record.Height] {
+			return fmt.Errorf("duplicate staking distribution at height %d", record.Height)
+		}
+		heights[record.Height] = true
+	}
+
+	if !gs.MintModuleRewards.IsNil() && gs.MintModuleRewards.IsNegative() {
+		return fmt.Errorf("negative mint module rewards %s", gs.MintModuleRewards)
+	}
+	if !gs.LastMintSupply.IsNil() && gs.LastMintSupply.IsNegative() {
+		return fmt.Errorf("negative last mint supply %s", gs.LastMintSupply)
+	}
+
+	return nil
 }
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -28,6 +29,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// tx_history is the transaction history retained in state
+	TxHistory []TransactionRecord `protobuf:"bytes,2,rep,name=tx_history,json=txHistory,proto3" json:"tx_history"`
+	// tx_history_seq is the next transaction history sequence
+	TxHistorySeq uint64 `protobuf:"varint,3,opt,name=tx_history_seq,json=txHistorySeq,proto3" json:"tx_history_seq,omitempty"`
+	// staking_distributions are the retained staking distribution records
+	StakingDistributions []StakingDistributionRecord `protobuf:"bytes,4,rep,name=staking_distributions,json=stakingDistributions,proto3" json:"staking_distributions"`
+	// mint_module_rewards is what x/mint minted in the reward denom since the
+	// last staking reward distribution
+	MintModuleRewards cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=mint_module_rewards,json=mintModuleRewards,proto3,customtype=cosmossdk.io/math.Int" json:"mint_module_rewards"`
+	// last_mint_supply is the reward denom supply the mint recorder saw last,
+	// unset before its first block
+	LastMintSupply cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=last_mint_supply,json=lastMintSupply,proto3,customtype=cosmossdk.io/math.Int" json:"last_mint_supply"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,6 +83,27 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetTxHistory() []TransactionRecord {
+	if m != nil {
+		return m.TxHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTxHistorySeq() uint64 {
+	if m != nil {
+		return m.TxHistorySeq
+	}
+	return 0
+}
+
+func (m *GenesisState) GetStakingDistributions() []StakingDistributionRecord {
+	if m != nil {
+		return m.StakingDistributions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "mychain.mychain.v1.GenesisState")
 }
@@ -77,19 +111,34 @@ func init() {
 func init() { proto.RegisterFile("mychain/mychain/v1/genesis.proto", fileDescriptor_3c0ac7d617b2d148) }
 
 var fileDescriptor_3c0ac7d617b2d148 = []byte{
-	// 188 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc8, 0xad, 0x4c, 0xce,
-	0x48, 0xcc, 0xcc, 0xd3, 0x87, 0xd1, 0x65, 0x86, 0xfa, 0xe9, 0xa9, 0x79, 0xa9, 0xc5, 0x99, 0xc5,
-	0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x42, 0x50, 0x19, 0x3d, 0x18, 0x5d, 0x66, 0x28, 0x25,
-	0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0xca, 0xa4, 0x44, 0xd2, 0xf3, 0xd3, 0xf3,
-	0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x2a, 0x2a, 0x8f, 0xc5, 0xf8, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8,
-	0xe9, 0x4a, 0xbe, 0x5c, 0x3c, 0xee, 0x10, 0xeb, 0x82, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0x6c, 0xb9,
-	0xd8, 0x20, 0xf2, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x52, 0x7a, 0x98, 0xd6, 0xeb, 0x05,
-	0x80, 0x55, 0x38, 0x71, 0x9e, 0xb8, 0x27, 0xcf, 0xb0, 0xe2, 0xf9, 0x06, 0x2d, 0xc6, 0x20, 0xa8,
-	0x26, 0x27, 0xc3, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71,
-	0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x12, 0x87, 0xb9,
-	0xa0, 0x02, 0xee, 0x96, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x43, 0x8c, 0x01, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x4f, 0x41, 0xb8, 0xb0, 0x0a, 0x01, 0x00, 0x00,
+	// 420 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x6a, 0xdb, 0x30,
+	0x1c, 0xc7, 0xa3, 0x25, 0x0b, 0x44, 0x2d, 0x65, 0xd5, 0x5a, 0x66, 0x02, 0x73, 0xcc, 0xfe, 0x11,
+	0x06, 0xb3, 0x49, 0x77, 0xde, 0xc5, 0x0c, 0xba, 0x0d, 0x02, 0xc3, 0xde, 0x69, 0x17, 0xa3, 0xda,
+	0xc2, 0x16, 0x8d, 0x25, 0x47, 0x3f, 0xb9, 0x8b, 0xdf, 0x62, 0x8f, 0xb1, 0xe3, 0x5e, 0x61, 0xb7,
+	0x1e, 0x7b, 0x1c, 0x3b, 0x94, 0x91, 0x1c, 0xf6, 0x1a, 0xc3, 0xb2, 0xd3, 0x6e, 0xc4, 0x87, 0x5e,
+	0x2c, 0x61, 0x7f, 0x3e, 0xdf, 0xaf, 0xc4, 0xcf, 0xd8, 0xc9, 0xab, 0x38, 0xa3, 0x5c, 0x78, 0xdb,
+	0xf5, 0x62, 0xe6, 0xa5, 0x4c, 0x30, 0xe0, 0xe0, 0x16, 0x4a, 0x6a, 0x49, 0x48, 0xfb, 0xc5, 0xdd,
+	0xae, 0x17, 0xb3, 0xf1, 0x21, 0xcd, 0xb9, 0x90, 0x9e, 0x79, 0x36, 0xd8, 0xf8, 0x28, 0x95, 0xa9,
+	0x34, 0x5b, 0xaf, 0xde, 0xb5, 0x6f, 0x27, 0x1d, 0xf1, 0x05, 0x55, 0x34, 0x6f, 0xd3, 0xc7, 0x2f,
+	0x3a, 0x80, 0x65, 0xc9, 0x54, 0x15, 0x81, 0xa6, 0xe7, 0x5c, 0xa4, 0x2d, 0xf7, 0xb4, 0x83, 0xd3,
+	0xab, 0x28, 0xe3, 0xa0, 0xa5, 0xaa, 0x1a, 0xe8, 0xc9, 0x8f, 0x3e, 0xde, 0x3f, 0x6d, 0x0e, 0x1f,
+	0x6a, 0xaa, 0x19, 0x79, 0x83, 0x87, 0x4d, 0x9b, 0x85, 0x1c, 0x34, 0xdd, 0x3b, 0x19, 0xbb, 0xbb,
+	0x97, 0x71, 0x3f, 0x1a, 0xc2, 0x1f, 0x5d, 0x5e, 0x4f, 0x7a, 0xdf, 0xfe, 0x7c, 0x7f, 0x89, 0x82,
+	0x56, 0x22, 0x1f, 0x30, 0xbe, 0xed, 0xb0, 0xee, 0x39, 0xfd, 0xe9, 0xde, 0xc9, 0xf3, 0xae, 0x88,
+	0x4f, 0x8a, 0x0a, 0xa0, 0xb1, 0xe6, 0x52, 0x04, 0x2c, 0x96, 0x2a, 0xf1, 0x07, 0x75, 0x5a, 0x30,
+	0xd2, 0xab, 0x77, 0x8d, 0x4d, 0x9e, 0xe1, 0x83, 0xdb, 0xac, 0x08, 0xd8, 0xd2, 0xea, 0x3b, 0x68,
+	0x3a, 0x08, 0xf6, 0x6f, 0x90, 0x90, 0x2d, 0x49, 0x86, 0x8f, 0xdb, 0x7b, 0x47, 0x09, 0x07, 0xad,
+	0xf8, 0x59, 0x59, 0x87, 0x82, 0x35, 0x30, 0xe5, 0xaf, 0xba, 0xca, 0xc3, 0x46, 0x78, 0xfb, 0x0f,
+	0xff, 0xdf, 0x21, 0x8e, 0x60, 0x17, 0x00, 0x32, 0xc7, 0x0f, 0x73, 0x2e, 0x74, 0x94, 0xcb, 0xa4,
+	0x5c, 0xb0, 0x48, 0xb1, 0x2f, 0x54, 0x25, 0x60, 0xdd, 0x77, 0xd0, 0x74, 0xe4, 0x3f, 0xae, 0xc5,
+	0x5f, 0xd7, 0x93, 0xe3, 0x58, 0x42, 0x2e, 0x01, 0x92, 0x73, 0x97, 0x4b, 0x2f, 0xa7, 0x3a, 0x73,
+	0xdf, 0x0b, 0x1d, 0x1c, 0xd6, 0xe6, 0xdc, 0x88, 0x41, 0xe3, 0x91, 0x53, 0xfc, 0x60, 0x41, 0x41,
+	0x47, 0x26, 0x13, 0xca, 0xa2, 0x58, 0x54, 0xd6, 0xf0, 0x2e, 0x59, 0x07, 0xb5, 0x36, 0xe7, 0x42,
+	0x87, 0x46, 0xf2, 0x67, 0x97, 0x6b, 0x1b, 0x5d, 0xad, 0x6d, 0xf4, 0x7b, 0x6d, 0xa3, 0xaf, 0x1b,
+	0xbb, 0x77, 0xb5, 0xb1, 0x7b, 0x3f, 0x37, 0x76, 0xef, 0xf3, 0xa3, 0xed, 0xe8, 0x57, 0x37, 0x3f,
+	0x81, 0xae, 0x0a, 0x06, 0x67, 0x43, 0x33, 0xfd, 0xd7, 0x7f, 0x07, 0x00, 0xbb, 0xd0, 0xea, 0xf4,
+	0xcc, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -112,6 +161,59 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.LastMintSupply.Size()
+		i -= size
+		if _, err := m.LastMintSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MintModuleRewards.Size()
+		i -= size
+		if _, err := m.MintModuleRewards.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.StakingDistributions) > 0 {
+		for iNdEx := len(m.StakingDistributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakingDistributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TxHistorySeq != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TxHistorySeq))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TxHistory) > 0 {
+		for iNdEx := len(m.TxHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TxHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -144,6 +246,25 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TxHistory) > 0 {
+		for _, e := range m.TxHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TxHistorySeq != 0 {
+		n += 1 + sovGenesis(uint64(m.TxHistorySeq))
+	}
+	if len(m.StakingDistributions) > 0 {
+		for _, e := range m.StakingDistributions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.MintModuleRewards.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.LastMintSupply.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -215,6 +336,161 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHistory = append(m.TxHistory, TransactionRecord{})
+			if err := m.TxHistory[len(m.TxHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHistorySeq", wireType)
+			}
+			m.TxHistorySeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxHistorySeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingDistributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingDistributions = append(m.StakingDistributions, StakingDistributionRecord{})
+			if err := m.StakingDistributions[len(m.StakingDistributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintModuleRewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintModuleRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastMintSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastMintSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,11 +3,20 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+
 	"mychain/x/mychain/types"
 
 	"github.com/stretchr/testify/require"
 )
 
+func enabledStakingRewards(modify func(*types.StakingRewardParams)) types.StakingRewardParams {
+	params := types.DefaultStakingRewardParams()
+	params.Enabled = true
+	modify(&params)
+	return params
+}
+
 func TestGenesisState_Validate(t *testing.T) {
 	tests := []struct {
 		desc     string
@@ -24,6 +33,78 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{},
 			valid:    true,
 		},
+		{
+			desc: "valid tx history",
+			genState: &types.GenesisState{
+				TxHistory:    []types.TransactionRecord{{Address: "alice", Sequence: 0}, {Address: "bob", Sequence: 1}},
+				TxHistorySeq: 2,
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate tx history sequence",
+			genState: &types.GenesisState{
+				TxHistory:    []types.TransactionRecord{{Address: "alice", Sequence: 0}, {Address: "bob", Sequence: 0}},
+				TxHistorySeq: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "tx history sequence ahead of counter",
+			genState: &types.GenesisState{
+				TxHistory:    []types.TransactionRecord{{Address: "alice", Sequence: 3}},
+				TxHistorySeq: 3,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate staking distribution",
+			genState: &types.GenesisState{
+				StakingDistributions: []types.StakingDistributionRecord{{Height: 720}, {Height: 720}},
+			},
+			valid: false,
+		},
+		{
+			desc: "retention without prune budget",
+			genState: &types.GenesisState{
+				Params: types.NewParams(false, types.TxHistoryRetention{MaxAgeBlocks: 10}, types.DefaultStakingRewardParams()),
+			},
+			valid: false,
+		},
+		{
+			desc: "enabled staking rewards",
+			genState: &types.GenesisState{
+				Params: types.NewParams(false, types.DefaultTxHistoryRetention(), enabledStakingRewards(func(p *types.StakingRewardParams) {})),
+			},
+			valid: true,
+		},
+		{
+			desc: "staking rewards without mode",
+			genState: &types.GenesisState{
+				Params: types.NewParams(false, types.DefaultTxHistoryRetention(), enabledStakingRewards(func(p *types.StakingRewardParams) {
+					p.Mode = types.STAKING_REWARD_MODE_UNSPECIFIED
+				})),
+			},
+			valid: false,
+		},
+		{
+			desc: "staking reward rate above 100%",
+			genState: &types.GenesisState{
+				Params: types.NewParams(false, types.DefaultTxHistoryRetention(), enabledStakingRewards(func(p *types.StakingRewardParams) {
+					p.Rate = math.LegacyNewDecWithPrec(101, 2)
+				})),
+			},
+			valid: false,
+		},
+		{
+			desc: "staking reward interval longer than a year",
+			genState: &types.GenesisState{
+				Params: types.NewParams(false, types.DefaultTxHistoryRetention(), enabledStakingRewards(func(p *types.StakingRewardParams) {
+					p.IntervalBlocks = p.BlocksPerYear + 1
+				})),
+			},
+			valid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -18,9 +18,72 @@ const (
 	GovModuleName = "gov"
 )
 
+const (
+	// EventTypeTransactionRecord is emitted for every transaction history
+	// record, whether or not it is also written to consensus state.
+	EventTypeTransactionRecord = "transaction_record"
+
+	AttributeKeyAddress     = "address"
+	AttributeKeyType        = "type"
+	AttributeKeyDescription = "description"
+	AttributeKeyAmount      = "amount"
+	AttributeKeyFrom        = "from"
+	AttributeKeyTo          = "to"
+	AttributeKeyTxHash      = "tx_hash"
+	AttributeKeyHeight      = "height"
+	AttributeKeyTimestamp   = "timestamp"
+	AttributeKeyRecord      = "record"
+
+	// EventTypeTransactionRecordArchived and EventTypeStakingDistributionArchived
+	// carry records pruned from state so external indexers keep a full copy.
+	EventTypeTransactionRecordArchived   = "transaction_record_archived"
+	EventTypeStakingDistributionArchived = "staking_distribution_archived"
+
+	AttributeKeySequence = "sequence"
+	AttributeKeyReason   = "reason"
+
+	// Reasons a record was pruned
+	PruneReasonMaxAge     = "max_age"
+	PruneReasonMaxRecords = "max_records"
+
+	// EventTypeStakingRewardsDistributed is emitted for every staking reward
+	// distribution of the reward engine.
+	EventTypeStakingRewardsDistributed = "staking_rewards_distributed"
+	// EventTypeStakingRewardParamsUpdated is emitted when governance changes
+	// the staking reward params.
+	EventTypeStakingRewardParamsUpdated = "staking_reward_params_updated"
+
+	AttributeKeyMode              = "mode"
+	AttributeKeyEnabled           = "enabled"
+	AttributeKeyRate              = "rate"
+	AttributeKeyIntervalBlocks    = "interval_blocks"
+	AttributeKeyRewardDenom       = "reward_denom"
+	AttributeKeyRewards           = "rewards"
+	AttributeKeyTarget            = "target"
+	AttributeKeyMinted            = "minted"
+	AttributeKeyMintModuleRewards = "mint_module_rewards"
+	AttributeKeyEffectiveAPR      = "effective_apr"
+	AttributeKeyDelegators        = "delegators"
+)
+
 // ParamsKey is the prefix to retrieve all Params
 var ParamsKey = collections.NewPrefix(0) // "p_mychain"
 
+// TxHistorySeqKey is the key of the transaction history record sequence
+var TxHistorySeqKey = collections.NewPrefix(3)
+
+var (
+	// TxHistoryByHeightKey indexes transaction history by height and sequence
+	TxHistoryByHeightKey = collections.NewPrefix(4)
+	// TxHistoryCountKey is the prefix of the per-address record counts
+	TxHistoryCountKey = collections.NewPrefix(5)
+	// TxHistoryOverCapKey is the prefix of addresses above the record cap
+	TxHistoryOverCapKey = collections.NewPrefix(6)
+	// MintModuleRewardsKey is the key of the x/mint rewards minted since the
+	// last staking reward distribution
+	MintModuleRewardsKey = collections.NewPrefix(7)
+)
+
 var (
 	// TransactionRecordPrefix is the prefix for transaction records
 	TransactionRecordPrefix = []byte{0x01}
@@ -1,17 +1,161 @@
 package types
 
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultTxHistoryMaxAgeBlocks keeps about six months of history at 5s blocks
+	DefaultTxHistoryMaxAgeBlocks = 3153600
+	// DefaultTxHistoryMaxRecordsPerAddress caps the history kept per address
+	DefaultTxHistoryMaxRecordsPerAddress = 1000
+	// DefaultTxHistoryPruneBudget bounds the records pruned in one EndBlock
+	DefaultTxHistoryPruneBudget = 200
+
+	// DefaultStakingRewardInterval distributes hourly at 5s blocks
+	DefaultStakingRewardInterval = 720
+	// DefaultStakingRewardDenom is the staking denom
+	DefaultStakingRewardDenom = "ulc"
+	// DefaultBlocksPerYear assumes 5s blocks over 365.25 days
+	DefaultBlocksPerYear = 6311520
+)
+
+var (
+	// DefaultStakingRewardRate pays 20% of the supply per year
+	DefaultStakingRewardRate = math.LegacyNewDecWithPrec(20, 2)
+	// MaxStakingRewardRate caps the yearly staking reward rate at 100%
+	MaxStakingRewardRate = math.LegacyOneDec()
+)
+
 // NewParams creates a new Params instance.
-func NewParams() Params {
-	return Params{}
+func NewParams(disableOnchainTxHistory bool, txHistoryRetention TxHistoryRetention, stakingRewards StakingRewardParams) Params {
+	return Params{
+		DisableOnchainTxHistory: disableOnchainTxHistory,
+		TxHistoryRetention:      txHistoryRetention,
+		StakingRewards:          stakingRewards,
+	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(false, DefaultTxHistoryRetention(), DefaultStakingRewardParams())
+}
+
+// DefaultTxHistoryRetention returns the default history retention.
+func DefaultTxHistoryRetention() TxHistoryRetention {
+	return TxHistoryRetention{
+		MaxAgeBlocks:         DefaultTxHistoryMaxAgeBlocks,
+		MaxRecordsPerAddress: DefaultTxHistoryMaxRecordsPerAddress,
+		PruneBudget:          DefaultTxHistoryPruneBudget,
+	}
+}
+
+// DefaultStakingRewardParams returns the default staking reward engine
+// config. The engine is disabled, leaving staking rewards to x/mint.
+func DefaultStakingRewardParams() StakingRewardParams {
+	return StakingRewardParams{
+		Enabled:        false,
+		Mode:           STAKING_REWARD_MODE_SUPPLY_PERCENTAGE,
+		Rate:           DefaultStakingRewardRate,
+		IntervalBlocks: DefaultStakingRewardInterval,
+		RewardDenom:    DefaultStakingRewardDenom,
+		BlocksPerYear:  DefaultBlocksPerYear,
+	}
 }
 
 // Validate validates the set of params.
 func (p Params) Validate() error {
+	if err := p.TxHistoryRetention.Validate(); err != nil {
+		return err
+	}
+	return p.StakingRewards.Validate()
+}
 
+// Enabled reports whether any retention limit is set.
+func (r TxHistoryRetention) Enabled() bool {
+	return r.MaxAgeBlocks > 0 || r.MaxRecordsPerAddress > 0
+}
+
+// Validate validates the retention limits.
+func (r TxHistoryRetention) Validate() error {
+	if r.Enabled() && r.PruneBudget == 0 {
+		return fmt.Errorf("prune budget must be positive when a retention limit is set")
+	}
 	return nil
 }
+
+// Validate validates the staking reward engine config. A disabled engine is
+// not validated, so it can be left empty.
+func (p StakingRewardParams) Validate() error {
+	if !p.Enabled {
+		return nil
+	}
+	switch p.Mode {
+	case STAKING_REWARD_MODE_SUPPLY_PERCENTAGE, STAKING_REWARD_MODE_TARGET_APR:
+	default:
+		return fmt.Errorf("unsupported staking reward mode %s", p.Mode)
+	}
+	if p.Rate.IsNil() || !p.Rate.IsPositive() || p.Rate.GT(MaxStakingRewardRate) {
+		return fmt.Errorf("staking reward rate must be in (0, %s]", MaxStakingRewardRate)
+	}
+	if p.IntervalBlocks == 0 {
+		return fmt.Errorf("staking reward interval must be positive")
+	}
+	if p.BlocksPerYear < p.IntervalBlocks {
+		return fmt.Errorf("blocks per year must be at least the staking reward interval")
+	}
+	if err := sdk.ValidateDenom(p.RewardDenom); err != nil {
+		return fmt.Errorf("invalid staking reward denom: %w", err)
+	}
+	return nil
+}
+
+// annualBase returns the amount the yearly rate applies to in the mode.
+func (p StakingRewardParams) annualBase(supply, bonded math.Int) math.Int {
+	if p.Mode == STAKING_REWARD_MODE_TARGET_APR {
+		return bonded
+	}
+	return supply
+}
+
+// AnnualRewards returns the yearly rewards of the mode for the given reward
+// denom supply and bonded tokens.
+func (p StakingRewardParams) AnnualRewards(supply, bonded math.Int) math.Int {
+	return p.Rate.MulInt(p.annualBase(supply, bonded)).TruncateInt()
+}
+
+// IntervalRewards returns the rewards targeted for one distribution interval.
+func (p StakingRewardParams) IntervalRewards(supply, bonded math.Int) math.Int {
+	if p.BlocksPerYear == 0 {
+		return math.ZeroInt()
+	}
+	return p.Rate.MulInt(p.annualBase(supply, bonded)).
+		MulInt64(int64(p.IntervalBlocks)).
+		QuoInt64(int64(p.BlocksPerYear)).
+		TruncateInt()
+}
+
+// EffectiveAPR returns the yearly rate stakers earn: the target rate in
+// target-APR mode, and the supply rate scaled by supply over bonded tokens in
+// supply-percentage mode.
+func (p StakingRewardParams) EffectiveAPR(supply, bonded math.Int) math.LegacyDec {
+	if !bonded.IsPositive() {
+		return math.LegacyZeroDec()
+	}
+	if p.Mode == STAKING_REWARD_MODE_TARGET_APR {
+		return p.Rate
+	}
+	return p.Rate.MulInt(supply).QuoInt(bonded)
+}
+
+// NextDistributionHeight returns the first distribution height after height.
+func (p StakingRewardParams) NextDistributionHeight(height int64) int64 {
+	if !p.Enabled || p.IntervalBlocks == 0 {
+		return 0
+	}
+	interval := int64(p.IntervalBlocks)
+	return (height/interval + 1) * interval
+}
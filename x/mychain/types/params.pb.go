@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -24,8 +26,50 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// StakingRewardMode selects how the staking reward engine sizes rewards.
+type StakingRewardMode int32
+
+const (
+	// STAKING_REWARD_MODE_UNSPECIFIED is only valid while the engine is disabled
+	STAKING_REWARD_MODE_UNSPECIFIED StakingRewardMode = 0
+	// STAKING_REWARD_MODE_SUPPLY_PERCENTAGE pays rate times the reward denom
+	// supply per year, so the APR of stakers falls as more is staked
+	STAKING_REWARD_MODE_SUPPLY_PERCENTAGE StakingRewardMode = 1
+	// STAKING_REWARD_MODE_TARGET_APR pays rate times the bonded tokens per
+	// year, so stakers earn rate whatever is staked
+	STAKING_REWARD_MODE_TARGET_APR StakingRewardMode = 2
+)
+
+var StakingRewardMode_name = map[int32]string{
+	0: "STAKING_REWARD_MODE_UNSPECIFIED",
+	1: "STAKING_REWARD_MODE_SUPPLY_PERCENTAGE",
+	2: "STAKING_REWARD_MODE_TARGET_APR",
+}
+
+var StakingRewardMode_value = map[string]int32{
+	"STAKING_REWARD_MODE_UNSPECIFIED":       0,
+	"STAKING_REWARD_MODE_SUPPLY_PERCENTAGE": 1,
+	"STAKING_REWARD_MODE_TARGET_APR":        2,
+}
+
+func (x StakingRewardMode) String() string {
+	return proto.EnumName(StakingRewardMode_name, int32(x))
+}
+
+func (StakingRewardMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_26baec0beea224d3, []int{0}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
+	// disable_onchain_tx_history stops the module from writing transaction
+	// history into consensus state. Records are still emitted as
+	// transaction_record events, which the off-chain tx-indexer consumes.
+	DisableOnchainTxHistory bool `protobuf:"varint,1,opt,name=disable_onchain_tx_history,json=disableOnchainTxHistory,proto3" json:"disable_onchain_tx_history,omitempty"`
+	// tx_history_retention bounds how much history is kept in state
+	TxHistoryRetention TxHistoryRetention `protobuf:"bytes,2,opt,name=tx_history_retention,json=txHistoryRetention,proto3" json:"tx_history_retention"`
+	// staking_rewards configures the module's staking reward engine
+	StakingRewards StakingRewardParams `protobuf:"bytes,3,opt,name=staking_rewards,json=stakingRewards,proto3" json:"staking_rewards"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,24 +105,232 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetDisableOnchainTxHistory() bool {
+	if m != nil {
+		return m.DisableOnchainTxHistory
+	}
+	return false
+}
+
+func (m *Params) GetTxHistoryRetention() TxHistoryRetention {
+	if m != nil {
+		return m.TxHistoryRetention
+	}
+	return TxHistoryRetention{}
+}
+
+func (m *Params) GetStakingRewards() StakingRewardParams {
+	if m != nil {
+		return m.StakingRewards
+	}
+	return StakingRewardParams{}
+}
+
+// TxHistoryRetention defines how long transaction history and staking
+// distribution records are kept in state. Records are pruned in EndBlock and
+// emitted as archive events when they are removed. A zero limit is disabled.
+type TxHistoryRetention struct {
+	// max_age_blocks is the number of blocks a record is kept for
+	MaxAgeBlocks uint64 `protobuf:"varint,1,opt,name=max_age_blocks,json=maxAgeBlocks,proto3" json:"max_age_blocks,omitempty"`
+	// max_records_per_address is the number of records kept per address;
+	// the oldest records are pruned first
+	MaxRecordsPerAddress uint64 `protobuf:"varint,2,opt,name=max_records_per_address,json=maxRecordsPerAddress,proto3" json:"max_records_per_address,omitempty"`
+	// prune_budget is the maximum number of records pruned in one block
+	PruneBudget uint64 `protobuf:"varint,3,opt,name=prune_budget,json=pruneBudget,proto3" json:"prune_budget,omitempty"`
+}
+
+func (m *TxHistoryRetention) Reset()         { *m = TxHistoryRetention{} }
+func (m *TxHistoryRetention) String() string { return proto.CompactTextString(m) }
+func (*TxHistoryRetention) ProtoMessage()    {}
+func (*TxHistoryRetention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26baec0beea224d3, []int{1}
+}
+func (m *TxHistoryRetention) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxHistoryRetention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxHistoryRetention.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxHistoryRetention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxHistoryRetention.Merge(m, src)
+}
+func (m *TxHistoryRetention) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxHistoryRetention) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxHistoryRetention.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxHistoryRetention proto.InternalMessageInfo
+
+func (m *TxHistoryRetention) GetMaxAgeBlocks() uint64 {
+	if m != nil {
+		return m.MaxAgeBlocks
+	}
+	return 0
+}
+
+func (m *TxHistoryRetention) GetMaxRecordsPerAddress() uint64 {
+	if m != nil {
+		return m.MaxRecordsPerAddress
+	}
+	return 0
+}
+
+func (m *TxHistoryRetention) GetPruneBudget() uint64 {
+	if m != nil {
+		return m.PruneBudget
+	}
+	return 0
+}
+
+// StakingRewardParams configures the staking reward engine. Every
+// interval_blocks it tops up what x/mint minted over the interval to the
+// mode's target and sends the top-up to the fee collector, from where
+// x/distribution pays it to stakers. x/mint inflation counts towards the
+// target, so running both never pays more than the target.
+type StakingRewardParams struct {
+	Enabled bool              `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Mode    StakingRewardMode `protobuf:"varint,2,opt,name=mode,proto3,enum=mychain.mychain.v1.StakingRewardMode" json:"mode,omitempty"`
+	// rate is the yearly rate applied to the supply or to the bonded tokens
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+	// interval_blocks is the number of blocks between distributions
+	IntervalBlocks uint64 `protobuf:"varint,4,opt,name=interval_blocks,json=intervalBlocks,proto3" json:"interval_blocks,omitempty"`
+	// reward_denom is the denom rewards are minted in
+	RewardDenom string `protobuf:"bytes,5,opt,name=reward_denom,json=rewardDenom,proto3" json:"reward_denom,omitempty"`
+	// blocks_per_year converts the yearly rate to a per interval amount
+	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
+}
+
+func (m *StakingRewardParams) Reset()         { *m = StakingRewardParams{} }
+func (m *StakingRewardParams) String() string { return proto.CompactTextString(m) }
+func (*StakingRewardParams) ProtoMessage()    {}
+func (*StakingRewardParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26baec0beea224d3, []int{2}
+}
+func (m *StakingRewardParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakingRewardParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakingRewardParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakingRewardParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakingRewardParams.Merge(m, src)
+}
+func (m *StakingRewardParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakingRewardParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakingRewardParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakingRewardParams proto.InternalMessageInfo
+
+func (m *StakingRewardParams) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *StakingRewardParams) GetMode() StakingRewardMode {
+	if m != nil {
+		return m.Mode
+	}
+	return STAKING_REWARD_MODE_UNSPECIFIED
+}
+
+func (m *StakingRewardParams) GetIntervalBlocks() uint64 {
+	if m != nil {
+		return m.IntervalBlocks
+	}
+	return 0
+}
+
+func (m *StakingRewardParams) GetRewardDenom() string {
+	if m != nil {
+		return m.RewardDenom
+	}
+	return ""
+}
+
+func (m *StakingRewardParams) GetBlocksPerYear() uint64 {
+	if m != nil {
+		return m.BlocksPerYear
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("mychain.mychain.v1.StakingRewardMode", StakingRewardMode_name, StakingRewardMode_value)
 	proto.RegisterType((*Params)(nil), "mychain.mychain.v1.Params")
+	proto.RegisterType((*TxHistoryRetention)(nil), "mychain.mychain.v1.TxHistoryRetention")
+	proto.RegisterType((*StakingRewardParams)(nil), "mychain.mychain.v1.StakingRewardParams")
 }
 
 func init() { proto.RegisterFile("mychain/mychain/v1/params.proto", fileDescriptor_26baec0beea224d3) }
 
 var fileDescriptor_26baec0beea224d3 = []byte{
-	// 151 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0xad, 0x4c, 0xce,
-	0x48, 0xcc, 0xcc, 0xd3, 0x87, 0xd1, 0x65, 0x86, 0xfa, 0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x7a,
-	0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x42, 0x50, 0x09, 0x3d, 0x18, 0x5d, 0x66, 0x28, 0x25, 0x98,
-	0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0xca, 0xa4, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1,
-	0x4c, 0x7d, 0x10, 0x0b, 0x22, 0xaa, 0xa4, 0xcd, 0xc5, 0x16, 0x00, 0x36, 0xcc, 0x4a, 0xf1, 0xc5,
-	0x02, 0x79, 0xc6, 0xae, 0xe7, 0x1b, 0xb4, 0x24, 0x60, 0x16, 0x55, 0xc0, 0xad, 0x84, 0x28, 0x71,
-	0x32, 0x3c, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c,
-	0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x71, 0x4c, 0x3d, 0x25,
-	0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0x6b, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x09,
-	0x45, 0xd1, 0x2a, 0xc6, 0x00, 0x00, 0x00,
+	// 652 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x4d, 0x4f, 0x13, 0x4f,
+	0x1c, 0xee, 0xf6, 0xbf, 0x7f, 0x94, 0x01, 0x0b, 0x8c, 0x24, 0xac, 0x35, 0xd9, 0x42, 0x95, 0x17,
+	0x49, 0x6c, 0x03, 0x46, 0x13, 0xf1, 0xb4, 0xa5, 0x2b, 0xa2, 0xbc, 0x6c, 0xa6, 0x25, 0x06, 0x3d,
+	0x4c, 0xa6, 0xbb, 0x93, 0x65, 0x03, 0xbb, 0xd3, 0xcc, 0x2c, 0xd8, 0x7e, 0x03, 0x63, 0x62, 0xe2,
+	0xd9, 0x93, 0x89, 0x17, 0xe3, 0x89, 0x83, 0x1f, 0x82, 0x23, 0xf1, 0x64, 0x3c, 0x10, 0x43, 0x0f,
+	0xf8, 0x31, 0xcc, 0xce, 0xec, 0x22, 0x49, 0x9b, 0x78, 0xe9, 0x74, 0x9e, 0xe7, 0x99, 0xdf, 0xcb,
+	0xf3, 0xfb, 0x2d, 0x28, 0x85, 0x5d, 0x77, 0x8f, 0x04, 0x51, 0x35, 0x3b, 0x8f, 0x96, 0xaa, 0x6d,
+	0xc2, 0x49, 0x28, 0x2a, 0x6d, 0xce, 0x62, 0x06, 0x61, 0x4a, 0x54, 0xb2, 0xf3, 0x68, 0xa9, 0x38,
+	0x41, 0xc2, 0x20, 0x62, 0x55, 0xf9, 0xab, 0x64, 0xc5, 0x5b, 0x2e, 0x13, 0x21, 0x13, 0x58, 0xde,
+	0xaa, 0xea, 0x92, 0x52, 0x93, 0x3e, 0xf3, 0x99, 0xc2, 0x93, 0x7f, 0x0a, 0x2d, 0x7f, 0xcd, 0x83,
+	0x21, 0x47, 0x26, 0x82, 0x4f, 0x40, 0xd1, 0x0b, 0x04, 0x69, 0x1d, 0x50, 0xcc, 0x22, 0x99, 0x04,
+	0xc7, 0x1d, 0xbc, 0x17, 0x88, 0x98, 0xf1, 0xae, 0xa1, 0x4d, 0x6b, 0x0b, 0xd7, 0xd1, 0x54, 0xaa,
+	0xd8, 0x56, 0x82, 0x66, 0xe7, 0x99, 0xa2, 0xa1, 0x0b, 0x26, 0xff, 0x8a, 0x31, 0xa7, 0x31, 0x8d,
+	0xe2, 0x80, 0x45, 0x46, 0x7e, 0x5a, 0x5b, 0x18, 0x59, 0x9e, 0xab, 0xf4, 0x97, 0x5f, 0xb9, 0x7c,
+	0x8c, 0x32, 0x75, 0x6d, 0xf8, 0xe4, 0xac, 0x94, 0xfb, 0x72, 0x71, 0xbc, 0xa8, 0x21, 0x18, 0xf7,
+	0xd1, 0xf0, 0x35, 0x18, 0x13, 0x31, 0xd9, 0x0f, 0x22, 0x1f, 0x73, 0xfa, 0x86, 0x70, 0x4f, 0x18,
+	0xff, 0xc9, 0xf8, 0xf3, 0x83, 0xe2, 0x37, 0x94, 0x14, 0x49, 0xa5, 0xea, 0xf1, 0x6a, 0x82, 0x82,
+	0xb8, 0xca, 0x8b, 0x95, 0x99, 0xdf, 0x9f, 0x4a, 0xda, 0xbb, 0x8b, 0xe3, 0x45, 0x23, 0x9b, 0x41,
+	0xe7, 0x72, 0x1a, 0xea, 0x75, 0xf9, 0xa3, 0x06, 0x60, 0x7f, 0xd5, 0xf0, 0x2e, 0x28, 0x84, 0xa4,
+	0x83, 0x89, 0x4f, 0x71, 0xeb, 0x80, 0xb9, 0xfb, 0x42, 0x9a, 0xa5, 0xa3, 0xd1, 0x90, 0x74, 0x2c,
+	0x9f, 0xd6, 0x24, 0x06, 0x1f, 0x82, 0xa9, 0x44, 0xc5, 0xa9, 0xcb, 0xb8, 0x27, 0x70, 0x9b, 0x72,
+	0x4c, 0x3c, 0x8f, 0x53, 0x21, 0xa4, 0x49, 0x3a, 0x9a, 0x0c, 0x49, 0x07, 0x29, 0xd6, 0xa1, 0xdc,
+	0x52, 0x1c, 0x9c, 0x01, 0xa3, 0x6d, 0x7e, 0x18, 0x51, 0xdc, 0x3a, 0xf4, 0x7c, 0x1a, 0xcb, 0x86,
+	0x75, 0x34, 0x22, 0xb1, 0x9a, 0x84, 0x56, 0xf4, 0xa4, 0xf2, 0xf2, 0x71, 0x1e, 0xdc, 0x1c, 0xd0,
+	0x32, 0x34, 0xc0, 0x35, 0x1a, 0x25, 0x33, 0xf3, 0xd2, 0x19, 0x66, 0x57, 0xf8, 0x18, 0xe8, 0x21,
+	0xf3, 0xa8, 0x4c, 0x5f, 0x58, 0x9e, 0xfd, 0xa7, 0x87, 0x9b, 0xcc, 0xa3, 0x48, 0x3e, 0x81, 0xcf,
+	0x81, 0xce, 0x49, 0x4c, 0x65, 0x35, 0xc3, 0xb5, 0x47, 0x89, 0xab, 0x3f, 0xcf, 0x4a, 0xb7, 0xd5,
+	0xc2, 0x09, 0x6f, 0xbf, 0x12, 0xb0, 0x6a, 0x48, 0xe2, 0xbd, 0xca, 0x06, 0xf5, 0x89, 0xdb, 0xad,
+	0x53, 0xf7, 0xfb, 0xb7, 0xfb, 0x20, 0xdd, 0xc7, 0x3a, 0x75, 0xd5, 0x08, 0x64, 0x0c, 0x38, 0x0f,
+	0xc6, 0x82, 0x28, 0xa6, 0xfc, 0x88, 0x1c, 0x64, 0xfe, 0xe9, 0xb2, 0xc9, 0x42, 0x06, 0xa7, 0x0e,
+	0xce, 0x80, 0x51, 0x35, 0x76, 0xec, 0xd1, 0x88, 0x85, 0xc6, 0xff, 0x49, 0x72, 0x34, 0xa2, 0xb0,
+	0x7a, 0x02, 0xc1, 0x39, 0x30, 0xa6, 0x42, 0x48, 0x7f, 0xbb, 0x94, 0x70, 0x63, 0x48, 0xc6, 0xba,
+	0xa1, 0x60, 0x87, 0xf2, 0x5d, 0x4a, 0xb8, 0xb2, 0x6c, 0xf1, 0xbd, 0x06, 0x26, 0xfa, 0x3a, 0x84,
+	0x77, 0x40, 0xa9, 0xd1, 0xb4, 0x5e, 0xac, 0x6f, 0xad, 0x61, 0x64, 0xbf, 0xb4, 0x50, 0x1d, 0x6f,
+	0x6e, 0xd7, 0x6d, 0xbc, 0xb3, 0xd5, 0x70, 0xec, 0xd5, 0xf5, 0xa7, 0xeb, 0x76, 0x7d, 0x3c, 0x07,
+	0xef, 0x81, 0xd9, 0x41, 0xa2, 0xc6, 0x8e, 0xe3, 0x6c, 0xec, 0x62, 0xc7, 0x46, 0xab, 0xf6, 0x56,
+	0xd3, 0x5a, 0xb3, 0xc7, 0x35, 0x58, 0x06, 0xe6, 0x20, 0x69, 0xd3, 0x42, 0x6b, 0x76, 0x13, 0x5b,
+	0x0e, 0x1a, 0xcf, 0x17, 0xf5, 0xb7, 0x9f, 0xcd, 0x5c, 0x6d, 0xe9, 0xe4, 0xdc, 0xd4, 0x4e, 0xcf,
+	0x4d, 0xed, 0xd7, 0xb9, 0xa9, 0x7d, 0xe8, 0x99, 0xb9, 0xd3, 0x9e, 0x99, 0xfb, 0xd1, 0x33, 0x73,
+	0xaf, 0xa6, 0xfa, 0x77, 0x32, 0xee, 0xb6, 0xa9, 0x68, 0x0d, 0xc9, 0xcf, 0xf8, 0xc1, 0x9f, 0x00,
+	0x00, 0x00, 0xff, 0xff, 0x10, 0x20, 0x50, 0xeb, 0x41, 0x04, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -100,6 +352,84 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.DisableOnchainTxHistory != that1.DisableOnchainTxHistory {
+		return false
+	}
+	if !this.TxHistoryRetention.Equal(&that1.TxHistoryRetention) {
+		return false
+	}
+	if !this.StakingRewards.Equal(&that1.StakingRewards) {
+		return false
+	}
+	return true
+}
+func (this *TxHistoryRetention) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxHistoryRetention)
+	if !ok {
+		that2, ok := that.(TxHistoryRetention)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxAgeBlocks != that1.MaxAgeBlocks {
+		return false
+	}
+	if this.MaxRecordsPerAddress != that1.MaxRecordsPerAddress {
+		return false
+	}
+	if this.PruneBudget != that1.PruneBudget {
+		return false
+	}
+	return true
+}
+func (this *StakingRewardParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StakingRewardParams)
+	if !ok {
+		that2, ok := that.(StakingRewardParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.Mode != that1.Mode {
+		return false
+	}
+	if !this.Rate.Equal(that1.Rate) {
+		return false
+	}
+	if this.IntervalBlocks != that1.IntervalBlocks {
+		return false
+	}
+	if this.RewardDenom != that1.RewardDenom {
+		return false
+	}
+	if this.BlocksPerYear != that1.BlocksPerYear {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -122,6 +452,139 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.StakingRewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.TxHistoryRetention.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.DisableOnchainTxHistory {
+		i--
+		if m.DisableOnchainTxHistory {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxHistoryRetention) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxHistoryRetention) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxHistoryRetention) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PruneBudget != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PruneBudget))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxRecordsPerAddress != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRecordsPerAddress))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxAgeBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAgeBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakingRewardParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakingRewardParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakingRewardParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlocksPerYear != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BlocksPerYear))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.RewardDenom) > 0 {
+		i -= len(m.RewardDenom)
+		copy(dAtA[i:], m.RewardDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.RewardDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.IntervalBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.IntervalBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Mode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -142,6 +605,58 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.DisableOnchainTxHistory {
+		n += 2
+	}
+	l = m.TxHistoryRetention.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.StakingRewards.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *TxHistoryRetention) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxAgeBlocks != 0 {
+		n += 1 + sovParams(uint64(m.MaxAgeBlocks))
+	}
+	if m.MaxRecordsPerAddress != 0 {
+		n += 1 + sovParams(uint64(m.MaxRecordsPerAddress))
+	}
+	if m.PruneBudget != 0 {
+		n += 1 + sovParams(uint64(m.PruneBudget))
+	}
+	return n
+}
+
+func (m *StakingRewardParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.Mode != 0 {
+		n += 1 + sovParams(uint64(m.Mode))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.IntervalBlocks != 0 {
+		n += 1 + sovParams(uint64(m.IntervalBlocks))
+	}
+	l = len(m.RewardDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.BlocksPerYear != 0 {
+		n += 1 + sovParams(uint64(m.BlocksPerYear))
+	}
 	return n
 }
 
@@ -180,6 +695,392 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableOnchainTxHistory", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableOnchainTxHistory = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHistoryRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxHistoryRetention.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxHistoryRetention) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxHistoryRetention: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxHistoryRetention: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAgeBlocks", wireType)
+			}
+			m.MaxAgeBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAgeBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecordsPerAddress", wireType)
+			}
+			m.MaxRecordsPerAddress = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecordsPerAddress |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneBudget", wireType)
+			}
+			m.PruneBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PruneBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakingRewardParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakingRewardParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakingRewardParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= StakingRewardMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalBlocks", wireType)
+			}
+			m.IntervalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
+			}
+			m.BlocksPerYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerYear |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
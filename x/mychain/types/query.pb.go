@@ -5,27 +5,31 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
-	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	types "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -116,10 +120,29 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
-// QueryTransactionHistoryRequest is request type for the Query/TransactionHistory RPC method
+// QueryTransactionHistoryRequest is request type for the Query/TransactionHistory RPC method.
+// All filters are optional and combined with AND.
 type QueryTransactionHistoryRequest struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Limit   uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// limit is only used when pagination is not set. Deprecated: use pagination.
+	Limit uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// types keeps records whose type is one of the given tx types
+	Types []string `protobuf:"bytes,3,rep,name=types,proto3" json:"types,omitempty"`
+	// denom keeps records whose amount contains the denom
+	Denom string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	// min_amount keeps records moving at least this amount of denom, or of any
+	// denom when denom is empty
+	MinAmount *cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=min_amount,json=minAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_amount,omitempty"`
+	// counterparty keeps records sent from or to the given address
+	Counterparty string `protobuf:"bytes,6,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	// min_height and max_height bound the block height, inclusive; 0 is unbounded
+	MinHeight int64 `protobuf:"varint,7,opt,name=min_height,json=minHeight,proto3" json:"min_height,omitempty"`
+	MaxHeight int64 `protobuf:"varint,8,opt,name=max_height,json=maxHeight,proto3" json:"max_height,omitempty"`
+	// start_time and end_time bound the block time, inclusive
+	StartTime *time.Time `protobuf:"bytes,9,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	EndTime   *time.Time `protobuf:"bytes,10,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+	// pagination defaults to the newest records first when unset or empty
+	Pagination *query.PageRequest `protobuf:"bytes,11,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryTransactionHistoryRequest) Reset()         { *m = QueryTransactionHistoryRequest{} }
@@ -169,117 +192,73 @@ func (m *QueryTransactionHistoryRequest) GetLimit() uint64 {
 	return 0
 }
 
-// TransactionRecord represents a transaction history record
-type TransactionRecord struct {
-	TxHash      string                                   `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
-	Type        string                                   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Description string                                   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Amount      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
-	From        string                                   `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
-	To          string                                   `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
-	Height      int64                                    `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
-	Timestamp   string                                   `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-}
-
-func (m *TransactionRecord) Reset()         { *m = TransactionRecord{} }
-func (m *TransactionRecord) String() string { return proto.CompactTextString(m) }
-func (*TransactionRecord) ProtoMessage()    {}
-func (*TransactionRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3cd05483e786d4a, []int{3}
-}
-func (m *TransactionRecord) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *TransactionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_TransactionRecord.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *TransactionRecord) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TransactionRecord.Merge(m, src)
-}
-func (m *TransactionRecord) XXX_Size() int {
-	return m.Size()
-}
-func (m *TransactionRecord) XXX_DiscardUnknown() {
-	xxx_messageInfo_TransactionRecord.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_TransactionRecord proto.InternalMessageInfo
-
-func (m *TransactionRecord) GetTxHash() string {
+func (m *QueryTransactionHistoryRequest) GetTypes() []string {
 	if m != nil {
-		return m.TxHash
+		return m.Types
 	}
-	return ""
+	return nil
 }
 
-func (m *TransactionRecord) GetType() string {
+func (m *QueryTransactionHistoryRequest) GetDenom() string {
 	if m != nil {
-		return m.Type
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *TransactionRecord) GetDescription() string {
+func (m *QueryTransactionHistoryRequest) GetCounterparty() string {
 	if m != nil {
-		return m.Description
+		return m.Counterparty
 	}
 	return ""
 }
 
-func (m *TransactionRecord) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *QueryTransactionHistoryRequest) GetMinHeight() int64 {
 	if m != nil {
-		return m.Amount
+		return m.MinHeight
 	}
-	return nil
+	return 0
 }
 
-func (m *TransactionRecord) GetFrom() string {
+func (m *QueryTransactionHistoryRequest) GetMaxHeight() int64 {
 	if m != nil {
-		return m.From
+		return m.MaxHeight
 	}
-	return ""
+	return 0
 }
 
-func (m *TransactionRecord) GetTo() string {
+func (m *QueryTransactionHistoryRequest) GetStartTime() *time.Time {
 	if m != nil {
-		return m.To
+		return m.StartTime
 	}
-	return ""
+	return nil
 }
 
-func (m *TransactionRecord) GetHeight() int64 {
+func (m *QueryTransactionHistoryRequest) GetEndTime() *time.Time {
 	if m != nil {
-		return m.Height
+		return m.EndTime
 	}
-	return 0
+	return nil
 }
 
-func (m *TransactionRecord) GetTimestamp() string {
+func (m *QueryTransactionHistoryRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.Timestamp
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
 // QueryTransactionHistoryResponse is response type for the Query/TransactionHistory RPC method
 type QueryTransactionHistoryResponse struct {
 	Transactions []TransactionRecord `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryTransactionHistoryResponse) Reset()         { *m = QueryTransactionHistoryResponse{} }
 func (m *QueryTransactionHistoryResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTransactionHistoryResponse) ProtoMessage()    {}
 func (*QueryTransactionHistoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3cd05483e786d4a, []int{4}
+	return fileDescriptor_c3cd05483e786d4a, []int{3}
 }
 func (m *QueryTransactionHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -315,62 +294,76 @@ func (m *QueryTransactionHistoryResponse) GetTransactions() []TransactionRecord
 	return nil
 }
 
+func (m *QueryTransactionHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "mychain.mychain.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "mychain.mychain.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryTransactionHistoryRequest)(nil), "mychain.mychain.v1.QueryTransactionHistoryRequest")
-	proto.RegisterType((*TransactionRecord)(nil), "mychain.mychain.v1.TransactionRecord")
 	proto.RegisterType((*QueryTransactionHistoryResponse)(nil), "mychain.mychain.v1.QueryTransactionHistoryResponse")
 }
 
 func init() { proto.RegisterFile("mychain/mychain/v1/query.proto", fileDescriptor_c3cd05483e786d4a) }
 
 var fileDescriptor_c3cd05483e786d4a = []byte{
-	// 696 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0xd3, 0x24, 0xfd, 0x32, 0xf9, 0x84, 0xd4, 0xa1, 0xa2, 0xc6, 0xaa, 0x9c, 0xc8, 0x12,
-	0x25, 0x2a, 0xd4, 0x6e, 0xd2, 0x0d, 0x3f, 0x62, 0x13, 0x58, 0x94, 0x15, 0xc5, 0x74, 0xc5, 0xa6,
-	0x9a, 0x38, 0x53, 0x7b, 0xd4, 0xda, 0xe3, 0x7a, 0x26, 0x55, 0x23, 0xd4, 0x0d, 0x4f, 0x80, 0xe0,
-	0x09, 0xd8, 0x55, 0xac, 0x58, 0xf2, 0x08, 0xdd, 0x51, 0x89, 0x0d, 0x2b, 0x40, 0x2d, 0x12, 0xaf,
-	0x81, 0xe6, 0xc7, 0xd4, 0x55, 0x12, 0x0a, 0x6c, 0xe2, 0x99, 0x3b, 0xe7, 0x9e, 0x7b, 0xce, 0xbd,
-	0x33, 0x01, 0x76, 0x3c, 0x0a, 0x22, 0x44, 0x12, 0x2f, 0xff, 0xee, 0x77, 0xbc, 0xbd, 0x21, 0xce,
-	0x46, 0x6e, 0x9a, 0x51, 0x4e, 0x21, 0xd4, 0x71, 0x37, 0xff, 0xee, 0x77, 0xac, 0x39, 0x14, 0x93,
-	0x84, 0x7a, 0xf2, 0x57, 0xc1, 0xac, 0xe5, 0x80, 0xb2, 0x98, 0x32, 0xaf, 0x8f, 0x18, 0x56, 0xf9,
-	0xde, 0x7e, 0xa7, 0x8f, 0x39, 0xea, 0x78, 0x29, 0x0a, 0x49, 0x82, 0x38, 0xa1, 0x89, 0xc6, 0xda,
-	0x45, 0x6c, 0x8e, 0x0a, 0x28, 0xc9, 0xcf, 0xe7, 0x43, 0x1a, 0x52, 0xb9, 0xf4, 0xc4, 0x4a, 0x47,
-	0x17, 0x43, 0x4a, 0xc3, 0x5d, 0xec, 0xa1, 0x94, 0x78, 0x28, 0x49, 0x28, 0x97, 0x94, 0x4c, 0x9f,
-	0x36, 0x27, 0xd8, 0x48, 0x51, 0x86, 0xe2, 0x1c, 0xb0, 0x34, 0xcd, 0xe7, 0x16, 0xe3, 0x68, 0x87,
-	0x24, 0xa1, 0xc2, 0x39, 0xf3, 0x00, 0x3e, 0x15, 0xe1, 0x0d, 0x99, 0xec, 0xe3, 0xbd, 0x21, 0x66,
-	0xdc, 0xd9, 0x04, 0x57, 0x2f, 0x44, 0x59, 0x4a, 0x13, 0x86, 0xe1, 0x03, 0x50, 0x53, 0x45, 0x4c,
-	0xa3, 0x65, 0xb4, 0x1b, 0x5d, 0xcb, 0x1d, 0xef, 0x96, 0xab, 0x72, 0x7a, 0xf5, 0xe3, 0x2f, 0xcd,
-	0xd2, 0xd1, 0x8f, 0xf7, 0xcb, 0x86, 0xaf, 0x93, 0x9c, 0x0d, 0x60, 0x4b, 0xd6, 0xcd, 0x0c, 0x25,
-	0x0c, 0x05, 0xc2, 0xcf, 0x3a, 0x61, 0x9c, 0x66, 0x23, 0x5d, 0x17, 0x9a, 0x60, 0x16, 0x0d, 0x06,
-	0x19, 0x66, 0xaa, 0x42, 0xdd, 0xcf, 0xb7, 0x70, 0x1e, 0x54, 0x77, 0x49, 0x4c, 0xb8, 0x59, 0x6e,
-	0x19, 0xed, 0x8a, 0xaf, 0x36, 0xce, 0xdb, 0x32, 0x98, 0x2b, 0xb0, 0xf9, 0x38, 0xa0, 0xd9, 0x00,
-	0x2e, 0x80, 0x59, 0x7e, 0xb0, 0x15, 0x21, 0x16, 0x69, 0x96, 0x1a, 0x3f, 0x58, 0x47, 0x2c, 0x82,
-	0x10, 0x54, 0xf8, 0x28, 0xc5, 0x92, 0xa3, 0xee, 0xcb, 0x35, 0x6c, 0x81, 0xc6, 0x00, 0xb3, 0x20,
-	0x23, 0xa9, 0x60, 0x30, 0x67, 0xe4, 0x51, 0x31, 0x04, 0x03, 0x50, 0x43, 0x31, 0x1d, 0x26, 0xdc,
-	0xac, 0xb4, 0x66, 0xda, 0x8d, 0xee, 0x75, 0x57, 0x0d, 0xd4, 0x15, 0x03, 0x75, 0xf5, 0x40, 0xdd,
-	0x87, 0x94, 0x24, 0xbd, 0x55, 0x61, 0xfa, 0xdd, 0xd7, 0x66, 0x3b, 0x24, 0x3c, 0x1a, 0xf6, 0xdd,
-	0x80, 0xc6, 0x9e, 0x9e, 0xbe, 0xfa, 0xac, 0xb0, 0xc1, 0x8e, 0x27, 0xea, 0x32, 0x99, 0xc0, 0x7c,
-	0x4d, 0x2d, 0xa4, 0x6d, 0x67, 0x34, 0x36, 0xab, 0x4a, 0x9a, 0x58, 0xc3, 0x2b, 0xa0, 0xcc, 0xa9,
-	0x59, 0x93, 0x91, 0x32, 0xa7, 0xf0, 0x1a, 0xa8, 0x45, 0x98, 0x84, 0x11, 0x37, 0x67, 0x5b, 0x46,
-	0x7b, 0xc6, 0xd7, 0x3b, 0xb8, 0x08, 0xea, 0x9c, 0xc4, 0x98, 0x71, 0x14, 0xa7, 0xe6, 0x7f, 0x12,
-	0x7e, 0x1e, 0x70, 0x32, 0xd0, 0x9c, 0xda, 0x75, 0x3d, 0xd7, 0x27, 0xe0, 0x7f, 0x7e, 0x7e, 0x2a,
-	0x7a, 0x2f, 0x7c, 0xde, 0x98, 0x34, 0xdd, 0xb1, 0x6e, 0xf7, 0x2a, 0xc2, 0xb3, 0x7f, 0x81, 0xa0,
-	0x7b, 0x54, 0x05, 0x55, 0x59, 0x14, 0x1e, 0x82, 0x9a, 0xba, 0x10, 0x70, 0x69, 0x12, 0xdd, 0xf8,
-	0xdd, 0xb3, 0x6e, 0x5e, 0x8a, 0x53, 0xaa, 0x1d, 0xe7, 0xe5, 0xa7, 0xef, 0x6f, 0xca, 0x8b, 0xd0,
-	0xf2, 0xa6, 0x3e, 0x06, 0xf8, 0xc1, 0x00, 0x70, 0xdc, 0x38, 0xec, 0x4e, 0xad, 0x31, 0xf5, 0x6e,
-	0x5a, 0x6b, 0x7f, 0x95, 0xa3, 0x35, 0xde, 0x95, 0x1a, 0xd7, 0x60, 0x67, 0x92, 0xc6, 0x42, 0xcb,
-	0x56, 0x22, 0x95, 0xe8, 0xbd, 0xd0, 0x17, 0xfe, 0x10, 0xbe, 0x36, 0x40, 0xe3, 0x99, 0x7a, 0xab,
-	0x8f, 0x93, 0x6d, 0x0a, 0x6f, 0x4d, 0xad, 0x5f, 0x40, 0xe5, 0x62, 0x6f, 0xff, 0x19, 0x58, 0xab,
-	0x6c, 0x4b, 0x95, 0x0e, 0x6c, 0x4d, 0x52, 0xa9, 0xff, 0x2f, 0x56, 0x88, 0x10, 0xf1, 0xd1, 0x00,
-	0x96, 0x66, 0x78, 0x44, 0x18, 0xcf, 0x48, 0x7f, 0x58, 0xec, 0xeb, 0xbd, 0xcb, 0xca, 0x4e, 0x48,
-	0xca, 0x25, 0xdf, 0xff, 0xa7, 0x5c, 0xed, 0xe0, 0x8e, 0x74, 0xd0, 0x85, 0xab, 0xbf, 0x73, 0x30,
-	0x28, 0x10, 0xe4, 0x0d, 0xef, 0x75, 0x8e, 0x4f, 0x6d, 0xe3, 0xe4, 0xd4, 0x36, 0xbe, 0x9d, 0xda,
-	0xc6, 0xab, 0x33, 0xbb, 0x74, 0x72, 0x66, 0x97, 0x3e, 0x9f, 0xd9, 0xa5, 0xe7, 0x0b, 0x39, 0xc5,
-	0xc1, 0x2f, 0x32, 0xf9, 0x72, 0xfb, 0x35, 0xf9, 0xd7, 0xb9, 0xf6, 0x33, 0x00, 0x00, 0xff, 0xff,
-	0x9f, 0x96, 0x2b, 0x5e, 0x4c, 0x06, 0x00, 0x00,
+	// 819 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x41, 0x4f, 0x1b, 0x47,
+	0x14, 0xf6, 0x62, 0x63, 0xf0, 0x18, 0x55, 0xea, 0x94, 0xaa, 0x5b, 0x8b, 0xae, 0xad, 0x45, 0x80,
+	0x05, 0x65, 0xb7, 0x36, 0x97, 0xb6, 0xa8, 0xaa, 0x6a, 0x55, 0x2d, 0x54, 0xaa, 0x4a, 0xb7, 0x9c,
+	0x7a, 0xb1, 0xc6, 0xde, 0x61, 0x3d, 0x82, 0x9d, 0x59, 0x76, 0xc6, 0xc8, 0x56, 0xc5, 0xa5, 0xbf,
+	0x00, 0x25, 0xf7, 0x9c, 0x73, 0xcc, 0x21, 0x87, 0x48, 0xf9, 0x03, 0xe4, 0x14, 0x94, 0x5c, 0xa2,
+	0x1c, 0x48, 0x04, 0x91, 0x72, 0xcd, 0x4f, 0x88, 0x76, 0x66, 0x36, 0xd8, 0xc2, 0xc6, 0x24, 0x17,
+	0x7b, 0xdf, 0x7b, 0xdf, 0xf7, 0xde, 0x37, 0x6f, 0xde, 0x1b, 0x60, 0x85, 0xfd, 0x76, 0x07, 0x11,
+	0xea, 0xa6, 0xff, 0x47, 0x35, 0xf7, 0xb0, 0x8b, 0xe3, 0xbe, 0x13, 0xc5, 0x4c, 0x30, 0x08, 0xb5,
+	0xdf, 0x49, 0xff, 0x8f, 0x6a, 0xa5, 0xcf, 0x51, 0x48, 0x28, 0x73, 0xe5, 0xaf, 0x82, 0x95, 0x56,
+	0xdb, 0x8c, 0x87, 0x8c, 0xbb, 0x2d, 0xc4, 0xb1, 0xe2, 0xbb, 0x47, 0xb5, 0x16, 0x16, 0xa8, 0xe6,
+	0x46, 0x28, 0x20, 0x14, 0x09, 0xc2, 0xa8, 0xc6, 0x7e, 0xad, 0xb0, 0x4d, 0x69, 0xb9, 0xca, 0xd0,
+	0xa1, 0xf9, 0x80, 0x05, 0x4c, 0xf9, 0x93, 0x2f, 0xed, 0x5d, 0x08, 0x18, 0x0b, 0x0e, 0xb0, 0x8b,
+	0x22, 0xe2, 0x22, 0x4a, 0x99, 0x90, 0xd9, 0x52, 0x4e, 0x59, 0x47, 0xa5, 0xd5, 0xea, 0xee, 0xb9,
+	0x82, 0x84, 0x98, 0x0b, 0x14, 0x46, 0x29, 0x60, 0xc4, 0x11, 0x23, 0x14, 0xa3, 0x30, 0xcd, 0xb0,
+	0x3c, 0xae, 0x07, 0x4d, 0x2e, 0xd0, 0x3e, 0xa1, 0x81, 0xc6, 0x2d, 0x8e, 0xc0, 0x89, 0x5e, 0xb3,
+	0x43, 0xb8, 0x60, 0x69, 0xc3, 0xec, 0x79, 0x00, 0xff, 0x4e, 0xb8, 0x3b, 0xb2, 0x82, 0x87, 0x0f,
+	0xbb, 0x98, 0x0b, 0x7b, 0x17, 0x7c, 0x31, 0xe4, 0xe5, 0x11, 0xa3, 0x1c, 0xc3, 0x9f, 0x40, 0x5e,
+	0x29, 0x31, 0x8d, 0x8a, 0x51, 0x2d, 0xd6, 0x4b, 0xce, 0xf5, 0x76, 0x3b, 0x8a, 0xd3, 0x28, 0x9c,
+	0x9e, 0x97, 0x33, 0xf7, 0xdf, 0x3e, 0x58, 0x35, 0x3c, 0x4d, 0xb2, 0xdf, 0x65, 0x81, 0x25, 0xd3,
+	0xee, 0xc6, 0x88, 0x72, 0xd4, 0x4e, 0xda, 0xb2, 0xa5, 0xd4, 0xe8, 0xc2, 0xd0, 0x04, 0x33, 0xc8,
+	0xf7, 0x63, 0xcc, 0x55, 0x89, 0x82, 0x97, 0x9a, 0x70, 0x1e, 0x4c, 0x1f, 0x90, 0x90, 0x08, 0x73,
+	0xaa, 0x62, 0x54, 0x73, 0x9e, 0x32, 0x12, 0xaf, 0xe8, 0x47, 0x98, 0x9b, 0xd9, 0x4a, 0xb6, 0x5a,
+	0xf0, 0x94, 0x91, 0x78, 0x7d, 0x4c, 0x59, 0x68, 0xe6, 0x64, 0x0e, 0x65, 0xc0, 0x3f, 0x00, 0x08,
+	0x09, 0x6d, 0xa2, 0x90, 0x75, 0xa9, 0x30, 0xa7, 0x93, 0x50, 0x63, 0xed, 0xf4, 0xbc, 0x6c, 0xbc,
+	0x3c, 0x2f, 0x7f, 0xa9, 0xee, 0x95, 0xfb, 0xfb, 0x0e, 0x61, 0x6e, 0x88, 0x44, 0xc7, 0xd9, 0xa6,
+	0xe2, 0xd9, 0xc3, 0x75, 0xa0, 0x2f, 0x7c, 0x9b, 0x0a, 0xaf, 0x10, 0x12, 0xfa, 0x8b, 0x64, 0x43,
+	0x1b, 0xcc, 0xb5, 0x93, 0x0f, 0x1c, 0x47, 0x28, 0x16, 0x7d, 0x33, 0x2f, 0x0b, 0x0d, 0xf9, 0xe0,
+	0x37, 0xaa, 0x5e, 0x07, 0x93, 0xa0, 0x23, 0xcc, 0x99, 0x8a, 0x51, 0xcd, 0xca, 0x14, 0x5b, 0xd2,
+	0x21, 0xc3, 0xa8, 0x97, 0x86, 0x67, 0x75, 0x18, 0xf5, 0x74, 0xf8, 0x67, 0x00, 0xb8, 0x40, 0xb1,
+	0x68, 0x26, 0xf3, 0x61, 0x16, 0x74, 0xbf, 0xd5, 0xf0, 0x38, 0xe9, 0xf0, 0x38, 0xbb, 0xe9, 0xf0,
+	0x34, 0x72, 0x27, 0xaf, 0xca, 0x86, 0x57, 0x90, 0x9c, 0xc4, 0x0b, 0x37, 0xc1, 0x2c, 0xa6, 0xbe,
+	0xa2, 0x83, 0x5b, 0xd2, 0x67, 0x30, 0xf5, 0x25, 0xf9, 0x37, 0x00, 0xae, 0x16, 0xc1, 0x2c, 0x4a,
+	0xfa, 0xb2, 0xa3, 0x7b, 0x91, 0x6c, 0x8d, 0xa3, 0xb6, 0x4e, 0x6f, 0x8d, 0xb3, 0x83, 0x02, 0xac,
+	0xef, 0xd0, 0x1b, 0x60, 0xda, 0x8f, 0x0d, 0x50, 0x1e, 0x7b, 0xe5, 0x7a, 0xaa, 0xfe, 0x02, 0x73,
+	0xe2, 0x2a, 0x9a, 0x5c, 0x7c, 0xb6, 0x5a, 0xac, 0x2f, 0x8d, 0x9a, 0xad, 0x81, 0x2c, 0x1e, 0x6e,
+	0xb3, 0xd8, 0x6f, 0xe4, 0x92, 0x31, 0xf3, 0x86, 0x12, 0xc0, 0xdf, 0x87, 0xc4, 0x4f, 0x49, 0xf1,
+	0x2b, 0x13, 0xc5, 0x2b, 0x35, 0x83, 0xea, 0xeb, 0x4f, 0xf2, 0x60, 0x5a, 0xaa, 0x87, 0xc7, 0x20,
+	0xaf, 0xe6, 0x1a, 0x2e, 0x8f, 0xd2, 0x75, 0x7d, 0x85, 0x4a, 0x2b, 0x13, 0x71, 0xaa, 0xa0, 0x6d,
+	0xff, 0xff, 0xfc, 0xcd, 0xdd, 0xa9, 0x05, 0x58, 0x72, 0xc7, 0x2e, 0x3e, 0x7c, 0x64, 0x00, 0x78,
+	0xbd, 0x83, 0xb0, 0x3e, 0xb6, 0xc6, 0xd8, 0x0d, 0x2b, 0x6d, 0x7c, 0x14, 0x47, 0x6b, 0xfc, 0x41,
+	0x6a, 0xdc, 0x80, 0xb5, 0x51, 0x1a, 0x07, 0x7a, 0xbf, 0xae, 0x1f, 0x17, 0xf7, 0x3f, 0xbd, 0xb6,
+	0xc7, 0xf0, 0x8e, 0x01, 0x8a, 0xff, 0xa8, 0x77, 0x69, 0x9b, 0xee, 0x31, 0xb8, 0x36, 0xb6, 0xfe,
+	0x00, 0x2a, 0x15, 0xfb, 0xed, 0xed, 0xc0, 0x5a, 0x65, 0x55, 0xaa, 0xb4, 0x61, 0x65, 0x94, 0x4a,
+	0xfd, 0x36, 0xae, 0x93, 0x44, 0xc4, 0x53, 0x03, 0x94, 0x74, 0x86, 0x5f, 0x09, 0x17, 0x31, 0x69,
+	0x75, 0x07, 0xfb, 0xfa, 0xe3, 0xa4, 0xb2, 0x23, 0x48, 0xa9, 0xe4, 0xcd, 0x4f, 0xe2, 0xea, 0x13,
+	0x7c, 0x2f, 0x4f, 0x50, 0x87, 0xdf, 0xdd, 0x74, 0x02, 0x7f, 0x20, 0x41, 0xda, 0x70, 0x78, 0xcf,
+	0x00, 0x9f, 0xe9, 0x02, 0x7f, 0x62, 0x11, 0x93, 0x36, 0x87, 0xce, 0x24, 0x25, 0x1a, 0x98, 0x2a,
+	0x77, 0x6f, 0x8d, 0xd7, 0x6a, 0xd7, 0xa4, 0xda, 0x25, 0xb8, 0x78, 0x93, 0xda, 0x50, 0x91, 0x1a,
+	0xb5, 0xd3, 0x0b, 0xcb, 0x38, 0xbb, 0xb0, 0x8c, 0xd7, 0x17, 0x96, 0x71, 0x72, 0x69, 0x65, 0xce,
+	0x2e, 0xad, 0xcc, 0x8b, 0x4b, 0x2b, 0xf3, 0xef, 0x57, 0x29, 0xab, 0xf7, 0x81, 0x2f, 0x9f, 0xf1,
+	0x56, 0x5e, 0xbe, 0x53, 0x1b, 0xef, 0x03, 0x00, 0x00, 0xff, 0xff, 0x29, 0xbe, 0x18, 0x3a, 0xf5,
+	0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -393,6 +386,8 @@ type QueryClient interface {
 	StakingInfo(ctx context.Context, in *QueryStakingInfoRequest, opts ...grpc.CallOption) (*QueryStakingInfoResponse, error)
 	// StakingDistributionHistory queries staking distribution history
 	StakingDistributionHistory(ctx context.Context, in *QueryStakingDistributionHistoryRequest, opts ...grpc.CallOption) (*QueryStakingDistributionHistoryResponse, error)
+	// StakingMetrics queries the bonded ratio, inflation and staking yield
+	StakingMetrics(ctx context.Context, in *QueryStakingMetricsRequest, opts ...grpc.CallOption) (*QueryStakingMetricsResponse, error)
 }
 
 type queryClient struct {
@@ -439,6 +434,15 @@ func (c *queryClient) StakingDistributionHistory(ctx context.Context, in *QueryS
 	return out, nil
 }
 
+func (c *queryClient) StakingMetrics(ctx context.Context, in *QueryStakingMetricsRequest, opts ...grpc.CallOption) (*QueryStakingMetricsResponse, error) {
+	out := new(QueryStakingMetricsResponse)
+	err := c.cc.Invoke(ctx, "/mychain.mychain.v1.Query/StakingMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -449,6 +453,8 @@ type QueryServer interface {
 	StakingInfo(context.Context, *QueryStakingInfoRequest) (*QueryStakingInfoResponse, error)
 	// StakingDistributionHistory queries staking distribution history
 	StakingDistributionHistory(context.Context, *QueryStakingDistributionHistoryRequest) (*QueryStakingDistributionHistoryResponse, error)
+	// StakingMetrics queries the bonded ratio, inflation and staking yield
+	StakingMetrics(context.Context, *QueryStakingMetricsRequest) (*QueryStakingMetricsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -467,6 +473,9 @@ func (*UnimplementedQueryServer) StakingInfo(ctx context.Context, req *QueryStak
 func (*UnimplementedQueryServer) StakingDistributionHistory(ctx context.Context, req *QueryStakingDistributionHistoryRequest) (*QueryStakingDistributionHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StakingDistributionHistory not implemented")
 }
+func (*UnimplementedQueryServer) StakingMetrics(ctx context.Context, req *QueryStakingMetricsRequest) (*QueryStakingMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakingMetrics not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -544,6 +553,24 @@ func _Query_StakingDistributionHistory_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_StakingMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakingMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StakingMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mychain.mychain.v1.Query/StakingMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StakingMetrics(ctx, req.(*QueryStakingMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "mychain.mychain.v1.Query",
@@ -565,6 +592,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StakingDistributionHistory",
 			Handler:    _Query_StakingDistributionHistory_Handler,
 		},
+		{
+			MethodName: "StakingMetrics",
+			Handler:    _Query_StakingMetrics_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "mychain/mychain/v1/query.proto",
@@ -646,99 +677,92 @@ func (m *QueryTransactionHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
-	if m.Limit != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x5a
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+	if m.EndTime != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintQuery(dAtA, i, uint64(n3))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x52
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TransactionRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.StartTime != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintQuery(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x4a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TransactionRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TransactionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Timestamp) > 0 {
-		i -= len(m.Timestamp)
-		copy(dAtA[i:], m.Timestamp)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Timestamp)))
+	if m.MaxHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxHeight))
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x40
 	}
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+	if m.MinHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinHeight))
 		i--
 		dAtA[i] = 0x38
 	}
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.To)))
+	if len(m.Counterparty) > 0 {
+		i -= len(m.Counterparty)
+		copy(dAtA[i:], m.Counterparty)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Counterparty)))
 		i--
 		dAtA[i] = 0x32
 	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.From)))
+	if m.MinAmount != nil {
+		{
+			size := m.MinAmount.Size()
+			i -= size
+			if _, err := m.MinAmount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x2a
 	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Types) > 0 {
+		for iNdEx := len(m.Types) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Types[iNdEx])
+			copy(dAtA[i:], m.Types[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Types[iNdEx])))
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -765,6 +789,18 @@ func (m *QueryTransactionHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Transactions) > 0 {
 		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -826,46 +862,40 @@ func (m *QueryTransactionHistoryRequest) Size() (n int) {
 	if m.Limit != 0 {
 		n += 1 + sovQuery(uint64(m.Limit))
 	}
-	return n
-}
-
-func (m *TransactionRecord) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Types) > 0 {
+		for _, s := range m.Types {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.TxHash)
+	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.Type)
-	if l > 0 {
+	if m.MinAmount != nil {
+		l = m.MinAmount.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.Description)
+	l = len(m.Counterparty)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.MinHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MinHeight))
 	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.MaxHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MaxHeight))
 	}
-	l = len(m.To)
-	if l > 0 {
+	if m.StartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.Timestamp)
-	if l > 0 {
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
@@ -883,6 +913,10 @@ func (m *QueryTransactionHistoryResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1105,59 +1139,9 @@ func (m *QueryTransactionHistoryRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TransactionRecord) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TransactionRecord: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransactionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1185,11 +1169,11 @@ func (m *TransactionRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
+			m.Types = append(m.Types, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1217,11 +1201,11 @@ func (m *TransactionRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1249,13 +1233,17 @@ func (m *TransactionRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			var v cosmossdk_io_math.Int
+			m.MinAmount = &v
+			if err := m.MinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1265,31 +1253,29 @@ func (m *TransactionRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Counterparty = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHeight", wireType)
 			}
-			var stringLen uint64
+			m.MinHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1299,29 +1285,35 @@ func (m *TransactionRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MinHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHeight", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.MaxHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1331,29 +1323,33 @@ func (m *TransactionRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1363,16 +1359,33 @@ func (m *TransactionRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1382,23 +1395,27 @@ func (m *TransactionRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Timestamp = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1484,6 +1501,42 @@ func (m *QueryTransactionHistoryResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4,7 +4,11 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -108,13 +112,21 @@ func (m *QueryStakingInfoResponse) GetInfo() *StakingInfo {
 
 // StakingInfo contains current staking information
 type StakingInfo struct {
-	TotalSupply            string `protobuf:"bytes,1,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
-	TotalStaked            string `protobuf:"bytes,2,opt,name=total_staked,json=totalStaked,proto3" json:"total_staked,omitempty"`
-	EffectiveApr           string `protobuf:"bytes,3,opt,name=effective_apr,json=effectiveApr,proto3" json:"effective_apr,omitempty"`
-	AnnualRewards          string `protobuf:"bytes,4,opt,name=annual_rewards,json=annualRewards,proto3" json:"annual_rewards,omitempty"`
-	HourlyRewards          string `protobuf:"bytes,5,opt,name=hourly_rewards,json=hourlyRewards,proto3" json:"hourly_rewards,omitempty"`
-	NumDelegators          int64  `protobuf:"varint,6,opt,name=num_delegators,json=numDelegators,proto3" json:"num_delegators,omitempty"`
-	NextDistributionHeight int64  `protobuf:"varint,7,opt,name=next_distribution_height,json=nextDistributionHeight,proto3" json:"next_distribution_height,omitempty"`
+	TotalSupply   string `protobuf:"bytes,1,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
+	TotalStaked   string `protobuf:"bytes,2,opt,name=total_staked,json=totalStaked,proto3" json:"total_staked,omitempty"`
+	EffectiveApr  string `protobuf:"bytes,3,opt,name=effective_apr,json=effectiveApr,proto3" json:"effective_apr,omitempty"`
+	AnnualRewards string `protobuf:"bytes,4,opt,name=annual_rewards,json=annualRewards,proto3" json:"annual_rewards,omitempty"`
+	HourlyRewards string `protobuf:"bytes,5,opt,name=hourly_rewards,json=hourlyRewards,proto3" json:"hourly_rewards,omitempty"`
+	NumDelegators int64  `protobuf:"varint,6,opt,name=num_delegators,json=numDelegators,proto3" json:"num_delegators,omitempty"`
+	// next_distribution_height is 0 while the reward engine is disabled
+	NextDistributionHeight int64 `protobuf:"varint,7,opt,name=next_distribution_height,json=nextDistributionHeight,proto3" json:"next_distribution_height,omitempty"`
+	// enabled and mode mirror the staking reward params
+	Enabled bool              `protobuf:"varint,8,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Mode    StakingRewardMode `protobuf:"varint,9,opt,name=mode,proto3,enum=mychain.mychain.v1.StakingRewardMode" json:"mode,omitempty"`
+	// interval_rewards is the target paid per distribution interval;
+	// hourly_rewards holds the same amount for older clients
+	IntervalRewards string `protobuf:"bytes,10,opt,name=interval_rewards,json=intervalRewards,proto3" json:"interval_rewards,omitempty"`
+	IntervalBlocks  uint64 `protobuf:"varint,11,opt,name=interval_blocks,json=intervalBlocks,proto3" json:"interval_blocks,omitempty"`
 }
 
 func (m *StakingInfo) Reset()         { *m = StakingInfo{} }
@@ -199,10 +211,218 @@ func (m *StakingInfo) GetNextDistributionHeight() int64 {
 	return 0
 }
 
+func (m *StakingInfo) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *StakingInfo) GetMode() StakingRewardMode {
+	if m != nil {
+		return m.Mode
+	}
+	return STAKING_REWARD_MODE_UNSPECIFIED
+}
+
+func (m *StakingInfo) GetIntervalRewards() string {
+	if m != nil {
+		return m.IntervalRewards
+	}
+	return ""
+}
+
+func (m *StakingInfo) GetIntervalBlocks() uint64 {
+	if m != nil {
+		return m.IntervalBlocks
+	}
+	return 0
+}
+
+// QueryStakingMetricsRequest is request type for the Query/StakingMetrics RPC method.
+type QueryStakingMetricsRequest struct {
+}
+
+func (m *QueryStakingMetricsRequest) Reset()         { *m = QueryStakingMetricsRequest{} }
+func (m *QueryStakingMetricsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStakingMetricsRequest) ProtoMessage()    {}
+func (*QueryStakingMetricsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f8e314410a26b97, []int{3}
+}
+func (m *QueryStakingMetricsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakingMetricsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakingMetricsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStakingMetricsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakingMetricsRequest.Merge(m, src)
+}
+func (m *QueryStakingMetricsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakingMetricsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakingMetricsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStakingMetricsRequest proto.InternalMessageInfo
+
+// QueryStakingMetricsResponse is response type for the Query/StakingMetrics RPC method.
+type QueryStakingMetricsResponse struct {
+	Metrics StakingMetrics `protobuf:"bytes,1,opt,name=metrics,proto3" json:"metrics"`
+}
+
+func (m *QueryStakingMetricsResponse) Reset()         { *m = QueryStakingMetricsResponse{} }
+func (m *QueryStakingMetricsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStakingMetricsResponse) ProtoMessage()    {}
+func (*QueryStakingMetricsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f8e314410a26b97, []int{4}
+}
+func (m *QueryStakingMetricsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakingMetricsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakingMetricsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStakingMetricsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakingMetricsResponse.Merge(m, src)
+}
+func (m *QueryStakingMetricsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakingMetricsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakingMetricsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStakingMetricsResponse proto.InternalMessageInfo
+
+func (m *QueryStakingMetricsResponse) GetMetrics() StakingMetrics {
+	if m != nil {
+		return m.Metrics
+	}
+	return StakingMetrics{}
+}
+
+// StakingMetrics contains the staking and inflation rates of the chain. Coin
+// amounts are in base units; the display fields convert them with the
+// denom's bank metadata. Rates are yearly fractions, so 0.2 is 20%.
+type StakingMetrics struct {
+	// total_supply is the supply of the bond denom
+	TotalSupply types.Coin `protobuf:"bytes,1,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply"`
+	TotalBonded types.Coin `protobuf:"bytes,2,opt,name=total_bonded,json=totalBonded,proto3" json:"total_bonded"`
+	// display_denom and display_exponent describe the display unit of the
+	// bond denom
+	DisplayDenom       string                      `protobuf:"bytes,3,opt,name=display_denom,json=displayDenom,proto3" json:"display_denom,omitempty"`
+	DisplayExponent    uint32                      `protobuf:"varint,4,opt,name=display_exponent,json=displayExponent,proto3" json:"display_exponent,omitempty"`
+	TotalSupplyDisplay cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=total_supply_display,json=totalSupplyDisplay,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"total_supply_display"`
+	TotalBondedDisplay cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=total_bonded_display,json=totalBondedDisplay,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"total_bonded_display"`
+	BondedRatio        cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=bonded_ratio,json=bondedRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonded_ratio"`
+	// mint_inflation is the current x/mint inflation
+	MintInflation cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=mint_inflation,json=mintInflation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"mint_inflation"`
+	// dex_reward_apr is the APR the dex pays on liquidity in orders. It is not
+	// a share of supply and is not part of total_inflation.
+	DexRewardApr cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=dex_reward_apr,json=dexRewardApr,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"dex_reward_apr"`
+	// engine_apr is the APR targeted by the staking reward engine, zero while
+	// it is disabled
+	EngineApr cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=engine_apr,json=engineApr,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"engine_apr"`
+	// staking_yield is the nominal yield of bonded tokens: the x/mint
+	// inflation paid to stakers, topped up to engine_apr when the engine is
+	// enabled
+	StakingYield cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=staking_yield,json=stakingYield,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"staking_yield"`
+	// total_inflation is the supply growth from staking rewards
+	TotalInflation cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=total_inflation,json=totalInflation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"total_inflation"`
+	// real_yield is staking_yield net of total_inflation:
+	// (1 + staking_yield) / (1 + total_inflation) - 1
+	RealYield cosmossdk_io_math.LegacyDec `protobuf:"bytes,13,opt,name=real_yield,json=realYield,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"real_yield"`
+}
+
+func (m *StakingMetrics) Reset()         { *m = StakingMetrics{} }
+func (m *StakingMetrics) String() string { return proto.CompactTextString(m) }
+func (*StakingMetrics) ProtoMessage()    {}
+func (*StakingMetrics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f8e314410a26b97, []int{5}
+}
+func (m *StakingMetrics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakingMetrics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakingMetrics.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakingMetrics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakingMetrics.Merge(m, src)
+}
+func (m *StakingMetrics) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakingMetrics) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakingMetrics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakingMetrics proto.InternalMessageInfo
+
+func (m *StakingMetrics) GetTotalSupply() types.Coin {
+	if m != nil {
+		return m.TotalSupply
+	}
+	return types.Coin{}
+}
+
+func (m *StakingMetrics) GetTotalBonded() types.Coin {
+	if m != nil {
+		return m.TotalBonded
+	}
+	return types.Coin{}
+}
+
+func (m *StakingMetrics) GetDisplayDenom() string {
+	if m != nil {
+		return m.DisplayDenom
+	}
+	return ""
+}
+
+func (m *StakingMetrics) GetDisplayExponent() uint32 {
+	if m != nil {
+		return m.DisplayExponent
+	}
+	return 0
+}
+
 // QueryStakingDistributionHistoryRequest is request type for the Query/StakingDistributionHistory RPC method.
 type QueryStakingDistributionHistoryRequest struct {
 	Limit  uint64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
 	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// before_height, if set, only returns distributions recorded below this
+	// height. Paging on the last height returned stays stable while new
+	// distributions are recorded, which offsets do not.
+	BeforeHeight int64 `protobuf:"varint,3,opt,name=before_height,json=beforeHeight,proto3" json:"before_height,omitempty"`
 }
 
 func (m *QueryStakingDistributionHistoryRequest) Reset() {
@@ -211,7 +431,7 @@ func (m *QueryStakingDistributionHistoryRequest) Reset() {
 func (m *QueryStakingDistributionHistoryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryStakingDistributionHistoryRequest) ProtoMessage()    {}
 func (*QueryStakingDistributionHistoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3f8e314410a26b97, []int{3}
+	return fileDescriptor_3f8e314410a26b97, []int{6}
 }
 func (m *QueryStakingDistributionHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -254,6 +474,13 @@ func (m *QueryStakingDistributionHistoryRequest) GetOffset() uint64 {
 	return 0
 }
 
+func (m *QueryStakingDistributionHistoryRequest) GetBeforeHeight() int64 {
+	if m != nil {
+		return m.BeforeHeight
+	}
+	return 0
+}
+
 // QueryStakingDistributionHistoryResponse is response type for the Query/StakingDistributionHistory RPC method.
 type QueryStakingDistributionHistoryResponse struct {
 	Distributions []*StakingDistributionRecord `protobuf:"bytes,1,rep,name=distributions,proto3" json:"distributions,omitempty"`
@@ -266,7 +493,7 @@ func (m *QueryStakingDistributionHistoryResponse) Reset() {
 func (m *QueryStakingDistributionHistoryResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryStakingDistributionHistoryResponse) ProtoMessage()    {}
 func (*QueryStakingDistributionHistoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3f8e314410a26b97, []int{4}
+	return fileDescriptor_3f8e314410a26b97, []int{7}
 }
 func (m *QueryStakingDistributionHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -311,20 +538,24 @@ func (m *QueryStakingDistributionHistoryResponse) GetTotal() uint64 {
 
 // StakingDistributionRecord represents a staking reward distribution event
 type StakingDistributionRecord struct {
-	Height             int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
-	Timestamp          int64  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	TotalSupply        string `protobuf:"bytes,3,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
-	TotalStaked        string `protobuf:"bytes,4,opt,name=total_staked,json=totalStaked,proto3" json:"total_staked,omitempty"`
-	RewardsDistributed string `protobuf:"bytes,5,opt,name=rewards_distributed,json=rewardsDistributed,proto3" json:"rewards_distributed,omitempty"`
-	EffectiveApr       string `protobuf:"bytes,6,opt,name=effective_apr,json=effectiveApr,proto3" json:"effective_apr,omitempty"`
-	NumDelegators      int64  `protobuf:"varint,7,opt,name=num_delegators,json=numDelegators,proto3" json:"num_delegators,omitempty"`
+	Height             int64             `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Timestamp          int64             `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	TotalSupply        string            `protobuf:"bytes,3,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
+	TotalStaked        string            `protobuf:"bytes,4,opt,name=total_staked,json=totalStaked,proto3" json:"total_staked,omitempty"`
+	RewardsDistributed string            `protobuf:"bytes,5,opt,name=rewards_distributed,json=rewardsDistributed,proto3" json:"rewards_distributed,omitempty"`
+	EffectiveApr       string            `protobuf:"bytes,6,opt,name=effective_apr,json=effectiveApr,proto3" json:"effective_apr,omitempty"`
+	NumDelegators      int64             `protobuf:"varint,7,opt,name=num_delegators,json=numDelegators,proto3" json:"num_delegators,omitempty"`
+	Mode               StakingRewardMode `protobuf:"varint,8,opt,name=mode,proto3,enum=mychain.mychain.v1.StakingRewardMode" json:"mode,omitempty"`
+	// minted is the part of rewards_distributed minted by the reward engine;
+	// the rest was minted by x/mint over the interval
+	Minted string `protobuf:"bytes,9,opt,name=minted,proto3" json:"minted,omitempty"`
 }
 
 func (m *StakingDistributionRecord) Reset()         { *m = StakingDistributionRecord{} }
 func (m *StakingDistributionRecord) String() string { return proto.CompactTextString(m) }
 func (*StakingDistributionRecord) ProtoMessage()    {}
 func (*StakingDistributionRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3f8e314410a26b97, []int{5}
+	return fileDescriptor_3f8e314410a26b97, []int{8}
 }
 func (m *StakingDistributionRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -402,10 +633,27 @@ func (m *StakingDistributionRecord) GetNumDelegators() int64 {
 	return 0
 }
 
+func (m *StakingDistributionRecord) GetMode() StakingRewardMode {
+	if m != nil {
+		return m.Mode
+	}
+	return STAKING_REWARD_MODE_UNSPECIFIED
+}
+
+func (m *StakingDistributionRecord) GetMinted() string {
+	if m != nil {
+		return m.Minted
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryStakingInfoRequest)(nil), "mychain.mychain.v1.QueryStakingInfoRequest")
 	proto.RegisterType((*QueryStakingInfoResponse)(nil), "mychain.mychain.v1.QueryStakingInfoResponse")
 	proto.RegisterType((*StakingInfo)(nil), "mychain.mychain.v1.StakingInfo")
+	proto.RegisterType((*QueryStakingMetricsRequest)(nil), "mychain.mychain.v1.QueryStakingMetricsRequest")
+	proto.RegisterType((*QueryStakingMetricsResponse)(nil), "mychain.mychain.v1.QueryStakingMetricsResponse")
+	proto.RegisterType((*StakingMetrics)(nil), "mychain.mychain.v1.StakingMetrics")
 	proto.RegisterType((*QueryStakingDistributionHistoryRequest)(nil), "mychain.mychain.v1.QueryStakingDistributionHistoryRequest")
 	proto.RegisterType((*QueryStakingDistributionHistoryResponse)(nil), "mychain.mychain.v1.QueryStakingDistributionHistoryResponse")
 	proto.RegisterType((*StakingDistributionRecord)(nil), "mychain.mychain.v1.StakingDistributionRecord")
@@ -416,40 +664,71 @@ func init() {
 }
 
 var fileDescriptor_3f8e314410a26b97 = []byte{
-	// 527 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xb1, 0x6e, 0xdb, 0x30,
-	0x10, 0xb5, 0x6c, 0xc7, 0x41, 0xe8, 0xb8, 0x03, 0x1b, 0x24, 0x4a, 0x10, 0xa8, 0xae, 0x8a, 0xa6,
-	0x5e, 0x6a, 0xc1, 0xc9, 0xd2, 0xb5, 0x85, 0x87, 0x76, 0x2a, 0x2a, 0x03, 0x1d, 0xba, 0x08, 0x8c,
-	0x45, 0xc9, 0x44, 0x24, 0x52, 0x21, 0x4f, 0x6e, 0xf4, 0x17, 0x1d, 0xf2, 0x0f, 0xfd, 0x95, 0x8e,
-	0x19, 0x3b, 0x16, 0xf6, 0x8f, 0x14, 0xa2, 0x68, 0x5b, 0xa9, 0xd3, 0x64, 0x12, 0xef, 0xbd, 0xc7,
-	0x87, 0xf3, 0xdd, 0x33, 0xd1, 0x59, 0x5a, 0x4c, 0x67, 0x84, 0x71, 0x6f, 0xf5, 0x9d, 0x8f, 0xbc,
-	0xeb, 0x9c, 0xca, 0x22, 0x50, 0x40, 0xae, 0x18, 0x8f, 0x87, 0x99, 0x14, 0x20, 0x30, 0x36, 0xfc,
-	0x70, 0xf5, 0x9d, 0x8f, 0x4e, 0x4e, 0x63, 0x21, 0xe2, 0x84, 0x7a, 0x24, 0x63, 0x1e, 0xe1, 0x5c,
-	0x00, 0x01, 0x26, 0xb8, 0xaa, 0x6e, 0x9c, 0x1c, 0xc4, 0x22, 0x16, 0xfa, 0xe8, 0x95, 0xa7, 0x0a,
-	0x75, 0x8f, 0xd1, 0xd1, 0x97, 0xd2, 0x7e, 0x52, 0xb9, 0x7f, 0xe2, 0x91, 0xf0, 0xe9, 0x75, 0x4e,
-	0x15, 0xb8, 0x9f, 0x91, 0xbd, 0x4d, 0xa9, 0x4c, 0x70, 0x45, 0xf1, 0x05, 0x6a, 0x33, 0x1e, 0x09,
-	0xdb, 0xea, 0x5b, 0x83, 0xee, 0xf9, 0x8b, 0xe1, 0x76, 0x37, 0xc3, 0xfa, 0x35, 0x2d, 0x76, 0x7f,
-	0x36, 0x51, 0xb7, 0x86, 0xe2, 0x97, 0x68, 0x1f, 0x04, 0x90, 0x24, 0x50, 0x79, 0x96, 0x25, 0x85,
-	0x36, 0xdb, 0xf3, 0xbb, 0x1a, 0x9b, 0x68, 0xa8, 0x26, 0x01, 0x72, 0x45, 0x43, 0xbb, 0x59, 0x97,
-	0x68, 0x08, 0xbf, 0x42, 0x3d, 0x1a, 0x45, 0x74, 0x0a, 0x6c, 0x4e, 0x03, 0x92, 0x49, 0xbb, 0xa5,
-	0x35, 0xfb, 0x6b, 0xf0, 0x7d, 0x26, 0xf1, 0x6b, 0xf4, 0x8c, 0x70, 0x9e, 0x93, 0x24, 0x90, 0xf4,
-	0x3b, 0x91, 0xa1, 0xb2, 0xdb, 0x5a, 0xd5, 0xab, 0x50, 0xbf, 0x02, 0x4b, 0xd9, 0x4c, 0xe4, 0x32,
-	0x29, 0xd6, 0xb2, 0x9d, 0x4a, 0x56, 0xa1, 0x35, 0x19, 0xcf, 0xd3, 0x20, 0xa4, 0x09, 0x8d, 0x09,
-	0x08, 0xa9, 0xec, 0x4e, 0xdf, 0x1a, 0xb4, 0xfc, 0x1e, 0xcf, 0xd3, 0xf1, 0x1a, 0xc4, 0xef, 0x90,
-	0xcd, 0xe9, 0x0d, 0x04, 0x21, 0x53, 0x20, 0xd9, 0x65, 0x5e, 0x6e, 0x23, 0x98, 0x51, 0x16, 0xcf,
-	0xc0, 0xde, 0xd5, 0x17, 0x0e, 0x4b, 0x7e, 0x5c, 0xa3, 0x3f, 0x6a, 0xd6, 0xfd, 0x8a, 0xce, 0xea,
-	0xa3, 0xbf, 0xa7, 0x60, 0x0a, 0x84, 0x2c, 0xcc, 0x92, 0xf0, 0x01, 0xda, 0x49, 0x58, 0xca, 0x40,
-	0x0f, 0xaf, 0xed, 0x57, 0x05, 0x3e, 0x44, 0x1d, 0x11, 0x45, 0x8a, 0x82, 0x1e, 0x58, 0xdb, 0x37,
-	0x95, 0x7b, 0x6b, 0xa1, 0x37, 0x4f, 0x1a, 0x9b, 0x15, 0x4f, 0x50, 0xaf, 0xde, 0xb8, 0xb2, 0xad,
-	0x7e, 0x6b, 0xd0, 0x3d, 0x7f, 0xfb, 0xc8, 0xae, 0xeb, 0x76, 0x3e, 0x9d, 0x0a, 0x19, 0xfa, 0xf7,
-	0x3d, 0xca, 0x76, 0xf5, 0xee, 0x4c, 0x5f, 0x55, 0xe1, 0xde, 0x36, 0xd1, 0xf1, 0x7f, 0x2d, 0xca,
-	0x1f, 0x63, 0x86, 0x66, 0xe9, 0xa1, 0x99, 0x0a, 0x9f, 0xa2, 0x3d, 0x60, 0x29, 0x55, 0x40, 0xd2,
-	0x4c, 0xfb, 0xb5, 0xfc, 0x0d, 0xb0, 0x15, 0xae, 0xd6, 0xd3, 0xe1, 0x6a, 0x6f, 0x87, 0xcb, 0x43,
-	0xcf, 0x4d, 0x12, 0x36, 0x5b, 0xa4, 0xa1, 0x49, 0x05, 0x36, 0xd4, 0x78, 0xc3, 0x6c, 0xa7, 0xb1,
-	0xf3, 0x70, 0x1a, 0xff, 0xc9, 0xcf, 0xee, 0x03, 0xf9, 0xf9, 0x30, 0xfa, 0xb5, 0x70, 0xac, 0xbb,
-	0x85, 0x63, 0xfd, 0x59, 0x38, 0xd6, 0x8f, 0xa5, 0xd3, 0xb8, 0x5b, 0x3a, 0x8d, 0xdf, 0x4b, 0xa7,
-	0xf1, 0xed, 0x68, 0xf5, 0x3a, 0xdc, 0xac, 0xdf, 0x09, 0x28, 0x32, 0xaa, 0x2e, 0x3b, 0xfa, 0x5f,
-	0x7d, 0xf1, 0x37, 0x00, 0x00, 0xff, 0xff, 0xd9, 0xa9, 0x53, 0x26, 0x47, 0x04, 0x00, 0x00,
+	// 1017 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0x36, 0x23, 0x45, 0x96, 0x56, 0x1f, 0x7e, 0xdf, 0xad, 0xe1, 0xd0, 0x8e, 0x21, 0xab, 0x2a,
+	0x92, 0xa8, 0x05, 0x22, 0x42, 0xce, 0xa5, 0x3d, 0x56, 0x55, 0x91, 0x06, 0x68, 0xd0, 0x94, 0x2e,
+	0xfa, 0x91, 0x0b, 0xb1, 0x22, 0x47, 0xd2, 0xc2, 0xe4, 0x2e, 0x43, 0xae, 0x54, 0x09, 0xfd, 0x13,
+	0x3d, 0xf4, 0xda, 0x7b, 0x8f, 0x3d, 0xf4, 0x47, 0xe4, 0x68, 0xf4, 0x54, 0xf4, 0x10, 0x14, 0xf6,
+	0x21, 0x7f, 0xa3, 0xd8, 0x0f, 0x4a, 0x74, 0xa5, 0x3a, 0x88, 0x7a, 0xb1, 0x3c, 0xcf, 0xcc, 0x3c,
+	0xbb, 0xb3, 0x9c, 0x7d, 0x66, 0xd1, 0xfd, 0x68, 0xe1, 0x4f, 0x08, 0x65, 0x4e, 0xf6, 0x3b, 0xeb,
+	0x39, 0x2f, 0xa6, 0x90, 0x2c, 0xbc, 0x54, 0x90, 0x73, 0xca, 0xc6, 0xdd, 0x38, 0xe1, 0x82, 0x63,
+	0x6c, 0xfc, 0xdd, 0xec, 0x77, 0xd6, 0x3b, 0xfa, 0x3f, 0x89, 0x28, 0xe3, 0x8e, 0xfa, 0xab, 0xc3,
+	0x8e, 0x9a, 0x3e, 0x4f, 0x23, 0x9e, 0x3a, 0x43, 0x92, 0x82, 0x33, 0xeb, 0x0d, 0x41, 0x90, 0x9e,
+	0xe3, 0x73, 0xca, 0x8c, 0xff, 0x50, 0xfb, 0x3d, 0x65, 0x39, 0xda, 0x30, 0xae, 0xe3, 0x31, 0xe7,
+	0xe3, 0x10, 0x1c, 0x12, 0x53, 0x87, 0x30, 0xc6, 0x05, 0x11, 0x94, 0xb3, 0xcc, 0xbb, 0x3f, 0xe6,
+	0x63, 0xae, 0xb3, 0xe4, 0x7f, 0x06, 0x3d, 0xd9, 0xb0, 0xfb, 0x98, 0x24, 0x24, 0x32, 0x69, 0xed,
+	0x43, 0x74, 0xe7, 0x4b, 0x59, 0xcd, 0x99, 0x2e, 0xe6, 0x09, 0x1b, 0x71, 0x17, 0x5e, 0x4c, 0x21,
+	0x15, 0xed, 0x2f, 0x90, 0xbd, 0xee, 0x4a, 0x63, 0xce, 0x52, 0xc0, 0x8f, 0x50, 0x91, 0xb2, 0x11,
+	0xb7, 0xad, 0x96, 0xd5, 0xa9, 0x9e, 0x9e, 0x74, 0xd7, 0x8b, 0xef, 0xe6, 0xd3, 0x54, 0x70, 0xfb,
+	0xa2, 0x80, 0xaa, 0x39, 0x14, 0xbf, 0x8b, 0x6a, 0x82, 0x0b, 0x12, 0x7a, 0xe9, 0x34, 0x8e, 0xc3,
+	0x85, 0x22, 0xab, 0xb8, 0x55, 0x85, 0x9d, 0x29, 0x28, 0x17, 0x22, 0xc8, 0x39, 0x04, 0xf6, 0xad,
+	0x7c, 0x88, 0x82, 0xf0, 0x7b, 0xa8, 0x0e, 0xa3, 0x11, 0xf8, 0x82, 0xce, 0xc0, 0x23, 0x71, 0x62,
+	0x17, 0x54, 0x4c, 0x6d, 0x09, 0x7e, 0x1c, 0x27, 0xf8, 0x1e, 0x6a, 0x10, 0xc6, 0xa6, 0x24, 0xf4,
+	0x12, 0xf8, 0x9e, 0x24, 0x41, 0x6a, 0x17, 0x55, 0x54, 0x5d, 0xa3, 0xae, 0x06, 0x65, 0xd8, 0x84,
+	0x4f, 0x93, 0x70, 0xb1, 0x0c, 0xbb, 0xad, 0xc3, 0x34, 0x9a, 0x0b, 0x63, 0xd3, 0xc8, 0x0b, 0x20,
+	0x84, 0x31, 0x11, 0x3c, 0x49, 0xed, 0x52, 0xcb, 0xea, 0x14, 0xdc, 0x3a, 0x9b, 0x46, 0x83, 0x25,
+	0x88, 0x3f, 0x44, 0x36, 0x83, 0xb9, 0xf0, 0x02, 0x9a, 0x8a, 0x84, 0x0e, 0xa7, 0xf2, 0x73, 0x79,
+	0x13, 0xa0, 0xe3, 0x89, 0xb0, 0x77, 0x55, 0xc2, 0x81, 0xf4, 0x0f, 0x72, 0xee, 0xcf, 0x94, 0x17,
+	0xdb, 0x68, 0x17, 0x18, 0x19, 0x86, 0x10, 0xd8, 0xe5, 0x96, 0xd5, 0x29, 0xbb, 0x99, 0x89, 0x3f,
+	0x42, 0xc5, 0x88, 0x07, 0x60, 0x57, 0x5a, 0x56, 0xa7, 0x71, 0x7a, 0xef, 0x86, 0x83, 0xd7, 0x9b,
+	0x7d, 0xca, 0x03, 0x70, 0x55, 0x0a, 0x7e, 0x1f, 0xfd, 0x8f, 0x32, 0x01, 0xc9, 0x2c, 0x77, 0x0a,
+	0x48, 0x95, 0xb7, 0x97, 0xe1, 0x59, 0x81, 0x0f, 0xd0, 0x12, 0xf2, 0x86, 0x21, 0xf7, 0xcf, 0x53,
+	0xbb, 0xda, 0xb2, 0x3a, 0x45, 0xb7, 0x91, 0xc1, 0x7d, 0x85, 0xb6, 0x8f, 0xd1, 0x51, 0xbe, 0x47,
+	0x9e, 0x82, 0x48, 0xa8, 0x9f, 0x66, 0x1d, 0x34, 0x42, 0x77, 0x37, 0x7a, 0x4d, 0x13, 0x3d, 0x46,
+	0xbb, 0x91, 0x86, 0x4c, 0x1f, 0xb5, 0x6f, 0x28, 0xc7, 0x24, 0xf7, 0x2b, 0x2f, 0x5f, 0x9d, 0xec,
+	0xfc, 0xf2, 0xfa, 0xd7, 0x0f, 0x2c, 0x37, 0xcb, 0x6e, 0xff, 0x5c, 0x46, 0x8d, 0xeb, 0x61, 0xf8,
+	0xf1, 0x86, 0xde, 0xaa, 0x9e, 0x1e, 0x76, 0xcd, 0x8d, 0x92, 0xd7, 0xaf, 0x6b, 0xae, 0x5f, 0xf7,
+	0x13, 0x4e, 0x59, 0x9e, 0xf7, 0x5a, 0x07, 0x2e, 0x89, 0x86, 0x9c, 0x05, 0xa6, 0x03, 0xdf, 0x8e,
+	0xa8, 0xaf, 0x12, 0x65, 0x9f, 0x06, 0x34, 0x8d, 0x43, 0xb2, 0xf0, 0x02, 0x60, 0x3c, 0xca, 0xfa,
+	0xd4, 0x80, 0x03, 0x89, 0xc9, 0x6f, 0x94, 0x05, 0xc1, 0x3c, 0xe6, 0x0c, 0x98, 0x50, 0x9d, 0x5a,
+	0x77, 0xf7, 0x0c, 0xfe, 0xa9, 0x81, 0xb1, 0x8f, 0xf6, 0xf3, 0x15, 0x7a, 0xc6, 0xaf, 0x3b, 0xb6,
+	0xdf, 0x93, 0xbb, 0xf8, 0xf3, 0xd5, 0xc9, 0x5d, 0xbd, 0xcf, 0x34, 0x38, 0xef, 0x52, 0xee, 0x44,
+	0x44, 0x4c, 0xba, 0x9f, 0xc3, 0x98, 0xf8, 0x8b, 0x01, 0xf8, 0xbf, 0xff, 0xf6, 0x10, 0x99, 0x32,
+	0x06, 0xe0, 0xbb, 0x38, 0x57, 0xf6, 0x40, 0x93, 0xad, 0x16, 0xd1, 0xd5, 0x2f, 0x17, 0x29, 0xfd,
+	0xb7, 0x45, 0xf4, 0x91, 0x64, 0x8b, 0x7c, 0x85, 0x6a, 0x86, 0x3e, 0x21, 0x82, 0x72, 0x7b, 0x77,
+	0x5b, 0xf2, 0xaa, 0xa6, 0x71, 0x25, 0x0b, 0xfe, 0x16, 0x35, 0x22, 0xca, 0x84, 0x47, 0xd9, 0x28,
+	0x94, 0x00, 0xb3, 0xcb, 0xdb, 0xf2, 0xd6, 0x25, 0xd1, 0x93, 0x8c, 0x07, 0x7f, 0x83, 0x1a, 0x01,
+	0xcc, 0xcd, 0x1d, 0x52, 0x92, 0x53, 0xd9, 0x96, 0xb9, 0x16, 0xc0, 0x5c, 0x5f, 0x3a, 0xa9, 0x52,
+	0xcf, 0x10, 0x02, 0x36, 0xa6, 0x4c, 0xeb, 0x18, 0xda, 0x96, 0xb4, 0xa2, 0x49, 0x24, 0xe3, 0xd7,
+	0xa8, 0x6e, 0xc6, 0x94, 0xb7, 0xa0, 0x10, 0x06, 0x76, 0x75, 0x5b, 0xd2, 0x9a, 0xe1, 0xf9, 0x4e,
+	0xd2, 0xe0, 0xe7, 0x68, 0x4f, 0xf7, 0xc5, 0xea, 0x74, 0x6b, 0xdb, 0x32, 0x37, 0x14, 0xd3, 0xea,
+	0x78, 0x9f, 0x21, 0x94, 0x00, 0x09, 0xcd, 0x86, 0xeb, 0x5b, 0x9f, 0x82, 0x24, 0x51, 0xbb, 0x6d,
+	0xff, 0x80, 0xee, 0xe7, 0x75, 0xe8, 0x9a, 0xe0, 0xd2, 0x54, 0xf0, 0x64, 0x61, 0x14, 0x0b, 0xef,
+	0xa3, 0xdb, 0x21, 0x8d, 0xa8, 0x50, 0x7a, 0x51, 0x74, 0xb5, 0x81, 0x0f, 0x50, 0x89, 0x8f, 0x46,
+	0x29, 0x08, 0x75, 0xfb, 0x8b, 0xae, 0xb1, 0xe4, 0x95, 0x1e, 0xc2, 0x88, 0x27, 0x90, 0xa9, 0x7a,
+	0x41, 0xa9, 0x7a, 0x4d, 0x83, 0x5a, 0xcb, 0xdb, 0x3f, 0x59, 0xe8, 0xc1, 0x1b, 0x57, 0x37, 0x8a,
+	0x78, 0xa6, 0x34, 0x62, 0xe9, 0x96, 0xba, 0x58, 0xe8, 0x54, 0x4f, 0x1f, 0xde, 0xa0, 0x8b, 0x79,
+	0x3a, 0x17, 0x7c, 0x9e, 0x04, 0xee, 0x75, 0x0e, 0x59, 0x93, 0x3a, 0x61, 0xb3, 0x79, 0x6d, 0xb4,
+	0x5f, 0xdf, 0x42, 0x87, 0xff, 0x4a, 0x21, 0x2b, 0x36, 0x25, 0x59, 0xaa, 0x24, 0x63, 0xe1, 0x63,
+	0x54, 0x11, 0x34, 0x82, 0x54, 0x90, 0x28, 0x56, 0x7c, 0x05, 0x77, 0x05, 0xac, 0x0d, 0xf4, 0xc2,
+	0x9b, 0x07, 0x7a, 0x71, 0x7d, 0xa0, 0x3b, 0xe8, 0x1d, 0x33, 0x9e, 0x56, 0x93, 0x13, 0x02, 0x33,
+	0x89, 0xb1, 0x71, 0x0d, 0x56, 0x9e, 0xf5, 0x17, 0x40, 0x69, 0xf3, 0x0b, 0xe0, 0x1f, 0x33, 0x7b,
+	0x77, 0xd3, 0xcc, 0xce, 0xe6, 0x6b, 0xf9, 0xed, 0xe7, 0xeb, 0x01, 0x2a, 0x49, 0x9d, 0x80, 0x40,
+	0xcb, 0x81, 0x6b, 0xac, 0x7e, 0xef, 0xe5, 0x65, 0xd3, 0xba, 0xb8, 0x6c, 0x5a, 0x7f, 0x5d, 0x36,
+	0xad, 0x1f, 0xaf, 0x9a, 0x3b, 0x17, 0x57, 0xcd, 0x9d, 0x3f, 0xae, 0x9a, 0x3b, 0xcf, 0xef, 0x64,
+	0xaf, 0xb2, 0xf9, 0xf2, 0x7d, 0x26, 0x16, 0x31, 0xa4, 0xc3, 0x92, 0x7a, 0x9c, 0x3d, 0xfa, 0x7b,
+	0x00, 0x57, 0x77, 0x26, 0x4e, 0x7d, 0x0a, 0x00, 0x00,
 }
 
 func (m *QueryStakingInfoRequest) Marshal() (dAtA []byte, err error) {
@@ -530,6 +809,33 @@ func (m *StakingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IntervalBlocks != 0 {
+		i = encodeVarintQueryStaking(dAtA, i, uint64(m.IntervalBlocks))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.IntervalRewards) > 0 {
+		i -= len(m.IntervalRewards)
+		copy(dAtA[i:], m.IntervalRewards)
+		i = encodeVarintQueryStaking(dAtA, i, uint64(len(m.IntervalRewards)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Mode != 0 {
+		i = encodeVarintQueryStaking(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.NextDistributionHeight != 0 {
 		i = encodeVarintQueryStaking(dAtA, i, uint64(m.NextDistributionHeight))
 		i--
@@ -578,6 +884,207 @@ func (m *StakingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryStakingMetricsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingMetricsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingMetricsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingMetricsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingMetricsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingMetricsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metrics.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQueryStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StakingMetrics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakingMetrics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakingMetrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RealYield.Size()
+		i -= size
+		if _, err := m.RealYield.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQueryStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.TotalInflation.Size()
+		i -= size
+		if _, err := m.TotalInflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQueryStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.StakingYield.Size()
+		i -= size
+		if _, err := m.StakingYield.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQueryStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.EngineApr.Size()
+		i -= size
+		if _, err := m.EngineApr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQueryStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.DexRewardApr.Size()
+		i -= size
+		if _, err := m.DexRewardApr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQueryStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.MintInflation.Size()
+		i -= size
+		if _, err := m.MintInflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQueryStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.BondedRatio.Size()
+		i -= size
+		if _, err := m.BondedRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQueryStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.TotalBondedDisplay.Size()
+		i -= size
+		if _, err := m.TotalBondedDisplay.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQueryStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TotalSupplyDisplay.Size()
+		i -= size
+		if _, err := m.TotalSupplyDisplay.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQueryStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.DisplayExponent != 0 {
+		i = encodeVarintQueryStaking(dAtA, i, uint64(m.DisplayExponent))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DisplayDenom) > 0 {
+		i -= len(m.DisplayDenom)
+		copy(dAtA[i:], m.DisplayDenom)
+		i = encodeVarintQueryStaking(dAtA, i, uint64(len(m.DisplayDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.TotalBonded.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQueryStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.TotalSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQueryStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryStakingDistributionHistoryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -598,6 +1105,11 @@ func (m *QueryStakingDistributionHistoryRequest) MarshalToSizedBuffer(dAtA []byt
 	_ = i
 	var l int
 	_ = l
+	if m.BeforeHeight != 0 {
+		i = encodeVarintQueryStaking(dAtA, i, uint64(m.BeforeHeight))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Offset != 0 {
 		i = encodeVarintQueryStaking(dAtA, i, uint64(m.Offset))
 		i--
@@ -673,6 +1185,18 @@ func (m *StakingDistributionRecord) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.Minted) > 0 {
+		i -= len(m.Minted)
+		copy(dAtA[i:], m.Minted)
+		i = encodeVarintQueryStaking(dAtA, i, uint64(len(m.Minted)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Mode != 0 {
+		i = encodeVarintQueryStaking(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.NumDelegators != 0 {
 		i = encodeVarintQueryStaking(dAtA, i, uint64(m.NumDelegators))
 		i--
@@ -784,6 +1308,77 @@ func (m *StakingInfo) Size() (n int) {
 	if m.NextDistributionHeight != 0 {
 		n += 1 + sovQueryStaking(uint64(m.NextDistributionHeight))
 	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.Mode != 0 {
+		n += 1 + sovQueryStaking(uint64(m.Mode))
+	}
+	l = len(m.IntervalRewards)
+	if l > 0 {
+		n += 1 + l + sovQueryStaking(uint64(l))
+	}
+	if m.IntervalBlocks != 0 {
+		n += 1 + sovQueryStaking(uint64(m.IntervalBlocks))
+	}
+	return n
+}
+
+func (m *QueryStakingMetricsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStakingMetricsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Metrics.Size()
+	n += 1 + l + sovQueryStaking(uint64(l))
+	return n
+}
+
+func (m *StakingMetrics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovQueryStaking(uint64(l))
+	l = m.TotalBonded.Size()
+	n += 1 + l + sovQueryStaking(uint64(l))
+	l = len(m.DisplayDenom)
+	if l > 0 {
+		n += 1 + l + sovQueryStaking(uint64(l))
+	}
+	if m.DisplayExponent != 0 {
+		n += 1 + sovQueryStaking(uint64(m.DisplayExponent))
+	}
+	l = m.TotalSupplyDisplay.Size()
+	n += 1 + l + sovQueryStaking(uint64(l))
+	l = m.TotalBondedDisplay.Size()
+	n += 1 + l + sovQueryStaking(uint64(l))
+	l = m.BondedRatio.Size()
+	n += 1 + l + sovQueryStaking(uint64(l))
+	l = m.MintInflation.Size()
+	n += 1 + l + sovQueryStaking(uint64(l))
+	l = m.DexRewardApr.Size()
+	n += 1 + l + sovQueryStaking(uint64(l))
+	l = m.EngineApr.Size()
+	n += 1 + l + sovQueryStaking(uint64(l))
+	l = m.StakingYield.Size()
+	n += 1 + l + sovQueryStaking(uint64(l))
+	l = m.TotalInflation.Size()
+	n += 1 + l + sovQueryStaking(uint64(l))
+	l = m.RealYield.Size()
+	n += 1 + l + sovQueryStaking(uint64(l))
 	return n
 }
 
@@ -799,6 +1394,9 @@ func (m *QueryStakingDistributionHistoryRequest) Size() (n int) {
 	if m.Offset != 0 {
 		n += 1 + sovQueryStaking(uint64(m.Offset))
 	}
+	if m.BeforeHeight != 0 {
+		n += 1 + sovQueryStaking(uint64(m.BeforeHeight))
+	}
 	return n
 }
 
@@ -851,9 +1449,16 @@ func (m *StakingDistributionRecord) Size() (n int) {
 	if m.NumDelegators != 0 {
 		n += 1 + sovQueryStaking(uint64(m.NumDelegators))
 	}
-	return n
-}
-
+	if m.Mode != 0 {
+		n += 1 + sovQueryStaking(uint64(m.Mode))
+	}
+	l = len(m.Minted)
+	if l > 0 {
+		n += 1 + l + sovQueryStaking(uint64(l))
+	}
+	return n
+}
+
 func sovQueryStaking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -920,30 +1525,704 @@ func (m *QueryStakingInfoResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQueryStaking
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakingInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &StakingInfo{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakingInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakingInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalSupply = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStaked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalStaked = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveApr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EffectiveApr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualRewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnnualRewards = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HourlyRewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HourlyRewards = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumDelegators", wireType)
+			}
+			m.NumDelegators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumDelegators |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDistributionHeight", wireType)
+			}
+			m.NextDistributionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextDistributionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= StakingRewardMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalRewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntervalRewards = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalBlocks", wireType)
+			}
+			m.IntervalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakingMetricsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingMetricsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakingMetricsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakingMetricsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingMetricsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakingMetricsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakingMetrics) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakingMetrics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakingMetrics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBonded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryStaking
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DisplayDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayExponent", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakingInfoResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakingInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.DisplayExponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisplayExponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupplyDisplay", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQueryStaking
@@ -953,81 +2232,63 @@ func (m *QueryStakingInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQueryStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQueryStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Info == nil {
-				m.Info = &StakingInfo{}
-			}
-			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalSupplyDisplay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQueryStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBondedDisplay", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQueryStaking
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryStaking
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StakingInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQueryStaking
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryStaking
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.TotalBondedDisplay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StakingInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StakingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1055,11 +2316,13 @@ func (m *StakingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalSupply = string(dAtA[iNdEx:postIndex])
+			if err := m.BondedRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalStaked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintInflation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1087,11 +2350,13 @@ func (m *StakingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalStaked = string(dAtA[iNdEx:postIndex])
+			if err := m.MintInflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveApr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DexRewardApr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1119,11 +2384,13 @@ func (m *StakingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EffectiveApr = string(dAtA[iNdEx:postIndex])
+			if err := m.DexRewardApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnnualRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EngineApr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1151,11 +2418,13 @@ func (m *StakingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AnnualRewards = string(dAtA[iNdEx:postIndex])
+			if err := m.EngineApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HourlyRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingYield", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1183,13 +2452,15 @@ func (m *StakingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HourlyRewards = string(dAtA[iNdEx:postIndex])
+			if err := m.StakingYield.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumDelegators", wireType)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalInflation", wireType)
 			}
-			m.NumDelegators = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQueryStaking
@@ -1199,16 +2470,31 @@ func (m *StakingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumDelegators |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextDistributionHeight", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryStaking
 			}
-			m.NextDistributionHeight = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalInflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RealYield", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQueryStaking
@@ -1218,11 +2504,26 @@ func (m *StakingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextDistributionHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RealYield.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueryStaking(dAtA[iNdEx:])
@@ -1311,6 +2612,25 @@ func (m *QueryStakingDistributionHistoryRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeHeight", wireType)
+			}
+			m.BeforeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeforeHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueryStaking(dAtA[iNdEx:])
@@ -1649,6 +2969,57 @@ func (m *StakingDistributionRecord) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= StakingRewardMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minted = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueryStaking(dAtA[iNdEx:])
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// DisplayUnit returns the display denom and exponent described by the bank
+// metadata of base. Without metadata, or when the display unit is not
+// listed, amounts are shown in the base denom itself.
+func DisplayUnit(base string, md banktypes.Metadata, found bool) (string, uint32) {
+	if !found {
+		return base, 0
+	}
+	for _, du := range md.DenomUnits {
+		if du.Denom == md.Display {
+			return md.Display, du.Exponent
+		}
+	}
+	return base, 0
+}
+
+// ToDisplay converts an amount of base units to display units.
+func ToDisplay(amount math.Int, exponent uint32) math.LegacyDec {
+	dec := math.LegacyNewDecFromInt(amount)
+	if exponent == 0 {
+		return dec
+	}
+	return dec.Quo(math.LegacyNewDec(10).Power(uint64(exponent)))
+}
+
+// BondedRatio returns the share of the supply that is bonded.
+func BondedRatio(supply, bonded math.Int) math.LegacyDec {
+	if !supply.IsPositive() {
+		return math.LegacyZeroDec()
+	}
+	return math.LegacyNewDecFromInt(bonded).QuoInt(supply)
+}
+
+// StakingYield returns the yearly yield of bonded tokens. x/mint inflation
+// is paid to stakers only, so they earn it divided by the bonded ratio; the
+// reward engine tops that up to its APR.
+func StakingYield(mintInflation, bondedRatio, engineAPR math.LegacyDec) math.LegacyDec {
+	yield := math.LegacyZeroDec()
+	if bondedRatio.IsPositive() {
+		yield = mintInflation.Quo(bondedRatio)
+	}
+	return math.LegacyMaxDec(yield, engineAPR)
+}
+
+// RealYield returns the yield net of inflation:
+// (1 + yield) / (1 + inflation) - 1.
+func RealYield(yield, inflation math.LegacyDec) math.LegacyDec {
+	return math.LegacyOneDec().Add(yield).
+		Quo(math.LegacyOneDec().Add(inflation)).
+		Sub(math.LegacyOneDec())
+}
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+const (
+	// DefaultTxHistoryLimit is the page size used when a request sets none
+	DefaultTxHistoryLimit = 50
+	// MaxTxHistoryLimit caps the page size of a transaction history query
+	MaxTxHistoryLimit = 100
+
+	// TxHistoryTimeFormat is the layout of TransactionRecord timestamps
+	TxHistoryTimeFormat = "2006-01-02T15:04:05Z"
+)
+
+// Filter returns the record filter described by the request.
+func (req *QueryTransactionHistoryRequest) Filter() func(TransactionRecord) bool {
+	types := make(map[string]bool, len(req.Types))
+	for _, t := range req.Types {
+		types[t] = true
+	}
+
+	return func(rec TransactionRecord) bool {
+		if len(types) > 0 && !types[rec.Type] {
+			return false
+		}
+		if req.Denom != "" && !rec.Amount.AmountOf(req.Denom).IsPositive() {
+			return false
+		}
+		if req.MinAmount != nil && !req.MinAmount.IsNil() {
+			if req.Denom != "" {
+				if rec.Amount.AmountOf(req.Denom).LT(*req.MinAmount) {
+					return false
+				}
+			} else {
+				found := false
+				for _, coin := range rec.Amount {
+					if coin.Amount.GTE(*req.MinAmount) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					return false
+				}
+			}
+		}
+		if req.Counterparty != "" && rec.From != req.Counterparty && rec.To != req.Counterparty {
+			return false
+		}
+		if req.MinHeight > 0 && rec.Height < req.MinHeight {
+			return false
+		}
+		if req.MaxHeight > 0 && rec.Height > req.MaxHeight {
+			return false
+		}
+		if req.StartTime != nil || req.EndTime != nil {
+			ts, err := time.Parse(TxHistoryTimeFormat, rec.Timestamp)
+			if err != nil {
+				return false
+			}
+			if req.StartTime != nil && ts.Before(*req.StartTime) {
+				return false
+			}
+			if req.EndTime != nil && ts.After(*req.EndTime) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// PageRequest returns the request's pagination with defaults applied. Without
+// pagination (or with an empty one, as CLI clients send) the legacy limit is
+// used and the newest records come first.
+func (req *QueryTransactionHistoryRequest) PageRequest() *query.PageRequest {
+	var pageReq query.PageRequest
+	if p := req.Pagination; p != nil && (len(p.Key) > 0 || p.Offset > 0 || p.Limit > 0 || p.CountTotal || p.Reverse) {
+		pageReq = *req.Pagination
+	} else {
+		pageReq = query.PageRequest{Limit: req.Limit, Reverse: true}
+	}
+
+	if pageReq.Limit == 0 {
+		pageReq.Limit = DefaultTxHistoryLimit
+	}
+	if pageReq.Limit > MaxTxHistoryLimit {
+		pageReq.Limit = MaxTxHistoryLimit
+	}
+
+	return &pageReq
+}
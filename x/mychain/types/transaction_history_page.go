@@ -0,0 +1,111 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// Page keys start with the order they were issued for, so a follow-up request
+// continues in that order whatever its reverse flag says.
+const (
+	pageKeyForward byte = 'f'
+	pageKeyReverse byte = 'r'
+)
+
+// PaginateTxHistory returns a page of the records in store accepted by filter.
+// store holds a single address' history keyed by zero-padded block height, so only
+// the keys between minHeight and maxHeight (inclusive, zero for open) are iterated.
+func PaginateTxHistory(store storetypes.KVStore, minHeight, maxHeight int64, filter func(TransactionRecord) bool, pageReq *query.PageRequest) ([]TransactionRecord, *query.PageResponse, error) {
+	var req query.PageRequest
+	if pageReq != nil {
+		req = *pageReq
+	}
+	if len(req.Key) > 0 {
+		switch req.Key[0] {
+		case pageKeyForward:
+			req.Reverse = false
+		case pageKeyReverse:
+			req.Reverse = true
+		default:
+			return nil, nil, errorsmod.Wrapf(ErrInvalidPageKey, "unknown order %q", req.Key[0])
+		}
+		req.Key = req.Key[1:]
+	}
+
+	if minHeight > 0 && maxHeight > 0 && minHeight > maxHeight {
+		return nil, &query.PageResponse{}, nil
+	}
+	bounded := heightRangeStore{KVStore: store}
+	if minHeight > 0 {
+		bounded.start = heightKey(minHeight)
+	}
+	if maxHeight > 0 {
+		bounded.end = heightKey(maxHeight + 1)
+	}
+
+	var records []TransactionRecord
+	pageRes, err := query.FilteredPaginate(bounded, &req, func(_, value []byte, accumulate bool) (bool, error) {
+		var record TransactionRecord
+		if err := record.Unmarshal(value); err != nil {
+			return false, err
+		}
+		if filter != nil && !filter(record) {
+			return false, nil
+		}
+		if accumulate {
+			records = append(records, record)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(pageRes.NextKey) > 0 {
+		order := pageKeyForward
+		if req.Reverse {
+			order = pageKeyReverse
+		}
+		pageRes.NextKey = append([]byte{order}, pageRes.NextKey...)
+	}
+
+	return records, pageRes, nil
+}
+
+// heightKey is the key prefix of the records at height.
+func heightKey(height int64) []byte {
+	return []byte(fmt.Sprintf("%020d", height))
+}
+
+// heightRangeStore limits every iterator over the wrapped store to [start, end).
+type heightRangeStore struct {
+	storetypes.KVStore
+	start, end []byte
+}
+
+func (s heightRangeStore) Iterator(start, end []byte) storetypes.Iterator {
+	start, end = s.clamp(start, end)
+	return s.KVStore.Iterator(start, end)
+}
+
+func (s heightRangeStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	start, end = s.clamp(start, end)
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+func (s heightRangeStore) clamp(start, end []byte) ([]byte, []byte) {
+	if s.start != nil && (start == nil || bytes.Compare(start, s.start) < 0) {
+		start = s.start
+	}
+	if s.end != nil && (end == nil || bytes.Compare(end, s.end) > 0) {
+		end = s.end
+	}
+	if start != nil && end != nil && bytes.Compare(start, end) > 0 {
+		start = end
+	}
+	return start, end
+}
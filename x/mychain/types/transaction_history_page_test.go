@@ -0,0 +1,90 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/store/dbadapter"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"mychain/x/mychain/types"
+)
+
+// historyStore returns a store with one record at each height from 1 to n,
+// keyed the way the keeper and the indexer key an address' history.
+func historyStore(t *testing.T, n int64) *dbadapter.Store {
+	t.Helper()
+
+	store := &dbadapter.Store{DB: dbm.NewMemDB()}
+	for h := int64(1); h <= n; h++ {
+		rec := types.TransactionRecord{Address: "alice", Height: h}
+		bz, err := rec.Marshal()
+		require.NoError(t, err)
+		store.Set([]byte(fmt.Sprintf("%020d/%020d", h, h)), bz)
+	}
+	return store
+}
+
+func heights(records []types.TransactionRecord) []int64 {
+	out := make([]int64, len(records))
+	for i, rec := range records {
+		out[i] = rec.Height
+	}
+	return out
+}
+
+func TestPaginateTxHistoryHeightRange(t *testing.T) {
+	store := historyStore(t, 10)
+
+	// No filter: only the keys in range are iterated at all
+	records, pageRes, err := types.PaginateTxHistory(store, 4, 6, nil, &query.PageRequest{Limit: 10, CountTotal: true})
+	require.NoError(t, err)
+	require.Equal(t, []int64{4, 5, 6}, heights(records))
+	require.Equal(t, uint64(3), pageRes.Total)
+
+	records, _, err = types.PaginateTxHistory(store, 8, 0, nil, &query.PageRequest{Limit: 10, Reverse: true})
+	require.NoError(t, err)
+	require.Equal(t, []int64{10, 9, 8}, heights(records))
+
+	records, _, err = types.PaginateTxHistory(store, 0, 2, nil, &query.PageRequest{Limit: 10})
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2}, heights(records))
+
+	records, _, err = types.PaginateTxHistory(store, 6, 4, nil, &query.PageRequest{Limit: 10})
+	require.NoError(t, err)
+	require.Empty(t, records)
+
+	// Pages stay inside the range
+	records, pageRes, err = types.PaginateTxHistory(store, 3, 7, nil, &query.PageRequest{Limit: 3, Reverse: true})
+	require.NoError(t, err)
+	require.Equal(t, []int64{7, 6, 5}, heights(records))
+	records, pageRes, err = types.PaginateTxHistory(store, 3, 7, nil, &query.PageRequest{Key: pageRes.NextKey, Limit: 3, Reverse: true})
+	require.NoError(t, err)
+	require.Equal(t, []int64{4, 3}, heights(records))
+	require.Empty(t, pageRes.NextKey)
+}
+
+func TestPaginateTxHistoryKeyKeepsOrder(t *testing.T) {
+	store := historyStore(t, 5)
+
+	records, pageRes, err := types.PaginateTxHistory(store, 0, 0, nil, &query.PageRequest{Limit: 2, Reverse: true})
+	require.NoError(t, err)
+	require.Equal(t, []int64{5, 4}, heights(records))
+
+	// The key was issued newest first; dropping the reverse flag does not flip it
+	records, pageRes, err = types.PaginateTxHistory(store, 0, 0, nil, &query.PageRequest{Key: pageRes.NextKey, Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []int64{3, 2}, heights(records))
+
+	records, pageRes, err = types.PaginateTxHistory(store, 0, 0, nil, &query.PageRequest{Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2}, heights(records))
+	records, _, err = types.PaginateTxHistory(store, 0, 0, nil, &query.PageRequest{Key: pageRes.NextKey, Limit: 2, Reverse: true})
+	require.NoError(t, err)
+	require.Equal(t, []int64{3, 4}, heights(records))
+
+	_, _, err = types.PaginateTxHistory(store, 0, 0, nil, &query.PageRequest{Key: []byte("00000000000000000003"), Limit: 2})
+	require.ErrorIs(t, err, types.ErrInvalidPageKey)
+}
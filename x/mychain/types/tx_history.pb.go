@@ -0,0 +1,4089 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: mychain/mychain/v1/tx_history.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TransactionRecord represents a transaction history record
+type TransactionRecord struct {
+	TxHash      string                                   `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Type        string                                   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Description string                                   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Amount      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	From        string                                   `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	To          string                                   `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	Height      int64                                    `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	Timestamp   string                                   `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// address is the account the record is filed under
+	Address string `protobuf:"bytes,9,opt,name=address,proto3" json:"address,omitempty"`
+	// msg_index is the index of the message that produced the record, or -1
+	// when the record is not tied to a known message (e.g. BeginBlock)
+	MsgIndex int64 `protobuf:"varint,10,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty"`
+	// sequence orders records globally and keeps keys unique per address
+	Sequence uint64 `protobuf:"varint,11,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// legacy_metadata holds the free-form metadata of records written before
+	// typed details existed
+	LegacyMetadata string `protobuf:"bytes,12,opt,name=legacy_metadata,json=legacyMetadata,proto3" json:"legacy_metadata,omitempty"`
+	// details carries module specific data about the record
+	//
+	// Types that are valid to be assigned to Details:
+	//	*TransactionRecord_DexOrder
+	//	*TransactionRecord_DexFill
+	//	*TransactionRecord_SegmentPurchase
+	//	*TransactionRecord_Bridge
+	//	*TransactionRecord_StakingReward
+	//	*TransactionRecord_LcRewardClaim
+	//	*TransactionRecord_DevVesting
+	//	*TransactionRecord_Fee
+	//	*TransactionRecord_MaincoinSale
+	Details isTransactionRecord_Details `protobuf_oneof:"details"`
+}
+
+func (m *TransactionRecord) Reset()         { *m = TransactionRecord{} }
+func (m *TransactionRecord) String() string { return proto.CompactTextString(m) }
+func (*TransactionRecord) ProtoMessage()    {}
+func (*TransactionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea1c7a7dfa236aa2, []int{0}
+}
+func (m *TransactionRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransactionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransactionRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransactionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionRecord.Merge(m, src)
+}
+func (m *TransactionRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransactionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionRecord proto.InternalMessageInfo
+
+type isTransactionRecord_Details interface {
+	isTransactionRecord_Details()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type TransactionRecord_DexOrder struct {
+	DexOrder *DexOrderDetails `protobuf:"bytes,20,opt,name=dex_order,json=dexOrder,proto3,oneof" json:"dex_order,omitempty"`
+}
+type TransactionRecord_DexFill struct {
+	DexFill *DexFillDetails `protobuf:"bytes,21,opt,name=dex_fill,json=dexFill,proto3,oneof" json:"dex_fill,omitempty"`
+}
+type TransactionRecord_SegmentPurchase struct {
+	SegmentPurchase *SegmentPurchaseDetails `protobuf:"bytes,22,opt,name=segment_purchase,json=segmentPurchase,proto3,oneof" json:"segment_purchase,omitempty"`
+}
+type TransactionRecord_Bridge struct {
+	Bridge *BridgeDetails `protobuf:"bytes,23,opt,name=bridge,proto3,oneof" json:"bridge,omitempty"`
+}
+type TransactionRecord_StakingReward struct {
+	StakingReward *StakingRewardDetails `protobuf:"bytes,24,opt,name=staking_reward,json=stakingReward,proto3,oneof" json:"staking_reward,omitempty"`
+}
+type TransactionRecord_LcRewardClaim struct {
+	LcRewardClaim *LCRewardClaimDetails `protobuf:"bytes,25,opt,name=lc_reward_claim,json=lcRewardClaim,proto3,oneof" json:"lc_reward_claim,omitempty"`
+}
+type TransactionRecord_DevVesting struct {
+	DevVesting *DevVestingDetails `protobuf:"bytes,26,opt,name=dev_vesting,json=devVesting,proto3,oneof" json:"dev_vesting,omitempty"`
+}
+type TransactionRecord_Fee struct {
+	Fee *FeeDetails `protobuf:"bytes,27,opt,name=fee,proto3,oneof" json:"fee,omitempty"`
+}
+type TransactionRecord_MaincoinSale struct {
+	MaincoinSale *MaincoinSaleDetails `protobuf:"bytes,28,opt,name=maincoin_sale,json=maincoinSale,proto3,oneof" json:"maincoin_sale,omitempty"`
+}
+
+func (*TransactionRecord_DexOrder) isTransactionRecord_Details()        {}
+func (*TransactionRecord_DexFill) isTransactionRecord_Details()         {}
+func (*TransactionRecord_SegmentPurchase) isTransactionRecord_Details() {}
+func (*TransactionRecord_Bridge) isTransactionRecord_Details()          {}
+func (*TransactionRecord_StakingReward) isTransactionRecord_Details()   {}
+func (*TransactionRecord_LcRewardClaim) isTransactionRecord_Details()   {}
+func (*TransactionRecord_DevVesting) isTransactionRecord_Details()      {}
+func (*TransactionRecord_Fee) isTransactionRecord_Details()             {}
+func (*TransactionRecord_MaincoinSale) isTransactionRecord_Details()    {}
+
+func (m *TransactionRecord) GetDetails() isTransactionRecord_Details {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (m *TransactionRecord) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *TransactionRecord) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *TransactionRecord) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *TransactionRecord) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *TransactionRecord) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *TransactionRecord) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *TransactionRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TransactionRecord) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
+}
+
+func (m *TransactionRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *TransactionRecord) GetMsgIndex() int64 {
+	if m != nil {
+		return m.MsgIndex
+	}
+	return 0
+}
+
+func (m *TransactionRecord) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *TransactionRecord) GetLegacyMetadata() string {
+	if m != nil {
+		return m.LegacyMetadata
+	}
+	return ""
+}
+
+func (m *TransactionRecord) GetDexOrder() *DexOrderDetails {
+	if x, ok := m.GetDetails().(*TransactionRecord_DexOrder); ok {
+		return x.DexOrder
+	}
+	return nil
+}
+
+func (m *TransactionRecord) GetDexFill() *DexFillDetails {
+	if x, ok := m.GetDetails().(*TransactionRecord_DexFill); ok {
+		return x.DexFill
+	}
+	return nil
+}
+
+func (m *TransactionRecord) GetSegmentPurchase() *SegmentPurchaseDetails {
+	if x, ok := m.GetDetails().(*TransactionRecord_SegmentPurchase); ok {
+		return x.SegmentPurchase
+	}
+	return nil
+}
+
+func (m *TransactionRecord) GetBridge() *BridgeDetails {
+	if x, ok := m.GetDetails().(*TransactionRecord_Bridge); ok {
+		return x.Bridge
+	}
+	return nil
+}
+
+func (m *TransactionRecord) GetStakingReward() *StakingRewardDetails {
+	if x, ok := m.GetDetails().(*TransactionRecord_StakingReward); ok {
+		return x.StakingReward
+	}
+	return nil
+}
+
+func (m *TransactionRecord) GetLcRewardClaim() *LCRewardClaimDetails {
+	if x, ok := m.GetDetails().(*TransactionRecord_LcRewardClaim); ok {
+		return x.LcRewardClaim
+	}
+	return nil
+}
+
+func (m *TransactionRecord) GetDevVesting() *DevVestingDetails {
+	if x, ok := m.GetDetails().(*TransactionRecord_DevVesting); ok {
+		return x.DevVesting
+	}
+	return nil
+}
+
+func (m *TransactionRecord) GetFee() *FeeDetails {
+	if x, ok := m.GetDetails().(*TransactionRecord_Fee); ok {
+		return x.Fee
+	}
+	return nil
+}
+
+func (m *TransactionRecord) GetMaincoinSale() *MaincoinSaleDetails {
+	if x, ok := m.GetDetails().(*TransactionRecord_MaincoinSale); ok {
+		return x.MaincoinSale
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*TransactionRecord) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*TransactionRecord_DexOrder)(nil),
+		(*TransactionRecord_DexFill)(nil),
+		(*TransactionRecord_SegmentPurchase)(nil),
+		(*TransactionRecord_Bridge)(nil),
+		(*TransactionRecord_StakingReward)(nil),
+		(*TransactionRecord_LcRewardClaim)(nil),
+		(*TransactionRecord_DevVesting)(nil),
+		(*TransactionRecord_Fee)(nil),
+		(*TransactionRecord_MaincoinSale)(nil),
+	}
+}
+
+// DexOrderDetails describes a created or cancelled dex order
+type DexOrderDetails struct {
+	OrderId uint64     `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	PairId  uint64     `protobuf:"varint,2,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	IsBuy   bool       `protobuf:"varint,3,opt,name=is_buy,json=isBuy,proto3" json:"is_buy,omitempty"`
+	Price   types.Coin `protobuf:"bytes,4,opt,name=price,proto3" json:"price"`
+	// cancel_fee is the fee charged when the order was cancelled
+	CancelFee types.Coin `protobuf:"bytes,5,opt,name=cancel_fee,json=cancelFee,proto3" json:"cancel_fee"`
+}
+
+func (m *DexOrderDetails) Reset()         { *m = DexOrderDetails{} }
+func (m *DexOrderDetails) String() string { return proto.CompactTextString(m) }
+func (*DexOrderDetails) ProtoMessage()    {}
+func (*DexOrderDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea1c7a7dfa236aa2, []int{1}
+}
+func (m *DexOrderDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DexOrderDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DexOrderDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DexOrderDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DexOrderDetails.Merge(m, src)
+}
+func (m *DexOrderDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *DexOrderDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_DexOrderDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DexOrderDetails proto.InternalMessageInfo
+
+func (m *DexOrderDetails) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+func (m *DexOrderDetails) GetPairId() uint64 {
+	if m != nil {
+		return m.PairId
+	}
+	return 0
+}
+
+func (m *DexOrderDetails) GetIsBuy() bool {
+	if m != nil {
+		return m.IsBuy
+	}
+	return false
+}
+
+func (m *DexOrderDetails) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func (m *DexOrderDetails) GetCancelFee() types.Coin {
+	if m != nil {
+		return m.CancelFee
+	}
+	return types.Coin{}
+}
+
+// DexFillDetails describes one side of a matched dex trade
+type DexFillDetails struct {
+	OrderId        uint64     `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CounterOrderId uint64     `protobuf:"varint,2,opt,name=counter_order_id,json=counterOrderId,proto3" json:"counter_order_id,omitempty"`
+	IsBuy          bool       `protobuf:"varint,3,opt,name=is_buy,json=isBuy,proto3" json:"is_buy,omitempty"`
+	Price          types.Coin `protobuf:"bytes,4,opt,name=price,proto3" json:"price"`
+	Fees           types.Coin `protobuf:"bytes,5,opt,name=fees,proto3" json:"fees"`
+}
+
+func (m *DexFillDetails) Reset()         { *m = DexFillDetails{} }
+func (m *DexFillDetails) String() string { return proto.CompactTextString(m) }
+func (*DexFillDetails) ProtoMessage()    {}
+func (*DexFillDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea1c7a7dfa236aa2, []int{2}
+}
+func (m *DexFillDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DexFillDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DexFillDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DexFillDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DexFillDetails.Merge(m, src)
+}
+func (m *DexFillDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *DexFillDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_DexFillDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DexFillDetails proto.InternalMessageInfo
+
+func (m *DexFillDetails) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+func (m *DexFillDetails) GetCounterOrderId() uint64 {
+	if m != nil {
+		return m.CounterOrderId
+	}
+	return 0
+}
+
+func (m *DexFillDetails) GetIsBuy() bool {
+	if m != nil {
+		return m.IsBuy
+	}
+	return false
+}
+
+func (m *DexFillDetails) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func (m *DexFillDetails) GetFees() types.Coin {
+	if m != nil {
+		return m.Fees
+	}
+	return types.Coin{}
+}
+
+// SegmentPurchaseDetails describes a maincoin purchase across segments
+type SegmentPurchaseDetails struct {
+	// paid is what the buyer paid, in the purchase denom
+	Paid types.Coin `protobuf:"bytes,1,opt,name=paid,proto3" json:"paid"`
+	// cost is the TestUSD value of the purchase
+	Cost              cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=cost,proto3,customtype=cosmossdk.io/math.Int" json:"cost"`
+	TokensReceived    cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=tokens_received,json=tokensReceived,proto3,customtype=cosmossdk.io/math.Int" json:"tokens_received"`
+	DevAllocation     cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=dev_allocation,json=devAllocation,proto3,customtype=cosmossdk.io/math.Int" json:"dev_allocation"`
+	StartSegment      uint64                `protobuf:"varint,5,opt,name=start_segment,json=startSegment,proto3" json:"start_segment,omitempty"`
+	SegmentsProcessed uint64                `protobuf:"varint,6,opt,name=segments_processed,json=segmentsProcessed,proto3" json:"segments_processed,omitempty"`
+}
+
+func (m *SegmentPurchaseDetails) Reset()         { *m = SegmentPurchaseDetails{} }
+func (m *SegmentPurchaseDetails) String() string { return proto.CompactTextString(m) }
+func (*SegmentPurchaseDetails) ProtoMessage()    {}
+func (*SegmentPurchaseDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea1c7a7dfa236aa2, []int{3}
+}
+func (m *SegmentPurchaseDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SegmentPurchaseDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SegmentPurchaseDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SegmentPurchaseDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentPurchaseDetails.Merge(m, src)
+}
+func (m *SegmentPurchaseDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *SegmentPurchaseDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentPurchaseDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentPurchaseDetails proto.InternalMessageInfo
+
+func (m *SegmentPurchaseDetails) GetPaid() types.Coin {
+	if m != nil {
+		return m.Paid
+	}
+	return types.Coin{}
+}
+
+func (m *SegmentPurchaseDetails) GetStartSegment() uint64 {
+	if m != nil {
+		return m.StartSegment
+	}
+	return 0
+}
+
+func (m *SegmentPurchaseDetails) GetSegmentsProcessed() uint64 {
+	if m != nil {
+		return m.SegmentsProcessed
+	}
+	return 0
+}
+
+// BridgeDetails describes a TestUSD bridge transfer
+type BridgeDetails struct {
+	// direction is "in" or "out"
+	Direction string                      `protobuf:"bytes,1,opt,name=direction,proto3" json:"direction,omitempty"`
+	Usdc      types.Coin                  `protobuf:"bytes,2,opt,name=usdc,proto3" json:"usdc"`
+	Testusd   types.Coin                  `protobuf:"bytes,3,opt,name=testusd,proto3" json:"testusd"`
+	PegRatio  cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=peg_ratio,json=pegRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"peg_ratio"`
+}
+
+func (m *BridgeDetails) Reset()         { *m = BridgeDetails{} }
+func (m *BridgeDetails) String() string { return proto.CompactTextString(m) }
+func (*BridgeDetails) ProtoMessage()    {}
+func (*BridgeDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea1c7a7dfa236aa2, []int{4}
+}
+func (m *BridgeDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeDetails.Merge(m, src)
+}
+func (m *BridgeDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeDetails proto.InternalMessageInfo
+
+func (m *BridgeDetails) GetDirection() string {
+	if m != nil {
+		return m.Direction
+	}
+	return ""
+}
+
+func (m *BridgeDetails) GetUsdc() types.Coin {
+	if m != nil {
+		return m.Usdc
+	}
+	return types.Coin{}
+}
+
+func (m *BridgeDetails) GetTestusd() types.Coin {
+	if m != nil {
+		return m.Testusd
+	}
+	return types.Coin{}
+}
+
+// StakingRewardDetails describes a staking reward withdrawal
+type StakingRewardDetails struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *StakingRewardDetails) Reset()         { *m = StakingRewardDetails{} }
+func (m *StakingRewardDetails) String() string { return proto.CompactTextString(m) }
+func (*StakingRewardDetails) ProtoMessage()    {}
+func (*StakingRewardDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea1c7a7dfa236aa2, []int{5}
+}
+func (m *StakingRewardDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakingRewardDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakingRewardDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakingRewardDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakingRewardDetails.Merge(m, src)
+}
+func (m *StakingRewardDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakingRewardDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakingRewardDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakingRewardDetails proto.InternalMessageInfo
+
+func (m *StakingRewardDetails) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+// LCRewardClaimDetails describes LC liquidity rewards paid to a dex user
+type LCRewardClaimDetails struct {
+	// apr is the base annual rate the rewards were paid at
+	Apr cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=apr,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"apr"`
+}
+
+func (m *LCRewardClaimDetails) Reset()         { *m = LCRewardClaimDetails{} }
+func (m *LCRewardClaimDetails) String() string { return proto.CompactTextString(m) }
+func (*LCRewardClaimDetails) ProtoMessage()    {}
+func (*LCRewardClaimDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea1c7a7dfa236aa2, []int{6}
+}
+func (m *LCRewardClaimDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LCRewardClaimDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LCRewardClaimDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LCRewardClaimDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LCRewardClaimDetails.Merge(m, src)
+}
+func (m *LCRewardClaimDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *LCRewardClaimDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_LCRewardClaimDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LCRewardClaimDetails proto.InternalMessageInfo
+
+// DevVestingDetails describes a dev allocation tranche
+type DevVestingDetails struct {
+	Segment    uint64    `protobuf:"varint,1,opt,name=segment,proto3" json:"segment,omitempty"`
+	VestingEnd time.Time `protobuf:"bytes,2,opt,name=vesting_end,json=vestingEnd,proto3,stdtime" json:"vesting_end"`
+}
+
+func (m *DevVestingDetails) Reset()         { *m = DevVestingDetails{} }
+func (m *DevVestingDetails) String() string { return proto.CompactTextString(m) }
+func (*DevVestingDetails) ProtoMessage()    {}
+func (*DevVestingDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea1c7a7dfa236aa2, []int{7}
+}
+func (m *DevVestingDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DevVestingDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DevVestingDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DevVestingDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DevVestingDetails.Merge(m, src)
+}
+func (m *DevVestingDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *DevVestingDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_DevVestingDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DevVestingDetails proto.InternalMessageInfo
+
+func (m *DevVestingDetails) GetSegment() uint64 {
+	if m != nil {
+		return m.Segment
+	}
+	return 0
+}
+
+func (m *DevVestingDetails) GetVestingEnd() time.Time {
+	if m != nil {
+		return m.VestingEnd
+	}
+	return time.Time{}
+}
+
+// FeeDetails describes the fee and gas of a tx, filed under its fee payer
+type FeeDetails struct {
+	GasWanted uint64 `protobuf:"varint,1,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
+	GasUsed   uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	MsgCount  uint64 `protobuf:"varint,3,opt,name=msg_count,json=msgCount,proto3" json:"msg_count,omitempty"`
+}
+
+func (m *FeeDetails) Reset()         { *m = FeeDetails{} }
+func (m *FeeDetails) String() string { return proto.CompactTextString(m) }
+func (*FeeDetails) ProtoMessage()    {}
+func (*FeeDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea1c7a7dfa236aa2, []int{8}
+}
+func (m *FeeDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDetails.Merge(m, src)
+}
+func (m *FeeDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDetails proto.InternalMessageInfo
+
+func (m *FeeDetails) GetGasWanted() uint64 {
+	if m != nil {
+		return m.GasWanted
+	}
+	return 0
+}
+
+func (m *FeeDetails) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *FeeDetails) GetMsgCount() uint64 {
+	if m != nil {
+		return m.MsgCount
+	}
+	return 0
+}
+
+// MaincoinSaleDetails describes maincoin sold back to the reserve
+type MaincoinSaleDetails struct {
+	Sold     types.Coin `protobuf:"bytes,1,opt,name=sold,proto3" json:"sold"`
+	Proceeds types.Coin `protobuf:"bytes,2,opt,name=proceeds,proto3" json:"proceeds"`
+}
+
+func (m *MaincoinSaleDetails) Reset()         { *m = MaincoinSaleDetails{} }
+func (m *MaincoinSaleDetails) String() string { return proto.CompactTextString(m) }
+func (*MaincoinSaleDetails) ProtoMessage()    {}
+func (*MaincoinSaleDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea1c7a7dfa236aa2, []int{9}
+}
+func (m *MaincoinSaleDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaincoinSaleDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaincoinSaleDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaincoinSaleDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaincoinSaleDetails.Merge(m, src)
+}
+func (m *MaincoinSaleDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaincoinSaleDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaincoinSaleDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaincoinSaleDetails proto.InternalMessageInfo
+
+func (m *MaincoinSaleDetails) GetSold() types.Coin {
+	if m != nil {
+		return m.Sold
+	}
+	return types.Coin{}
+}
+
+func (m *MaincoinSaleDetails) GetProceeds() types.Coin {
+	if m != nil {
+		return m.Proceeds
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*TransactionRecord)(nil), "mychain.mychain.v1.TransactionRecord")
+	proto.RegisterType((*DexOrderDetails)(nil), "mychain.mychain.v1.DexOrderDetails")
+	proto.RegisterType((*DexFillDetails)(nil), "mychain.mychain.v1.DexFillDetails")
+	proto.RegisterType((*SegmentPurchaseDetails)(nil), "mychain.mychain.v1.SegmentPurchaseDetails")
+	proto.RegisterType((*BridgeDetails)(nil), "mychain.mychain.v1.BridgeDetails")
+	proto.RegisterType((*StakingRewardDetails)(nil), "mychain.mychain.v1.StakingRewardDetails")
+	proto.RegisterType((*LCRewardClaimDetails)(nil), "mychain.mychain.v1.LCRewardClaimDetails")
+	proto.RegisterType((*DevVestingDetails)(nil), "mychain.mychain.v1.DevVestingDetails")
+	proto.RegisterType((*FeeDetails)(nil), "mychain.mychain.v1.FeeDetails")
+	proto.RegisterType((*MaincoinSaleDetails)(nil), "mychain.mychain.v1.MaincoinSaleDetails")
+}
+
+func init() {
+	proto.RegisterFile("mychain/mychain/v1/tx_history.proto", fileDescriptor_ea1c7a7dfa236aa2)
+}
+
+var fileDescriptor_ea1c7a7dfa236aa2 = []byte{
+	// 1222 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x13, 0x47,
+	0x14, 0x8f, 0x13, 0xc7, 0x7f, 0x5e, 0x88, 0x03, 0xd3, 0x00, 0x9b, 0x40, 0x9d, 0xd4, 0xa8, 0xc2,
+	0x6a, 0xc5, 0xba, 0x81, 0xf6, 0x50, 0x21, 0x15, 0xd5, 0x09, 0x88, 0x48, 0xfc, 0xeb, 0x42, 0x8b,
+	0xd4, 0x1e, 0x56, 0xe3, 0x99, 0x97, 0xf5, 0x28, 0xbb, 0x3b, 0xee, 0xce, 0xd8, 0x24, 0x9f, 0xa0,
+	0x97, 0x1e, 0xf8, 0x1c, 0x3d, 0xf7, 0xd0, 0x8f, 0xc0, 0x11, 0xb5, 0x17, 0xd4, 0x03, 0x54, 0x70,
+	0xee, 0x77, 0xa8, 0x66, 0x76, 0xd6, 0x36, 0xc5, 0x20, 0x53, 0xf5, 0xe4, 0x7d, 0xef, 0xfd, 0xde,
+	0xcf, 0xf3, 0xde, 0xcc, 0xfc, 0xde, 0xc0, 0x85, 0xe4, 0x98, 0xf5, 0xa9, 0x48, 0x3b, 0xc5, 0xef,
+	0x68, 0xa7, 0xa3, 0x8f, 0xc2, 0xbe, 0x50, 0x5a, 0x66, 0xc7, 0xfe, 0x20, 0x93, 0x5a, 0x12, 0xe2,
+	0x82, 0x7e, 0xf1, 0x3b, 0xda, 0xd9, 0x6c, 0x32, 0xa9, 0x12, 0xa9, 0x3a, 0x3d, 0xaa, 0xb0, 0x33,
+	0xda, 0xe9, 0xa1, 0xa6, 0x3b, 0x1d, 0x26, 0x45, 0x9a, 0xe7, 0x6c, 0x6e, 0xe4, 0xf1, 0xd0, 0x5a,
+	0x9d, 0xdc, 0x70, 0xa1, 0xf5, 0x48, 0x46, 0x32, 0xf7, 0x9b, 0x2f, 0xe7, 0xdd, 0x8a, 0xa4, 0x8c,
+	0x62, 0xec, 0x58, 0xab, 0x37, 0x3c, 0xe8, 0x68, 0x91, 0xa0, 0xd2, 0x34, 0x19, 0xe4, 0x80, 0xd6,
+	0x6f, 0x35, 0x38, 0xf5, 0x20, 0xa3, 0xa9, 0xa2, 0x4c, 0x0b, 0x99, 0x06, 0xc8, 0x64, 0xc6, 0xc9,
+	0x59, 0xa8, 0x9a, 0xf5, 0x52, 0xd5, 0xf7, 0x4a, 0xdb, 0xa5, 0x76, 0x3d, 0xa8, 0xe8, 0xa3, 0x9b,
+	0x54, 0xf5, 0x09, 0x81, 0xb2, 0x3e, 0x1e, 0xa0, 0xb7, 0x68, 0xbd, 0xf6, 0x9b, 0x6c, 0xc3, 0x0a,
+	0x47, 0xc5, 0x32, 0x31, 0x30, 0x0c, 0xde, 0x92, 0x0d, 0x4d, 0xbb, 0x08, 0x83, 0x0a, 0x4d, 0xe4,
+	0x30, 0xd5, 0x5e, 0x79, 0x7b, 0xa9, 0xbd, 0x72, 0x79, 0xc3, 0x77, 0x4b, 0x37, 0x75, 0xfa, 0xae,
+	0x4e, 0x7f, 0x57, 0x8a, 0xb4, 0xfb, 0xd9, 0x93, 0xe7, 0x5b, 0x0b, 0xbf, 0xbc, 0xd8, 0x6a, 0x47,
+	0x42, 0xf7, 0x87, 0x3d, 0x9f, 0xc9, 0xc4, 0xd5, 0xe9, 0x7e, 0x2e, 0x29, 0x7e, 0xd8, 0x31, 0xff,
+	0xab, 0x6c, 0x82, 0x0a, 0x1c, 0xb5, 0x59, 0xda, 0x41, 0x26, 0x13, 0x6f, 0x39, 0x5f, 0x9a, 0xf9,
+	0x26, 0x0d, 0x58, 0xd4, 0xd2, 0xab, 0x58, 0xcf, 0xa2, 0x96, 0xe4, 0x0c, 0x54, 0xfa, 0x28, 0xa2,
+	0xbe, 0xf6, 0xaa, 0xdb, 0xa5, 0xf6, 0x52, 0xe0, 0x2c, 0x72, 0x1e, 0xea, 0xe3, 0xc6, 0x78, 0x35,
+	0x0b, 0x9f, 0x38, 0x88, 0x07, 0x55, 0xca, 0x79, 0x86, 0x4a, 0x79, 0x75, 0x1b, 0x2b, 0x4c, 0x72,
+	0x0e, 0xea, 0x89, 0x8a, 0x42, 0x91, 0x72, 0x3c, 0xf2, 0xc0, 0x52, 0xd6, 0x12, 0x15, 0xed, 0x1b,
+	0x9b, 0x6c, 0x42, 0x4d, 0xe1, 0x8f, 0x43, 0x4c, 0x19, 0x7a, 0x2b, 0xdb, 0xa5, 0x76, 0x39, 0x18,
+	0xdb, 0xe4, 0x22, 0xac, 0xc5, 0x18, 0x51, 0x76, 0x1c, 0x26, 0xa8, 0x29, 0xa7, 0x9a, 0x7a, 0x27,
+	0x2c, 0x75, 0x23, 0x77, 0xdf, 0x76, 0x5e, 0xd2, 0x85, 0x3a, 0xc7, 0xa3, 0x50, 0x66, 0x1c, 0x33,
+	0x6f, 0x7d, 0xbb, 0xd4, 0x5e, 0xb9, 0x7c, 0xc1, 0x7f, 0xf3, 0xe4, 0xf8, 0x7b, 0x78, 0x74, 0xd7,
+	0x60, 0xf6, 0x50, 0x53, 0x11, 0xab, 0x9b, 0x0b, 0x41, 0x8d, 0x3b, 0x17, 0xb9, 0x06, 0xe6, 0x3b,
+	0x3c, 0x10, 0x71, 0xec, 0x9d, 0xb6, 0x14, 0xad, 0xb7, 0x50, 0xdc, 0x10, 0x71, 0x3c, 0x61, 0xa8,
+	0xf2, 0xdc, 0x43, 0x1e, 0xc2, 0x49, 0x85, 0x51, 0x82, 0xa9, 0x0e, 0x07, 0xc3, 0x8c, 0xf5, 0xa9,
+	0x42, 0xef, 0x8c, 0x25, 0xfa, 0x64, 0x16, 0xd1, 0xfd, 0x1c, 0x7b, 0xcf, 0x41, 0x27, 0x84, 0x6b,
+	0xea, 0xf5, 0x08, 0xb9, 0x0a, 0x95, 0x5e, 0x26, 0x78, 0x84, 0xde, 0x59, 0x4b, 0xf7, 0xd1, 0x2c,
+	0xba, 0xae, 0x45, 0x4c, 0x58, 0x5c, 0x0a, 0xf9, 0x06, 0x1a, 0x4a, 0xd3, 0x43, 0x91, 0x46, 0x61,
+	0x86, 0x8f, 0x68, 0xc6, 0x3d, 0xcf, 0x92, 0xb4, 0x67, 0xae, 0x29, 0x47, 0x06, 0x16, 0x38, 0xe1,
+	0x5a, 0x55, 0xd3, 0x7e, 0x12, 0xc0, 0x5a, 0xcc, 0x1c, 0x5b, 0xc8, 0x62, 0x2a, 0x12, 0x6f, 0xe3,
+	0xed, 0x9c, 0xb7, 0x76, 0xf3, 0xb4, 0x5d, 0x03, 0x9c, 0xe2, 0x8c, 0xd9, 0x94, 0x9f, 0xdc, 0x34,
+	0xd7, 0x63, 0x14, 0x8e, 0x50, 0x69, 0x91, 0x46, 0xde, 0xa6, 0xe5, 0xfb, 0x78, 0xf6, 0x06, 0x8c,
+	0xbe, 0xcb, 0x51, 0x13, 0x32, 0xe0, 0x63, 0x27, 0xb9, 0x0c, 0x4b, 0x07, 0x88, 0xde, 0x39, 0xcb,
+	0xd0, 0x9c, 0xc5, 0x70, 0x03, 0xa7, 0xfa, 0x64, 0xc0, 0xe4, 0x0e, 0xac, 0x26, 0x54, 0xa4, 0x46,
+	0x43, 0x42, 0x45, 0x63, 0xf4, 0xce, 0xdb, 0xec, 0x8b, 0xb3, 0xb2, 0x6f, 0x3b, 0xe0, 0x7d, 0x1a,
+	0x4f, 0xd1, 0x9c, 0x48, 0xa6, 0xdc, 0xdd, 0x3a, 0x54, 0x79, 0x1e, 0x6a, 0xfd, 0x51, 0x82, 0xb5,
+	0x7f, 0x1d, 0x3b, 0xb2, 0x01, 0x35, 0x7b, 0x54, 0x43, 0xc1, 0xad, 0x72, 0x94, 0x83, 0xaa, 0xb5,
+	0xf7, 0xad, 0xa6, 0x0c, 0xa8, 0xb0, 0x91, 0x45, 0x1b, 0xa9, 0x18, 0x73, 0x9f, 0x93, 0xd3, 0x50,
+	0x11, 0x2a, 0xec, 0x0d, 0x8f, 0xad, 0x74, 0xd4, 0x82, 0x65, 0xa1, 0xba, 0xc3, 0x63, 0xf2, 0x05,
+	0x2c, 0x0f, 0x32, 0xc1, 0xd0, 0x2b, 0xdb, 0x15, 0xbf, 0x43, 0x33, 0xca, 0x46, 0x33, 0x82, 0x1c,
+	0x4d, 0xbe, 0x02, 0x60, 0x34, 0x65, 0x18, 0x87, 0xa6, 0x57, 0xcb, 0xf3, 0xe5, 0xd6, 0xf3, 0x94,
+	0x1b, 0x88, 0xad, 0x67, 0x25, 0x68, 0xbc, 0x7e, 0x13, 0xde, 0x55, 0x54, 0x1b, 0x4e, 0x32, 0xa3,
+	0x3e, 0x98, 0x85, 0x63, 0x48, 0x5e, 0x5d, 0xc3, 0xf9, 0xef, 0x3a, 0xe4, 0xff, 0x5b, 0xe5, 0x15,
+	0x28, 0x1f, 0x20, 0xaa, 0x79, 0xeb, 0xb3, 0xe0, 0xd6, 0xcf, 0x4b, 0x70, 0x66, 0xf6, 0xdd, 0x34,
+	0x7c, 0x03, 0xea, 0xca, 0x9b, 0x87, 0xcf, 0x80, 0xc9, 0x35, 0x28, 0x33, 0xa9, 0x74, 0x3e, 0x0c,
+	0xba, 0x9f, 0x9a, 0xc8, 0x9f, 0xcf, 0xb7, 0x4e, 0xe7, 0xb9, 0x8a, 0x1f, 0xfa, 0x42, 0x76, 0x12,
+	0xaa, 0xfb, 0xfe, 0x7e, 0xaa, 0x7f, 0xff, 0xf5, 0x12, 0x38, 0xd2, 0xfd, 0x54, 0x07, 0x36, 0x91,
+	0x3c, 0x80, 0x35, 0x2d, 0x0f, 0x31, 0x55, 0x61, 0x86, 0x0c, 0xc5, 0x08, 0x79, 0x3e, 0x3d, 0xde,
+	0x8f, 0xab, 0x91, 0x73, 0x04, 0x8e, 0x82, 0x04, 0xd0, 0x30, 0x17, 0x8e, 0xc6, 0xb1, 0x64, 0xd4,
+	0x8e, 0xa4, 0xf2, 0xfb, 0x93, 0xae, 0x72, 0x1c, 0x7d, 0x3d, 0x66, 0x20, 0x17, 0xc0, 0x28, 0x45,
+	0xa6, 0x43, 0xa7, 0x60, 0xb6, 0xf1, 0xe5, 0xe0, 0x84, 0x75, 0xba, 0x9e, 0x92, 0x4b, 0x40, 0x5c,
+	0xd8, 0x4e, 0x68, 0x86, 0x4a, 0x21, 0xb7, 0xd3, 0xa7, 0x1c, 0x9c, 0x2a, 0x22, 0xf7, 0x8a, 0x40,
+	0xeb, 0xef, 0x12, 0xac, 0xbe, 0xa6, 0x6d, 0x66, 0x0c, 0x71, 0x91, 0xa1, 0x9d, 0xc4, 0x6e, 0xf0,
+	0x4e, 0x1c, 0x66, 0x8f, 0x86, 0x8a, 0x33, 0xdb, 0xee, 0x79, 0xf6, 0xc8, 0x80, 0xc9, 0x97, 0x50,
+	0xd5, 0xa8, 0xf4, 0x50, 0xe5, 0xad, 0x9d, 0x23, 0xaf, 0xc0, 0x93, 0x3b, 0x50, 0x1f, 0x60, 0x14,
+	0x66, 0xa6, 0x03, 0xae, 0x85, 0x3b, 0xae, 0x85, 0xe7, 0xde, 0x6c, 0xe1, 0x2d, 0x3b, 0xb7, 0xf6,
+	0x90, 0x4d, 0x35, 0x72, 0x0f, 0x59, 0x50, 0x1b, 0x60, 0x14, 0x18, 0x8a, 0xd6, 0xe7, 0xb0, 0x3e,
+	0x4b, 0x85, 0x4d, 0xd5, 0x23, 0x1a, 0x0b, 0x4e, 0xb5, 0xcc, 0x8a, 0xaa, 0xc7, 0x8e, 0xd6, 0x0f,
+	0xb0, 0x3e, 0x4b, 0x67, 0xc9, 0x2e, 0x2c, 0xd1, 0x81, 0xc3, 0xff, 0x97, 0x75, 0x99, 0xec, 0x96,
+	0x86, 0x53, 0x6f, 0x88, 0xae, 0x19, 0xf7, 0xc5, 0x2e, 0xbb, 0xdb, 0xee, 0x4c, 0x72, 0x1d, 0x56,
+	0x9c, 0x8c, 0x87, 0x98, 0x72, 0xb7, 0x11, 0x9b, 0x7e, 0xfe, 0xc6, 0xf2, 0x8b, 0x37, 0x96, 0xff,
+	0xa0, 0x78, 0x39, 0x74, 0x6b, 0x66, 0x5d, 0x8f, 0x5f, 0x6c, 0x95, 0x02, 0x70, 0x89, 0xd7, 0x53,
+	0xde, 0x62, 0x00, 0x13, 0xa1, 0x26, 0x1f, 0x02, 0x44, 0x54, 0x85, 0x8f, 0x68, 0xaa, 0xb1, 0xd0,
+	0x97, 0x7a, 0x44, 0xd5, 0x43, 0xeb, 0x30, 0xe2, 0x63, 0xc2, 0x43, 0x73, 0x94, 0x72, 0x65, 0xa9,
+	0x46, 0x54, 0x7d, 0xab, 0x90, 0x17, 0xaf, 0x0f, 0x2b, 0x34, 0x76, 0x77, 0xcb, 0xf6, 0xf5, 0xb1,
+	0x6b, 0xec, 0xd6, 0x4f, 0x25, 0xf8, 0x60, 0x86, 0xa0, 0x9b, 0x53, 0xa4, 0x64, 0x3c, 0xff, 0x4d,
+	0x37, 0x60, 0x72, 0x15, 0x6a, 0xf6, 0x40, 0x23, 0x57, 0xf3, 0x1e, 0xbf, 0x71, 0x42, 0x77, 0xe7,
+	0xc9, 0xcb, 0x66, 0xe9, 0xe9, 0xcb, 0x66, 0xe9, 0xaf, 0x97, 0xcd, 0xd2, 0xe3, 0x57, 0xcd, 0x85,
+	0xa7, 0xaf, 0x9a, 0x0b, 0xcf, 0x5e, 0x35, 0x17, 0xbe, 0x3f, 0x5b, 0xbc, 0x8f, 0x8f, 0xc6, 0x2f,
+	0x65, 0xfb, 0xb2, 0xeb, 0x55, 0x6c, 0x2f, 0xaf, 0xfc, 0x13, 0x00, 0x00, 0xff, 0xff, 0x1a, 0xef,
+	0x2f, 0x75, 0x49, 0x0b, 0x00, 0x00,
+}
+
+func (m *TransactionRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Details != nil {
+		{
+			size := m.Details.Size()
+			i -= size
+			if _, err := m.Details.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.LegacyMetadata) > 0 {
+		i -= len(m.LegacyMetadata)
+		copy(dAtA[i:], m.LegacyMetadata)
+		i = encodeVarintTxHistory(dAtA, i, uint64(len(m.LegacyMetadata)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTxHistory(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MsgIndex != 0 {
+		i = encodeVarintTxHistory(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTxHistory(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Timestamp) > 0 {
+		i -= len(m.Timestamp)
+		copy(dAtA[i:], m.Timestamp)
+		i = encodeVarintTxHistory(dAtA, i, uint64(len(m.Timestamp)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Height != 0 {
+		i = encodeVarintTxHistory(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTxHistory(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTxHistory(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTxHistory(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTxHistory(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTxHistory(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTxHistory(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransactionRecord_DexOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionRecord_DexOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DexOrder != nil {
+		{
+			size, err := m.DexOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTxHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TransactionRecord_DexFill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionRecord_DexFill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DexFill != nil {
+		{
+			size, err := m.DexFill.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTxHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TransactionRecord_SegmentPurchase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionRecord_SegmentPurchase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SegmentPurchase != nil {
+		{
+			size, err := m.SegmentPurchase.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTxHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TransactionRecord_Bridge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionRecord_Bridge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Bridge != nil {
+		{
+			size, err := m.Bridge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTxHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TransactionRecord_StakingReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionRecord_StakingReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.StakingReward != nil {
+		{
+			size, err := m.StakingReward.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTxHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TransactionRecord_LcRewardClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionRecord_LcRewardClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.LcRewardClaim != nil {
+		{
+			size, err := m.LcRewardClaim.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTxHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TransactionRecord_DevVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionRecord_DevVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DevVesting != nil {
+		{
+			size, err := m.DevVesting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTxHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TransactionRecord_Fee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionRecord_Fee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Fee != nil {
+		{
+			size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTxHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TransactionRecord_MaincoinSale) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionRecord_MaincoinSale) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MaincoinSale != nil {
+		{
+			size, err := m.MaincoinSale.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTxHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *DexOrderDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DexOrderDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DexOrderDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CancelFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTxHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTxHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.IsBuy {
+		i--
+		if m.IsBuy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PairId != 0 {
+		i = encodeVarintTxHistory(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintTxHistory(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DexFillDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DexFillDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DexFillDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTxHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTxHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.IsBuy {
+		i--
+		if m.IsBuy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CounterOrderId != 0 {
+		i = encodeVarintTxHistory(dAtA, i, uint64(m.CounterOrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintTxHistory(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SegmentPurchaseDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SegmentPurchaseDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SegmentPurchaseDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SegmentsProcessed != 0 {
+		i = encodeVarintTxHistory(dAtA, i, uint64(m.SegmentsProcessed))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartSegment != 0 {
+		i = encodeVarintTxHistory(dAtA, i, uint64(m.StartSegment))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.DevAllocation.Size()
+		i -= size
+		if _, err := m.DevAllocation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTxHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TokensReceived.Size()
+		i -= size
+		if _, err := m.TokensReceived.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTxHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Cost.Size()
+		i -= size
+		if _, err := m.Cost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTxHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Paid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTxHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PegRatio.Size()
+		i -= size
+		if _, err := m.PegRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTxHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Testusd.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTxHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Usdc.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTxHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Direction) > 0 {
+		i -= len(m.Direction)
+		copy(dAtA[i:], m.Direction)
+		i = encodeVarintTxHistory(dAtA, i, uint64(len(m.Direction)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakingRewardDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakingRewardDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakingRewardDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTxHistory(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LCRewardClaimDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LCRewardClaimDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LCRewardClaimDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Apr.Size()
+		i -= size
+		if _, err := m.Apr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTxHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DevVestingDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DevVestingDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DevVestingDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.VestingEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VestingEnd):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintTxHistory(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x12
+	if m.Segment != 0 {
+		i = encodeVarintTxHistory(dAtA, i, uint64(m.Segment))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MsgCount != 0 {
+		i = encodeVarintTxHistory(dAtA, i, uint64(m.MsgCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintTxHistory(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GasWanted != 0 {
+		i = encodeVarintTxHistory(dAtA, i, uint64(m.GasWanted))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MaincoinSaleDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaincoinSaleDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaincoinSaleDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proceeds.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTxHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Sold.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTxHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTxHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTxHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TransactionRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTxHistory(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTxHistory(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTxHistory(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTxHistory(uint64(l))
+		}
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTxHistory(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTxHistory(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTxHistory(uint64(m.Height))
+	}
+	l = len(m.Timestamp)
+	if l > 0 {
+		n += 1 + l + sovTxHistory(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTxHistory(uint64(l))
+	}
+	if m.MsgIndex != 0 {
+		n += 1 + sovTxHistory(uint64(m.MsgIndex))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTxHistory(uint64(m.Sequence))
+	}
+	l = len(m.LegacyMetadata)
+	if l > 0 {
+		n += 1 + l + sovTxHistory(uint64(l))
+	}
+	if m.Details != nil {
+		n += m.Details.Size()
+	}
+	return n
+}
+
+func (m *TransactionRecord_DexOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DexOrder != nil {
+		l = m.DexOrder.Size()
+		n += 2 + l + sovTxHistory(uint64(l))
+	}
+	return n
+}
+func (m *TransactionRecord_DexFill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DexFill != nil {
+		l = m.DexFill.Size()
+		n += 2 + l + sovTxHistory(uint64(l))
+	}
+	return n
+}
+func (m *TransactionRecord_SegmentPurchase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SegmentPurchase != nil {
+		l = m.SegmentPurchase.Size()
+		n += 2 + l + sovTxHistory(uint64(l))
+	}
+	return n
+}
+func (m *TransactionRecord_Bridge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Bridge != nil {
+		l = m.Bridge.Size()
+		n += 2 + l + sovTxHistory(uint64(l))
+	}
+	return n
+}
+func (m *TransactionRecord_StakingReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StakingReward != nil {
+		l = m.StakingReward.Size()
+		n += 2 + l + sovTxHistory(uint64(l))
+	}
+	return n
+}
+func (m *TransactionRecord_LcRewardClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LcRewardClaim != nil {
+		l = m.LcRewardClaim.Size()
+		n += 2 + l + sovTxHistory(uint64(l))
+	}
+	return n
+}
+func (m *TransactionRecord_DevVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DevVesting != nil {
+		l = m.DevVesting.Size()
+		n += 2 + l + sovTxHistory(uint64(l))
+	}
+	return n
+}
+func (m *TransactionRecord_Fee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fee != nil {
+		l = m.Fee.Size()
+		n += 2 + l + sovTxHistory(uint64(l))
+	}
+	return n
+}
+func (m *TransactionRecord_MaincoinSale) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaincoinSale != nil {
+		l = m.MaincoinSale.Size()
+		n += 2 + l + sovTxHistory(uint64(l))
+	}
+	return n
+}
+func (m *DexOrderDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovTxHistory(uint64(m.OrderId))
+	}
+	if m.PairId != 0 {
+		n += 1 + sovTxHistory(uint64(m.PairId))
+	}
+	if m.IsBuy {
+		n += 2
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTxHistory(uint64(l))
+	l = m.CancelFee.Size()
+	n += 1 + l + sovTxHistory(uint64(l))
+	return n
+}
+
+func (m *DexFillDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovTxHistory(uint64(m.OrderId))
+	}
+	if m.CounterOrderId != 0 {
+		n += 1 + sovTxHistory(uint64(m.CounterOrderId))
+	}
+	if m.IsBuy {
+		n += 2
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTxHistory(uint64(l))
+	l = m.Fees.Size()
+	n += 1 + l + sovTxHistory(uint64(l))
+	return n
+}
+
+func (m *SegmentPurchaseDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Paid.Size()
+	n += 1 + l + sovTxHistory(uint64(l))
+	l = m.Cost.Size()
+	n += 1 + l + sovTxHistory(uint64(l))
+	l = m.TokensReceived.Size()
+	n += 1 + l + sovTxHistory(uint64(l))
+	l = m.DevAllocation.Size()
+	n += 1 + l + sovTxHistory(uint64(l))
+	if m.StartSegment != 0 {
+		n += 1 + sovTxHistory(uint64(m.StartSegment))
+	}
+	if m.SegmentsProcessed != 0 {
+		n += 1 + sovTxHistory(uint64(m.SegmentsProcessed))
+	}
+	return n
+}
+
+func (m *BridgeDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Direction)
+	if l > 0 {
+		n += 1 + l + sovTxHistory(uint64(l))
+	}
+	l = m.Usdc.Size()
+	n += 1 + l + sovTxHistory(uint64(l))
+	l = m.Testusd.Size()
+	n += 1 + l + sovTxHistory(uint64(l))
+	l = m.PegRatio.Size()
+	n += 1 + l + sovTxHistory(uint64(l))
+	return n
+}
+
+func (m *StakingRewardDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTxHistory(uint64(l))
+	}
+	return n
+}
+
+func (m *LCRewardClaimDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Apr.Size()
+	n += 1 + l + sovTxHistory(uint64(l))
+	return n
+}
+
+func (m *DevVestingDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Segment != 0 {
+		n += 1 + sovTxHistory(uint64(m.Segment))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VestingEnd)
+	n += 1 + l + sovTxHistory(uint64(l))
+	return n
+}
+
+func (m *FeeDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasWanted != 0 {
+		n += 1 + sovTxHistory(uint64(m.GasWanted))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovTxHistory(uint64(m.GasUsed))
+	}
+	if m.MsgCount != 0 {
+		n += 1 + sovTxHistory(uint64(m.MsgCount))
+	}
+	return n
+}
+
+func (m *MaincoinSaleDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Sold.Size()
+	n += 1 + l + sovTxHistory(uint64(l))
+	l = m.Proceeds.Size()
+	n += 1 + l + sovTxHistory(uint64(l))
+	return n
+}
+
+func sovTxHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTxHistory(x uint64) (n int) {
+	return sovTxHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TransactionRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timestamp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyMetadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LegacyMetadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DexOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DexOrderDetails{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Details = &TransactionRecord_DexOrder{v}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DexFill", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DexFillDetails{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Details = &TransactionRecord_DexFill{v}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentPurchase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SegmentPurchaseDetails{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Details = &TransactionRecord_SegmentPurchase{v}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bridge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BridgeDetails{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Details = &TransactionRecord_Bridge{v}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &StakingRewardDetails{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Details = &TransactionRecord_StakingReward{v}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LcRewardClaim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &LCRewardClaimDetails{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Details = &TransactionRecord_LcRewardClaim{v}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DevVestingDetails{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Details = &TransactionRecord_DevVesting{v}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &FeeDetails{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Details = &TransactionRecord_Fee{v}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaincoinSale", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MaincoinSaleDetails{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Details = &TransactionRecord_MaincoinSale{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DexOrderDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DexOrderDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DexOrderDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			m.PairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBuy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsBuy = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CancelFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DexFillDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DexFillDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DexFillDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterOrderId", wireType)
+			}
+			m.CounterOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CounterOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBuy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsBuy = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SegmentPurchaseDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SegmentPurchaseDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SegmentPurchaseDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Paid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensReceived", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokensReceived.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevAllocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DevAllocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartSegment", wireType)
+			}
+			m.StartSegment = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartSegment |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentsProcessed", wireType)
+			}
+			m.SegmentsProcessed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SegmentsProcessed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Direction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usdc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usdc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Testusd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Testusd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PegRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PegRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakingRewardDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakingRewardDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakingRewardDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LCRewardClaimDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LCRewardClaimDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LCRewardClaimDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Apr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DevVestingDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DevVestingDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DevVestingDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Segment", wireType)
+			}
+			m.Segment = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Segment |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingEnd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.VestingEnd, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
+			}
+			m.GasWanted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasWanted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgCount", wireType)
+			}
+			m.MsgCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaincoinSaleDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaincoinSaleDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaincoinSaleDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proceeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proceeds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTxHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTxHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTxHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTxHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTxHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTxHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTxHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTxHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTxHistory = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+    "context"
+
+    "cosmossdk.io/collections"
+    sdk "github.com/cosmos/cosmos-sdk/types"
+
+    "mychain/x/testusd/types"
+)
+
+// addBridgeRecord stores record under its address with the next sequence
+// and the current block, and returns the sequence.
+func (k Keeper) addBridgeRecord(ctx context.Context, record types.BridgeRecord) (uint64, error) {
+    seq, err := k.BridgeRecordSeq.Next(ctx)
+    if err != nil {
+        return 0, err
+    }
+
+    sdkCtx := sdk.UnwrapSDKContext(ctx)
+    record.Sequence = seq
+    record.Height = sdkCtx.BlockHeight()
+    record.Timestamp = sdkCtx.BlockTime().Unix()
+    return seq, k.BridgeRecords.Set(ctx, collections.Join(record.Address, seq), record)
+}
+
+// updateBridgeRecord applies update to the record of address at seq.
+func (k Keeper) updateBridgeRecord(ctx context.Context, address string, seq uint64, update func(*types.BridgeRecord)) error {
+    key := collections.Join(address, seq)
+    record, err := k.BridgeRecords.Get(ctx, key)
+    if err != nil {
+        return err
+    }
+    update(&record)
+    return k.BridgeRecords.Set(ctx, key, record)
+}
+
+// setBridgeRecordStatus sets the status of the record of address at seq.
+func (k Keeper) setBridgeRecordStatus(ctx context.Context, address string, seq uint64, status string) error {
+    return k.updateBridgeRecord(ctx, address, seq, func(record *types.BridgeRecord) {
+        record.Status = status
+    })
+}
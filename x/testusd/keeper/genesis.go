@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
     "context"
 
@@ -10,29 +11,201 @@ import (
 )
 
 // InitGenesis initializes the module's state from a provided genesis state.
-func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) {
-    k.SetParams(ctx, genState.Params)
+func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
+    if err := genState.Validate(); err != nil {
+        return err
+    }
+    if err := k.Params.Set(ctx, genState.Params); err != nil {
+        return err
+    }
     
     sdkCtx := sdk.UnwrapSDKContext(ctx)
-    if genState.TotalBridged.IsNil() {
-        k.SetTotalBridged(sdkCtx, math.ZeroInt())
-    } else {
-        k.SetTotalBridged(sdkCtx, genState.TotalBridged)
+    totalBridged, totalSupply := genState.TotalBridged, genState.TotalSupply
+    if totalBridged.IsNil() {
+        totalBridged = math.ZeroInt()
     }
-    
-    if genState.TotalSupply.IsNil() {
-        k.SetTotalSupply(sdkCtx, math.ZeroInt())
-    } else {
-        k.SetTotalSupply(sdkCtx, genState.TotalSupply)
+    if totalSupply.IsNil() {
+        totalSupply = math.ZeroInt()
+    }
+    if err := k.TrackedBridged.Set(ctx, totalBridged); err != nil {
+        return err
+    }
+    if err := k.TrackedSupply.Set(ctx, totalSupply); err != nil {
+        return err
+    }
+    if err := k.Statistics.Set(ctx, genState.Statistics); err != nil {
+        return err
+    }
+
+    for _, pending := range genState.PendingBridgeOuts {
+        if err := k.PendingBridgeOuts.Set(ctx, collections.Join(pending.ChannelId, pending.Sequence), pending); err != nil {
+            return err
+        }
+    }
+
+    if err := k.Pause.Set(ctx, genState.Pause); err != nil {
+        return err
+    }
+    for _, bucket := range genState.FlowBuckets {
+        if err := k.FlowBuckets.Set(ctx, bucket.Start, bucket); err != nil {
+            return err
+        }
+    }
+    for _, flow := range genState.AddressFlows {
+        if err := k.AddressFlows.Set(ctx, collections.Join(flow.Day, flow.Address), flow); err != nil {
+            return err
+        }
+    }
+    for _, attestation := range genState.Attestations {
+        if err := k.Attestations.Set(ctx, attestation.Id, attestation); err != nil {
+            return err
+        }
     }
+    if err := k.AttestationSeq.Set(ctx, uint64(len(genState.Attestations))); err != nil {
+        return err
+    }
+
+    var nextRecord uint64
+    for _, record := range genState.BridgeRecords {
+        if err := k.BridgeRecords.Set(ctx, collections.Join(record.Address, record.Sequence), record); err != nil {
+            return err
+        }
+        if record.Sequence >= nextRecord {
+            nextRecord = record.Sequence + 1
+        }
+    }
+    if err := k.BridgeRecordSeq.Set(ctx, max(nextRecord, genState.NextBridgeRecordSequence)); err != nil {
+        return err
+    }
+
+    var nextWithdrawal uint64
+    for _, withdrawal := range genState.PendingWithdrawals {
+        if err := k.setWithdrawal(sdkCtx, withdrawal); err != nil {
+            return err
+        }
+        if withdrawal.Id >= nextWithdrawal {
+            nextWithdrawal = withdrawal.Id + 1
+        }
+    }
+    if err := k.WithdrawalSeq.Set(ctx, max(nextWithdrawal, genState.NextWithdrawalId)); err != nil {
+        return err
+    }
+
+    if genState.CollateralPrice != nil {
+        if err := k.CollateralPrice.Set(ctx, *genState.CollateralPrice); err != nil {
+            return err
+        }
+    }
+
+    return nil
 }
 
 // ExportGenesis returns the module's exported genesis state.
-func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
-    sdkCtx := sdk.UnwrapSDKContext(ctx)
-    return &types.GenesisState{
-        Params:       k.GetParams(ctx),
-        TotalBridged: k.GetTotalBridged(sdkCtx),
-        TotalSupply:  k.GetTotalSupply(sdkCtx),
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+    params, err := k.GetParams(ctx)
+    if err != nil {
+        return nil, err
     }
+    totalBridged, err := k.GetTotalBridged(ctx)
+    if err != nil {
+        return nil, err
+    }
+    totalSupply, err := k.GetTotalSupply(ctx)
+    if err != nil {
+        return nil, err
+    }
+    stats, err := k.GetBridgeStatistics(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    pending := []types.PendingBridgeOut{}
+    err = k.PendingBridgeOuts.Walk(ctx, nil, func(_ collections.Pair[string, uint64], value types.PendingBridgeOut) (bool, error) {
+        pending = append(pending, value)
+        return false, nil
+    })
+    if err != nil {
+        return nil, err
+    }
+
+    pause, err := k.GetPause(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    buckets := []types.FlowBucket{}
+    err = k.FlowBuckets.Walk(ctx, nil, func(_ int64, bucket types.FlowBucket) (bool, error) {
+        buckets = append(buckets, bucket)
+        return false, nil
+    })
+    if err != nil {
+        return nil, err
+    }
+
+    flows := []types.AddressFlow{}
+    err = k.AddressFlows.Walk(ctx, nil, func(_ collections.Pair[int64, string], flow types.AddressFlow) (bool, error) {
+        flows = append(flows, flow)
+        return false, nil
+    })
+    if err != nil {
+        return nil, err
+    }
+
+    attestations := []types.ReserveAttestation{}
+    err = k.Attestations.Walk(ctx, nil, func(_ uint64, attestation types.ReserveAttestation) (bool, error) {
+        attestations = append(attestations, attestation)
+        return false, nil
+    })
+    if err != nil {
+        return nil, err
+    }
+
+    records := []types.BridgeRecord{}
+    err = k.BridgeRecords.Walk(ctx, nil, func(_ collections.Pair[string, uint64], record types.BridgeRecord) (bool, error) {
+        records = append(records, record)
+        return false, nil
+    })
+    if err != nil {
+        return nil, err
+    }
+
+    withdrawals := []types.PendingWithdrawal{}
+    err = k.Withdrawals.Walk(ctx, nil, func(_ uint64, withdrawal types.PendingWithdrawal) (bool, error) {
+        withdrawals = append(withdrawals, withdrawal)
+        return false, nil
+    })
+    if err != nil {
+        return nil, err
+    }
+
+    collateralPrice, err := k.GetCollateralPrice(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    nextRecord, err := k.BridgeRecordSeq.Peek(ctx)
+    if err != nil {
+        return nil, err
+    }
+    nextWithdrawal, err := k.WithdrawalSeq.Peek(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    return &types.GenesisState{
+        Params:                   params,
+        TotalBridged:             totalBridged,
+        TotalSupply:              totalSupply,
+        PendingBridgeOuts:        pending,
+        Pause:                    pause,
+        FlowBuckets:              buckets,
+        AddressFlows:             flows,
+        Attestations:             attestations,
+        BridgeRecords:            records,
+        PendingWithdrawals:       withdrawals,
+        CollateralPrice:          collateralPrice,
+        Statistics:               stats,
+        NextBridgeRecordSequence: nextRecord,
+        NextWithdrawalId:         nextWithdrawal,
+    }, nil
 }
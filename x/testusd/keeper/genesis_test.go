@@ -3,6 +3,10 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"mychain/testutil/nullify"
 	"mychain/x/testusd/types"
 
@@ -26,3 +30,130 @@ func TestGenesis(t *testing.T) {
 
 	require.Equal(t, genesisState.Params, got.Params)
 }
+
+func populatedGenesis() types.GenesisState {
+	alice := sdk.AccAddress("alice_______________").String()
+	genesis := *types.DefaultGenesis()
+	genesis.Params.UsdcSources = []types.UsdcSource{{ChannelId: "channel-0", Denom: "uusdc"}}
+	genesis.TotalBridged = math.NewInt(900_000)
+	genesis.TotalSupply = math.NewInt(1_000_000)
+	genesis.PendingBridgeOuts = []types.PendingBridgeOut{{
+		Sender:         alice,
+		ChannelId:      "channel-0",
+		Sequence:       3,
+		Testusd:        sdk.NewInt64Coin("utestusd", 100_000),
+		Usdc:           sdk.NewInt64Coin("uusdc", 100_000),
+		RecordSequence: 1,
+		Fee:            sdk.NewInt64Coin("utestusd", 2_000),
+	}}
+	genesis.Pause = types.BridgePause{Paused: true, PausedBy: alice, Reason: "maintenance", PausedAt: 50}
+	genesis.FlowBuckets = []types.FlowBucket{{Start: 3600, Minted: math.NewInt(1_000_000), Burned: math.NewInt(100_000)}}
+	genesis.AddressFlows = []types.AddressFlow{{Address: alice, Day: 1, Minted: math.NewInt(1_000_000), Burned: math.NewInt(100_000)}}
+	genesis.Attestations = []types.ReserveAttestation{{Id: 0, Attestor: alice, Amount: math.NewInt(1_000_000), Timestamp: 40, Signature: []byte("sig"), Height: 4}}
+	genesis.BridgeRecords = []types.BridgeRecord{
+		{Address: alice, Sequence: 0, Direction: types.BridgeDirectionIn, Status: types.BridgeStatusCompleted, Usdc: sdk.NewInt64Coin("uusdc", 1_000_000), Testusd: sdk.NewInt64Coin("utestusd", 1_000_000), Height: 2, Timestamp: 20},
+		{Address: alice, Sequence: 1, Direction: types.BridgeDirectionOut, Status: types.BridgeStatusSent, Usdc: sdk.NewInt64Coin("uusdc", 100_000), Testusd: sdk.NewInt64Coin("utestusd", 100_000), ChannelId: "channel-0", Height: 3, Timestamp: 30},
+		{Address: alice, Sequence: 4, Direction: types.BridgeDirectionOut, Status: types.BridgeStatusQueued, Usdc: sdk.NewInt64Coin("uusdc", 0), Testusd: sdk.NewInt64Coin("utestusd", 50_000), ChannelId: "channel-0", WithdrawalId: 2, Height: 5, Timestamp: 50},
+	}
+	genesis.PendingWithdrawals = []types.PendingWithdrawal{{
+		Id:             2,
+		Sender:         alice,
+		Testusd:        sdk.NewInt64Coin("utestusd", 50_000),
+		ChannelId:      "channel-0",
+		Receiver:       "receiver",
+		RequestedAt:    50,
+		ReleaseAt:      86_450,
+		RecordSequence: 4,
+	}}
+	genesis.CollateralPrice = &types.CollateralPrice{Price: math.LegacyMustNewDecFromStr("0.99"), UpdatedAt: 45, Oracle: alice}
+	genesis.Statistics = types.BridgeStatistics{TotalBridgeInCount: 1, TotalBridgeOutCount: 2, LastBridgeInTimestamp: 20, LastBridgeOutTimestamp: 50}
+	genesis.NextBridgeRecordSequence = 7
+	genesis.NextWithdrawalId = 5
+	return genesis
+}
+
+func TestGenesisRoundTrip(t *testing.T) {
+	genesisState := populatedGenesis()
+	require.NoError(t, genesisState.Validate())
+
+	f := initFixture(t)
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, genesisState))
+	got, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, &genesisState, got)
+
+	// Sequences continue where the exported chain left off
+	next, err := f.keeper.BridgeRecordSeq.Next(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), next)
+	next, err = f.keeper.WithdrawalSeq.Next(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), next)
+	next, err = f.keeper.AttestationSeq.Next(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), next)
+
+	// The withdrawal queue index is rebuilt
+	has, err := f.keeper.WithdrawalQueue.Has(f.ctx, collections.Join(int64(86_450), uint64(2)))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// Importing the export into a fresh store exports the same state
+	g := initFixture(t)
+	require.NoError(t, g.keeper.InitGenesis(g.ctx, *got))
+	reexported, err := g.keeper.ExportGenesis(g.ctx)
+	require.NoError(t, err)
+	require.Equal(t, got, reexported)
+}
+
+func TestGenesisRoundTripDerivesSequences(t *testing.T) {
+	// Genesis files written before the next sequences were exported
+	genesisState := populatedGenesis()
+	genesisState.NextBridgeRecordSequence = 0
+	genesisState.NextWithdrawalId = 0
+
+	f := initFixture(t)
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, genesisState))
+	got, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), got.NextBridgeRecordSequence)
+	require.Equal(t, uint64(3), got.NextWithdrawalId)
+}
+
+func TestInitGenesisInvalid(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		mutate func(*types.GenesisState)
+		errMsg string
+	}{
+		{
+			name:   "invalid params",
+			mutate: func(gs *types.GenesisState) { gs.Params.PegRatio = "" },
+			errMsg: "peg ratio cannot be empty",
+		},
+		{
+			name:   "negative supply",
+			mutate: func(gs *types.GenesisState) { gs.TotalSupply = math.NewInt(-1) },
+			errMsg: "total supply cannot be negative",
+		},
+		{
+			name:   "duplicate withdrawal",
+			mutate: func(gs *types.GenesisState) { gs.PendingWithdrawals = append(gs.PendingWithdrawals, gs.PendingWithdrawals[0]) },
+			errMsg: "duplicate pending withdrawal 2",
+		},
+		{
+			name:   "record past the next sequence",
+			mutate: func(gs *types.GenesisState) { gs.NextBridgeRecordSequence = 4 },
+			errMsg: "bridge record 4 is not below the next sequence 4",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			genesisState := populatedGenesis()
+			tc.mutate(&genesisState)
+
+			f := initFixture(t)
+			err := f.keeper.InitGenesis(f.ctx, genesisState)
+			require.ErrorContains(t, err, tc.errMsg)
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package keeper
+
+import (
+    "errors"
+    "fmt"
+    "time"
+
+    "cosmossdk.io/collections"
+    errorsmod "cosmossdk.io/errors"
+    "cosmossdk.io/math"
+    sdk "github.com/cosmos/cosmos-sdk/types"
+    sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+    transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+    clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+    channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+    mychaintypes "mychain/x/mychain/types"
+    "mychain/x/testusd/types"
+)
+
+// SendUsdc sends usdc from the module account to receiver over the ICS-20
+// channel channelID. The burned testusd and the redeem fee, which the module
+// account holds meanwhile, are kept as a pending bridge out until the packet
+// is acknowledged or times out, so both can be returned to sender if the
+// transfer fails. recordSeq is sender's bridge record of the transfer.
+func (k Keeper) SendUsdc(ctx sdk.Context, sender, channelID, receiver string, testusd, fee, usdc sdk.Coin, recordSeq uint64) (uint64, error) {
+    if k.transferKeeper == nil {
+        return 0, errorsmod.Wrap(types.ErrBridgeTransferFailed, "ibc transfer keeper not set")
+    }
+
+    params, err := k.GetParams(ctx)
+    if err != nil {
+        return 0, err
+    }
+    timeout := ctx.BlockTime().Add(time.Duration(params.IbcTimeoutSeconds) * time.Second)
+
+    msg := transfertypes.NewMsgTransfer(
+        transfertypes.PortID,
+        channelID,
+        usdc,
+        k.accountKeeper.GetModuleAddress(types.ModuleName).String(),
+        receiver,
+        clienttypes.ZeroHeight(),
+        uint64(timeout.UnixNano()),
+        "",
+    )
+    res, err := k.transferKeeper.Transfer(ctx, msg)
+    if err != nil {
+        return 0, errorsmod.Wrapf(types.ErrBridgeTransferFailed, "failed to send USDC over %s: %s", channelID, err)
+    }
+
+    pending := types.PendingBridgeOut{
+        Sender:    sender,
+        ChannelId: channelID,
+        Sequence:  res.Sequence,
+        Testusd:   testusd,
+        Usdc:      usdc,
+        RecordSequence: recordSeq,
+        Fee:       fee,
+    }
+    if err := k.PendingBridgeOuts.Set(ctx, collections.Join(channelID, res.Sequence), pending); err != nil {
+        return 0, err
+    }
+
+    return res.Sequence, nil
+}
+
+// OnRecvUsdc mints TestUSD for USDC received over an allow-listed channel.
+// The transfer module has already credited the vouchers to the receiver;
+// they are escrowed in the module account as collateral. Transfers of other
+// denoms or over other channels, and any transfer while the bridge is
+// disabled, paused or undercollateralized, are left alone.
+func (k Keeper) OnRecvUsdc(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.InternalTransferRepresentation) error {
+    params, err := k.GetParams(ctx)
+    if err != nil {
+        return err
+    }
+    if !params.BridgeEnabled || k.IsPaused(ctx) || packet.DestinationPort != transfertypes.PortID {
+        return nil
+    }
+
+    source, found := params.GetUsdcSource(packet.DestinationChannel)
+    if !found || data.Token.Denom.Path() != source.Denom {
+        return nil
+    }
+
+    if k.checkCollateralized(ctx, params) != nil {
+        return nil
+    }
+
+    amount, ok := math.NewIntFromString(data.Token.Amount)
+    if !ok {
+        return errorsmod.Wrapf(types.ErrInvalidAmount, "invalid transfer amount %s", data.Token.Amount)
+    }
+
+    receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+    if err != nil {
+        return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid receiver address")
+    }
+
+    _, err = k.mintForUsdc(ctx, params, receiver, sdk.NewCoin(source.VoucherDenom(), amount), data.Sender, packet.DestinationChannel)
+    return err
+}
+
+// OnBridgeOutDone settles the pending bridge out sent in packet, if any.
+// When the transfer succeeded the held redeem fee is paid to the treasury.
+// When it failed the transfer module has refunded the USDC to the module
+// account, the burned TestUSD is minted back to the sender and the fee is
+// returned to them.
+func (k Keeper) OnBridgeOutDone(ctx sdk.Context, packet channeltypes.Packet, failed bool) error {
+    if packet.SourcePort != transfertypes.PortID {
+        return nil
+    }
+
+    key := collections.Join(packet.SourceChannel, packet.Sequence)
+    pending, err := k.PendingBridgeOuts.Get(ctx, key)
+    if errors.Is(err, collections.ErrNotFound) {
+        return nil
+    } else if err != nil {
+        return err
+    }
+
+    if err := k.PendingBridgeOuts.Remove(ctx, key); err != nil {
+        return err
+    }
+
+    // Bridge outs sent before the fee was held paid it to the treasury already
+    fee := pending.Fee
+    if fee.Denom == "" || fee.IsNil() {
+        fee = sdk.NewCoin(pending.Testusd.Denom, math.ZeroInt())
+    }
+
+    sender, err := sdk.AccAddressFromBech32(pending.Sender)
+    if err != nil {
+        return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+    }
+
+    if !failed {
+        if err := k.settleFee(ctx, sender, fee); err != nil {
+            return err
+        }
+        return k.setBridgeRecordStatus(ctx, pending.Sender, pending.RecordSequence, types.BridgeStatusCompleted)
+    }
+    refund := pending.Testusd.Add(fee)
+
+    err = k.setBridgeRecordStatus(ctx, pending.Sender, pending.RecordSequence, types.BridgeStatusRefunded)
+    if err != nil {
+        return err
+    }
+    _, err = k.addBridgeRecord(ctx, types.BridgeRecord{
+        Address:   pending.Sender,
+        Direction: types.BridgeDirectionRefund,
+        Status:    types.BridgeStatusCompleted,
+        Usdc:      pending.Usdc,
+        Testusd:   refund,
+        ChannelId: pending.ChannelId,
+    })
+    if err != nil {
+        return err
+    }
+
+    // Mint the burned TestUSD back and return it to the sender with the fee
+    err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(pending.Testusd))
+    if err != nil {
+        return errorsmod.Wrap(err, "failed to mint TestUSD")
+    }
+    err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(refund))
+    if err != nil {
+        return errorsmod.Wrap(err, "failed to send TestUSD to user")
+    }
+
+    // The refunded USDC backs the TestUSD again
+    if err := k.addTotals(ctx, pending.Usdc.Amount, pending.Testusd.Amount); err != nil {
+        return err
+    }
+
+    if tk := k.GetTransactionKeeper(); tk != nil {
+        record := mychaintypes.TransactionRecord{
+            Address:     pending.Sender,
+            Type:        "bridge_out_refund",
+            Description: fmt.Sprintf("Refunded %s, release of %s over %s failed", refund.String(), pending.Usdc.String(), pending.ChannelId),
+            Amount:      sdk.NewCoins(refund),
+            From:        "external_bridge",
+            To:          pending.Sender,
+            Details: &mychaintypes.TransactionRecord_Bridge{Bridge: &mychaintypes.BridgeDetails{
+                Direction: "in",
+                Usdc:      pending.Usdc,
+                Testusd:   pending.Testusd,
+                PegRatio:  math.LegacyNewDecFromInt(pending.Testusd.Amount).QuoInt(pending.Usdc.Amount),
+            }},
+        }
+        if err := tk.RecordTransaction(ctx, record); err != nil {
+            k.Logger(ctx).Error("failed to record transaction", "error", err)
+        }
+    }
+
+    ctx.EventManager().EmitEvent(
+        sdk.NewEvent(
+            types.EventTypeBridgeOutRefund,
+            sdk.NewAttribute(types.AttributeKeySender, pending.Sender),
+            sdk.NewAttribute(types.AttributeKeyMintedAmount, pending.Testusd.String()),
+            sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+            sdk.NewAttribute(types.AttributeKeyChannel, pending.ChannelId),
+            sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", pending.Sequence)),
+        ),
+    )
+
+    return nil
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+    "fmt"
+
+    "cosmossdk.io/collections"
+    "cosmossdk.io/math"
+    sdk "github.com/cosmos/cosmos-sdk/types"
+
+    "mychain/x/testusd/types"
+)
+
+// RegisterInvariants registers the testusd module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+    ir.RegisterRoute(types.ModuleName, "reserves", ReservesInvariant(k))
+    ir.RegisterRoute(types.ModuleName, "withdrawals", WithdrawalsInvariant(k))
+}
+
+// ReservesInvariant checks that the tracked TestUSD supply matches the bank
+// supply and that the escrowed USDC covers the tracked bridged amount.
+func ReservesInvariant(k Keeper) sdk.Invariant {
+    return func(ctx sdk.Context) (string, bool) {
+        params, err := k.GetParams(ctx)
+        if err != nil {
+            return sdk.FormatInvariant(types.ModuleName, "reserves", err.Error()), true
+        }
+        trackedSupply, err := k.GetTotalSupply(ctx)
+        if err != nil {
+            return sdk.FormatInvariant(types.ModuleName, "reserves", err.Error()), true
+        }
+        bankSupply := k.bankKeeper.GetSupply(ctx, params.TestusdDenom).Amount
+        trackedBridged, err := k.GetTotalBridged(ctx)
+        if err != nil {
+            return sdk.FormatInvariant(types.ModuleName, "reserves", err.Error()), true
+        }
+        escrowed := k.EscrowedCollateral(ctx, params)
+
+        broken := !trackedSupply.Equal(bankSupply) || escrowed.LT(trackedBridged)
+
+        return sdk.FormatInvariant(types.ModuleName, "reserves", fmt.Sprintf(
+            "\ttracked supply: %s\n\tbank supply: %s\n\ttracked bridged: %s\n\tescrowed collateral: %s\n",
+            trackedSupply, bankSupply, trackedBridged, escrowed,
+        )), broken
+    }
+}
+
+// WithdrawalsInvariant checks that every queued withdrawal is indexed by its
+// release time and that the module account holds the TestUSD of all of them
+// and the fees of the pending bridge outs.
+func WithdrawalsInvariant(k Keeper) sdk.Invariant {
+    return func(ctx sdk.Context) (string, bool) {
+        params, err := k.GetParams(ctx)
+        if err != nil {
+            return sdk.FormatInvariant(types.ModuleName, "withdrawals", err.Error()), true
+        }
+
+        var msg string
+        count := 0
+        queued := math.ZeroInt()
+        err = k.Withdrawals.Walk(ctx, nil, func(id uint64, withdrawal types.PendingWithdrawal) (bool, error) {
+            count++
+            if has, err := k.WithdrawalQueue.Has(ctx, collections.Join(withdrawal.ReleaseAt, id)); err != nil || !has {
+                msg += fmt.Sprintf("\twithdrawal %d is not queued for release at %d\n", id, withdrawal.ReleaseAt)
+            }
+            queued = queued.Add(withdrawal.Testusd.Amount)
+            return false, nil
+        })
+        if err != nil {
+            return sdk.FormatInvariant(types.ModuleName, "withdrawals", err.Error()), true
+        }
+
+        indexed := 0
+        err = k.WithdrawalQueue.Walk(ctx, nil, func(collections.Pair[int64, uint64]) (bool, error) {
+            indexed++
+            return false, nil
+        })
+        if err != nil {
+            return sdk.FormatInvariant(types.ModuleName, "withdrawals", err.Error()), true
+        }
+        if indexed != count {
+            msg += fmt.Sprintf("\t%d withdrawals, %d queued for release\n", count, indexed)
+        }
+
+        fees := math.ZeroInt()
+        err = k.PendingBridgeOuts.Walk(ctx, nil, func(_ collections.Pair[string, uint64], pending types.PendingBridgeOut) (bool, error) {
+            if pending.Fee.Denom != "" {
+                fees = fees.Add(pending.Fee.Amount)
+            }
+            return false, nil
+        })
+        if err != nil {
+            return sdk.FormatInvariant(types.ModuleName, "withdrawals", err.Error()), true
+        }
+
+        moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+        held := k.bankKeeper.GetBalance(ctx, moduleAddr, params.TestusdDenom).Amount
+        if held.LT(queued.Add(fees)) {
+            msg += fmt.Sprintf("\tqueued for withdrawal: %s\n\tfees of pending bridge outs: %s\n\theld by module: %s\n", queued, fees, held)
+        }
+
+        return sdk.FormatInvariant(types.ModuleName, "withdrawals", msg), msg != ""
+    }
+}
@@ -6,6 +6,7 @@ import (
     "cosmossdk.io/core/event"
     "cosmossdk.io/core/store"
     "cosmossdk.io/log"
+    "cosmossdk.io/math"
     "github.com/cosmos/cosmos-sdk/codec"
     sdk "github.com/cosmos/cosmos-sdk/types"
     
@@ -25,8 +26,37 @@ type Keeper struct {
     accountKeeper types.AccountKeeper
     bankKeeper    types.BankKeeper
     eventService  event.Service
-    Params        collections.Item[types.Params]
     transactionKeeper types.TransactionKeeper
+    transferKeeper    types.TransferKeeper
+
+    Schema            collections.Schema
+    Params            collections.Item[types.Params]
+    // TrackedBridged and TrackedSupply are the USDC bridged in and the TestUSD
+    // minted for it, net of bridge outs
+    TrackedBridged collections.Item[math.Int]
+    TrackedSupply  collections.Item[math.Int]
+    Statistics     collections.Item[types.BridgeStatistics]
+    // PendingBridgeOuts holds USDC sent over ICS-20, keyed by channel and
+    // packet sequence, until it is acknowledged or times out
+    PendingBridgeOuts collections.Map[collections.Pair[string, uint64], types.PendingBridgeOut]
+    Pause             collections.Item[types.BridgePause]
+    // FlowBuckets slices the rolling limit window, keyed by slice start
+    FlowBuckets collections.Map[int64, types.FlowBucket]
+    // AddressFlows holds daily flows keyed by day and address
+    AddressFlows collections.Map[collections.Pair[int64, string], types.AddressFlow]
+    // Attestations is the history of reserve attestations, keyed by id
+    Attestations   collections.Map[uint64, types.ReserveAttestation]
+    AttestationSeq collections.Sequence
+    // BridgeRecords holds each address's bridge operations, keyed by address
+    // and a sequence shared by all addresses
+    BridgeRecords   collections.Map[collections.Pair[string, uint64], types.BridgeRecord]
+    BridgeRecordSeq collections.Sequence
+    // Withdrawals holds queued bridge outs by id, and WithdrawalQueue
+    // indexes them by release time
+    Withdrawals     collections.Map[uint64, types.PendingWithdrawal]
+    WithdrawalSeq   collections.Sequence
+    WithdrawalQueue collections.KeySet[collections.Pair[int64, uint64]]
+    CollateralPrice collections.Item[types.CollateralPrice]
 }
 
 // NewKeeper creates a new testusd Keeper instance
@@ -40,7 +70,9 @@ func NewKeeper(
     bk types.BankKeeper,
     eventService event.Service,
 ) Keeper {
-    return Keeper{
+    sb := collections.NewSchemaBuilder(storeService)
+
+    k := Keeper{
         cdc:           cdc,
         storeService:  storeService,
         logger:        logger,
@@ -49,7 +81,55 @@ func NewKeeper(
         accountKeeper: ak,
         bankKeeper:    bk,
         eventService:  eventService,
+
+        Params:         collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+        TrackedBridged: collections.NewItem(sb, types.TotalBridgedKey, "total_bridged", sdk.IntValue),
+        TrackedSupply:  collections.NewItem(sb, types.TotalSupplyKey, "total_supply", sdk.IntValue),
+        Statistics:     collections.NewItem(sb, types.BridgeStatisticsKey, "statistics", codec.CollValue[types.BridgeStatistics](cdc)),
+        PendingBridgeOuts: collections.NewMap(
+            sb,
+            types.PendingBridgeOutKey,
+            "pending_bridge_outs",
+            collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+            codec.CollValue[types.PendingBridgeOut](cdc),
+        ),
+        Pause:       collections.NewItem(sb, types.BridgePauseKey, "pause", codec.CollValue[types.BridgePause](cdc)),
+        FlowBuckets: collections.NewMap(sb, types.FlowBucketKey, "flow_buckets", collections.Int64Key, codec.CollValue[types.FlowBucket](cdc)),
+        AddressFlows: collections.NewMap(
+            sb,
+            types.AddressFlowKey,
+            "address_flows",
+            collections.PairKeyCodec(collections.Int64Key, collections.StringKey),
+            codec.CollValue[types.AddressFlow](cdc),
+        ),
+        Attestations:   collections.NewMap(sb, types.AttestationKey, "attestations", collections.Uint64Key, codec.CollValue[types.ReserveAttestation](cdc)),
+        AttestationSeq: collections.NewSequence(sb, types.AttestationSeqKey, "attestation_seq"),
+        BridgeRecords: collections.NewMap(
+            sb,
+            types.BridgeRecordKey,
+            "bridge_records",
+            collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+            codec.CollValue[types.BridgeRecord](cdc),
+        ),
+        BridgeRecordSeq: collections.NewSequence(sb, types.BridgeRecordSeqKey, "bridge_record_seq"),
+        Withdrawals:     collections.NewMap(sb, types.WithdrawalKey, "withdrawals", collections.Uint64Key, codec.CollValue[types.PendingWithdrawal](cdc)),
+        WithdrawalSeq:   collections.NewSequence(sb, types.WithdrawalSeqKey, "withdrawal_seq"),
+        WithdrawalQueue: collections.NewKeySet(
+            sb,
+            types.WithdrawalQueueKey,
+            "withdrawal_queue",
+            collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key),
+        ),
+        CollateralPrice: collections.NewItem(sb, types.CollateralPriceKey, "collateral_price", codec.CollValue[types.CollateralPrice](cdc)),
+    }
+
+    schema, err := sb.Build()
+    if err != nil {
+        panic(err)
     }
+    k.Schema = schema
+
+    return k
 }
 
 // GetAuthority returns the module's authority.
@@ -65,9 +145,8 @@ func (k Keeper) Logger(ctx context.Context) log.Logger {
 
 
 // GetParams returns the module parameters.
-func (k Keeper) GetParams(ctx context.Context) (params types.Params) {
-    params, _ = k.Params.Get(ctx)
-    return params
+func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
+    return k.Params.Get(ctx)
 }
 
 // SetParams sets the module parameters.
@@ -80,6 +159,12 @@ func (k *Keeper) SetTransactionKeeper(tk types.TransactionKeeper) {
     k.transactionKeeper = tk
 }
 
+// SetTransferKeeper sets the ICS-20 transfer keeper used to send USDC back
+// to its source chain
+func (k *Keeper) SetTransferKeeper(tk types.TransferKeeper) {
+    k.transferKeeper = tk
+}
+
 // GetTransactionKeeper returns the transaction keeper
 func (k Keeper) GetTransactionKeeper() types.TransactionKeeper {
     return k.transactionKeeper
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"cosmossdk.io/core/address"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -12,51 +14,52 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"mychain/x/testusd/keeper"
 	module "mychain/x/testusd/module"
 	"mychain/x/testusd/types"
-	"cosmossdk.io/log"
 )
 
 type fixture struct {
 	ctx          context.Context
 	keeper       keeper.Keeper
 	addressCodec address.Codec
+	storeService corestore.KVStoreService
 }
 
 func initFixture(t *testing.T) *fixture {
-t.Helper()
-
-encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModule{})
-addressCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
-
-storeKey := storetypes.NewKVStoreKey(types.StoreKey)
-storeService := runtime.NewKVStoreService(storeKey)
-ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
-
-authority := authtypes.NewModuleAddress(types.GovModuleName)
-
-k := keeper.NewKeeper(
-encCfg.Codec,
-storeService,
-storeKey,
-log.NewNopLogger(),
-authority.String(),
-addressCodec,
-nil,
-nil,
-nil,
-)
+	t.Helper()
 
-// Initialize params
-if err := k.Params.Set(ctx, types.DefaultParams()); err != nil {
-t.Fatalf("failed to set params: %v", err)
-}
+	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModule{})
+	addressCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
-return &fixture{
-ctx:          ctx,
-keeper:       k,
-addressCodec: addressCodec,
-}
+	storeService := runtime.NewKVStoreService(storeKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	k := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		log.NewNopLogger(),
+		authority.String(),
+		addressCodec,
+		nil,
+		nil,
+		nil,
+	)
+
+	// Initialize params
+	if err := k.Params.Set(ctx, types.DefaultParams()); err != nil {
+		t.Fatalf("failed to set params: %v", err)
+	}
+
+	return &fixture{
+		ctx:          ctx,
+		keeper:       k,
+		addressCodec: addressCodec,
+		storeService: storeService,
+	}
 }
@@ -0,0 +1,250 @@
+package keeper
+
+import (
+    "context"
+    "errors"
+    "fmt"
+
+    "cosmossdk.io/collections"
+    errorsmod "cosmossdk.io/errors"
+    "cosmossdk.io/math"
+    sdk "github.com/cosmos/cosmos-sdk/types"
+
+    "mychain/x/testusd/types"
+)
+
+const (
+    // secondsPerDay is the length of the per-address limit period
+    secondsPerDay = 24 * 60 * 60
+
+    // flowBucketsPerWindow is how many slices the rolling window is kept in
+    flowBucketsPerWindow = 24
+
+    // PausedByCircuitBreaker marks a pause triggered by the net flow limit
+    PausedByCircuitBreaker = "circuit_breaker"
+)
+
+// GetPause returns the current pause of the bridge.
+func (k Keeper) GetPause(ctx context.Context) (types.BridgePause, error) {
+    pause, err := k.Pause.Get(ctx)
+    if errors.Is(err, collections.ErrNotFound) {
+        return types.BridgePause{}, nil
+    }
+    return pause, err
+}
+
+// IsPaused reports whether the guardian, the authority or the circuit
+// breaker has paused the bridge.
+func (k Keeper) IsPaused(ctx context.Context) bool {
+    pause, err := k.GetPause(ctx)
+    return err != nil || pause.Paused
+}
+
+// checkBridgeOpen returns an error unless bridging is enabled and not paused.
+func (k Keeper) checkBridgeOpen(ctx context.Context, params types.Params) error {
+    if !params.BridgeEnabled {
+        return errorsmod.Wrap(types.ErrBridgeDisabled, "bridge is currently disabled")
+    }
+    if k.IsPaused(ctx) {
+        return errorsmod.Wrap(types.ErrBridgePaused, "bridge is paused")
+    }
+    return nil
+}
+
+// SetBridgePaused pauses bridging in and out until the authority unpauses it.
+func (k Keeper) SetBridgePaused(ctx context.Context, pausedBy, reason string) error {
+    sdkCtx := sdk.UnwrapSDKContext(ctx)
+    pause := types.BridgePause{
+        Paused:   true,
+        PausedBy: pausedBy,
+        Reason:   reason,
+        PausedAt: sdkCtx.BlockTime().Unix(),
+    }
+    if err := k.Pause.Set(ctx, pause); err != nil {
+        return err
+    }
+
+    sdkCtx.EventManager().EmitEvent(
+        sdk.NewEvent(
+            types.EventTypeBridgePaused,
+            sdk.NewAttribute(types.AttributeKeyPausedBy, pausedBy),
+            sdk.NewAttribute(types.AttributeKeyReason, reason),
+        ),
+    )
+    k.Logger(ctx).Info("Bridge paused", "paused_by", pausedBy, "reason", reason)
+    return nil
+}
+
+// flowBucketStart returns the start of the window slice now falls in.
+func flowBucketStart(now int64, limits types.BridgeLimits) int64 {
+    size := int64(limits.WindowSeconds / flowBucketsPerWindow)
+    if size < 1 {
+        size = 1
+    }
+    return now - now%size
+}
+
+// windowFlow returns the TestUSD minted and burned in the rolling window
+// ending now.
+func (k Keeper) windowFlow(ctx context.Context, limits types.BridgeLimits) (minted, burned math.Int, err error) {
+    minted, burned = math.ZeroInt(), math.ZeroInt()
+    now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+
+    rng := new(collections.Range[int64]).StartExclusive(now - int64(limits.WindowSeconds))
+    err = k.FlowBuckets.Walk(ctx, rng, func(_ int64, bucket types.FlowBucket) (bool, error) {
+        minted = minted.Add(bucket.Minted)
+        burned = burned.Add(bucket.Burned)
+        return false, nil
+    })
+    return minted, burned, err
+}
+
+// addressFlow returns what addr has minted and burned today.
+func (k Keeper) addressFlow(ctx context.Context, addr sdk.AccAddress) (types.AddressFlow, error) {
+    day := sdk.UnwrapSDKContext(ctx).BlockTime().Unix() / secondsPerDay
+    flow, err := k.AddressFlows.Get(ctx, collections.Join(day, addr.String()))
+    if errors.Is(err, collections.ErrNotFound) {
+        return types.AddressFlow{Address: addr.String(), Day: day, Minted: math.ZeroInt(), Burned: math.ZeroInt()}, nil
+    }
+    return flow, err
+}
+
+// applyFlowLimits checks a mint or burn of amount TestUSD by addr against
+// the bridge limits and records it in the limit windows. Exceeding a cap
+// fails the bridge operation. Exceeding the net flow cap lets the operation
+// through but pauses the bridge, since an error would revert the pause too.
+func (k Keeper) applyFlowLimits(ctx context.Context, params types.Params, addr sdk.AccAddress, amount math.Int, mint bool) error {
+    limits := params.Limits
+    direction := "burn"
+    if mint {
+        direction = "mint"
+    }
+
+    if types.IsCapped(limits.MaxPerTx) && amount.GT(limits.MaxPerTx) {
+        return errorsmod.Wrapf(types.ErrBridgeLimitExceeded, "%s of %s exceeds the per-tx limit of %s", direction, amount, limits.MaxPerTx)
+    }
+
+    if err := k.pruneFlows(ctx, limits); err != nil {
+        return err
+    }
+
+    // Per-address daily cap
+    flow, err := k.addressFlow(ctx, addr)
+    if err != nil {
+        return err
+    }
+    used := flow.Burned
+    if mint {
+        used = flow.Minted
+    }
+    if types.IsCapped(limits.MaxPerAddressDaily) && used.Add(amount).GT(limits.MaxPerAddressDaily) {
+        return errorsmod.Wrapf(types.ErrBridgeLimitExceeded, "%s of %s exceeds the daily limit of %s, %s used today", direction, amount, limits.MaxPerAddressDaily, used)
+    }
+
+    // Global rolling window caps
+    minted, burned, err := k.windowFlow(ctx, limits)
+    if err != nil {
+        return err
+    }
+    if mint {
+        minted = minted.Add(amount)
+        if types.IsCapped(limits.MaxMintPerWindow) && minted.GT(limits.MaxMintPerWindow) {
+            return errorsmod.Wrapf(types.ErrBridgeLimitExceeded, "mint of %s exceeds the window limit of %s", amount, limits.MaxMintPerWindow)
+        }
+    } else {
+        burned = burned.Add(amount)
+        if types.IsCapped(limits.MaxBurnPerWindow) && burned.GT(limits.MaxBurnPerWindow) {
+            return errorsmod.Wrapf(types.ErrBridgeLimitExceeded, "burn of %s exceeds the window limit of %s", amount, limits.MaxBurnPerWindow)
+        }
+    }
+
+    if err := k.recordFlow(ctx, limits, flow, amount, mint); err != nil {
+        return err
+    }
+
+    netFlow := minted.Sub(burned).Abs()
+    if types.IsCapped(limits.MaxNetFlowPerWindow) && netFlow.GT(limits.MaxNetFlowPerWindow) {
+        reason := fmt.Sprintf("net flow of %s in the limit window exceeds %s", netFlow, limits.MaxNetFlowPerWindow)
+        return k.SetBridgePaused(ctx, PausedByCircuitBreaker, reason)
+    }
+    return nil
+}
+
+// recordFlow adds a mint or burn to today's flow of its address and to the
+// current window slice.
+func (k Keeper) recordFlow(ctx context.Context, limits types.BridgeLimits, flow types.AddressFlow, amount math.Int, mint bool) error {
+    start := flowBucketStart(sdk.UnwrapSDKContext(ctx).BlockTime().Unix(), limits)
+    bucket, err := k.FlowBuckets.Get(ctx, start)
+    if errors.Is(err, collections.ErrNotFound) {
+        bucket = types.FlowBucket{Start: start, Minted: math.ZeroInt(), Burned: math.ZeroInt()}
+    } else if err != nil {
+        return err
+    }
+
+    if mint {
+        flow.Minted = flow.Minted.Add(amount)
+        bucket.Minted = bucket.Minted.Add(amount)
+    } else {
+        flow.Burned = flow.Burned.Add(amount)
+        bucket.Burned = bucket.Burned.Add(amount)
+    }
+
+    if err := k.AddressFlows.Set(ctx, collections.Join(flow.Day, flow.Address), flow); err != nil {
+        return err
+    }
+    return k.FlowBuckets.Set(ctx, start, bucket)
+}
+
+// pruneFlows removes window slices and daily flows that no longer count
+// towards any limit.
+func (k Keeper) pruneFlows(ctx context.Context, limits types.BridgeLimits) error {
+    now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+
+    expired := new(collections.Range[int64]).EndInclusive(now - int64(limits.WindowSeconds))
+    if err := k.FlowBuckets.Clear(ctx, expired); err != nil {
+        return err
+    }
+
+    yesterday := now/secondsPerDay - 1
+    return k.AddressFlows.Clear(ctx, collections.NewPrefixUntilPairRange[int64, string](yesterday))
+}
+
+// GetBridgeQuota returns the TestUSD still allowed under the window caps
+// and, when addr is set, under its daily caps.
+func (k Keeper) GetBridgeQuota(ctx context.Context, addr sdk.AccAddress) (types.BridgeQuota, error) {
+    params, err := k.GetParams(ctx)
+    if err != nil {
+        return types.BridgeQuota{}, err
+    }
+    limits := params.Limits
+    minted, burned, err := k.windowFlow(ctx, limits)
+    if err != nil {
+        return types.BridgeQuota{}, err
+    }
+
+    quota := types.BridgeQuota{
+        WindowMinted:        minted,
+        WindowBurned:        burned,
+        RemainingWindowMint: remaining(limits.MaxMintPerWindow, minted),
+        RemainingWindowBurn: remaining(limits.MaxBurnPerWindow, burned),
+    }
+
+    if addr != nil {
+        flow, err := k.addressFlow(ctx, addr)
+        if err != nil {
+            return types.BridgeQuota{}, err
+        }
+        quota.RemainingAddressMint = remaining(limits.MaxPerAddressDaily, flow.Minted)
+        quota.RemainingAddressBurn = remaining(limits.MaxPerAddressDaily, flow.Burned)
+    }
+    return quota, nil
+}
+
+// remaining returns what is left of cap after used, or nil when uncapped.
+func remaining(cap, used math.Int) *math.Int {
+    if !types.IsCapped(cap) {
+        return nil
+    }
+    left := math.MaxInt(cap.Sub(used), math.ZeroInt())
+    return &left
+}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+    "errors"
+    "fmt"
+
+    "cosmossdk.io/collections"
+    sdk "github.com/cosmos/cosmos-sdk/types"
+
+    "mychain/x/testusd/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+    keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+    return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 moves the module to its collections schema. The totals and
+// statistics were written under the same keys and encodings before, so they
+// are only checked to decode and set to zero if missing. Params fields added
+// since version 1 are set to their defaults, which leave the bridge limits,
+// withdrawal queue, fees and depeg handling disabled.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+    k := m.keeper
+
+    params, err := k.Params.Get(ctx)
+    if errors.Is(err, collections.ErrNotFound) {
+        params = types.DefaultParams()
+    } else if err != nil {
+        return fmt.Errorf("failed to decode params: %w", err)
+    }
+    migrateParams(&params)
+    if err := params.Validate(); err != nil {
+        return fmt.Errorf("invalid params: %w", err)
+    }
+    if err := k.Params.Set(ctx, params); err != nil {
+        return err
+    }
+
+    totalBridged, err := k.GetTotalBridged(ctx)
+    if err != nil {
+        return fmt.Errorf("failed to decode total bridged: %w", err)
+    }
+    if err := k.TrackedBridged.Set(ctx, totalBridged); err != nil {
+        return err
+    }
+    totalSupply, err := k.GetTotalSupply(ctx)
+    if err != nil {
+        return fmt.Errorf("failed to decode total supply: %w", err)
+    }
+    if err := k.TrackedSupply.Set(ctx, totalSupply); err != nil {
+        return err
+    }
+
+    if _, err := k.GetBridgeStatistics(ctx); err != nil {
+        return fmt.Errorf("failed to decode bridge statistics: %w", err)
+    }
+
+    return nil
+}
+
+// Migrate2to3 sets the withdrawal release budget, which version 2 params do
+// not have. Without it no queued withdrawal would ever be released.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+    k := m.keeper
+
+    params, err := k.Params.Get(ctx)
+    if err != nil {
+        return fmt.Errorf("failed to decode params: %w", err)
+    }
+    if params.WithdrawalQueue.ReleaseBudget == 0 {
+        params.WithdrawalQueue.ReleaseBudget = types.DefaultWithdrawalReleaseBudget
+    }
+    if err := params.Validate(); err != nil {
+        return fmt.Errorf("invalid params: %w", err)
+    }
+    return k.Params.Set(ctx, params)
+}
+
+// migrateParams sets the params fields that are unset in version 1 params to
+// their defaults.
+func migrateParams(params *types.Params) {
+    if params.IbcTimeoutSeconds == 0 {
+        params.IbcTimeoutSeconds = types.DefaultIBCTimeoutSeconds
+    }
+
+    defaultLimits := types.DefaultBridgeLimits()
+    limits := &params.Limits
+    if limits.MaxPerTx.IsNil() {
+        limits.MaxPerTx = defaultLimits.MaxPerTx
+    }
+    if limits.MaxPerAddressDaily.IsNil() {
+        limits.MaxPerAddressDaily = defaultLimits.MaxPerAddressDaily
+    }
+    if limits.MaxMintPerWindow.IsNil() {
+        limits.MaxMintPerWindow = defaultLimits.MaxMintPerWindow
+    }
+    if limits.MaxBurnPerWindow.IsNil() {
+        limits.MaxBurnPerWindow = defaultLimits.MaxBurnPerWindow
+    }
+    if limits.MaxNetFlowPerWindow.IsNil() {
+        limits.MaxNetFlowPerWindow = defaultLimits.MaxNetFlowPerWindow
+    }
+    if limits.WindowSeconds == 0 {
+        limits.WindowSeconds = defaultLimits.WindowSeconds
+    }
+
+    if params.WithdrawalQueue.Threshold.IsNil() {
+        params.WithdrawalQueue.Threshold = types.DefaultWithdrawalQueue().Threshold
+    }
+    if params.WithdrawalQueue.DelaySeconds == 0 {
+        params.WithdrawalQueue.DelaySeconds = types.DefaultWithdrawalDelaySeconds
+    }
+
+    if params.Fees.MintFeeRate.IsNil() {
+        params.Fees.MintFeeRate = types.DefaultBridgeFees().MintFeeRate
+    }
+    if params.Fees.RedeemFeeRate.IsNil() {
+        params.Fees.RedeemFeeRate = types.DefaultBridgeFees().RedeemFeeRate
+    }
+
+    if params.PriceOracle.DepegThreshold.IsNil() {
+        params.PriceOracle.DepegThreshold = types.DefaultPriceOracle().DepegThreshold
+    }
+    if params.PriceOracle.MaxAgeSeconds == 0 {
+        params.PriceOracle.MaxAgeSeconds = types.DefaultOracleMaxAgeSeconds
+    }
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	"mychain/x/testusd/keeper"
+	module "mychain/x/testusd/module"
+	"mychain/x/testusd/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	kvStore := f.storeService.OpenKVStore(ctx)
+	cdc := moduletestutil.MakeTestEncodingConfig(module.AppModule{}).Codec
+
+	// Version 1 params only had the bridge switch, peg and denoms, and the
+	// totals and statistics were written directly to the store
+	legacyParams := types.NewParams(true, "1.0", "utestusd", "uusdc", "")
+	require.NoError(t, kvStore.Set(types.ParamsKey, cdc.MustMarshal(&legacyParams)))
+	bz, err := math.NewInt(750_000).Marshal()
+	require.NoError(t, err)
+	require.NoError(t, kvStore.Set(types.TotalBridgedKey, bz))
+	stats := types.BridgeStatistics{TotalBridgeInCount: 3, LastBridgeInTimestamp: 30}
+	require.NoError(t, kvStore.Set(types.BridgeStatisticsKey, cdc.MustMarshal(&stats)))
+
+	m := keeper.NewMigrator(&f.keeper)
+	require.NoError(t, m.Migrate1to2(ctx))
+
+	params, err := f.keeper.GetParams(ctx)
+	require.NoError(t, err)
+	expected := types.DefaultParams()
+	expected.UsdcSources = nil
+	// The release budget is only set by the next migration
+	expected.WithdrawalQueue.ReleaseBudget = 0
+	require.Equal(t, expected, params)
+
+	bridged, err := f.keeper.TrackedBridged.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(750_000), bridged)
+	// A missing total is written as zero
+	supply, err := f.keeper.TrackedSupply.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.ZeroInt(), supply)
+	migratedStats, err := f.keeper.Statistics.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, stats, migratedStats)
+
+	exported, err := f.keeper.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.NoError(t, exported.Validate())
+}
+
+func TestMigrate1to2InvalidParams(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	legacyParams := types.NewParams(true, "one", "utestusd", "uusdc", "")
+	require.NoError(t, f.keeper.Params.Set(ctx, legacyParams))
+
+	m := keeper.NewMigrator(&f.keeper)
+	require.ErrorContains(t, m.Migrate1to2(ctx), "invalid peg ratio")
+}
+
+func TestMigrate2to3(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	// Version 2 params have no release budget; validation then only passes
+	// with the queue idle
+	params := types.DefaultParams()
+	params.WithdrawalQueue.ReleaseBudget = 0
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	m := keeper.NewMigrator(&f.keeper)
+	require.NoError(t, m.Migrate2to3(ctx))
+
+	migrated, err := f.keeper.GetParams(ctx)
+	require.NoError(t, err)
+	expected := types.DefaultParams()
+	expected.UsdcSources = nil
+	require.Equal(t, expected, migrated)
+}
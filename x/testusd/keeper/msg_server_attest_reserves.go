@@ -0,0 +1,82 @@
+package keeper
+
+import (
+    "context"
+    "fmt"
+
+    errorsmod "cosmossdk.io/errors"
+    sdk "github.com/cosmos/cosmos-sdk/types"
+    sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+    "mychain/x/testusd/types"
+)
+
+func (k msgServer) AttestReserves(goCtx context.Context, msg *types.MsgAttestReserves) (*types.MsgAttestReservesResponse, error) {
+    ctx := sdk.UnwrapSDKContext(goCtx)
+    params, err := k.GetParams(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    if params.Attestor == "" || msg.Attestor != params.Attestor {
+        return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the reserve attestor", msg.Attestor)
+    }
+
+    if msg.Amount.IsNil() || msg.Amount.IsNegative() {
+        return nil, errorsmod.Wrap(types.ErrInvalidAmount, "attested amount cannot be negative")
+    }
+    if msg.Timestamp > ctx.BlockTime().Unix() {
+        return nil, errorsmod.Wrap(types.ErrInvalidAttestation, "attestation timestamp is in the future")
+    }
+
+    latest, err := k.LatestAttestation(ctx)
+    if err != nil {
+        return nil, err
+    }
+    if latest != nil && msg.Timestamp <= latest.Timestamp {
+        return nil, errorsmod.Wrapf(types.ErrInvalidAttestation, "attestation timestamp must be after %d", latest.Timestamp)
+    }
+
+    // The signature lets anyone check the attestation against the
+    // attestor's key without trusting the chain
+    attestor, err := sdk.AccAddressFromBech32(msg.Attestor)
+    if err != nil {
+        return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid attestor address")
+    }
+    account := k.accountKeeper.GetAccount(ctx, attestor)
+    if account == nil || account.GetPubKey() == nil {
+        return nil, errorsmod.Wrap(types.ErrInvalidAttestation, "attestor has no public key")
+    }
+    signBytes := types.ReserveAttestationSignBytes(ctx.ChainID(), msg.Amount, msg.Timestamp)
+    if !account.GetPubKey().VerifySignature(signBytes, msg.Signature) {
+        return nil, errorsmod.Wrap(types.ErrInvalidAttestation, "invalid attestation signature")
+    }
+
+    id, err := k.AttestationSeq.Next(ctx)
+    if err != nil {
+        return nil, err
+    }
+    attestation := types.ReserveAttestation{
+        Id:        id,
+        Attestor:  msg.Attestor,
+        Amount:    msg.Amount,
+        Timestamp: msg.Timestamp,
+        Signature: msg.Signature,
+        Height:    ctx.BlockHeight(),
+    }
+    if err := k.Attestations.Set(ctx, id, attestation); err != nil {
+        return nil, err
+    }
+
+    ctx.EventManager().EmitEvent(
+        sdk.NewEvent(
+            types.EventTypeReserveAttestation,
+            sdk.NewAttribute(types.AttributeKeyAttestationID, fmt.Sprintf("%d", id)),
+            sdk.NewAttribute(types.AttributeKeySender, msg.Attestor),
+            sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+            sdk.NewAttribute(types.AttributeKeyTimestamp, fmt.Sprintf("%d", msg.Timestamp)),
+        ),
+    )
+
+    return &types.MsgAttestReservesResponse{Id: id}, nil
+}
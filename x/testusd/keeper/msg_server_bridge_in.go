@@ -9,6 +9,7 @@ import (
     sdk "github.com/cosmos/cosmos-sdk/types"
     sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
     
+    mychaintypes "mychain/x/mychain/types"
     "mychain/x/testusd/types"
 )
 
@@ -16,11 +17,19 @@ func (k msgServer) BridgeIn(goCtx context.Context, msg *types.MsgBridgeIn) (*typ
     ctx := sdk.UnwrapSDKContext(goCtx)
     
     // Get module parameters
-    params := k.GetParams(ctx)
+    params, err := k.GetParams(ctx)
+    if err != nil {
+        return nil, err
+    }
     
-    // Check if bridge is enabled
-    if !params.BridgeEnabled {
-        return nil, errorsmod.Wrap(types.ErrBridgeDisabled, "bridge is currently disabled")
+    // Check if bridge is enabled and not paused
+    if err := k.checkBridgeOpen(ctx, params); err != nil {
+        return nil, err
+    }
+
+    // Halt minting while TestUSD is not fully backed
+    if err := k.checkCollateralized(ctx, params); err != nil {
+        return nil, err
     }
     
     // Validate the amount
@@ -38,55 +47,107 @@ func (k msgServer) BridgeIn(goCtx context.Context, msg *types.MsgBridgeIn) (*typ
     // Create USDC coin (amount being bridged in)
     usdcCoin := sdk.NewCoin(params.UsdcDenom, amount)
     
-    // Transfer USDC from sender to module account
     // Note: In a real implementation, this would involve actual USDC bridging
     // For now, we assume the user has USDC tokens in their account
-    err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(usdcCoin))
+    testUsdCoin, err := k.mintForUsdc(ctx, params, sender, usdcCoin, "external_bridge", "")
     if err != nil {
-        return nil, errorsmod.Wrap(err, "failed to transfer USDC to bridge")
+        return nil, err
     }
     
-    // Calculate TestUSD amount to mint (1:1 ratio)
-    pegRatio, err := math.LegacyNewDecFromStr(params.PegRatio)
+    return &types.MsgBridgeInResponse{
+        MintedAmount: testUsdCoin,
+    }, nil
+}
+
+// mintForUsdc escrows usdcCoin from recipient in the module account and
+// mints TestUSD to recipient at the mint rate, less the mint fee paid to the
+// treasury. from is the counterparty shown
+// in the transaction record, and channelID the transfer channel the USDC
+// arrived over, if any.
+func (k Keeper) mintForUsdc(ctx sdk.Context, params types.Params, recipient sdk.AccAddress, usdcCoin sdk.Coin, from, channelID string) (sdk.Coin, error) {
+    // Transfer USDC from recipient to module account
+    err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, recipient, types.ModuleName, sdk.NewCoins(usdcCoin))
+    if err != nil {
+        return sdk.Coin{}, errorsmod.Wrap(err, "failed to transfer USDC to bridge")
+    }
+    
+    // Calculate TestUSD amount to mint at the peg, or the USDC price in a depeg
+    pegRatio, err := k.mintRate(ctx, params)
     if err != nil {
-        return nil, errorsmod.Wrap(types.ErrInvalidPegRatio, "invalid peg ratio")
+        return sdk.Coin{}, err
     }
     
-    testUsdAmount := math.LegacyNewDecFromInt(amount).Mul(pegRatio).TruncateInt()
-    testUsdCoin := sdk.NewCoin(params.TestusdDenom, testUsdAmount)
+    testUsdAmount := math.LegacyNewDecFromInt(usdcCoin.Amount).Mul(pegRatio).TruncateInt()
+    feeCoin := sdk.NewCoin(params.TestusdDenom, params.Fees.MintFee(testUsdAmount))
+    testUsdCoin := sdk.NewCoin(params.TestusdDenom, testUsdAmount.Sub(feeCoin.Amount))
+    
+    // Enforce the mint caps
+    if err := k.applyFlowLimits(ctx, params, recipient, testUsdAmount, true); err != nil {
+        return sdk.Coin{}, err
+    }
     
     // Mint TestUSD tokens
-    err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(testUsdCoin))
+    err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(testUsdCoin).Add(feeCoin))
     if err != nil {
-        return nil, errorsmod.Wrap(err, "failed to mint TestUSD")
+        return sdk.Coin{}, errorsmod.Wrap(err, "failed to mint TestUSD")
     }
     
-    // Send minted TestUSD to the sender
-    err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(testUsdCoin))
+    // Send minted TestUSD to the recipient
+    err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(testUsdCoin))
     if err != nil {
-        return nil, errorsmod.Wrap(err, "failed to send TestUSD to user")
+        return sdk.Coin{}, errorsmod.Wrap(err, "failed to send TestUSD to user")
     }
     
-    // Update total bridged amount
-    totalBridged := k.GetTotalBridged(ctx)
-    k.SetTotalBridged(ctx, totalBridged.Add(amount))
+    // Pay the mint fee to the treasury
+    if err := k.payFee(ctx, params, feeCoin); err != nil {
+        return sdk.Coin{}, err
+    }
     
-    // Update total supply
-    totalSupply := k.GetTotalSupply(ctx)
-    k.SetTotalSupply(ctx, totalSupply.Add(testUsdAmount))
+    // Update total bridged amount and supply
+    if err := k.addTotals(ctx, usdcCoin.Amount, testUsdAmount); err != nil {
+        return sdk.Coin{}, err
+    }
     
     // Update statistics
-    stats := k.GetBridgeStatistics(ctx)
-    stats.TotalBridgeInCount++
-    stats.LastBridgeInTimestamp = ctx.BlockTime().Unix()
-    k.SetBridgeStatistics(ctx, stats)
+    err = k.updateBridgeStatistics(ctx, func(stats *types.BridgeStatistics) {
+        stats.TotalBridgeInCount++
+        stats.LastBridgeInTimestamp = ctx.BlockTime().Unix()
+    })
+    if err != nil {
+        return sdk.Coin{}, err
+    }
+    
+    _, err = k.addBridgeRecord(ctx, types.BridgeRecord{
+        Address:   recipient.String(),
+        Direction: types.BridgeDirectionIn,
+        Status:    types.BridgeStatusCompleted,
+        Usdc:      usdcCoin,
+        Testusd:   testUsdCoin,
+        ChannelId: channelID,
+    })
+    if err != nil {
+        return sdk.Coin{}, err
+    }
     
     // Record transaction
     if tk := k.GetTransactionKeeper(); tk != nil {
         description := fmt.Sprintf("Bridged in %s, received %s", usdcCoin.String(), testUsdCoin.String())
-        metadata := fmt.Sprintf(`{"usdc_amount":"%s","testusd_amount":"%s","peg_ratio":"%s"}`, usdcCoin.String(), testUsdCoin.String(), pegRatio.String())
         
-        if err := tk.RecordTransaction(ctx, msg.Sender, "bridge_in", description, sdk.NewCoins(testUsdCoin), "external_bridge", msg.Sender, metadata); err != nil {
+        record := mychaintypes.TransactionRecord{
+            Address:     recipient.String(),
+            Type:        "bridge_in",
+            Description: description,
+            Amount:      sdk.NewCoins(testUsdCoin),
+            From:        from,
+            To:          recipient.String(),
+            Details: &mychaintypes.TransactionRecord_Bridge{Bridge: &mychaintypes.BridgeDetails{
+                Direction: "in",
+                Usdc:      usdcCoin,
+                Testusd:   testUsdCoin,
+                PegRatio:  pegRatio,
+            }},
+        }
+        if err := tk.RecordTransaction(ctx, record); err != nil {
             k.Logger(ctx).Error("failed to record transaction", "error", err)
         }
     }
@@ -95,19 +156,18 @@ func (k msgServer) BridgeIn(goCtx context.Context, msg *types.MsgBridgeIn) (*typ
     ctx.EventManager().EmitEvent(
         sdk.NewEvent(
             types.EventTypeBridgeIn,
-            sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
-            sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+            sdk.NewAttribute(types.AttributeKeySender, recipient.String()),
+            sdk.NewAttribute(types.AttributeKeyAmount, usdcCoin.Amount.String()),
             sdk.NewAttribute(types.AttributeKeyMintedAmount, testUsdCoin.String()),
+            sdk.NewAttribute(types.AttributeKeyFee, feeCoin.String()),
         ),
     )
     
     k.Logger(ctx).Info("Bridge in successful",
-        "sender", msg.Sender,
+        "sender", recipient.String(),
         "usdc_amount", usdcCoin.String(),
         "testusd_amount", testUsdCoin.String(),
     )
     
-    return &types.MsgBridgeInResponse{
-        MintedAmount: testUsdCoin,
-    }, nil
+    return testUsdCoin, nil
 }
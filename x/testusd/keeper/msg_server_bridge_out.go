@@ -9,6 +9,7 @@ import (
     sdk "github.com/cosmos/cosmos-sdk/types"
     sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
     
+    mychaintypes "mychain/x/mychain/types"
     "mychain/x/testusd/types"
 )
 
@@ -16,11 +17,14 @@ func (k msgServer) BridgeOut(goCtx context.Context, msg *types.MsgBridgeOut) (*t
     ctx := sdk.UnwrapSDKContext(goCtx)
     
     // Get module parameters
-    params := k.GetParams(ctx)
+    params, err := k.GetParams(ctx)
+    if err != nil {
+        return nil, err
+    }
     
-    // Check if bridge is enabled
-    if !params.BridgeEnabled {
-        return nil, errorsmod.Wrap(types.ErrBridgeDisabled, "bridge is currently disabled")
+    // Check if bridge is enabled and not paused
+    if err := k.checkBridgeOpen(ctx, params); err != nil {
+        return nil, err
     }
     
     // Validate the amount
@@ -35,6 +39,21 @@ func (k msgServer) BridgeOut(goCtx context.Context, msg *types.MsgBridgeOut) (*t
         return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
     }
     
+    // USDC goes back to its source chain when a channel is given
+    if msg.ChannelId != "" {
+        if _, found := params.GetUsdcSource(msg.ChannelId); !found {
+            return nil, errorsmod.Wrapf(types.ErrUnknownUsdcSource, "no usdc source on channel %s", msg.ChannelId)
+        }
+        if msg.Receiver == "" {
+            return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "receiver cannot be empty")
+        }
+    }
+    
+    // Enforce the burn caps
+    if err := k.applyFlowLimits(ctx, params, sender, amount, false); err != nil {
+        return nil, err
+    }
+    
     // Create TestUSD coin (amount being burned)
     testUsdCoin := sdk.NewCoin(params.TestusdDenom, amount)
     
@@ -50,53 +69,151 @@ func (k msgServer) BridgeOut(goCtx context.Context, msg *types.MsgBridgeOut) (*t
         return nil, errorsmod.Wrap(err, "failed to transfer TestUSD to module")
     }
     
-    // Burn the TestUSD tokens
-    err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(testUsdCoin))
+    // Large or reviewed withdrawals wait in the queue with the TestUSD held
+    // by the module
+    if params.WithdrawalQueue.Queues(amount) {
+        id, err := k.queueWithdrawal(ctx, params, msg.Sender, testUsdCoin, msg.ChannelId, msg.Receiver)
+        if err != nil {
+            return nil, err
+        }
+        return &types.MsgBridgeOutResponse{
+            ReleasedAmount: sdk.NewCoin(params.UsdcDenom, math.ZeroInt()),
+            Queued:         true,
+            WithdrawalId:   id,
+        }, nil
+    }
+    
+    recordSeq, err := k.addBridgeRecord(ctx, types.BridgeRecord{
+        Address:   msg.Sender,
+        Direction: types.BridgeDirectionOut,
+        Testusd:   testUsdCoin,
+        ChannelId: msg.ChannelId,
+    })
     if err != nil {
-        return nil, errorsmod.Wrap(err, "failed to burn TestUSD")
+        return nil, err
     }
     
-    // Calculate USDC amount to release (1:1 ratio)
-    pegRatio, err := math.LegacyNewDecFromStr(params.PegRatio)
+    usdcCoin, sequence, err := k.releaseUsdc(ctx, params, msg.Sender, testUsdCoin, msg.ChannelId, msg.Receiver, recordSeq)
     if err != nil {
-        return nil, errorsmod.Wrap(types.ErrInvalidPegRatio, "invalid peg ratio")
+        return nil, err
     }
     
-    usdcAmount := math.LegacyNewDecFromInt(amount).Quo(pegRatio).TruncateInt()
-    usdcCoin := sdk.NewCoin(params.UsdcDenom, usdcAmount)
+    return &types.MsgBridgeOutResponse{
+        ReleasedAmount: usdcCoin,
+        Sequence:       sequence,
+    }, nil
+}
+
+// releaseUsdc burns testUsdCoin held by the module account, less the redeem
+// fee paid to the treasury, and releases the USDC it redeems for, to sender on this chain or to receiver over channelID.
+// recordSeq is sender's bridge record of the bridge out.
+func (k Keeper) releaseUsdc(ctx sdk.Context, params types.Params, sender string, testUsdCoin sdk.Coin, channelID, receiver string, recordSeq uint64) (sdk.Coin, uint64, error) {
+    usdcDenom := params.UsdcDenom
+    if channelID != "" {
+        source, found := params.GetUsdcSource(channelID)
+        if !found {
+            return sdk.Coin{}, 0, errorsmod.Wrapf(types.ErrUnknownUsdcSource, "no usdc source on channel %s", channelID)
+        }
+        usdcDenom = source.VoucherDenom()
+    }
     
-    // Check if module has enough USDC to release
-    moduleBalance := k.bankKeeper.GetBalance(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName), params.UsdcDenom)
-    if moduleBalance.Amount.LT(usdcAmount) {
-        return nil, errorsmod.Wrap(types.ErrInsufficientBridgeBalance, "insufficient USDC in bridge")
+    // The redeem fee goes to the treasury and the rest is burned. Over IBC
+    // the module holds the fee until the transfer completes
+    feeCoin := sdk.NewCoin(testUsdCoin.Denom, params.Fees.RedeemFee(testUsdCoin.Amount))
+    burnCoin := testUsdCoin.Sub(feeCoin)
+    
+    // Calculate USDC amount to release at the peg, or pro rata in a depeg
+    usdcAmount, err := k.redeemUsdc(ctx, params, burnCoin.Amount)
+    if err != nil {
+        return sdk.Coin{}, 0, err
+    }
+    usdcCoin := sdk.NewCoin(usdcDenom, usdcAmount)
+    pegRatio := math.LegacyZeroDec()
+    if usdcAmount.IsPositive() {
+        pegRatio = math.LegacyNewDecFromInt(burnCoin.Amount).QuoInt(usdcAmount)
+    }
+    
+    if channelID == "" {
+        if err := k.payFee(ctx, params, feeCoin); err != nil {
+            return sdk.Coin{}, 0, err
+        }
     }
     
-    // Send USDC from module to user
-    err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(usdcCoin))
+    // Burn the TestUSD tokens
+    err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(burnCoin))
     if err != nil {
-        return nil, errorsmod.Wrap(err, "failed to send USDC to user")
+        return sdk.Coin{}, 0, errorsmod.Wrap(err, "failed to burn TestUSD")
     }
     
-    // Update total bridged amount
-    totalBridged := k.GetTotalBridged(ctx)
-    k.SetTotalBridged(ctx, totalBridged.Sub(usdcAmount))
+    // Check if module has enough USDC to release
+    moduleBalance := k.bankKeeper.GetBalance(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName), usdcDenom)
+    if moduleBalance.Amount.LT(usdcAmount) {
+        return sdk.Coin{}, 0, errorsmod.Wrap(types.ErrInsufficientBridgeBalance, "insufficient USDC in bridge")
+    }
     
-    // Update total supply
-    totalSupply := k.GetTotalSupply(ctx)
-    k.SetTotalSupply(ctx, totalSupply.Sub(amount))
+    // Send USDC from module to user, here or on the source chain
+    var sequence uint64
+    to := "external_bridge"
+    status := types.BridgeStatusCompleted
+    if channelID == "" {
+        senderAddr, err := sdk.AccAddressFromBech32(sender)
+        if err != nil {
+            return sdk.Coin{}, 0, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+        }
+        err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, senderAddr, sdk.NewCoins(usdcCoin))
+        if err != nil {
+            return sdk.Coin{}, 0, errorsmod.Wrap(err, "failed to send USDC to user")
+        }
+    } else {
+        sequence, err = k.SendUsdc(ctx, sender, channelID, receiver, burnCoin, feeCoin, usdcCoin, recordSeq)
+        if err != nil {
+            return sdk.Coin{}, 0, err
+        }
+        to = receiver
+        status = types.BridgeStatusSent
+    }
+    
+    // Update total bridged amount and supply
+    if err := k.addTotals(ctx, usdcAmount.Neg(), burnCoin.Amount.Neg()); err != nil {
+        return sdk.Coin{}, 0, err
+    }
     
     // Update statistics
-    stats := k.GetBridgeStatistics(ctx)
-    stats.TotalBridgeOutCount++
-    stats.LastBridgeOutTimestamp = ctx.BlockTime().Unix()
-    k.SetBridgeStatistics(ctx, stats)
+    err = k.updateBridgeStatistics(ctx, func(stats *types.BridgeStatistics) {
+        stats.TotalBridgeOutCount++
+        stats.LastBridgeOutTimestamp = ctx.BlockTime().Unix()
+    })
+    if err != nil {
+        return sdk.Coin{}, 0, err
+    }
+    
+    err = k.updateBridgeRecord(ctx, sender, recordSeq, func(record *types.BridgeRecord) {
+        record.Usdc = usdcCoin
+        record.Status = status
+    })
+    if err != nil {
+        return sdk.Coin{}, 0, err
+    }
     
     // Record transaction
     if tk := k.GetTransactionKeeper(); tk != nil {
         description := fmt.Sprintf("Bridged out %s, released %s", testUsdCoin.String(), usdcCoin.String())
-        metadata := fmt.Sprintf(`{"testusd_amount":"%s","usdc_amount":"%s","peg_ratio":"%s"}`, testUsdCoin.String(), usdcCoin.String(), pegRatio.String())
         
-        if err := tk.RecordTransaction(ctx, msg.Sender, "bridge_out", description, sdk.NewCoins(testUsdCoin), msg.Sender, "external_bridge", metadata); err != nil {
+        record := mychaintypes.TransactionRecord{
+            Address:     sender,
+            Type:        "bridge_out",
+            Description: description,
+            Amount:      sdk.NewCoins(testUsdCoin),
+            From:        sender,
+            To:          to,
+            Details: &mychaintypes.TransactionRecord_Bridge{Bridge: &mychaintypes.BridgeDetails{
+                Direction: "out",
+                Usdc:      usdcCoin,
+                Testusd:   testUsdCoin,
+                PegRatio:  pegRatio,
+            }},
+        }
+        if err := tk.RecordTransaction(ctx, record); err != nil {
             k.Logger(ctx).Error("failed to record transaction", "error", err)
         }
     }
@@ -105,19 +222,20 @@ func (k msgServer) BridgeOut(goCtx context.Context, msg *types.MsgBridgeOut) (*t
     ctx.EventManager().EmitEvent(
         sdk.NewEvent(
             types.EventTypeBridgeOut,
-            sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
-            sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+            sdk.NewAttribute(types.AttributeKeySender, sender),
+            sdk.NewAttribute(types.AttributeKeyAmount, testUsdCoin.Amount.String()),
             sdk.NewAttribute(types.AttributeKeyReleasedAmount, usdcCoin.String()),
+            sdk.NewAttribute(types.AttributeKeyFee, feeCoin.String()),
+            sdk.NewAttribute(types.AttributeKeyChannel, channelID),
+            sdk.NewAttribute(types.AttributeKeyReceiver, receiver),
         ),
     )
     
     k.Logger(ctx).Info("Bridge out successful",
-        "sender", msg.Sender,
+        "sender", sender,
         "testusd_burned", testUsdCoin.String(),
         "usdc_released", usdcCoin.String(),
     )
     
-    return &types.MsgBridgeOutResponse{
-        ReleasedAmount: usdcCoin,
-    }, nil
+    return usdcCoin, sequence, nil
 }
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+    "context"
+
+    errorsmod "cosmossdk.io/errors"
+    sdk "github.com/cosmos/cosmos-sdk/types"
+    sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+    "mychain/x/testusd/types"
+)
+
+func (k msgServer) UpdateCollateralPrice(goCtx context.Context, msg *types.MsgUpdateCollateralPrice) (*types.MsgUpdateCollateralPriceResponse, error) {
+    ctx := sdk.UnwrapSDKContext(goCtx)
+    params, err := k.GetParams(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    if params.PriceOracle.Oracle == "" || msg.Oracle != params.PriceOracle.Oracle {
+        return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the price oracle", msg.Oracle)
+    }
+    if msg.Price.IsNil() || !msg.Price.IsPositive() {
+        return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "price must be positive")
+    }
+
+    price := types.CollateralPrice{
+        Price:     msg.Price,
+        UpdatedAt: ctx.BlockTime().Unix(),
+        Oracle:    msg.Oracle,
+    }
+    if err := k.CollateralPrice.Set(ctx, price); err != nil {
+        return nil, err
+    }
+
+    ctx.EventManager().EmitEvent(
+        sdk.NewEvent(
+            types.EventTypeCollateralPrice,
+            sdk.NewAttribute(types.AttributeKeySender, msg.Oracle),
+            sdk.NewAttribute(types.AttributeKeyPrice, msg.Price.String()),
+        ),
+    )
+
+    return &types.MsgUpdateCollateralPriceResponse{}, nil
+}
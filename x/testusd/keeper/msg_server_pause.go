@@ -0,0 +1,56 @@
+package keeper
+
+import (
+    "context"
+
+    errorsmod "cosmossdk.io/errors"
+    sdk "github.com/cosmos/cosmos-sdk/types"
+    sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+    "mychain/x/testusd/types"
+)
+
+func (k msgServer) PauseBridge(goCtx context.Context, msg *types.MsgPauseBridge) (*types.MsgPauseBridgeResponse, error) {
+    ctx := sdk.UnwrapSDKContext(goCtx)
+    params, err := k.GetParams(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    // The guardian can pause, and so can the authority
+    isGuardian := params.Guardian != "" && msg.Signer == params.Guardian
+    if !isGuardian && k.checkAuthority(msg.Signer) != nil {
+        return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is neither the guardian nor the authority", msg.Signer)
+    }
+
+    if k.IsPaused(ctx) {
+        return nil, errorsmod.Wrap(types.ErrBridgePaused, "bridge is already paused")
+    }
+
+    if err := k.SetBridgePaused(ctx, msg.Signer, msg.Reason); err != nil {
+        return nil, err
+    }
+
+    return &types.MsgPauseBridgeResponse{}, nil
+}
+
+func (k msgServer) UnpauseBridge(goCtx context.Context, msg *types.MsgUnpauseBridge) (*types.MsgUnpauseBridgeResponse, error) {
+    ctx := sdk.UnwrapSDKContext(goCtx)
+
+    if err := k.checkAuthority(msg.Authority); err != nil {
+        return nil, err
+    }
+
+    if err := k.Pause.Remove(ctx); err != nil {
+        return nil, err
+    }
+
+    ctx.EventManager().EmitEvent(
+        sdk.NewEvent(
+            types.EventTypeBridgeUnpaused,
+            sdk.NewAttribute(types.AttributeKeySender, msg.Authority),
+        ),
+    )
+
+    return &types.MsgUnpauseBridgeResponse{}, nil
+}
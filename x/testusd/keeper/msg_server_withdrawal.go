@@ -0,0 +1,77 @@
+package keeper
+
+import (
+    "context"
+    "errors"
+    "fmt"
+
+    "cosmossdk.io/collections"
+    errorsmod "cosmossdk.io/errors"
+    sdk "github.com/cosmos/cosmos-sdk/types"
+    sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+    "mychain/x/testusd/types"
+)
+
+// getWithdrawal returns the queued withdrawal id.
+func (k Keeper) getWithdrawal(ctx context.Context, id uint64) (types.PendingWithdrawal, error) {
+    withdrawal, err := k.Withdrawals.Get(ctx, id)
+    if errors.Is(err, collections.ErrNotFound) {
+        return withdrawal, errorsmod.Wrapf(types.ErrWithdrawalNotFound, "withdrawal %d", id)
+    }
+    return withdrawal, err
+}
+
+func (k msgServer) CancelWithdrawal(goCtx context.Context, msg *types.MsgCancelWithdrawal) (*types.MsgCancelWithdrawalResponse, error) {
+    ctx := sdk.UnwrapSDKContext(goCtx)
+
+    withdrawal, err := k.getWithdrawal(ctx, msg.Id)
+    if err != nil {
+        return nil, err
+    }
+    if withdrawal.Sender != msg.Sender {
+        return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "withdrawal %d belongs to %s", msg.Id, withdrawal.Sender)
+    }
+
+    if err := k.returnWithdrawal(ctx, withdrawal, types.BridgeStatusCancelled); err != nil {
+        return nil, err
+    }
+
+    ctx.EventManager().EmitEvent(
+        sdk.NewEvent(
+            types.EventTypeWithdrawalCancelled,
+            sdk.NewAttribute(types.AttributeKeyWithdrawalID, fmt.Sprintf("%d", msg.Id)),
+            sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+        ),
+    )
+
+    return &types.MsgCancelWithdrawalResponse{}, nil
+}
+
+func (k msgServer) ApproveWithdrawal(goCtx context.Context, msg *types.MsgApproveWithdrawal) (*types.MsgApproveWithdrawalResponse, error) {
+    ctx := sdk.UnwrapSDKContext(goCtx)
+
+    if err := k.checkAuthority(msg.Authority); err != nil {
+        return nil, err
+    }
+
+    params, err := k.GetParams(ctx)
+    if err != nil {
+        return nil, err
+    }
+    if err := k.checkBridgeOpen(ctx, params); err != nil {
+        return nil, err
+    }
+
+    withdrawal, err := k.getWithdrawal(ctx, msg.Id)
+    if err != nil {
+        return nil, err
+    }
+
+    usdcCoin, err := k.releaseWithdrawal(ctx, params, withdrawal)
+    if err != nil {
+        return nil, err
+    }
+
+    return &types.MsgApproveWithdrawalResponse{ReleasedAmount: usdcCoin}, nil
+}
@@ -10,14 +10,8 @@ import (
 )
 
 func (k msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
-	authority, err := k.addressCodec.StringToBytes(req.Authority)
-	if err != nil {
-		return nil, errorsmod.Wrap(err, "invalid authority address")
-	}
-
-	if !bytes.Equal([]byte(k.GetAuthority()), authority) {
-		expectedAuthorityStr, _ := k.addressCodec.BytesToString([]byte(k.GetAuthority()))
-		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, req.Authority)
+	if err := k.checkAuthority(req.Authority); err != nil {
+		return nil, err
 	}
 
 	if err := req.Params.Validate(); err != nil {
@@ -30,3 +24,21 @@ func (k msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// checkAuthority returns an error unless addr is the module authority.
+func (k Keeper) checkAuthority(addr string) error {
+	authority, err := k.addressCodec.StringToBytes(addr)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	expected, err := k.addressCodec.StringToBytes(k.GetAuthority())
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid module authority")
+	}
+
+	if !bytes.Equal(expected, authority) {
+		return errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), addr)
+	}
+	return nil
+}
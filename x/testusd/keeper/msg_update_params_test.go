@@ -11,13 +11,12 @@ import (
 
 func TestMsgUpdateParams(t *testing.T) {
 	f := initFixture(t)
-	ms := keeper.NewMsgServerImpl(f.keeper)
+	ms := keeper.NewMsgServerImpl(&f.keeper)
 
 	params := types.DefaultParams()
 	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
 
-	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
-	require.NoError(t, err)
+	authorityStr := f.keeper.GetAuthority()
 
 	// default params
 	testCases := []struct {
@@ -36,12 +35,13 @@ func TestMsgUpdateParams(t *testing.T) {
 			expErrMsg: "invalid authority",
 		},
 		{
-			name: "send enabled param",
+			name: "invalid params",
 			input: &types.MsgUpdateParams{
 				Authority: authorityStr,
 				Params:    types.Params{},
 			},
-			expErr: false,
+			expErr:    true,
+			expErrMsg: "peg ratio cannot be empty",
 		},
 		{
 			name: "all good",
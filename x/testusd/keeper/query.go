@@ -21,20 +21,27 @@ type queryServer struct {
 }
 
 func (q queryServer) BridgeStatus(ctx context.Context, req *types.QueryBridgeStatusRequest) (*types.QueryBridgeStatusResponse, error) {
-    if req == nil {
-        return nil, status.Error(codes.InvalidArgument, "invalid request")
-    }
-    
-    k := q.k
-    sdkCtx := sdk.UnwrapSDKContext(ctx)
-    
-    totalBridged := k.GetTotalBridged(sdkCtx)
-    totalSupply := k.GetTotalSupply(sdkCtx)
-    
-    return &types.QueryBridgeStatusResponse{
-        TotalBridged: totalBridged,
-        TotalSupply:  totalSupply,
-    }, nil
+    return q.k.BridgeStatus(ctx, req)
+}
+
+func (q queryServer) BridgeHistory(ctx context.Context, req *types.QueryBridgeHistoryRequest) (*types.QueryBridgeHistoryResponse, error) {
+    return q.k.BridgeHistory(ctx, req)
+}
+
+func (q queryServer) PendingWithdrawals(ctx context.Context, req *types.QueryPendingWithdrawalsRequest) (*types.QueryPendingWithdrawalsResponse, error) {
+    return q.k.PendingWithdrawals(ctx, req)
+}
+
+func (q queryServer) EffectiveRates(ctx context.Context, req *types.QueryEffectiveRatesRequest) (*types.QueryEffectiveRatesResponse, error) {
+    return q.k.EffectiveRates(ctx, req)
+}
+
+func (q queryServer) Reserves(ctx context.Context, req *types.QueryReservesRequest) (*types.QueryReservesResponse, error) {
+    return q.k.Reserves(ctx, req)
+}
+
+func (q queryServer) ReserveAttestations(ctx context.Context, req *types.QueryReserveAttestationsRequest) (*types.QueryReserveAttestationsResponse, error) {
+    return q.k.ReserveAttestations(ctx, req)
 }
 
 func (q queryServer) TotalSupply(ctx context.Context, req *types.QueryTotalSupplyRequest) (*types.QueryTotalSupplyResponse, error) {
@@ -45,7 +52,10 @@ func (q queryServer) TotalSupply(ctx context.Context, req *types.QueryTotalSuppl
     k := q.k
     sdkCtx := sdk.UnwrapSDKContext(ctx)
     
-    totalSupply := k.GetTotalSupply(sdkCtx)
+    totalSupply, err := k.GetTotalSupply(sdkCtx)
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
     
     return &types.QueryTotalSupplyResponse{
         TotalSupply: totalSupply,
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+    "context"
+
+    "cosmossdk.io/collections"
+    sdk "github.com/cosmos/cosmos-sdk/types"
+    "github.com/cosmos/cosmos-sdk/types/query"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+
+    "mychain/x/testusd/types"
+)
+
+func (k Keeper) BridgeHistory(ctx context.Context, req *types.QueryBridgeHistoryRequest) (*types.QueryBridgeHistoryResponse, error) {
+    if req == nil {
+        return nil, status.Error(codes.InvalidArgument, "invalid request")
+    }
+    if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+        return nil, status.Error(codes.InvalidArgument, "invalid address")
+    }
+
+    records, pageRes, err := query.CollectionPaginate(ctx, k.BridgeRecords, req.Pagination,
+        func(_ collections.Pair[string, uint64], record types.BridgeRecord) (types.BridgeRecord, error) {
+            return record, nil
+        },
+        query.WithCollectionPaginationPairPrefix[string, uint64](req.Address),
+    )
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    return &types.QueryBridgeHistoryResponse{
+        Records:    records,
+        Pagination: pageRes,
+    }, nil
+}
+
+func (k Keeper) PendingWithdrawals(ctx context.Context, req *types.QueryPendingWithdrawalsRequest) (*types.QueryPendingWithdrawalsResponse, error) {
+    if req == nil {
+        return nil, status.Error(codes.InvalidArgument, "invalid request")
+    }
+
+    withdrawals, pageRes, err := query.CollectionFilteredPaginate(ctx, k.Withdrawals, req.Pagination,
+        func(_ uint64, withdrawal types.PendingWithdrawal) (bool, error) {
+            return req.Address == "" || withdrawal.Sender == req.Address, nil
+        },
+        func(_ uint64, withdrawal types.PendingWithdrawal) (types.PendingWithdrawal, error) {
+            return withdrawal, nil
+        },
+    )
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    return &types.QueryPendingWithdrawalsResponse{
+        Withdrawals: withdrawals,
+        Pagination:  pageRes,
+    }, nil
+}
@@ -16,15 +16,46 @@ func (k Keeper) BridgeStatus(goCtx context.Context, req *types.QueryBridgeStatus
     }
     ctx := sdk.UnwrapSDKContext(goCtx)
 
-    params := k.GetParams(ctx)
-    totalBridged := k.GetTotalBridged(ctx)
-    totalSupply := k.GetTotalSupply(ctx)
-    stats := k.GetBridgeStatistics(ctx)
+    var addr sdk.AccAddress
+    if req.Address != "" {
+        var err error
+        if addr, err = sdk.AccAddressFromBech32(req.Address); err != nil {
+            return nil, status.Error(codes.InvalidArgument, "invalid address")
+        }
+    }
+
+    params, err := k.GetParams(ctx)
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+    totalBridged, err := k.GetTotalBridged(ctx)
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+    totalSupply, err := k.GetTotalSupply(ctx)
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+    stats, err := k.GetBridgeStatistics(ctx)
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    pause, err := k.GetPause(ctx)
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+    quota, err := k.GetBridgeQuota(ctx, addr)
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
 
     return &types.QueryBridgeStatusResponse{
         TotalBridged:   totalBridged,
         TotalSupply:    totalSupply,
         BridgeEnabled:  params.BridgeEnabled,
         Statistics:     &stats,
+        Pause:          pause,
+        Quota:          quota,
     }, nil
 }
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+    "context"
+
+    "cosmossdk.io/math"
+    sdk "github.com/cosmos/cosmos-sdk/types"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+
+    "mychain/x/testusd/types"
+)
+
+// EffectiveRates returns the TestUSD received per USDC bridged in and the
+// USDC received per TestUSD bridged out, after fees and depeg handling.
+func (k Keeper) EffectiveRates(goCtx context.Context, req *types.QueryEffectiveRatesRequest) (*types.QueryEffectiveRatesResponse, error) {
+    if req == nil {
+        return nil, status.Error(codes.InvalidArgument, "invalid request")
+    }
+    ctx := sdk.UnwrapSDKContext(goCtx)
+    params, err := k.GetParams(ctx)
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    mintRate, err := k.mintRate(ctx, params)
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+    price, err := k.depegPrice(ctx, params)
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+    collateralPrice, err := k.GetCollateralPrice(ctx)
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    // Rates are quoted on a large amount so integer truncation does not
+    // distort them
+    unit := math.NewIntWithDecimal(1, 18)
+    redeemed := unit.Sub(params.Fees.RedeemFee(unit))
+    usdc, err := k.redeemUsdc(ctx, params, redeemed)
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+    minted := math.LegacyNewDecFromInt(unit).Mul(mintRate).TruncateInt()
+    minted = minted.Sub(params.Fees.MintFee(minted))
+
+    return &types.QueryEffectiveRatesResponse{
+        MintRate:        math.LegacyNewDecFromInt(minted).QuoInt(unit),
+        RedeemRate:      math.LegacyNewDecFromInt(usdc).QuoInt(unit),
+        Depegged:        price != nil,
+        CollateralPrice: collateralPrice,
+    }, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"mychain/testutil/nullify"
 	"mychain/x/testusd/keeper"
 	"mychain/x/testusd/types"
 )
@@ -18,5 +19,5 @@ func TestParamsQuery(t *testing.T) {
 
 	response, err := qs.Params(f.ctx, &types.QueryParamsRequest{})
 	require.NoError(t, err)
-	require.Equal(t, &types.QueryParamsResponse{Params: params}, response)
+	require.Equal(t, nullify.Fill(&types.QueryParamsResponse{Params: params}), nullify.Fill(response))
 }
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+    "context"
+
+    sdk "github.com/cosmos/cosmos-sdk/types"
+    "github.com/cosmos/cosmos-sdk/types/query"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+
+    "mychain/x/testusd/types"
+)
+
+func (k Keeper) Reserves(goCtx context.Context, req *types.QueryReservesRequest) (*types.QueryReservesResponse, error) {
+    if req == nil {
+        return nil, status.Error(codes.InvalidArgument, "invalid request")
+    }
+    ctx := sdk.UnwrapSDKContext(goCtx)
+    params, err := k.GetParams(ctx)
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    ratio, err := k.CollateralRatio(ctx, params)
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+    latest, err := k.LatestAttestation(ctx)
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    trackedSupply, err := k.GetTotalSupply(ctx)
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+    trackedBridged, err := k.GetTotalBridged(ctx)
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    return &types.QueryReservesResponse{
+        TrackedSupply:      trackedSupply,
+        BankSupply:         k.bankKeeper.GetSupply(ctx, params.TestusdDenom).Amount,
+        TrackedBridged:     trackedBridged,
+        EscrowedCollateral: k.EscrowedCollateral(ctx, params),
+        CollateralRatio:    ratio,
+        BridgeInHalted:     k.checkCollateralized(ctx, params) != nil,
+        LatestAttestation:  latest,
+    }, nil
+}
+
+func (k Keeper) ReserveAttestations(goCtx context.Context, req *types.QueryReserveAttestationsRequest) (*types.QueryReserveAttestationsResponse, error) {
+    if req == nil {
+        return nil, status.Error(codes.InvalidArgument, "invalid request")
+    }
+
+    attestations, pageRes, err := query.CollectionPaginate(goCtx, k.Attestations, req.Pagination,
+        func(_ uint64, attestation types.ReserveAttestation) (types.ReserveAttestation, error) {
+            return attestation, nil
+        },
+    )
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    return &types.QueryReserveAttestationsResponse{
+        Attestations: attestations,
+        Pagination:   pageRes,
+    }, nil
+}
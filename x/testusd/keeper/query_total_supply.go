@@ -16,7 +16,10 @@ func (k Keeper) TotalSupply(goCtx context.Context, req *types.QueryTotalSupplyRe
     }
     ctx := sdk.UnwrapSDKContext(goCtx)
 
-    totalSupply := k.GetTotalSupply(ctx)
+    totalSupply, err := k.GetTotalSupply(ctx)
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
 
     return &types.QueryTotalSupplyResponse{
         TotalSupply: totalSupply,
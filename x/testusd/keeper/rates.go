@@ -0,0 +1,123 @@
+package keeper
+
+import (
+    "context"
+    "errors"
+
+    "cosmossdk.io/collections"
+    errorsmod "cosmossdk.io/errors"
+    "cosmossdk.io/math"
+    sdk "github.com/cosmos/cosmos-sdk/types"
+
+    "mychain/x/testusd/types"
+)
+
+// GetCollateralPrice returns the last USDC price posted by the oracle, or
+// nil if none was posted.
+func (k Keeper) GetCollateralPrice(ctx context.Context) (*types.CollateralPrice, error) {
+    price, err := k.CollateralPrice.Get(ctx)
+    if errors.Is(err, collections.ErrNotFound) {
+        return nil, nil
+    } else if err != nil {
+        return nil, err
+    }
+    return &price, nil
+}
+
+// depegPrice returns the oracle price of USDC while it is fresh and below
+// the depeg threshold, and nil otherwise.
+func (k Keeper) depegPrice(ctx context.Context, params types.Params) (*math.LegacyDec, error) {
+    oracle := params.PriceOracle
+    if oracle.DepegThreshold.IsNil() || !oracle.DepegThreshold.IsPositive() {
+        return nil, nil
+    }
+
+    price, err := k.GetCollateralPrice(ctx)
+    if err != nil || price == nil {
+        return nil, err
+    }
+
+    age := sdk.UnwrapSDKContext(ctx).BlockTime().Unix() - price.UpdatedAt
+    if age > int64(oracle.MaxAgeSeconds) || price.Price.GTE(oracle.DepegThreshold) {
+        return nil, nil
+    }
+    return &price.Price, nil
+}
+
+// mintRate returns the TestUSD minted per USDC before fees: the peg ratio,
+// scaled by the USDC price during a depeg.
+func (k Keeper) mintRate(ctx context.Context, params types.Params) (math.LegacyDec, error) {
+    pegRatio, err := params.PegRatioDec()
+    if err != nil {
+        return math.LegacyDec{}, errorsmod.Wrap(types.ErrInvalidPegRatio, "invalid peg ratio")
+    }
+
+    price, err := k.depegPrice(ctx, params)
+    if err != nil {
+        return math.LegacyDec{}, err
+    }
+    if price != nil {
+        return pegRatio.Mul(*price), nil
+    }
+    return pegRatio, nil
+}
+
+// redeemUsdc returns the USDC paid for redeeming amount TestUSD after fees:
+// amount over the peg ratio, or during a depeg at most amount's pro rata
+// share of the escrowed collateral.
+func (k Keeper) redeemUsdc(ctx context.Context, params types.Params, amount math.Int) (math.Int, error) {
+    pegRatio, err := params.PegRatioDec()
+    if err != nil {
+        return math.Int{}, errorsmod.Wrap(types.ErrInvalidPegRatio, "invalid peg ratio")
+    }
+    usdc := math.LegacyNewDecFromInt(amount).Quo(pegRatio).TruncateInt()
+
+    price, err := k.depegPrice(ctx, params)
+    if err != nil || price == nil {
+        return usdc, err
+    }
+
+    supply := k.bankKeeper.GetSupply(ctx, params.TestusdDenom).Amount
+    if !supply.IsPositive() {
+        return usdc, nil
+    }
+    proRata := amount.Mul(k.EscrowedCollateral(ctx, params)).Quo(supply)
+    return math.MinInt(usdc, proRata), nil
+}
+
+// payFee sends fee from the module account to the treasury.
+func (k Keeper) payFee(ctx context.Context, params types.Params, fee sdk.Coin) error {
+    if !fee.IsPositive() {
+        return nil
+    }
+    treasury, err := sdk.AccAddressFromBech32(params.Fees.Treasury)
+    if err != nil {
+        return errorsmod.Wrap(err, "invalid treasury address")
+    }
+    err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, treasury, sdk.NewCoins(fee))
+    if err != nil {
+        return errorsmod.Wrap(err, "failed to pay fee to treasury")
+    }
+    return nil
+}
+
+// settleFee pays the redeem fee held for a completed bridge out to the
+// treasury. It goes back to sender if no treasury is set any more, since the
+// fee can no longer be collected.
+func (k Keeper) settleFee(ctx context.Context, sender sdk.AccAddress, fee sdk.Coin) error {
+    if !fee.IsPositive() {
+        return nil
+    }
+    params, err := k.GetParams(ctx)
+    if err != nil {
+        return err
+    }
+    if params.Fees.Treasury != "" {
+        return k.payFee(ctx, params, fee)
+    }
+    err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(fee))
+    if err != nil {
+        return errorsmod.Wrap(err, "failed to return fee to user")
+    }
+    return nil
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+    "context"
+
+    "cosmossdk.io/collections"
+    errorsmod "cosmossdk.io/errors"
+    "cosmossdk.io/math"
+
+    "mychain/x/testusd/types"
+)
+
+// EscrowedCollateral returns the USDC held by the module account across all
+// collateral denoms.
+func (k Keeper) EscrowedCollateral(ctx context.Context, params types.Params) math.Int {
+    moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+    total := math.ZeroInt()
+    for _, denom := range params.CollateralDenoms() {
+        total = total.Add(k.bankKeeper.GetBalance(ctx, moduleAddr, denom).Amount)
+    }
+    return total
+}
+
+// CollateralRatio returns the escrowed collateral, converted at the peg
+// ratio, over the bank supply of TestUSD. It returns nil while there is no
+// supply.
+func (k Keeper) CollateralRatio(ctx context.Context, params types.Params) (*math.LegacyDec, error) {
+    supply := k.bankKeeper.GetSupply(ctx, params.TestusdDenom).Amount
+    if !supply.IsPositive() {
+        return nil, nil
+    }
+
+    pegRatio, err := params.PegRatioDec()
+    if err != nil {
+        return nil, errorsmod.Wrap(types.ErrInvalidPegRatio, "invalid peg ratio")
+    }
+
+    ratio := math.LegacyNewDecFromInt(k.EscrowedCollateral(ctx, params)).Mul(pegRatio).QuoInt(supply)
+    return &ratio, nil
+}
+
+// checkCollateralized halts bridging in while the escrowed collateral does
+// not fully back the TestUSD supply. Bridging out stays open so holders can
+// still redeem.
+func (k Keeper) checkCollateralized(ctx context.Context, params types.Params) error {
+    ratio, err := k.CollateralRatio(ctx, params)
+    if err != nil {
+        return err
+    }
+    if ratio != nil && ratio.LT(math.LegacyOneDec()) {
+        return errorsmod.Wrapf(types.ErrUndercollateralized, "collateral ratio %s is below 1", ratio)
+    }
+    return nil
+}
+
+// LatestAttestation returns the most recent reserve attestation, or nil if
+// none was posted yet.
+func (k Keeper) LatestAttestation(ctx context.Context) (*types.ReserveAttestation, error) {
+    iter, err := k.Attestations.Iterate(ctx, new(collections.Range[uint64]).Descending())
+    if err != nil {
+        return nil, err
+    }
+    defer iter.Close()
+
+    if !iter.Valid() {
+        return nil, nil
+    }
+    attestation, err := iter.Value()
+    if err != nil {
+        return nil, err
+    }
+    return &attestation, nil
+}
@@ -2,100 +2,66 @@ package keeper
 
 import (
     "context"
-    
+    "errors"
+
+    "cosmossdk.io/collections"
     "cosmossdk.io/math"
-    
+
     "mychain/x/testusd/types"
 )
 
 // GetTotalBridged returns the total amount of USDC bridged
-func (k Keeper) GetTotalBridged(ctx context.Context) math.Int {
-    store := k.storeService.OpenKVStore(ctx)
-    bz, err := store.Get(types.TotalBridgedKey)
-    if err != nil {
-        panic(err)
-    }
-    if bz == nil {
-        return math.ZeroInt()
-    }
-    
-    var totalBridged math.Int
-    err = totalBridged.Unmarshal(bz)
-    if err != nil {
-        panic(err)
-    }
-    
-    return totalBridged
+func (k Keeper) GetTotalBridged(ctx context.Context) (math.Int, error) {
+    return getIntOrZero(ctx, k.TrackedBridged)
 }
 
-// SetTotalBridged sets the total amount of USDC bridged
-func (k Keeper) SetTotalBridged(ctx context.Context, amount math.Int) {
-    store := k.storeService.OpenKVStore(ctx)
-    bz, err := amount.Marshal()
-    if err != nil {
-        panic(err)
-    }
-    err = store.Set(types.TotalBridgedKey, bz)
-    if err != nil {
-        panic(err)
-    }
+// GetTotalSupply returns the total supply of TestUSD
+func (k Keeper) GetTotalSupply(ctx context.Context) (math.Int, error) {
+    return getIntOrZero(ctx, k.TrackedSupply)
 }
 
-// GetTotalSupply returns the total supply of TestUSD
-func (k Keeper) GetTotalSupply(ctx context.Context) math.Int {
-    store := k.storeService.OpenKVStore(ctx)
-    bz, err := store.Get(types.TotalSupplyKey)
-    if err != nil {
-        panic(err)
-    }
-    if bz == nil {
-        return math.ZeroInt()
-    }
-    
-    var totalSupply math.Int
-    err = totalSupply.Unmarshal(bz)
-    if err != nil {
-        panic(err)
+// GetBridgeStatistics returns the bridge statistics
+func (k Keeper) GetBridgeStatistics(ctx context.Context) (types.BridgeStatistics, error) {
+    stats, err := k.Statistics.Get(ctx)
+    if errors.Is(err, collections.ErrNotFound) {
+        return types.BridgeStatistics{}, nil
     }
-    
-    return totalSupply
+    return stats, err
 }
 
-// SetTotalSupply sets the total supply of TestUSD
-func (k Keeper) SetTotalSupply(ctx context.Context, amount math.Int) {
-    store := k.storeService.OpenKVStore(ctx)
-    bz, err := amount.Marshal()
+// addTotals adds bridged USDC and supplied TestUSD, either of which may be
+// negative, to the tracked totals.
+func (k Keeper) addTotals(ctx context.Context, bridged, supply math.Int) error {
+    totalBridged, err := k.GetTotalBridged(ctx)
     if err != nil {
-        panic(err)
+        return err
     }
-    err = store.Set(types.TotalSupplyKey, bz)
+    if err := k.TrackedBridged.Set(ctx, totalBridged.Add(bridged)); err != nil {
+        return err
+    }
+
+    totalSupply, err := k.GetTotalSupply(ctx)
     if err != nil {
-        panic(err)
+        return err
     }
+    return k.TrackedSupply.Set(ctx, totalSupply.Add(supply))
 }
 
-// GetBridgeStatistics returns the bridge statistics
-func (k Keeper) GetBridgeStatistics(ctx context.Context) types.BridgeStatistics {
-    store := k.storeService.OpenKVStore(ctx)
-    bz, err := store.Get(types.BridgeStatisticsKey)
+// updateBridgeStatistics applies update to the bridge statistics.
+func (k Keeper) updateBridgeStatistics(ctx context.Context, update func(*types.BridgeStatistics)) error {
+    stats, err := k.GetBridgeStatistics(ctx)
     if err != nil {
-        panic(err)
+        return err
     }
-    if bz == nil {
-        return types.BridgeStatistics{}
-    }
-    
-    var stats types.BridgeStatistics
-    k.cdc.MustUnmarshal(bz, &stats)
-    return stats
+    update(&stats)
+    return k.Statistics.Set(ctx, stats)
 }
 
-// SetBridgeStatistics sets the bridge statistics
-func (k Keeper) SetBridgeStatistics(ctx context.Context, stats types.BridgeStatistics) {
-    store := k.storeService.OpenKVStore(ctx)
-    bz := k.cdc.MustMarshal(&stats)
-    err := store.Set(types.BridgeStatisticsKey, bz)
-    if err != nil {
-        panic(err)
+// getIntOrZero returns the value of item, or zero if it was never set.
+func getIntOrZero(ctx context.Context, item collections.Item[math.Int]) (math.Int, error) {
+    value, err := item.Get(ctx)
+    if errors.Is(err, collections.ErrNotFound) {
+        return math.ZeroInt(), nil
     }
-}
\ No newline at end of file
+    return value, err
+}
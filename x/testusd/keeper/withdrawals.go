@@ -0,0 +1,164 @@
+package keeper
+
+import (
+    "fmt"
+    "time"
+
+    "cosmossdk.io/collections"
+    errorsmod "cosmossdk.io/errors"
+    sdk "github.com/cosmos/cosmos-sdk/types"
+
+    "mychain/x/testusd/types"
+)
+
+// queueWithdrawal puts a bridge out of testUsdCoin, already moved to the
+// module account, in the withdrawal queue and returns its id.
+func (k Keeper) queueWithdrawal(ctx sdk.Context, params types.Params, sender string, testUsdCoin sdk.Coin, channelID, receiver string) (uint64, error) {
+    id, err := k.WithdrawalSeq.Next(ctx)
+    if err != nil {
+        return 0, err
+    }
+
+    recordSeq, err := k.addBridgeRecord(ctx, types.BridgeRecord{
+        Address:      sender,
+        Direction:    types.BridgeDirectionOut,
+        Status:       types.BridgeStatusQueued,
+        Testusd:      testUsdCoin,
+        ChannelId:    channelID,
+        WithdrawalId: id,
+    })
+    if err != nil {
+        return 0, err
+    }
+
+    releaseAt := ctx.BlockTime().Add(time.Duration(params.WithdrawalQueue.DelaySeconds) * time.Second).Unix()
+    withdrawal := types.PendingWithdrawal{
+        Id:             id,
+        Sender:         sender,
+        Testusd:        testUsdCoin,
+        ChannelId:      channelID,
+        Receiver:       receiver,
+        RequestedAt:    ctx.BlockTime().Unix(),
+        ReleaseAt:      releaseAt,
+        RecordSequence: recordSeq,
+    }
+    if err := k.setWithdrawal(ctx, withdrawal); err != nil {
+        return 0, err
+    }
+
+    ctx.EventManager().EmitEvent(
+        sdk.NewEvent(
+            types.EventTypeWithdrawalQueued,
+            sdk.NewAttribute(types.AttributeKeyWithdrawalID, fmt.Sprintf("%d", id)),
+            sdk.NewAttribute(types.AttributeKeySender, sender),
+            sdk.NewAttribute(types.AttributeKeyAmount, testUsdCoin.String()),
+            sdk.NewAttribute(types.AttributeKeyReleaseAt, fmt.Sprintf("%d", releaseAt)),
+        ),
+    )
+
+    return id, nil
+}
+
+// setWithdrawal stores withdrawal and indexes it by release time.
+func (k Keeper) setWithdrawal(ctx sdk.Context, withdrawal types.PendingWithdrawal) error {
+    if err := k.Withdrawals.Set(ctx, withdrawal.Id, withdrawal); err != nil {
+        return err
+    }
+    return k.WithdrawalQueue.Set(ctx, collections.Join(withdrawal.ReleaseAt, withdrawal.Id))
+}
+
+// removeWithdrawal takes withdrawal out of the queue.
+func (k Keeper) removeWithdrawal(ctx sdk.Context, withdrawal types.PendingWithdrawal) error {
+    if err := k.Withdrawals.Remove(ctx, withdrawal.Id); err != nil {
+        return err
+    }
+    return k.WithdrawalQueue.Remove(ctx, collections.Join(withdrawal.ReleaseAt, withdrawal.Id))
+}
+
+// releaseWithdrawal takes withdrawal out of the queue and releases its USDC.
+func (k Keeper) releaseWithdrawal(ctx sdk.Context, params types.Params, withdrawal types.PendingWithdrawal) (sdk.Coin, error) {
+    if err := k.removeWithdrawal(ctx, withdrawal); err != nil {
+        return sdk.Coin{}, err
+    }
+    usdcCoin, _, err := k.releaseUsdc(ctx, params, withdrawal.Sender, withdrawal.Testusd, withdrawal.ChannelId, withdrawal.Receiver, withdrawal.RecordSequence)
+    return usdcCoin, err
+}
+
+// returnWithdrawal takes withdrawal out of the queue and sends its TestUSD
+// back to the sender, marking its record with status.
+func (k Keeper) returnWithdrawal(ctx sdk.Context, withdrawal types.PendingWithdrawal, status string) error {
+    if err := k.removeWithdrawal(ctx, withdrawal); err != nil {
+        return err
+    }
+
+    sender, err := sdk.AccAddressFromBech32(withdrawal.Sender)
+    if err != nil {
+        return err
+    }
+    err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(withdrawal.Testusd))
+    if err != nil {
+        return errorsmod.Wrap(err, "failed to return TestUSD to user")
+    }
+
+    return k.setBridgeRecordStatus(ctx, withdrawal.Sender, withdrawal.RecordSequence, status)
+}
+
+// ProcessWithdrawalQueue releases the queued withdrawals whose delay has
+// passed, at most ReleaseBudget per call and oldest first. A withdrawal that
+// cannot be released is returned to its sender. Nothing is released while
+// the bridge is disabled or paused.
+func (k Keeper) ProcessWithdrawalQueue(ctx sdk.Context) error {
+    params, err := k.GetParams(ctx)
+    if err != nil {
+        return err
+    }
+    if k.checkBridgeOpen(ctx, params) != nil {
+        return nil
+    }
+    budget := params.WithdrawalQueue.ReleaseBudget
+
+    rng := collections.NewPrefixUntilPairRange[int64, uint64](ctx.BlockTime().Unix())
+    iter, err := k.WithdrawalQueue.Iterate(ctx, rng)
+    if err != nil {
+        return err
+    }
+    var due []collections.Pair[int64, uint64]
+    for ; iter.Valid() && uint64(len(due)) < budget; iter.Next() {
+        key, err := iter.Key()
+        if err != nil {
+            iter.Close()
+            return err
+        }
+        due = append(due, key)
+    }
+    iter.Close()
+
+    for _, key := range due {
+        withdrawal, err := k.Withdrawals.Get(ctx, key.K2())
+        if err != nil {
+            return err
+        }
+
+        cacheCtx, write := ctx.CacheContext()
+        _, err = k.releaseWithdrawal(cacheCtx, params, withdrawal)
+        if err == nil {
+            write()
+            ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+            continue
+        }
+
+        k.Logger(ctx).Error("failed to release withdrawal", "id", withdrawal.Id, "error", err)
+        ctx.EventManager().EmitEvent(
+            sdk.NewEvent(
+                types.EventTypeWithdrawalFailed,
+                sdk.NewAttribute(types.AttributeKeyWithdrawalID, fmt.Sprintf("%d", withdrawal.Id)),
+                sdk.NewAttribute(types.AttributeKeySender, withdrawal.Sender),
+                sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+            ),
+        )
+        if err := k.returnWithdrawal(ctx, withdrawal, types.BridgeStatusFailed); err != nil {
+            return err
+        }
+    }
+    return nil
+}
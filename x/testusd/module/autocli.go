@@ -17,6 +17,43 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Shows the parameters of the module",
 				},
+				{
+					RpcMethod: "BridgeStatus",
+					Use:       "bridge-status",
+					Short:     "Shows the bridge totals, pause and remaining limit quotas",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"address": {Usage: "address to show the daily quota of"},
+					},
+				},
+				{
+					RpcMethod:      "BridgeHistory",
+					Use:            "bridge-history [address]",
+					Short:          "Lists the bridge operations of an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "PendingWithdrawals",
+					Use:       "pending-withdrawals",
+					Short:     "Lists the bridge outs waiting in the withdrawal queue",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"address": {Usage: "address to list the withdrawals of"},
+					},
+				},
+				{
+					RpcMethod: "Reserves",
+					Use:       "reserves",
+					Short:     "Compares the TestUSD supply with the escrowed USDC collateral",
+				},
+				{
+					RpcMethod: "EffectiveRates",
+					Use:       "effective-rates",
+					Short:     "Shows the mint and redeem rates after fees and depeg handling",
+				},
+				{
+					RpcMethod: "ReserveAttestations",
+					Use:       "reserve-attestations",
+					Short:     "Lists the posted reserve attestations",
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -28,6 +65,38 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod:      "PauseBridge",
+					Use:            "pause-bridge [reason]",
+					Short:          "Pause the bridge as its guardian",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "reason"}},
+				},
+				{
+					RpcMethod: "UnpauseBridge",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "CancelWithdrawal",
+					Use:            "cancel-withdrawal [id]",
+					Short:          "Cancel a queued bridge out and get the TestUSD back",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "ApproveWithdrawal",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "AttestReserves",
+					Use:            "attest-reserves [amount] [timestamp] [signature]",
+					Short:          "Post a signed attestation of the USDC reserves as the attestor",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}, {ProtoField: "timestamp"}, {ProtoField: "signature"}},
+				},
+				{
+					RpcMethod:      "UpdateCollateralPrice",
+					Use:            "update-collateral-price [price]",
+					Short:          "Post the USDC price as the price oracle",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "price"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
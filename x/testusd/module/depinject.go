@@ -46,7 +46,7 @@ type ModuleInputs struct {
 type ModuleOutputs struct {
         depinject.Out
 
-        TestusdKeeper keeper.Keeper
+        TestusdKeeper *keeper.Keeper
         Module        appmodule.AppModule
 }
 
@@ -66,7 +66,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
                 in.BankKeeper,
                 in.EventService,
         )
-        m := NewAppModule(in.Cdc, k, in.BankKeeper)
+        m := NewAppModule(in.Cdc, &k, in.AccountKeeper, in.BankKeeper)
 
-        return ModuleOutputs{TestusdKeeper: k, Module: m}
+        return ModuleOutputs{TestusdKeeper: &k, Module: m}
 }
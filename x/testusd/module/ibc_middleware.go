@@ -0,0 +1,85 @@
+package testusd
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"mychain/x/testusd/keeper"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware wraps the ICS-20 transfer module to bridge USDC into TestUSD.
+// USDC received over an allow-listed channel is escrowed and TestUSD minted
+// to the receiver. USDC sent back by BridgeOut is settled when its packet is
+// acknowledged, and the TestUSD minted back if the transfer failed.
+type IBCMiddleware struct {
+	porttypes.IBCModule
+
+	keeper *keeper.Keeper
+}
+
+// NewIBCMiddleware wraps app, which must be the transfer stack.
+func NewIBCMiddleware(app porttypes.IBCModule, k *keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		IBCModule: app,
+		keeper:    k,
+	}
+}
+
+// OnRecvPacket mints TestUSD for received USDC. If minting fails the packet
+// is rejected with an error acknowledgement, which also reverts the transfer
+// and refunds the USDC on the sending chain.
+func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, channelVersion string, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	ack := im.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	data, err := transfertypes.UnmarshalPacketData(packet.Data, channelVersion, "")
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	if err := im.keeper.OnRecvUsdc(ctx, packet, data); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return ack
+}
+
+// OnAcknowledgementPacket settles a bridge out, minting the TestUSD back if
+// the counterparty rejected the USDC.
+func (im IBCMiddleware) OnAcknowledgementPacket(ctx sdk.Context, channelVersion string, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+	if err := im.IBCModule.OnAcknowledgementPacket(ctx, channelVersion, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return err
+	}
+	return im.keeper.OnBridgeOutDone(ctx, packet, !ack.Success())
+}
+
+// OnTimeoutPacket mints back the TestUSD of a bridge out that timed out.
+func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, channelVersion string, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	if err := im.IBCModule.OnTimeoutPacket(ctx, channelVersion, packet, relayer); err != nil {
+		return err
+	}
+	return im.keeper.OnBridgeOutDone(ctx, packet, true)
+}
+
+// UnmarshalPacketData forwards to the wrapped module so middleware stacked on
+// top can still decode packets.
+func (im IBCMiddleware) UnmarshalPacketData(ctx sdk.Context, portID, channelID string, bz []byte) (any, string, error) {
+	unmarshaler, ok := im.IBCModule.(porttypes.PacketDataUnmarshaler)
+	if !ok {
+		return nil, "", fmt.Errorf("%T does not unmarshal packet data", im.IBCModule)
+	}
+	return unmarshaler.UnmarshalPacketData(ctx, portID, channelID, bz)
+}
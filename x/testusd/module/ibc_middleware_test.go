@@ -0,0 +1,247 @@
+package testusd_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	"github.com/stretchr/testify/require"
+
+	"mychain/app"
+	"mychain/x/testusd/types"
+)
+
+// bridgeFixture connects chain A, which runs the bridge, to chain B, the
+// source of USDC.
+type bridgeFixture struct {
+	t      *testing.T
+	coord  *ibctesting.Coordinator
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+	path   *ibctesting.Path
+}
+
+func initBridgeFixture(t *testing.T) *bridgeFixture {
+	t.Helper()
+
+	ibctesting.DefaultTestingAppInit = func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		a := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+		return a, a.DefaultGenesis()
+	}
+
+	coord := ibctesting.NewCoordinator(t, 2)
+	f := &bridgeFixture{
+		t:      t,
+		coord:  coord,
+		chainA: coord.GetChain(ibctesting.GetChainID(1)),
+		chainB: coord.GetChain(ibctesting.GetChainID(2)),
+	}
+	f.path = ibctesting.NewTransferPath(f.chainA, f.chainB)
+	f.path.Setup()
+
+	// Accept uusdc from chain B
+	k := f.appA().TestusdKeeper
+	params := f.params()
+	params.UsdcSources = []types.UsdcSource{{ChannelId: f.path.EndpointA.ChannelID, Denom: "uusdc"}}
+	require.NoError(t, k.Params.Set(f.chainA.GetContext(), params))
+
+	// Fund chain B's sender with USDC
+	usdc := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 10_000_000))
+	appB := f.chainB.App.(*app.App)
+	require.NoError(t, appB.BankKeeper.MintCoins(f.chainB.GetContext(), minttypes.ModuleName, usdc))
+	require.NoError(t, appB.BankKeeper.SendCoinsFromModuleToAccount(f.chainB.GetContext(), minttypes.ModuleName, f.chainB.SenderAccount.GetAddress(), usdc))
+
+	coord.CommitBlock(f.chainA, f.chainB)
+	return f
+}
+
+func (f *bridgeFixture) appA() *app.App {
+	return f.chainA.App.(*app.App)
+}
+
+func (f *bridgeFixture) params() types.Params {
+	f.t.Helper()
+	params, err := f.appA().TestusdKeeper.GetParams(f.chainA.GetContext())
+	require.NoError(f.t, err)
+	return params
+}
+
+func (f *bridgeFixture) totalSupply() math.Int {
+	f.t.Helper()
+	supply, err := f.appA().TestusdKeeper.GetTotalSupply(f.chainA.GetContext())
+	require.NoError(f.t, err)
+	return supply
+}
+
+func (f *bridgeFixture) totalBridged() math.Int {
+	f.t.Helper()
+	bridged, err := f.appA().TestusdKeeper.GetTotalBridged(f.chainA.GetContext())
+	require.NoError(f.t, err)
+	return bridged
+}
+
+func (f *bridgeFixture) voucherDenom() string {
+	return transfertypes.NewDenom("uusdc", transfertypes.NewHop(transfertypes.PortID, f.path.EndpointA.ChannelID)).IBCDenom()
+}
+
+func (f *bridgeFixture) balanceA(addr sdk.AccAddress, denom string) math.Int {
+	return f.appA().BankKeeper.GetBalance(f.chainA.GetContext(), addr, denom).Amount
+}
+
+// sendFromB transfers coin from chain B's sender to chain A's sender and
+// relays the packet.
+func (f *bridgeFixture) sendFromB(coin sdk.Coin) {
+	f.t.Helper()
+
+	msg := transfertypes.NewMsgTransfer(
+		f.path.EndpointB.ChannelConfig.PortID, f.path.EndpointB.ChannelID, coin,
+		f.chainB.SenderAccount.GetAddress().String(), f.chainA.SenderAccount.GetAddress().String(),
+		clienttypes.ZeroHeight(), uint64(f.chainB.GetContext().BlockTime().Add(time.Hour).UnixNano()), "",
+	)
+	res, err := f.chainB.SendMsgs(msg)
+	require.NoError(f.t, err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(f.t, err)
+	require.NoError(f.t, f.path.RelayPacket(packet))
+}
+
+// bridgeOut burns amount of chain A sender's TestUSD for USDC sent to
+// receiver on chain B, and returns the packet without relaying it.
+func (f *bridgeFixture) bridgeOut(amount int64, receiver string) channeltypes.Packet {
+	f.t.Helper()
+
+	res, err := f.chainA.SendMsgs(&types.MsgBridgeOut{
+		Sender:    f.chainA.SenderAccount.GetAddress().String(),
+		Amount:    math.NewInt(amount),
+		ChannelId: f.path.EndpointA.ChannelID,
+		Receiver:  receiver,
+	})
+	require.NoError(f.t, err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(f.t, err)
+	return packet
+}
+
+func TestBridgeInOverIBC(t *testing.T) {
+	f := initBridgeFixture(t)
+	userA := f.chainA.SenderAccount.GetAddress()
+	moduleAddr := f.appA().AuthKeeper.GetModuleAddress(types.ModuleName)
+
+	f.sendFromB(sdk.NewInt64Coin("uusdc", 1_000_000))
+
+	// The voucher is escrowed and TestUSD minted 1:1
+	require.Equal(t, math.NewInt(1_000_000), f.balanceA(userA, "utestusd"))
+	require.True(t, f.balanceA(userA, f.voucherDenom()).IsZero())
+	require.Equal(t, math.NewInt(1_000_000), f.balanceA(moduleAddr, f.voucherDenom()))
+	require.Equal(t, math.NewInt(1_000_000), f.totalBridged())
+	require.Equal(t, math.NewInt(1_000_000), f.totalSupply())
+
+	// Other denoms are received as plain vouchers
+	testusdBefore := f.balanceA(userA, "utestusd")
+	f.sendFromB(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500))
+	stakeVoucher := transfertypes.NewDenom(sdk.DefaultBondDenom, transfertypes.NewHop(transfertypes.PortID, f.path.EndpointA.ChannelID)).IBCDenom()
+	require.Equal(t, math.NewInt(500), f.balanceA(userA, stakeVoucher))
+	require.Equal(t, testusdBefore, f.balanceA(userA, "utestusd"))
+}
+
+func TestBridgeOutOverIBC(t *testing.T) {
+	f := initBridgeFixture(t)
+	userA := f.chainA.SenderAccount.GetAddress()
+	userB := f.chainB.SenderAccount.GetAddress()
+	appB := f.chainB.App.(*app.App)
+
+	f.sendFromB(sdk.NewInt64Coin("uusdc", 1_000_000))
+	usdcB := appB.BankKeeper.GetBalance(f.chainB.GetContext(), userB, "uusdc").Amount
+
+	packet := f.bridgeOut(400_000, userB.String())
+	k := f.appA().TestusdKeeper
+	_, err := k.PendingBridgeOuts.Get(f.chainA.GetContext(), collections.Join(packet.SourceChannel, packet.Sequence))
+	require.NoError(t, err)
+
+	require.NoError(t, f.path.RelayPacket(packet))
+
+	// The USDC is back on chain B and the TestUSD stays burned
+	require.Equal(t, usdcB.AddRaw(400_000), appB.BankKeeper.GetBalance(f.chainB.GetContext(), userB, "uusdc").Amount)
+	require.Equal(t, math.NewInt(600_000), f.balanceA(userA, "utestusd"))
+	require.Equal(t, math.NewInt(600_000), f.totalSupply())
+	require.Equal(t, math.NewInt(600_000), f.totalBridged())
+	has, err := k.PendingBridgeOuts.Has(f.chainA.GetContext(), collections.Join(packet.SourceChannel, packet.Sequence))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestBridgeOutRefundOnTimeout(t *testing.T) {
+	f := initBridgeFixture(t)
+	userA := f.chainA.SenderAccount.GetAddress()
+	moduleAddr := f.appA().AuthKeeper.GetModuleAddress(types.ModuleName)
+	k := f.appA().TestusdKeeper
+
+	f.sendFromB(sdk.NewInt64Coin("uusdc", 1_000_000))
+
+	params := f.params()
+	params.IbcTimeoutSeconds = 10
+	require.NoError(t, k.Params.Set(f.chainA.GetContext(), params))
+
+	packet := f.bridgeOut(400_000, f.chainB.SenderAccount.GetAddress().String())
+	require.Equal(t, math.NewInt(600_000), f.balanceA(userA, "utestusd"))
+
+	// Let the packet time out on chain B
+	f.coord.IncrementTimeBy(time.Minute)
+	f.coord.CommitBlock(f.chainB)
+	require.NoError(t, f.path.EndpointA.UpdateClient())
+	require.NoError(t, f.path.EndpointA.TimeoutPacket(packet))
+
+	// The TestUSD is minted back and the USDC is escrowed again
+	require.Equal(t, math.NewInt(1_000_000), f.balanceA(userA, "utestusd"))
+	require.Equal(t, math.NewInt(1_000_000), f.balanceA(moduleAddr, f.voucherDenom()))
+	require.Equal(t, math.NewInt(1_000_000), f.totalSupply())
+	require.Equal(t, math.NewInt(1_000_000), f.totalBridged())
+	has, err := k.PendingBridgeOuts.Has(f.chainA.GetContext(), collections.Join(packet.SourceChannel, packet.Sequence))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestBridgeOutRefundOnErrorAck(t *testing.T) {
+	f := initBridgeFixture(t)
+	userA := f.chainA.SenderAccount.GetAddress()
+	moduleAddr := f.appA().AuthKeeper.GetModuleAddress(types.ModuleName)
+	k := f.appA().TestusdKeeper
+
+	f.sendFromB(sdk.NewInt64Coin("uusdc", 1_000_000))
+
+	// Chain B rejects a receiver that is not an address
+	packet := f.bridgeOut(400_000, "not-an-address")
+	require.NoError(t, f.path.RelayPacket(packet))
+
+	require.Equal(t, math.NewInt(1_000_000), f.balanceA(userA, "utestusd"))
+	require.Equal(t, math.NewInt(1_000_000), f.balanceA(moduleAddr, f.voucherDenom()))
+	require.Equal(t, math.NewInt(1_000_000), f.totalSupply())
+	has, err := k.PendingBridgeOuts.Has(f.chainA.GetContext(), collections.Join(packet.SourceChannel, packet.Sequence))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestBridgeOutUnknownChannel(t *testing.T) {
+	f := initBridgeFixture(t)
+	f.sendFromB(sdk.NewInt64Coin("uusdc", 1_000_000))
+
+	_, err := f.chainA.SendMsgs(&types.MsgBridgeOut{
+		Sender:    f.chainA.SenderAccount.GetAddress().String(),
+		Amount:    math.NewInt(400_000),
+		ChannelId: "channel-99",
+		Receiver:  f.chainB.SenderAccount.GetAddress().String(),
+	})
+	require.ErrorContains(t, err, types.ErrUnknownUsdcSource.Error())
+	require.Equal(t, math.NewInt(1_000_000), f.balanceA(f.chainA.SenderAccount.GetAddress(), "utestusd"))
+}
@@ -0,0 +1,133 @@
+package testusd_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"mychain/app"
+	"mychain/x/testusd/keeper"
+	"mychain/x/testusd/types"
+)
+
+func (f *bridgeFixture) setLimits(limits types.BridgeLimits) {
+	f.t.Helper()
+
+	k := f.appA().TestusdKeeper
+	params := f.params()
+	params.Limits = limits
+	params.Guardian = f.chainA.SenderAccount.GetAddress().String()
+	require.NoError(f.t, params.Validate())
+	require.NoError(f.t, k.Params.Set(f.chainA.GetContext(), params))
+	f.coord.CommitBlock(f.chainA)
+}
+
+func TestBridgeLimitsPerTxAndDaily(t *testing.T) {
+	f := initBridgeFixture(t)
+	limits := types.DefaultBridgeLimits()
+	limits.MaxPerTx = math.NewInt(600_000)
+	limits.MaxPerAddressDaily = math.NewInt(1_000_000)
+	f.setLimits(limits)
+
+	userA := f.chainA.SenderAccount.GetAddress()
+	userB := f.chainB.SenderAccount.GetAddress()
+	appB := f.chainB.App.(*app.App)
+	usdcB := appB.BankKeeper.GetBalance(f.chainB.GetContext(), userB, "uusdc").Amount
+
+	// Over the per-tx cap the packet is rejected and chain B refunds it
+	f.sendFromB(sdk.NewInt64Coin("uusdc", 700_000))
+	require.True(t, f.balanceA(userA, "utestusd").IsZero())
+	require.Equal(t, usdcB, appB.BankKeeper.GetBalance(f.chainB.GetContext(), userB, "uusdc").Amount)
+
+	// The second transfer of the day goes over the daily cap
+	f.sendFromB(sdk.NewInt64Coin("uusdc", 600_000))
+	f.sendFromB(sdk.NewInt64Coin("uusdc", 600_000))
+	require.Equal(t, math.NewInt(600_000), f.balanceA(userA, "utestusd"))
+
+	res, err := keeper.NewQueryServerImpl(*f.appA().TestusdKeeper).BridgeStatus(f.chainA.GetContext(), &types.QueryBridgeStatusRequest{Address: userA.String()})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(600_000), res.Quota.WindowMinted)
+	require.Equal(t, math.NewInt(400_000), *res.Quota.RemainingAddressMint)
+	require.Equal(t, math.NewInt(1_000_000), *res.Quota.RemainingAddressBurn)
+	require.Nil(t, res.Quota.RemainingWindowMint)
+}
+
+func TestBridgeWindowCap(t *testing.T) {
+	f := initBridgeFixture(t)
+	limits := types.DefaultBridgeLimits()
+	limits.MaxBurnPerWindow = math.NewInt(300_000)
+	f.setLimits(limits)
+
+	f.sendFromB(sdk.NewInt64Coin("uusdc", 1_000_000))
+	f.bridgeOut(200_000, f.chainB.SenderAccount.GetAddress().String())
+
+	_, err := f.chainA.SendMsgs(&types.MsgBridgeOut{
+		Sender:    f.chainA.SenderAccount.GetAddress().String(),
+		Amount:    math.NewInt(200_000),
+		ChannelId: f.path.EndpointA.ChannelID,
+		Receiver:  f.chainB.SenderAccount.GetAddress().String(),
+	})
+	require.ErrorContains(t, err, types.ErrBridgeLimitExceeded.Error())
+	require.Equal(t, math.NewInt(800_000), f.balanceA(f.chainA.SenderAccount.GetAddress(), "utestusd"))
+}
+
+func TestCircuitBreakerPausesBridge(t *testing.T) {
+	f := initBridgeFixture(t)
+	limits := types.DefaultBridgeLimits()
+	limits.MaxNetFlowPerWindow = math.NewInt(500_000)
+	f.setLimits(limits)
+	k := f.appA().TestusdKeeper
+	userA := f.chainA.SenderAccount.GetAddress()
+
+	// The transfer that trips the breaker still goes through
+	f.sendFromB(sdk.NewInt64Coin("uusdc", 600_000))
+	require.Equal(t, math.NewInt(600_000), f.balanceA(userA, "utestusd"))
+
+	pause, err := k.GetPause(f.chainA.GetContext())
+	require.NoError(t, err)
+	require.True(t, pause.Paused)
+	require.Equal(t, keeper.PausedByCircuitBreaker, pause.PausedBy)
+
+	// While paused nothing is minted or burned
+	_, err = f.chainA.SendMsgs(&types.MsgBridgeOut{
+		Sender:    userA.String(),
+		Amount:    math.NewInt(100_000),
+		ChannelId: f.path.EndpointA.ChannelID,
+		Receiver:  f.chainB.SenderAccount.GetAddress().String(),
+	})
+	require.ErrorContains(t, err, types.ErrBridgePaused.Error())
+
+	f.sendFromB(sdk.NewInt64Coin("uusdc", 100_000))
+	require.Equal(t, math.NewInt(600_000), f.balanceA(userA, "utestusd"))
+}
+
+func TestGuardianPause(t *testing.T) {
+	f := initBridgeFixture(t)
+	f.setLimits(types.DefaultBridgeLimits())
+	k := f.appA().TestusdKeeper
+	ms := keeper.NewMsgServerImpl(k)
+	guardian := f.chainA.SenderAccount.GetAddress().String()
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// Only the guardian and the authority can pause
+	_, err := ms.PauseBridge(f.chainA.GetContext(), &types.MsgPauseBridge{Signer: f.chainB.SenderAccount.GetAddress().String()})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = f.chainA.SendMsgs(&types.MsgPauseBridge{Signer: guardian, Reason: "suspicious mints"})
+	require.NoError(t, err)
+	require.True(t, k.IsPaused(f.chainA.GetContext()))
+
+	// The guardian cannot unpause, the authority can
+	_, err = ms.UnpauseBridge(f.chainA.GetContext(), &types.MsgUnpauseBridge{Authority: guardian})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	require.True(t, k.IsPaused(f.chainA.GetContext()))
+
+	_, err = ms.UnpauseBridge(f.chainA.GetContext(), &types.MsgUnpauseBridge{Authority: authority})
+	require.NoError(t, err)
+	require.False(t, k.IsPaused(f.chainA.GetContext()))
+}
@@ -0,0 +1,182 @@
+package testusd_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"mychain/x/testusd/keeper"
+	"mychain/x/testusd/types"
+)
+
+func (f *bridgeFixture) updateParams(update func(*types.Params)) {
+	f.t.Helper()
+
+	k := f.appA().TestusdKeeper
+	params := f.params()
+	update(&params)
+	require.NoError(f.t, params.Validate())
+	require.NoError(f.t, k.Params.Set(f.chainA.GetContext(), params))
+	f.coord.CommitBlock(f.chainA)
+}
+
+func (f *bridgeFixture) effectiveRates() *types.QueryEffectiveRatesResponse {
+	f.t.Helper()
+
+	res, err := f.appA().TestusdKeeper.EffectiveRates(f.chainA.GetContext(), &types.QueryEffectiveRatesRequest{})
+	require.NoError(f.t, err)
+	return res
+}
+
+func TestPegRatioValidation(t *testing.T) {
+	params := types.DefaultParams()
+	for _, pegRatio := range []string{"", "one", "0", "-1.0"} {
+		params.PegRatio = pegRatio
+		require.Error(t, params.Validate(), pegRatio)
+	}
+	params.PegRatio = "0.995"
+	require.NoError(t, params.Validate())
+
+	// Fees need a treasury to go to
+	params.Fees.MintFeeRate = math.LegacyMustNewDecFromStr("0.01")
+	require.Error(t, params.Validate())
+}
+
+func TestPriceOracleValidation(t *testing.T) {
+	oracle := types.PriceOracle{
+		Oracle:         sdk.AccAddress([]byte("testusd_oracle______")).String(),
+		DepegThreshold: math.LegacyMustNewDecFromStr("0.98"),
+	}
+	// A threshold needs prices to expire, or a stale price would hold the
+	// depeg forever
+	require.ErrorContains(t, oracle.Validate(), "max age")
+
+	oracle.MaxAgeSeconds = 3600
+	require.NoError(t, oracle.Validate())
+
+	// Without a threshold the oracle is off and needs nothing else
+	require.NoError(t, types.PriceOracle{DepegThreshold: math.LegacyZeroDec()}.Validate())
+}
+
+func TestBridgeFees(t *testing.T) {
+	f := initBridgeFixture(t)
+	treasury := sdk.AccAddress([]byte("testusd_treasury____"))
+	f.updateParams(func(params *types.Params) {
+		params.Fees = types.BridgeFees{
+			MintFeeRate:   math.LegacyMustNewDecFromStr("0.01"),
+			RedeemFeeRate: math.LegacyMustNewDecFromStr("0.02"),
+			Treasury:      treasury.String(),
+		}
+	})
+	k := f.appA().TestusdKeeper
+	userA := f.chainA.SenderAccount.GetAddress()
+
+	rates := f.effectiveRates()
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.99"), rates.MintRate)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.98"), rates.RedeemRate)
+	require.False(t, rates.Depegged)
+
+	f.sendFromB(sdk.NewInt64Coin("uusdc", 1_000_000))
+	require.Equal(t, math.NewInt(990_000), f.balanceA(userA, "utestusd"))
+	require.Equal(t, math.NewInt(10_000), f.balanceA(treasury, "utestusd"))
+	require.Equal(t, math.NewInt(1_000_000), f.totalSupply())
+
+	packet := f.bridgeOut(500_000, f.chainB.SenderAccount.GetAddress().String())
+	require.NoError(t, f.path.RelayPacket(packet))
+	require.Equal(t, math.NewInt(20_000), f.balanceA(treasury, "utestusd"))
+	require.Equal(t, math.NewInt(510_000), f.totalSupply())
+	require.Equal(t, math.NewInt(510_000), f.balanceA(f.appA().AuthKeeper.GetModuleAddress(types.ModuleName), f.voucherDenom()))
+
+	_, broken := keeper.ReservesInvariant(*k)(f.chainA.GetContext())
+	require.False(t, broken)
+}
+
+func TestDepegRedemption(t *testing.T) {
+	f := initBridgeFixture(t)
+	oracle := f.chainA.SenderAccount.GetAddress()
+	f.updateParams(func(params *types.Params) {
+		params.PriceOracle = types.PriceOracle{
+			Oracle:         oracle.String(),
+			DepegThreshold: math.LegacyMustNewDecFromStr("0.98"),
+			MaxAgeSeconds:  3600,
+		}
+	})
+	k := f.appA().TestusdKeeper
+
+	f.sendFromB(sdk.NewInt64Coin("uusdc", 1_000_000))
+
+	_, err := f.chainA.SendMsgs(&types.MsgUpdateCollateralPrice{Oracle: oracle.String(), Price: math.LegacyMustNewDecFromStr("0.9")})
+	require.NoError(t, err)
+
+	// USDC is credited at its price, and redemptions are still fully backed
+	rates := f.effectiveRates()
+	require.True(t, rates.Depegged)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.9"), rates.MintRate)
+	require.Equal(t, math.LegacyOneDec(), rates.RedeemRate)
+
+	// With a shortfall each TestUSD redeems for its share of the collateral
+	ctx := f.chainA.GetContext()
+	unbacked := sdk.NewCoins(sdk.NewInt64Coin("utestusd", 250_000))
+	require.NoError(t, f.appA().BankKeeper.MintCoins(ctx, types.ModuleName, unbacked))
+	require.NoError(t, f.appA().BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, oracle, unbacked))
+	f.coord.CommitBlock(f.chainA)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.8"), f.effectiveRates().RedeemRate)
+
+	f.bridgeOut(500_000, f.chainB.SenderAccount.GetAddress().String())
+	records := f.history()
+	require.Equal(t, sdk.NewInt64Coin(f.voucherDenom(), 400_000), records[len(records)-1].Usdc)
+
+	// A stale price no longer counts as a depeg
+	f.coord.IncrementTimeBy(2 * time.Hour)
+	f.coord.CommitBlock(f.chainA)
+	rates = f.effectiveRates()
+	require.False(t, rates.Depegged)
+	require.Equal(t, math.LegacyOneDec(), rates.RedeemRate)
+
+	// Only the oracle posts prices
+	_, err = keeper.NewMsgServerImpl(k).UpdateCollateralPrice(f.chainA.GetContext(), &types.MsgUpdateCollateralPrice{
+		Oracle: f.chainB.SenderAccount.GetAddress().String(),
+		Price:  math.LegacyOneDec(),
+	})
+	require.Error(t, err)
+}
+
+func TestBridgeFeeRefundedOnFailure(t *testing.T) {
+	f := initBridgeFixture(t)
+	treasury := sdk.AccAddress([]byte("testusd_treasury____"))
+	f.updateParams(func(params *types.Params) {
+		params.Fees = types.BridgeFees{
+			MintFeeRate:   math.LegacyZeroDec(),
+			RedeemFeeRate: math.LegacyMustNewDecFromStr("0.02"),
+			Treasury:      treasury.String(),
+		}
+	})
+	k := f.appA().TestusdKeeper
+	userA := f.chainA.SenderAccount.GetAddress()
+	moduleAddr := f.appA().AuthKeeper.GetModuleAddress(types.ModuleName)
+
+	f.sendFromB(sdk.NewInt64Coin("uusdc", 1_000_000))
+
+	// The module holds the fee while the transfer is in flight
+	packet := f.bridgeOut(500_000, "not-an-address")
+	require.Equal(t, math.NewInt(10_000), f.balanceA(moduleAddr, "utestusd"))
+	require.True(t, f.balanceA(treasury, "utestusd").IsZero())
+	_, broken := keeper.WithdrawalsInvariant(*k)(f.chainA.GetContext())
+	require.False(t, broken)
+
+	// A rejected transfer returns the fee with the burned TestUSD
+	require.NoError(t, f.path.RelayPacket(packet))
+	require.Equal(t, math.NewInt(1_000_000), f.balanceA(userA, "utestusd"))
+	require.True(t, f.balanceA(moduleAddr, "utestusd").IsZero())
+	require.True(t, f.balanceA(treasury, "utestusd").IsZero())
+	require.Equal(t, math.NewInt(1_000_000), f.totalSupply())
+	records := f.history()
+	require.Equal(t, types.BridgeDirectionRefund, records[len(records)-1].Direction)
+	require.Equal(t, sdk.NewInt64Coin("utestusd", 500_000), records[len(records)-1].Testusd)
+
+	_, broken = keeper.ReservesInvariant(*k)(f.chainA.GetContext())
+	require.False(t, broken)
+}
@@ -0,0 +1,112 @@
+package testusd_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"mychain/x/testusd/keeper"
+	"mychain/x/testusd/types"
+)
+
+func TestReservesInvariantAndHalt(t *testing.T) {
+	f := initBridgeFixture(t)
+	k := f.appA().TestusdKeeper
+	userA := f.chainA.SenderAccount.GetAddress()
+
+	f.sendFromB(sdk.NewInt64Coin("uusdc", 1_000_000))
+
+	res, err := k.Reserves(f.chainA.GetContext(), &types.QueryReservesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1_000_000), res.TrackedSupply)
+	require.Equal(t, math.NewInt(1_000_000), res.BankSupply)
+	require.Equal(t, math.NewInt(1_000_000), res.EscrowedCollateral)
+	require.Equal(t, math.LegacyOneDec(), *res.CollateralRatio)
+	require.False(t, res.BridgeInHalted)
+
+	_, broken := keeper.ReservesInvariant(*k)(f.chainA.GetContext())
+	require.False(t, broken)
+
+	// TestUSD minted outside the bridge is not backed
+	ctx := f.chainA.GetContext()
+	unbacked := sdk.NewCoins(sdk.NewInt64Coin("utestusd", 250_000))
+	require.NoError(t, f.appA().BankKeeper.MintCoins(ctx, types.ModuleName, unbacked))
+	require.NoError(t, f.appA().BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, userA, unbacked))
+	f.coord.CommitBlock(f.chainA)
+
+	msg, broken := keeper.ReservesInvariant(*k)(f.chainA.GetContext())
+	require.True(t, broken, msg)
+
+	res, err = k.Reserves(f.chainA.GetContext(), &types.QueryReservesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.8"), *res.CollateralRatio)
+	require.True(t, res.BridgeInHalted)
+
+	// USDC arriving while halted is received as plain vouchers
+	f.sendFromB(sdk.NewInt64Coin("uusdc", 100_000))
+	require.Equal(t, math.NewInt(1_250_000), f.balanceA(userA, "utestusd"))
+	require.Equal(t, math.NewInt(100_000), f.balanceA(userA, f.voucherDenom()))
+
+	// Bridging out still works
+	f.bridgeOut(500_000, f.chainB.SenderAccount.GetAddress().String())
+	require.Equal(t, math.NewInt(750_000), f.balanceA(userA, "utestusd"))
+}
+
+func TestAttestReserves(t *testing.T) {
+	f := initBridgeFixture(t)
+	k := f.appA().TestusdKeeper
+	ms := keeper.NewMsgServerImpl(k)
+	attestor := f.chainA.SenderAccount.GetAddress().String()
+
+	params := f.params()
+	params.Attestor = attestor
+	require.NoError(t, k.Params.Set(f.chainA.GetContext(), params))
+	f.coord.CommitBlock(f.chainA)
+
+	attest := func(amount int64, timestamp int64) *types.MsgAttestReserves {
+		signBytes := types.ReserveAttestationSignBytes(f.chainA.ChainID, math.NewInt(amount), timestamp)
+		signature, err := f.chainA.SenderPrivKey.Sign(signBytes)
+		require.NoError(t, err)
+		return &types.MsgAttestReserves{Attestor: attestor, Amount: math.NewInt(amount), Timestamp: timestamp, Signature: signature}
+	}
+
+	first := f.chainA.GetContext().BlockTime().Unix() - 60
+	_, err := f.chainA.SendMsgs(attest(5_000_000, first))
+	require.NoError(t, err)
+	_, err = f.chainA.SendMsgs(attest(5_100_000, first+30))
+	require.NoError(t, err)
+
+	// Attestations must move forward in time
+	_, err = ms.AttestReserves(f.chainA.GetContext(), attest(5_200_000, first+30))
+	require.ErrorIs(t, err, types.ErrInvalidAttestation)
+
+	// The signature must cover the attested amount
+	tampered := attest(5_200_000, first+45)
+	tampered.Amount = math.NewInt(9_000_000)
+	_, err = ms.AttestReserves(f.chainA.GetContext(), tampered)
+	require.ErrorIs(t, err, types.ErrInvalidAttestation)
+
+	// Only the attestor can post
+	other := attest(5_200_000, first+45)
+	other.Attestor = f.chainB.SenderAccount.GetAddress().String()
+	_, err = ms.AttestReserves(f.chainA.GetContext(), other)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	history, err := k.ReserveAttestations(f.chainA.GetContext(), &types.QueryReserveAttestationsRequest{})
+	require.NoError(t, err)
+	require.Len(t, history.Attestations, 2)
+	require.Equal(t, math.NewInt(5_000_000), history.Attestations[0].Amount)
+
+	res, err := k.Reserves(f.chainA.GetContext(), &types.QueryReservesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.LatestAttestation.Id)
+	require.Equal(t, math.NewInt(5_100_000), res.LatestAttestation.Amount)
+
+	genesis, err := k.ExportGenesis(f.chainA.GetContext())
+	require.NoError(t, err)
+	require.Len(t, genesis.Attestations, 2)
+	require.NoError(t, genesis.Validate())
+}
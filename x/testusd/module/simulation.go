@@ -1,22 +1,21 @@
 package testusd
 
 import (
+	"math/rand"
+
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 
+	testusdsimulation "mychain/x/testusd/simulation"
 	"mychain/x/testusd/types"
 )
 
 // GenerateGenesisState creates a randomized GenState of the module.
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
-	accs := make([]string, len(simState.Accounts))
-	for i, acc := range simState.Accounts {
-		accs[i] = acc.Address.String()
-	}
-	testusdGenesis := types.GenesisState{
-		Params: types.DefaultParams(),
-	}
-	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&testusdGenesis)
+	testusdGenesis := types.DefaultGenesis()
+	testusdGenesis.Params = testusdsimulation.RandomParams(simState.Rand, simState.Accounts)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(testusdGenesis)
 }
 
 // RegisterStoreDecoder registers a decoder.
@@ -25,10 +24,128 @@ func (am AppModule) RegisterStoreDecoder(_ simtypes.StoreDecoderRegistry) {}
 // WeightedOperations returns the all the gov module operations with their respective weights.
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
 	operations := make([]simtypes.WeightedOperation, 0)
+	const (
+		opWeightMsgBridgeIn          = "op_weight_msg_bridge_in"
+		defaultWeightMsgBridgeIn int = 100
+	)
+
+	var weightMsgBridgeIn int
+	simState.AppParams.GetOrGenerate(opWeightMsgBridgeIn, &weightMsgBridgeIn, nil,
+		func(_ *rand.Rand) {
+			weightMsgBridgeIn = defaultWeightMsgBridgeIn
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgBridgeIn,
+		testusdsimulation.SimulateMsgBridgeIn(am.accountKeeper, am.bankKeeper, *am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgBridgeOut          = "op_weight_msg_bridge_out"
+		defaultWeightMsgBridgeOut int = 60
+	)
+
+	var weightMsgBridgeOut int
+	simState.AppParams.GetOrGenerate(opWeightMsgBridgeOut, &weightMsgBridgeOut, nil,
+		func(_ *rand.Rand) {
+			weightMsgBridgeOut = defaultWeightMsgBridgeOut
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgBridgeOut,
+		testusdsimulation.SimulateMsgBridgeOut(am.accountKeeper, am.bankKeeper, *am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgCancelWithdrawal          = "op_weight_msg_cancel_withdrawal"
+		defaultWeightMsgCancelWithdrawal int = 10
+	)
+
+	var weightMsgCancelWithdrawal int
+	simState.AppParams.GetOrGenerate(opWeightMsgCancelWithdrawal, &weightMsgCancelWithdrawal, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelWithdrawal = defaultWeightMsgCancelWithdrawal
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCancelWithdrawal,
+		testusdsimulation.SimulateMsgCancelWithdrawal(am.accountKeeper, am.bankKeeper, *am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgAttestReserves          = "op_weight_msg_attest_reserves"
+		defaultWeightMsgAttestReserves int = 10
+	)
+
+	var weightMsgAttestReserves int
+	simState.AppParams.GetOrGenerate(opWeightMsgAttestReserves, &weightMsgAttestReserves, nil,
+		func(_ *rand.Rand) {
+			weightMsgAttestReserves = defaultWeightMsgAttestReserves
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAttestReserves,
+		testusdsimulation.SimulateMsgAttestReserves(am.accountKeeper, am.bankKeeper, *am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgUpdateCollateralPrice          = "op_weight_msg_update_collateral_price"
+		defaultWeightMsgUpdateCollateralPrice int = 20
+	)
+
+	var weightMsgUpdateCollateralPrice int
+	simState.AppParams.GetOrGenerate(opWeightMsgUpdateCollateralPrice, &weightMsgUpdateCollateralPrice, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateCollateralPrice = defaultWeightMsgUpdateCollateralPrice
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUpdateCollateralPrice,
+		testusdsimulation.SimulateMsgUpdateCollateralPrice(am.accountKeeper, am.bankKeeper, *am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgPauseBridge          = "op_weight_msg_pause_bridge"
+		defaultWeightMsgPauseBridge int = 2
+	)
+
+	var weightMsgPauseBridge int
+	simState.AppParams.GetOrGenerate(opWeightMsgPauseBridge, &weightMsgPauseBridge, nil,
+		func(_ *rand.Rand) {
+			weightMsgPauseBridge = defaultWeightMsgPauseBridge
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgPauseBridge,
+		testusdsimulation.SimulateMsgPauseBridge(am.accountKeeper, am.bankKeeper, *am.keeper, simState.TxConfig),
+	))
+
 	return operations
 }
 
 // ProposalMsgs returns msgs used for governance proposals for simulations.
 func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.WeightedProposalMsg {
-	return []simtypes.WeightedProposalMsg{}
+	const (
+		opWeightMsgUpdateParams          = "op_weight_msg_testusd_update_params"
+		defaultWeightMsgUpdateParams int = 10
+
+		opWeightMsgUnpauseBridge          = "op_weight_msg_unpause_bridge"
+		defaultWeightMsgUnpauseBridge int = 20
+
+		opWeightMsgApproveWithdrawal          = "op_weight_msg_approve_withdrawal"
+		defaultWeightMsgApproveWithdrawal int = 20
+	)
+
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgUpdateParams,
+			defaultWeightMsgUpdateParams,
+			testusdsimulation.SimulateMsgUpdateParams(*am.keeper),
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgUnpauseBridge,
+			defaultWeightMsgUnpauseBridge,
+			testusdsimulation.SimulateMsgUnpauseBridge(*am.keeper),
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgApproveWithdrawal,
+			defaultWeightMsgApproveWithdrawal,
+			testusdsimulation.SimulateMsgApproveWithdrawal(*am.keeper),
+		),
+	}
 }
@@ -0,0 +1,212 @@
+package testusd_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"mychain/x/testusd/keeper"
+	"mychain/x/testusd/types"
+)
+
+func (f *bridgeFixture) setWithdrawalQueue(queue types.WithdrawalQueue) {
+	f.t.Helper()
+
+	k := f.appA().TestusdKeeper
+	params := f.params()
+	params.WithdrawalQueue = queue
+	require.NoError(f.t, params.Validate())
+	require.NoError(f.t, k.Params.Set(f.chainA.GetContext(), params))
+	f.coord.CommitBlock(f.chainA)
+}
+
+// requestBridgeOut sends a bridge out of amount over channelID and returns
+// the response.
+func (f *bridgeFixture) requestBridgeOut(amount int64, channelID string) types.MsgBridgeOutResponse {
+	f.t.Helper()
+
+	msg := &types.MsgBridgeOut{
+		Sender:    f.chainA.SenderAccount.GetAddress().String(),
+		Amount:    math.NewInt(amount),
+		ChannelId: channelID,
+	}
+	if channelID != "" {
+		msg.Receiver = f.chainB.SenderAccount.GetAddress().String()
+	}
+	res, err := f.chainA.SendMsgs(msg)
+	require.NoError(f.t, err)
+
+	var txMsgData sdk.TxMsgData
+	require.NoError(f.t, f.appA().AppCodec().Unmarshal(res.Data, &txMsgData))
+	var resp types.MsgBridgeOutResponse
+	require.NoError(f.t, f.appA().AppCodec().Unmarshal(txMsgData.MsgResponses[0].Value, &resp))
+	return resp
+}
+
+func (f *bridgeFixture) history() []types.BridgeRecord {
+	f.t.Helper()
+
+	res, err := f.appA().TestusdKeeper.BridgeHistory(f.chainA.GetContext(), &types.QueryBridgeHistoryRequest{
+		Address: f.chainA.SenderAccount.GetAddress().String(),
+	})
+	require.NoError(f.t, err)
+	return res.Records
+}
+
+func TestBridgeHistory(t *testing.T) {
+	f := initBridgeFixture(t)
+	k := f.appA().TestusdKeeper
+	userA := f.chainA.SenderAccount.GetAddress().String()
+
+	f.sendFromB(sdk.NewInt64Coin("uusdc", 1_000_000))
+	packet := f.bridgeOut(300_000, f.chainB.SenderAccount.GetAddress().String())
+
+	records := f.history()
+	require.Len(t, records, 2)
+	require.Equal(t, types.BridgeDirectionIn, records[0].Direction)
+	require.Equal(t, types.BridgeStatusCompleted, records[0].Status)
+	require.Equal(t, f.path.EndpointA.ChannelID, records[0].ChannelId)
+	require.Equal(t, types.BridgeDirectionOut, records[1].Direction)
+	require.Equal(t, types.BridgeStatusSent, records[1].Status)
+	require.Equal(t, sdk.NewInt64Coin(f.voucherDenom(), 300_000), records[1].Usdc)
+
+	// The acknowledgement completes the bridge out
+	require.NoError(t, f.path.RelayPacket(packet))
+	require.Equal(t, types.BridgeStatusCompleted, f.history()[1].Status)
+
+	page, err := k.BridgeHistory(f.chainA.GetContext(), &types.QueryBridgeHistoryRequest{
+		Address:    userA,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, page.Records, 1)
+	require.Equal(t, uint64(2), page.Pagination.Total)
+	require.NotEmpty(t, page.Pagination.NextKey)
+
+	// Other addresses have their own history
+	other, err := k.BridgeHistory(f.chainA.GetContext(), &types.QueryBridgeHistoryRequest{Address: f.chainB.SenderAccount.GetAddress().String()})
+	require.NoError(t, err)
+	require.Empty(t, other.Records)
+}
+
+func TestWithdrawalQueueCancel(t *testing.T) {
+	f := initBridgeFixture(t)
+	f.setWithdrawalQueue(types.WithdrawalQueue{DelaySeconds: 3600, Threshold: math.NewInt(500_000), ReleaseBudget: 10})
+	k := f.appA().TestusdKeeper
+	userA := f.chainA.SenderAccount.GetAddress()
+	channelID := f.path.EndpointA.ChannelID
+
+	f.sendFromB(sdk.NewInt64Coin("uusdc", 1_000_000))
+
+	// Below the threshold the bridge out is released at once
+	resp := f.requestBridgeOut(100_000, channelID)
+	require.False(t, resp.Queued)
+	require.Equal(t, math.NewInt(900_000), f.balanceA(userA, "utestusd"))
+
+	// Above it the TestUSD waits in the queue
+	resp = f.requestBridgeOut(600_000, channelID)
+	require.True(t, resp.Queued)
+	require.Equal(t, math.NewInt(300_000), f.balanceA(userA, "utestusd"))
+
+	pending, err := k.PendingWithdrawals(f.chainA.GetContext(), &types.QueryPendingWithdrawalsRequest{Address: userA.String()})
+	require.NoError(t, err)
+	require.Len(t, pending.Withdrawals, 1)
+	require.Equal(t, resp.WithdrawalId, pending.Withdrawals[0].Id)
+
+	// Only the sender can cancel
+	ms := keeper.NewMsgServerImpl(k)
+	_, err = ms.CancelWithdrawal(f.chainA.GetContext(), &types.MsgCancelWithdrawal{Sender: f.chainB.SenderAccount.GetAddress().String(), Id: resp.WithdrawalId})
+	require.Error(t, err)
+
+	_, err = f.chainA.SendMsgs(&types.MsgCancelWithdrawal{Sender: userA.String(), Id: resp.WithdrawalId})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(900_000), f.balanceA(userA, "utestusd"))
+	require.Equal(t, types.BridgeStatusCancelled, f.history()[2].Status)
+
+	_, err = ms.CancelWithdrawal(f.chainA.GetContext(), &types.MsgCancelWithdrawal{Sender: userA.String(), Id: resp.WithdrawalId})
+	require.ErrorIs(t, err, types.ErrWithdrawalNotFound)
+}
+
+func TestWithdrawalQueueRelease(t *testing.T) {
+	f := initBridgeFixture(t)
+	f.setWithdrawalQueue(types.WithdrawalQueue{Enabled: true, DelaySeconds: 3600, Threshold: math.ZeroInt(), ReleaseBudget: 10})
+	k := f.appA().TestusdKeeper
+	userA := f.chainA.SenderAccount.GetAddress()
+	channelID := f.path.EndpointA.ChannelID
+
+	f.sendFromB(sdk.NewInt64Coin("uusdc", 1_000_000))
+
+	// In queue mode every bridge out waits for the delay
+	resp := f.requestBridgeOut(100_000, channelID)
+	require.True(t, resp.Queued)
+	f.coord.CommitBlock(f.chainA)
+	require.Equal(t, math.NewInt(1_000_000), f.totalSupply())
+
+	f.coord.IncrementTimeBy(2 * time.Hour)
+	f.coord.CommitBlock(f.chainA)
+	require.Equal(t, math.NewInt(900_000), f.totalSupply())
+	require.Equal(t, types.BridgeStatusSent, f.history()[1].Status)
+
+	// A withdrawal that cannot be released goes back to the sender
+	resp = f.requestBridgeOut(200_000, "")
+	require.True(t, resp.Queued)
+	f.coord.IncrementTimeBy(2 * time.Hour)
+	f.coord.CommitBlock(f.chainA)
+	require.Equal(t, math.NewInt(900_000), f.balanceA(userA, "utestusd"))
+	require.Equal(t, types.BridgeStatusFailed, f.history()[2].Status)
+
+	// The authority can release before the delay ends
+	resp = f.requestBridgeOut(300_000, channelID)
+	ms := keeper.NewMsgServerImpl(k)
+	_, err := ms.ApproveWithdrawal(f.chainA.GetContext(), &types.MsgApproveWithdrawal{Authority: userA.String(), Id: resp.WithdrawalId})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	approved, err := ms.ApproveWithdrawal(f.chainA.GetContext(), &types.MsgApproveWithdrawal{Authority: authority, Id: resp.WithdrawalId})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(f.voucherDenom(), 300_000), approved.ReleasedAmount)
+	require.Equal(t, math.NewInt(600_000), f.totalSupply())
+
+	pending, err := k.PendingWithdrawals(f.chainA.GetContext(), &types.QueryPendingWithdrawalsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, pending.Withdrawals)
+
+	genesis, err := k.ExportGenesis(f.chainA.GetContext())
+	require.NoError(t, err)
+	require.Len(t, genesis.BridgeRecords, 4)
+	require.NoError(t, genesis.Validate())
+}
+
+func TestWithdrawalQueueReleaseBudget(t *testing.T) {
+	f := initBridgeFixture(t)
+	f.setWithdrawalQueue(types.WithdrawalQueue{Enabled: true, DelaySeconds: 3600, Threshold: math.ZeroInt(), ReleaseBudget: 2})
+	k := f.appA().TestusdKeeper
+	channelID := f.path.EndpointA.ChannelID
+
+	f.sendFromB(sdk.NewInt64Coin("uusdc", 1_000_000))
+	var ids []uint64
+	for i := 0; i < 3; i++ {
+		ids = append(ids, f.requestBridgeOut(100_000, channelID).WithdrawalId)
+	}
+
+	// Only the budget is released per block, oldest first
+	f.coord.IncrementTimeBy(2 * time.Hour)
+	f.coord.CommitBlock(f.chainA)
+	require.Equal(t, math.NewInt(800_000), f.totalSupply())
+	pending, err := k.PendingWithdrawals(f.chainA.GetContext(), &types.QueryPendingWithdrawalsRequest{})
+	require.NoError(t, err)
+	require.Len(t, pending.Withdrawals, 1)
+	require.Equal(t, ids[2], pending.Withdrawals[0].Id)
+
+	f.coord.CommitBlock(f.chainA)
+	require.Equal(t, math.NewInt(700_000), f.totalSupply())
+	pending, err = k.PendingWithdrawals(f.chainA.GetContext(), &types.QueryPendingWithdrawalsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, pending.Withdrawals)
+}
@@ -0,0 +1,66 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"mychain/x/testusd/keeper"
+	"mychain/x/testusd/types"
+)
+
+func SimulateMsgAttestReserves(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgAttestReserves{
+			Timestamp: ctx.BlockTime().Unix(),
+		}
+
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to read params"), nil, err
+		}
+		simAccount, found := findAccount(accs, params.Attestor)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "attestor is not a simulation account"), nil, nil
+		}
+		latest, err := k.LatestAttestation(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to read the latest attestation"), nil, err
+		}
+		if latest != nil && latest.Timestamp >= msg.Timestamp {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "reserves already attested this block"), nil, nil
+		}
+
+		// The attestor reports the escrow it sees, signed with its account key
+		msg.Attestor = params.Attestor
+		msg.Amount = k.EscrowedCollateral(ctx, params)
+		msg.Signature, err = simAccount.PrivKey.Sign(types.ReserveAttestationSignBytes(chainID, msg.Amount, msg.Timestamp))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to sign the attestation"), nil, err
+		}
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         txGen,
+			Cdc:           nil,
+			Msg:           msg,
+			Context:       ctx,
+			SimAccount:    simAccount,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    types.ModuleName,
+		}
+		return deliverTx(txCtx)
+	}
+}
@@ -0,0 +1,70 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"mychain/x/testusd/keeper"
+	"mychain/x/testusd/types"
+)
+
+func SimulateMsgBridgeIn(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgBridgeIn{
+			Sender: simAccount.Address.String(),
+		}
+
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to read params"), nil, err
+		}
+		if !params.BridgeEnabled || k.IsPaused(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "bridge is closed"), nil, nil
+		}
+
+		amount := randomAmount(r, 1, 10_000)
+		if balance := bk.SpendableCoins(ctx, simAccount.Address).AmountOf(params.UsdcDenom); balance.LT(amount) {
+			amount = balance
+		}
+
+		// Stay within the caps most of the time; the quota is in TestUSD,
+		// which only differs from USDC during a depeg
+		quota, err := k.GetBridgeQuota(ctx, simAccount.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to read bridge quota"), nil, err
+		}
+		amount = withinQuota(amount, params.Limits.MaxPerTx, quota.RemainingWindowMint, quota.RemainingAddressMint)
+		if !amount.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no USDC to bridge in within the limits"), nil, nil
+		}
+		msg.Amount = amount
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(sdk.NewCoin(params.UsdcDenom, amount)),
+		}
+		// Quotas are checked in TestUSD, and minting halts while undercollateralized
+		return deliverTx(txCtx, types.ErrBridgeLimitExceeded, types.ErrUndercollateralized)
+	}
+}
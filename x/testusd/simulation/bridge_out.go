@@ -0,0 +1,71 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"mychain/x/testusd/keeper"
+	"mychain/x/testusd/types"
+)
+
+func SimulateMsgBridgeOut(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// Without a channel the USDC is released on this chain
+		msg := &types.MsgBridgeOut{}
+
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to read params"), nil, err
+		}
+		if !params.BridgeEnabled || k.IsPaused(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "bridge is closed"), nil, nil
+		}
+		simAccount, found := randomHolder(r, ctx, bk, accs, params.TestusdDenom)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no account holds TestUSD"), nil, nil
+		}
+		msg.Sender = simAccount.Address.String()
+
+		balance := bk.SpendableCoins(ctx, simAccount.Address).AmountOf(params.TestusdDenom)
+		amount, err := simtypes.RandPositiveInt(r, balance)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to pick an amount"), nil, err
+		}
+
+		quota, err := k.GetBridgeQuota(ctx, simAccount.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to read bridge quota"), nil, err
+		}
+		amount = withinQuota(amount, params.Limits.MaxPerTx, quota.RemainingWindowBurn, quota.RemainingAddressBurn)
+		if !amount.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no TestUSD to bridge out within the limits"), nil, nil
+		}
+		msg.Amount = amount
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(sdk.NewCoin(params.TestusdDenom, amount)),
+		}
+		return deliverTx(txCtx, types.ErrBridgeLimitExceeded)
+	}
+}
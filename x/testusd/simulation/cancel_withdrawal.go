@@ -0,0 +1,55 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"mychain/x/testusd/keeper"
+	"mychain/x/testusd/types"
+)
+
+func SimulateMsgCancelWithdrawal(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgCancelWithdrawal{}
+
+		withdrawals, err := pendingWithdrawals(ctx, k)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to read withdrawals"), nil, err
+		}
+		if len(withdrawals) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no queued withdrawals"), nil, nil
+		}
+		withdrawal := withdrawals[r.Intn(len(withdrawals))]
+		simAccount, found := findAccount(accs, withdrawal.Sender)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "withdrawal sender is not a simulation account"), nil, nil
+		}
+		msg.Sender = withdrawal.Sender
+		msg.Id = withdrawal.Id
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         txGen,
+			Cdc:           nil,
+			Msg:           msg,
+			Context:       ctx,
+			SimAccount:    simAccount,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    types.ModuleName,
+		}
+		return deliverTx(txCtx)
+	}
+}
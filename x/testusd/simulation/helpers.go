@@ -0,0 +1,132 @@
+package simulation
+
+import (
+	"errors"
+	"math/rand"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"mychain/x/testusd/keeper"
+	"mychain/x/testusd/types"
+)
+
+// deliverTx delivers the message of txCtx with random fees. A rejection with
+// one of the expected errors is reported as a no-op rather than a failure.
+func deliverTx(txCtx simulation.OperationInput, expected ...error) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	opMsg, futureOps, err := simulation.GenAndDeliverTxWithRandFees(txCtx)
+	for _, e := range expected {
+		if errors.Is(err, e) {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(txCtx.Msg), err.Error()), nil, nil
+		}
+	}
+	return opMsg, futureOps, err
+}
+
+// findAccount returns the simulation account of address, if there is one.
+func findAccount(accs []simtypes.Account, address string) (simtypes.Account, bool) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return simtypes.Account{}, false
+	}
+	return simtypes.FindAccount(accs, addr)
+}
+
+// RandomParams returns valid params whose guardian, attestor, price oracle
+// and treasury are among accs. Fees and depeg handling are randomized, and
+// each limit and the withdrawal queue are enabled now and then.
+func RandomParams(r *rand.Rand, accs []simtypes.Account) types.Params {
+	params := types.DefaultParams()
+	if len(accs) == 0 {
+		return params
+	}
+	randomAddress := func() string {
+		acc, _ := simtypes.RandomAcc(r, accs)
+		return acc.Address.String()
+	}
+
+	params.Guardian = randomAddress()
+	params.Attestor = randomAddress()
+
+	params.Fees.MintFeeRate = math.LegacyNewDecWithPrec(int64(r.Intn(6)), 3)
+	params.Fees.RedeemFeeRate = math.LegacyNewDecWithPrec(int64(r.Intn(6)), 3)
+	params.Fees.Treasury = randomAddress()
+
+	params.PriceOracle.Oracle = randomAddress()
+	params.PriceOracle.MaxAgeSeconds = 3 * 60 * 60
+	if r.Intn(2) == 0 {
+		params.PriceOracle.DepegThreshold = math.LegacyNewDecWithPrec(98, 2)
+	}
+
+	limits := &params.Limits
+	if r.Intn(4) == 0 {
+		limits.MaxPerTx = randomAmount(r, 1_000, 10_000)
+	}
+	if r.Intn(4) == 0 {
+		limits.MaxPerAddressDaily = randomAmount(r, 10_000, 50_000)
+	}
+	if r.Intn(4) == 0 {
+		limits.MaxMintPerWindow = randomAmount(r, 100_000, 500_000)
+		limits.MaxBurnPerWindow = randomAmount(r, 100_000, 500_000)
+	}
+	if r.Intn(10) == 0 {
+		limits.MaxNetFlowPerWindow = randomAmount(r, 50_000, 200_000)
+	}
+
+	if r.Intn(3) == 0 {
+		params.WithdrawalQueue.Threshold = randomAmount(r, 100, 1_000)
+		params.WithdrawalQueue.DelaySeconds = uint64(simtypes.RandIntBetween(r, 1, 7)) * 60 * 60
+		params.WithdrawalQueue.Enabled = r.Intn(3) == 0
+	}
+	return params
+}
+
+// randomAmount returns between min and max whole units in micro units.
+func randomAmount(r *rand.Rand, min, max int) math.Int {
+	return math.NewInt(int64(simtypes.RandIntBetween(r, min, max+1))).MulRaw(1_000_000)
+}
+
+// randomCollateralPrice returns a USDC price between 0.97 and 1.01.
+func randomCollateralPrice(r *rand.Rand) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 97, 102)), 2)
+}
+
+// withinQuota caps amount at the per-tx limit and the remaining quotas,
+// where a nil quota is uncapped.
+func withinQuota(amount, maxPerTx math.Int, quotas ...*math.Int) math.Int {
+	if types.IsCapped(maxPerTx) {
+		amount = math.MinInt(amount, maxPerTx)
+	}
+	for _, quota := range quotas {
+		if quota != nil {
+			amount = math.MinInt(amount, *quota)
+		}
+	}
+	return amount
+}
+
+// pendingWithdrawals returns the queued withdrawals in id order.
+func pendingWithdrawals(ctx sdk.Context, k keeper.Keeper) ([]types.PendingWithdrawal, error) {
+	iter, err := k.Withdrawals.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// randomHolder returns a random account among accs with a spendable balance
+// of denom.
+func randomHolder(r *rand.Rand, ctx sdk.Context, bk types.BankKeeper, accs []simtypes.Account, denom string) (simtypes.Account, bool) {
+	var holders []simtypes.Account
+	for _, acc := range accs {
+		if bk.SpendableCoins(ctx, acc.Address).AmountOf(denom).IsPositive() {
+			holders = append(holders, acc)
+		}
+	}
+	if len(holders) == 0 {
+		return simtypes.Account{}, false
+	}
+	return holders[r.Intn(len(holders))], true
+}
@@ -0,0 +1,47 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"mychain/x/testusd/keeper"
+	"mychain/x/testusd/types"
+)
+
+// SimulateMsgUpdateParams returns a governance message replacing the params
+// with random ones.
+func SimulateMsgUpdateParams(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, _ sdk.Context, accs []simtypes.Account) sdk.Msg {
+		return &types.MsgUpdateParams{
+			Authority: k.GetAuthority(),
+			Params:    RandomParams(r, accs),
+		}
+	}
+}
+
+// SimulateMsgUnpauseBridge returns a governance message reopening the
+// bridge after a guardian or circuit breaker pause.
+func SimulateMsgUnpauseBridge(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(_ *rand.Rand, _ sdk.Context, _ []simtypes.Account) sdk.Msg {
+		return &types.MsgUnpauseBridge{
+			Authority: k.GetAuthority(),
+		}
+	}
+}
+
+// SimulateMsgApproveWithdrawal returns a governance message releasing a
+// random queued withdrawal, or nil when the queue is empty.
+func SimulateMsgApproveWithdrawal(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		withdrawals, err := pendingWithdrawals(ctx, k)
+		if err != nil || len(withdrawals) == 0 {
+			return nil
+		}
+		return &types.MsgApproveWithdrawal{
+			Authority: k.GetAuthority(),
+			Id:        withdrawals[r.Intn(len(withdrawals))].Id,
+		}
+	}
+}
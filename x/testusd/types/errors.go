@@ -14,4 +14,10 @@ var (
     ErrInsufficientBridgeBalance  = errorsmod.Register(ModuleName, 1105, "insufficient bridge balance")
     ErrInvalidAmount              = errorsmod.Register(ModuleName, 1106, "invalid amount")
     ErrBridgeTransferFailed       = errorsmod.Register(ModuleName, 1107, "bridge transfer failed")
+    ErrUnknownUsdcSource          = errorsmod.Register(ModuleName, 1108, "unknown usdc source")
+    ErrBridgePaused               = errorsmod.Register(ModuleName, 1109, "bridge is paused")
+    ErrBridgeLimitExceeded        = errorsmod.Register(ModuleName, 1110, "bridge limit exceeded")
+    ErrUndercollateralized        = errorsmod.Register(ModuleName, 1111, "bridge is undercollateralized")
+    ErrInvalidAttestation         = errorsmod.Register(ModuleName, 1112, "invalid reserve attestation")
+    ErrWithdrawalNotFound         = errorsmod.Register(ModuleName, 1113, "withdrawal not found")
 )
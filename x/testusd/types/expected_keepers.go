@@ -4,6 +4,9 @@ import (
     "context"
     
     sdk "github.com/cosmos/cosmos-sdk/types"
+    transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+
+    mychaintypes "mychain/x/mychain/types"
 )
 
 // AccountKeeper defines the expected interface for the Account module.
@@ -35,5 +38,10 @@ type ParamSubspace interface {
 
 // TransactionKeeper defines the expected interface for transaction recording
 type TransactionKeeper interface {
-    RecordTransaction(ctx context.Context, address string, txType string, description string, amount sdk.Coins, from string, to string, metadata string) error
+    RecordTransaction(ctx context.Context, record mychaintypes.TransactionRecord) error
+}
+
+// TransferKeeper defines the expected interface for the ICS-20 transfer module.
+type TransferKeeper interface {
+    Transfer(ctx context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error)
 }
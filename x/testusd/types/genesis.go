@@ -11,6 +11,12 @@ func DefaultGenesis() *GenesisState {
         Params: DefaultParams(),
         TotalBridged: math.ZeroInt(),
         TotalSupply: math.ZeroInt(),
+        PendingBridgeOuts: []PendingBridgeOut{},
+        FlowBuckets: []FlowBucket{},
+        AddressFlows: []AddressFlow{},
+        Attestations: []ReserveAttestation{},
+        BridgeRecords: []BridgeRecord{},
+        PendingWithdrawals: []PendingWithdrawal{},
     }
 }
 
@@ -20,13 +26,92 @@ func (gs GenesisState) Validate() error {
         return err
     }
     
-    if gs.TotalBridged.IsNegative() {
+    if !gs.TotalBridged.IsNil() && gs.TotalBridged.IsNegative() {
         return fmt.Errorf("total bridged cannot be negative")
     }
     
-    if gs.TotalSupply.IsNegative() {
+    if !gs.TotalSupply.IsNil() && gs.TotalSupply.IsNegative() {
         return fmt.Errorf("total supply cannot be negative")
     }
+
+    seen := make(map[string]bool)
+    for _, pending := range gs.PendingBridgeOuts {
+        key := fmt.Sprintf("%s/%d", pending.ChannelId, pending.Sequence)
+        if seen[key] {
+            return fmt.Errorf("duplicate pending bridge out %s", key)
+        }
+        seen[key] = true
+
+        if err := pending.Testusd.Validate(); err != nil {
+            return fmt.Errorf("invalid pending bridge out %s: %w", key, err)
+        }
+        if err := pending.Usdc.Validate(); err != nil {
+            return fmt.Errorf("invalid pending bridge out %s: %w", key, err)
+        }
+        if pending.Fee.Denom != "" {
+            if err := pending.Fee.Validate(); err != nil {
+                return fmt.Errorf("invalid pending bridge out %s fee: %w", key, err)
+            }
+        }
+    }
+
+    buckets := make(map[int64]bool)
+    for _, bucket := range gs.FlowBuckets {
+        if buckets[bucket.Start] {
+            return fmt.Errorf("duplicate flow bucket %d", bucket.Start)
+        }
+        buckets[bucket.Start] = true
+    }
+
+    flows := make(map[string]bool)
+    for _, flow := range gs.AddressFlows {
+        key := fmt.Sprintf("%d/%s", flow.Day, flow.Address)
+        if flows[key] {
+            return fmt.Errorf("duplicate address flow %s", key)
+        }
+        flows[key] = true
+    }
+
+    for i, attestation := range gs.Attestations {
+        if attestation.Id != uint64(i) {
+            return fmt.Errorf("reserve attestation %d out of order, expected id %d", attestation.Id, i)
+        }
+        if attestation.Amount.IsNil() || attestation.Amount.IsNegative() {
+            return fmt.Errorf("reserve attestation %d has an invalid amount", attestation.Id)
+        }
+    }
+    
+    if gs.CollateralPrice != nil && (gs.CollateralPrice.Price.IsNil() || !gs.CollateralPrice.Price.IsPositive()) {
+        return fmt.Errorf("collateral price must be positive")
+    }
+
+    records := make(map[uint64]bool)
+    for _, record := range gs.BridgeRecords {
+        if records[record.Sequence] {
+            return fmt.Errorf("duplicate bridge record %d", record.Sequence)
+        }
+        records[record.Sequence] = true
+        if record.Address == "" {
+            return fmt.Errorf("bridge record %d has no address", record.Sequence)
+        }
+        if gs.NextBridgeRecordSequence != 0 && record.Sequence >= gs.NextBridgeRecordSequence {
+            return fmt.Errorf("bridge record %d is not below the next sequence %d", record.Sequence, gs.NextBridgeRecordSequence)
+        }
+    }
+
+    withdrawals := make(map[uint64]bool)
+    for _, withdrawal := range gs.PendingWithdrawals {
+        if withdrawals[withdrawal.Id] {
+            return fmt.Errorf("duplicate pending withdrawal %d", withdrawal.Id)
+        }
+        withdrawals[withdrawal.Id] = true
+        if err := withdrawal.Testusd.Validate(); err != nil {
+            return fmt.Errorf("invalid pending withdrawal %d: %w", withdrawal.Id, err)
+        }
+        if gs.NextWithdrawalId != 0 && withdrawal.Id >= gs.NextWithdrawalId {
+            return fmt.Errorf("pending withdrawal %d is not below the next id %d", withdrawal.Id, gs.NextWithdrawalId)
+        }
+    }
     
     return nil
 }
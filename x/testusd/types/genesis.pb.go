@@ -6,6 +6,7 @@ package types
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -33,6 +34,30 @@ type GenesisState struct {
 	TotalBridged cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total_bridged,json=totalBridged,proto3,customtype=cosmossdk.io/math.Int" json:"total_bridged"`
 	// total_supply tracks the total supply of TestUSD minted
 	TotalSupply cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=total_supply,json=totalSupply,proto3,customtype=cosmossdk.io/math.Int" json:"total_supply"`
+	// pending_bridge_outs are the USDC releases still awaiting an ICS-20
+	// acknowledgement or timeout
+	PendingBridgeOuts []PendingBridgeOut `protobuf:"bytes,4,rep,name=pending_bridge_outs,json=pendingBridgeOuts,proto3" json:"pending_bridge_outs"`
+	// pause is the guardian or circuit breaker pause of the bridge
+	Pause BridgePause `protobuf:"bytes,5,opt,name=pause,proto3" json:"pause"`
+	// flow_buckets are the slices of the rolling limit window
+	FlowBuckets []FlowBucket `protobuf:"bytes,6,rep,name=flow_buckets,json=flowBuckets,proto3" json:"flow_buckets"`
+	// address_flows are the daily flows of each address
+	AddressFlows []AddressFlow `protobuf:"bytes,7,rep,name=address_flows,json=addressFlows,proto3" json:"address_flows"`
+	// attestations is the history of reserve attestations, oldest first
+	Attestations []ReserveAttestation `protobuf:"bytes,8,rep,name=attestations,proto3" json:"attestations"`
+	// bridge_records are the bridge operations of each address
+	BridgeRecords []BridgeRecord `protobuf:"bytes,9,rep,name=bridge_records,json=bridgeRecords,proto3" json:"bridge_records"`
+	// pending_withdrawals are the queued bridge outs
+	PendingWithdrawals []PendingWithdrawal `protobuf:"bytes,10,rep,name=pending_withdrawals,json=pendingWithdrawals,proto3" json:"pending_withdrawals"`
+	// collateral_price is the last USDC price posted by the oracle, if any
+	CollateralPrice *CollateralPrice `protobuf:"bytes,11,opt,name=collateral_price,json=collateralPrice,proto3" json:"collateral_price,omitempty"`
+	// statistics are the bridge usage counters
+	Statistics BridgeStatistics `protobuf:"bytes,12,opt,name=statistics,proto3" json:"statistics"`
+	// next_bridge_record_sequence is the sequence of the next bridge record
+	NextBridgeRecordSequence uint64 `protobuf:"varint,13,opt,name=next_bridge_record_sequence,json=nextBridgeRecordSequence,proto3" json:"next_bridge_record_sequence,omitempty"`
+	// next_withdrawal_id is the id of the next queued withdrawal. Ids are not
+	// reused once their withdrawal has been released or cancelled.
+	NextWithdrawalId uint64 `protobuf:"varint,14,opt,name=next_withdrawal_id,json=nextWithdrawalId,proto3" json:"next_withdrawal_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -75,30 +100,105 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
-// BridgeStatistics tracks bridge usage statistics
-type BridgeStatistics struct {
-	// total_bridge_in_count is the total number of bridge in transactions
-	TotalBridgeInCount uint64 `protobuf:"varint,1,opt,name=total_bridge_in_count,json=totalBridgeInCount,proto3" json:"total_bridge_in_count,omitempty"`
-	// total_bridge_out_count is the total number of bridge out transactions
-	TotalBridgeOutCount uint64 `protobuf:"varint,2,opt,name=total_bridge_out_count,json=totalBridgeOutCount,proto3" json:"total_bridge_out_count,omitempty"`
-	// last_bridge_in_timestamp is the timestamp of the last bridge in
-	LastBridgeInTimestamp int64 `protobuf:"varint,3,opt,name=last_bridge_in_timestamp,json=lastBridgeInTimestamp,proto3" json:"last_bridge_in_timestamp,omitempty"`
-	// last_bridge_out_timestamp is the timestamp of the last bridge out
-	LastBridgeOutTimestamp int64 `protobuf:"varint,4,opt,name=last_bridge_out_timestamp,json=lastBridgeOutTimestamp,proto3" json:"last_bridge_out_timestamp,omitempty"`
+func (m *GenesisState) GetPendingBridgeOuts() []PendingBridgeOut {
+	if m != nil {
+		return m.PendingBridgeOuts
+	}
+	return nil
 }
 
-func (m *BridgeStatistics) Reset()         { *m = BridgeStatistics{} }
-func (m *BridgeStatistics) String() string { return proto.CompactTextString(m) }
-func (*BridgeStatistics) ProtoMessage()    {}
-func (*BridgeStatistics) Descriptor() ([]byte, []int) {
+func (m *GenesisState) GetPause() BridgePause {
+	if m != nil {
+		return m.Pause
+	}
+	return BridgePause{}
+}
+
+func (m *GenesisState) GetFlowBuckets() []FlowBucket {
+	if m != nil {
+		return m.FlowBuckets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAddressFlows() []AddressFlow {
+	if m != nil {
+		return m.AddressFlows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAttestations() []ReserveAttestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBridgeRecords() []BridgeRecord {
+	if m != nil {
+		return m.BridgeRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingWithdrawals() []PendingWithdrawal {
+	if m != nil {
+		return m.PendingWithdrawals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCollateralPrice() *CollateralPrice {
+	if m != nil {
+		return m.CollateralPrice
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStatistics() BridgeStatistics {
+	if m != nil {
+		return m.Statistics
+	}
+	return BridgeStatistics{}
+}
+
+func (m *GenesisState) GetNextBridgeRecordSequence() uint64 {
+	if m != nil {
+		return m.NextBridgeRecordSequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetNextWithdrawalId() uint64 {
+	if m != nil {
+		return m.NextWithdrawalId
+	}
+	return 0
+}
+
+// CollateralPrice is a USDC price posted by the price oracle.
+type CollateralPrice struct {
+	// price is the USDC price in USD
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+	// updated_at is the unix time the price was posted at
+	UpdatedAt int64 `protobuf:"varint,2,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// oracle is the address that posted the price
+	Oracle string `protobuf:"bytes,3,opt,name=oracle,proto3" json:"oracle,omitempty"`
+}
+
+func (m *CollateralPrice) Reset()         { *m = CollateralPrice{} }
+func (m *CollateralPrice) String() string { return proto.CompactTextString(m) }
+func (*CollateralPrice) ProtoMessage()    {}
+func (*CollateralPrice) Descriptor() ([]byte, []int) {
 	return fileDescriptor_903d442dcd371b28, []int{1}
 }
-func (m *BridgeStatistics) XXX_Unmarshal(b []byte) error {
+func (m *CollateralPrice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BridgeStatistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CollateralPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BridgeStatistics.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CollateralPrice.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -108,232 +208,3712 @@ func (m *BridgeStatistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *BridgeStatistics) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BridgeStatistics.Merge(m, src)
+func (m *CollateralPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollateralPrice.Merge(m, src)
 }
-func (m *BridgeStatistics) XXX_Size() int {
+func (m *CollateralPrice) XXX_Size() int {
 	return m.Size()
 }
-func (m *BridgeStatistics) XXX_DiscardUnknown() {
-	xxx_messageInfo_BridgeStatistics.DiscardUnknown(m)
+func (m *CollateralPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollateralPrice.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BridgeStatistics proto.InternalMessageInfo
+var xxx_messageInfo_CollateralPrice proto.InternalMessageInfo
 
-func (m *BridgeStatistics) GetTotalBridgeInCount() uint64 {
+func (m *CollateralPrice) GetUpdatedAt() int64 {
 	if m != nil {
-		return m.TotalBridgeInCount
+		return m.UpdatedAt
 	}
 	return 0
 }
 
-func (m *BridgeStatistics) GetTotalBridgeOutCount() uint64 {
+func (m *CollateralPrice) GetOracle() string {
 	if m != nil {
-		return m.TotalBridgeOutCount
+		return m.Oracle
+	}
+	return ""
+}
+
+// BridgeRecord is one bridge operation of an address.
+type BridgeRecord struct {
+	// address is the address that bridged in or out
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// sequence orders the records of all addresses
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// direction is "in", "out" or "refund"
+	Direction string `protobuf:"bytes,3,opt,name=direction,proto3" json:"direction,omitempty"`
+	// status is "completed", "queued", "sent", "refunded", "cancelled" or
+	// "failed"
+	Status  string     `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Usdc    types.Coin `protobuf:"bytes,5,opt,name=usdc,proto3" json:"usdc"`
+	Testusd types.Coin `protobuf:"bytes,6,opt,name=testusd,proto3" json:"testusd"`
+	// channel_id is the transfer channel, if the USDC moved over ICS-20
+	ChannelId string `protobuf:"bytes,7,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// withdrawal_id is the queued withdrawal of a queued bridge out
+	WithdrawalId uint64 `protobuf:"varint,8,opt,name=withdrawal_id,json=withdrawalId,proto3" json:"withdrawal_id,omitempty"`
+	// height and timestamp are when the record was created
+	Height    int64 `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
+	Timestamp int64 `protobuf:"varint,10,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *BridgeRecord) Reset()         { *m = BridgeRecord{} }
+func (m *BridgeRecord) String() string { return proto.CompactTextString(m) }
+func (*BridgeRecord) ProtoMessage()    {}
+func (*BridgeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_903d442dcd371b28, []int{2}
+}
+func (m *BridgeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeRecord.Merge(m, src)
+}
+func (m *BridgeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeRecord proto.InternalMessageInfo
+
+func (m *BridgeRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BridgeRecord) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
 	return 0
 }
 
-func (m *BridgeStatistics) GetLastBridgeInTimestamp() int64 {
+func (m *BridgeRecord) GetDirection() string {
 	if m != nil {
-		return m.LastBridgeInTimestamp
+		return m.Direction
+	}
+	return ""
+}
+
+func (m *BridgeRecord) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *BridgeRecord) GetUsdc() types.Coin {
+	if m != nil {
+		return m.Usdc
+	}
+	return types.Coin{}
+}
+
+func (m *BridgeRecord) GetTestusd() types.Coin {
+	if m != nil {
+		return m.Testusd
+	}
+	return types.Coin{}
+}
+
+func (m *BridgeRecord) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *BridgeRecord) GetWithdrawalId() uint64 {
+	if m != nil {
+		return m.WithdrawalId
 	}
 	return 0
 }
 
-func (m *BridgeStatistics) GetLastBridgeOutTimestamp() int64 {
+func (m *BridgeRecord) GetHeight() int64 {
 	if m != nil {
-		return m.LastBridgeOutTimestamp
+		return m.Height
 	}
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*GenesisState)(nil), "mychain.testusd.v1.GenesisState")
-	proto.RegisterType((*BridgeStatistics)(nil), "mychain.testusd.v1.BridgeStatistics")
+func (m *BridgeRecord) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("mychain/testusd/v1/genesis.proto", fileDescriptor_903d442dcd371b28) }
+// PendingWithdrawal is a bridge out waiting in the withdrawal queue. Its
+// TestUSD is held by the module until it is released or cancelled.
+type PendingWithdrawal struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sender is the address bridging out
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// testusd is the TestUSD to burn on release
+	Testusd types.Coin `protobuf:"bytes,3,opt,name=testusd,proto3" json:"testusd"`
+	// channel_id and receiver are where the USDC is sent on release
+	ChannelId string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Receiver  string `protobuf:"bytes,5,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// requested_at is the unix time the bridge out was requested at
+	RequestedAt int64 `protobuf:"varint,6,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	// release_at is the unix time the withdrawal is released at
+	ReleaseAt int64 `protobuf:"varint,7,opt,name=release_at,json=releaseAt,proto3" json:"release_at,omitempty"`
+	// record_sequence is the sender's bridge record of the withdrawal
+	RecordSequence uint64 `protobuf:"varint,8,opt,name=record_sequence,json=recordSequence,proto3" json:"record_sequence,omitempty"`
+}
 
-var fileDescriptor_903d442dcd371b28 = []byte{
-	// 393 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6b, 0xe2, 0x40,
-	0x14, 0xc7, 0x33, 0x2a, 0x82, 0xa3, 0x0b, 0xbb, 0xb3, 0xab, 0x9b, 0x15, 0x36, 0x8a, 0x27, 0xd9,
-	0x43, 0x42, 0xd6, 0xc3, 0xb2, 0x87, 0x85, 0x25, 0x3d, 0x14, 0x4f, 0x96, 0xd8, 0x53, 0x2f, 0x61,
-	0x4c, 0x42, 0x1c, 0x6a, 0x32, 0xc1, 0x79, 0x91, 0xfa, 0x2d, 0xfa, 0x31, 0x7a, 0xec, 0xc7, 0xf0,
-	0x28, 0xf4, 0x52, 0x7a, 0x90, 0xa2, 0x87, 0xde, 0xfa, 0x19, 0x4a, 0x26, 0x51, 0x53, 0xda, 0x43,
-	0x2f, 0xe1, 0x65, 0xde, 0xff, 0xf7, 0xff, 0xbf, 0x07, 0x0f, 0x77, 0xc3, 0xa5, 0x3b, 0xa5, 0x2c,
-	0x32, 0xc0, 0x17, 0x90, 0x08, 0xcf, 0x58, 0x98, 0x46, 0xe0, 0x47, 0xbe, 0x60, 0x42, 0x8f, 0xe7,
-	0x1c, 0x38, 0x21, 0xb9, 0x42, 0xcf, 0x15, 0xfa, 0xc2, 0x6c, 0x7f, 0xa1, 0x21, 0x8b, 0xb8, 0x21,
-	0xbf, 0x99, 0xac, 0xfd, 0x2d, 0xe0, 0x01, 0x97, 0xa5, 0x91, 0x56, 0xf9, 0x6b, 0xe7, 0x1d, 0xfb,
-	0x98, 0xce, 0x69, 0x98, 0xbb, 0xf7, 0xee, 0x10, 0x6e, 0x9c, 0x66, 0x79, 0x63, 0xa0, 0xe0, 0x93,
-	0x7f, 0xb8, 0x9a, 0x09, 0x54, 0xd4, 0x45, 0xfd, 0xfa, 0xef, 0xb6, 0xfe, 0x36, 0x5f, 0x3f, 0x93,
-	0x0a, 0xab, 0xb6, 0xda, 0x74, 0x94, 0x9b, 0xa7, 0xdb, 0x5f, 0xc8, 0xce, 0x21, 0x62, 0xe1, 0x4f,
-	0xc0, 0x81, 0xce, 0x9c, 0xc9, 0x9c, 0x79, 0x81, 0xef, 0xa9, 0xa5, 0x2e, 0xea, 0xd7, 0xac, 0x9f,
-	0xa9, 0xf2, 0x61, 0xd3, 0x69, 0xba, 0x5c, 0x84, 0x5c, 0x08, 0xef, 0x52, 0x67, 0xdc, 0x08, 0x29,
-	0x4c, 0xf5, 0x61, 0x04, 0x76, 0x43, 0x32, 0x56, 0x86, 0x90, 0xff, 0x38, 0xfb, 0x77, 0x44, 0x12,
-	0xc7, 0xb3, 0xa5, 0x5a, 0xfe, 0x88, 0x45, 0x5d, 0x22, 0x63, 0x49, 0xf4, 0x9e, 0x11, 0xfe, 0x9c,
-	0xb9, 0xa5, 0x4b, 0x31, 0x01, 0xcc, 0x15, 0xc4, 0xc4, 0xcd, 0xe2, 0x68, 0x0e, 0x8b, 0x1c, 0x97,
-	0x27, 0x11, 0xc8, 0x45, 0x2b, 0x36, 0x29, 0xcc, 0x30, 0x8c, 0x4e, 0xd2, 0x0e, 0x19, 0xe0, 0xd6,
-	0x2b, 0x84, 0x27, 0x90, 0x33, 0x25, 0xc9, 0x7c, 0x2d, 0x30, 0xa3, 0x04, 0x32, 0xe8, 0x0f, 0x56,
-	0x67, 0x54, 0x40, 0x21, 0x06, 0x58, 0xe8, 0x0b, 0xa0, 0x61, 0x2c, 0x57, 0x29, 0xdb, 0xcd, 0xb4,
-	0xbf, 0x4f, 0x3a, 0xdf, 0x37, 0xc9, 0x5f, 0xfc, 0xa3, 0x08, 0xa6, 0x61, 0x47, 0xb2, 0x22, 0xc9,
-	0xd6, 0x91, 0x1c, 0x25, 0x70, 0x40, 0x2d, 0x73, 0xb5, 0xd5, 0xd0, 0x7a, 0xab, 0xa1, 0xc7, 0xad,
-	0x86, 0xae, 0x77, 0x9a, 0xb2, 0xde, 0x69, 0xca, 0xfd, 0x4e, 0x53, 0x2e, 0xbe, 0xef, 0x2f, 0xe0,
-	0xea, 0x70, 0x03, 0xb0, 0x8c, 0x7d, 0x31, 0xa9, 0xca, 0x03, 0x18, 0xbc, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0x40, 0x86, 0x71, 0x41, 0x82, 0x02, 0x00, 0x00,
+func (m *PendingWithdrawal) Reset()         { *m = PendingWithdrawal{} }
+func (m *PendingWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*PendingWithdrawal) ProtoMessage()    {}
+func (*PendingWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_903d442dcd371b28, []int{3}
+}
+func (m *PendingWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingWithdrawal.Merge(m, src)
+}
+func (m *PendingWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingWithdrawal.DiscardUnknown(m)
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PendingWithdrawal proto.InternalMessageInfo
+
+func (m *PendingWithdrawal) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PendingWithdrawal) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TotalSupply.Size()
-		i -= size
-		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+func (m *PendingWithdrawal) GetTestusd() types.Coin {
+	if m != nil {
+		return m.Testusd
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.TotalBridged.Size()
-		i -= size
-		if _, err := m.TotalBridged.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	return types.Coin{}
+}
+
+func (m *PendingWithdrawal) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+	return ""
+}
+
+func (m *PendingWithdrawal) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *PendingWithdrawal) GetRequestedAt() int64 {
+	if m != nil {
+		return m.RequestedAt
+	}
+	return 0
+}
+
+func (m *PendingWithdrawal) GetReleaseAt() int64 {
+	if m != nil {
+		return m.ReleaseAt
+	}
+	return 0
+}
+
+func (m *PendingWithdrawal) GetRecordSequence() uint64 {
+	if m != nil {
+		return m.RecordSequence
+	}
+	return 0
+}
+
+// BridgePause records who paused the bridge and why. Only the authority can
+// unpause it.
+type BridgePause struct {
+	// paused blocks bridging in and out while set
+	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	// paused_by is the guardian, the authority or "circuit_breaker"
+	PausedBy string `protobuf:"bytes,2,opt,name=paused_by,json=pausedBy,proto3" json:"paused_by,omitempty"`
+	// reason describes why the bridge was paused
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// paused_at is the unix time the bridge was paused at
+	PausedAt int64 `protobuf:"varint,4,opt,name=paused_at,json=pausedAt,proto3" json:"paused_at,omitempty"`
+}
+
+func (m *BridgePause) Reset()         { *m = BridgePause{} }
+func (m *BridgePause) String() string { return proto.CompactTextString(m) }
+func (*BridgePause) ProtoMessage()    {}
+func (*BridgePause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_903d442dcd371b28, []int{4}
+}
+func (m *BridgePause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgePause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgePause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgePause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgePause.Merge(m, src)
+}
+func (m *BridgePause) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgePause) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgePause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgePause proto.InternalMessageInfo
+
+func (m *BridgePause) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *BridgePause) GetPausedBy() string {
+	if m != nil {
+		return m.PausedBy
+	}
+	return ""
+}
+
+func (m *BridgePause) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *BridgePause) GetPausedAt() int64 {
+	if m != nil {
+		return m.PausedAt
+	}
+	return 0
+}
+
+// FlowBucket is the TestUSD minted and burned in one slice of the rolling
+// limit window.
+type FlowBucket struct {
+	// start is the unix time the slice starts at
+	Start  int64                 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	Minted cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=minted,proto3,customtype=cosmossdk.io/math.Int" json:"minted"`
+	Burned cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=burned,proto3,customtype=cosmossdk.io/math.Int" json:"burned"`
+}
+
+func (m *FlowBucket) Reset()         { *m = FlowBucket{} }
+func (m *FlowBucket) String() string { return proto.CompactTextString(m) }
+func (*FlowBucket) ProtoMessage()    {}
+func (*FlowBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_903d442dcd371b28, []int{5}
+}
+func (m *FlowBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlowBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FlowBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FlowBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlowBucket.Merge(m, src)
+}
+func (m *FlowBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlowBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlowBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlowBucket proto.InternalMessageInfo
+
+func (m *FlowBucket) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+// AddressFlow is the TestUSD an address minted and burned on one UTC day.
+type AddressFlow struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// day is the number of days since the unix epoch
+	Day    int64                 `protobuf:"varint,2,opt,name=day,proto3" json:"day,omitempty"`
+	Minted cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=minted,proto3,customtype=cosmossdk.io/math.Int" json:"minted"`
+	Burned cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=burned,proto3,customtype=cosmossdk.io/math.Int" json:"burned"`
+}
+
+func (m *AddressFlow) Reset()         { *m = AddressFlow{} }
+func (m *AddressFlow) String() string { return proto.CompactTextString(m) }
+func (*AddressFlow) ProtoMessage()    {}
+func (*AddressFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_903d442dcd371b28, []int{6}
+}
+func (m *AddressFlow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressFlow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddressFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressFlow.Merge(m, src)
+}
+func (m *AddressFlow) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressFlow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressFlow proto.InternalMessageInfo
+
+func (m *AddressFlow) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AddressFlow) GetDay() int64 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+// ReserveAttestation is an off-chain statement of the USDC reserves backing
+// TestUSD, signed by the attestor.
+type ReserveAttestation struct {
+	// id is the position of the attestation in the history
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// attestor is the address that posted and signed the attestation
+	Attestor string `protobuf:"bytes,2,opt,name=attestor,proto3" json:"attestor,omitempty"`
+	// amount is the attested USDC reserve in base units
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// timestamp is the unix time the reserves were attested at
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// signature is the attestor's signature over the attestation sign bytes
+	Signature []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	// height is the block height the attestation was posted at
+	Height int64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ReserveAttestation) Reset()         { *m = ReserveAttestation{} }
+func (m *ReserveAttestation) String() string { return proto.CompactTextString(m) }
+func (*ReserveAttestation) ProtoMessage()    {}
+func (*ReserveAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_903d442dcd371b28, []int{7}
+}
+func (m *ReserveAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReserveAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReserveAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReserveAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReserveAttestation.Merge(m, src)
+}
+func (m *ReserveAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReserveAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReserveAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReserveAttestation proto.InternalMessageInfo
+
+func (m *ReserveAttestation) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ReserveAttestation) GetAttestor() string {
+	if m != nil {
+		return m.Attestor
+	}
+	return ""
+}
+
+func (m *ReserveAttestation) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ReserveAttestation) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *ReserveAttestation) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// PendingBridgeOut is a USDC release sent over ICS-20 for burned TestUSD.
+// The TestUSD is minted back to the sender if the transfer fails.
+type PendingBridgeOut struct {
+	// sender is the address that burned the TestUSD
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// channel_id is the transfer channel the USDC was sent over
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence is the packet sequence on channel_id
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// testusd is the TestUSD that was burned
+	Testusd types.Coin `protobuf:"bytes,4,opt,name=testusd,proto3" json:"testusd"`
+	// usdc is the USDC voucher that was sent
+	Usdc types.Coin `protobuf:"bytes,5,opt,name=usdc,proto3" json:"usdc"`
+	// record_sequence is the sender's bridge record of the transfer
+	RecordSequence uint64 `protobuf:"varint,6,opt,name=record_sequence,json=recordSequence,proto3" json:"record_sequence,omitempty"`
+	// fee is the redeem fee held by the module account until the transfer
+	// completes. It is paid to the treasury then, or refunded with the burned
+	// TestUSD if the transfer fails.
+	Fee types.Coin `protobuf:"bytes,7,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *PendingBridgeOut) Reset()         { *m = PendingBridgeOut{} }
+func (m *PendingBridgeOut) String() string { return proto.CompactTextString(m) }
+func (*PendingBridgeOut) ProtoMessage()    {}
+func (*PendingBridgeOut) Descriptor() ([]byte, []int) {
+	return fileDescriptor_903d442dcd371b28, []int{8}
+}
+func (m *PendingBridgeOut) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingBridgeOut) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingBridgeOut.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingBridgeOut) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingBridgeOut.Merge(m, src)
+}
+func (m *PendingBridgeOut) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingBridgeOut) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingBridgeOut.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingBridgeOut proto.InternalMessageInfo
+
+func (m *PendingBridgeOut) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *PendingBridgeOut) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PendingBridgeOut) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PendingBridgeOut) GetTestusd() types.Coin {
+	if m != nil {
+		return m.Testusd
+	}
+	return types.Coin{}
+}
+
+func (m *PendingBridgeOut) GetUsdc() types.Coin {
+	if m != nil {
+		return m.Usdc
+	}
+	return types.Coin{}
+}
+
+func (m *PendingBridgeOut) GetRecordSequence() uint64 {
+	if m != nil {
+		return m.RecordSequence
+	}
+	return 0
+}
+
+func (m *PendingBridgeOut) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
+// BridgeStatistics tracks bridge usage statistics
+type BridgeStatistics struct {
+	// total_bridge_in_count is the total number of bridge in transactions
+	TotalBridgeInCount uint64 `protobuf:"varint,1,opt,name=total_bridge_in_count,json=totalBridgeInCount,proto3" json:"total_bridge_in_count,omitempty"`
+	// total_bridge_out_count is the total number of bridge out transactions
+	TotalBridgeOutCount uint64 `protobuf:"varint,2,opt,name=total_bridge_out_count,json=totalBridgeOutCount,proto3" json:"total_bridge_out_count,omitempty"`
+	// last_bridge_in_timestamp is the timestamp of the last bridge in
+	LastBridgeInTimestamp int64 `protobuf:"varint,3,opt,name=last_bridge_in_timestamp,json=lastBridgeInTimestamp,proto3" json:"last_bridge_in_timestamp,omitempty"`
+	// last_bridge_out_timestamp is the timestamp of the last bridge out
+	LastBridgeOutTimestamp int64 `protobuf:"varint,4,opt,name=last_bridge_out_timestamp,json=lastBridgeOutTimestamp,proto3" json:"last_bridge_out_timestamp,omitempty"`
+}
+
+func (m *BridgeStatistics) Reset()         { *m = BridgeStatistics{} }
+func (m *BridgeStatistics) String() string { return proto.CompactTextString(m) }
+func (*BridgeStatistics) ProtoMessage()    {}
+func (*BridgeStatistics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_903d442dcd371b28, []int{9}
+}
+func (m *BridgeStatistics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeStatistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeStatistics.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeStatistics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeStatistics.Merge(m, src)
+}
+func (m *BridgeStatistics) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeStatistics) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeStatistics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeStatistics proto.InternalMessageInfo
+
+func (m *BridgeStatistics) GetTotalBridgeInCount() uint64 {
+	if m != nil {
+		return m.TotalBridgeInCount
+	}
+	return 0
+}
+
+func (m *BridgeStatistics) GetTotalBridgeOutCount() uint64 {
+	if m != nil {
+		return m.TotalBridgeOutCount
+	}
+	return 0
+}
+
+func (m *BridgeStatistics) GetLastBridgeInTimestamp() int64 {
+	if m != nil {
+		return m.LastBridgeInTimestamp
+	}
+	return 0
+}
+
+func (m *BridgeStatistics) GetLastBridgeOutTimestamp() int64 {
+	if m != nil {
+		return m.LastBridgeOutTimestamp
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "mychain.testusd.v1.GenesisState")
+	proto.RegisterType((*CollateralPrice)(nil), "mychain.testusd.v1.CollateralPrice")
+	proto.RegisterType((*BridgeRecord)(nil), "mychain.testusd.v1.BridgeRecord")
+	proto.RegisterType((*PendingWithdrawal)(nil), "mychain.testusd.v1.PendingWithdrawal")
+	proto.RegisterType((*BridgePause)(nil), "mychain.testusd.v1.BridgePause")
+	proto.RegisterType((*FlowBucket)(nil), "mychain.testusd.v1.FlowBucket")
+	proto.RegisterType((*AddressFlow)(nil), "mychain.testusd.v1.AddressFlow")
+	proto.RegisterType((*ReserveAttestation)(nil), "mychain.testusd.v1.ReserveAttestation")
+	proto.RegisterType((*PendingBridgeOut)(nil), "mychain.testusd.v1.PendingBridgeOut")
+	proto.RegisterType((*BridgeStatistics)(nil), "mychain.testusd.v1.BridgeStatistics")
+}
+
+func init() { proto.RegisterFile("mychain/testusd/v1/genesis.proto", fileDescriptor_903d442dcd371b28) }
+
+var fileDescriptor_903d442dcd371b28 = []byte{
+	// 1228 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x36, 0x45, 0x59, 0x3f, 0x23, 0xd9, 0x71, 0x36, 0x89, 0xcb, 0xc8, 0x8d, 0xac, 0xca, 0xfd,
+	0x31, 0x8a, 0x42, 0x82, 0x62, 0x14, 0x85, 0x0f, 0x01, 0x22, 0xb9, 0x68, 0x61, 0x20, 0xa8, 0x0d,
+	0xba, 0x45, 0x81, 0x5e, 0x88, 0x15, 0xb9, 0x96, 0x88, 0x50, 0x24, 0xcb, 0x5d, 0x5a, 0xd1, 0xb9,
+	0x2f, 0x90, 0x07, 0xe8, 0xbd, 0x3d, 0x36, 0x6f, 0x11, 0xa0, 0x97, 0x1c, 0x8b, 0x1e, 0x82, 0xc2,
+	0x2e, 0xd0, 0x5b, 0x9f, 0xa1, 0xd8, 0x1f, 0x51, 0x2b, 0x59, 0x0e, 0x94, 0xf4, 0x22, 0x70, 0x66,
+	0xbf, 0x99, 0x9d, 0xbf, 0x6f, 0x77, 0x05, 0x8d, 0xd1, 0xc4, 0x1d, 0x62, 0x3f, 0x6c, 0x33, 0x42,
+	0x59, 0x4a, 0xbd, 0xf6, 0x45, 0xa7, 0x3d, 0x20, 0x21, 0xa1, 0x3e, 0x6d, 0xc5, 0x49, 0xc4, 0x22,
+	0x84, 0x14, 0xa2, 0xa5, 0x10, 0xad, 0x8b, 0x4e, 0xed, 0x36, 0x1e, 0xf9, 0x61, 0xd4, 0x16, 0xbf,
+	0x12, 0x56, 0xbb, 0x3b, 0x88, 0x06, 0x91, 0xf8, 0x6c, 0xf3, 0x2f, 0xa5, 0xad, 0xbb, 0x11, 0x1d,
+	0x45, 0xb4, 0xdd, 0xc7, 0x94, 0xb4, 0x2f, 0x3a, 0x7d, 0xc2, 0x70, 0xa7, 0xed, 0x46, 0x7e, 0xa8,
+	0xd6, 0x77, 0x97, 0x6c, 0x1f, 0xe3, 0x04, 0x8f, 0xd4, 0xee, 0xcd, 0x17, 0x25, 0xa8, 0x7e, 0x2d,
+	0xe3, 0x39, 0x63, 0x98, 0x11, 0xf4, 0x08, 0x0a, 0x12, 0x60, 0x19, 0x0d, 0x63, 0xbf, 0xf2, 0xb0,
+	0xd6, 0xba, 0x1e, 0x5f, 0xeb, 0x54, 0x20, 0x7a, 0xe5, 0x97, 0xaf, 0x77, 0xd7, 0x7e, 0xfd, 0xe7,
+	0xb7, 0x4f, 0x0d, 0x5b, 0x19, 0xa1, 0x1e, 0x6c, 0xb0, 0x88, 0xe1, 0xc0, 0xe9, 0x27, 0xbe, 0x37,
+	0x20, 0x9e, 0x95, 0x6b, 0x18, 0xfb, 0xe5, 0xde, 0x03, 0x8e, 0xfc, 0xf3, 0xf5, 0xee, 0x3d, 0x19,
+	0x2f, 0xf5, 0x9e, 0xb6, 0xfc, 0xa8, 0x3d, 0xc2, 0x6c, 0xd8, 0x3a, 0x0e, 0x99, 0x5d, 0x15, 0x36,
+	0x3d, 0x69, 0x82, 0x1e, 0x83, 0x94, 0x1d, 0x9a, 0xc6, 0x71, 0x30, 0xb1, 0xcc, 0x55, 0x5c, 0x54,
+	0x84, 0xc9, 0x99, 0xb0, 0x40, 0x0e, 0xdc, 0x89, 0x49, 0xe8, 0xf9, 0xe1, 0x40, 0xc5, 0xe1, 0x44,
+	0x29, 0xa3, 0x56, 0xbe, 0x61, 0xee, 0x57, 0x1e, 0x7e, 0xb8, 0x34, 0x23, 0x09, 0x97, 0x21, 0x9c,
+	0xa4, 0x4c, 0xcf, 0xed, 0x76, 0xbc, 0xb0, 0x48, 0xd1, 0x63, 0x58, 0x8f, 0x71, 0x4a, 0x89, 0xb5,
+	0x2e, 0x8a, 0xb4, 0xbb, 0xcc, 0xa5, 0x84, 0x9f, 0x72, 0x98, 0xee, 0x4d, 0x1a, 0xa2, 0x27, 0x50,
+	0x3d, 0x0f, 0xa2, 0xb1, 0xd3, 0x4f, 0xdd, 0xa7, 0x84, 0x51, 0xab, 0x20, 0x62, 0xab, 0x2f, 0x73,
+	0xf4, 0x55, 0x10, 0x8d, 0x7b, 0x02, 0xa6, 0xfb, 0xa9, 0x9c, 0x67, 0x6a, 0x8a, 0x4e, 0x60, 0x03,
+	0x7b, 0x5e, 0x42, 0x28, 0x75, 0xb8, 0x9a, 0x5a, 0xc5, 0x86, 0x79, 0x53, 0x5c, 0x5d, 0x09, 0x14,
+	0x5e, 0x35, 0x7f, 0x55, 0x3c, 0xd3, 0x53, 0xf4, 0x1d, 0x54, 0x31, 0xe3, 0x46, 0x98, 0xf9, 0x51,
+	0x48, 0xad, 0x92, 0xf0, 0xf7, 0xf1, 0x32, 0x7f, 0x36, 0xa1, 0x24, 0xb9, 0x20, 0xdd, 0x19, 0x7c,
+	0xde, 0xad, 0xe6, 0x06, 0xd9, 0xb0, 0xa9, 0x1a, 0x92, 0x10, 0x37, 0x4a, 0x3c, 0x6a, 0x95, 0x85,
+	0xe3, 0xc6, 0xcd, 0x05, 0xb4, 0x05, 0x50, 0x77, 0xb9, 0xd1, 0xd7, 0x16, 0x28, 0xc2, 0xb3, 0x66,
+	0x8f, 0x7d, 0x36, 0xf4, 0x12, 0x3c, 0xc6, 0x01, 0xb5, 0x40, 0x38, 0xfe, 0xe8, 0x0d, 0xcd, 0xfe,
+	0x3e, 0x43, 0xeb, 0xde, 0x51, 0xbc, 0xb8, 0x4a, 0xd1, 0x37, 0xb0, 0xe5, 0x46, 0x41, 0x80, 0x19,
+	0x49, 0x70, 0xe0, 0xc4, 0x89, 0xef, 0x12, 0xab, 0x22, 0x3a, 0xbf, 0xb7, 0xcc, 0xff, 0x51, 0x86,
+	0x3d, 0xe5, 0x50, 0xfb, 0x96, 0x3b, 0xaf, 0x40, 0x27, 0x00, 0xa2, 0x24, 0x94, 0xf9, 0x2e, 0xb5,
+	0xaa, 0x0d, 0xe3, 0xa6, 0xb1, 0x94, 0x25, 0x38, 0xcb, 0xb0, 0x7a, 0xa0, 0x9a, 0x0b, 0xf4, 0x08,
+	0x76, 0x42, 0xf2, 0x8c, 0x39, 0x73, 0xc5, 0x75, 0x28, 0xf9, 0x31, 0x25, 0xa1, 0x4b, 0xac, 0x8d,
+	0x86, 0xb1, 0x9f, 0xb7, 0x2d, 0x0e, 0xd1, 0x8b, 0x7a, 0xa6, 0xd6, 0xd1, 0x67, 0x80, 0x84, 0xf9,
+	0xac, 0x7e, 0x8e, 0xef, 0x59, 0x9b, 0xc2, 0x6a, 0x8b, 0xaf, 0xcc, 0x8a, 0x71, 0xec, 0x35, 0x7f,
+	0x32, 0xe0, 0xd6, 0x42, 0x8a, 0xe8, 0x10, 0xd6, 0x65, 0x59, 0x0c, 0x41, 0xd6, 0x3d, 0x45, 0xd6,
+	0x9d, 0xeb, 0x64, 0x7d, 0x42, 0x06, 0xd8, 0x9d, 0x7c, 0x49, 0x5c, 0x5b, 0x5a, 0xa0, 0x07, 0x00,
+	0x69, 0xec, 0x61, 0x46, 0x3c, 0x07, 0x33, 0x71, 0x5e, 0x98, 0x76, 0x59, 0x69, 0xba, 0x0c, 0x6d,
+	0x43, 0x21, 0x4a, 0xb0, 0x1b, 0x10, 0x79, 0x0e, 0xd8, 0x4a, 0x6a, 0xfe, 0x9d, 0x83, 0xaa, 0x9e,
+	0x0c, 0xb2, 0xa0, 0xa8, 0x46, 0x58, 0x06, 0x61, 0x4f, 0x45, 0x54, 0x83, 0x52, 0x56, 0x8a, 0x9c,
+	0x48, 0x2a, 0x93, 0xd1, 0xfb, 0x50, 0xf6, 0xfc, 0x84, 0xb8, 0x7c, 0x3e, 0xd5, 0x0e, 0x33, 0x05,
+	0xdf, 0x9c, 0x57, 0x39, 0xe5, 0x67, 0x87, 0xd8, 0x5c, 0x4a, 0xe8, 0x00, 0xf2, 0x29, 0xf5, 0x5c,
+	0x45, 0xff, 0xfb, 0x2d, 0x99, 0x66, 0x8b, 0x1f, 0xc3, 0x2d, 0x75, 0x0c, 0xb7, 0x8e, 0x22, 0x3f,
+	0xec, 0xe5, 0x79, 0x21, 0x6c, 0x01, 0x46, 0x87, 0x50, 0x54, 0xad, 0xb5, 0x0a, 0xab, 0xd9, 0x4d,
+	0xf1, 0xbc, 0x46, 0xee, 0x10, 0x87, 0x21, 0x11, 0x8d, 0x29, 0xca, 0x30, 0x95, 0xe6, 0xd8, 0x43,
+	0x7b, 0xb0, 0x31, 0xdf, 0xba, 0x92, 0xc8, 0xb2, 0x3a, 0xd6, 0xda, 0xc6, 0x73, 0x19, 0x12, 0x7f,
+	0x30, 0x64, 0x56, 0x59, 0xd4, 0x58, 0x49, 0xbc, 0x02, 0xcc, 0x1f, 0x71, 0x8e, 0x8e, 0x62, 0x0b,
+	0x64, 0xf9, 0x33, 0x45, 0xf3, 0xe7, 0x1c, 0xdc, 0xbe, 0xc6, 0x17, 0xb4, 0x09, 0x39, 0xdf, 0x13,
+	0x65, 0xce, 0xdb, 0x39, 0x5f, 0xf8, 0xa6, 0x24, 0xf4, 0x48, 0x22, 0xcf, 0x7b, 0x5b, 0x49, 0x7a,
+	0xca, 0xe6, 0xff, 0x4a, 0x39, 0xbf, 0x98, 0x72, 0x0d, 0x4a, 0x09, 0x71, 0x89, 0x7f, 0x41, 0x12,
+	0xd1, 0x85, 0xb2, 0x9d, 0xc9, 0xe8, 0x03, 0xa8, 0x26, 0xbc, 0xbf, 0x54, 0xcd, 0x54, 0x41, 0x24,
+	0x55, 0xc9, 0x74, 0x5d, 0xc6, 0xbd, 0x27, 0x24, 0x20, 0x98, 0x12, 0x0e, 0x28, 0xca, 0xac, 0x95,
+	0xa6, 0xcb, 0xd0, 0x27, 0x70, 0x6b, 0x91, 0x43, 0xb2, 0xa4, 0x9b, 0xc9, 0x1c, 0x73, 0x9a, 0x63,
+	0xa8, 0x68, 0xe7, 0x3c, 0xaf, 0x83, 0x38, 0xde, 0x65, 0x6d, 0x4a, 0xb6, 0x92, 0xd0, 0x0e, 0x94,
+	0xe5, 0x97, 0xd3, 0x9f, 0xa8, 0x12, 0x95, 0xa4, 0xa2, 0x37, 0xe1, 0x46, 0x09, 0xc1, 0x34, 0x9b,
+	0x3f, 0x25, 0x69, 0x46, 0x98, 0x89, 0x02, 0x98, 0x53, 0xa3, 0x2e, 0x6b, 0x3e, 0x37, 0x00, 0x66,
+	0x17, 0x03, 0xba, 0x0b, 0xeb, 0x94, 0xe1, 0x84, 0x89, 0x7d, 0x4d, 0x5b, 0x0a, 0xe8, 0x73, 0x28,
+	0x8c, 0xfc, 0x90, 0xad, 0x7a, 0x0d, 0x2b, 0x30, 0x37, 0xeb, 0xa7, 0x49, 0x48, 0xbc, 0xd5, 0xae,
+	0x5e, 0x05, 0x6e, 0xfe, 0x62, 0x40, 0x45, 0xbb, 0x5c, 0xde, 0x40, 0xc8, 0x2d, 0x30, 0x3d, 0x3c,
+	0x51, 0x5c, 0xe7, 0x9f, 0x5a, 0xa4, 0xe6, 0xbb, 0x45, 0x9a, 0x7f, 0x9b, 0x48, 0x7f, 0x37, 0x00,
+	0x5d, 0xbf, 0xb6, 0xae, 0x4d, 0x75, 0x0d, 0x4a, 0xf2, 0xf6, 0x8a, 0xa6, 0x73, 0x9d, 0xc9, 0x7c,
+	0x67, 0x3c, 0x8a, 0xd2, 0x90, 0xad, 0x18, 0xb0, 0x04, 0xcf, 0x93, 0x2d, 0xbf, 0x40, 0x36, 0xbe,
+	0x4a, 0xfd, 0x41, 0x88, 0x59, 0x9a, 0xc8, 0xa7, 0x45, 0xd5, 0x9e, 0x29, 0x34, 0x02, 0x17, 0x74,
+	0x02, 0x37, 0x5f, 0xe4, 0x60, 0x6b, 0xf1, 0xfd, 0xa2, 0x31, 0xd2, 0x98, 0x63, 0xe4, 0x3c, 0xad,
+	0x72, 0x4b, 0x68, 0x95, 0x4d, 0xbc, 0xb9, 0x70, 0x54, 0x6a, 0x64, 0xce, 0xbf, 0x25, 0x99, 0xdf,
+	0xe9, 0xbc, 0x5c, 0x42, 0xc2, 0xc2, 0x32, 0x12, 0xa2, 0x0e, 0x98, 0xe7, 0x84, 0x58, 0xc5, 0xd5,
+	0x9c, 0x73, 0x6c, 0xf3, 0x5f, 0x03, 0xb6, 0x16, 0x2f, 0x57, 0xd4, 0x81, 0x7b, 0xfa, 0xe3, 0xd5,
+	0xf1, 0x43, 0xc7, 0x15, 0x2d, 0x96, 0x23, 0x81, 0xb4, 0x57, 0xea, 0x71, 0x78, 0x24, 0xfa, 0x79,
+	0x00, 0xdb, 0x73, 0x26, 0x51, 0xca, 0x94, 0x8d, 0xbc, 0x68, 0xee, 0x68, 0x36, 0x27, 0x29, 0x93,
+	0x46, 0x5f, 0x80, 0x15, 0x60, 0xca, 0xb4, 0x6d, 0x66, 0x33, 0x61, 0x8a, 0xd6, 0xde, 0xe3, 0xeb,
+	0xd3, 0x9d, 0xbe, 0xcd, 0xe6, 0xe3, 0x10, 0xee, 0xeb, 0x86, 0x7c, 0xb3, 0xc5, 0x69, 0xda, 0x9e,
+	0x59, 0x9e, 0xa4, 0x2c, 0x33, 0xed, 0x75, 0x5e, 0x5e, 0xd6, 0x8d, 0x57, 0x97, 0x75, 0xe3, 0xaf,
+	0xcb, 0xba, 0xf1, 0xfc, 0xaa, 0xbe, 0xf6, 0xea, 0xaa, 0xbe, 0xf6, 0xc7, 0x55, 0x7d, 0xed, 0x87,
+	0xf7, 0xa6, 0xff, 0x11, 0x9e, 0x65, 0xff, 0x12, 0xd8, 0x24, 0x26, 0xb4, 0x5f, 0x10, 0x7f, 0x11,
+	0x0e, 0xfe, 0x1b, 0x00, 0x3d, 0xea, 0x49, 0xa4, 0xc4, 0x0c, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextWithdrawalId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextWithdrawalId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.NextBridgeRecordSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextBridgeRecordSequence))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size, err := m.Statistics.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.CollateralPrice != nil {
+		{
+			size, err := m.CollateralPrice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.PendingWithdrawals) > 0 {
+		for iNdEx := len(m.PendingWithdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingWithdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.BridgeRecords) > 0 {
+		for iNdEx := len(m.BridgeRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgeRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.AddressFlows) > 0 {
+		for iNdEx := len(m.AddressFlows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddressFlows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.FlowBuckets) > 0 {
+		for iNdEx := len(m.FlowBuckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FlowBuckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.Pause.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.PendingBridgeOuts) > 0 {
+		for iNdEx := len(m.PendingBridgeOuts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingBridgeOuts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.TotalSupply.Size()
+		i -= size
+		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalBridged.Size()
+		i -= size
+		if _, err := m.TotalBridged.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CollateralPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollateralPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CollateralPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Oracle) > 0 {
+		i -= len(m.Oracle)
+		copy(dAtA[i:], m.Oracle)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Oracle)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.UpdatedAt != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.WithdrawalId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.WithdrawalId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.Testusd.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Usdc.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Direction) > 0 {
+		i -= len(m.Direction)
+		copy(dAtA[i:], m.Direction)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Direction)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecordSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RecordSequence))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ReleaseAt != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ReleaseAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RequestedAt != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RequestedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Testusd.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgePause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgePause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgePause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PausedAt != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PausedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PausedBy) > 0 {
+		i -= len(m.PausedBy)
+		copy(dAtA[i:], m.PausedBy)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PausedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FlowBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlowBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlowBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Burned.Size()
+		i -= size
+		if _, err := m.Burned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Start != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressFlow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressFlow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressFlow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Burned.Size()
+		i -= size
+		if _, err := m.Burned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Day != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Day))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReserveAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReserveAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReserveAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Attestor) > 0 {
+		i -= len(m.Attestor)
+		copy(dAtA[i:], m.Attestor)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Attestor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingBridgeOut) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingBridgeOut) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingBridgeOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.RecordSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RecordSequence))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.Usdc.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Testusd.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeStatistics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeStatistics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeStatistics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastBridgeOutTimestamp != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastBridgeOutTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastBridgeInTimestamp != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastBridgeInTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalBridgeOutCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TotalBridgeOutCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TotalBridgeInCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TotalBridgeInCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.TotalBridged.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PendingBridgeOuts) > 0 {
+		for _, e := range m.PendingBridgeOuts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Pause.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FlowBuckets) > 0 {
+		for _, e := range m.FlowBuckets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AddressFlows) > 0 {
+		for _, e := range m.AddressFlows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BridgeRecords) > 0 {
+		for _, e := range m.BridgeRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingWithdrawals) > 0 {
+		for _, e := range m.PendingWithdrawals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.CollateralPrice != nil {
+		l = m.CollateralPrice.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Statistics.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.NextBridgeRecordSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.NextBridgeRecordSequence))
+	}
+	if m.NextWithdrawalId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextWithdrawalId))
+	}
+	return n
+}
+
+func (m *CollateralPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.UpdatedAt != 0 {
+		n += 1 + sovGenesis(uint64(m.UpdatedAt))
+	}
+	l = len(m.Oracle)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *BridgeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	l = len(m.Direction)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Usdc.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Testusd.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.WithdrawalId != 0 {
+		n += 1 + sovGenesis(uint64(m.WithdrawalId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovGenesis(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *PendingWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGenesis(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Testusd.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.RequestedAt != 0 {
+		n += 1 + sovGenesis(uint64(m.RequestedAt))
+	}
+	if m.ReleaseAt != 0 {
+		n += 1 + sovGenesis(uint64(m.ReleaseAt))
+	}
+	if m.RecordSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.RecordSequence))
+	}
+	return n
+}
+
+func (m *BridgePause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	l = len(m.PausedBy)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.PausedAt != 0 {
+		n += 1 + sovGenesis(uint64(m.PausedAt))
+	}
+	return n
+}
+
+func (m *FlowBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovGenesis(uint64(m.Start))
+	}
+	l = m.Minted.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Burned.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *AddressFlow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Day != 0 {
+		n += 1 + sovGenesis(uint64(m.Day))
+	}
+	l = m.Minted.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Burned.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ReserveAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGenesis(uint64(m.Id))
+	}
+	l = len(m.Attestor)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Timestamp != 0 {
+		n += 1 + sovGenesis(uint64(m.Timestamp))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *PendingBridgeOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	l = m.Testusd.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Usdc.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.RecordSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.RecordSequence))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *BridgeStatistics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalBridgeInCount != 0 {
+		n += 1 + sovGenesis(uint64(m.TotalBridgeInCount))
+	}
+	if m.TotalBridgeOutCount != 0 {
+		n += 1 + sovGenesis(uint64(m.TotalBridgeOutCount))
+	}
+	if m.LastBridgeInTimestamp != 0 {
+		n += 1 + sovGenesis(uint64(m.LastBridgeInTimestamp))
+	}
+	if m.LastBridgeOutTimestamp != 0 {
+		n += 1 + sovGenesis(uint64(m.LastBridgeOutTimestamp))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBridged", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBridged.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingBridgeOuts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingBridgeOuts = append(m.PendingBridgeOuts, PendingBridgeOut{})
+			if err := m.PendingBridgeOuts[len(m.PendingBridgeOuts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowBuckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FlowBuckets = append(m.FlowBuckets, FlowBucket{})
+			if err := m.FlowBuckets[len(m.FlowBuckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressFlows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressFlows = append(m.AddressFlows, AddressFlow{})
+			if err := m.AddressFlows[len(m.AddressFlows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, ReserveAttestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeRecords = append(m.BridgeRecords, BridgeRecord{})
+			if err := m.BridgeRecords[len(m.BridgeRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingWithdrawals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingWithdrawals = append(m.PendingWithdrawals, PendingWithdrawal{})
+			if err := m.PendingWithdrawals[len(m.PendingWithdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CollateralPrice == nil {
+				m.CollateralPrice = &CollateralPrice{}
+			}
+			if err := m.CollateralPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Statistics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextBridgeRecordSequence", wireType)
+			}
+			m.NextBridgeRecordSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextBridgeRecordSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextWithdrawalId", wireType)
+			}
+			m.NextWithdrawalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextWithdrawalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollateralPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollateralPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollateralPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Oracle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Direction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usdc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usdc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Testusd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Testusd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalId", wireType)
+			}
+			m.WithdrawalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingWithdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingWithdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Testusd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Testusd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedAt", wireType)
+			}
+			m.RequestedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseAt", wireType)
+			}
+			m.ReleaseAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSequence", wireType)
+			}
+			m.RecordSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgePause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgePause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgePause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedAt", wireType)
+			}
+			m.PausedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PausedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FlowBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlowBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlowBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressFlow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressFlow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressFlow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
+			}
+			m.Day = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Day |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReserveAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReserveAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReserveAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *BridgeStatistics) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *BridgeStatistics) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *BridgeStatistics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastBridgeOutTimestamp != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastBridgeOutTimestamp))
-		i--
-		dAtA[i] = 0x20
 	}
-	if m.LastBridgeInTimestamp != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastBridgeInTimestamp))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.TotalBridgeOutCount != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TotalBridgeOutCount))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.TotalBridgeInCount != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TotalBridgeInCount))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.TotalBridged.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.TotalSupply.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
 
-func (m *BridgeStatistics) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TotalBridgeInCount != 0 {
-		n += 1 + sovGenesis(uint64(m.TotalBridgeInCount))
-	}
-	if m.TotalBridgeOutCount != 0 {
-		n += 1 + sovGenesis(uint64(m.TotalBridgeOutCount))
-	}
-	if m.LastBridgeInTimestamp != 0 {
-		n += 1 + sovGenesis(uint64(m.LastBridgeInTimestamp))
-	}
-	if m.LastBridgeOutTimestamp != 0 {
-		n += 1 + sovGenesis(uint64(m.LastBridgeOutTimestamp))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *PendingBridgeOut) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -356,17 +3936,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingBridgeOut: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingBridgeOut: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -376,28 +3956,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalBridged", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -425,15 +4004,65 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalBridged.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Testusd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Testusd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Usdc", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -443,23 +4072,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Usdc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSequence", wireType)
+			}
+			m.RecordSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
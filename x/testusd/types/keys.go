@@ -1,5 +1,7 @@
 package types
 
+import "cosmossdk.io/collections"
+
 const (
     // ModuleName defines the module name
     ModuleName = "testusd"
@@ -14,23 +16,69 @@ const (
     RouterKey = ModuleName
 )
 
-// Store key prefixes
+// Store key prefixes. The prefixes are part of the module's state layout and
+// must not be reused or renumbered.
 var (
-    ParamsKey          = []byte{0x01} // Prefix for params
-    TotalBridgedKey    = []byte{0x02} // Key for total bridged amount
-    TotalSupplyKey     = []byte{0x03} // Key for total supply
-    BridgeStatisticsKey = []byte{0x04} // Key for bridge statistics
+    ParamsKey           = collections.NewPrefix(0x01) // Key for params
+    TotalBridgedKey     = collections.NewPrefix(0x02) // Key for total bridged amount
+    TotalSupplyKey      = collections.NewPrefix(0x03) // Key for total supply
+    BridgeStatisticsKey = collections.NewPrefix(0x04) // Key for bridge statistics
+    PendingBridgeOutKey = collections.NewPrefix(0x05) // Prefix for USDC sent over ICS-20 awaiting an ack
+    BridgePauseKey      = collections.NewPrefix(0x06) // Key for the guardian or circuit breaker pause
+    FlowBucketKey       = collections.NewPrefix(0x07) // Prefix for the rolling limit window slices
+    AddressFlowKey      = collections.NewPrefix(0x08) // Prefix for daily flows by address
+    AttestationKey      = collections.NewPrefix(0x09) // Prefix for reserve attestations by id
+    AttestationSeqKey   = collections.NewPrefix(0x0a) // Key for the next reserve attestation id
+    BridgeRecordKey     = collections.NewPrefix(0x0b) // Prefix for bridge records by address and sequence
+    BridgeRecordSeqKey  = collections.NewPrefix(0x0c) // Key for the next bridge record sequence
+    WithdrawalKey       = collections.NewPrefix(0x0d) // Prefix for queued withdrawals by id
+    WithdrawalSeqKey    = collections.NewPrefix(0x0e) // Key for the next withdrawal id
+    WithdrawalQueueKey  = collections.NewPrefix(0x0f) // Prefix for queued withdrawals by release time
+    CollateralPriceKey  = collections.NewPrefix(0x10) // Key for the oracle USDC price
 )
 
 // Event types
 const (
     EventTypeBridgeIn  = "bridge_in"
     EventTypeBridgeOut = "bridge_out"
+    EventTypeBridgeOutRefund = "bridge_out_refund"
+    EventTypeBridgePaused    = "bridge_paused"
+    EventTypeBridgeUnpaused  = "bridge_unpaused"
+    EventTypeReserveAttestation = "reserve_attestation"
+    EventTypeWithdrawalQueued    = "withdrawal_queued"
+    EventTypeWithdrawalCancelled = "withdrawal_cancelled"
+    EventTypeWithdrawalFailed    = "withdrawal_failed"
+    EventTypeCollateralPrice     = "collateral_price"
     
     AttributeKeySender         = "sender"
     AttributeKeyAmount         = "amount"
     AttributeKeyMintedAmount   = "minted_amount"
     AttributeKeyReleasedAmount = "released_amount"
+    AttributeKeyChannel        = "channel"
+    AttributeKeySequence       = "sequence"
+    AttributeKeyReceiver       = "receiver"
+    AttributeKeyPausedBy       = "paused_by"
+    AttributeKeyReason         = "reason"
+    AttributeKeyAttestationID  = "attestation_id"
+    AttributeKeyTimestamp      = "timestamp"
+    AttributeKeyWithdrawalID   = "withdrawal_id"
+    AttributeKeyReleaseAt      = "release_at"
+    AttributeKeyFee            = "fee"
+    AttributeKeyPrice          = "price"
+)
+
+// Bridge record directions and statuses
+const (
+    BridgeDirectionIn     = "in"
+    BridgeDirectionOut    = "out"
+    BridgeDirectionRefund = "refund"
+
+    BridgeStatusCompleted = "completed"
+    BridgeStatusQueued    = "queued"
+    BridgeStatusSent      = "sent"
+    BridgeStatusRefunded  = "refunded"
+    BridgeStatusCancelled = "cancelled"
+    BridgeStatusFailed    = "failed"
 )
 
 func KeyPrefix(p string) []byte {
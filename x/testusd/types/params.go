@@ -2,7 +2,12 @@ package types
 
 import (
     "fmt"
+
+    "cosmossdk.io/math"
+    sdk "github.com/cosmos/cosmos-sdk/types"
     paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+    transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+    host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -23,15 +28,202 @@ func NewParams(bridgeEnabled bool, pegRatio string, testusdDenom string, usdcDen
     }
 }
 
+// DefaultIBCTimeoutSeconds is the default timeout of USDC sent back over
+// ICS-20.
+const DefaultIBCTimeoutSeconds = 600
+
+// DefaultLimitWindowSeconds is the default length of the rolling limit window.
+const DefaultLimitWindowSeconds = 24 * 60 * 60
+
+// DefaultWithdrawalDelaySeconds is the default review delay of queued bridge
+// outs.
+const DefaultWithdrawalDelaySeconds = 24 * 60 * 60
+
+// DefaultWithdrawalReleaseBudget bounds the withdrawals released in one
+// EndBlock.
+const DefaultWithdrawalReleaseBudget = 50
+
+// DefaultOracleMaxAgeSeconds is how long an oracle price stays fresh by
+// default.
+const DefaultOracleMaxAgeSeconds = 60 * 60
+
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-    return NewParams(
+    params := NewParams(
         true,        // BridgeEnabled
         "1.0",       // PegRatio
         "utestusd",  // TestusdDenom
         "uusdc",     // UsdcDenom
         "",          // BridgeAddress (will be set at runtime)
     )
+    params.UsdcSources = []UsdcSource{}
+    params.IbcTimeoutSeconds = DefaultIBCTimeoutSeconds
+    params.Limits = DefaultBridgeLimits()
+    params.WithdrawalQueue = DefaultWithdrawalQueue()
+    params.Fees = DefaultBridgeFees()
+    params.PriceOracle = DefaultPriceOracle()
+    return params
+}
+
+// DefaultBridgeLimits returns limits that leave the bridge uncapped.
+func DefaultBridgeLimits() BridgeLimits {
+    return BridgeLimits{
+        MaxPerTx:            math.ZeroInt(),
+        MaxPerAddressDaily:  math.ZeroInt(),
+        WindowSeconds:       DefaultLimitWindowSeconds,
+        MaxMintPerWindow:    math.ZeroInt(),
+        MaxBurnPerWindow:    math.ZeroInt(),
+        MaxNetFlowPerWindow: math.ZeroInt(),
+    }
+}
+
+// DefaultWithdrawalQueue returns a withdrawal queue that queues nothing.
+func DefaultWithdrawalQueue() WithdrawalQueue {
+    return WithdrawalQueue{
+        Enabled:       false,
+        DelaySeconds:  DefaultWithdrawalDelaySeconds,
+        Threshold:     math.ZeroInt(),
+        ReleaseBudget: DefaultWithdrawalReleaseBudget,
+    }
+}
+
+// DefaultBridgeFees returns fees that charge nothing.
+func DefaultBridgeFees() BridgeFees {
+    return BridgeFees{
+        MintFeeRate:   math.LegacyZeroDec(),
+        RedeemFeeRate: math.LegacyZeroDec(),
+    }
+}
+
+// DefaultPriceOracle returns a price oracle with depeg handling disabled.
+func DefaultPriceOracle() PriceOracle {
+    return PriceOracle{
+        DepegThreshold: math.LegacyZeroDec(),
+        MaxAgeSeconds:  DefaultOracleMaxAgeSeconds,
+    }
+}
+
+// PegRatioDec returns the peg ratio as a decimal.
+func (p Params) PegRatioDec() (math.LegacyDec, error) {
+    return math.LegacyNewDecFromStr(p.PegRatio)
+}
+
+// feeOf returns the fee at rate on amount, treating an unset rate as zero.
+func feeOf(rate math.LegacyDec, amount math.Int) math.Int {
+    if rate.IsNil() {
+        return math.ZeroInt()
+    }
+    return math.LegacyNewDecFromInt(amount).Mul(rate).TruncateInt()
+}
+
+// MintFee returns the fee on minting amount TestUSD.
+func (f BridgeFees) MintFee(amount math.Int) math.Int {
+    return feeOf(f.MintFeeRate, amount)
+}
+
+// RedeemFee returns the fee on redeeming amount TestUSD.
+func (f BridgeFees) RedeemFee(amount math.Int) math.Int {
+    return feeOf(f.RedeemFeeRate, amount)
+}
+
+// Validate validates the bridge fees.
+func (f BridgeFees) Validate() error {
+    rates := map[string]math.LegacyDec{
+        "mint fee rate":   f.MintFeeRate,
+        "redeem fee rate": f.RedeemFeeRate,
+    }
+    charged := false
+    for name, rate := range rates {
+        if rate.IsNil() {
+            continue
+        }
+        if rate.IsNegative() || rate.GTE(math.LegacyOneDec()) {
+            return fmt.Errorf("%s must be in [0, 1)", name)
+        }
+        charged = charged || rate.IsPositive()
+    }
+
+    if f.Treasury != "" {
+        if _, err := sdk.AccAddressFromBech32(f.Treasury); err != nil {
+            return fmt.Errorf("invalid treasury address: %w", err)
+        }
+    } else if charged {
+        return fmt.Errorf("treasury is required when fees are charged")
+    }
+    return nil
+}
+
+// Validate validates the price oracle.
+func (o PriceOracle) Validate() error {
+    if o.DepegThreshold.IsNil() || o.DepegThreshold.IsZero() {
+        return nil
+    }
+    if o.DepegThreshold.IsNegative() || o.DepegThreshold.GT(math.LegacyOneDec()) {
+        return fmt.Errorf("depeg threshold must be in [0, 1]")
+    }
+    if _, err := sdk.AccAddressFromBech32(o.Oracle); err != nil {
+        return fmt.Errorf("invalid price oracle address: %w", err)
+    }
+    if o.MaxAgeSeconds == 0 {
+        return fmt.Errorf("oracle price max age cannot be zero")
+    }
+    return nil
+}
+
+// Queues reports whether a bridge out of amount TestUSD goes through the
+// withdrawal queue.
+func (q WithdrawalQueue) Queues(amount math.Int) bool {
+    return q.Enabled || (IsCapped(q.Threshold) && amount.GT(q.Threshold))
+}
+
+// Validate validates the withdrawal queue.
+func (q WithdrawalQueue) Validate() error {
+    if !q.Threshold.IsNil() && q.Threshold.IsNegative() {
+        return fmt.Errorf("withdrawal threshold cannot be negative")
+    }
+    if (q.Enabled || IsCapped(q.Threshold)) && q.DelaySeconds == 0 {
+        return fmt.Errorf("withdrawal delay cannot be zero")
+    }
+    if (q.Enabled || IsCapped(q.Threshold)) && q.ReleaseBudget == 0 {
+        return fmt.Errorf("withdrawal release budget must be positive when withdrawals are queued")
+    }
+    return nil
+}
+
+// IsCapped reports whether cap limits anything. Zero and unset caps are
+// unlimited.
+func IsCapped(cap math.Int) bool {
+    return !cap.IsNil() && cap.IsPositive()
+}
+
+// GetUsdcSource returns the USDC source on channelID.
+func (p Params) GetUsdcSource(channelID string) (UsdcSource, bool) {
+    for _, source := range p.UsdcSources {
+        if source.ChannelId == channelID {
+            return source, true
+        }
+    }
+    return UsdcSource{}, false
+}
+
+// VoucherDenom returns the ibc/ denom that USDC received from the source is
+// held in on this chain.
+func (s UsdcSource) VoucherDenom() string {
+    denom := transfertypes.ExtractDenomFromPath(s.Denom)
+    denom.Trace = append([]transfertypes.Hop{transfertypes.NewHop(transfertypes.PortID, s.ChannelId)}, denom.Trace...)
+    return denom.IBCDenom()
+}
+
+// CollateralDenoms returns the denoms USDC collateral is escrowed in: the
+// local USDC denom and the voucher denom of each source.
+func (p Params) CollateralDenoms() []string {
+    denoms := []string{p.UsdcDenom}
+    for _, source := range p.UsdcSources {
+        if denom := source.VoucherDenom(); denom != p.UsdcDenom {
+            denoms = append(denoms, denom)
+        }
+    }
+    return denoms
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -56,10 +248,64 @@ func (p Params) Validate() error {
     if err := validateDenom(p.UsdcDenom); err != nil {
         return err
     }
+
+    if err := validateUsdcSources(p.UsdcSources); err != nil {
+        return err
+    }
+
+    if len(p.UsdcSources) > 0 && p.IbcTimeoutSeconds == 0 {
+        return fmt.Errorf("ibc timeout cannot be zero")
+    }
+
+    if err := p.Limits.Validate(); err != nil {
+        return err
+    }
+
+    if p.Guardian != "" {
+        if _, err := sdk.AccAddressFromBech32(p.Guardian); err != nil {
+            return fmt.Errorf("invalid guardian address: %w", err)
+        }
+    }
+
+    if err := p.WithdrawalQueue.Validate(); err != nil {
+        return err
+    }
+
+    if err := p.Fees.Validate(); err != nil {
+        return err
+    }
+
+    if err := p.PriceOracle.Validate(); err != nil {
+        return err
+    }
+
+    if p.Attestor != "" {
+        if _, err := sdk.AccAddressFromBech32(p.Attestor); err != nil {
+            return fmt.Errorf("invalid attestor address: %w", err)
+        }
+    }
     
     return nil
 }
 
+func validateUsdcSources(sources []UsdcSource) error {
+    seen := make(map[string]bool)
+    for _, source := range sources {
+        if err := host.ChannelIdentifierValidator(source.ChannelId); err != nil {
+            return fmt.Errorf("invalid usdc source channel %q: %w", source.ChannelId, err)
+        }
+        if seen[source.ChannelId] {
+            return fmt.Errorf("duplicate usdc source channel %s", source.ChannelId)
+        }
+        seen[source.ChannelId] = true
+
+        if err := transfertypes.ExtractDenomFromPath(source.Denom).Validate(); err != nil {
+            return fmt.Errorf("invalid usdc source denom %q: %w", source.Denom, err)
+        }
+    }
+    return nil
+}
+
 func validateBridgeEnabled(i interface{}) error {
     _, ok := i.(bool)
     if !ok {
@@ -78,7 +324,13 @@ func validatePegRatio(i interface{}) error {
         return fmt.Errorf("peg ratio cannot be empty")
     }
     
-    // TODO: Add decimal validation
+    ratio, err := math.LegacyNewDecFromStr(v)
+    if err != nil {
+        return fmt.Errorf("invalid peg ratio %q: %w", v, err)
+    }
+    if !ratio.IsPositive() {
+        return fmt.Errorf("peg ratio must be positive")
+    }
     return nil
 }
 
@@ -94,3 +346,25 @@ func validateDenom(i interface{}) error {
     
     return nil
 }
+
+// Validate validates the bridge limits.
+func (l BridgeLimits) Validate() error {
+    caps := map[string]math.Int{
+        "max per tx":              l.MaxPerTx,
+        "max per address daily":   l.MaxPerAddressDaily,
+        "max mint per window":     l.MaxMintPerWindow,
+        "max burn per window":     l.MaxBurnPerWindow,
+        "max net flow per window": l.MaxNetFlowPerWindow,
+    }
+    for name, cap := range caps {
+        if !cap.IsNil() && cap.IsNegative() {
+            return fmt.Errorf("%s cannot be negative", name)
+        }
+    }
+
+    windowCapped := IsCapped(l.MaxMintPerWindow) || IsCapped(l.MaxBurnPerWindow) || IsCapped(l.MaxNetFlowPerWindow)
+    if windowCapped && l.WindowSeconds == 0 {
+        return fmt.Errorf("limit window cannot be zero")
+    }
+    return nil
+}
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -28,7 +30,7 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// bridge_enabled controls whether bridging is enabled
 	BridgeEnabled bool `protobuf:"varint,1,opt,name=bridge_enabled,json=bridgeEnabled,proto3" json:"bridge_enabled,omitempty"`
-	// peg_ratio defines the ratio for USDC:TestUSD (should always be "1.0")
+	// peg_ratio is the TestUSD minted per USDC, as a decimal such as "1.0"
 	PegRatio string `protobuf:"bytes,2,opt,name=peg_ratio,json=pegRatio,proto3" json:"peg_ratio,omitempty"`
 	// testusd_denom is the denomination for TestUSD tokens
 	TestusdDenom string `protobuf:"bytes,3,opt,name=testusd_denom,json=testusdDenom,proto3" json:"testusd_denom,omitempty"`
@@ -36,6 +38,23 @@ type Params struct {
 	UsdcDenom string `protobuf:"bytes,4,opt,name=usdc_denom,json=usdcDenom,proto3" json:"usdc_denom,omitempty"`
 	// bridge_address is the module account that holds bridged USDC
 	BridgeAddress string `protobuf:"bytes,5,opt,name=bridge_address,json=bridgeAddress,proto3" json:"bridge_address,omitempty"`
+	// usdc_sources lists the ICS-20 channels and denom traces whose incoming
+	// vouchers are escrowed as USDC collateral
+	UsdcSources []UsdcSource `protobuf:"bytes,6,rep,name=usdc_sources,json=usdcSources,proto3" json:"usdc_sources"`
+	// ibc_timeout_seconds is the timeout of USDC sent back over ICS-20
+	IbcTimeoutSeconds uint64 `protobuf:"varint,7,opt,name=ibc_timeout_seconds,json=ibcTimeoutSeconds,proto3" json:"ibc_timeout_seconds,omitempty"`
+	// limits caps how much TestUSD can be minted and burned
+	Limits BridgeLimits `protobuf:"bytes,8,opt,name=limits,proto3" json:"limits"`
+	// guardian is an address that can pause the bridge but not unpause it
+	Guardian string `protobuf:"bytes,9,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// attestor is the address allowed to post off-chain reserve attestations
+	Attestor string `protobuf:"bytes,10,opt,name=attestor,proto3" json:"attestor,omitempty"`
+	// withdrawal_queue delays bridge outs so they can be reviewed
+	WithdrawalQueue WithdrawalQueue `protobuf:"bytes,11,opt,name=withdrawal_queue,json=withdrawalQueue,proto3" json:"withdrawal_queue"`
+	// fees are charged on bridging in and out and paid to the treasury
+	Fees BridgeFees `protobuf:"bytes,12,opt,name=fees,proto3" json:"fees"`
+	// price_oracle configures the oracle-fed USDC price used to detect a depeg
+	PriceOracle PriceOracle `protobuf:"bytes,13,opt,name=price_oracle,json=priceOracle,proto3" json:"price_oracle"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -106,32 +125,431 @@ func (m *Params) GetBridgeAddress() string {
 	return ""
 }
 
+func (m *Params) GetUsdcSources() []UsdcSource {
+	if m != nil {
+		return m.UsdcSources
+	}
+	return nil
+}
+
+func (m *Params) GetIbcTimeoutSeconds() uint64 {
+	if m != nil {
+		return m.IbcTimeoutSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetLimits() BridgeLimits {
+	if m != nil {
+		return m.Limits
+	}
+	return BridgeLimits{}
+}
+
+func (m *Params) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+func (m *Params) GetAttestor() string {
+	if m != nil {
+		return m.Attestor
+	}
+	return ""
+}
+
+func (m *Params) GetWithdrawalQueue() WithdrawalQueue {
+	if m != nil {
+		return m.WithdrawalQueue
+	}
+	return WithdrawalQueue{}
+}
+
+func (m *Params) GetFees() BridgeFees {
+	if m != nil {
+		return m.Fees
+	}
+	return BridgeFees{}
+}
+
+func (m *Params) GetPriceOracle() PriceOracle {
+	if m != nil {
+		return m.PriceOracle
+	}
+	return PriceOracle{}
+}
+
+// BridgeFees are the fees charged in TestUSD on bridging in and out.
+type BridgeFees struct {
+	// mint_fee_rate is the share of minted TestUSD paid to the treasury
+	MintFeeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=mint_fee_rate,json=mintFeeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"mint_fee_rate"`
+	// redeem_fee_rate is the share of redeemed TestUSD paid to the treasury
+	// instead of being burned
+	RedeemFeeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=redeem_fee_rate,json=redeemFeeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"redeem_fee_rate"`
+	// treasury receives the fees
+	Treasury string `protobuf:"bytes,3,opt,name=treasury,proto3" json:"treasury,omitempty"`
+}
+
+func (m *BridgeFees) Reset()         { *m = BridgeFees{} }
+func (m *BridgeFees) String() string { return proto.CompactTextString(m) }
+func (*BridgeFees) ProtoMessage()    {}
+func (*BridgeFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f9fbb1cabae6426, []int{1}
+}
+func (m *BridgeFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeFees.Merge(m, src)
+}
+func (m *BridgeFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeFees proto.InternalMessageInfo
+
+func (m *BridgeFees) GetTreasury() string {
+	if m != nil {
+		return m.Treasury
+	}
+	return ""
+}
+
+// PriceOracle configures the feed of the USDC price. While a fresh price is
+// below the depeg threshold, minting credits USDC at that price and
+// redemptions are paid pro rata to the escrowed collateral.
+type PriceOracle struct {
+	// oracle is the address allowed to post the USDC price
+	Oracle string `protobuf:"bytes,1,opt,name=oracle,proto3" json:"oracle,omitempty"`
+	// depeg_threshold is the USDC price below which it counts as depegged.
+	// Zero disables depeg handling.
+	DepegThreshold cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=depeg_threshold,json=depegThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"depeg_threshold"`
+	// max_age_seconds is how long a posted price stays fresh
+	MaxAgeSeconds uint64 `protobuf:"varint,3,opt,name=max_age_seconds,json=maxAgeSeconds,proto3" json:"max_age_seconds,omitempty"`
+}
+
+func (m *PriceOracle) Reset()         { *m = PriceOracle{} }
+func (m *PriceOracle) String() string { return proto.CompactTextString(m) }
+func (*PriceOracle) ProtoMessage()    {}
+func (*PriceOracle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f9fbb1cabae6426, []int{2}
+}
+func (m *PriceOracle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceOracle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceOracle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceOracle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceOracle.Merge(m, src)
+}
+func (m *PriceOracle) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceOracle) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceOracle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceOracle proto.InternalMessageInfo
+
+func (m *PriceOracle) GetOracle() string {
+	if m != nil {
+		return m.Oracle
+	}
+	return ""
+}
+
+func (m *PriceOracle) GetMaxAgeSeconds() uint64 {
+	if m != nil {
+		return m.MaxAgeSeconds
+	}
+	return 0
+}
+
+// WithdrawalQueue holds bridge outs for a review delay before the USDC is
+// released.
+type WithdrawalQueue struct {
+	// enabled queues every bridge out
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// delay_seconds is how long a queued bridge out waits unless the
+	// authority approves it earlier
+	DelaySeconds uint64 `protobuf:"varint,2,opt,name=delay_seconds,json=delaySeconds,proto3" json:"delay_seconds,omitempty"`
+	// threshold queues bridge outs above it even when the queue is not
+	// enabled. Zero disables it.
+	Threshold cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=threshold,proto3,customtype=cosmossdk.io/math.Int" json:"threshold"`
+	// release_budget is the maximum number of due withdrawals released in one
+	// block. The rest are released in the blocks after.
+	ReleaseBudget uint64 `protobuf:"varint,4,opt,name=release_budget,json=releaseBudget,proto3" json:"release_budget,omitempty"`
+}
+
+func (m *WithdrawalQueue) Reset()         { *m = WithdrawalQueue{} }
+func (m *WithdrawalQueue) String() string { return proto.CompactTextString(m) }
+func (*WithdrawalQueue) ProtoMessage()    {}
+func (*WithdrawalQueue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f9fbb1cabae6426, []int{3}
+}
+func (m *WithdrawalQueue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawalQueue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawalQueue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawalQueue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawalQueue.Merge(m, src)
+}
+func (m *WithdrawalQueue) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawalQueue) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawalQueue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawalQueue proto.InternalMessageInfo
+
+func (m *WithdrawalQueue) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *WithdrawalQueue) GetDelaySeconds() uint64 {
+	if m != nil {
+		return m.DelaySeconds
+	}
+	return 0
+}
+
+func (m *WithdrawalQueue) GetReleaseBudget() uint64 {
+	if m != nil {
+		return m.ReleaseBudget
+	}
+	return 0
+}
+
+// BridgeLimits caps bridge flows, in TestUSD base units. A zero cap is
+// unlimited.
+type BridgeLimits struct {
+	// max_per_tx caps a single bridge in or out
+	MaxPerTx cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=max_per_tx,json=maxPerTx,proto3,customtype=cosmossdk.io/math.Int" json:"max_per_tx"`
+	// max_per_address_daily caps what one address can mint, and separately
+	// burn, per UTC day
+	MaxPerAddressDaily cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=max_per_address_daily,json=maxPerAddressDaily,proto3,customtype=cosmossdk.io/math.Int" json:"max_per_address_daily"`
+	// window_seconds is the length of the rolling window of the global caps
+	WindowSeconds uint64 `protobuf:"varint,3,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	// max_mint_per_window caps the TestUSD minted by all addresses in the window
+	MaxMintPerWindow cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_mint_per_window,json=maxMintPerWindow,proto3,customtype=cosmossdk.io/math.Int" json:"max_mint_per_window"`
+	// max_burn_per_window caps the TestUSD burned by all addresses in the window
+	MaxBurnPerWindow cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=max_burn_per_window,json=maxBurnPerWindow,proto3,customtype=cosmossdk.io/math.Int" json:"max_burn_per_window"`
+	// max_net_flow_per_window pauses the bridge once minted and burned TestUSD
+	// in the window differ by more than it
+	MaxNetFlowPerWindow cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=max_net_flow_per_window,json=maxNetFlowPerWindow,proto3,customtype=cosmossdk.io/math.Int" json:"max_net_flow_per_window"`
+}
+
+func (m *BridgeLimits) Reset()         { *m = BridgeLimits{} }
+func (m *BridgeLimits) String() string { return proto.CompactTextString(m) }
+func (*BridgeLimits) ProtoMessage()    {}
+func (*BridgeLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f9fbb1cabae6426, []int{4}
+}
+func (m *BridgeLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeLimits.Merge(m, src)
+}
+func (m *BridgeLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeLimits proto.InternalMessageInfo
+
+func (m *BridgeLimits) GetWindowSeconds() uint64 {
+	if m != nil {
+		return m.WindowSeconds
+	}
+	return 0
+}
+
+// UsdcSource is an ICS-20 channel that USDC is bridged in over.
+type UsdcSource struct {
+	// channel_id is this chain's end of the transfer channel
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// denom is the USDC denom trace as sent by the counterparty, e.g. "uusdc"
+	// or "transfer/channel-3/uusdc"
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *UsdcSource) Reset()         { *m = UsdcSource{} }
+func (m *UsdcSource) String() string { return proto.CompactTextString(m) }
+func (*UsdcSource) ProtoMessage()    {}
+func (*UsdcSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f9fbb1cabae6426, []int{5}
+}
+func (m *UsdcSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UsdcSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UsdcSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UsdcSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsdcSource.Merge(m, src)
+}
+func (m *UsdcSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *UsdcSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsdcSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsdcSource proto.InternalMessageInfo
+
+func (m *UsdcSource) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *UsdcSource) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "mychain.testusd.v1.Params")
+	proto.RegisterType((*BridgeFees)(nil), "mychain.testusd.v1.BridgeFees")
+	proto.RegisterType((*PriceOracle)(nil), "mychain.testusd.v1.PriceOracle")
+	proto.RegisterType((*WithdrawalQueue)(nil), "mychain.testusd.v1.WithdrawalQueue")
+	proto.RegisterType((*BridgeLimits)(nil), "mychain.testusd.v1.BridgeLimits")
+	proto.RegisterType((*UsdcSource)(nil), "mychain.testusd.v1.UsdcSource")
 }
 
 func init() { proto.RegisterFile("mychain/testusd/v1/params.proto", fileDescriptor_9f9fbb1cabae6426) }
 
 var fileDescriptor_9f9fbb1cabae6426 = []byte{
-	// 276 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0xad, 0x4c, 0xce,
-	0x48, 0xcc, 0xcc, 0xd3, 0x2f, 0x49, 0x2d, 0x2e, 0x29, 0x2d, 0x4e, 0xd1, 0x2f, 0x33, 0xd4, 0x2f,
-	0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0x2a, 0xd0,
-	0x83, 0x2a, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10,
-	0x65, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x11, 0x55, 0xba, 0xc5,
-	0xc8, 0xc5, 0x16, 0x00, 0x36, 0x4d, 0x48, 0x95, 0x8b, 0x2f, 0xa9, 0x28, 0x33, 0x25, 0x3d, 0x35,
-	0x3e, 0x35, 0x2f, 0x31, 0x29, 0x27, 0x35, 0x45, 0x82, 0x51, 0x81, 0x51, 0x83, 0x23, 0x88, 0x17,
-	0x22, 0xea, 0x0a, 0x11, 0x14, 0x92, 0xe6, 0xe2, 0x2c, 0x48, 0x4d, 0x8f, 0x2f, 0x4a, 0x2c, 0xc9,
-	0xcc, 0x97, 0x60, 0x52, 0x60, 0xd4, 0xe0, 0x0c, 0xe2, 0x28, 0x48, 0x4d, 0x0f, 0x02, 0xf1, 0x85,
-	0x94, 0xb9, 0x78, 0xa1, 0xae, 0x88, 0x4f, 0x49, 0xcd, 0xcb, 0xcf, 0x95, 0x60, 0x06, 0x2b, 0xe0,
-	0x81, 0x0a, 0xba, 0x80, 0xc4, 0x84, 0x64, 0xb9, 0xb8, 0x4a, 0x8b, 0x53, 0x92, 0xa1, 0x2a, 0x58,
-	0xc0, 0x2a, 0x38, 0x41, 0x22, 0x10, 0x69, 0x84, 0x3b, 0x12, 0x53, 0x52, 0x8a, 0x52, 0x8b, 0x8b,
-	0x25, 0x58, 0xc1, 0x4a, 0xa0, 0xee, 0x70, 0x84, 0x08, 0x5a, 0x29, 0xbe, 0x58, 0x20, 0xcf, 0xd8,
-	0xf5, 0x7c, 0x83, 0x96, 0x04, 0x2c, 0x80, 0x2a, 0xe0, 0x41, 0x04, 0xf1, 0x91, 0x93, 0xe1, 0x89,
-	0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x89, 0x63, 0xea, 0x29, 0xa9, 0x2c, 0x48,
-	0x2d, 0x4e, 0x62, 0x03, 0x07, 0x8b, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xcd, 0x6f, 0xd7, 0xb9,
-	0x76, 0x01, 0x00, 0x00,
+	// 948 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x26, 0x8e, 0x6b, 0x8f, 0xed, 0xba, 0x9d, 0xa6, 0xea, 0xd2, 0x0a, 0xdb, 0xa4, 0x02,
+	0x59, 0x45, 0xb5, 0x49, 0xe9, 0xa9, 0x12, 0x87, 0x9a, 0x10, 0x64, 0x29, 0x85, 0xb0, 0x49, 0x55,
+	0x35, 0x07, 0x56, 0xe3, 0x9d, 0x97, 0xf5, 0x8a, 0xdd, 0x1d, 0x33, 0x33, 0x5b, 0xdb, 0x47, 0xae,
+	0x9c, 0xf8, 0x13, 0x38, 0x72, 0xec, 0xa1, 0x7f, 0x44, 0xb8, 0x55, 0x3d, 0x21, 0x0e, 0x15, 0x4a,
+	0x0e, 0xe5, 0xcc, 0x91, 0x13, 0x9a, 0x1f, 0x6b, 0x3b, 0x4d, 0x50, 0x94, 0x5c, 0xa2, 0xcc, 0x37,
+	0xdf, 0x7c, 0xf3, 0xbd, 0xb7, 0xef, 0xbd, 0x31, 0x6a, 0x26, 0xd3, 0x60, 0x48, 0xa2, 0xb4, 0x2b,
+	0x41, 0xc8, 0x4c, 0xd0, 0xee, 0x8b, 0x8d, 0xee, 0x88, 0x70, 0x92, 0x88, 0xce, 0x88, 0x33, 0xc9,
+	0x30, 0xb6, 0x84, 0x8e, 0x25, 0x74, 0x5e, 0x6c, 0xdc, 0xbe, 0x4e, 0x92, 0x28, 0x65, 0x5d, 0xfd,
+	0xd7, 0xd0, 0x6e, 0x7f, 0x10, 0x30, 0x91, 0x30, 0xe1, 0xeb, 0x55, 0xd7, 0x2c, 0xec, 0xd6, 0x5a,
+	0xc8, 0x42, 0x66, 0x70, 0xf5, 0x9f, 0x41, 0xd7, 0xff, 0x59, 0x45, 0xc5, 0x1d, 0x7d, 0x11, 0xfe,
+	0x18, 0x5d, 0x1d, 0xf0, 0x88, 0x86, 0xe0, 0x43, 0x4a, 0x06, 0x31, 0x50, 0xd7, 0x69, 0x39, 0xed,
+	0x92, 0x57, 0x33, 0xe8, 0x57, 0x06, 0xc4, 0x77, 0x50, 0x79, 0x04, 0xa1, 0xcf, 0x89, 0x8c, 0x98,
+	0xbb, 0xdc, 0x72, 0xda, 0x65, 0xaf, 0x34, 0x82, 0xd0, 0x53, 0x6b, 0x7c, 0x17, 0xd5, 0xac, 0x41,
+	0x9f, 0x42, 0xca, 0x12, 0x77, 0x45, 0x13, 0xaa, 0x16, 0xdc, 0x54, 0x18, 0xfe, 0x10, 0xa1, 0x4c,
+	0xd0, 0xc0, 0x32, 0x0a, 0x9a, 0x51, 0x56, 0x88, 0xd9, 0x9e, 0xfb, 0x20, 0x94, 0x72, 0x10, 0xc2,
+	0x5d, 0xd5, 0x14, 0xeb, 0xe3, 0xb1, 0x01, 0xf1, 0x36, 0xaa, 0x6a, 0x15, 0xc1, 0x32, 0x1e, 0x80,
+	0x70, 0x8b, 0xad, 0x95, 0x76, 0xe5, 0x41, 0xa3, 0x73, 0x3a, 0x51, 0x9d, 0xa7, 0x82, 0x06, 0xbb,
+	0x9a, 0xd6, 0x2b, 0x1f, 0xbe, 0x6d, 0x2e, 0xfd, 0xf6, 0xee, 0xe5, 0x3d, 0xc7, 0xab, 0x64, 0x33,
+	0x58, 0xe0, 0x0e, 0xba, 0x11, 0x0d, 0x02, 0x5f, 0x46, 0x09, 0xb0, 0x4c, 0xfa, 0x02, 0x02, 0x96,
+	0x52, 0xe1, 0x5e, 0x69, 0x39, 0xed, 0x82, 0x77, 0x3d, 0x1a, 0x04, 0x7b, 0x66, 0x67, 0xd7, 0x6c,
+	0xe0, 0x2f, 0x51, 0x31, 0x8e, 0x92, 0x48, 0x0a, 0xb7, 0xd4, 0x72, 0xda, 0x95, 0x07, 0xad, 0xb3,
+	0xee, 0xed, 0x69, 0xc3, 0xdb, 0x9a, 0xb7, 0x78, 0xb3, 0x3d, 0x8a, 0x1f, 0xa2, 0x52, 0x98, 0x11,
+	0x4e, 0x23, 0x92, 0xba, 0x65, 0x15, 0x63, 0xcf, 0x7d, 0xf3, 0xea, 0xfe, 0x9a, 0xfd, 0x6c, 0x36,
+	0xd0, 0x5d, 0xc9, 0xa3, 0x34, 0xf4, 0x66, 0x4c, 0x75, 0x8a, 0x48, 0x75, 0x0b, 0xe3, 0x2e, 0x3a,
+	0xef, 0x54, 0xce, 0xc4, 0xcf, 0xd1, 0xb5, 0x71, 0x24, 0x87, 0x94, 0x93, 0x31, 0x89, 0xfd, 0x1f,
+	0x33, 0xc8, 0xc0, 0xad, 0x68, 0xeb, 0x77, 0xcf, 0xb2, 0xfe, 0x6c, 0xc6, 0xfd, 0x4e, 0x51, 0x17,
+	0xdd, 0xd7, 0xc7, 0x27, 0xf7, 0xf0, 0x17, 0xa8, 0x70, 0x00, 0x20, 0xdc, 0x6a, 0xcb, 0xf9, 0xbf,
+	0x2f, 0x60, 0x32, 0xb1, 0x05, 0x70, 0x22, 0x0f, 0xfa, 0x18, 0x7e, 0x82, 0xaa, 0x23, 0x1e, 0x05,
+	0xe0, 0x33, 0x4e, 0x82, 0x18, 0xdc, 0x9a, 0x96, 0x69, 0x9e, 0x25, 0xb3, 0xa3, 0x78, 0xdf, 0x6a,
+	0xda, 0x89, 0x2f, 0x39, 0x9a, 0xe3, 0x8f, 0x3e, 0xfa, 0xfb, 0xd7, 0xa6, 0xf3, 0xf3, 0xbb, 0x97,
+	0xf7, 0xdc, 0xbc, 0xa7, 0x26, 0xb3, 0xae, 0x32, 0x95, 0xbe, 0xfe, 0xaf, 0x83, 0xd0, 0xdc, 0x11,
+	0x7e, 0x8a, 0x6a, 0x49, 0x94, 0x4a, 0xff, 0x00, 0x40, 0x95, 0x35, 0xe8, 0xba, 0x2f, 0xf7, 0x36,
+	0xd4, 0x05, 0x7f, 0xbe, 0x6d, 0xde, 0x31, 0x99, 0x15, 0xf4, 0x87, 0x4e, 0xc4, 0xba, 0x09, 0x91,
+	0xc3, 0xce, 0x36, 0x84, 0x24, 0x98, 0x6e, 0x42, 0xf0, 0xe6, 0xd5, 0x7d, 0x64, 0x13, 0xbf, 0x09,
+	0x81, 0x57, 0x51, 0x3a, 0x5b, 0x00, 0x1e, 0x91, 0x80, 0x9f, 0xa3, 0x3a, 0x07, 0x0a, 0x90, 0xcc,
+	0x85, 0x97, 0x2f, 0x2b, 0x5c, 0x33, 0x4a, 0xb9, 0xf4, 0x43, 0x54, 0x92, 0x1c, 0x88, 0xc8, 0xf8,
+	0xd4, 0x5d, 0x39, 0xaf, 0x04, 0x72, 0xe6, 0xa3, 0x82, 0xca, 0xcc, 0xfa, 0xef, 0x0e, 0xaa, 0x2c,
+	0xe4, 0x11, 0x7f, 0x86, 0x8a, 0x36, 0xf1, 0xce, 0x39, 0x4a, 0x96, 0x87, 0xf7, 0x51, 0x9d, 0x82,
+	0x9a, 0x01, 0x72, 0xc8, 0x41, 0x0c, 0x59, 0x4c, 0x2f, 0x1f, 0xd8, 0x55, 0xad, 0xb4, 0x97, 0x0b,
+	0xe1, 0x4f, 0x50, 0x3d, 0x21, 0x13, 0x9f, 0x84, 0x30, 0xeb, 0xc1, 0x15, 0xdd, 0x83, 0xb5, 0x84,
+	0x4c, 0x1e, 0x87, 0x60, 0xfb, 0xcf, 0xc6, 0x72, 0xe8, 0xa0, 0xfa, 0x7b, 0x95, 0x8a, 0x5d, 0x74,
+	0xe5, 0xe4, 0xfc, 0xca, 0x97, 0x6a, 0x38, 0x51, 0x88, 0xc9, 0x74, 0xa6, 0xbc, 0xac, 0x95, 0xab,
+	0x1a, 0xcc, 0x1b, 0xbb, 0x8f, 0xca, 0xf3, 0xb0, 0x4c, 0x6e, 0x3f, 0xb5, 0x61, 0xdd, 0x3c, 0x1d,
+	0x56, 0x3f, 0x95, 0x0b, 0x01, 0xf5, 0x53, 0xe9, 0xcd, 0x4f, 0xab, 0x41, 0xc6, 0x21, 0x06, 0x22,
+	0xc0, 0x1f, 0x64, 0x34, 0x04, 0xa9, 0x67, 0x5d, 0xc1, 0xab, 0x59, 0xb4, 0xa7, 0x41, 0x1b, 0xca,
+	0x4f, 0x05, 0x54, 0x5d, 0x9c, 0x17, 0xb8, 0x8f, 0x90, 0xca, 0xc4, 0x08, 0xb8, 0x2f, 0x27, 0xae,
+	0x73, 0x71, 0x27, 0xa5, 0x84, 0x4c, 0x76, 0x80, 0xef, 0x4d, 0xf0, 0xf7, 0xe8, 0x66, 0x2e, 0x65,
+	0x47, 0xaa, 0x4f, 0x49, 0x14, 0x4f, 0xdd, 0xe5, 0x8b, 0xab, 0x62, 0xa3, 0x6a, 0x2b, 0x63, 0x53,
+	0xc9, 0xa8, 0x40, 0xc7, 0x51, 0x4a, 0xd9, 0xf8, 0xfd, 0x6f, 0x66, 0xd0, 0x3c, 0xb5, 0xfb, 0xe8,
+	0x86, 0xb2, 0xa1, 0x7b, 0x4d, 0x79, 0x31, 0xbb, 0x6e, 0xe1, 0xe2, 0x26, 0xae, 0x25, 0x64, 0xf2,
+	0x24, 0x4a, 0xe5, 0x0e, 0xf0, 0x67, 0x5a, 0x24, 0xd7, 0x1e, 0x64, 0x3c, 0x5d, 0xd4, 0x5e, 0xbd,
+	0x9c, 0x76, 0x2f, 0xe3, 0xe9, 0x5c, 0x9b, 0xa0, 0x5b, 0x4a, 0x3b, 0x05, 0xe9, 0x1f, 0xc4, 0x6c,
+	0xbc, 0xa8, 0x5f, 0xbc, 0xb8, 0xbe, 0xf2, 0xf9, 0x0d, 0xc8, 0xad, 0x98, 0x8d, 0x67, 0x57, 0xd8,
+	0x1a, 0xf8, 0x1a, 0xa1, 0xf9, 0x53, 0xa5, 0x9e, 0xc9, 0x60, 0x48, 0xd2, 0x14, 0x62, 0x3f, 0x32,
+	0xb5, 0x5c, 0xf6, 0xca, 0x16, 0xe9, 0x53, 0xbc, 0x86, 0x56, 0xcd, 0x03, 0x6a, 0xde, 0x60, 0xb3,
+	0x30, 0x42, 0xbd, 0x8d, 0xc3, 0xa3, 0x86, 0xf3, 0xfa, 0xa8, 0xe1, 0xfc, 0x75, 0xd4, 0x70, 0x7e,
+	0x39, 0x6e, 0x2c, 0xbd, 0x3e, 0x6e, 0x2c, 0xfd, 0x71, 0xdc, 0x58, 0xda, 0xbf, 0x75, 0x7a, 0x28,
+	0xca, 0xe9, 0x08, 0xc4, 0xa0, 0xa8, 0x7f, 0x0f, 0x7c, 0xfe, 0xdf, 0x00, 0x88, 0x1f, 0x2f, 0x5e,
+	0x8a, 0x08, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -168,55 +586,316 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.BridgeAddress != that1.BridgeAddress {
 		return false
 	}
+	if len(this.UsdcSources) != len(that1.UsdcSources) {
+		return false
+	}
+	for i := range this.UsdcSources {
+		if !this.UsdcSources[i].Equal(&that1.UsdcSources[i]) {
+			return false
+		}
+	}
+	if this.IbcTimeoutSeconds != that1.IbcTimeoutSeconds {
+		return false
+	}
+	if !this.Limits.Equal(&that1.Limits) {
+		return false
+	}
+	if this.Guardian != that1.Guardian {
+		return false
+	}
+	if this.Attestor != that1.Attestor {
+		return false
+	}
+	if !this.WithdrawalQueue.Equal(&that1.WithdrawalQueue) {
+		return false
+	}
+	if !this.Fees.Equal(&that1.Fees) {
+		return false
+	}
+	if !this.PriceOracle.Equal(&that1.PriceOracle) {
+		return false
+	}
 	return true
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *BridgeFees) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BridgeAddress) > 0 {
-		i -= len(m.BridgeAddress)
-		copy(dAtA[i:], m.BridgeAddress)
-		i = encodeVarintParams(dAtA, i, uint64(len(m.BridgeAddress)))
-		i--
-		dAtA[i] = 0x2a
+	that1, ok := that.(*BridgeFees)
+	if !ok {
+		that2, ok := that.(BridgeFees)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.UsdcDenom) > 0 {
-		i -= len(m.UsdcDenom)
-		copy(dAtA[i:], m.UsdcDenom)
-		i = encodeVarintParams(dAtA, i, uint64(len(m.UsdcDenom)))
-		i--
-		dAtA[i] = 0x22
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.TestusdDenom) > 0 {
-		i -= len(m.TestusdDenom)
-		copy(dAtA[i:], m.TestusdDenom)
-		i = encodeVarintParams(dAtA, i, uint64(len(m.TestusdDenom)))
-		i--
-		dAtA[i] = 0x1a
+	if !this.MintFeeRate.Equal(that1.MintFeeRate) {
+		return false
 	}
-	if len(m.PegRatio) > 0 {
-		i -= len(m.PegRatio)
-		copy(dAtA[i:], m.PegRatio)
-		i = encodeVarintParams(dAtA, i, uint64(len(m.PegRatio)))
-		i--
-		dAtA[i] = 0x12
+	if !this.RedeemFeeRate.Equal(that1.RedeemFeeRate) {
+		return false
+	}
+	if this.Treasury != that1.Treasury {
+		return false
+	}
+	return true
+}
+func (this *PriceOracle) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PriceOracle)
+	if !ok {
+		that2, ok := that.(PriceOracle)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Oracle != that1.Oracle {
+		return false
+	}
+	if !this.DepegThreshold.Equal(that1.DepegThreshold) {
+		return false
+	}
+	if this.MaxAgeSeconds != that1.MaxAgeSeconds {
+		return false
+	}
+	return true
+}
+func (this *WithdrawalQueue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WithdrawalQueue)
+	if !ok {
+		that2, ok := that.(WithdrawalQueue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.DelaySeconds != that1.DelaySeconds {
+		return false
+	}
+	if !this.Threshold.Equal(that1.Threshold) {
+		return false
+	}
+	if this.ReleaseBudget != that1.ReleaseBudget {
+		return false
+	}
+	return true
+}
+func (this *BridgeLimits) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BridgeLimits)
+	if !ok {
+		that2, ok := that.(BridgeLimits)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MaxPerTx.Equal(that1.MaxPerTx) {
+		return false
+	}
+	if !this.MaxPerAddressDaily.Equal(that1.MaxPerAddressDaily) {
+		return false
+	}
+	if this.WindowSeconds != that1.WindowSeconds {
+		return false
+	}
+	if !this.MaxMintPerWindow.Equal(that1.MaxMintPerWindow) {
+		return false
+	}
+	if !this.MaxBurnPerWindow.Equal(that1.MaxBurnPerWindow) {
+		return false
+	}
+	if !this.MaxNetFlowPerWindow.Equal(that1.MaxNetFlowPerWindow) {
+		return false
+	}
+	return true
+}
+func (this *UsdcSource) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UsdcSource)
+	if !ok {
+		that2, ok := that.(UsdcSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ChannelId != that1.ChannelId {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PriceOracle.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size, err := m.Fees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.WithdrawalQueue.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.Attestor) > 0 {
+		i -= len(m.Attestor)
+		copy(dAtA[i:], m.Attestor)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Attestor)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		size, err := m.Limits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.IbcTimeoutSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.IbcTimeoutSeconds))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.UsdcSources) > 0 {
+		for iNdEx := len(m.UsdcSources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UsdcSources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.BridgeAddress) > 0 {
+		i -= len(m.BridgeAddress)
+		copy(dAtA[i:], m.BridgeAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.BridgeAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.UsdcDenom) > 0 {
+		i -= len(m.UsdcDenom)
+		copy(dAtA[i:], m.UsdcDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.UsdcDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TestusdDenom) > 0 {
+		i -= len(m.TestusdDenom)
+		copy(dAtA[i:], m.TestusdDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.TestusdDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PegRatio) > 0 {
+		i -= len(m.PegRatio)
+		copy(dAtA[i:], m.PegRatio)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.PegRatio)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if m.BridgeEnabled {
 		i--
@@ -225,58 +904,1314 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		} else {
 			dAtA[i] = 0
 		}
-		i--
-		dAtA[i] = 0x8
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Treasury) > 0 {
+		i -= len(m.Treasury)
+		copy(dAtA[i:], m.Treasury)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Treasury)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.RedeemFeeRate.Size()
+		i -= size
+		if _, err := m.RedeemFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MintFeeRate.Size()
+		i -= size
+		if _, err := m.MintFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceOracle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceOracle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceOracle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxAgeSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAgeSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.DepegThreshold.Size()
+		i -= size
+		if _, err := m.DepegThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Oracle) > 0 {
+		i -= len(m.Oracle)
+		copy(dAtA[i:], m.Oracle)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Oracle)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WithdrawalQueue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawalQueue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawalQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReleaseBudget != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReleaseBudget))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.DelaySeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DelaySeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxNetFlowPerWindow.Size()
+		i -= size
+		if _, err := m.MaxNetFlowPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxBurnPerWindow.Size()
+		i -= size
+		if _, err := m.MaxBurnPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxMintPerWindow.Size()
+		i -= size
+		if _, err := m.MaxMintPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.WindowSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WindowSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MaxPerAddressDaily.Size()
+		i -= size
+		if _, err := m.MaxPerAddressDaily.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxPerTx.Size()
+		i -= size
+		if _, err := m.MaxPerTx.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UsdcSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UsdcSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UsdcSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BridgeEnabled {
+		n += 2
+	}
+	l = len(m.PegRatio)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.TestusdDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.UsdcDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.BridgeAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.UsdcSources) > 0 {
+		for _, e := range m.UsdcSources {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.IbcTimeoutSeconds != 0 {
+		n += 1 + sovParams(uint64(m.IbcTimeoutSeconds))
+	}
+	l = m.Limits.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Attestor)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.WithdrawalQueue.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Fees.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.PriceOracle.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *BridgeFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MintFeeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.RedeemFeeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.Treasury)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *PriceOracle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Oracle)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.DepegThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxAgeSeconds != 0 {
+		n += 1 + sovParams(uint64(m.MaxAgeSeconds))
+	}
+	return n
+}
+
+func (m *WithdrawalQueue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.DelaySeconds != 0 {
+		n += 1 + sovParams(uint64(m.DelaySeconds))
+	}
+	l = m.Threshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ReleaseBudget != 0 {
+		n += 1 + sovParams(uint64(m.ReleaseBudget))
+	}
+	return n
+}
+
+func (m *BridgeLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxPerTx.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxPerAddressDaily.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.WindowSeconds != 0 {
+		n += 1 + sovParams(uint64(m.WindowSeconds))
+	}
+	l = m.MaxMintPerWindow.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxBurnPerWindow.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxNetFlowPerWindow.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *UsdcSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BridgeEnabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PegRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PegRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TestusdDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TestusdDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdcDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsdcDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdcSources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsdcSources = append(m.UsdcSources, UsdcSource{})
+			if err := m.UsdcSources[len(m.UsdcSources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcTimeoutSeconds", wireType)
+			}
+			m.IbcTimeoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IbcTimeoutSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WithdrawalQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceOracle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceOracle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedeemFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Treasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceOracle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceOracle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceOracle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Oracle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepegThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepegThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAgeSeconds", wireType)
+			}
+			m.MaxAgeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAgeSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawalQueue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawalQueue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawalQueue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelaySeconds", wireType)
+			}
+			m.DelaySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelaySeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseBudget", wireType)
+			}
+			m.ReleaseBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BridgeEnabled {
-		n += 2
-	}
-	l = len(m.PegRatio)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
-	}
-	l = len(m.TestusdDenom)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
-	}
-	l = len(m.UsdcDenom)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
-	}
-	l = len(m.BridgeAddress)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *BridgeLimits) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -299,17 +2234,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeLimits: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeLimits: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerTx", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -319,15 +2254,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.BridgeEnabled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPerTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PegRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerAddressDaily", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -355,11 +2304,32 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PegRatio = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxPerAddressDaily.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSeconds", wireType)
+			}
+			m.WindowSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TestusdDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMintPerWindow", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -387,11 +2357,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TestusdDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxMintPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsdcDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBurnPerWindow", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -419,11 +2391,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UsdcDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxBurnPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNetFlowPerWindow", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -451,7 +2425,123 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxNetFlowPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UsdcSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UsdcSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UsdcSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
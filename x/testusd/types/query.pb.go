@@ -7,7 +7,7 @@ import (
 	context "context"
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -117,6 +117,8 @@ func (m *QueryParamsResponse) GetParams() Params {
 
 // QueryBridgeStatusRequest is request type for the Query/BridgeStatus RPC method.
 type QueryBridgeStatusRequest struct {
+	// address optionally selects the address whose daily quota is returned
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
 func (m *QueryBridgeStatusRequest) Reset()         { *m = QueryBridgeStatusRequest{} }
@@ -152,6 +154,13 @@ func (m *QueryBridgeStatusRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryBridgeStatusRequest proto.InternalMessageInfo
 
+func (m *QueryBridgeStatusRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 // QueryBridgeStatusResponse is response type for the Query/BridgeStatus RPC method.
 type QueryBridgeStatusResponse struct {
 	// total_bridged is the total amount of USDC bridged
@@ -162,6 +171,10 @@ type QueryBridgeStatusResponse struct {
 	BridgeEnabled bool `protobuf:"varint,3,opt,name=bridge_enabled,json=bridgeEnabled,proto3" json:"bridge_enabled,omitempty"`
 	// statistics contains bridge usage statistics
 	Statistics *BridgeStatistics `protobuf:"bytes,4,opt,name=statistics,proto3" json:"statistics,omitempty"`
+	// pause is set while the guardian or circuit breaker has paused the bridge
+	Pause BridgePause `protobuf:"bytes,5,opt,name=pause,proto3" json:"pause"`
+	// quota is how much TestUSD can still be minted and burned
+	Quota BridgeQuota `protobuf:"bytes,6,opt,name=quota,proto3" json:"quota"`
 }
 
 func (m *QueryBridgeStatusResponse) Reset()         { *m = QueryBridgeStatusResponse{} }
@@ -211,6 +224,68 @@ func (m *QueryBridgeStatusResponse) GetStatistics() *BridgeStatistics {
 	return nil
 }
 
+func (m *QueryBridgeStatusResponse) GetPause() BridgePause {
+	if m != nil {
+		return m.Pause
+	}
+	return BridgePause{}
+}
+
+func (m *QueryBridgeStatusResponse) GetQuota() BridgeQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return BridgeQuota{}
+}
+
+// BridgeQuota is the TestUSD left under the bridge limits. Remaining amounts
+// are unset when the cap is unlimited.
+type BridgeQuota struct {
+	// window_minted is the TestUSD minted in the rolling window
+	WindowMinted cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=window_minted,json=windowMinted,proto3,customtype=cosmossdk.io/math.Int" json:"window_minted"`
+	// window_burned is the TestUSD burned in the rolling window
+	WindowBurned        cosmossdk_io_math.Int  `protobuf:"bytes,2,opt,name=window_burned,json=windowBurned,proto3,customtype=cosmossdk.io/math.Int" json:"window_burned"`
+	RemainingWindowMint *cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=remaining_window_mint,json=remainingWindowMint,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_window_mint,omitempty"`
+	RemainingWindowBurn *cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=remaining_window_burn,json=remainingWindowBurn,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_window_burn,omitempty"`
+	// remaining_address_mint and remaining_address_burn are the requested
+	// address's quota for today
+	RemainingAddressMint *cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=remaining_address_mint,json=remainingAddressMint,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_address_mint,omitempty"`
+	RemainingAddressBurn *cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=remaining_address_burn,json=remainingAddressBurn,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_address_burn,omitempty"`
+}
+
+func (m *BridgeQuota) Reset()         { *m = BridgeQuota{} }
+func (m *BridgeQuota) String() string { return proto.CompactTextString(m) }
+func (*BridgeQuota) ProtoMessage()    {}
+func (*BridgeQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73551095d08b3f9e, []int{4}
+}
+func (m *BridgeQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeQuota.Merge(m, src)
+}
+func (m *BridgeQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeQuota proto.InternalMessageInfo
+
 // QueryTotalSupplyRequest is request type for the Query/TotalSupply RPC method.
 type QueryTotalSupplyRequest struct {
 }
@@ -219,7 +294,7 @@ func (m *QueryTotalSupplyRequest) Reset()         { *m = QueryTotalSupplyRequest
 func (m *QueryTotalSupplyRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalSupplyRequest) ProtoMessage()    {}
 func (*QueryTotalSupplyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_73551095d08b3f9e, []int{4}
+	return fileDescriptor_73551095d08b3f9e, []int{5}
 }
 func (m *QueryTotalSupplyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -258,7 +333,7 @@ func (m *QueryTotalSupplyResponse) Reset()         { *m = QueryTotalSupplyRespon
 func (m *QueryTotalSupplyResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalSupplyResponse) ProtoMessage()    {}
 func (*QueryTotalSupplyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_73551095d08b3f9e, []int{5}
+	return fileDescriptor_73551095d08b3f9e, []int{6}
 }
 func (m *QueryTotalSupplyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -287,502 +362,3337 @@ func (m *QueryTotalSupplyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryTotalSupplyResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "mychain.testusd.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "mychain.testusd.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryBridgeStatusRequest)(nil), "mychain.testusd.v1.QueryBridgeStatusRequest")
-	proto.RegisterType((*QueryBridgeStatusResponse)(nil), "mychain.testusd.v1.QueryBridgeStatusResponse")
-	proto.RegisterType((*QueryTotalSupplyRequest)(nil), "mychain.testusd.v1.QueryTotalSupplyRequest")
-	proto.RegisterType((*QueryTotalSupplyResponse)(nil), "mychain.testusd.v1.QueryTotalSupplyResponse")
+// QueryReservesRequest is request type for the Query/Reserves RPC method.
+type QueryReservesRequest struct {
 }
 
-func init() { proto.RegisterFile("mychain/testusd/v1/query.proto", fileDescriptor_73551095d08b3f9e) }
-
-var fileDescriptor_73551095d08b3f9e = []byte{
-	// 547 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
-	0x1c, 0xad, 0x3b, 0xa8, 0x98, 0xbb, 0x21, 0x61, 0x86, 0x96, 0x45, 0x23, 0x2d, 0xe1, 0x5f, 0x19,
-	0x23, 0x56, 0xc6, 0x19, 0x09, 0x45, 0x70, 0xe0, 0x06, 0xd9, 0x4e, 0x08, 0xa9, 0x72, 0x1a, 0x2b,
-	0x8b, 0x68, 0xec, 0xac, 0x76, 0x2a, 0x7a, 0xe0, 0xc2, 0x07, 0x40, 0x08, 0x24, 0x3e, 0x03, 0x47,
-	0x0e, 0x7c, 0x88, 0x1d, 0x27, 0x71, 0x41, 0x1c, 0x26, 0xd4, 0x22, 0xf1, 0x35, 0x50, 0x6d, 0x77,
-	0xeb, 0x68, 0x2a, 0xba, 0x4b, 0xe5, 0xfa, 0xf7, 0xde, 0xef, 0x3d, 0xbf, 0xdf, 0x4f, 0x81, 0x4e,
-	0x36, 0xe8, 0xec, 0x93, 0x94, 0x61, 0x49, 0x85, 0x2c, 0x44, 0x8c, 0xfb, 0x3e, 0x3e, 0x28, 0x68,
-	0x6f, 0xe0, 0xe5, 0x3d, 0x2e, 0x39, 0x42, 0xa6, 0xee, 0x99, 0xba, 0xd7, 0xf7, 0xed, 0x2b, 0x24,
-	0x4b, 0x19, 0xc7, 0xea, 0x57, 0xc3, 0xec, 0xb5, 0x84, 0x27, 0x5c, 0x1d, 0xf1, 0xf8, 0x64, 0x6e,
-	0x37, 0x13, 0xce, 0x93, 0x2e, 0xc5, 0x24, 0x4f, 0x31, 0x61, 0x8c, 0x4b, 0x22, 0x53, 0xce, 0x84,
-	0xa9, 0x6e, 0x75, 0xb8, 0xc8, 0xb8, 0xc0, 0x11, 0x11, 0x54, 0x6b, 0xe2, 0xbe, 0x1f, 0x51, 0x49,
-	0x7c, 0x9c, 0x93, 0x24, 0x65, 0x0a, 0x6c, 0xb0, 0x8d, 0x12, 0x9b, 0x39, 0xe9, 0x91, 0x6c, 0xd2,
-	0xac, 0x59, 0x02, 0x48, 0x28, 0xa3, 0x22, 0x35, 0x08, 0x77, 0x0d, 0xa2, 0x17, 0x63, 0x91, 0xe7,
-	0x8a, 0x16, 0xd2, 0x83, 0x82, 0x0a, 0xe9, 0xee, 0xc1, 0xab, 0x67, 0x6e, 0x45, 0xce, 0x99, 0xa0,
-	0xe8, 0x11, 0xac, 0xe9, 0xf6, 0x16, 0x68, 0x82, 0x56, 0x7d, 0xc7, 0xf6, 0x66, 0x73, 0xf0, 0x34,
-	0x27, 0x58, 0x3e, 0x3c, 0x6e, 0x54, 0xbe, 0xfc, 0xf9, 0xba, 0x05, 0x42, 0x43, 0x72, 0x6d, 0x68,
-	0xa9, 0xae, 0x41, 0x2f, 0x8d, 0x13, 0xba, 0x2b, 0x89, 0x2c, 0x4e, 0x14, 0xdf, 0x57, 0xe1, 0x46,
-	0x49, 0xd1, 0x08, 0x07, 0x70, 0x55, 0x72, 0x49, 0xba, 0xed, 0x48, 0x55, 0x63, 0xa5, 0xbf, 0x1c,
-	0x5c, 0x1f, 0x6b, 0xfc, 0x3c, 0x6e, 0x5c, 0xd3, 0x99, 0x89, 0xf8, 0xb5, 0x97, 0x72, 0x9c, 0x11,
-	0xb9, 0xef, 0x3d, 0x63, 0x32, 0x5c, 0x51, 0x1c, 0xdd, 0x30, 0x46, 0x8f, 0xa1, 0xfe, 0xdf, 0x16,
-	0x45, 0x9e, 0x77, 0x07, 0x56, 0x75, 0x91, 0x16, 0x75, 0x45, 0xd9, 0x55, 0x0c, 0x74, 0x1b, 0x5e,
-	0xd6, 0xfa, 0x6d, 0xca, 0x48, 0xd4, 0xa5, 0xb1, 0xb5, 0xd4, 0x04, 0xad, 0x4b, 0xe1, 0xaa, 0xbe,
-	0x7d, 0xaa, 0x2f, 0xd1, 0x13, 0x08, 0xc5, 0x78, 0xa6, 0x42, 0xa6, 0x1d, 0x61, 0x5d, 0x50, 0x49,
-	0xdd, 0x2a, 0x4b, 0xea, 0xf4, 0xa9, 0x1a, 0x1b, 0x4e, 0xf1, 0xdc, 0x0d, 0xb8, 0xae, 0xf2, 0xd8,
-	0x3b, 0x35, 0x30, 0xc9, 0xea, 0x95, 0xc9, 0xf1, 0x4c, 0xc9, 0x24, 0xf5, 0xef, 0x2b, 0xc1, 0x79,
-	0x5f, 0xb9, 0xf3, 0x6d, 0x09, 0x5e, 0x54, 0xed, 0xd1, 0x5b, 0x58, 0xd3, 0xc3, 0x44, 0x77, 0xca,
-	0xec, 0xcf, 0xee, 0x8d, 0x7d, 0xf7, 0xbf, 0x38, 0x6d, 0xd3, 0x75, 0xdf, 0x7d, 0xff, 0xfd, 0xa9,
-	0xba, 0x89, 0x6c, 0x3c, 0x77, 0x85, 0xd1, 0x67, 0x00, 0x57, 0xa6, 0xb7, 0x01, 0x6d, 0xcf, 0xed,
-	0x5e, 0xb2, 0x51, 0xf6, 0x83, 0x05, 0xd1, 0xc6, 0xd1, 0x3d, 0xe5, 0xe8, 0x26, 0xba, 0x51, 0xe6,
-	0xc8, 0x8c, 0x5d, 0x68, 0x1f, 0x1f, 0x01, 0xac, 0x4f, 0x65, 0x8f, 0xee, 0xcf, 0x55, 0x9a, 0x1d,
-	0x9e, 0xbd, 0xbd, 0x18, 0xd8, 0xb8, 0x6a, 0x29, 0x57, 0x2e, 0x6a, 0x96, 0xb9, 0x9a, 0x1e, 0x74,
-	0xe0, 0x1f, 0x0e, 0x1d, 0x70, 0x34, 0x74, 0xc0, 0xaf, 0xa1, 0x03, 0x3e, 0x8c, 0x9c, 0xca, 0xd1,
-	0xc8, 0xa9, 0xfc, 0x18, 0x39, 0x95, 0x97, 0xeb, 0x13, 0xea, 0x9b, 0x13, 0xb2, 0x1c, 0xe4, 0x54,
-	0x44, 0x35, 0xf5, 0x09, 0x78, 0xf8, 0x37, 0x00, 0x00, 0xff, 0xff, 0xdd, 0xbb, 0x75, 0x77, 0xee,
-	0x04, 0x00, 0x00,
+func (m *QueryReservesRequest) Reset()         { *m = QueryReservesRequest{} }
+func (m *QueryReservesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReservesRequest) ProtoMessage()    {}
+func (*QueryReservesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73551095d08b3f9e, []int{7}
+}
+func (m *QueryReservesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReservesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReservesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReservesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReservesRequest.Merge(m, src)
+}
+func (m *QueryReservesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReservesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReservesRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryReservesRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// BridgeStatus queries the current bridge status
-	BridgeStatus(ctx context.Context, in *QueryBridgeStatusRequest, opts ...grpc.CallOption) (*QueryBridgeStatusResponse, error)
-	// TotalSupply queries the total supply of TestUSD
-	TotalSupply(ctx context.Context, in *QueryTotalSupplyRequest, opts ...grpc.CallOption) (*QueryTotalSupplyResponse, error)
+// QueryReservesResponse is response type for the Query/Reserves RPC method.
+type QueryReservesResponse struct {
+	// tracked_supply is the TestUSD supply tracked by the module
+	TrackedSupply cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=tracked_supply,json=trackedSupply,proto3,customtype=cosmossdk.io/math.Int" json:"tracked_supply"`
+	// bank_supply is the TestUSD supply according to the bank module
+	BankSupply cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=bank_supply,json=bankSupply,proto3,customtype=cosmossdk.io/math.Int" json:"bank_supply"`
+	// tracked_bridged is the USDC bridged in as tracked by the module
+	TrackedBridged cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=tracked_bridged,json=trackedBridged,proto3,customtype=cosmossdk.io/math.Int" json:"tracked_bridged"`
+	// escrowed_collateral is the USDC held by the module account
+	EscrowedCollateral cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=escrowed_collateral,json=escrowedCollateral,proto3,customtype=cosmossdk.io/math.Int" json:"escrowed_collateral"`
+	// collateral_ratio is the escrowed collateral, at the peg ratio, over the
+	// bank supply. It is unset while there is no supply.
+	CollateralRatio *cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=collateral_ratio,json=collateralRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"collateral_ratio,omitempty"`
+	// bridge_in_halted is set while the collateral ratio is below 1
+	BridgeInHalted bool `protobuf:"varint,6,opt,name=bridge_in_halted,json=bridgeInHalted,proto3" json:"bridge_in_halted,omitempty"`
+	// latest_attestation is the most recent reserve attestation, if any
+	LatestAttestation *ReserveAttestation `protobuf:"bytes,7,opt,name=latest_attestation,json=latestAttestation,proto3" json:"latest_attestation,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryReservesResponse) Reset()         { *m = QueryReservesResponse{} }
+func (m *QueryReservesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReservesResponse) ProtoMessage()    {}
+func (*QueryReservesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73551095d08b3f9e, []int{8}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryReservesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/mychain.testusd.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryReservesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReservesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryReservesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReservesResponse.Merge(m, src)
+}
+func (m *QueryReservesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReservesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReservesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) BridgeStatus(ctx context.Context, in *QueryBridgeStatusRequest, opts ...grpc.CallOption) (*QueryBridgeStatusResponse, error) {
-	out := new(QueryBridgeStatusResponse)
-	err := c.cc.Invoke(ctx, "/mychain.testusd.v1.Query/BridgeStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryReservesResponse proto.InternalMessageInfo
+
+func (m *QueryReservesResponse) GetBridgeInHalted() bool {
+	if m != nil {
+		return m.BridgeInHalted
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) TotalSupply(ctx context.Context, in *QueryTotalSupplyRequest, opts ...grpc.CallOption) (*QueryTotalSupplyResponse, error) {
-	out := new(QueryTotalSupplyResponse)
-	err := c.cc.Invoke(ctx, "/mychain.testusd.v1.Query/TotalSupply", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryReservesResponse) GetLatestAttestation() *ReserveAttestation {
+	if m != nil {
+		return m.LatestAttestation
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// BridgeStatus queries the current bridge status
-	BridgeStatus(context.Context, *QueryBridgeStatusRequest) (*QueryBridgeStatusResponse, error)
-	// TotalSupply queries the total supply of TestUSD
-	TotalSupply(context.Context, *QueryTotalSupplyRequest) (*QueryTotalSupplyResponse, error)
+// QueryReserveAttestationsRequest is request type for the
+// Query/ReserveAttestations RPC method.
+type QueryReserveAttestationsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryReserveAttestationsRequest) Reset()         { *m = QueryReserveAttestationsRequest{} }
+func (m *QueryReserveAttestationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReserveAttestationsRequest) ProtoMessage()    {}
+func (*QueryReserveAttestationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73551095d08b3f9e, []int{9}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryReserveAttestationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) BridgeStatus(ctx context.Context, req *QueryBridgeStatusRequest) (*QueryBridgeStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BridgeStatus not implemented")
+func (m *QueryReserveAttestationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReserveAttestationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) TotalSupply(ctx context.Context, req *QueryTotalSupplyRequest) (*QueryTotalSupplyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalSupply not implemented")
+func (m *QueryReserveAttestationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReserveAttestationsRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryReserveAttestationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReserveAttestationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReserveAttestationsRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mychain.testusd.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryReserveAttestationsRequest proto.InternalMessageInfo
+
+func (m *QueryReserveAttestationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_BridgeStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBridgeStatusRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BridgeStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mychain.testusd.v1.Query/BridgeStatus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BridgeStatus(ctx, req.(*QueryBridgeStatusRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryReserveAttestationsResponse is response type for the
+// Query/ReserveAttestations RPC method.
+type QueryReserveAttestationsResponse struct {
+	Attestations []ReserveAttestation `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations"`
+	Pagination   *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_TotalSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalSupplyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalSupply(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mychain.testusd.v1.Query/TotalSupply",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalSupply(ctx, req.(*QueryTotalSupplyRequest))
+func (m *QueryReserveAttestationsResponse) Reset()         { *m = QueryReserveAttestationsResponse{} }
+func (m *QueryReserveAttestationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReserveAttestationsResponse) ProtoMessage()    {}
+func (*QueryReserveAttestationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73551095d08b3f9e, []int{10}
+}
+func (m *QueryReserveAttestationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReserveAttestationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReserveAttestationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryReserveAttestationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReserveAttestationsResponse.Merge(m, src)
+}
+func (m *QueryReserveAttestationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReserveAttestationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReserveAttestationsResponse.DiscardUnknown(m)
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "mychain.testusd.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "BridgeStatus",
-			Handler:    _Query_BridgeStatus_Handler,
-		},
-		{
-			MethodName: "TotalSupply",
-			Handler:    _Query_TotalSupply_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "mychain/testusd/v1/query.proto",
+var xxx_messageInfo_QueryReserveAttestationsResponse proto.InternalMessageInfo
+
+func (m *QueryReserveAttestationsResponse) GetAttestations() []ReserveAttestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryReserveAttestationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBridgeHistoryRequest is request type for the Query/BridgeHistory RPC
+// method.
+type QueryBridgeHistoryRequest struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryBridgeHistoryRequest) Reset()         { *m = QueryBridgeHistoryRequest{} }
+func (m *QueryBridgeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeHistoryRequest) ProtoMessage()    {}
+func (*QueryBridgeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73551095d08b3f9e, []int{11}
+}
+func (m *QueryBridgeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBridgeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBridgeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeHistoryRequest.Merge(m, src)
+}
+func (m *QueryBridgeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBridgeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryBridgeHistoryRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBridgeHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+// QueryBridgeHistoryResponse is response type for the Query/BridgeHistory
+// RPC method.
+type QueryBridgeHistoryResponse struct {
+	Records    []BridgeRecord      `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBridgeHistoryResponse) Reset()         { *m = QueryBridgeHistoryResponse{} }
+func (m *QueryBridgeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeHistoryResponse) ProtoMessage()    {}
+func (*QueryBridgeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73551095d08b3f9e, []int{12}
+}
+func (m *QueryBridgeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBridgeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryBridgeStatusRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryBridgeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeHistoryResponse.Merge(m, src)
 }
-
-func (m *QueryBridgeStatusRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBridgeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBridgeStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryBridgeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryBridgeHistoryResponse) GetRecords() []BridgeRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
 }
 
-func (m *QueryBridgeStatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBridgeHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryBridgeStatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPendingWithdrawalsRequest is request type for the
+// Query/PendingWithdrawals RPC method.
+type QueryPendingWithdrawalsRequest struct {
+	// address optionally selects the withdrawals of one sender
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryBridgeStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Statistics != nil {
-		{
-			size, err := m.Statistics.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.BridgeEnabled {
-		i--
-		if m.BridgeEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	{
-		size := m.TotalSupply.Size()
-		i -= size
-		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.TotalBridged.Size()
-		i -= size
-		if _, err := m.TotalBridged.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryPendingWithdrawalsRequest) Reset()         { *m = QueryPendingWithdrawalsRequest{} }
+func (m *QueryPendingWithdrawalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingWithdrawalsRequest) ProtoMessage()    {}
+func (*QueryPendingWithdrawalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73551095d08b3f9e, []int{13}
+}
+func (m *QueryPendingWithdrawalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingWithdrawalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingWithdrawalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryTotalSupplyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPendingWithdrawalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingWithdrawalsRequest.Merge(m, src)
 }
-
-func (m *QueryTotalSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPendingWithdrawalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingWithdrawalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingWithdrawalsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryTotalSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryPendingWithdrawalsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingWithdrawalsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *QueryTotalSupplyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPendingWithdrawalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryTotalSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPendingWithdrawalsResponse is response type for the
+// Query/PendingWithdrawals RPC method.
+type QueryPendingWithdrawalsResponse struct {
+	Withdrawals []PendingWithdrawal `protobuf:"bytes,1,rep,name=withdrawals,proto3" json:"withdrawals"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryTotalSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TotalSupply.Size()
-		i -= size
-		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryPendingWithdrawalsResponse) Reset()         { *m = QueryPendingWithdrawalsResponse{} }
+func (m *QueryPendingWithdrawalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingWithdrawalsResponse) ProtoMessage()    {}
+func (*QueryPendingWithdrawalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73551095d08b3f9e, []int{14}
+}
+func (m *QueryPendingWithdrawalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingWithdrawalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingWithdrawalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryPendingWithdrawalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingWithdrawalsResponse.Merge(m, src)
+}
+func (m *QueryPendingWithdrawalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingWithdrawalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingWithdrawalsResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryPendingWithdrawalsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingWithdrawalsResponse) GetWithdrawals() []PendingWithdrawal {
+	if m != nil {
+		return m.Withdrawals
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryPendingWithdrawalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// QueryEffectiveRatesRequest is request type for the Query/EffectiveRates
+// RPC method.
+type QueryEffectiveRatesRequest struct {
 }
 
-func (m *QueryBridgeStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEffectiveRatesRequest) Reset()         { *m = QueryEffectiveRatesRequest{} }
+func (m *QueryEffectiveRatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveRatesRequest) ProtoMessage()    {}
+func (*QueryEffectiveRatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73551095d08b3f9e, []int{15}
+}
+func (m *QueryEffectiveRatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveRatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveRatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectiveRatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveRatesRequest.Merge(m, src)
+}
+func (m *QueryEffectiveRatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveRatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveRatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveRatesRequest proto.InternalMessageInfo
+
+// QueryEffectiveRatesResponse is response type for the Query/EffectiveRates
+// RPC method.
+type QueryEffectiveRatesResponse struct {
+	// mint_rate is the TestUSD received per USDC bridged in, after fees
+	MintRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=mint_rate,json=mintRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"mint_rate"`
+	// redeem_rate is the USDC received per TestUSD bridged out, after fees
+	RedeemRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=redeem_rate,json=redeemRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"redeem_rate"`
+	// depegged is set while a fresh oracle price is below the depeg threshold
+	Depegged bool `protobuf:"varint,3,opt,name=depegged,proto3" json:"depegged,omitempty"`
+	// collateral_price is the last posted USDC price, if any
+	CollateralPrice *CollateralPrice `protobuf:"bytes,4,opt,name=collateral_price,json=collateralPrice,proto3" json:"collateral_price,omitempty"`
+}
+
+func (m *QueryEffectiveRatesResponse) Reset()         { *m = QueryEffectiveRatesResponse{} }
+func (m *QueryEffectiveRatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveRatesResponse) ProtoMessage()    {}
+func (*QueryEffectiveRatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73551095d08b3f9e, []int{16}
+}
+func (m *QueryEffectiveRatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveRatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveRatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectiveRatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveRatesResponse.Merge(m, src)
+}
+func (m *QueryEffectiveRatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveRatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveRatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveRatesResponse proto.InternalMessageInfo
+
+func (m *QueryEffectiveRatesResponse) GetDepegged() bool {
+	if m != nil {
+		return m.Depegged
+	}
+	return false
+}
+
+func (m *QueryEffectiveRatesResponse) GetCollateralPrice() *CollateralPrice {
+	if m != nil {
+		return m.CollateralPrice
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "mychain.testusd.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "mychain.testusd.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryBridgeStatusRequest)(nil), "mychain.testusd.v1.QueryBridgeStatusRequest")
+	proto.RegisterType((*QueryBridgeStatusResponse)(nil), "mychain.testusd.v1.QueryBridgeStatusResponse")
+	proto.RegisterType((*BridgeQuota)(nil), "mychain.testusd.v1.BridgeQuota")
+	proto.RegisterType((*QueryTotalSupplyRequest)(nil), "mychain.testusd.v1.QueryTotalSupplyRequest")
+	proto.RegisterType((*QueryTotalSupplyResponse)(nil), "mychain.testusd.v1.QueryTotalSupplyResponse")
+	proto.RegisterType((*QueryReservesRequest)(nil), "mychain.testusd.v1.QueryReservesRequest")
+	proto.RegisterType((*QueryReservesResponse)(nil), "mychain.testusd.v1.QueryReservesResponse")
+	proto.RegisterType((*QueryReserveAttestationsRequest)(nil), "mychain.testusd.v1.QueryReserveAttestationsRequest")
+	proto.RegisterType((*QueryReserveAttestationsResponse)(nil), "mychain.testusd.v1.QueryReserveAttestationsResponse")
+	proto.RegisterType((*QueryBridgeHistoryRequest)(nil), "mychain.testusd.v1.QueryBridgeHistoryRequest")
+	proto.RegisterType((*QueryBridgeHistoryResponse)(nil), "mychain.testusd.v1.QueryBridgeHistoryResponse")
+	proto.RegisterType((*QueryPendingWithdrawalsRequest)(nil), "mychain.testusd.v1.QueryPendingWithdrawalsRequest")
+	proto.RegisterType((*QueryPendingWithdrawalsResponse)(nil), "mychain.testusd.v1.QueryPendingWithdrawalsResponse")
+	proto.RegisterType((*QueryEffectiveRatesRequest)(nil), "mychain.testusd.v1.QueryEffectiveRatesRequest")
+	proto.RegisterType((*QueryEffectiveRatesResponse)(nil), "mychain.testusd.v1.QueryEffectiveRatesResponse")
+}
+
+func init() { proto.RegisterFile("mychain/testusd/v1/query.proto", fileDescriptor_73551095d08b3f9e) }
+
+var fileDescriptor_73551095d08b3f9e = []byte{
+	// 1322 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcb, 0x6f, 0x1b, 0xd5,
+	0x17, 0xce, 0x38, 0x89, 0x9b, 0x1c, 0x37, 0x7d, 0xdc, 0xa4, 0xed, 0x74, 0x9a, 0x9f, 0xed, 0xdf,
+	0xa4, 0x0f, 0xf7, 0xc1, 0x0c, 0x69, 0xbb, 0x43, 0x20, 0x6a, 0xd2, 0xd2, 0x22, 0x5a, 0xd2, 0x69,
+	0x11, 0x12, 0x42, 0xb2, 0xae, 0x3d, 0xb7, 0x93, 0x51, 0xed, 0x19, 0x77, 0xee, 0x75, 0x82, 0x85,
+	0xba, 0x89, 0x84, 0xc4, 0x92, 0x87, 0xc4, 0x92, 0x15, 0x12, 0xac, 0x10, 0x62, 0xc7, 0x82, 0x7d,
+	0x97, 0x95, 0x10, 0x12, 0xea, 0x22, 0x42, 0x09, 0x12, 0x12, 0x7f, 0x05, 0xba, 0x8f, 0xb1, 0xc7,
+	0xf1, 0x4c, 0x6c, 0xa3, 0x8a, 0x4d, 0x62, 0xdf, 0x7b, 0xbe, 0xef, 0x7c, 0x73, 0xe6, 0xde, 0xef,
+	0x9c, 0x04, 0x8a, 0xad, 0x6e, 0x63, 0x03, 0xfb, 0x81, 0xcd, 0x08, 0x65, 0x1d, 0xea, 0xda, 0x9b,
+	0xab, 0xf6, 0x93, 0x0e, 0x89, 0xba, 0x56, 0x3b, 0x0a, 0x59, 0x88, 0x90, 0xda, 0xb7, 0xd4, 0xbe,
+	0xb5, 0xb9, 0x6a, 0x1c, 0xc7, 0x2d, 0x3f, 0x08, 0x6d, 0xf1, 0x53, 0x86, 0x19, 0x4b, 0x5e, 0xe8,
+	0x85, 0xe2, 0xa3, 0xcd, 0x3f, 0xa9, 0xd5, 0x65, 0x2f, 0x0c, 0xbd, 0x26, 0xb1, 0x71, 0xdb, 0xb7,
+	0x71, 0x10, 0x84, 0x0c, 0x33, 0x3f, 0x0c, 0xa8, 0xda, 0xbd, 0xd4, 0x08, 0x69, 0x2b, 0xa4, 0x76,
+	0x1d, 0x53, 0x22, 0x73, 0xda, 0x9b, 0xab, 0x75, 0xc2, 0xf0, 0xaa, 0xdd, 0xc6, 0x9e, 0x1f, 0x88,
+	0x60, 0x15, 0x5b, 0x4a, 0x91, 0xd9, 0xc6, 0x11, 0x6e, 0xc5, 0x64, 0xe5, 0x94, 0x00, 0x8f, 0x04,
+	0x84, 0xfa, 0x2a, 0xc2, 0x5c, 0x02, 0x74, 0x9f, 0x27, 0x59, 0x17, 0x30, 0x87, 0x3c, 0xe9, 0x10,
+	0xca, 0xcc, 0x87, 0xb0, 0x38, 0xb0, 0x4a, 0xdb, 0x61, 0x40, 0x09, 0x7a, 0x1d, 0xf2, 0x92, 0x5e,
+	0xd7, 0xca, 0x5a, 0xa5, 0x70, 0xd5, 0xb0, 0x86, 0xeb, 0x60, 0x49, 0x4c, 0x75, 0xfe, 0xd9, 0x4e,
+	0x69, 0xea, 0xfb, 0xbf, 0x7e, 0xbc, 0xa4, 0x39, 0x0a, 0x64, 0x5e, 0x07, 0x5d, 0xb0, 0x56, 0x23,
+	0xdf, 0xf5, 0xc8, 0x03, 0x86, 0x59, 0x27, 0xce, 0x88, 0x74, 0x38, 0x84, 0x5d, 0x37, 0x22, 0x54,
+	0x72, 0xcf, 0x3b, 0xf1, 0x57, 0xf3, 0xb3, 0x69, 0x38, 0x9d, 0x02, 0x53, 0x92, 0xaa, 0xb0, 0xc0,
+	0x42, 0x86, 0x9b, 0xb5, 0xba, 0xd8, 0x75, 0x25, 0xba, 0xfa, 0x3f, 0x9e, 0xfd, 0xc5, 0x4e, 0xe9,
+	0x84, 0xac, 0x26, 0x75, 0x1f, 0x5b, 0x7e, 0x68, 0xb7, 0x30, 0xdb, 0xb0, 0xee, 0x04, 0xcc, 0x39,
+	0x2c, 0x30, 0x92, 0xd0, 0x45, 0x6f, 0x82, 0xfc, 0x5e, 0xa3, 0x9d, 0x76, 0xbb, 0xd9, 0xd5, 0x73,
+	0xe3, 0x50, 0x14, 0x04, 0xe4, 0x81, 0x40, 0xa0, 0x73, 0x70, 0x44, 0xe6, 0xaf, 0x91, 0x00, 0xd7,
+	0x9b, 0xc4, 0xd5, 0xa7, 0xcb, 0x5a, 0x65, 0xce, 0x59, 0x90, 0xab, 0x37, 0xe5, 0x22, 0x5a, 0x03,
+	0xa0, 0xfc, 0x6d, 0x53, 0xe6, 0x37, 0xa8, 0x3e, 0x23, 0x6a, 0x78, 0x36, 0xad, 0x86, 0xfd, 0x47,
+	0x95, 0xb1, 0x4e, 0x02, 0x87, 0x5e, 0x83, 0xd9, 0x36, 0xee, 0x50, 0xa2, 0xcf, 0x0a, 0x82, 0x52,
+	0x36, 0xc1, 0x3a, 0x0f, 0xab, 0xce, 0xf0, 0x07, 0x71, 0x24, 0x86, 0x83, 0x9f, 0x74, 0x42, 0x86,
+	0xf5, 0xfc, 0x28, 0xf0, 0x7d, 0x1e, 0x16, 0x83, 0x05, 0xc6, 0xfc, 0x6d, 0x1a, 0x0a, 0x89, 0x4d,
+	0x5e, 0xfc, 0x2d, 0x3f, 0x70, 0xc3, 0xad, 0x5a, 0xcb, 0x0f, 0xd8, 0xd8, 0xc5, 0x97, 0x98, 0xbb,
+	0x02, 0x92, 0xe0, 0xa8, 0x77, 0xa2, 0x80, 0xb8, 0x7a, 0x6e, 0x02, 0x8e, 0xaa, 0x80, 0xa0, 0xbb,
+	0x70, 0x22, 0x22, 0x2d, 0xec, 0x07, 0x7e, 0xe0, 0xd5, 0x12, 0x8a, 0xc4, 0x5b, 0x98, 0xaf, 0x9e,
+	0xce, 0xe6, 0x59, 0xec, 0xe1, 0x3e, 0xe8, 0x89, 0x4a, 0xa5, 0xe3, 0xe2, 0xf4, 0x99, 0x49, 0xe9,
+	0xb8, 0x3e, 0xf4, 0x1e, 0x9c, 0xec, 0xd3, 0xa9, 0x53, 0x2d, 0xe5, 0xcd, 0x8e, 0xe2, 0x5b, 0xea,
+	0x01, 0x6f, 0x48, 0x9c, 0xd0, 0x97, 0x4a, 0x28, 0x04, 0xe6, 0x27, 0x26, 0xe4, 0x0a, 0xcd, 0xd3,
+	0x70, 0x4a, 0xdc, 0xb0, 0x87, 0xfd, 0x23, 0x1d, 0x3b, 0xc1, 0x47, 0xa0, 0x0f, 0x6f, 0xa9, 0xbb,
+	0xb7, 0xff, 0xde, 0x68, 0x93, 0xde, 0x1b, 0xf3, 0x24, 0x2c, 0x09, 0x76, 0x87, 0x50, 0x12, 0x6d,
+	0x92, 0x9e, 0xff, 0x6c, 0xcf, 0xc0, 0x89, 0x7d, 0x1b, 0x2a, 0xe7, 0x1a, 0x1c, 0x61, 0x11, 0x6e,
+	0x3c, 0x26, 0xee, 0x44, 0x59, 0x17, 0x14, 0x48, 0xdd, 0xd7, 0x37, 0xa0, 0x50, 0xc7, 0xc1, 0xe3,
+	0x89, 0x2e, 0x3c, 0x70, 0x84, 0xc2, 0xdf, 0x82, 0xa3, 0xb1, 0x8a, 0xd8, 0x77, 0xa6, 0xc7, 0xe1,
+	0x88, 0xb5, 0xc7, 0xce, 0x73, 0x0f, 0x16, 0x09, 0x6d, 0x44, 0xe1, 0x16, 0x71, 0x6b, 0x8d, 0xb0,
+	0xd9, 0xc4, 0x8c, 0x44, 0xb8, 0xa9, 0xcf, 0x8c, 0xc3, 0x85, 0x62, 0xe4, 0x5b, 0x3d, 0x20, 0x7a,
+	0x07, 0x8e, 0xf5, 0x69, 0x6a, 0x11, 0xef, 0x15, 0xea, 0x90, 0x95, 0x5e, 0xec, 0x94, 0xce, 0x0c,
+	0x13, 0xbd, 0x4b, 0x3c, 0xdc, 0xe8, 0xae, 0x91, 0x86, 0x73, 0xb4, 0x0f, 0x74, 0x38, 0x0e, 0x55,
+	0xe0, 0x98, 0xf2, 0x34, 0x3f, 0xa8, 0x6d, 0xe0, 0x26, 0xbf, 0xdf, 0x79, 0xe1, 0x6a, 0xca, 0xeb,
+	0xee, 0x04, 0xb7, 0xc5, 0x2a, 0x7a, 0x1f, 0x10, 0x47, 0x52, 0x56, 0xc3, 0x8c, 0xff, 0xe2, 0xf0,
+	0x40, 0x3f, 0x24, 0x0c, 0xe6, 0x7c, 0x9a, 0xc1, 0xa8, 0xb7, 0x7a, 0xa3, 0x1f, 0xed, 0x1c, 0x97,
+	0x0c, 0x89, 0x25, 0xd3, 0x87, 0x52, 0xf2, 0x0c, 0x24, 0xb6, 0x7a, 0x5d, 0xe3, 0x16, 0x40, 0xbf,
+	0x29, 0xaa, 0xa6, 0x74, 0xde, 0x92, 0x8f, 0x69, 0xf1, 0x0e, 0x6a, 0xc9, 0xae, 0xad, 0x3a, 0xa8,
+	0xb5, 0x8e, 0x3d, 0xa2, 0xb0, 0x4e, 0x02, 0x69, 0xfe, 0xa2, 0x41, 0x39, 0x3b, 0x97, 0x3a, 0x7a,
+	0xeb, 0x70, 0x38, 0xf1, 0x7c, 0xbc, 0x4f, 0x4d, 0x8f, 0xff, 0x80, 0xca, 0x48, 0x07, 0x18, 0xd0,
+	0xdb, 0x03, 0xf2, 0x73, 0x42, 0xfe, 0x85, 0x91, 0xf2, 0xa5, 0x9c, 0x01, 0xfd, 0x4f, 0x07, 0x5a,
+	0xe4, 0x6d, 0x9f, 0xb2, 0x30, 0xea, 0x8e, 0x6c, 0xad, 0xe8, 0x56, 0x4a, 0xfe, 0x7f, 0x53, 0xbe,
+	0xef, 0x34, 0x30, 0xd2, 0xf2, 0xf7, 0x7c, 0xe2, 0x50, 0x44, 0x1a, 0x61, 0xe4, 0xc6, 0x35, 0x2b,
+	0x67, 0x77, 0x1d, 0x47, 0x04, 0xaa, 0x6a, 0xc5, 0xb0, 0x97, 0x57, 0xa8, 0x6d, 0x0d, 0x8a, 0x72,
+	0xb2, 0x21, 0x81, 0x2b, 0x6c, 0x9a, 0x6d, 0xb8, 0x11, 0xde, 0xc2, 0x4d, 0xfa, 0xdf, 0x95, 0xeb,
+	0x67, 0x0d, 0x4a, 0x99, 0x22, 0x54, 0xcd, 0xee, 0x42, 0x61, 0xab, 0xbf, 0xac, 0xea, 0x76, 0x2e,
+	0x75, 0xde, 0xda, 0x4f, 0xa2, 0x8a, 0x97, 0xc4, 0xbf, 0xbc, 0x02, 0x2e, 0xab, 0x37, 0x7d, 0xf3,
+	0xd1, 0x23, 0xd2, 0x60, 0xfe, 0x26, 0x71, 0xf8, 0xbd, 0x8d, 0x7d, 0xfb, 0x8b, 0x1c, 0x9c, 0x49,
+	0xdd, 0xee, 0x9d, 0x84, 0x79, 0xde, 0xf8, 0xb8, 0x33, 0x11, 0x65, 0xdc, 0x2b, 0xca, 0xe5, 0x0e,
+	0x34, 0xa7, 0x39, 0x8e, 0xe2, 0x54, 0x68, 0x0d, 0x0a, 0x11, 0x71, 0x09, 0x69, 0x49, 0x8e, 0xdc,
+	0xf8, 0x1c, 0x20, 0x71, 0x82, 0xc5, 0x80, 0x39, 0x97, 0xb4, 0x89, 0xe7, 0xf5, 0x26, 0xb5, 0xde,
+	0x77, 0x74, 0x6f, 0xc0, 0x43, 0xdb, 0x91, 0xdf, 0x20, 0x6a, 0x54, 0x5b, 0x49, 0x2b, 0x7f, 0xdf,
+	0x7d, 0xd7, 0x79, 0x68, 0xd2, 0x47, 0xc5, 0xc2, 0xd5, 0xbf, 0xe7, 0x61, 0x56, 0xd4, 0x04, 0x3d,
+	0x85, 0xbc, 0x1c, 0x8e, 0x51, 0xaa, 0x69, 0x0c, 0xcf, 0xe1, 0xc6, 0x85, 0x91, 0x71, 0xb2, 0xb0,
+	0xa6, 0xb9, 0xfd, 0xeb, 0x9f, 0x5f, 0xe5, 0x96, 0x91, 0x61, 0x67, 0xfe, 0x49, 0x80, 0xbe, 0xd6,
+	0xe0, 0x70, 0x72, 0x86, 0x46, 0x57, 0x32, 0xd9, 0x53, 0x26, 0x74, 0xe3, 0x95, 0x31, 0xa3, 0x95,
+	0xa2, 0x8b, 0x42, 0xd1, 0x0a, 0xfa, 0x7f, 0x9a, 0x22, 0xd5, 0x58, 0xa8, 0xd4, 0xf1, 0xa5, 0x06,
+	0x85, 0xc4, 0x7c, 0x81, 0x2e, 0x67, 0x66, 0x1a, 0x1e, 0x50, 0x8c, 0x2b, 0xe3, 0x05, 0x2b, 0x55,
+	0x15, 0xa1, 0xca, 0x44, 0xe5, 0x34, 0x55, 0xc9, 0x61, 0x06, 0x7d, 0xaa, 0xc1, 0x5c, 0x3c, 0x7d,
+	0xa0, 0x4a, 0x66, 0x92, 0x7d, 0x93, 0x8b, 0x71, 0x71, 0x8c, 0x48, 0xa5, 0xe5, 0xac, 0xd0, 0x52,
+	0x44, 0xcb, 0x69, 0x5a, 0xa2, 0x38, 0xf5, 0xb7, 0x1a, 0x2c, 0x0c, 0xd8, 0x2a, 0x1a, 0xf5, 0x22,
+	0x06, 0xed, 0xdf, 0xb0, 0xc6, 0x0d, 0x57, 0xb2, 0xae, 0x0b, 0x59, 0x16, 0xba, 0x72, 0xc0, 0x8b,
+	0xdb, 0x90, 0x18, 0xfb, 0x13, 0x65, 0x8d, 0x4f, 0xd1, 0x0f, 0x1a, 0xa0, 0x61, 0x3b, 0x43, 0x57,
+	0xb3, 0x0f, 0x70, 0x96, 0x01, 0x1b, 0xd7, 0x26, 0xc2, 0x28, 0xd5, 0xb6, 0x50, 0x7d, 0x11, 0x5d,
+	0x48, 0xbd, 0x00, 0x12, 0x57, 0x4b, 0x3a, 0xe2, 0x37, 0x1a, 0x1c, 0x19, 0x74, 0x29, 0x94, 0x5d,
+	0xa9, 0x54, 0xb7, 0x33, 0xec, 0xb1, 0xe3, 0x95, 0xc8, 0xcb, 0x42, 0xe4, 0x39, 0xb4, 0x92, 0x26,
+	0x92, 0xc4, 0x18, 0xe1, 0x6c, 0x14, 0xfd, 0xa4, 0xc1, 0x62, 0xca, 0x38, 0x82, 0xae, 0x8d, 0x3a,
+	0x61, 0x29, 0x83, 0x92, 0x71, 0x7d, 0x32, 0x90, 0xd2, 0xfb, 0xaa, 0xd0, 0x7b, 0x09, 0x55, 0x0e,
+	0x38, 0xa1, 0xc9, 0x99, 0x8f, 0x56, 0x57, 0x9f, 0xed, 0x16, 0xb5, 0xe7, 0xbb, 0x45, 0xed, 0x8f,
+	0xdd, 0xa2, 0xf6, 0xf9, 0x5e, 0x71, 0xea, 0xf9, 0x5e, 0x71, 0xea, 0xf7, 0xbd, 0xe2, 0xd4, 0x87,
+	0xa7, 0x62, 0x8a, 0x8f, 0x7b, 0x24, 0xac, 0xdb, 0x26, 0xb4, 0x9e, 0x17, 0xff, 0x88, 0xb8, 0xf6,
+	0xcf, 0x00, 0x11, 0x68, 0x37, 0x4f, 0x74, 0x11, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// BridgeStatus queries the current bridge status
+	BridgeStatus(ctx context.Context, in *QueryBridgeStatusRequest, opts ...grpc.CallOption) (*QueryBridgeStatusResponse, error)
+	// TotalSupply queries the total supply of TestUSD
+	TotalSupply(ctx context.Context, in *QueryTotalSupplyRequest, opts ...grpc.CallOption) (*QueryTotalSupplyResponse, error)
+	// Reserves compares the tracked and bank supply of TestUSD with the
+	// escrowed USDC collateral
+	Reserves(ctx context.Context, in *QueryReservesRequest, opts ...grpc.CallOption) (*QueryReservesResponse, error)
+	// BridgeHistory queries the bridge operations of an address
+	BridgeHistory(ctx context.Context, in *QueryBridgeHistoryRequest, opts ...grpc.CallOption) (*QueryBridgeHistoryResponse, error)
+	// PendingWithdrawals queries the withdrawal queue
+	PendingWithdrawals(ctx context.Context, in *QueryPendingWithdrawalsRequest, opts ...grpc.CallOption) (*QueryPendingWithdrawalsResponse, error)
+	// EffectiveRates queries the mint and redeem rates after fees and depeg
+	// handling
+	EffectiveRates(ctx context.Context, in *QueryEffectiveRatesRequest, opts ...grpc.CallOption) (*QueryEffectiveRatesResponse, error)
+	// ReserveAttestations queries the history of reserve attestations
+	ReserveAttestations(ctx context.Context, in *QueryReserveAttestationsRequest, opts ...grpc.CallOption) (*QueryReserveAttestationsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/mychain.testusd.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BridgeStatus(ctx context.Context, in *QueryBridgeStatusRequest, opts ...grpc.CallOption) (*QueryBridgeStatusResponse, error) {
+	out := new(QueryBridgeStatusResponse)
+	err := c.cc.Invoke(ctx, "/mychain.testusd.v1.Query/BridgeStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalSupply(ctx context.Context, in *QueryTotalSupplyRequest, opts ...grpc.CallOption) (*QueryTotalSupplyResponse, error) {
+	out := new(QueryTotalSupplyResponse)
+	err := c.cc.Invoke(ctx, "/mychain.testusd.v1.Query/TotalSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Reserves(ctx context.Context, in *QueryReservesRequest, opts ...grpc.CallOption) (*QueryReservesResponse, error) {
+	out := new(QueryReservesResponse)
+	err := c.cc.Invoke(ctx, "/mychain.testusd.v1.Query/Reserves", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BridgeHistory(ctx context.Context, in *QueryBridgeHistoryRequest, opts ...grpc.CallOption) (*QueryBridgeHistoryResponse, error) {
+	out := new(QueryBridgeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/mychain.testusd.v1.Query/BridgeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingWithdrawals(ctx context.Context, in *QueryPendingWithdrawalsRequest, opts ...grpc.CallOption) (*QueryPendingWithdrawalsResponse, error) {
+	out := new(QueryPendingWithdrawalsResponse)
+	err := c.cc.Invoke(ctx, "/mychain.testusd.v1.Query/PendingWithdrawals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EffectiveRates(ctx context.Context, in *QueryEffectiveRatesRequest, opts ...grpc.CallOption) (*QueryEffectiveRatesResponse, error) {
+	out := new(QueryEffectiveRatesResponse)
+	err := c.cc.Invoke(ctx, "/mychain.testusd.v1.Query/EffectiveRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReserveAttestations(ctx context.Context, in *QueryReserveAttestationsRequest, opts ...grpc.CallOption) (*QueryReserveAttestationsResponse, error) {
+	out := new(QueryReserveAttestationsResponse)
+	err := c.cc.Invoke(ctx, "/mychain.testusd.v1.Query/ReserveAttestations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// BridgeStatus queries the current bridge status
+	BridgeStatus(context.Context, *QueryBridgeStatusRequest) (*QueryBridgeStatusResponse, error)
+	// TotalSupply queries the total supply of TestUSD
+	TotalSupply(context.Context, *QueryTotalSupplyRequest) (*QueryTotalSupplyResponse, error)
+	// Reserves compares the tracked and bank supply of TestUSD with the
+	// escrowed USDC collateral
+	Reserves(context.Context, *QueryReservesRequest) (*QueryReservesResponse, error)
+	// BridgeHistory queries the bridge operations of an address
+	BridgeHistory(context.Context, *QueryBridgeHistoryRequest) (*QueryBridgeHistoryResponse, error)
+	// PendingWithdrawals queries the withdrawal queue
+	PendingWithdrawals(context.Context, *QueryPendingWithdrawalsRequest) (*QueryPendingWithdrawalsResponse, error)
+	// EffectiveRates queries the mint and redeem rates after fees and depeg
+	// handling
+	EffectiveRates(context.Context, *QueryEffectiveRatesRequest) (*QueryEffectiveRatesResponse, error)
+	// ReserveAttestations queries the history of reserve attestations
+	ReserveAttestations(context.Context, *QueryReserveAttestationsRequest) (*QueryReserveAttestationsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) BridgeStatus(ctx context.Context, req *QueryBridgeStatusRequest) (*QueryBridgeStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeStatus not implemented")
+}
+func (*UnimplementedQueryServer) TotalSupply(ctx context.Context, req *QueryTotalSupplyRequest) (*QueryTotalSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalSupply not implemented")
+}
+func (*UnimplementedQueryServer) Reserves(ctx context.Context, req *QueryReservesRequest) (*QueryReservesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reserves not implemented")
+}
+func (*UnimplementedQueryServer) BridgeHistory(ctx context.Context, req *QueryBridgeHistoryRequest) (*QueryBridgeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeHistory not implemented")
+}
+func (*UnimplementedQueryServer) PendingWithdrawals(ctx context.Context, req *QueryPendingWithdrawalsRequest) (*QueryPendingWithdrawalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingWithdrawals not implemented")
+}
+func (*UnimplementedQueryServer) EffectiveRates(ctx context.Context, req *QueryEffectiveRatesRequest) (*QueryEffectiveRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EffectiveRates not implemented")
+}
+func (*UnimplementedQueryServer) ReserveAttestations(ctx context.Context, req *QueryReserveAttestationsRequest) (*QueryReserveAttestationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveAttestations not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mychain.testusd.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgeStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mychain.testusd.v1.Query/BridgeStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeStatus(ctx, req.(*QueryBridgeStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mychain.testusd.v1.Query/TotalSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalSupply(ctx, req.(*QueryTotalSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Reserves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReservesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Reserves(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mychain.testusd.v1.Query/Reserves",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Reserves(ctx, req.(*QueryReservesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mychain.testusd.v1.Query/BridgeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeHistory(ctx, req.(*QueryBridgeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingWithdrawals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingWithdrawalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingWithdrawals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mychain.testusd.v1.Query/PendingWithdrawals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingWithdrawals(ctx, req.(*QueryPendingWithdrawalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EffectiveRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEffectiveRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EffectiveRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mychain.testusd.v1.Query/EffectiveRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EffectiveRates(ctx, req.(*QueryEffectiveRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReserveAttestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReserveAttestationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReserveAttestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mychain.testusd.v1.Query/ReserveAttestations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReserveAttestations(ctx, req.(*QueryReserveAttestationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "mychain.testusd.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "BridgeStatus",
+			Handler:    _Query_BridgeStatus_Handler,
+		},
+		{
+			MethodName: "TotalSupply",
+			Handler:    _Query_TotalSupply_Handler,
+		},
+		{
+			MethodName: "Reserves",
+			Handler:    _Query_Reserves_Handler,
+		},
+		{
+			MethodName: "BridgeHistory",
+			Handler:    _Query_BridgeHistory_Handler,
+		},
+		{
+			MethodName: "PendingWithdrawals",
+			Handler:    _Query_PendingWithdrawals_Handler,
+		},
+		{
+			MethodName: "EffectiveRates",
+			Handler:    _Query_EffectiveRates_Handler,
+		},
+		{
+			MethodName: "ReserveAttestations",
+			Handler:    _Query_ReserveAttestations_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mychain/testusd/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Quota.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Pause.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Statistics != nil {
+		{
+			size, err := m.Statistics.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BridgeEnabled {
+		i--
+		if m.BridgeEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.TotalSupply.Size()
+		i -= size
+		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TotalBridged.Size()
+		i -= size
+		if _, err := m.TotalBridged.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingAddressBurn != nil {
+		{
+			size := m.RemainingAddressBurn.Size()
+			i -= size
+			if _, err := m.RemainingAddressBurn.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.RemainingAddressMint != nil {
+		{
+			size := m.RemainingAddressMint.Size()
+			i -= size
+			if _, err := m.RemainingAddressMint.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.RemainingWindowBurn != nil {
+		{
+			size := m.RemainingWindowBurn.Size()
+			i -= size
+			if _, err := m.RemainingWindowBurn.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RemainingWindowMint != nil {
+		{
+			size := m.RemainingWindowMint.Size()
+			i -= size
+			if _, err := m.RemainingWindowMint.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.WindowBurned.Size()
+		i -= size
+		if _, err := m.WindowBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.WindowMinted.Size()
+		i -= size
+		if _, err := m.WindowMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalSupply.Size()
+		i -= size
+		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReservesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReservesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReservesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReservesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReservesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReservesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LatestAttestation != nil {
+		{
+			size, err := m.LatestAttestation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.BridgeInHalted {
+		i--
+		if m.BridgeInHalted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CollateralRatio != nil {
+		{
+			size := m.CollateralRatio.Size()
+			i -= size
+			if _, err := m.CollateralRatio.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.EscrowedCollateral.Size()
+		i -= size
+		if _, err := m.EscrowedCollateral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TrackedBridged.Size()
+		i -= size
+		if _, err := m.TrackedBridged.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BankSupply.Size()
+		i -= size
+		if _, err := m.BankSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TrackedSupply.Size()
+		i -= size
+		if _, err := m.TrackedSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReserveAttestationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReserveAttestationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReserveAttestationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReserveAttestationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReserveAttestationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReserveAttestationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingWithdrawalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingWithdrawalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingWithdrawalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingWithdrawalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingWithdrawalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingWithdrawalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Withdrawals) > 0 {
+		for iNdEx := len(m.Withdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveRatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveRatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveRatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveRatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CollateralPrice != nil {
+		{
+			size, err := m.CollateralPrice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Depegged {
+		i--
+		if m.Depegged {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.RedeemRate.Size()
+		i -= size
+		if _, err := m.RedeemRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MintRate.Size()
+		i -= size
+		if _, err := m.MintRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBridgeStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBridgeStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalBridged.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.BridgeEnabled {
+		n += 2
+	}
+	if m.Statistics != nil {
+		l = m.Statistics.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Pause.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Quota.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *BridgeQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.WindowMinted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.WindowBurned.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.RemainingWindowMint != nil {
+		l = m.RemainingWindowMint.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RemainingWindowBurn != nil {
+		l = m.RemainingWindowBurn.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RemainingAddressMint != nil {
+		l = m.RemainingAddressMint.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RemainingAddressBurn != nil {
+		l = m.RemainingAddressBurn.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReservesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryReservesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TrackedSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BankSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TrackedBridged.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EscrowedCollateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.CollateralRatio != nil {
+		l = m.CollateralRatio.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BridgeInHalted {
+		n += 2
+	}
+	if m.LatestAttestation != nil {
+		l = m.LatestAttestation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReserveAttestationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReserveAttestationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBridgeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBridgeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingWithdrawalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingWithdrawalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Withdrawals) > 0 {
+		for _, e := range m.Withdrawals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEffectiveRatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEffectiveRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MintRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RedeemRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Depegged {
+		n += 2
+	}
+	if m.CollateralPrice != nil {
+		l = m.CollateralPrice.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBridgeStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBridgeStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBridgeStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBridgeStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBridgeStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBridgeStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBridged", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBridged.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BridgeEnabled = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Statistics == nil {
+				m.Statistics = &BridgeStatistics{}
+			}
+			if err := m.Statistics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WindowMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WindowBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingWindowMint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.RemainingWindowMint = &v
+			if err := m.RemainingWindowMint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingWindowBurn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.RemainingWindowBurn = &v
+			if err := m.RemainingWindowBurn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAddressMint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.RemainingAddressMint = &v
+			if err := m.RemainingAddressMint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAddressBurn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.RemainingAddressBurn = &v
+			if err := m.RemainingAddressBurn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReservesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReservesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReservesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReservesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReservesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReservesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackedSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrackedSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BankSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackedBridged", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrackedBridged.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedCollateral", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EscrowedCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.CollateralRatio = &v
+			if err := m.CollateralRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeInHalted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BridgeInHalted = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestAttestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LatestAttestation == nil {
+				m.LatestAttestation = &ReserveAttestation{}
+			}
+			if err := m.LatestAttestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryBridgeStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.TotalBridged.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.TotalSupply.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.BridgeEnabled {
-		n += 2
-	}
-	if m.Statistics != nil {
-		l = m.Statistics.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryTotalSupplyRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryReserveAttestationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReserveAttestationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReserveAttestationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryTotalSupplyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.TotalSupply.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryReserveAttestationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -805,12 +3715,82 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReserveAttestationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReserveAttestationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, ReserveAttestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -832,7 +3812,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBridgeHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -855,15 +3835,47 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBridgeHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBridgeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -890,7 +3902,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -915,7 +3930,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBridgeStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBridgeHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -938,12 +3953,82 @@ func (m *QueryBridgeStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBridgeStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBridgeHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBridgeStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBridgeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, BridgeRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -965,7 +4050,7 @@ func (m *QueryBridgeStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBridgeStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingWithdrawalsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -988,15 +4073,15 @@ func (m *QueryBridgeStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBridgeStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingWithdrawalsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBridgeStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingWithdrawalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalBridged", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1024,15 +4109,13 @@ func (m *QueryBridgeStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalBridged.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1042,31 +4125,83 @@ func (m *QueryBridgeStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEnabled", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingWithdrawalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingWithdrawalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingWithdrawalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawals", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1076,15 +4211,29 @@ func (m *QueryBridgeStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.BridgeEnabled = bool(v != 0)
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawals = append(m.Withdrawals, PendingWithdrawal{})
+			if err := m.Withdrawals[len(m.Withdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1111,10 +4260,10 @@ func (m *QueryBridgeStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Statistics == nil {
-				m.Statistics = &BridgeStatistics{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Statistics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1139,7 +4288,7 @@ func (m *QueryBridgeStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalSupplyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEffectiveRatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1162,10 +4311,10 @@ func (m *QueryTotalSupplyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalSupplyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEffectiveRatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEffectiveRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1189,7 +4338,7 @@ func (m *QueryTotalSupplyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalSupplyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEffectiveRatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1212,15 +4361,15 @@ func (m *QueryTotalSupplyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalSupplyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEffectiveRatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEffectiveRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1248,7 +4397,97 @@ func (m *QueryTotalSupplyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MintRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedeemRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depegged", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Depegged = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CollateralPrice == nil {
+				m.CollateralPrice = &CollateralPrice{}
+			}
+			if err := m.CollateralPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,13 @@
+package types
+
+import (
+    "fmt"
+
+    "cosmossdk.io/math"
+)
+
+// ReserveAttestationSignBytes returns the bytes the attestor signs to attest
+// amount of USDC reserves at timestamp on chainID.
+func ReserveAttestationSignBytes(chainID string, amount math.Int, timestamp int64) []byte {
+    return []byte(fmt.Sprintf("%s/reserves/%s/%s/%d", ModuleName, chainID, amount, timestamp))
+}
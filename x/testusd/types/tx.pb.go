@@ -228,6 +228,11 @@ type MsgBridgeOut struct {
 	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	// amount is the amount of TestUSD to burn (in smallest unit)
 	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// channel_id is the USDC source channel to send the USDC back over. When
+	// empty the USDC is released to the sender on this chain.
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// receiver is the counterparty address receiving the USDC
+	Receiver string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
 }
 
 func (m *MsgBridgeOut) Reset()         { *m = MsgBridgeOut{} }
@@ -270,10 +275,30 @@ func (m *MsgBridgeOut) GetSender() string {
 	return ""
 }
 
+func (m *MsgBridgeOut) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *MsgBridgeOut) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
 // MsgBridgeOutResponse defines the response for MsgBridgeOut
 type MsgBridgeOutResponse struct {
 	// released_amount is the amount of USDC released
 	ReleasedAmount types.Coin `protobuf:"bytes,1,opt,name=released_amount,json=releasedAmount,proto3" json:"released_amount"`
+	// sequence is the ICS-20 packet sequence when sent over a channel
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// queued is set when the bridge out went into the withdrawal queue
+	Queued bool `protobuf:"varint,3,opt,name=queued,proto3" json:"queued,omitempty"`
+	// withdrawal_id is the id of the queued withdrawal
+	WithdrawalId uint64 `protobuf:"varint,4,opt,name=withdrawal_id,json=withdrawalId,proto3" json:"withdrawal_id,omitempty"`
 }
 
 func (m *MsgBridgeOutResponse) Reset()         { *m = MsgBridgeOutResponse{} }
@@ -316,521 +341,3196 @@ func (m *MsgBridgeOutResponse) GetReleasedAmount() types.Coin {
 	return types.Coin{}
 }
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "mychain.testusd.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "mychain.testusd.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgBridgeIn)(nil), "mychain.testusd.v1.MsgBridgeIn")
-	proto.RegisterType((*MsgBridgeInResponse)(nil), "mychain.testusd.v1.MsgBridgeInResponse")
-	proto.RegisterType((*MsgBridgeOut)(nil), "mychain.testusd.v1.MsgBridgeOut")
-	proto.RegisterType((*MsgBridgeOutResponse)(nil), "mychain.testusd.v1.MsgBridgeOutResponse")
+func (m *MsgBridgeOutResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("mychain/testusd/v1/tx.proto", fileDescriptor_648ea177d9ed0eb7) }
-
-var fileDescriptor_648ea177d9ed0eb7 = []byte{
-	// 572 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xce, 0x15, 0x88, 0xc8, 0xb5, 0x50, 0x61, 0x82, 0x92, 0x18, 0xe1, 0x04, 0x33, 0x10, 0xa5,
-	0xca, 0x99, 0x14, 0xc4, 0x50, 0x89, 0xa1, 0x2e, 0x03, 0x19, 0xa2, 0xa0, 0x00, 0x42, 0x82, 0xa1,
-	0xbd, 0xc4, 0x27, 0xc7, 0x02, 0xdf, 0x59, 0xbe, 0x73, 0xd4, 0x6c, 0x88, 0x91, 0x89, 0x3f, 0xc0,
-	0xce, 0x18, 0x89, 0xb2, 0x32, 0x77, 0xac, 0x3a, 0x21, 0x86, 0x0a, 0x25, 0x43, 0xfe, 0x06, 0xb2,
-	0x7d, 0x76, 0x4a, 0x1a, 0x1a, 0x98, 0x58, 0xa2, 0xbc, 0x7b, 0xef, 0x7b, 0xef, 0xfb, 0xbe, 0x77,
-	0x67, 0x78, 0xd3, 0x1d, 0xf6, 0xfa, 0xd8, 0xa1, 0x86, 0x20, 0x5c, 0x04, 0xdc, 0x32, 0x06, 0x0d,
-	0x43, 0xec, 0x23, 0xcf, 0x67, 0x82, 0x29, 0x8a, 0x4c, 0x22, 0x99, 0x44, 0x83, 0x86, 0x7a, 0x0d,
-	0xbb, 0x0e, 0x65, 0x46, 0xf4, 0x1b, 0x97, 0xa9, 0x85, 0x1e, 0xe3, 0x2e, 0xe3, 0x86, 0xcb, 0xed,
-	0x10, 0xee, 0x72, 0x5b, 0x26, 0x4a, 0x71, 0x62, 0x37, 0x8a, 0x8c, 0x38, 0x90, 0xa9, 0xbc, 0xcd,
-	0x6c, 0x16, 0x9f, 0x87, 0xff, 0xe4, 0x69, 0x79, 0x01, 0x1b, 0x0f, 0xfb, 0xd8, 0x4d, 0x60, 0x9a,
-	0x1c, 0xd5, 0xc5, 0x9c, 0x18, 0x83, 0x46, 0x97, 0x08, 0xdc, 0x30, 0x7a, 0xcc, 0xa1, 0x71, 0x5e,
-	0xff, 0x06, 0xe0, 0x7a, 0x8b, 0xdb, 0x2f, 0x3c, 0x0b, 0x0b, 0xf2, 0x34, 0x42, 0x2a, 0x0f, 0x61,
-	0x0e, 0x07, 0xa2, 0xcf, 0x7c, 0x47, 0x0c, 0x8b, 0xa0, 0x02, 0xaa, 0x39, 0xb3, 0x78, 0x7c, 0x50,
-	0xcf, 0x4b, 0x3e, 0xdb, 0x96, 0xe5, 0x13, 0xce, 0x9f, 0x09, 0xdf, 0xa1, 0x76, 0x67, 0x56, 0xaa,
-	0x3c, 0x82, 0xd9, 0x78, 0x76, 0x71, 0xa5, 0x02, 0xaa, 0xab, 0x9b, 0x2a, 0x3a, 0x6b, 0x07, 0x8a,
-	0x67, 0x98, 0xb9, 0xc3, 0x93, 0x72, 0xe6, 0xf3, 0x74, 0x54, 0x03, 0x1d, 0x09, 0xda, 0x7a, 0xf0,
-	0x7e, 0x3a, 0xaa, 0xcd, 0xda, 0x7d, 0x98, 0x8e, 0x6a, 0xb7, 0x13, 0x79, 0xfb, 0xa9, 0xc0, 0x39,
-	0xb2, 0x7a, 0x09, 0x16, 0xe6, 0x8e, 0x3a, 0x84, 0x7b, 0x8c, 0x72, 0xa2, 0x7f, 0x01, 0x70, 0xb5,
-	0xc5, 0x6d, 0xd3, 0x77, 0x2c, 0x9b, 0x34, 0xa9, 0x72, 0x0f, 0x66, 0x39, 0xa1, 0x16, 0xf1, 0x97,
-	0x8a, 0x92, 0x75, 0xca, 0x0e, 0xcc, 0x62, 0x97, 0x05, 0x54, 0x44, 0x8a, 0x72, 0xe6, 0x46, 0xc8,
-	0xfa, 0xc7, 0x49, 0xf9, 0x46, 0x8c, 0xe2, 0xd6, 0x1b, 0xe4, 0x30, 0xc3, 0xc5, 0xa2, 0x8f, 0x9a,
-	0x54, 0x1c, 0x1f, 0xd4, 0xa1, 0x6c, 0xd7, 0xa4, 0xa2, 0x23, 0xa1, 0x5b, 0xf5, 0x50, 0x97, 0xec,
-	0x18, 0x8a, 0xba, 0xb5, 0x50, 0x54, 0xc2, 0x52, 0x7f, 0x0d, 0xaf, 0x9f, 0x0a, 0x13, 0x31, 0xca,
-	0x63, 0x78, 0xc5, 0x75, 0xa8, 0x20, 0xd6, 0xae, 0x64, 0x04, 0x22, 0x8f, 0x4b, 0x48, 0x4e, 0x0c,
-	0x17, 0x8c, 0xe4, 0x82, 0xd1, 0x0e, 0x73, 0xa8, 0x79, 0x31, 0x24, 0xdb, 0x59, 0x8b, 0x51, 0xdb,
-	0x11, 0x48, 0xff, 0x0a, 0xe0, 0x5a, 0xda, 0xbd, 0x1d, 0x88, 0xff, 0xe5, 0x09, 0x9a, 0xf3, 0x44,
-	0x3b, 0xc7, 0x93, 0x76, 0x20, 0xf4, 0x3d, 0x98, 0x3f, 0x1d, 0xa7, 0xae, 0x3c, 0x81, 0xeb, 0x3e,
-	0x79, 0x4b, 0x30, 0xff, 0x67, 0x5f, 0xae, 0x26, 0xb8, 0xd8, 0x99, 0xcd, 0x4f, 0x2b, 0xf0, 0x42,
-	0x8b, 0xdb, 0xca, 0x1e, 0x5c, 0xfb, 0xed, 0x31, 0xdc, 0x59, 0x74, 0x89, 0xe7, 0x6e, 0x9c, 0xba,
-	0xf1, 0x17, 0x45, 0x29, 0xe7, 0xe7, 0xf0, 0x72, 0x7a, 0x25, 0xcb, 0x7f, 0x00, 0x26, 0x05, 0xea,
-	0xdd, 0x25, 0x05, 0x69, 0xd7, 0x97, 0x30, 0x37, 0xdb, 0x6a, 0xe5, 0x5c, 0x54, 0x3b, 0x10, 0x6a,
-	0x75, 0x59, 0x45, 0xd2, 0x58, 0xbd, 0xf4, 0x2e, 0x7c, 0xa5, 0x66, 0xe3, 0x70, 0xac, 0x81, 0xa3,
-	0xb1, 0x06, 0x7e, 0x8e, 0x35, 0xf0, 0x71, 0xa2, 0x65, 0x8e, 0x26, 0x5a, 0xe6, 0xfb, 0x44, 0xcb,
-	0xbc, 0x2a, 0x9c, 0xdd, 0x9d, 0x18, 0x7a, 0x84, 0x77, 0xb3, 0xd1, 0x27, 0xe6, 0xfe, 0xaf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xe6, 0x11, 0xd4, 0x17, 0x33, 0x05, 0x00, 0x00,
+func (m *MsgBridgeOutResponse) GetQueued() bool {
+	if m != nil {
+		return m.Queued
+	}
+	return false
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// BridgeIn handles bridging USDC to mint TestUSD
-	BridgeIn(ctx context.Context, in *MsgBridgeIn, opts ...grpc.CallOption) (*MsgBridgeInResponse, error)
-	// BridgeOut handles burning TestUSD to redeem USDC
-	BridgeOut(ctx context.Context, in *MsgBridgeOut, opts ...grpc.CallOption) (*MsgBridgeOutResponse, error)
+func (m *MsgBridgeOutResponse) GetWithdrawalId() uint64 {
+	if m != nil {
+		return m.WithdrawalId
+	}
+	return 0
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+// MsgPauseBridge defines a message to pause the bridge
+type MsgPauseBridge struct {
+	// signer is the guardian or the authority
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// reason describes why the bridge is paused
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgPauseBridge) Reset()         { *m = MsgPauseBridge{} }
+func (m *MsgPauseBridge) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseBridge) ProtoMessage()    {}
+func (*MsgPauseBridge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_648ea177d9ed0eb7, []int{6}
 }
-
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/mychain.testusd.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgPauseBridge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseBridge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseBridge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgPauseBridge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseBridge.Merge(m, src)
+}
+func (m *MsgPauseBridge) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseBridge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseBridge.DiscardUnknown(m)
 }
 
-func (c *msgClient) BridgeIn(ctx context.Context, in *MsgBridgeIn, opts ...grpc.CallOption) (*MsgBridgeInResponse, error) {
-	out := new(MsgBridgeInResponse)
-	err := c.cc.Invoke(ctx, "/mychain.testusd.v1.Msg/BridgeIn", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgPauseBridge proto.InternalMessageInfo
+
+func (m *MsgPauseBridge) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) BridgeOut(ctx context.Context, in *MsgBridgeOut, opts ...grpc.CallOption) (*MsgBridgeOutResponse, error) {
-	out := new(MsgBridgeOutResponse)
-	err := c.cc.Invoke(ctx, "/mychain.testusd.v1.Msg/BridgeOut", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgPauseBridge) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// BridgeIn handles bridging USDC to mint TestUSD
-	BridgeIn(context.Context, *MsgBridgeIn) (*MsgBridgeInResponse, error)
-	// BridgeOut handles burning TestUSD to redeem USDC
-	BridgeOut(context.Context, *MsgBridgeOut) (*MsgBridgeOutResponse, error)
+// MsgPauseBridgeResponse defines the response for MsgPauseBridge
+type MsgPauseBridgeResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgPauseBridgeResponse) Reset()         { *m = MsgPauseBridgeResponse{} }
+func (m *MsgPauseBridgeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseBridgeResponse) ProtoMessage()    {}
+func (*MsgPauseBridgeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_648ea177d9ed0eb7, []int{7}
 }
-
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgPauseBridgeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) BridgeIn(ctx context.Context, req *MsgBridgeIn) (*MsgBridgeInResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BridgeIn not implemented")
+func (m *MsgPauseBridgeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseBridgeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) BridgeOut(ctx context.Context, req *MsgBridgeOut) (*MsgBridgeOutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BridgeOut not implemented")
+func (m *MsgPauseBridgeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseBridgeResponse.Merge(m, src)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgPauseBridgeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseBridgeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseBridgeResponse.DiscardUnknown(m)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mychain.testusd.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgPauseBridgeResponse proto.InternalMessageInfo
+
+// MsgUnpauseBridge defines a message to unpause the bridge
+type MsgUnpauseBridge struct {
+	// authority is the address that controls the module
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 }
 
-func _Msg_BridgeIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBridgeIn)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).BridgeIn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mychain.testusd.v1.Msg/BridgeIn",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BridgeIn(ctx, req.(*MsgBridgeIn))
+func (m *MsgUnpauseBridge) Reset()         { *m = MsgUnpauseBridge{} }
+func (m *MsgUnpauseBridge) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpauseBridge) ProtoMessage()    {}
+func (*MsgUnpauseBridge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_648ea177d9ed0eb7, []int{8}
+}
+func (m *MsgUnpauseBridge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpauseBridge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpauseBridge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUnpauseBridge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpauseBridge.Merge(m, src)
+}
+func (m *MsgUnpauseBridge) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpauseBridge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpauseBridge.DiscardUnknown(m)
 }
 
-func _Msg_BridgeOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBridgeOut)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).BridgeOut(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mychain.testusd.v1.Msg/BridgeOut",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BridgeOut(ctx, req.(*MsgBridgeOut))
+var xxx_messageInfo_MsgUnpauseBridge proto.InternalMessageInfo
+
+func (m *MsgUnpauseBridge) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "mychain.testusd.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "BridgeIn",
-			Handler:    _Msg_BridgeIn_Handler,
-		},
-		{
-			MethodName: "BridgeOut",
-			Handler:    _Msg_BridgeOut_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "mychain/testusd/v1/tx.proto",
+// MsgUnpauseBridgeResponse defines the response for MsgUnpauseBridge
+type MsgUnpauseBridgeResponse struct {
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUnpauseBridgeResponse) Reset()         { *m = MsgUnpauseBridgeResponse{} }
+func (m *MsgUnpauseBridgeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpauseBridgeResponse) ProtoMessage()    {}
+func (*MsgUnpauseBridgeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_648ea177d9ed0eb7, []int{9}
+}
+func (m *MsgUnpauseBridgeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpauseBridgeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpauseBridgeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUnpauseBridgeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpauseBridgeResponse.Merge(m, src)
+}
+func (m *MsgUnpauseBridgeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpauseBridgeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpauseBridgeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUnpauseBridgeResponse proto.InternalMessageInfo
+
+// MsgAttestReserves defines a message to post a reserve attestation
+type MsgAttestReserves struct {
+	// attestor is the attestor set in the params
+	Attestor string `protobuf:"bytes,1,opt,name=attestor,proto3" json:"attestor,omitempty"`
+	// amount is the attested USDC reserve in base units
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// timestamp is the unix time the reserves were attested at
+	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// signature is the attestor's signature over the attestation sign bytes
+	Signature []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgAttestReserves) Reset()         { *m = MsgAttestReserves{} }
+func (m *MsgAttestReserves) String() string { return proto.CompactTextString(m) }
+func (*MsgAttestReserves) ProtoMessage()    {}
+func (*MsgAttestReserves) Descriptor() ([]byte, []int) {
+	return fileDescriptor_648ea177d9ed0eb7, []int{10}
+}
+func (m *MsgAttestReserves) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAttestReserves) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttestReserves.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgAttestReserves) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttestReserves.Merge(m, src)
+}
+func (m *MsgAttestReserves) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttestReserves) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttestReserves.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgAttestReserves proto.InternalMessageInfo
+
+func (m *MsgAttestReserves) GetAttestor() string {
+	if m != nil {
+		return m.Attestor
+	}
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgAttestReserves) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
 }
 
-func (m *MsgBridgeIn) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAttestReserves) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgBridgeIn) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgAttestReservesResponse defines the response for MsgAttestReserves
+type MsgAttestReservesResponse struct {
+	// id is the id of the stored attestation
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgBridgeIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgAttestReservesResponse) Reset()         { *m = MsgAttestReservesResponse{} }
+func (m *MsgAttestReservesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAttestReservesResponse) ProtoMessage()    {}
+func (*MsgAttestReservesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_648ea177d9ed0eb7, []int{11}
+}
+func (m *MsgAttestReservesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAttestReservesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttestReservesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgAttestReservesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttestReservesResponse.Merge(m, src)
+}
+func (m *MsgAttestReservesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttestReservesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttestReservesResponse.DiscardUnknown(m)
 }
 
-func (m *MsgBridgeInResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAttestReservesResponse proto.InternalMessageInfo
+
+func (m *MsgAttestReservesResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgBridgeInResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCancelWithdrawal defines a message to cancel a queued bridge out
+type MsgCancelWithdrawal struct {
+	// sender is the address that requested the bridge out
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// id is the id of the queued withdrawal
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgBridgeInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.MintedAmount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgCancelWithdrawal) Reset()         { *m = MsgCancelWithdrawal{} }
+func (m *MsgCancelWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelWithdrawal) ProtoMessage()    {}
+func (*MsgCancelWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_648ea177d9ed0eb7, []int{12}
+}
+func (m *MsgCancelWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgCancelWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelWithdrawal.Merge(m, src)
+}
+func (m *MsgCancelWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelWithdrawal.DiscardUnknown(m)
 }
 
-func (m *MsgBridgeOut) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCancelWithdrawal proto.InternalMessageInfo
+
+func (m *MsgCancelWithdrawal) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgBridgeOut) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelWithdrawal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *MsgBridgeOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// MsgCancelWithdrawalResponse defines the response for MsgCancelWithdrawal
+type MsgCancelWithdrawalResponse struct {
 }
 
-func (m *MsgBridgeOutResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelWithdrawalResponse) Reset()         { *m = MsgCancelWithdrawalResponse{} }
+func (m *MsgCancelWithdrawalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelWithdrawalResponse) ProtoMessage()    {}
+func (*MsgCancelWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_648ea177d9ed0eb7, []int{13}
+}
+func (m *MsgCancelWithdrawalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelWithdrawalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCancelWithdrawalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelWithdrawalResponse.Merge(m, src)
+}
+func (m *MsgCancelWithdrawalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelWithdrawalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelWithdrawalResponse.DiscardUnknown(m)
 }
 
-func (m *MsgBridgeOutResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCancelWithdrawalResponse proto.InternalMessageInfo
+
+// MsgApproveWithdrawal defines a message to release a queued bridge out
+type MsgApproveWithdrawal struct {
+	// authority is the address that controls the module
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// id is the id of the queued withdrawal
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgBridgeOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ReleasedAmount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgApproveWithdrawal) Reset()         { *m = MsgApproveWithdrawal{} }
+func (m *MsgApproveWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveWithdrawal) ProtoMessage()    {}
+func (*MsgApproveWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_648ea177d9ed0eb7, []int{14}
+}
+func (m *MsgApproveWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgApproveWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveWithdrawal.Merge(m, src)
+}
+func (m *MsgApproveWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveWithdrawal.DiscardUnknown(m)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_MsgApproveWithdrawal proto.InternalMessageInfo
+
+func (m *MsgApproveWithdrawal) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+func (m *MsgApproveWithdrawal) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return 0
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// MsgApproveWithdrawalResponse defines the response for MsgApproveWithdrawal
+type MsgApproveWithdrawalResponse struct {
+	// released_amount is the amount of USDC released
+	ReleasedAmount types.Coin `protobuf:"bytes,1,opt,name=released_amount,json=releasedAmount,proto3" json:"released_amount"`
 }
 
-func (m *MsgBridgeIn) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
+func (m *MsgApproveWithdrawalResponse) Reset()         { *m = MsgApproveWithdrawalResponse{} }
+func (m *MsgApproveWithdrawalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveWithdrawalResponse) ProtoMessage()    {}
+func (*MsgApproveWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_648ea177d9ed0eb7, []int{15}
+}
+func (m *MsgApproveWithdrawalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveWithdrawalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveWithdrawalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveWithdrawalResponse.Merge(m, src)
+}
+func (m *MsgApproveWithdrawalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveWithdrawalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveWithdrawalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveWithdrawalResponse proto.InternalMessageInfo
+
+func (m *MsgApproveWithdrawalResponse) GetReleasedAmount() types.Coin {
+	if m != nil {
+		return m.ReleasedAmount
+	}
+	return types.Coin{}
+}
+
+// MsgUpdateCollateralPrice defines a message to post the USDC price
+type MsgUpdateCollateralPrice struct {
+	// oracle is the price oracle set in the params
+	Oracle string `protobuf:"bytes,1,opt,name=oracle,proto3" json:"oracle,omitempty"`
+	// price is the USDC price in USD
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+}
+
+func (m *MsgUpdateCollateralPrice) Reset()         { *m = MsgUpdateCollateralPrice{} }
+func (m *MsgUpdateCollateralPrice) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCollateralPrice) ProtoMessage()    {}
+func (*MsgUpdateCollateralPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_648ea177d9ed0eb7, []int{16}
+}
+func (m *MsgUpdateCollateralPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCollateralPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCollateralPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCollateralPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCollateralPrice.Merge(m, src)
+}
+func (m *MsgUpdateCollateralPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCollateralPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCollateralPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCollateralPrice proto.InternalMessageInfo
+
+func (m *MsgUpdateCollateralPrice) GetOracle() string {
+	if m != nil {
+		return m.Oracle
+	}
+	return ""
+}
+
+// MsgUpdateCollateralPriceResponse defines the response for
+// MsgUpdateCollateralPrice
+type MsgUpdateCollateralPriceResponse struct {
+}
+
+func (m *MsgUpdateCollateralPriceResponse) Reset()         { *m = MsgUpdateCollateralPriceResponse{} }
+func (m *MsgUpdateCollateralPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCollateralPriceResponse) ProtoMessage()    {}
+func (*MsgUpdateCollateralPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_648ea177d9ed0eb7, []int{17}
+}
+func (m *MsgUpdateCollateralPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCollateralPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCollateralPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCollateralPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCollateralPriceResponse.Merge(m, src)
+}
+func (m *MsgUpdateCollateralPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCollateralPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCollateralPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCollateralPriceResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "mychain.testusd.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "mychain.testusd.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgBridgeIn)(nil), "mychain.testusd.v1.MsgBridgeIn")
+	proto.RegisterType((*MsgBridgeInResponse)(nil), "mychain.testusd.v1.MsgBridgeInResponse")
+	proto.RegisterType((*MsgBridgeOut)(nil), "mychain.testusd.v1.MsgBridgeOut")
+	proto.RegisterType((*MsgBridgeOutResponse)(nil), "mychain.testusd.v1.MsgBridgeOutResponse")
+	proto.RegisterType((*MsgPauseBridge)(nil), "mychain.testusd.v1.MsgPauseBridge")
+	proto.RegisterType((*MsgPauseBridgeResponse)(nil), "mychain.testusd.v1.MsgPauseBridgeResponse")
+	proto.RegisterType((*MsgUnpauseBridge)(nil), "mychain.testusd.v1.MsgUnpauseBridge")
+	proto.RegisterType((*MsgUnpauseBridgeResponse)(nil), "mychain.testusd.v1.MsgUnpauseBridgeResponse")
+	proto.RegisterType((*MsgAttestReserves)(nil), "mychain.testusd.v1.MsgAttestReserves")
+	proto.RegisterType((*MsgAttestReservesResponse)(nil), "mychain.testusd.v1.MsgAttestReservesResponse")
+	proto.RegisterType((*MsgCancelWithdrawal)(nil), "mychain.testusd.v1.MsgCancelWithdrawal")
+	proto.RegisterType((*MsgCancelWithdrawalResponse)(nil), "mychain.testusd.v1.MsgCancelWithdrawalResponse")
+	proto.RegisterType((*MsgApproveWithdrawal)(nil), "mychain.testusd.v1.MsgApproveWithdrawal")
+	proto.RegisterType((*MsgApproveWithdrawalResponse)(nil), "mychain.testusd.v1.MsgApproveWithdrawalResponse")
+	proto.RegisterType((*MsgUpdateCollateralPrice)(nil), "mychain.testusd.v1.MsgUpdateCollateralPrice")
+	proto.RegisterType((*MsgUpdateCollateralPriceResponse)(nil), "mychain.testusd.v1.MsgUpdateCollateralPriceResponse")
+}
+
+func init() { proto.RegisterFile("mychain/testusd/v1/tx.proto", fileDescriptor_648ea177d9ed0eb7) }
+
+var fileDescriptor_648ea177d9ed0eb7 = []byte{
+	// 1087 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcf, 0x4f, 0x24, 0x45,
+	0x14, 0xa6, 0x81, 0x45, 0xe6, 0xf1, 0x63, 0x97, 0x96, 0x5d, 0x86, 0x66, 0x19, 0xb0, 0x71, 0x95,
+	0xc0, 0xd2, 0xc3, 0xac, 0x68, 0xe2, 0x24, 0x1e, 0x18, 0x48, 0x94, 0x44, 0x02, 0x69, 0x35, 0x9b,
+	0x68, 0x0c, 0x16, 0xdd, 0x65, 0x4f, 0xc7, 0xe9, 0x1f, 0xdb, 0x55, 0x3d, 0xbb, 0xc4, 0x8b, 0x7a,
+	0x34, 0xc6, 0x78, 0xf5, 0x0f, 0x30, 0xf1, 0x48, 0xe2, 0x5e, 0xbc, 0x78, 0xde, 0xe3, 0xba, 0x27,
+	0xe3, 0x61, 0x63, 0xe0, 0xc0, 0xd5, 0x3f, 0xc1, 0x74, 0x55, 0x4d, 0x4d, 0x4f, 0x4f, 0x0f, 0x33,
+	0x4b, 0x36, 0xf1, 0x42, 0xa8, 0x57, 0xdf, 0x7b, 0xef, 0x7b, 0xdf, 0xab, 0x7a, 0x5d, 0x03, 0x0b,
+	0xde, 0x89, 0x55, 0x47, 0xae, 0x5f, 0xa6, 0x98, 0xd0, 0x98, 0xd8, 0xe5, 0x66, 0xa5, 0x4c, 0x1f,
+	0x19, 0x61, 0x14, 0xd0, 0x40, 0x55, 0xc5, 0xa6, 0x21, 0x36, 0x8d, 0x66, 0x45, 0x9b, 0x41, 0x9e,
+	0xeb, 0x07, 0x65, 0xf6, 0x97, 0xc3, 0xb4, 0x39, 0x2b, 0x20, 0x5e, 0x40, 0xca, 0x1e, 0x71, 0x12,
+	0x77, 0x8f, 0x38, 0x62, 0x63, 0x9e, 0x6f, 0x1c, 0xb1, 0x55, 0x99, 0x2f, 0xc4, 0xd6, 0xac, 0x13,
+	0x38, 0x01, 0xb7, 0x27, 0xff, 0x09, 0xeb, 0x52, 0x0e, 0x9b, 0x10, 0x45, 0xc8, 0x6b, 0xb9, 0x95,
+	0x44, 0xaa, 0x63, 0x44, 0x70, 0xb9, 0x59, 0x39, 0xc6, 0x14, 0x55, 0xca, 0x56, 0xe0, 0xfa, 0x7c,
+	0x5f, 0xff, 0x43, 0x81, 0xeb, 0xfb, 0xc4, 0xf9, 0x24, 0xb4, 0x11, 0xc5, 0x87, 0xcc, 0x53, 0x7d,
+	0x07, 0x0a, 0x28, 0xa6, 0xf5, 0x20, 0x72, 0xe9, 0x49, 0x51, 0x59, 0x56, 0x56, 0x0b, 0xb5, 0xe2,
+	0xb3, 0xc7, 0x1b, 0xb3, 0x82, 0xcf, 0xb6, 0x6d, 0x47, 0x98, 0x90, 0x8f, 0x68, 0xe4, 0xfa, 0x8e,
+	0xd9, 0x86, 0xaa, 0xef, 0xc1, 0x18, 0xcf, 0x5d, 0x1c, 0x5e, 0x56, 0x56, 0x27, 0xee, 0x69, 0x46,
+	0xb7, 0x1c, 0x06, 0xcf, 0x51, 0x2b, 0x3c, 0x79, 0xbe, 0x34, 0xf4, 0xeb, 0xc5, 0xe9, 0x9a, 0x62,
+	0x0a, 0xa7, 0xea, 0xd6, 0x77, 0x17, 0xa7, 0x6b, 0xed, 0x70, 0xdf, 0x5f, 0x9c, 0xae, 0xbd, 0xd6,
+	0x2a, 0xef, 0x91, 0x2c, 0x30, 0x43, 0x56, 0x9f, 0x87, 0xb9, 0x8c, 0xc9, 0xc4, 0x24, 0x0c, 0x7c,
+	0x82, 0xf5, 0xdf, 0x14, 0x98, 0xd8, 0x27, 0x4e, 0x2d, 0x72, 0x6d, 0x07, 0xef, 0xf9, 0xea, 0x26,
+	0x8c, 0x11, 0xec, 0xdb, 0x38, 0xea, 0x5b, 0x94, 0xc0, 0xa9, 0x3b, 0x30, 0x86, 0xbc, 0x20, 0xf6,
+	0x29, 0xab, 0xa8, 0x50, 0x5b, 0x4f, 0x58, 0xff, 0xfd, 0x7c, 0xe9, 0x26, 0xf7, 0x22, 0xf6, 0x57,
+	0x86, 0x1b, 0x94, 0x3d, 0x44, 0xeb, 0xc6, 0x9e, 0x4f, 0x9f, 0x3d, 0xde, 0x00, 0x11, 0x6e, 0xcf,
+	0xa7, 0xa6, 0x70, 0xad, 0x6e, 0x24, 0x75, 0x89, 0x88, 0x49, 0x51, 0x8b, 0xb9, 0x45, 0xb5, 0x58,
+	0xea, 0x9f, 0xc1, 0xab, 0xa9, 0x65, 0xab, 0x18, 0x75, 0x17, 0xa6, 0x3c, 0xd7, 0xa7, 0xd8, 0x3e,
+	0x12, 0x8c, 0x14, 0xa6, 0xf1, 0xbc, 0x21, 0x32, 0x26, 0x0d, 0x36, 0x44, 0x83, 0x8d, 0x9d, 0xc0,
+	0xf5, 0x6b, 0xa3, 0x09, 0x59, 0x73, 0x92, 0x7b, 0x6d, 0x33, 0x27, 0xfd, 0x5f, 0x05, 0x26, 0x65,
+	0xf4, 0x83, 0x98, 0xfe, 0x4f, 0x9a, 0xa8, 0x8b, 0x00, 0x56, 0x1d, 0xf9, 0x3e, 0x6e, 0x1c, 0xb9,
+	0x76, 0x71, 0x24, 0x09, 0x64, 0x16, 0x84, 0x65, 0xcf, 0x56, 0x35, 0x18, 0x8f, 0xb0, 0x85, 0xdd,
+	0x26, 0x8e, 0x8a, 0xa3, 0x6c, 0x53, 0xae, 0xab, 0x46, 0x46, 0xce, 0xd2, 0x25, 0x72, 0x1e, 0xc4,
+	0x54, 0xff, 0x5d, 0x81, 0xd9, 0xb4, 0x41, 0x2a, 0xfa, 0x01, 0x5c, 0x8f, 0x70, 0x03, 0x23, 0xf2,
+	0xc2, 0x9a, 0x4e, 0xb7, 0xfc, 0xb8, 0xaa, 0x09, 0x5d, 0x82, 0x1f, 0xc4, 0xd8, 0xb7, 0x30, 0x13,
+	0x65, 0xd4, 0x94, 0x6b, 0xf5, 0x16, 0x8c, 0x3d, 0x88, 0x71, 0x8c, 0x79, 0x95, 0xe3, 0xa6, 0x58,
+	0xa9, 0x2b, 0x30, 0xf5, 0xd0, 0xa5, 0x75, 0x3b, 0x42, 0x0f, 0x11, 0x13, 0x61, 0x94, 0x39, 0x4e,
+	0xb6, 0x8d, 0x7b, 0xb6, 0xfe, 0x83, 0x02, 0xd3, 0xfb, 0xc4, 0x39, 0x44, 0x31, 0xc1, 0xbc, 0x00,
+	0xd6, 0x30, 0xd7, 0xf1, 0x07, 0x6a, 0x18, 0xc3, 0x25, 0x0c, 0x22, 0x8c, 0x48, 0xe0, 0xf3, 0x86,
+	0x99, 0x62, 0x55, 0xdd, 0xe4, 0x42, 0x32, 0x50, 0x22, 0xe4, 0x72, 0xae, 0x90, 0xa9, 0xdc, 0x7a,
+	0x11, 0x6e, 0x75, 0x5a, 0xe4, 0x55, 0xfb, 0x56, 0x81, 0x1b, 0xc9, 0x35, 0xf4, 0xc3, 0x14, 0xd5,
+	0x2b, 0xce, 0x91, 0xea, 0xdb, 0xdd, 0x83, 0x40, 0xcf, 0x1f, 0x04, 0xe9, 0x74, 0xba, 0x06, 0xc5,
+	0xac, 0xad, 0xcd, 0x6f, 0x18, 0x66, 0xf6, 0x89, 0xb3, 0x4d, 0x13, 0x6f, 0x13, 0x13, 0x1c, 0x35,
+	0x31, 0x51, 0xb7, 0x60, 0x1c, 0x31, 0x4b, 0xd0, 0x5f, 0x4d, 0x89, 0x7c, 0x39, 0x17, 0xe0, 0x36,
+	0x14, 0xa8, 0xeb, 0x61, 0x42, 0x91, 0x17, 0xb2, 0x93, 0x31, 0x62, 0xb6, 0x0d, 0xc9, 0x6e, 0xd2,
+	0x17, 0x44, 0xe3, 0x08, 0xb3, 0x83, 0x31, 0x69, 0xb6, 0x0d, 0x5c, 0x1f, 0xc9, 0x27, 0x91, 0x67,
+	0x25, 0x57, 0x9e, 0xce, 0x6a, 0xf5, 0x75, 0x98, 0xef, 0x32, 0xca, 0xcb, 0x30, 0x0d, 0xc3, 0xae,
+	0xcd, 0x44, 0x18, 0x35, 0x87, 0x5d, 0x5b, 0xff, 0x51, 0x61, 0x63, 0x68, 0x07, 0xf9, 0x16, 0x6e,
+	0xdc, 0x97, 0x67, 0xf2, 0x0a, 0xf3, 0x82, 0x47, 0x1e, 0x6e, 0x45, 0xe6, 0xec, 0x53, 0xf7, 0xf7,
+	0x4e, 0x2e, 0xf7, 0x6c, 0x62, 0x7d, 0x11, 0x16, 0x72, 0xcc, 0xb2, 0xc1, 0x3f, 0xf3, 0x5b, 0xbe,
+	0x1d, 0x86, 0x51, 0xd0, 0xc4, 0x29, 0xc2, 0x57, 0xfd, 0x98, 0x65, 0x69, 0xbf, 0xdb, 0x7d, 0x28,
+	0xdf, 0xc8, 0x57, 0x3d, 0x4b, 0x41, 0xaf, 0xc3, 0xed, 0x3c, 0xfb, 0xcb, 0x1f, 0x44, 0xfa, 0x9f,
+	0x0a, 0x14, 0xe5, 0xd7, 0x70, 0x27, 0x68, 0x34, 0x10, 0xc5, 0x11, 0x6a, 0x1c, 0x46, 0xae, 0xc5,
+	0x26, 0x47, 0x10, 0x21, 0xab, 0x81, 0xfb, 0xb7, 0x8e, 0xe3, 0xd4, 0xf7, 0xe1, 0x5a, 0x98, 0xb8,
+	0x8a, 0x83, 0x5e, 0x11, 0x07, 0x7d, 0xa1, 0xfb, 0xa0, 0x7f, 0x88, 0x1d, 0x64, 0x9d, 0xec, 0x62,
+	0x2b, 0x75, 0xdc, 0x77, 0xb1, 0x65, 0x72, 0xff, 0x6a, 0x95, 0xf5, 0x9c, 0x47, 0x4d, 0x94, 0x5b,
+	0xbb, 0xe4, 0xbb, 0x9e, 0xa1, 0xad, 0xeb, 0xb0, 0xdc, 0x6b, 0xaf, 0xa5, 0xe0, 0xbd, 0x5f, 0x5e,
+	0x81, 0x91, 0x7d, 0xe2, 0xa8, 0x5f, 0xc0, 0x64, 0xc7, 0x4b, 0x66, 0x25, 0xef, 0x05, 0x92, 0x79,
+	0x2e, 0x68, 0xeb, 0x03, 0x80, 0x64, 0xaf, 0x3e, 0x86, 0x71, 0xf9, 0x9e, 0x58, 0xea, 0xe1, 0xd8,
+	0x02, 0x68, 0x6f, 0xf6, 0x01, 0xc8, 0xa8, 0xf7, 0xa1, 0xd0, 0xfe, 0x24, 0x2f, 0x5f, 0xea, 0x75,
+	0x10, 0x53, 0x6d, 0xb5, 0x1f, 0x42, 0x06, 0xfe, 0x1c, 0x26, 0xd2, 0x1f, 0x0f, 0xbd, 0x87, 0x63,
+	0x0a, 0xa3, 0xad, 0xf5, 0xc7, 0xc8, 0xf0, 0x16, 0x4c, 0x75, 0x8e, 0xfc, 0xd7, 0x7b, 0x69, 0x99,
+	0x46, 0x69, 0x77, 0x07, 0x41, 0xc9, 0x24, 0x5f, 0xc2, 0x74, 0x66, 0x6e, 0xdf, 0xe9, 0xe1, 0xdf,
+	0x09, 0xd3, 0x36, 0x06, 0x82, 0xc9, 0x3c, 0x0d, 0xb8, 0xd1, 0x35, 0xee, 0x7a, 0x75, 0x30, 0x0b,
+	0xd4, 0xca, 0x03, 0x02, 0x65, 0xb6, 0x00, 0x66, 0xba, 0x87, 0x55, 0xaf, 0xc6, 0x76, 0x21, 0xb5,
+	0xcd, 0x41, 0x91, 0x32, 0xe1, 0xd7, 0x70, 0x33, 0x7f, 0x2e, 0xdc, 0xbd, 0xf4, 0xfc, 0x67, 0xd0,
+	0xda, 0xd6, 0x8b, 0xa0, 0x5b, 0xc9, 0xb5, 0x6b, 0xdf, 0x24, 0x4f, 0xfd, 0x5a, 0xe5, 0xc9, 0x59,
+	0x49, 0x79, 0x7a, 0x56, 0x52, 0xfe, 0x39, 0x2b, 0x29, 0x3f, 0x9d, 0x97, 0x86, 0x9e, 0x9e, 0x97,
+	0x86, 0xfe, 0x3a, 0x2f, 0x0d, 0x7d, 0x3a, 0xd7, 0x3d, 0x11, 0xe8, 0x49, 0x88, 0xc9, 0xf1, 0x18,
+	0xfb, 0x9d, 0xf2, 0xd6, 0x7f, 0x03, 0x00, 0x43, 0x77, 0xb0, 0x01, 0x78, 0x0d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// BridgeIn handles bridging USDC to mint TestUSD
+	BridgeIn(ctx context.Context, in *MsgBridgeIn, opts ...grpc.CallOption) (*MsgBridgeInResponse, error)
+	// BridgeOut handles burning TestUSD to redeem USDC
+	BridgeOut(ctx context.Context, in *MsgBridgeOut, opts ...grpc.CallOption) (*MsgBridgeOutResponse, error)
+	// PauseBridge pauses bridging in and out. The guardian and the authority
+	// can pause the bridge.
+	PauseBridge(ctx context.Context, in *MsgPauseBridge, opts ...grpc.CallOption) (*MsgPauseBridgeResponse, error)
+	// UnpauseBridge lifts a pause. Only the authority can unpause the bridge.
+	UnpauseBridge(ctx context.Context, in *MsgUnpauseBridge, opts ...grpc.CallOption) (*MsgUnpauseBridgeResponse, error)
+	// AttestReserves records a signed off-chain attestation of the USDC
+	// reserves.
+	AttestReserves(ctx context.Context, in *MsgAttestReserves, opts ...grpc.CallOption) (*MsgAttestReservesResponse, error)
+	// CancelWithdrawal returns the TestUSD of a queued bridge out to its
+	// sender.
+	CancelWithdrawal(ctx context.Context, in *MsgCancelWithdrawal, opts ...grpc.CallOption) (*MsgCancelWithdrawalResponse, error)
+	// ApproveWithdrawal releases a queued bridge out before its delay ends.
+	ApproveWithdrawal(ctx context.Context, in *MsgApproveWithdrawal, opts ...grpc.CallOption) (*MsgApproveWithdrawalResponse, error)
+	// UpdateCollateralPrice posts the USDC price as the price oracle.
+	UpdateCollateralPrice(ctx context.Context, in *MsgUpdateCollateralPrice, opts ...grpc.CallOption) (*MsgUpdateCollateralPriceResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/mychain.testusd.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BridgeIn(ctx context.Context, in *MsgBridgeIn, opts ...grpc.CallOption) (*MsgBridgeInResponse, error) {
+	out := new(MsgBridgeInResponse)
+	err := c.cc.Invoke(ctx, "/mychain.testusd.v1.Msg/BridgeIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BridgeOut(ctx context.Context, in *MsgBridgeOut, opts ...grpc.CallOption) (*MsgBridgeOutResponse, error) {
+	out := new(MsgBridgeOutResponse)
+	err := c.cc.Invoke(ctx, "/mychain.testusd.v1.Msg/BridgeOut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PauseBridge(ctx context.Context, in *MsgPauseBridge, opts ...grpc.CallOption) (*MsgPauseBridgeResponse, error) {
+	out := new(MsgPauseBridgeResponse)
+	err := c.cc.Invoke(ctx, "/mychain.testusd.v1.Msg/PauseBridge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnpauseBridge(ctx context.Context, in *MsgUnpauseBridge, opts ...grpc.CallOption) (*MsgUnpauseBridgeResponse, error) {
+	out := new(MsgUnpauseBridgeResponse)
+	err := c.cc.Invoke(ctx, "/mychain.testusd.v1.Msg/UnpauseBridge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AttestReserves(ctx context.Context, in *MsgAttestReserves, opts ...grpc.CallOption) (*MsgAttestReservesResponse, error) {
+	out := new(MsgAttestReservesResponse)
+	err := c.cc.Invoke(ctx, "/mychain.testusd.v1.Msg/AttestReserves", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelWithdrawal(ctx context.Context, in *MsgCancelWithdrawal, opts ...grpc.CallOption) (*MsgCancelWithdrawalResponse, error) {
+	out := new(MsgCancelWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/mychain.testusd.v1.Msg/CancelWithdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveWithdrawal(ctx context.Context, in *MsgApproveWithdrawal, opts ...grpc.CallOption) (*MsgApproveWithdrawalResponse, error) {
+	out := new(MsgApproveWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/mychain.testusd.v1.Msg/ApproveWithdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateCollateralPrice(ctx context.Context, in *MsgUpdateCollateralPrice, opts ...grpc.CallOption) (*MsgUpdateCollateralPriceResponse, error) {
+	out := new(MsgUpdateCollateralPriceResponse)
+	err := c.cc.Invoke(ctx, "/mychain.testusd.v1.Msg/UpdateCollateralPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// BridgeIn handles bridging USDC to mint TestUSD
+	BridgeIn(context.Context, *MsgBridgeIn) (*MsgBridgeInResponse, error)
+	// BridgeOut handles burning TestUSD to redeem USDC
+	BridgeOut(context.Context, *MsgBridgeOut) (*MsgBridgeOutResponse, error)
+	// PauseBridge pauses bridging in and out. The guardian and the authority
+	// can pause the bridge.
+	PauseBridge(context.Context, *MsgPauseBridge) (*MsgPauseBridgeResponse, error)
+	// UnpauseBridge lifts a pause. Only the authority can unpause the bridge.
+	UnpauseBridge(context.Context, *MsgUnpauseBridge) (*MsgUnpauseBridgeResponse, error)
+	// AttestReserves records a signed off-chain attestation of the USDC
+	// reserves.
+	AttestReserves(context.Context, *MsgAttestReserves) (*MsgAttestReservesResponse, error)
+	// CancelWithdrawal returns the TestUSD of a queued bridge out to its
+	// sender.
+	CancelWithdrawal(context.Context, *MsgCancelWithdrawal) (*MsgCancelWithdrawalResponse, error)
+	// ApproveWithdrawal releases a queued bridge out before its delay ends.
+	ApproveWithdrawal(context.Context, *MsgApproveWithdrawal) (*MsgApproveWithdrawalResponse, error)
+	// UpdateCollateralPrice posts the USDC price as the price oracle.
+	UpdateCollateralPrice(context.Context, *MsgUpdateCollateralPrice) (*MsgUpdateCollateralPriceResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) BridgeIn(ctx context.Context, req *MsgBridgeIn) (*MsgBridgeInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeIn not implemented")
+}
+func (*UnimplementedMsgServer) BridgeOut(ctx context.Context, req *MsgBridgeOut) (*MsgBridgeOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeOut not implemented")
+}
+func (*UnimplementedMsgServer) PauseBridge(ctx context.Context, req *MsgPauseBridge) (*MsgPauseBridgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseBridge not implemented")
+}
+func (*UnimplementedMsgServer) UnpauseBridge(ctx context.Context, req *MsgUnpauseBridge) (*MsgUnpauseBridgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseBridge not implemented")
+}
+func (*UnimplementedMsgServer) AttestReserves(ctx context.Context, req *MsgAttestReserves) (*MsgAttestReservesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestReserves not implemented")
+}
+func (*UnimplementedMsgServer) CancelWithdrawal(ctx context.Context, req *MsgCancelWithdrawal) (*MsgCancelWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelWithdrawal not implemented")
+}
+func (*UnimplementedMsgServer) ApproveWithdrawal(ctx context.Context, req *MsgApproveWithdrawal) (*MsgApproveWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveWithdrawal not implemented")
+}
+func (*UnimplementedMsgServer) UpdateCollateralPrice(ctx context.Context, req *MsgUpdateCollateralPrice) (*MsgUpdateCollateralPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollateralPrice not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mychain.testusd.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BridgeIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBridgeIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BridgeIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mychain.testusd.v1.Msg/BridgeIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BridgeIn(ctx, req.(*MsgBridgeIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BridgeOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBridgeOut)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BridgeOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mychain.testusd.v1.Msg/BridgeOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BridgeOut(ctx, req.(*MsgBridgeOut))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PauseBridge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseBridge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseBridge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mychain.testusd.v1.Msg/PauseBridge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseBridge(ctx, req.(*MsgPauseBridge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnpauseBridge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpauseBridge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnpauseBridge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mychain.testusd.v1.Msg/UnpauseBridge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnpauseBridge(ctx, req.(*MsgUnpauseBridge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AttestReserves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAttestReserves)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AttestReserves(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mychain.testusd.v1.Msg/AttestReserves",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AttestReserves(ctx, req.(*MsgAttestReserves))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelWithdrawal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mychain.testusd.v1.Msg/CancelWithdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelWithdrawal(ctx, req.(*MsgCancelWithdrawal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveWithdrawal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mychain.testusd.v1.Msg/ApproveWithdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveWithdrawal(ctx, req.(*MsgApproveWithdrawal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCollateralPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCollateralPrice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCollateralPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mychain.testusd.v1.Msg/UpdateCollateralPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCollateralPrice(ctx, req.(*MsgUpdateCollateralPrice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "mychain.testusd.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "BridgeIn",
+			Handler:    _Msg_BridgeIn_Handler,
+		},
+		{
+			MethodName: "BridgeOut",
+			Handler:    _Msg_BridgeOut_Handler,
+		},
+		{
+			MethodName: "PauseBridge",
+			Handler:    _Msg_PauseBridge_Handler,
+		},
+		{
+			MethodName: "UnpauseBridge",
+			Handler:    _Msg_UnpauseBridge_Handler,
+		},
+		{
+			MethodName: "AttestReserves",
+			Handler:    _Msg_AttestReserves_Handler,
+		},
+		{
+			MethodName: "CancelWithdrawal",
+			Handler:    _Msg_CancelWithdrawal_Handler,
+		},
+		{
+			MethodName: "ApproveWithdrawal",
+			Handler:    _Msg_ApproveWithdrawal_Handler,
+		},
+		{
+			MethodName: "UpdateCollateralPrice",
+			Handler:    _Msg_UpdateCollateralPrice_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mychain/testusd/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBridgeIn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBridgeIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBridgeIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBridgeInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBridgeInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBridgeInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MintedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBridgeOut) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBridgeOut) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBridgeOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBridgeOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBridgeOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBridgeOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithdrawalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WithdrawalId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Queued {
+		i--
+		if m.Queued {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.ReleasedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseBridge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseBridge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseBridge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseBridgeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseBridgeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseBridgeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseBridge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseBridge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseBridge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseBridgeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseBridgeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseBridgeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttestReserves) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttestReserves) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttestReserves) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Attestor) > 0 {
+		i -= len(m.Attestor)
+		copy(dAtA[i:], m.Attestor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Attestor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttestReservesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttestReservesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttestReservesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ReleasedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCollateralPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCollateralPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCollateralPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Oracle) > 0 {
+		i -= len(m.Oracle)
+		copy(dAtA[i:], m.Oracle)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Oracle)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCollateralPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCollateralPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCollateralPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBridgeIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBridgeInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MintedAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBridgeOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBridgeOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ReleasedAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	if m.Queued {
+		n += 2
+	}
+	if m.WithdrawalId != 0 {
+		n += 1 + sovTx(uint64(m.WithdrawalId))
+	}
+	return n
+}
+
+func (m *MsgPauseBridge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPauseBridgeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnpauseBridge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnpauseBridgeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAttestReserves) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Attestor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Timestamp != 0 {
+		n += 1 + sovTx(uint64(m.Timestamp))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAttestReservesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgApproveWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgApproveWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ReleasedAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateCollateralPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Oracle)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateCollateralPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBridgeIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBridgeIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBridgeIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBridgeInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBridgeInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBridgeInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBridgeOut) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBridgeOut: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBridgeOut: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBridgeOutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBridgeOutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBridgeOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReleasedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Queued = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalId", wireType)
+			}
+			m.WithdrawalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseBridge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseBridge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseBridge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseBridgeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseBridgeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseBridgeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnpauseBridge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpauseBridge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpauseBridge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnpauseBridgeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpauseBridgeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpauseBridgeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAttestReserves) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAttestReserves: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAttestReserves: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgBridgeInResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.MintedAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return nil
 }
-
-func (m *MsgBridgeOut) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgAttestReservesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAttestReservesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAttestReservesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgBridgeOutResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.ReleasedAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelWithdrawal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -853,15 +3553,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelWithdrawal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -889,13 +3589,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -905,25 +3605,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -945,7 +3631,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelWithdrawalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -968,10 +3654,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelWithdrawalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -995,7 +3681,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBridgeIn) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveWithdrawal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1018,15 +3704,15 @@ func (m *MsgBridgeIn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBridgeIn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveWithdrawal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBridgeIn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1054,13 +3740,13 @@ func (m *MsgBridgeIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1070,26 +3756,11 @@ func (m *MsgBridgeIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1111,7 +3782,7 @@ func (m *MsgBridgeIn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBridgeInResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveWithdrawalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1134,15 +3805,15 @@ func (m *MsgBridgeInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBridgeInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveWithdrawalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBridgeInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintedAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1169,7 +3840,7 @@ func (m *MsgBridgeInResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MintedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReleasedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1194,7 +3865,7 @@ func (m *MsgBridgeInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBridgeOut) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCollateralPrice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1217,15 +3888,15 @@ func (m *MsgBridgeOut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBridgeOut: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCollateralPrice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBridgeOut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCollateralPrice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1253,11 +3924,11 @@ func (m *MsgBridgeOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Oracle = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1285,7 +3956,7 @@ func (m *MsgBridgeOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1310,7 +3981,7 @@ func (m *MsgBridgeOut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBridgeOutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCollateralPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1333,45 +4004,12 @@ func (m *MsgBridgeOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBridgeOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCollateralPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBridgeOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCollateralPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedAmount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ReleasedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])